@@ -19,16 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Accounts_FullMethodName             = "/cosmos.auth.v1beta1.Query/Accounts"
-	Query_Account_FullMethodName              = "/cosmos.auth.v1beta1.Query/Account"
-	Query_AccountAddressByID_FullMethodName   = "/cosmos.auth.v1beta1.Query/AccountAddressByID"
-	Query_Params_FullMethodName               = "/cosmos.auth.v1beta1.Query/Params"
-	Query_ModuleAccounts_FullMethodName       = "/cosmos.auth.v1beta1.Query/ModuleAccounts"
-	Query_ModuleAccountByName_FullMethodName  = "/cosmos.auth.v1beta1.Query/ModuleAccountByName"
-	Query_Bech32Prefix_FullMethodName         = "/cosmos.auth.v1beta1.Query/Bech32Prefix"
-	Query_AddressBytesToString_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressBytesToString"
-	Query_AddressStringToBytes_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressStringToBytes"
-	Query_AccountInfo_FullMethodName          = "/cosmos.auth.v1beta1.Query/AccountInfo"
+	Query_Accounts_FullMethodName              = "/cosmos.auth.v1beta1.Query/Accounts"
+	Query_Account_FullMethodName               = "/cosmos.auth.v1beta1.Query/Account"
+	Query_AccountAddressByID_FullMethodName    = "/cosmos.auth.v1beta1.Query/AccountAddressByID"
+	Query_Params_FullMethodName                = "/cosmos.auth.v1beta1.Query/Params"
+	Query_ModuleAccounts_FullMethodName        = "/cosmos.auth.v1beta1.Query/ModuleAccounts"
+	Query_ModuleAccountByName_FullMethodName   = "/cosmos.auth.v1beta1.Query/ModuleAccountByName"
+	Query_Bech32Prefix_FullMethodName          = "/cosmos.auth.v1beta1.Query/Bech32Prefix"
+	Query_AddressBytesToString_FullMethodName  = "/cosmos.auth.v1beta1.Query/AddressBytesToString"
+	Query_AddressStringToBytes_FullMethodName  = "/cosmos.auth.v1beta1.Query/AddressStringToBytes"
+	Query_AccountInfo_FullMethodName           = "/cosmos.auth.v1beta1.Query/AccountInfo"
+	Query_MultisigProposal_FullMethodName      = "/cosmos.auth.v1beta1.Query/MultisigProposal"
+	Query_MultisigProposals_FullMethodName     = "/cosmos.auth.v1beta1.Query/MultisigProposals"
+	Query_AccountAuthenticators_FullMethodName = "/cosmos.auth.v1beta1.Query/AccountAuthenticators"
 )
 
 // QueryClient is the client API for Query service.
@@ -60,6 +63,12 @@ type QueryClient interface {
 	AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// MultisigProposal returns a proposal of a multisig account.
+	MultisigProposal(ctx context.Context, in *QueryMultisigProposalRequest, opts ...grpc.CallOption) (*QueryMultisigProposalResponse, error)
+	// MultisigProposals returns all the proposals of a multisig account.
+	MultisigProposals(ctx context.Context, in *QueryMultisigProposalsRequest, opts ...grpc.CallOption) (*QueryMultisigProposalsResponse, error)
+	// AccountAuthenticators returns the authenticators of an account.
+	AccountAuthenticators(ctx context.Context, in *QueryAccountAuthenticatorsRequest, opts ...grpc.CallOption) (*QueryAccountAuthenticatorsResponse, error)
 }
 
 type queryClient struct {
@@ -170,6 +179,36 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) MultisigProposal(ctx context.Context, in *QueryMultisigProposalRequest, opts ...grpc.CallOption) (*QueryMultisigProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMultisigProposalResponse)
+	err := c.cc.Invoke(ctx, Query_MultisigProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MultisigProposals(ctx context.Context, in *QueryMultisigProposalsRequest, opts ...grpc.CallOption) (*QueryMultisigProposalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMultisigProposalsResponse)
+	err := c.cc.Invoke(ctx, Query_MultisigProposals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountAuthenticators(ctx context.Context, in *QueryAccountAuthenticatorsRequest, opts ...grpc.CallOption) (*QueryAccountAuthenticatorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountAuthenticatorsResponse)
+	err := c.cc.Invoke(ctx, Query_AccountAuthenticators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -199,6 +238,12 @@ type QueryServer interface {
 	AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// MultisigProposal returns a proposal of a multisig account.
+	MultisigProposal(context.Context, *QueryMultisigProposalRequest) (*QueryMultisigProposalResponse, error)
+	// MultisigProposals returns all the proposals of a multisig account.
+	MultisigProposals(context.Context, *QueryMultisigProposalsRequest) (*QueryMultisigProposalsResponse, error)
+	// AccountAuthenticators returns the authenticators of an account.
+	AccountAuthenticators(context.Context, *QueryAccountAuthenticatorsRequest) (*QueryAccountAuthenticatorsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -239,6 +284,15 @@ func (UnimplementedQueryServer) AddressStringToBytes(context.Context, *AddressSt
 func (UnimplementedQueryServer) AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (UnimplementedQueryServer) MultisigProposal(context.Context, *QueryMultisigProposalRequest) (*QueryMultisigProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultisigProposal not implemented")
+}
+func (UnimplementedQueryServer) MultisigProposals(context.Context, *QueryMultisigProposalsRequest) (*QueryMultisigProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultisigProposals not implemented")
+}
+func (UnimplementedQueryServer) AccountAuthenticators(context.Context, *QueryAccountAuthenticatorsRequest) (*QueryAccountAuthenticatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountAuthenticators not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -440,6 +494,60 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MultisigProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMultisigProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MultisigProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MultisigProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MultisigProposal(ctx, req.(*QueryMultisigProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MultisigProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMultisigProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MultisigProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MultisigProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MultisigProposals(ctx, req.(*QueryMultisigProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountAuthenticators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountAuthenticatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountAuthenticators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountAuthenticators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountAuthenticators(ctx, req.(*QueryAccountAuthenticatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -487,6 +595,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "MultisigProposal",
+			Handler:    _Query_MultisigProposal_Handler,
+		},
+		{
+			MethodName: "MultisigProposals",
+			Handler:    _Query_MultisigProposals_Handler,
+		},
+		{
+			MethodName: "AccountAuthenticators",
+			Handler:    _Query_AccountAuthenticators_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
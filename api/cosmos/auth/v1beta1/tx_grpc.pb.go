@@ -19,7 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_UpdateParams_FullMethodName = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName           = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_CreateMultisig_FullMethodName         = "/cosmos.auth.v1beta1.Msg/CreateMultisig"
+	Msg_UpdateMultisig_FullMethodName         = "/cosmos.auth.v1beta1.Msg/UpdateMultisig"
+	Msg_SubmitMultisigProposal_FullMethodName = "/cosmos.auth.v1beta1.Msg/SubmitMultisigProposal"
+	Msg_VoteMultisigProposal_FullMethodName   = "/cosmos.auth.v1beta1.Msg/VoteMultisigProposal"
+	Msg_AddAuthenticator_FullMethodName       = "/cosmos.auth.v1beta1.Msg/AddAuthenticator"
+	Msg_RemoveAuthenticator_FullMethodName    = "/cosmos.auth.v1beta1.Msg/RemoveAuthenticator"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +37,22 @@ type MsgClient interface {
 	// UpdateParams defines a (governance) operation for updating the x/auth module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateMultisig creates an on-chain multisig account.
+	CreateMultisig(ctx context.Context, in *MsgCreateMultisig, opts ...grpc.CallOption) (*MsgCreateMultisigResponse, error)
+	// UpdateMultisig updates the members, threshold and voting period of a
+	// multisig account. It must be executed through a proposal of the multisig,
+	// and aborts its pending proposals.
+	UpdateMultisig(ctx context.Context, in *MsgUpdateMultisig, opts ...grpc.CallOption) (*MsgUpdateMultisigResponse, error)
+	// SubmitMultisigProposal submits a proposal on behalf of a multisig account,
+	// counting as a yes vote of the proposer.
+	SubmitMultisigProposal(ctx context.Context, in *MsgSubmitMultisigProposal, opts ...grpc.CallOption) (*MsgSubmitMultisigProposalResponse, error)
+	// VoteMultisigProposal votes on a multisig proposal. The proposal is executed
+	// as soon as it reaches the threshold.
+	VoteMultisigProposal(ctx context.Context, in *MsgVoteMultisigProposal, opts ...grpc.CallOption) (*MsgVoteMultisigProposalResponse, error)
+	// AddAuthenticator registers an authenticator for the sender account.
+	AddAuthenticator(ctx context.Context, in *MsgAddAuthenticator, opts ...grpc.CallOption) (*MsgAddAuthenticatorResponse, error)
+	// RemoveAuthenticator removes an authenticator of the sender account.
+	RemoveAuthenticator(ctx context.Context, in *MsgRemoveAuthenticator, opts ...grpc.CallOption) (*MsgRemoveAuthenticatorResponse, error)
 }
 
 type msgClient struct {
@@ -51,6 +73,66 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) CreateMultisig(ctx context.Context, in *MsgCreateMultisig, opts ...grpc.CallOption) (*MsgCreateMultisigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCreateMultisigResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateMultisig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateMultisig(ctx context.Context, in *MsgUpdateMultisig, opts ...grpc.CallOption) (*MsgUpdateMultisigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateMultisigResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateMultisig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitMultisigProposal(ctx context.Context, in *MsgSubmitMultisigProposal, opts ...grpc.CallOption) (*MsgSubmitMultisigProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSubmitMultisigProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitMultisigProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VoteMultisigProposal(ctx context.Context, in *MsgVoteMultisigProposal, opts ...grpc.CallOption) (*MsgVoteMultisigProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgVoteMultisigProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_VoteMultisigProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAuthenticator(ctx context.Context, in *MsgAddAuthenticator, opts ...grpc.CallOption) (*MsgAddAuthenticatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAddAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, Msg_AddAuthenticator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAuthenticator(ctx context.Context, in *MsgRemoveAuthenticator, opts ...grpc.CallOption) (*MsgRemoveAuthenticatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveAuthenticator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -60,6 +142,22 @@ type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the x/auth module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateMultisig creates an on-chain multisig account.
+	CreateMultisig(context.Context, *MsgCreateMultisig) (*MsgCreateMultisigResponse, error)
+	// UpdateMultisig updates the members, threshold and voting period of a
+	// multisig account. It must be executed through a proposal of the multisig,
+	// and aborts its pending proposals.
+	UpdateMultisig(context.Context, *MsgUpdateMultisig) (*MsgUpdateMultisigResponse, error)
+	// SubmitMultisigProposal submits a proposal on behalf of a multisig account,
+	// counting as a yes vote of the proposer.
+	SubmitMultisigProposal(context.Context, *MsgSubmitMultisigProposal) (*MsgSubmitMultisigProposalResponse, error)
+	// VoteMultisigProposal votes on a multisig proposal. The proposal is executed
+	// as soon as it reaches the threshold.
+	VoteMultisigProposal(context.Context, *MsgVoteMultisigProposal) (*MsgVoteMultisigProposalResponse, error)
+	// AddAuthenticator registers an authenticator for the sender account.
+	AddAuthenticator(context.Context, *MsgAddAuthenticator) (*MsgAddAuthenticatorResponse, error)
+	// RemoveAuthenticator removes an authenticator of the sender account.
+	RemoveAuthenticator(context.Context, *MsgRemoveAuthenticator) (*MsgRemoveAuthenticatorResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -73,6 +171,24 @@ type UnimplementedMsgServer struct{}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) CreateMultisig(context.Context, *MsgCreateMultisig) (*MsgCreateMultisigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMultisig not implemented")
+}
+func (UnimplementedMsgServer) UpdateMultisig(context.Context, *MsgUpdateMultisig) (*MsgUpdateMultisigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMultisig not implemented")
+}
+func (UnimplementedMsgServer) SubmitMultisigProposal(context.Context, *MsgSubmitMultisigProposal) (*MsgSubmitMultisigProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitMultisigProposal not implemented")
+}
+func (UnimplementedMsgServer) VoteMultisigProposal(context.Context, *MsgVoteMultisigProposal) (*MsgVoteMultisigProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteMultisigProposal not implemented")
+}
+func (UnimplementedMsgServer) AddAuthenticator(context.Context, *MsgAddAuthenticator) (*MsgAddAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAuthenticator not implemented")
+}
+func (UnimplementedMsgServer) RemoveAuthenticator(context.Context, *MsgRemoveAuthenticator) (*MsgRemoveAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAuthenticator not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -112,6 +228,114 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateMultisig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMultisig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMultisig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateMultisig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMultisig(ctx, req.(*MsgCreateMultisig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMultisig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMultisig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMultisig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateMultisig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMultisig(ctx, req.(*MsgUpdateMultisig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitMultisigProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitMultisigProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitMultisigProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitMultisigProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitMultisigProposal(ctx, req.(*MsgSubmitMultisigProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoteMultisigProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteMultisigProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteMultisigProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VoteMultisigProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteMultisigProposal(ctx, req.(*MsgVoteMultisigProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAuthenticator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddAuthenticator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAuthenticator(ctx, req.(*MsgAddAuthenticator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAuthenticator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveAuthenticator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAuthenticator(ctx, req.(*MsgRemoveAuthenticator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -123,6 +347,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "CreateMultisig",
+			Handler:    _Msg_CreateMultisig_Handler,
+		},
+		{
+			MethodName: "UpdateMultisig",
+			Handler:    _Msg_UpdateMultisig_Handler,
+		},
+		{
+			MethodName: "SubmitMultisigProposal",
+			Handler:    _Msg_SubmitMultisigProposal_Handler,
+		},
+		{
+			MethodName: "VoteMultisigProposal",
+			Handler:    _Msg_VoteMultisigProposal_Handler,
+		},
+		{
+			MethodName: "AddAuthenticator",
+			Handler:    _Msg_AddAuthenticator_Handler,
+		},
+		{
+			MethodName: "RemoveAuthenticator",
+			Handler:    _Msg_RemoveAuthenticator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/tx.proto",
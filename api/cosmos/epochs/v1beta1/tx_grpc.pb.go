@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cosmos/epochs/v1beta1/tx.proto
+
+package epochsv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Msg_AddEpoch_FullMethodName            = "/cosmos.epochs.v1beta1.Msg/AddEpoch"
+	Msg_PauseEpoch_FullMethodName          = "/cosmos.epochs.v1beta1.Msg/PauseEpoch"
+	Msg_ResumeEpoch_FullMethodName         = "/cosmos.epochs.v1beta1.Msg/ResumeEpoch"
+	Msg_UpdateEpochDuration_FullMethodName = "/cosmos.epochs.v1beta1.Msg/UpdateEpochDuration"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Msg defines the epochs Msg service.
+type MsgClient interface {
+	// AddEpoch adds a new epoch identifier.
+	// It can only be executed by the module authority.
+	AddEpoch(ctx context.Context, in *MsgAddEpoch, opts ...grpc.CallOption) (*MsgAddEpochResponse, error)
+	// PauseEpoch stops an epoch from ticking.
+	// It can only be executed by the module authority.
+	PauseEpoch(ctx context.Context, in *MsgPauseEpoch, opts ...grpc.CallOption) (*MsgPauseEpochResponse, error)
+	// ResumeEpoch restarts the current epoch of a paused epoch.
+	// It can only be executed by the module authority.
+	ResumeEpoch(ctx context.Context, in *MsgResumeEpoch, opts ...grpc.CallOption) (*MsgResumeEpochResponse, error)
+	// UpdateEpochDuration changes the duration of an epoch.
+	// It can only be executed by the module authority.
+	UpdateEpochDuration(ctx context.Context, in *MsgUpdateEpochDuration, opts ...grpc.CallOption) (*MsgUpdateEpochDurationResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddEpoch(ctx context.Context, in *MsgAddEpoch, opts ...grpc.CallOption) (*MsgAddEpochResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAddEpochResponse)
+	err := c.cc.Invoke(ctx, Msg_AddEpoch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseEpoch(ctx context.Context, in *MsgPauseEpoch, opts ...grpc.CallOption) (*MsgPauseEpochResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgPauseEpochResponse)
+	err := c.cc.Invoke(ctx, Msg_PauseEpoch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeEpoch(ctx context.Context, in *MsgResumeEpoch, opts ...grpc.CallOption) (*MsgResumeEpochResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgResumeEpochResponse)
+	err := c.cc.Invoke(ctx, Msg_ResumeEpoch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateEpochDuration(ctx context.Context, in *MsgUpdateEpochDuration, opts ...grpc.CallOption) (*MsgUpdateEpochDurationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateEpochDurationResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateEpochDuration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility.
+//
+// Msg defines the epochs Msg service.
+type MsgServer interface {
+	// AddEpoch adds a new epoch identifier.
+	// It can only be executed by the module authority.
+	AddEpoch(context.Context, *MsgAddEpoch) (*MsgAddEpochResponse, error)
+	// PauseEpoch stops an epoch from ticking.
+	// It can only be executed by the module authority.
+	PauseEpoch(context.Context, *MsgPauseEpoch) (*MsgPauseEpochResponse, error)
+	// ResumeEpoch restarts the current epoch of a paused epoch.
+	// It can only be executed by the module authority.
+	ResumeEpoch(context.Context, *MsgResumeEpoch) (*MsgResumeEpochResponse, error)
+	// UpdateEpochDuration changes the duration of an epoch.
+	// It can only be executed by the module authority.
+	UpdateEpochDuration(context.Context, *MsgUpdateEpochDuration) (*MsgUpdateEpochDurationResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMsgServer struct{}
+
+func (UnimplementedMsgServer) AddEpoch(context.Context, *MsgAddEpoch) (*MsgAddEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEpoch not implemented")
+}
+func (UnimplementedMsgServer) PauseEpoch(context.Context, *MsgPauseEpoch) (*MsgPauseEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseEpoch not implemented")
+}
+func (UnimplementedMsgServer) ResumeEpoch(context.Context, *MsgResumeEpoch) (*MsgResumeEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeEpoch not implemented")
+}
+func (UnimplementedMsgServer) UpdateEpochDuration(context.Context, *MsgUpdateEpochDuration) (*MsgUpdateEpochDurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEpochDuration not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+func (UnimplementedMsgServer) testEmbeddedByValue()             {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	// If the following call pancis, it indicates UnimplementedMsgServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_AddEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddEpoch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddEpoch(ctx, req.(*MsgAddEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PauseEpoch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseEpoch(ctx, req.(*MsgPauseEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResumeEpoch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeEpoch(ctx, req.(*MsgResumeEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateEpochDuration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEpochDuration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEpochDuration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateEpochDuration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEpochDuration(ctx, req.(*MsgUpdateEpochDuration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.epochs.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddEpoch",
+			Handler:    _Msg_AddEpoch_Handler,
+		},
+		{
+			MethodName: "PauseEpoch",
+			Handler:    _Msg_PauseEpoch_Handler,
+		},
+		{
+			MethodName: "ResumeEpoch",
+			Handler:    _Msg_ResumeEpoch_Handler,
+		},
+		{
+			MethodName: "UpdateEpochDuration",
+			Handler:    _Msg_UpdateEpochDuration_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/epochs/v1beta1/tx.proto",
+}
@@ -23,6 +23,8 @@ const (
 	Msg_CommunityPoolSpend_FullMethodName   = "/cosmos.protocolpool.v1.Msg/CommunityPoolSpend"
 	Msg_CreateContinuousFund_FullMethodName = "/cosmos.protocolpool.v1.Msg/CreateContinuousFund"
 	Msg_CancelContinuousFund_FullMethodName = "/cosmos.protocolpool.v1.Msg/CancelContinuousFund"
+	Msg_CreateBudget_FullMethodName         = "/cosmos.protocolpool.v1.Msg/CreateBudget"
+	Msg_ClawbackBudget_FullMethodName       = "/cosmos.protocolpool.v1.Msg/ClawbackBudget"
 	Msg_UpdateParams_FullMethodName         = "/cosmos.protocolpool.v1.Msg/UpdateParams"
 )
 
@@ -47,6 +49,12 @@ type MsgClient interface {
 	CreateContinuousFund(ctx context.Context, in *MsgCreateContinuousFund, opts ...grpc.CallOption) (*MsgCreateContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(ctx context.Context, in *MsgCancelContinuousFund, opts ...grpc.CallOption) (*MsgCancelContinuousFundResponse, error)
+	// CreateBudget defines a method to pay a fixed amount from the community pool to an address
+	// once per period, for a fixed number of periods.
+	CreateBudget(ctx context.Context, in *MsgCreateBudget, opts ...grpc.CallOption) (*MsgCreateBudgetResponse, error)
+	// ClawbackBudget defines a method for cancelling a budget. All amounts not yet paid out
+	// remain in the community pool.
+	ClawbackBudget(ctx context.Context, in *MsgClawbackBudget, opts ...grpc.CallOption) (*MsgClawbackBudgetResponse, error)
 	// UpdateParams defines a governance operation for updating the x/protocolpool module parameters.
 	// The authority is defined in the keeper.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -100,6 +108,26 @@ func (c *msgClient) CancelContinuousFund(ctx context.Context, in *MsgCancelConti
 	return out, nil
 }
 
+func (c *msgClient) CreateBudget(ctx context.Context, in *MsgCreateBudget, opts ...grpc.CallOption) (*MsgCreateBudgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCreateBudgetResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClawbackBudget(ctx context.Context, in *MsgClawbackBudget, opts ...grpc.CallOption) (*MsgClawbackBudgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgClawbackBudgetResponse)
+	err := c.cc.Invoke(ctx, Msg_ClawbackBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgUpdateParamsResponse)
@@ -131,6 +159,12 @@ type MsgServer interface {
 	CreateContinuousFund(context.Context, *MsgCreateContinuousFund) (*MsgCreateContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error)
+	// CreateBudget defines a method to pay a fixed amount from the community pool to an address
+	// once per period, for a fixed number of periods.
+	CreateBudget(context.Context, *MsgCreateBudget) (*MsgCreateBudgetResponse, error)
+	// ClawbackBudget defines a method for cancelling a budget. All amounts not yet paid out
+	// remain in the community pool.
+	ClawbackBudget(context.Context, *MsgClawbackBudget) (*MsgClawbackBudgetResponse, error)
 	// UpdateParams defines a governance operation for updating the x/protocolpool module parameters.
 	// The authority is defined in the keeper.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -156,6 +190,12 @@ func (UnimplementedMsgServer) CreateContinuousFund(context.Context, *MsgCreateCo
 func (UnimplementedMsgServer) CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelContinuousFund not implemented")
 }
+func (UnimplementedMsgServer) CreateBudget(context.Context, *MsgCreateBudget) (*MsgCreateBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBudget not implemented")
+}
+func (UnimplementedMsgServer) ClawbackBudget(context.Context, *MsgClawbackBudget) (*MsgClawbackBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClawbackBudget not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -252,6 +292,42 @@ func _Msg_CancelContinuousFund_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBudget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBudget(ctx, req.(*MsgCreateBudget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClawbackBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawbackBudget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClawbackBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClawbackBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClawbackBudget(ctx, req.(*MsgClawbackBudget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -293,6 +369,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelContinuousFund",
 			Handler:    _Msg_CancelContinuousFund_Handler,
 		},
+		{
+			MethodName: "CreateBudget",
+			Handler:    _Msg_CreateBudget_Handler,
+		},
+		{
+			MethodName: "ClawbackBudget",
+			Handler:    _Msg_ClawbackBudget_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
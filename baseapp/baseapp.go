@@ -457,6 +457,12 @@ func (app *BaseApp) Init() error {
 	return app.cms.GetPruning().Validate()
 }
 
+// MinGasPrices returns the minimum gas prices of the node, configured with
+// the minimum-gas-prices option.
+func (app *BaseApp) MinGasPrices() sdk.DecCoins {
+	return app.gasConfig.MinGasPrices
+}
+
 func (app *BaseApp) setMinGasPrices(gasPrices sdk.DecCoins) {
 	app.gasConfig.MinGasPrices = gasPrices
 }
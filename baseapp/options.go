@@ -394,6 +394,13 @@ func (app *BaseApp) SetStreamingManager(manager storetypes.StreamingManager) {
 	app.streamingManager = manager
 }
 
+// AddABCIListener adds an ABCIListener to the streaming manager of the BaseApp.
+// As the streaming services replace the streaming manager, it must be called
+// after RegisterStreamingServices.
+func (app *BaseApp) AddABCIListener(listener storetypes.ABCIListener) {
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, listener)
+}
+
 // SetDisableBlockGasMeter sets the disableBlockGasMeter flag for the BaseApp.
 func (app *BaseApp) SetDisableBlockGasMeter(disableBlockGasMeter bool) {
 	app.disableBlockGasMeter = disableBlockGasMeter
@@ -131,6 +131,12 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 	if clientCtx.Keyring == nil || flagSet.Changed(flags.FlagKeyringBackend) {
 		keyringBackend, _ := flagSet.GetString(flags.FlagKeyringBackend)
 
+		if keyringBackend == keyring.BackendRemote {
+			remoteAddr, _ := flagSet.GetString(flags.FlagKeyringRemote)
+			opts := append([]keyring.Option{}, clientCtx.KeyringOptions...)
+			clientCtx = clientCtx.WithKeyringOptions(append(opts, keyring.WithRemoteSigner(remoteAddr))...)
+		}
+
 		if keyringBackend != "" {
 			kr, err := NewKeyringFromBackend(clientCtx, keyringBackend)
 			if err != nil {
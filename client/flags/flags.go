@@ -17,6 +17,7 @@ const (
 	DefaultGasAdjustment = 1.0
 	DefaultGasLimit      = 200000
 	GasFlagAuto          = "auto"
+	FeesFlagAuto         = "auto"
 
 	DefaultKeyringBackend = keyring.BackendOS
 
@@ -58,6 +59,9 @@ const (
 	FlagFees             = "fees"
 	FlagGas              = "gas"
 	FlagGasPrices        = "gas-prices"
+	FlagFeePriority      = "fee-priority"
+	FlagTextualReview    = "textual-review"
+	FlagTextualExpert    = "textual-expert"
 	FlagBroadcastMode    = "broadcast-mode"
 	FlagDryRun           = "dry-run"
 	FlagGenerateOnly     = "generate-only"
@@ -66,6 +70,7 @@ const (
 	FlagSkipConfirmation = "yes"
 	FlagProve            = "prove"
 	FlagKeyringBackend   = "keyring-backend"
+	FlagKeyringRemote    = "keyring-remote-addr"
 	FlagPage             = "page"
 	FlagLimit            = "limit"
 	FlagSignMode         = "sign-mode"
@@ -126,8 +131,9 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	f.Uint64P(FlagAccountNumber, "a", 0, "The account number of the signing account (offline mode only)")
 	f.Uint64P(FlagSequence, "s", 0, "The sequence number of the signing account (offline mode only)")
 	f.String(FlagNote, "", "Note to add a description to the transaction (previously --memo)")
-	f.String(FlagFees, "", "Fees to pay along with transaction; eg: 10uatom")
+	f.String(FlagFees, "", fmt.Sprintf("Fees to pay along with transaction; eg: 10uatom. Set to %q to estimate them with the node", FeesFlagAuto))
 	f.String(FlagGasPrices, "", "Gas prices in decimal format to determine the transaction fee (e.g. 0.1uatom)")
+	f.String(FlagFeePriority, "medium", fmt.Sprintf("Priority level of the fees estimated with --%s=%s (low|medium|high)", FlagFees, FeesFlagAuto))
 	f.String(FlagNode, "tcp://localhost:26657", "<host>:<port> to CometBFT rpc interface for this chain")
 	f.Bool(FlagUseLedger, false, "Use a connected Ledger device")
 	f.Float64(FlagGasAdjustment, DefaultGasAdjustment, "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored")
@@ -137,6 +143,8 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	f.Bool(FlagOffline, false, "Offline mode (does not allow any online functionality)")
 	f.BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
 	f.String(FlagSignMode, "", "Choose sign mode (direct|amino-json|direct-aux|textual), this is an advanced feature")
+	f.Bool(FlagTextualReview, false, "Review the SIGN_MODE_TEXTUAL screens of the transaction, as shown by a Ledger device, and confirm before signing it, even with --yes; implies --sign-mode=textual")
+	f.Bool(FlagTextualExpert, false, "Show the expert screens in the review of --textual-review, which can also be toggled while reviewing")
 	f.Uint64(FlagTimeoutHeight, 0, "DEPRECATED: Please use --timeout-duration instead. Set a block timeout height to prevent the tx from being committed past a certain height")
 	f.Duration(TimeoutDuration, 0, "TimeoutDuration is the duration the transaction will be considered valid in the mempool. The transaction's unordered nonce will be set to the time of transaction creation + the duration value passed. If the transaction is still in the mempool, and the block time has passed the time of submission + TimeoutTimestamp, the transaction will be rejected.")
 	f.Bool(FlagUnordered, false, "Enable unordered transaction delivery; must be used in conjunction with --timeout-duration")
@@ -160,7 +168,8 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 // AddKeyringFlags sets common keyring flags
 func AddKeyringFlags(flags *pflag.FlagSet) {
 	flags.String(FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
-	flags.String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+	flags.String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory|remote)")
+	flags.String(FlagKeyringRemote, "", "The gRPC address of the remote signer used by the remote keyring backend")
 }
 
 // AddPaginationFlagsToCmd adds common pagination flags to cmd
@@ -91,7 +91,7 @@ Example:
 	f.Uint32(flagCoinType, sdk.GetConfig().GetCoinType(), "coin type number for HD derivation")
 	f.Uint32(flagAccount, 0, "Account number for HD derivation (less than equal 2147483647)")
 	f.Uint32(flagIndex, 0, "Address index number for HD derivation (less than equal 2147483647)")
-	f.String(flags.FlagKeyType, string(hd.Secp256k1Type), "Key signing algorithm to generate keys for")
+	f.String(flags.FlagKeyType, string(hd.Secp256k1Type), "Key signing algorithm to generate keys for (ed25519 is only available if the app opts in)")
 	f.String(flagMnemonicSrc, "", "Import mnemonic from a file (only usable when recover or interactive is passed)")
 
 	// support old flags name for backwards compatibility
@@ -276,9 +276,13 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	hdPath, _ := cmd.Flags().GetString(flagHDPath)
 	useLedger, _ := cmd.Flags().GetBool(flags.FlagUseLedger)
 
-	if len(hdPath) == 0 {
+	switch {
+	case len(hdPath) == 0 && algo.Name() == hd.Ed25519Type:
+		// SLIP-0010 ed25519 keys are only derived on hardened paths
+		hdPath = hd.CreateHardenedHDPath(coinType, account, index)
+	case len(hdPath) == 0:
 		hdPath = hd.CreateHDPath(coinType, account, index).String()
-	} else if useLedger {
+	case useLedger:
 		return errors.New("cannot set custom bip32 path with ledger")
 	}
 
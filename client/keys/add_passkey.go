@@ -0,0 +1,68 @@
+package keys
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
+)
+
+const flagRPID = "rp-id"
+
+// AddPasskeyCommand adds the public key of a WebAuthn credential, such as a
+// passkey, to the keyring.
+func AddPasskeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-passkey <name> <public-key>",
+		Short: "Add the public key of a passkey to the keyring",
+		Long: `Add the public key of a WebAuthn credential, such as a passkey, as an offline key.
+
+The public key is the base64 encoded DER SubjectPublicKeyInfo of a P-256 credential, as returned
+by getPublicKey() on the attestation response of the browser. The relying party id is the domain
+the credential is scoped to, and is part of the public key.
+
+Transactions are signed with the credential through the tx passkey commands.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			name := args[0]
+			if err := checkName(name); err != nil {
+				return err
+			}
+			if _, err := clientCtx.Keyring.Key(name); err == nil {
+				return fmt.Errorf("key %s already exists", name)
+			}
+
+			rpID, _ := cmd.Flags().GetString(flagRPID)
+			der, err := base64.StdEncoding.DecodeString(strings.TrimSpace(args[1]))
+			if err != nil {
+				return err
+			}
+			pk, err := webauthn.NewPubKeyFromPKIX(der, rpID)
+			if err != nil {
+				return err
+			}
+
+			k, err := clientCtx.Keyring.SaveOfflineKey(name, pk)
+			if err != nil {
+				return err
+			}
+
+			return printCreate(cmd, k, false, "", clientCtx.OutputFormat)
+		},
+	}
+
+	cmd.Flags().String(flagRPID, "", "The relying party id of the credential")
+	_ = cmd.MarkFlagRequired(flagRPID)
+
+	return cmd
+}
@@ -0,0 +1,70 @@
+package keys
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func Test_runAddPasskeyCmd(t *testing.T) {
+	cmd := AddPasskeyCommand()
+	cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+	kbHome := t.TempDir()
+
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn, cdc)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.WithKeyringDir(kbHome).WithInput(mockIn).WithCodec(cdc)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	spki, err := x509.MarshalPKIXPublicKey(&priv.PublicKey)
+	require.NoError(t, err)
+
+	args := func(name, pubKey string) []string {
+		return []string{
+			name, pubKey,
+			fmt.Sprintf("--%s=%s", flagRPID, "wallet.example.com"),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHome),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		}
+	}
+
+	cmd.SetArgs(args("passkey", "not base64"))
+	require.Error(t, cmd.ExecuteContext(ctx))
+
+	cmd.SetArgs(args("passkey", base64.StdEncoding.EncodeToString(spki)))
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	k, err := kb.Key("passkey")
+	require.NoError(t, err)
+	require.Equal(t, keyring.TypeOffline, k.GetType())
+	pk, err := k.GetPubKey()
+	require.NoError(t, err)
+	pubKey, ok := pk.(*webauthn.PubKey)
+	require.True(t, ok)
+	require.Equal(t, "wallet.example.com", pubKey.RelyingPartyId)
+
+	// existing keys are not overridden
+	cmd.SetArgs(args("passkey", base64.StdEncoding.EncodeToString(spki)))
+	require.ErrorContains(t, cmd.ExecuteContext(ctx), "already exists")
+}
@@ -130,6 +130,61 @@ func Test_runAddCmdBasic(t *testing.T) {
 	require.Error(t, cmd.ExecuteContext(ctx))
 }
 
+func Test_runAddCmdSLIP10(t *testing.T) {
+	cmd := AddKeyCommand()
+	cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+	kbHome := t.TempDir()
+
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn, cdc)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.WithKeyringDir(kbHome).WithInput(mockIn).WithCodec(cdc)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	const mnemonic = "decide praise business actor peasant farm drastic weather extend front hurt later song give verb rhythm worry fun pond reform school tumble august one"
+
+	args := func(name string, algo hd.PubKeyType, extraArgs ...string) []string {
+		return append([]string{
+			name,
+			fmt.Sprintf("--%s", flagRecover),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyType, algo),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHome),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		}, extraArgs...)
+	}
+
+	// ed25519 keys are opt-in
+	cmd.SetArgs(args(string(hd.Ed25519Type), hd.Ed25519Type))
+	mockIn.Reset(mnemonic + "\n")
+	require.ErrorIs(t, cmd.ExecuteContext(ctx), keyring.ErrUnsupportedSigningAlgo)
+	clientCtx = clientCtx.WithKeyringOptions(keyring.WithSupportedAlgos(hd.Ed25519))
+
+	// keys of all the algorithms are recovered from the same mnemonic
+	for _, algo := range []hd.PubKeyType{hd.Secp256k1Type, hd.Ed25519Type, hd.Secp256r1Type} {
+		cmd.SetArgs(args(string(algo), algo))
+		mockIn.Reset(mnemonic + "\n")
+		require.NoError(t, cmd.ExecuteContext(ctx))
+
+		k, err := kb.Key(string(algo))
+		require.NoError(t, err)
+		pubKey, err := k.GetPubKey()
+		require.NoError(t, err)
+		require.Equal(t, string(algo), pubKey.Type())
+	}
+
+	// ed25519 keys are derived on a hardened path by default
+	k, err := kb.Key(string(hd.Ed25519Type))
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/118'/0'/0'/0'", k.GetLocal().HdPath)
+
+	cmd.SetArgs(args("unhardened", hd.Ed25519Type, fmt.Sprintf("--%s=%s", flagHDPath, sdk.FullFundraiserPath)))
+	mockIn.Reset(mnemonic + "\n")
+	require.ErrorContains(t, cmd.ExecuteContext(ctx), "ed25519 only supports hardened derivation")
+}
+
 func Test_runAddCmdMultisigDupKeys(t *testing.T) {
 	cmd := AddKeyCommand()
 	cmd.Flags().AddFlagSet(Commands().PersistentFlags())
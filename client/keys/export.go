@@ -16,6 +16,7 @@ import (
 const (
 	flagUnarmoredHex = "unarmored-hex"
 	flagUnsafe       = "unsafe"
+	flagLegacyArmor  = "legacy-armor"
 )
 
 // ExportKeyCommand exports private keys from the key store.
@@ -25,6 +26,12 @@ func ExportKeyCommand() *cobra.Command {
 		Short: "Export private keys",
 		Long: `Export a private key from the local keyring in ASCII-armored encrypted format.
 
+The key is encrypted with XChaCha20-Poly1305 under a key derived from the passphrase with
+Argon2id. The Argon2id parameters are stored in the armor header, along with the algorithm,
+the HD path and the creation time of the key when known. Former versions cannot import
+keys exported in this format: use the --legacy-armor flag to export a key in the legacy
+format they can import.
+
 When both the --unarmored-hex and --unsafe flags are selected, cryptographic
 private key material is exported in an INSECURE fashion that is designed to
 allow users to import their keys in hot wallets. This feature is for advanced
@@ -40,6 +47,7 @@ and export your keys in ASCII-armored encrypted format.`,
 			buf := bufio.NewReader(clientCtx.Input)
 			unarmored, _ := cmd.Flags().GetBool(flagUnarmoredHex)
 			unsafe, _ := cmd.Flags().GetBool(flagUnsafe)
+			legacy, _ := cmd.Flags().GetBool(flagLegacyArmor)
 
 			if unarmored && unsafe {
 				return exportUnsafeUnarmored(cmd, args[0], buf, clientCtx.Keyring)
@@ -47,12 +55,21 @@ and export your keys in ASCII-armored encrypted format.`,
 				return fmt.Errorf("the flags %s and %s must be used together", flagUnsafe, flagUnarmoredHex)
 			}
 
+			exportArmor := clientCtx.Keyring.ExportPrivKeyArmor
+			if legacy {
+				exporter, ok := clientCtx.Keyring.(legacyArmorExporter)
+				if !ok {
+					return fmt.Errorf("the keyring does not support the %s flag", flagLegacyArmor)
+				}
+				exportArmor = exporter.ExportPrivKeyArmorLegacy
+			}
+
 			encryptPassword, err := input.GetPassword("Enter passphrase to encrypt the exported key:", buf)
 			if err != nil {
 				return err
 			}
 
-			armored, err := clientCtx.Keyring.ExportPrivKeyArmor(args[0], encryptPassword)
+			armored, err := exportArmor(args[0], encryptPassword)
 			if err != nil {
 				return err
 			}
@@ -66,6 +83,7 @@ and export your keys in ASCII-armored encrypted format.`,
 	cmd.Flags().Bool(flagUnarmoredHex, false, "Export unarmored hex privkey. Requires --unsafe.")
 	cmd.Flags().Bool(flagUnsafe, false, "Enable unsafe operations. This flag must be switched on along with all unsafe operation-specific options.")
 	cmd.Flags().BoolP(flagYes, "y", false, "Skip confirmation prompt when export unarmored hex privkey")
+	cmd.Flags().Bool(flagLegacyArmor, false, "Export the key in the legacy armor format, which former versions can import")
 
 	return cmd
 }
@@ -90,6 +108,13 @@ func exportUnsafeUnarmored(cmd *cobra.Command, uid string, buf *bufio.Reader, kr
 	return nil
 }
 
+// legacyArmorExporter is implemented by key stores that support the export of
+// private keys in the legacy armor format.
+type legacyArmorExporter interface {
+	// ExportPrivKeyArmorLegacy returns a private key in the legacy ASCII armored format.
+	ExportPrivKeyArmorLegacy(uid, encryptPassphrase string) (string, error)
+}
+
 // unsafeExporter is implemented by key stores that support unsafe export
 // of private keys' material.
 type unsafeExporter interface {
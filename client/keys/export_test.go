@@ -65,6 +65,19 @@ func Test_runExportCmd(t *testing.T) {
 			mustFail:              false,
 			expectedOutputContain: "2485e33678db4175dc0ecef2d6e1fc493d4a0d7f7ce83324b6ed70afe77f3485\n",
 		},
+		{
+			name:                  "armored export in the v2 format",
+			keyringBackend:        keyring.BackendTest,
+			userInput:             "12345678\n",
+			expectedOutputContain: "version: 2\n",
+		},
+		{
+			name:                  "--legacy-armor exports in the legacy format",
+			keyringBackend:        keyring.BackendTest,
+			extraArgs:             []string{"--legacy-armor"},
+			userInput:             "12345678\n",
+			expectedOutputContain: "kdf: argon2\n",
+		},
 		{
 			name:           "file keyring backend properly read password and user confirmation",
 			keyringBackend: keyring.BackendFile,
@@ -19,7 +19,7 @@ func ImportKeyCommand() *cobra.Command {
 	return &cobra.Command{
 		Use:   "import <name> <keyfile>",
 		Short: "Import private keys into the local keybase",
-		Long:  "Import an ASCII armored private key into the local keybase.",
+		Long:  "Import an ASCII armored private key into the local keybase. Keys exported in the legacy and v2 formats are supported.",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -4,6 +4,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 )
 
 // MigrateCommand migrates key information from legacy keybase to OS secret store.
@@ -18,6 +19,10 @@ Otherwise, we try to deserialize it using Amino into LegacyInfo. If this attempt
 LegacyInfo to Protobuf serialization format and overwrite the keyring entry. If any error occurred, it will be 
 outputted in CLI and migration will be continued until all keys in the keyring DB are exhausted.
 See https://github.com/cosmos/cosmos-sdk/pull/9695 for more details.
+
+With the file backend, the entries encrypted by former versions are also re-encrypted in the v2
+armor format, with the Argon2id key derivation function. This is one-way: former versions can not
+read the migrated entries.
 `,
 		Args: cobra.NoArgs,
 		RunE: runMigrateCmd,
@@ -36,6 +41,10 @@ func runMigrateCmd(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	if err := keyring.MigrateFileBackend(clientCtx.Keyring); err != nil {
+		return err
+	}
+
 	cmd.Println("Keys migration has been successfully executed.")
 	return nil
 }
@@ -39,6 +39,7 @@ The pass backend requires GnuPG: https://gnupg.org/
 	cmd.AddCommand(
 		MnemonicKeyCommand(),
 		AddKeyCommand(),
+		AddPasskeyCommand(),
 		ExportKeyCommand(),
 		ImportKeyCommand(),
 		ImportKeyHexCommand(),
@@ -3,6 +3,7 @@ package tx
 import (
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"os"
 	"strings"
@@ -21,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -43,12 +45,18 @@ type Factory struct {
 	generateOnly       bool
 	memo               string
 	fees               sdk.Coins
+	feesAuto           bool
+	feePriority        tx.FeePriority
 	feeGranter         sdk.AccAddress
 	feePayer           sdk.AccAddress
 	gasPrices          sdk.DecCoins
 	extOptions         []*codectypes.Any
 	signMode           signing.SignMode
 	simulateAndExecute bool
+	textualReview      bool
+	textualExpert      bool
+	textualInput       io.Reader
+	textualOutput      io.Writer
 	preprocessTxHook   client.PreprocessTxFn
 }
 
@@ -76,6 +84,17 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 		signMode = signing.SignMode_SIGN_MODE_EIP_191
 	}
 
+	textualReview := clientCtx.Viper.GetBool(flags.FlagTextualReview)
+	if textualReview {
+		switch signMode {
+		case signing.SignMode_SIGN_MODE_UNSPECIFIED:
+			signMode = signing.SignMode_SIGN_MODE_TEXTUAL
+		case signing.SignMode_SIGN_MODE_TEXTUAL:
+		default:
+			return Factory{}, fmt.Errorf("--%s requires the %s sign mode", flags.FlagTextualReview, flags.SignModeTextual)
+		}
+	}
+
 	var accNum, accSeq uint64
 	if clientCtx.Offline {
 		if flagSet.Changed(flags.FlagAccountNumber) && flagSet.Changed(flags.FlagSequence) {
@@ -117,16 +136,39 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 		gasAdjustment:      gasAdj,
 		memo:               memo,
 		signMode:           signMode,
+		textualReview:      textualReview,
+		textualExpert:      clientCtx.Viper.GetBool(flags.FlagTextualExpert),
+		textualInput:       clientCtx.Input,
 		feeGranter:         clientCtx.FeeGranter,
 		feePayer:           clientCtx.FeePayer,
 	}
 
-	feesStr := clientCtx.Viper.GetString(flags.FlagFees)
-	f = f.WithFees(feesStr)
-
 	gasPricesStr := clientCtx.Viper.GetString(flags.FlagGasPrices)
 	f = f.WithGasPrices(gasPricesStr)
 
+	feesStr := clientCtx.Viper.GetString(flags.FlagFees)
+	if feesStr == flags.FeesFlagAuto {
+		if !f.gasPrices.IsZero() {
+			return Factory{}, errors.New("cannot provide both fees and gas prices")
+		}
+
+		// the gas is simulated along with the fees, unless it is set
+		f = f.WithFeesAuto(true)
+		if gasStr == "" {
+			f = f.WithSimulateAndExecute(true)
+		}
+	} else {
+		f = f.WithFees(feesStr)
+	}
+
+	if feePriorityStr := clientCtx.Viper.GetString(flags.FlagFeePriority); feePriorityStr != "" {
+		feePriority, ok := tx.FeePriority_value["FEE_PRIORITY_"+strings.ToUpper(feePriorityStr)]
+		if !ok || feePriority == int32(tx.FeePriority_FEE_PRIORITY_UNSPECIFIED) {
+			return Factory{}, fmt.Errorf("invalid fee priority %q, must be one of low, medium or high", feePriorityStr)
+		}
+		f = f.WithFeePriority(tx.FeePriority(feePriority))
+	}
+
 	f = f.WithPreprocessTxHook(clientCtx.PreprocessTxHook)
 
 	return f, nil
@@ -140,6 +182,8 @@ func (f Factory) Keybase() keyring.Keyring                  { return f.keybase }
 func (f Factory) ChainID() string                           { return f.chainID }
 func (f Factory) Memo() string                              { return f.memo }
 func (f Factory) Fees() sdk.Coins                           { return f.fees }
+func (f Factory) FeesAuto() bool                            { return f.feesAuto }
+func (f Factory) FeePriority() tx.FeePriority               { return f.feePriority }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
@@ -186,6 +230,21 @@ func (f Factory) WithFees(fees string) Factory {
 	return f
 }
 
+// WithFeesAuto returns a copy of the Factory with an updated fee estimation
+// value. When set, the fees are estimated with the node before the
+// transaction is built.
+func (f Factory) WithFeesAuto(auto bool) Factory {
+	f.feesAuto = auto
+	return f
+}
+
+// WithFeePriority returns a copy of the Factory with an updated priority level
+// of the estimated fees.
+func (f Factory) WithFeePriority(priority tx.FeePriority) Factory {
+	f.feePriority = priority
+	return f
+}
+
 // WithGasPrices returns a copy of the Factory with updated gas prices.
 func (f Factory) WithGasPrices(gasPrices string) Factory {
 	parsedGasPrices, err := sdk.ParseDecCoins(gasPrices)
@@ -252,6 +311,39 @@ func (f Factory) WithSignMode(mode signing.SignMode) Factory {
 	return f
 }
 
+// TextualReview returns whether the SIGN_MODE_TEXTUAL screens of the
+// transaction are reviewed and confirmed by the user before signing it.
+func (f Factory) TextualReview() bool { return f.textualReview }
+
+// TextualExpert returns whether the expert screens are shown in the review of
+// the SIGN_MODE_TEXTUAL screens.
+func (f Factory) TextualExpert() bool { return f.textualExpert }
+
+// WithTextualReview returns a copy of the Factory with an updated textual
+// review value. The review only applies to transactions signed with
+// SIGN_MODE_TEXTUAL.
+func (f Factory) WithTextualReview(review bool) Factory {
+	f.textualReview = review
+	return f
+}
+
+// WithTextualExpert returns a copy of the Factory with an updated textual
+// expert mode value.
+func (f Factory) WithTextualExpert(expert bool) Factory {
+	f.textualExpert = expert
+	return f
+}
+
+// WithTextualIO returns a copy of the Factory with an updated reader of the
+// answers of the user and writer of the review of the SIGN_MODE_TEXTUAL
+// screens, which default to the input of the client context and the standard
+// error, so that the review is not mixed with the output of the command.
+func (f Factory) WithTextualIO(r io.Reader, w io.Writer) Factory {
+	f.textualInput = r
+	f.textualOutput = w
+	return f
+}
+
 // WithTimeoutHeight returns a copy of the Factory with an updated timeout height.
 func (f Factory) WithTimeoutHeight(height uint64) Factory {
 	f.timeoutHeight = height
@@ -391,7 +483,31 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 // simulated and also printed to the same writer before the transaction is
 // printed.
 func (f Factory) PrintUnsignedTx(clientCtx client.Context, msgs ...sdk.Msg) error {
-	if f.SimulateAndExecute() {
+	switch {
+	case f.FeesAuto():
+		if clientCtx.Offline {
+			return errors.New("cannot estimate fees in offline mode")
+		}
+
+		// Prepare TxFactory with acc & seq numbers as EstimateFees requires
+		// account and sequence numbers to be set
+		preparedTxf, err := f.Prepare(clientCtx)
+		if err != nil {
+			return err
+		}
+
+		_, estimated, err := EstimateFees(clientCtx, preparedTxf, msgs...)
+		if err != nil {
+			return err
+		}
+
+		f = f.WithGas(estimated.Gas())
+		f.fees = estimated.Fees()
+		if f.SimulateAndExecute() {
+			_, _ = fmt.Fprintf(os.Stderr, "%s\n", GasEstimateResponse{GasEstimate: f.Gas()})
+		}
+		_, _ = fmt.Fprintf(os.Stderr, "fee estimate: %s\n", f.Fees())
+	case f.SimulateAndExecute():
 		if clientCtx.Offline {
 			return errors.New("cannot estimate gas in offline mode")
 		}
@@ -1,11 +1,16 @@
 package tx
 
 import (
+	"bytes"
+	"os"
+	"strings"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -15,6 +20,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -54,6 +61,100 @@ func TestFactoryPrepare(t *testing.T) {
 	require.Zero(t, output.Sequence())
 }
 
+func TestNewFactoryCLIFees(t *testing.T) {
+	testCases := []struct {
+		name            string
+		args            []string
+		expErr          string
+		expFees         sdk.Coins
+		expFeesAuto     bool
+		expFeePriority  tx.FeePriority
+		expSimulateExec bool
+	}{
+		{"fees", []string{"--fees=10stake"}, "", sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), false, tx.FeePriority_FEE_PRIORITY_MEDIUM, false},
+		{"auto fees simulate the gas", []string{"--fees=auto"}, "", nil, true, tx.FeePriority_FEE_PRIORITY_MEDIUM, true},
+		{"auto fees with gas limit", []string{"--fees=auto", "--gas=100000", "--fee-priority=high"}, "", nil, true, tx.FeePriority_FEE_PRIORITY_HIGH, false},
+		{"auto fees with simulated gas", []string{"--fees=auto", "--gas=auto", "--fee-priority=LOW"}, "", nil, true, tx.FeePriority_FEE_PRIORITY_LOW, true},
+		{"auto fees with gas prices", []string{"--fees=auto", "--gas-prices=0.1stake"}, "cannot provide both fees and gas prices", nil, false, 0, false},
+		{"invalid fee priority", []string{"--fee-priority=urgent"}, "invalid fee priority", nil, false, 0, false},
+		{"unspecified fee priority", []string{"--fee-priority=unspecified"}, "invalid fee priority", nil, false, 0, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			flags.AddTxFlagsToCmd(cmd)
+			require.NoError(t, cmd.ParseFlags(tc.args))
+
+			f, err := NewFactoryCLI(client.Context{}, cmd.Flags())
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expFees, f.Fees())
+			require.Equal(t, tc.expFeesAuto, f.FeesAuto())
+			require.Equal(t, tc.expFeePriority, f.FeePriority())
+			require.Equal(t, tc.expSimulateExec, f.SimulateAndExecute())
+		})
+	}
+}
+
+func TestNewFactoryCLITextualReview(t *testing.T) {
+	testCases := []struct {
+		name        string
+		signMode    string
+		args        []string
+		expErr      string
+		expSignMode signing.SignMode
+		expReview   bool
+		expExpert   bool
+	}{
+		{"no review", "", nil, "", signing.SignMode_SIGN_MODE_UNSPECIFIED, false, false},
+		{"review implies textual", "", []string{"--textual-review"}, "", signing.SignMode_SIGN_MODE_TEXTUAL, true, false},
+		{"review in expert mode", flags.SignModeTextual, []string{"--textual-review", "--textual-expert"}, "", signing.SignMode_SIGN_MODE_TEXTUAL, true, true},
+		{"review with another sign mode", flags.SignModeDirect, []string{"--textual-review"}, "requires the textual sign mode", 0, false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			flags.AddTxFlagsToCmd(cmd)
+			require.NoError(t, cmd.ParseFlags(tc.args))
+
+			f, err := NewFactoryCLI(client.Context{}.WithSignModeStr(tc.signMode), cmd.Flags())
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expSignMode, f.SignMode())
+			require.Equal(t, tc.expReview, f.TextualReview())
+			require.Equal(t, tc.expExpert, f.TextualExpert())
+		})
+	}
+}
+
+func TestFactoryTextualIO(t *testing.T) {
+	cmd := &cobra.Command{}
+	flags.AddTxFlagsToCmd(cmd)
+
+	// the review reads the answers from the client context, but is written to
+	// the standard error rather than to the output of the command
+	var out bytes.Buffer
+	clientCtx := client.Context{}.WithInput(strings.NewReader("y\n")).WithOutput(&out)
+	f, err := NewFactoryCLI(clientCtx, cmd.Flags())
+	require.NoError(t, err)
+	r, w := f.textualIO()
+	require.Same(t, clientCtx.Input, r)
+	require.Equal(t, os.Stderr, w)
+
+	// unless a writer is set
+	f = f.WithTextualIO(nil, &out)
+	_, w = f.textualIO()
+	require.Same(t, &out, w)
+}
+
 func TestFactory_getSimPKType(t *testing.T) {
 	// setup keyring
 	registry := codectypes.NewInterfaceRegistry()
@@ -0,0 +1,275 @@
+package tx
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"cosmossdk.io/x/tx/signing/textual"
+
+	"github.com/cosmos/cosmos-sdk/client/input"
+)
+
+// CBOR major types and simple values of the SIGN_MODE_TEXTUAL sign bytes.
+const (
+	cborMajorUint  = 0
+	cborMajorText  = 3
+	cborMajorArray = 4
+	cborMajorMap   = 5
+	cborMajorOther = 7
+
+	cborFalse = 20
+	cborTrue  = 21
+)
+
+// Keys of the SIGN_MODE_TEXTUAL sign document and of its screens, as defined
+// in ADR-050.
+const (
+	textualScreensKey = 1
+	textualTitleKey   = 1
+	textualContentKey = 2
+	textualIndentKey  = 3
+	textualExpertKey  = 4
+)
+
+// decodeTextualScreens decodes the screens of SIGN_MODE_TEXTUAL sign bytes,
+// which are the CBOR encoding of a map holding the array of the screens.
+func decodeTextualScreens(signBytes []byte) ([]textual.Screen, error) {
+	d := &cborDecoder{bz: signBytes}
+
+	entries, err := d.expect(cborMajorMap)
+	if err != nil {
+		return nil, err
+	}
+	if entries != 1 {
+		return nil, fmt.Errorf("expected a sign document with 1 entry, got %d", entries)
+	}
+	if key, err := d.expect(cborMajorUint); err != nil {
+		return nil, err
+	} else if key != textualScreensKey {
+		return nil, fmt.Errorf("unexpected sign document key %d", key)
+	}
+
+	count, err := d.expect(cborMajorArray)
+	if err != nil {
+		return nil, err
+	}
+
+	var screens []textual.Screen
+	for i := uint64(0); i < count; i++ {
+		screen, err := d.screen()
+		if err != nil {
+			return nil, fmt.Errorf("screen %d: %w", i, err)
+		}
+		screens = append(screens, screen)
+	}
+
+	if len(d.bz) != 0 {
+		return nil, errors.New("unexpected trailing bytes after the sign document")
+	}
+
+	return screens, nil
+}
+
+// renderTextualScreens writes the screens, indented by their level, to the
+// writer. Expert screens are only written in expert mode.
+func renderTextualScreens(w io.Writer, screens []textual.Screen, expert bool) {
+	hidden := 0
+	for _, screen := range screens {
+		if screen.Expert && !expert {
+			hidden++
+			continue
+		}
+
+		indent := strings.Repeat("  ", screen.Indent)
+		text := screen.Content
+		if screen.Title != "" {
+			text = screen.Title + ": " + screen.Content
+		}
+		if screen.Expert {
+			indent = "*" + indent
+		}
+		_, _ = fmt.Fprintf(w, "%s%s\n", indent, strings.ReplaceAll(text, "\n", "\n"+indent))
+	}
+
+	if hidden > 0 {
+		_, _ = fmt.Fprintf(w, "(%d expert screens hidden)\n", hidden)
+	}
+}
+
+// reviewTextualScreens renders the screens and asks for the confirmation of
+// the user, who can toggle the expert mode to render the expert screens too.
+// It returns whether the user confirmed.
+func reviewTextualScreens(screens []textual.Screen, expert bool, r *bufio.Reader, w io.Writer) (bool, error) {
+	for {
+		renderTextualScreens(w, screens, expert)
+
+		mode := "show"
+		if expert {
+			mode = "hide"
+		}
+		response, err := input.GetString(fmt.Sprintf("confirm signing the transaction above [y/N], or enter e to %s the expert screens:", mode), r)
+		if err != nil {
+			return false, err
+		}
+
+		switch strings.ToLower(response) {
+		case "e", "expert":
+			expert = !expert
+		case "y", "yes":
+			return true, nil
+		default:
+			return false, nil
+		}
+	}
+}
+
+// textualIO returns the reader of the answers of the user and the writer of the
+// review of the screens, which default to the standard input when the client
+// context has none, and to the standard error.
+func (f Factory) textualIO() (*bufio.Reader, io.Writer) {
+	var r io.Reader = os.Stdin
+	if f.textualInput != nil {
+		r = f.textualInput
+	}
+	var w io.Writer = os.Stderr
+	if f.textualOutput != nil {
+		w = f.textualOutput
+	}
+
+	if buf, ok := r.(*bufio.Reader); ok {
+		return buf, w
+	}
+	return bufio.NewReader(r), w
+}
+
+// cborDecoder decodes the subset of CBOR used by the SIGN_MODE_TEXTUAL sign
+// bytes: unsigned integers, text strings, booleans, and arrays and maps of
+// definite length.
+type cborDecoder struct {
+	bz []byte
+}
+
+// head decodes the head of the next data item, returning its major type and
+// argument.
+func (d *cborDecoder) head() (major byte, arg uint64, err error) {
+	if len(d.bz) == 0 {
+		return 0, 0, io.ErrUnexpectedEOF
+	}
+
+	major, info := d.bz[0]>>5, d.bz[0]&0x1f
+	d.bz = d.bz[1:]
+
+	var size int
+	switch {
+	case info < 24:
+		return major, uint64(info), nil
+	case info == 24:
+		size = 1
+	case info == 25:
+		size = 2
+	case info == 26:
+		size = 4
+	case info == 27:
+		size = 8
+	default:
+		return 0, 0, fmt.Errorf("unsupported CBOR additional information %d", info)
+	}
+
+	if len(d.bz) < size {
+		return 0, 0, io.ErrUnexpectedEOF
+	}
+	var buf [8]byte
+	copy(buf[8-size:], d.bz[:size])
+	d.bz = d.bz[size:]
+
+	return major, binary.BigEndian.Uint64(buf[:]), nil
+}
+
+// expect decodes the head of the next data item, which must be of the given
+// major type, returning its argument.
+func (d *cborDecoder) expect(major byte) (uint64, error) {
+	m, arg, err := d.head()
+	if err != nil {
+		return 0, err
+	}
+	if m != major {
+		return 0, fmt.Errorf("expected CBOR major type %d, got %d", major, m)
+	}
+
+	return arg, nil
+}
+
+// text decodes a text string.
+func (d *cborDecoder) text() (string, error) {
+	n, err := d.expect(cborMajorText)
+	if err != nil {
+		return "", err
+	}
+	if uint64(len(d.bz)) < n {
+		return "", io.ErrUnexpectedEOF
+	}
+
+	text := string(d.bz[:n])
+	d.bz = d.bz[n:]
+
+	return text, nil
+}
+
+// bool decodes a boolean.
+func (d *cborDecoder) bool() (bool, error) {
+	switch v, err := d.expect(cborMajorOther); {
+	case err != nil:
+		return false, err
+	case v == cborTrue:
+		return true, nil
+	case v == cborFalse:
+		return false, nil
+	default:
+		return false, fmt.Errorf("expected a CBOR boolean, got simple value %d", v)
+	}
+}
+
+// screen decodes a screen, a map of its non-empty fields.
+func (d *cborDecoder) screen() (textual.Screen, error) {
+	var screen textual.Screen
+
+	fields, err := d.expect(cborMajorMap)
+	if err != nil {
+		return screen, err
+	}
+
+	for i := uint64(0); i < fields; i++ {
+		key, err := d.expect(cborMajorUint)
+		if err != nil {
+			return screen, err
+		}
+
+		switch key {
+		case textualTitleKey:
+			screen.Title, err = d.text()
+		case textualContentKey:
+			screen.Content, err = d.text()
+		case textualIndentKey:
+			var indent uint64
+			indent, err = d.expect(cborMajorUint)
+			if err == nil && indent > 16 {
+				err = fmt.Errorf("invalid indent %d", indent)
+			}
+			screen.Indent = int(indent)
+		case textualExpertKey:
+			screen.Expert, err = d.bool()
+		default:
+			err = fmt.Errorf("unexpected screen key %d", key)
+		}
+		if err != nil {
+			return screen, err
+		}
+	}
+
+	return screen, nil
+}
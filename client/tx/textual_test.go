@@ -0,0 +1,127 @@
+package tx
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	"cosmossdk.io/x/tx/signing/textual"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestDecodeTextualScreens(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	banktypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	noMetadata := func(context.Context, string) (*bankv1beta1.Metadata, error) { return nil, nil }
+	txConfig, err := authtx.NewTxConfigWithOptions(encCfg.Codec, authtx.ConfigOptions{
+		EnabledSignModes:           []signing.SignMode{signing.SignMode_SIGN_MODE_TEXTUAL},
+		TextualCoinMetadataQueryFn: textual.CoinMetadataQueryFn(noMetadata),
+	})
+	require.NoError(t, err)
+
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 2)))
+	txBuilder.SetGasLimit(100000)
+	txBuilder.SetMemo("multi\nline")
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey: pubKey,
+		Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_TEXTUAL},
+	}))
+
+	signBytes, err := authsigning.GetSignBytesAdapter(context.Background(), txConfig.SignModeHandler(), signing.SignMode_SIGN_MODE_TEXTUAL, authsigning.SignerData{
+		Address:       addr.String(),
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		PubKey:        pubKey,
+	}, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	screens, err := decodeTextualScreens(signBytes)
+	require.NoError(t, err)
+	require.Contains(t, screens, textual.Screen{Title: "Chain id", Content: "test-chain"})
+	require.Contains(t, screens, textual.Screen{Title: "Amount", Content: "10 stake", Indent: 2})
+	require.Contains(t, screens, textual.Screen{Title: "Memo", Content: "multi\nline"})
+	require.Contains(t, screens, textual.Screen{Title: "Gas limit", Content: "100'000", Expert: true})
+
+	// the screens are rendered without the expert ones, unless in expert mode
+	var out bytes.Buffer
+	renderTextualScreens(&out, screens, false)
+	require.Contains(t, out.String(), "Chain id: test-chain\n")
+	require.Contains(t, out.String(), "    Amount: 10 stake\n")
+	require.Contains(t, out.String(), "Memo: multi\nline\n")
+	require.NotContains(t, out.String(), "Gas limit")
+	require.Contains(t, out.String(), "expert screens hidden")
+
+	out.Reset()
+	renderTextualScreens(&out, screens, true)
+	require.Contains(t, out.String(), "*Gas limit: 100'000\n")
+	require.NotContains(t, out.String(), "expert screens hidden")
+
+	for _, tc := range []struct {
+		name      string
+		signBytes []byte
+		expErr    string
+	}{
+		{"empty", nil, "unexpected EOF"},
+		{"truncated", signBytes[:len(signBytes)-1], "unexpected EOF"},
+		{"trailing bytes", append(bytes.Clone(signBytes), 0), "trailing bytes"},
+		{"not a map", []byte{0x80}, "expected CBOR major type 5"},
+		{"unknown key", []byte{0xa1, 0x02, 0x80}, "unexpected sign document key 2"},
+		{"unknown screen key", []byte{0xa1, 0x01, 0x81, 0xa1, 0x05, 0xf5}, "unexpected screen key 5"},
+		{"indefinite length", []byte{0xa1, 0x01, 0x9f}, "unsupported CBOR additional information 31"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := decodeTextualScreens(tc.signBytes)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestReviewTextualScreens(t *testing.T) {
+	screens := []textual.Screen{
+		{Title: "Chain id", Content: "test-chain"},
+		{Title: "Account number", Content: "1", Expert: true},
+	}
+
+	testCases := []struct {
+		name      string
+		input     string
+		expert    bool
+		expOk     bool
+		expExpert bool
+	}{
+		{"confirmed", "y\n", false, true, false},
+		{"canceled", "n\n", false, false, false},
+		{"empty response cancels", "\n", false, false, false},
+		{"expert mode toggled before confirming", "e\nyes\n", false, true, true},
+		{"expert mode from the start", "Y\n", true, true, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			ok, err := reviewTextualScreens(screens, tc.expert, bufio.NewReader(strings.NewReader(tc.input)), &out)
+			require.NoError(t, err)
+			require.Equal(t, tc.expOk, ok)
+			require.Equal(t, tc.expExpert, strings.Contains(out.String(), "*Account number: 1"))
+		})
+	}
+
+	_, err := reviewTextualScreens(screens, false, bufio.NewReader(strings.NewReader("")), &bytes.Buffer{})
+	require.Error(t, err)
+}
@@ -6,11 +6,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"os"
 
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/spf13/pflag"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -76,7 +79,23 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
-	if txf.SimulateAndExecute() || clientCtx.Simulate {
+	switch {
+	case txf.FeesAuto():
+		if clientCtx.Offline {
+			return errors.New("cannot estimate fees in offline mode")
+		}
+
+		res, estimated, err := EstimateFees(clientCtx, txf, msgs...)
+		if err != nil {
+			return err
+		}
+
+		txf = estimated
+		if txf.SimulateAndExecute() || clientCtx.Simulate {
+			_, _ = fmt.Fprintf(os.Stderr, "%s\n", GasEstimateResponse{GasEstimate: res.GasLimit})
+		}
+		_, _ = fmt.Fprintf(os.Stderr, "fee estimate: %s\n", txf.Fees())
+	case txf.SimulateAndExecute() || clientCtx.Simulate:
 		if clientCtx.Offline {
 			return errors.New("cannot estimate gas in offline mode")
 		}
@@ -99,7 +118,8 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
-	if !clientCtx.SkipConfirm {
+	// the textual review confirms the transaction when signing it
+	if !clientCtx.SkipConfirm && !txf.TextualReview() {
 		encoder := txf.txConfig.TxJSONEncoder()
 		if encoder == nil {
 			return errors.New("failed to encode transaction: tx json encoder is nil")
@@ -165,6 +185,54 @@ func CalculateGas(
 	return simRes, uint64(txf.GasAdjustment() * float64(simRes.GasInfo.GasUsed)), nil
 }
 
+// EstimateFees simulates the execution of a transaction and returns the fee
+// estimation response obtained by the query, and the factory with the fees
+// estimated at its priority level. The gas of the factory is set to the
+// adjusted gas estimate if it simulates the transaction. It returns an error if
+// the node recommends no gas price at the priority level.
+func EstimateFees(
+	clientCtx gogogrpc.ClientConn, txf Factory, msgs ...sdk.Msg,
+) (*tx.EstimateFeeResponse, Factory, error) {
+	txBytes, err := txf.BuildSimTx(msgs...)
+	if err != nil {
+		return nil, txf, err
+	}
+
+	txSvcClient := tx.NewServiceClient(clientCtx)
+	res, err := txSvcClient.EstimateFee(context.Background(), &tx.EstimateFeeRequest{
+		TxBytes:       txBytes,
+		GasAdjustment: txf.GasAdjustment(),
+	})
+	if err != nil {
+		return nil, txf, err
+	}
+
+	if txf.SimulateAndExecute() {
+		txf = txf.WithGas(res.GasLimit)
+	}
+
+	priority := txf.FeePriority()
+	if priority == tx.FeePriority_FEE_PRIORITY_UNSPECIFIED {
+		priority = tx.FeePriority_FEE_PRIORITY_MEDIUM
+	}
+
+	// the fees are paid in the first denom estimated, as the node accepts fees
+	// covering its minimum gas price in any of its denoms
+	txf.fees = nil
+	gasLimitDec := math.LegacyNewDecFromBigInt(new(big.Int).SetUint64(txf.Gas()))
+	for _, estimate := range res.Estimates {
+		if estimate.Priority == priority {
+			txf.fees = sdk.NewCoins(sdk.NewCoin(estimate.GasPrice.Denom, estimate.GasPrice.Amount.Mul(gasLimitDec).Ceil().RoundInt()))
+			break
+		}
+	}
+	if txf.fees == nil {
+		return nil, txf, fmt.Errorf("no fee estimate at the %s priority, the fees or gas prices must be set", priority)
+	}
+
+	return res, txf, nil
+}
+
 // SignWithPrivKey signs a given tx with the given private key, and returns the
 // corresponding SignatureV2 if the signing is successful.
 func SignWithPrivKey(
@@ -327,6 +395,22 @@ func Sign(ctx context.Context, txf Factory, name string, txBuilder client.TxBuil
 		return err
 	}
 
+	if txf.textualReview && signMode == signing.SignMode_SIGN_MODE_TEXTUAL {
+		screens, err := decodeTextualScreens(bytesToSign)
+		if err != nil {
+			return fmt.Errorf("failed to decode the textual screens: %w", err)
+		}
+
+		r, w := txf.textualIO()
+		ok, err := reviewTextualScreens(screens, txf.textualExpert, r, w)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("signing canceled")
+		}
+	}
+
 	// Sign those bytes
 	sigBytes, _, err := txf.keybase.Sign(name, bytesToSign, signMode)
 	if err != nil {
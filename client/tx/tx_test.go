@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -101,6 +103,76 @@ func TestCalculateGas(t *testing.T) {
 	}
 }
 
+// mockFeeEstimateContext is a mock client.Context to return a fee estimation response
+// with the given gas limit, and without estimates if noEstimates is set, used to unit
+// test EstimateFees.
+type mockFeeEstimateContext struct {
+	gasLimit    uint64
+	noEstimates bool
+}
+
+func (m mockFeeEstimateContext) Invoke(_ context.Context, _ string, req, reply any, _ ...grpc.CallOption) error {
+	if req.(*txtypes.EstimateFeeRequest).GasAdjustment != 1.5 {
+		return fmt.Errorf("unexpected gas adjustment")
+	}
+
+	*(reply.(*txtypes.EstimateFeeResponse)) = txtypes.EstimateFeeResponse{
+		GasLimit: m.gasLimit,
+		Estimates: []txtypes.FeeEstimate{
+			{Priority: txtypes.FeePriority_FEE_PRIORITY_LOW, GasPrice: sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(1, 2))},
+			{Priority: txtypes.FeePriority_FEE_PRIORITY_MEDIUM, GasPrice: sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(25, 3))},
+			{Priority: txtypes.FeePriority_FEE_PRIORITY_MEDIUM, GasPrice: sdk.NewDecCoinFromDec("atom", math.LegacyNewDecWithPrec(1, 3))},
+			{Priority: txtypes.FeePriority_FEE_PRIORITY_HIGH, GasPrice: sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(1, 1))},
+		},
+	}
+	if m.noEstimates {
+		reply.(*txtypes.EstimateFeeResponse).Estimates = nil
+	}
+
+	return nil
+}
+
+func (mockFeeEstimateContext) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	panic("not implemented")
+}
+
+func TestEstimateFees(t *testing.T) {
+	txCfg, _ := newTestTxConfig()
+	defaultSignMode, err := signing.APISignModeToInternal(txCfg.SignModeHandler().DefaultMode())
+	require.NoError(t, err)
+
+	txf := Factory{}.
+		WithChainID("test-chain").
+		WithTxConfig(txCfg).WithSignMode(defaultSignMode).
+		WithGasAdjustment(1.5).
+		WithFeesAuto(true)
+
+	testCases := []struct {
+		name     string
+		txf      Factory
+		wantGas  uint64
+		wantFees sdk.Coins
+	}{
+		{"simulated gas, default priority", txf.WithSimulateAndExecute(true), 1001, sdk.NewCoins(sdk.NewInt64Coin("stake", 26))},
+		{"simulated gas, high priority", txf.WithSimulateAndExecute(true).WithFeePriority(txtypes.FeePriority_FEE_PRIORITY_HIGH), 1001, sdk.NewCoins(sdk.NewInt64Coin("stake", 101))},
+		{"fixed gas, low priority", txf.WithGas(2000).WithFeePriority(txtypes.FeePriority_FEE_PRIORITY_LOW), 2000, sdk.NewCoins(sdk.NewInt64Coin("stake", 20))},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, estimated, err := EstimateFees(mockFeeEstimateContext{gasLimit: 1001}, tc.txf)
+			require.NoError(t, err)
+			require.Equal(t, uint64(1001), res.GasLimit)
+			require.Equal(t, tc.wantGas, estimated.Gas())
+			require.Equal(t, tc.wantFees, estimated.Fees())
+		})
+	}
+
+	// the fees can't be estimated without gas prices recommended by the node
+	_, _, err = EstimateFees(mockFeeEstimateContext{gasLimit: 1001, noEstimates: true}, txf.WithGas(2000))
+	require.ErrorContains(t, err, "no fee estimate")
+}
+
 func mockTxFactory(txCfg client.TxConfig) Factory {
 	return Factory{}.
 		WithTxConfig(txCfg).
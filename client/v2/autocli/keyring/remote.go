@@ -0,0 +1,15 @@
+package keyring
+
+import (
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkkeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+// NewRemoteKeyring returns a keyring signing with the remote signer served on
+// the given connection, implementing the RemoteSigner service of the SDK
+// remote keyring backend.
+func NewRemoteKeyring(conn grpc.ClientConnInterface, cdc codec.Codec) (Keyring, error) {
+	return sdkkeyring.NewAutoCLIKeyring(sdkkeyring.NewRemote(conn, cdc))
+}
@@ -0,0 +1,338 @@
+// Package tx builds transactions offline, without any CLI code or connection to
+// a node.
+package tx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/client/v2/autocli/keyring"
+	"cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+)
+
+// Signer is a signer of a transaction. Its account number and sequence are
+// given explicitly, so that no node is queried.
+type Signer struct {
+	// Address is the address of the signer. If empty, it is derived from
+	// PubKey with the address codec of the Config.
+	Address string
+	// PubKey is the public key of the signer. Multisig public keys are not
+	// supported.
+	PubKey cryptotypes.PubKey
+	// AccountNumber is the account number of the signer.
+	AccountNumber uint64
+	// Sequence is the account sequence of the signer.
+	Sequence uint64
+	// SignMode is the sign mode of the signer. If unspecified, the default
+	// sign mode of the Config is used.
+	SignMode signingv1beta1.SignMode
+}
+
+// Builder builds a transaction offline from its messages, fee, memo, timeout
+// and signers, all given explicitly. It returns the sign bytes of each signer
+// in its sign mode, and the raw transaction once all signatures are set.
+//
+// All the signers must be added before computing any sign bytes, as the sign
+// bytes of most sign modes cover the signer infos of all the signers.
+type Builder struct {
+	config *Config
+
+	chainID          string
+	msgs             []*anypb.Any
+	memo             string
+	timeoutHeight    uint64
+	timeoutTimestamp time.Time
+	unordered        bool
+
+	feeAmount  []*basev1beta1.Coin
+	gasLimit   uint64
+	feePayer   string
+	feeGranter string
+
+	signers     []Signer
+	signerInfos []*txv1beta1.SignerInfo
+	signatures  [][]byte
+}
+
+// SetChainID sets the chain ID the transaction is signed for.
+func (b *Builder) SetChainID(chainID string) {
+	b.chainID = chainID
+}
+
+// SetMsgs sets the messages of the transaction, which can be gogoproto or
+// protoreflect messages.
+func (b *Builder) SetMsgs(msgs ...gogoproto.Message) error {
+	anys := make([]*anypb.Any, len(msgs))
+	for i, msg := range msgs {
+		anyMsg, err := msgToAny(msg)
+		if err != nil {
+			return fmt.Errorf("failed to pack message %d: %w", i, err)
+		}
+		anys[i] = anyMsg
+	}
+	b.msgs = anys
+
+	return nil
+}
+
+// SetMemo sets the memo of the transaction.
+func (b *Builder) SetMemo(memo string) {
+	b.memo = memo
+}
+
+// SetTimeoutHeight sets the block height after which the transaction is not
+// included anymore.
+func (b *Builder) SetTimeoutHeight(height uint64) {
+	b.timeoutHeight = height
+}
+
+// SetTimeoutTimestamp sets the block time after which the transaction is not
+// included anymore. It is required by unordered transactions.
+func (b *Builder) SetTimeoutTimestamp(timestamp time.Time) {
+	b.timeoutTimestamp = timestamp
+}
+
+// SetUnordered sets whether the transaction is unordered, in which case the
+// sequences of its signers are not checked.
+func (b *Builder) SetUnordered(unordered bool) {
+	b.unordered = unordered
+}
+
+// SetFeeAmount sets the fees paid by the transaction.
+func (b *Builder) SetFeeAmount(coins ...*basev1beta1.Coin) {
+	b.feeAmount = coins
+}
+
+// SetGasLimit sets the gas limit of the transaction.
+func (b *Builder) SetGasLimit(gasLimit uint64) {
+	b.gasLimit = gasLimit
+}
+
+// SetFeePayer sets the address paying the fees, the first signer if empty.
+func (b *Builder) SetFeePayer(feePayer string) {
+	b.feePayer = feePayer
+}
+
+// SetFeeGranter sets the address granting the fees with a fee allowance.
+func (b *Builder) SetFeeGranter(feeGranter string) {
+	b.feeGranter = feeGranter
+}
+
+// AddSigner adds a signer of the transaction and returns its index. Signers
+// must be added in the order of the signers of the messages.
+func (b *Builder) AddSigner(signer Signer) (int, error) {
+	if signer.PubKey == nil {
+		return 0, errors.New("signer public key is required")
+	}
+	if _, ok := signer.PubKey.(multisig.PubKey); ok {
+		return 0, errors.New("multisig signers are not supported")
+	}
+
+	if signer.Address == "" {
+		addr, err := b.config.addressCodec.BytesToString(signer.PubKey.Address())
+		if err != nil {
+			return 0, err
+		}
+		signer.Address = addr
+	}
+	if signer.SignMode == signingv1beta1.SignMode_SIGN_MODE_UNSPECIFIED {
+		signer.SignMode = b.config.signModeHandler.DefaultMode()
+	}
+
+	pubKey, err := pubKeyToAny(signer.PubKey)
+	if err != nil {
+		return 0, err
+	}
+
+	b.signers = append(b.signers, signer)
+	b.signerInfos = append(b.signerInfos, &txv1beta1.SignerInfo{
+		PublicKey: pubKey,
+		ModeInfo: &txv1beta1.ModeInfo{
+			Sum: &txv1beta1.ModeInfo_Single_{
+				Single: &txv1beta1.ModeInfo_Single{Mode: signer.SignMode},
+			},
+		},
+		Sequence: signer.Sequence,
+	})
+	b.signatures = append(b.signatures, nil)
+
+	return len(b.signers) - 1, nil
+}
+
+// SignBytes returns the bytes to sign by the signer of the given index, in its
+// sign mode.
+func (b *Builder) SignBytes(ctx context.Context, index int) ([]byte, error) {
+	if index < 0 || index >= len(b.signers) {
+		return nil, fmt.Errorf("invalid signer index %d, the transaction has %d signers", index, len(b.signers))
+	}
+	if b.chainID == "" {
+		return nil, errors.New("chain ID is required")
+	}
+
+	txData, err := b.txData()
+	if err != nil {
+		return nil, err
+	}
+
+	signer := b.signers[index]
+	signerData := signing.SignerData{
+		Address:       signer.Address,
+		ChainID:       b.chainID,
+		AccountNumber: signer.AccountNumber,
+		Sequence:      signer.Sequence,
+		PubKey:        b.signerInfos[index].PublicKey,
+	}
+
+	return b.config.signModeHandler.GetSignBytes(ctx, signer.SignMode, signerData, txData)
+}
+
+// SetSignature sets the signature of the signer of the given index.
+func (b *Builder) SetSignature(index int, signature []byte) error {
+	if index < 0 || index >= len(b.signers) {
+		return fmt.Errorf("invalid signer index %d, the transaction has %d signers", index, len(b.signers))
+	}
+	b.signatures[index] = signature
+
+	return nil
+}
+
+// Sign signs the transaction with the key of the given name, which must be
+// the key of one of the signers.
+func (b *Builder) Sign(ctx context.Context, kr keyring.Keyring, name string) error {
+	pubKey, err := kr.GetPubKey(name)
+	if err != nil {
+		return err
+	}
+
+	for i, signer := range b.signers {
+		if !signer.PubKey.Equals(pubKey) {
+			continue
+		}
+
+		signBytes, err := b.SignBytes(ctx, i)
+		if err != nil {
+			return err
+		}
+
+		signature, err := kr.Sign(name, signBytes, signer.SignMode)
+		if err != nil {
+			return err
+		}
+
+		return b.SetSignature(i, signature)
+	}
+
+	return fmt.Errorf("key %s is not a signer of the transaction", name)
+}
+
+// Build returns the raw transaction, once all its signers have signed it.
+func (b *Builder) Build() ([]byte, error) {
+	if len(b.signers) == 0 {
+		return nil, errors.New("transaction has no signer")
+	}
+	for i, signature := range b.signatures {
+		if len(signature) == 0 {
+			return nil, fmt.Errorf("signature of signer %d is missing", i)
+		}
+	}
+
+	txData, err := b.txData()
+	if err != nil {
+		return nil, err
+	}
+
+	return protov2.MarshalOptions{Deterministic: true}.Marshal(&txv1beta1.TxRaw{
+		BodyBytes:     txData.BodyBytes,
+		AuthInfoBytes: txData.AuthInfoBytes,
+		Signatures:    b.signatures,
+	})
+}
+
+// txData returns the body and auth info of the transaction, and their bytes.
+func (b *Builder) txData() (signing.TxData, error) {
+	if len(b.msgs) == 0 {
+		return signing.TxData{}, errors.New("transaction has no message")
+	}
+
+	body := &txv1beta1.TxBody{
+		Messages:      b.msgs,
+		Memo:          b.memo,
+		TimeoutHeight: b.timeoutHeight,
+		Unordered:     b.unordered,
+	}
+	if !b.timeoutTimestamp.IsZero() {
+		body.TimeoutTimestamp = timestamppb.New(b.timeoutTimestamp)
+	}
+
+	authInfo := &txv1beta1.AuthInfo{
+		SignerInfos: b.signerInfos,
+		Fee: &txv1beta1.Fee{
+			Amount:   b.feeAmount,
+			GasLimit: b.gasLimit,
+			Payer:    b.feePayer,
+			Granter:  b.feeGranter,
+		},
+	}
+
+	bodyBz, err := protov2.MarshalOptions{Deterministic: true}.Marshal(body)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+	authInfoBz, err := protov2.MarshalOptions{Deterministic: true}.Marshal(authInfo)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	return signing.TxData{
+		Body:          body,
+		AuthInfo:      authInfo,
+		BodyBytes:     bodyBz,
+		AuthInfoBytes: authInfoBz,
+	}, nil
+}
+
+// msgToAny packs a gogoproto or protoreflect message.
+func msgToAny(msg gogoproto.Message) (*anypb.Any, error) {
+	if msgV2, ok := msg.(protov2.Message); ok {
+		bz, err := protov2.MarshalOptions{Deterministic: true}.Marshal(msgV2)
+		if err != nil {
+			return nil, err
+		}
+
+		return &anypb.Any{
+			TypeUrl: "/" + string(msgV2.ProtoReflect().Descriptor().FullName()),
+			Value:   bz,
+		}, nil
+	}
+
+	anyMsg, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &anypb.Any{TypeUrl: anyMsg.TypeUrl, Value: anyMsg.Value}, nil
+}
+
+// pubKeyToAny packs a public key.
+func pubKeyToAny(pubKey cryptotypes.PubKey) (*anypb.Any, error) {
+	anyPubKey, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &anypb.Any{TypeUrl: anyPubKey.TypeUrl, Value: anyPubKey.Value}, nil
+}
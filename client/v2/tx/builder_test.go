@@ -0,0 +1,196 @@
+package tx_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/client/v2/tx"
+	"cosmossdk.io/x/tx/signing/textual"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestBuilder(t *testing.T) {
+	ctx := context.Background()
+	encCfg := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{})
+	addressCodec := addresscodec.NewBech32Codec("cosmos")
+
+	// the SDK tx config, supporting all the sign modes, checks the transactions
+	noMetadata := func(context.Context, string) (*bankv1beta1.Metadata, error) { return nil, nil }
+	sdkTxConfig, err := authtx.NewTxConfigWithOptions(encCfg.Codec, authtx.ConfigOptions{
+		EnabledSignModes:           append(authtx.DefaultSignModes, signingtypes.SignMode_SIGN_MODE_TEXTUAL),
+		TextualCoinMetadataQueryFn: textual.CoinMetadataQueryFn(noMetadata),
+	})
+	require.NoError(t, err)
+
+	config, err := tx.NewConfig(tx.ConfigOptions{
+		AddressCodec:          addressCodec,
+		ValidatorAddressCodec: addresscodec.NewBech32Codec("cosmosvaloper"),
+	})
+	require.NoError(t, err)
+
+	privKeys := []*secp256k1.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	addrs := make([]string, len(privKeys))
+	for i, privKey := range privKeys {
+		addrs[i], err = addressCodec.BytesToString(privKey.PubKey().Address())
+		require.NoError(t, err)
+	}
+
+	for _, signMode := range []signingv1beta1.SignMode{
+		signingv1beta1.SignMode_SIGN_MODE_DIRECT,
+		signingv1beta1.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		signingv1beta1.SignMode_SIGN_MODE_TEXTUAL,
+	} {
+		t.Run(signMode.String(), func(t *testing.T) {
+			builder := config.NewBuilder()
+			builder.SetChainID("test-chain")
+			require.NoError(t, builder.SetMsgs(
+				banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(addrs[0]), sdk.MustAccAddressFromBech32(addrs[1]), sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+				// protoreflect messages are supported too
+				&bankv1beta1.MsgSend{FromAddress: addrs[1], ToAddress: addrs[0], Amount: []*basev1beta1.Coin{{Denom: "stake", Amount: "5"}}},
+			))
+			builder.SetMemo("memo")
+			builder.SetTimeoutHeight(100)
+			builder.SetTimeoutTimestamp(time.Unix(1_700_000_000, 0))
+			builder.SetFeeAmount(&basev1beta1.Coin{Denom: "stake", Amount: "1000"})
+			builder.SetGasLimit(200_000)
+
+			signers := []tx.Signer{
+				{PubKey: privKeys[0].PubKey(), AccountNumber: 1, Sequence: 2, SignMode: signMode},
+				// the second signer is not the fee payer, and may sign with DIRECT_AUX
+				{PubKey: privKeys[1].PubKey(), AccountNumber: 3, Sequence: 4, SignMode: signingv1beta1.SignMode_SIGN_MODE_DIRECT_AUX},
+			}
+			for i, signer := range signers {
+				index, err := builder.AddSigner(signer)
+				require.NoError(t, err)
+				require.Equal(t, i, index)
+			}
+
+			_, err := builder.Build()
+			require.ErrorContains(t, err, "signature of signer 0 is missing")
+
+			signBytes := make([][]byte, len(signers))
+			for i, privKey := range privKeys {
+				signBytes[i], err = builder.SignBytes(ctx, i)
+				require.NoError(t, err)
+				signature, err := privKey.Sign(signBytes[i])
+				require.NoError(t, err)
+				require.NoError(t, builder.SetSignature(i, signature))
+			}
+
+			raw, err := builder.Build()
+			require.NoError(t, err)
+
+			// the SDK decodes the transaction, and computes the same sign bytes
+			sdkTx, err := sdkTxConfig.TxDecoder()(raw)
+			require.NoError(t, err)
+			sigTx := sdkTx.(authsigning.Tx)
+			require.Equal(t, "memo", sigTx.GetMemo())
+			require.Equal(t, uint64(100), sigTx.GetTimeoutHeight())
+			require.Equal(t, uint64(200_000), sigTx.GetGas())
+			require.Len(t, sigTx.GetMsgs(), 2)
+
+			sigs, err := sigTx.GetSignaturesV2()
+			require.NoError(t, err)
+			require.Len(t, sigs, len(signers))
+			for i, sig := range sigs {
+				require.True(t, signers[i].PubKey.Equals(sig.PubKey))
+				sdkSignBytes, err := authsigning.GetSignBytesAdapter(ctx, sdkTxConfig.SignModeHandler(), signingtypes.SignMode(signers[i].SignMode), authsigning.SignerData{
+					Address:       addrs[i],
+					ChainID:       "test-chain",
+					AccountNumber: signers[i].AccountNumber,
+					Sequence:      signers[i].Sequence,
+					PubKey:        signers[i].PubKey,
+				}, sdkTx)
+				require.NoError(t, err)
+				require.Equal(t, sdkSignBytes, signBytes[i])
+				require.True(t, sig.PubKey.VerifySignature(sdkSignBytes, sig.Data.(*signingtypes.SingleSignatureData).Signature))
+			}
+		})
+	}
+}
+
+func TestBuilderSign(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{})
+	addressCodec := addresscodec.NewBech32Codec("cosmos")
+	config, err := tx.NewConfig(tx.ConfigOptions{
+		AddressCodec:          addressCodec,
+		ValidatorAddressCodec: addresscodec.NewBech32Codec("cosmosvaloper"),
+	})
+	require.NoError(t, err)
+
+	kb := keyring.NewInMemory(encCfg.Codec)
+	record, _, err := kb.NewMnemonic("alice", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	pubKey, err := record.GetPubKey()
+	require.NoError(t, err)
+	kr, err := keyring.NewAutoCLIKeyring(kb)
+	require.NoError(t, err)
+
+	builder := config.NewBuilder()
+	builder.SetChainID("test-chain")
+	addr, err := addressCodec.BytesToString(pubKey.Address())
+	require.NoError(t, err)
+	require.NoError(t, builder.SetMsgs(&bankv1beta1.MsgSend{FromAddress: addr, ToAddress: addr}))
+	index, err := builder.AddSigner(tx.Signer{PubKey: pubKey, AccountNumber: 1})
+	require.NoError(t, err)
+
+	_, _, err = kb.NewMnemonic("bob", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	require.ErrorContains(t, builder.Sign(context.Background(), kr, "bob"), "not a signer")
+
+	require.NoError(t, builder.Sign(context.Background(), kr, "alice"))
+	raw, err := builder.Build()
+	require.NoError(t, err)
+
+	signBytes, err := builder.SignBytes(context.Background(), index)
+	require.NoError(t, err)
+	sdkTx, err := encCfg.TxConfig.TxDecoder()(raw)
+	require.NoError(t, err)
+	sigs, err := sdkTx.(authsigning.Tx).GetSignaturesV2()
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(signBytes, sigs[0].Data.(*signingtypes.SingleSignatureData).Signature))
+}
+
+func TestBuilderErrors(t *testing.T) {
+	_, err := tx.NewConfig(tx.ConfigOptions{})
+	require.ErrorContains(t, err, "address codec is required")
+
+	config, err := tx.NewConfig(tx.ConfigOptions{
+		AddressCodec:          addresscodec.NewBech32Codec("cosmos"),
+		ValidatorAddressCodec: addresscodec.NewBech32Codec("cosmosvaloper"),
+	})
+	require.NoError(t, err)
+
+	builder := config.NewBuilder()
+	_, err = builder.AddSigner(tx.Signer{})
+	require.ErrorContains(t, err, "public key is required")
+	_, err = builder.SignBytes(context.Background(), 0)
+	require.ErrorContains(t, err, "invalid signer index")
+	_, err = builder.Build()
+	require.ErrorContains(t, err, "no signer")
+
+	index, err := builder.AddSigner(tx.Signer{PubKey: secp256k1.GenPrivKey().PubKey()})
+	require.NoError(t, err)
+	_, err = builder.SignBytes(context.Background(), index)
+	require.ErrorContains(t, err, "chain ID is required")
+	builder.SetChainID("test-chain")
+	_, err = builder.SignBytes(context.Background(), index)
+	require.ErrorContains(t, err, "no message")
+}
@@ -0,0 +1,122 @@
+package tx
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/aminojson"
+	"cosmossdk.io/x/tx/signing/directaux"
+	"cosmossdk.io/x/tx/signing/std"
+	"cosmossdk.io/x/tx/signing/textual"
+)
+
+// ConfigOptions are the options of a Config.
+type ConfigOptions struct {
+	// AddressCodec encodes the account addresses of the chain. It is required.
+	AddressCodec address.Codec
+	// ValidatorAddressCodec encodes the validator addresses of the chain. It is
+	// required.
+	ValidatorAddressCodec address.Codec
+
+	// FileResolver resolves the protobuf files of the messages. If nil, the
+	// gogoproto hybrid resolver is used.
+	FileResolver signing.ProtoFileResolver
+	// TypeResolver resolves the protobuf types of the messages. If nil, the
+	// global protobuf registry is used.
+	TypeResolver signing.TypeResolver
+
+	// TextualCoinMetadataQueryFn queries the metadata of the coins rendered by
+	// SIGN_MODE_TEXTUAL. If nil, no metadata is known, so that the coins are
+	// rendered in their base denomination without any query.
+	TextualCoinMetadataQueryFn textual.CoinMetadataQueryFn
+
+	// SignModeHandler overrides the handlers of all the sign modes of
+	// x/tx/signing, which are used by default.
+	SignModeHandler *signing.HandlerMap
+}
+
+// Config holds the chain specific configuration needed to build transactions.
+// It holds no connection and is safe for concurrent use.
+type Config struct {
+	addressCodec    address.Codec
+	signModeHandler *signing.HandlerMap
+}
+
+// NewConfig returns the Config of the given options.
+func NewConfig(opts ConfigOptions) (*Config, error) {
+	if opts.AddressCodec == nil {
+		return nil, errors.New("address codec is required")
+	}
+	if opts.ValidatorAddressCodec == nil {
+		return nil, errors.New("validator address codec is required")
+	}
+	if opts.FileResolver == nil {
+		opts.FileResolver = proto.HybridResolver
+	}
+	if opts.TypeResolver == nil {
+		opts.TypeResolver = protoregistry.GlobalTypes
+	}
+	if opts.TextualCoinMetadataQueryFn == nil {
+		opts.TextualCoinMetadataQueryFn = noCoinMetadata
+	}
+
+	handler := opts.SignModeHandler
+	if handler == nil {
+		signingCtx, err := signing.NewContext(signing.Options{
+			FileResolver:          opts.FileResolver,
+			TypeResolver:          opts.TypeResolver,
+			AddressCodec:          opts.AddressCodec,
+			ValidatorAddressCodec: opts.ValidatorAddressCodec,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		handler, err = std.SignModeOptions{
+			Textual: textual.SignModeOptions{
+				CoinMetadataQuerier: opts.TextualCoinMetadataQueryFn,
+				FileResolver:        opts.FileResolver,
+				TypeResolver:        opts.TypeResolver,
+			},
+			DirectAux: directaux.SignModeHandlerOptions{
+				TypeResolver:   opts.TypeResolver,
+				SignersContext: signingCtx,
+			},
+			AminoJSON: aminojson.SignModeHandlerOptions{
+				FileResolver: opts.FileResolver,
+				TypeResolver: opts.TypeResolver,
+			},
+		}.HandlerMap()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Config{
+		addressCodec:    opts.AddressCodec,
+		signModeHandler: handler,
+	}, nil
+}
+
+// SignModeHandler returns the handlers of the sign modes supported by the
+// Config.
+func (c *Config) SignModeHandler() *signing.HandlerMap {
+	return c.signModeHandler
+}
+
+// NewBuilder returns a new Builder of a transaction.
+func (c *Config) NewBuilder() *Builder {
+	return &Builder{config: c}
+}
+
+// noCoinMetadata is the offline textual.CoinMetadataQueryFn, knowing no
+// metadata.
+func noCoinMetadata(context.Context, string) (*bankv1beta1.Metadata, error) {
+	return nil, nil
+}
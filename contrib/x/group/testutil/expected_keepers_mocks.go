@@ -133,6 +133,96 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// Burn mocks base method.
+func (m *MockBankKeeper) Burn(arg0 context.Context, arg1 *types0.MsgBurn) (*types0.MsgBurnResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Burn", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgBurnResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Burn indicates an expected call of Burn.
+func (mr *MockBankKeeperMockRecorder) Burn(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Burn", reflect.TypeOf((*MockBankKeeper)(nil).Burn), arg0, arg1)
+}
+
+// ChangeDenomAdmin mocks base method.
+func (m *MockBankKeeper) ChangeDenomAdmin(arg0 context.Context, arg1 *types0.MsgChangeDenomAdmin) (*types0.MsgChangeDenomAdminResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeDenomAdmin", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgChangeDenomAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeDenomAdmin indicates an expected call of ChangeDenomAdmin.
+func (mr *MockBankKeeperMockRecorder) ChangeDenomAdmin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeDenomAdmin", reflect.TypeOf((*MockBankKeeper)(nil).ChangeDenomAdmin), arg0, arg1)
+}
+
+// CreateDenom mocks base method.
+func (m *MockBankKeeper) CreateDenom(arg0 context.Context, arg1 *types0.MsgCreateDenom) (*types0.MsgCreateDenomResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDenom", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgCreateDenomResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDenom indicates an expected call of CreateDenom.
+func (mr *MockBankKeeperMockRecorder) CreateDenom(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDenom", reflect.TypeOf((*MockBankKeeper)(nil).CreateDenom), arg0, arg1)
+}
+
+// CreateDenomMetadata mocks base method.
+func (m *MockBankKeeper) CreateDenomMetadata(arg0 context.Context, arg1 *types0.MsgCreateDenomMetadata) (*types0.MsgCreateDenomMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDenomMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgCreateDenomMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDenomMetadata indicates an expected call of CreateDenomMetadata.
+func (mr *MockBankKeeperMockRecorder) CreateDenomMetadata(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDenomMetadata", reflect.TypeOf((*MockBankKeeper)(nil).CreateDenomMetadata), arg0, arg1)
+}
+
+// DeleteDenomMetadata mocks base method.
+func (m *MockBankKeeper) DeleteDenomMetadata(arg0 context.Context, arg1 *types0.MsgDeleteDenomMetadata) (*types0.MsgDeleteDenomMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDenomMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgDeleteDenomMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDenomMetadata indicates an expected call of DeleteDenomMetadata.
+func (mr *MockBankKeeperMockRecorder) DeleteDenomMetadata(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDenomMetadata", reflect.TypeOf((*MockBankKeeper)(nil).DeleteDenomMetadata), arg0, arg1)
+}
+
+// ForceTransfer mocks base method.
+func (m *MockBankKeeper) ForceTransfer(arg0 context.Context, arg1 *types0.MsgForceTransfer) (*types0.MsgForceTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceTransfer", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgForceTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForceTransfer indicates an expected call of ForceTransfer.
+func (mr *MockBankKeeperMockRecorder) ForceTransfer(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceTransfer", reflect.TypeOf((*MockBankKeeper)(nil).ForceTransfer), arg0, arg1)
+}
+
 // GetAllBalances mocks base method.
 func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types.AccAddress) types.Coins {
 	m.ctrl.T.Helper()
@@ -147,6 +237,21 @@ func (mr *MockBankKeeperMockRecorder) GetAllBalances(ctx, addr any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBalances", reflect.TypeOf((*MockBankKeeper)(nil).GetAllBalances), ctx, addr)
 }
 
+// Mint mocks base method.
+func (m *MockBankKeeper) Mint(arg0 context.Context, arg1 *types0.MsgMint) (*types0.MsgMintResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Mint", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgMintResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Mint indicates an expected call of Mint.
+func (mr *MockBankKeeperMockRecorder) Mint(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mint", reflect.TypeOf((*MockBankKeeper)(nil).Mint), arg0, arg1)
+}
+
 // MintCoins mocks base method.
 func (m *MockBankKeeper) MintCoins(ctx context.Context, moduleName string, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -205,6 +310,21 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// SetDenomMetadata mocks base method.
+func (m *MockBankKeeper) SetDenomMetadata(arg0 context.Context, arg1 *types0.MsgSetDenomMetadata) (*types0.MsgSetDenomMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDenomMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgSetDenomMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDenomMetadata indicates an expected call of SetDenomMetadata.
+func (mr *MockBankKeeperMockRecorder) SetDenomMetadata(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomMetadata", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomMetadata), arg0, arg1)
+}
+
 // SetSendEnabled mocks base method.
 func (m *MockBankKeeper) SetSendEnabled(arg0 context.Context, arg1 *types0.MsgSetSendEnabled) (*types0.MsgSetSendEnabledResponse, error) {
 	m.ctrl.T.Helper()
@@ -234,6 +354,21 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
 }
 
+// UpdateDenomMetadata mocks base method.
+func (m *MockBankKeeper) UpdateDenomMetadata(arg0 context.Context, arg1 *types0.MsgUpdateDenomMetadata) (*types0.MsgUpdateDenomMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDenomMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgUpdateDenomMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDenomMetadata indicates an expected call of UpdateDenomMetadata.
+func (mr *MockBankKeeperMockRecorder) UpdateDenomMetadata(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDenomMetadata", reflect.TypeOf((*MockBankKeeper)(nil).UpdateDenomMetadata), arg0, arg1)
+}
+
 // UpdateParams mocks base method.
 func (m *MockBankKeeper) UpdateParams(arg0 context.Context, arg1 *types0.MsgUpdateParams) (*types0.MsgUpdateParamsResponse, error) {
 	m.ctrl.T.Helper()
@@ -142,7 +142,8 @@ func unarmorBytes(armorStr, blockType string) (bz []byte, header map[string]stri
 //-----------------------------------------------------------------
 // encrypt/decrypt with armor
 
-// EncryptArmorPrivKey encrypts and armors the private key.
+// EncryptArmorPrivKey encrypts and armors the private key in the legacy
+// format. Use EncryptArmorPrivKeyV2 for the v2 format.
 func EncryptArmorPrivKey(privKey cryptotypes.PrivKey, passphrase, algo string) string {
 	saltBytes, encBytes := encryptPrivKey(privKey, passphrase)
 	header := map[string]string{
@@ -177,7 +178,8 @@ func encryptPrivKey(privKey cryptotypes.PrivKey, passphrase string) (saltBytes,
 	return saltBytes, encBytes
 }
 
-// UnarmorDecryptPrivKey returns the privkey byte slice, a string of the algo type, and an error
+// UnarmorDecryptPrivKey returns the privkey byte slice, a string of the algo type, and an error.
+// It reads armors of the legacy and v2 formats.
 func UnarmorDecryptPrivKey(armorStr, passphrase string) (privKey cryptotypes.PrivKey, algo string, err error) {
 	blockType, header, encBytes, err := DecodeArmor(armorStr)
 	if err != nil {
@@ -188,6 +190,11 @@ func UnarmorDecryptPrivKey(armorStr, passphrase string) (privKey cryptotypes.Pri
 		return privKey, "", fmt.Errorf("unrecognized armor type: %v", blockType)
 	}
 
+	if header[headerVersion] == armorVersionV2 {
+		privKey, metadata, err := unarmorDecryptPrivKeyV2(armorStr, passphrase)
+		return privKey, metadata.Algo, err
+	}
+
 	if header[kdfHeader] != kdfBcrypt && header[kdfHeader] != kdfArgon2 {
 		return privKey, "", fmt.Errorf("unrecognized KDF type: %v", header[kdfHeader])
 	}
@@ -0,0 +1,307 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// The v2 armor format encrypts data with XChaCha20-Poly1305 under a key
+// derived from a passphrase with Argon2id. The Argon2id parameters, the salt
+// and the nonce are stored in the armor header, along with metadata such as
+// the algorithm of a private key. The whole header is authenticated.
+const (
+	armorVersionV2 = "2"
+
+	kdfArgon2id       = "argon2id"
+	cipherXChaCha20   = "xchacha20-poly1305"
+	headerCipher      = "cipher"
+	headerSalt        = "salt"
+	headerNonce       = "nonce"
+	headerArgon2Time  = "argon2-time"
+	headerArgon2Mem   = "argon2-memory"
+	headerArgon2Par   = "argon2-threads"
+	headerHDPath      = "hd-path"
+	headerCreated     = "created"
+	argon2V2SaltBytes = 16
+
+	// maxArgon2Memory bounds the memory of the key derivation of an armor to
+	// 256 MiB, in KiB, so that a crafted header cannot exhaust the memory of
+	// the host.
+	maxArgon2Memory = 256 * 1024
+	// maxArgon2Time bounds the passes of the key derivation of an armor.
+	maxArgon2Time = 64
+)
+
+// Argon2Params are the Argon2id parameters deriving the key of an armor of the
+// v2 format from a passphrase.
+type Argon2Params struct {
+	// Time is the number of passes over the memory.
+	Time uint32
+	// Memory is the size of the memory, in KiB.
+	Memory uint32
+	// Threads is the degree of parallelism.
+	Threads uint8
+}
+
+// DefaultArgon2Params are the Argon2id parameters of newly encrypted armors,
+// following the second recommended option of RFC 9106.
+var DefaultArgon2Params = Argon2Params{
+	Time:    3,
+	Memory:  64 * 1024,
+	Threads: 4,
+}
+
+// Validate checks the Argon2id parameters are within bounds.
+func (p Argon2Params) Validate() error {
+	switch {
+	case p.Time == 0 || p.Time > maxArgon2Time:
+		return fmt.Errorf("argon2 time must be between 1 and %d: %d", maxArgon2Time, p.Time)
+	case p.Threads == 0:
+		return errors.New("argon2 threads must be positive")
+	case p.Memory < 8*uint32(p.Threads) || p.Memory > maxArgon2Memory:
+		return fmt.Errorf("argon2 memory must be between %d and %d KiB: %d", 8*uint32(p.Threads), maxArgon2Memory, p.Memory)
+	}
+
+	return nil
+}
+
+// DeriveKey derives the encryption key of the given passphrase and salt.
+func (p Argon2Params) DeriveKey(passphrase string, salt []byte) []byte {
+	return argon2.IDKey([]byte(passphrase), salt, p.Time, p.Memory, p.Threads, chacha20poly1305.KeySize)
+}
+
+// KeyDeriver derives the encryption key of an armor of the v2 format from the
+// Argon2id parameters and the salt of its header. Key stores encrypting many
+// items with the same passphrase can cache the derived keys.
+type KeyDeriver func(params Argon2Params, salt []byte) ([]byte, error)
+
+// PassphraseKeyDeriver returns the KeyDeriver of the given passphrase.
+func PassphraseKeyDeriver(passphrase string) KeyDeriver {
+	return func(params Argon2Params, salt []byte) ([]byte, error) {
+		if err := params.Validate(); err != nil {
+			return nil, err
+		}
+
+		return params.DeriveKey(passphrase, salt), nil
+	}
+}
+
+// KeyMetadata is the metadata of a private key armored in the v2 format.
+type KeyMetadata struct {
+	// Algo is the signing algorithm of the key.
+	Algo string
+	// HDPath is the HD path the key was derived with, if any.
+	HDPath string
+	// CreatedAt is the creation time of the key, if known.
+	CreatedAt time.Time
+}
+
+// EncryptArmorPrivKeyV2 encrypts and armors the private key in the v2 format,
+// with the default Argon2id parameters.
+func EncryptArmorPrivKeyV2(privKey cryptotypes.PrivKey, passphrase string, metadata KeyMetadata) string {
+	header := map[string]string{}
+	if metadata.Algo != "" {
+		header[headerType] = metadata.Algo
+	}
+	if metadata.HDPath != "" {
+		header[headerHDPath] = metadata.HDPath
+	}
+	if !metadata.CreatedAt.IsZero() {
+		header[headerCreated] = metadata.CreatedAt.UTC().Format(time.RFC3339)
+	}
+
+	armorStr, err := EncryptArmorV2(blockTypePrivKey, header, legacy.Cdc.MustMarshal(privKey), DefaultArgon2Params, crypto.CRandBytes(argon2V2SaltBytes), PassphraseKeyDeriver(passphrase))
+	if err != nil {
+		panic(errorsmod.Wrap(err, "error encrypting private key"))
+	}
+
+	return armorStr
+}
+
+// UnarmorDecryptPrivKeyWithMetadata returns the private key of an armor of any
+// version, and its metadata. Armors older than the v2 format only hold the
+// algorithm of the key.
+func UnarmorDecryptPrivKeyWithMetadata(armorStr, passphrase string) (cryptotypes.PrivKey, KeyMetadata, error) {
+	_, header, _, err := DecodeArmor(armorStr)
+	if err != nil {
+		return nil, KeyMetadata{}, err
+	}
+
+	if header[headerVersion] != armorVersionV2 {
+		privKey, algo, err := UnarmorDecryptPrivKey(armorStr, passphrase)
+		return privKey, KeyMetadata{Algo: algo}, err
+	}
+
+	return unarmorDecryptPrivKeyV2(armorStr, passphrase)
+}
+
+func unarmorDecryptPrivKeyV2(armorStr, passphrase string) (cryptotypes.PrivKey, KeyMetadata, error) {
+	blockType, header, privKeyBytes, err := DecryptArmorV2(armorStr, PassphraseKeyDeriver(passphrase))
+	if err != nil {
+		return nil, KeyMetadata{}, err
+	}
+
+	if blockType != blockTypePrivKey {
+		return nil, KeyMetadata{}, fmt.Errorf("unrecognized armor type: %v", blockType)
+	}
+
+	metadata := KeyMetadata{
+		Algo:   header[headerType],
+		HDPath: header[headerHDPath],
+	}
+	if metadata.Algo == "" {
+		metadata.Algo = defaultAlgo
+	}
+	if created := header[headerCreated]; created != "" {
+		if metadata.CreatedAt, err = time.Parse(time.RFC3339, created); err != nil {
+			return nil, KeyMetadata{}, fmt.Errorf("error decoding creation time: %w", err)
+		}
+	}
+
+	privKey, err := legacy.PrivKeyFromBytes(privKeyBytes)
+	if err != nil {
+		return nil, KeyMetadata{}, err
+	}
+
+	return privKey, metadata, nil
+}
+
+// EncryptArmorV2 encrypts the given bytes with the key derived from the given
+// Argon2id parameters and salt, and armors them in the v2 format with the
+// given header.
+func EncryptArmorV2(blockType string, header map[string]string, bz []byte, params Argon2Params, salt []byte, deriveKey KeyDeriver) (string, error) {
+	key, err := deriveKey(params, salt)
+	if err != nil {
+		return "", err
+	}
+
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return "", errorsmod.Wrap(err, "error generating cypher from key")
+	}
+	nonce := crypto.CRandBytes(aead.NonceSize())
+
+	armorHeader := make(map[string]string, len(header)+8)
+	for k, v := range header {
+		armorHeader[k] = v
+	}
+	armorHeader[headerVersion] = armorVersionV2
+	armorHeader[kdfHeader] = kdfArgon2id
+	armorHeader[headerArgon2Time] = strconv.FormatUint(uint64(params.Time), 10)
+	armorHeader[headerArgon2Mem] = strconv.FormatUint(uint64(params.Memory), 10)
+	armorHeader[headerArgon2Par] = strconv.FormatUint(uint64(params.Threads), 10)
+	armorHeader[headerSalt] = fmt.Sprintf("%X", salt)
+	armorHeader[headerCipher] = cipherXChaCha20
+	armorHeader[headerNonce] = fmt.Sprintf("%X", nonce)
+
+	encBytes := aead.Seal(nil, nonce, bz, armorHeaderAD(blockType, armorHeader))
+
+	return EncodeArmor(blockType, armorHeader, encBytes), nil
+}
+
+// DecryptArmorV2 decodes an armor of the v2 format and decrypts its data with
+// the key derived from the Argon2id parameters and salt of its header.
+func DecryptArmorV2(armorStr string, deriveKey KeyDeriver) (blockType string, header map[string]string, bz []byte, err error) {
+	blockType, header, encBytes, err := DecodeArmor(armorStr)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if header[headerVersion] != armorVersionV2 {
+		return "", nil, nil, fmt.Errorf("unrecognized version: %v", header[headerVersion])
+	}
+	if header[kdfHeader] != kdfArgon2id {
+		return "", nil, nil, fmt.Errorf("unrecognized KDF type: %v", header[kdfHeader])
+	}
+	if header[headerCipher] != cipherXChaCha20 {
+		return "", nil, nil, fmt.Errorf("unrecognized cipher: %v", header[headerCipher])
+	}
+
+	params, err := parseArgon2Params(header)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	salt, err := hex.DecodeString(header[headerSalt])
+	if err != nil || len(salt) == 0 {
+		return "", nil, nil, fmt.Errorf("error decoding salt: %q", header[headerSalt])
+	}
+	nonce, err := hex.DecodeString(header[headerNonce])
+	if err != nil || len(nonce) != chacha20poly1305.NonceSizeX {
+		return "", nil, nil, fmt.Errorf("error decoding nonce: %q", header[headerNonce])
+	}
+
+	key, err := deriveKey(params, salt)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return "", nil, nil, errorsmod.Wrap(err, "error generating cypher from key")
+	}
+
+	bz, err = aead.Open(nil, nonce, encBytes, armorHeaderAD(blockType, header))
+	if err != nil {
+		return "", nil, nil, sdkerrors.ErrWrongPassword
+	}
+
+	return blockType, header, bz, nil
+}
+
+func parseArgon2Params(header map[string]string) (Argon2Params, error) {
+	passes, err := strconv.ParseUint(header[headerArgon2Time], 10, 32)
+	if err != nil {
+		return Argon2Params{}, fmt.Errorf("error decoding argon2 time: %w", err)
+	}
+	memory, err := strconv.ParseUint(header[headerArgon2Mem], 10, 32)
+	if err != nil {
+		return Argon2Params{}, fmt.Errorf("error decoding argon2 memory: %w", err)
+	}
+	threads, err := strconv.ParseUint(header[headerArgon2Par], 10, 8)
+	if err != nil {
+		return Argon2Params{}, fmt.Errorf("error decoding argon2 threads: %w", err)
+	}
+
+	params := Argon2Params{
+		Time:    uint32(passes),
+		Memory:  uint32(memory),
+		Threads: uint8(threads),
+	}
+
+	return params, params.Validate()
+}
+
+// armorHeaderAD returns the additional data authenticating the block type and
+// the header of an armor of the v2 format.
+func armorHeaderAD(blockType string, header map[string]string) []byte {
+	keys := make([]string, 0, len(header))
+	for k := range header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	sb.WriteString(blockType)
+	sb.WriteByte('\n')
+	for _, k := range keys {
+		fmt.Fprintf(&sb, "%s: %s\n", k, header[k])
+	}
+
+	return []byte(sb.String())
+}
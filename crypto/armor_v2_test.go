@@ -0,0 +1,108 @@
+package crypto_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestArmorUnarmorPrivKeyV2(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	metadata := crypto.KeyMetadata{
+		Algo:      "secp256k1",
+		HDPath:    "m/44'/118'/0'/0/0",
+		CreatedAt: time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC),
+	}
+	armored := crypto.EncryptArmorPrivKeyV2(priv, "passphrase", metadata)
+
+	blockType, header, _, err := crypto.DecodeArmor(armored)
+	require.NoError(t, err)
+	require.Equal(t, "TENDERMINT PRIVATE KEY", blockType)
+	require.Equal(t, "2", header["version"])
+	require.Equal(t, "argon2id", header["kdf"])
+	require.Equal(t, "xchacha20-poly1305", header["cipher"])
+	require.Equal(t, "3", header["argon2-time"])
+	require.Equal(t, "65536", header["argon2-memory"])
+	require.Equal(t, "4", header["argon2-threads"])
+	require.Equal(t, "m/44'/118'/0'/0/0", header["hd-path"])
+	require.Equal(t, "2024-05-01T12:30:00Z", header["created"])
+
+	_, _, err = crypto.UnarmorDecryptPrivKeyWithMetadata(armored, "wrongpassphrase")
+	require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+
+	decrypted, decryptedMetadata, err := crypto.UnarmorDecryptPrivKeyWithMetadata(armored, "passphrase")
+	require.NoError(t, err)
+	require.True(t, priv.Equals(decrypted))
+	require.Equal(t, metadata, decryptedMetadata)
+
+	// v2 armors are also read by UnarmorDecryptPrivKey
+	decrypted, algo, err := crypto.UnarmorDecryptPrivKey(armored, "passphrase")
+	require.NoError(t, err)
+	require.True(t, priv.Equals(decrypted))
+	require.Equal(t, "secp256k1", algo)
+
+	// the header is authenticated
+	tampered := make(map[string]string)
+	for k, v := range header {
+		tampered[k] = v
+	}
+	tampered["hd-path"] = "m/44'/60'/0'/0/0"
+	_, _, encBytes, err := crypto.DecodeArmor(armored)
+	require.NoError(t, err)
+	_, _, err = crypto.UnarmorDecryptPrivKeyWithMetadata(crypto.EncodeArmor(blockType, tampered, encBytes), "passphrase")
+	require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+
+	// and so are its key derivation parameters, which are bounded
+	tampered = map[string]string{}
+	for k, v := range header {
+		tampered[k] = v
+	}
+	tampered["argon2-memory"] = "1073741824"
+	_, _, err = crypto.UnarmorDecryptPrivKeyWithMetadata(crypto.EncodeArmor(blockType, tampered, encBytes), "passphrase")
+	require.ErrorContains(t, err, "argon2 memory")
+
+	// legacy armors only hold the algorithm
+	legacyArmored := crypto.EncryptArmorPrivKey(priv, "passphrase", "secp256k1")
+	decrypted, decryptedMetadata, err = crypto.UnarmorDecryptPrivKeyWithMetadata(legacyArmored, "passphrase")
+	require.NoError(t, err)
+	require.True(t, priv.Equals(decrypted))
+	require.Equal(t, crypto.KeyMetadata{Algo: "secp256k1"}, decryptedMetadata)
+}
+
+func TestEncryptArmorV2(t *testing.T) {
+	params := crypto.Argon2Params{Time: 1, Memory: 64, Threads: 1}
+	salt := []byte("0123456789abcdef")
+
+	// the key derivation can be cached by the caller
+	derivations := 0
+	deriveKey := func(params crypto.Argon2Params, salt []byte) ([]byte, error) {
+		derivations++
+		return crypto.PassphraseKeyDeriver("passphrase")(params, salt)
+	}
+
+	armored, err := crypto.EncryptArmorV2("TEST ITEM", map[string]string{"name": "item"}, []byte("data"), params, salt, deriveKey)
+	require.NoError(t, err)
+	blockType, header, bz, err := crypto.DecryptArmorV2(armored, deriveKey)
+	require.NoError(t, err)
+	require.Equal(t, "TEST ITEM", blockType)
+	require.Equal(t, "item", header["name"])
+	require.Equal(t, []byte("data"), bz)
+	require.Equal(t, 2, derivations)
+
+	_, _, _, err = crypto.DecryptArmorV2(armored, crypto.PassphraseKeyDeriver("wrongpassphrase"))
+	require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+
+	// invalid parameters are rejected
+	_, err = crypto.EncryptArmorV2("TEST ITEM", nil, []byte("data"), crypto.Argon2Params{Time: 1, Memory: 64}, salt, crypto.PassphraseKeyDeriver("passphrase"))
+	require.ErrorContains(t, err, "argon2 threads")
+	require.ErrorContains(t, crypto.Argon2Params{Time: 1, Memory: 512 * 1024, Threads: 1}.Validate(), "argon2 memory")
+
+	// legacy armors are not of the v2 format
+	_, _, _, err = crypto.DecryptArmorV2(crypto.EncryptArmorPrivKey(secp256k1.GenPrivKey(), "passphrase", ""), deriveKey)
+	require.ErrorContains(t, err, "unrecognized version")
+}
@@ -1,9 +1,14 @@
 package hd
 
 import (
+	stded25519 "crypto/ed25519"
+
 	"github.com/cosmos/go-bip39"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
@@ -16,15 +21,27 @@ const (
 	// Secp256k1Type uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1Type = PubKeyType("secp256k1")
 	// Ed25519Type represents the Ed25519Type signature system.
-	// It is currently not supported for end-user keys (wallets/ledgers).
+	// Keys are derived with SLIP-0010, which only supports hardened paths, and
+	// are not supported by ledgers.
 	Ed25519Type = PubKeyType("ed25519")
+	// Secp256r1Type uses the NIST P-256 ECDSA parameters.
+	// Keys are derived with SLIP-0010 and are not supported by ledgers.
+	Secp256r1Type = PubKeyType("secp256r1")
 	// Bls12_381Type represents the Bls12_381Type signature system.
-	// It is currently not supported for end-user keys (wallets/ledgers).
+	// It requires the bls12381 build tag and is not supported by ledgers.
 	Bls12_381Type = PubKeyType("bls12_381")
 )
 
-// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
-var Secp256k1 = secp256k1Algo{}
+var (
+	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
+	Secp256k1 = secp256k1Algo{}
+	// Bls12_381 derives BLS12-381 keys from the same BIP-44 secrets as Secp256k1.
+	Bls12_381 = bls12_381Algo{}
+	// Ed25519 derives ed25519 keys with SLIP-0010.
+	Ed25519 = ed25519Algo{}
+	// Secp256r1 derives secp256r1 keys with SLIP-0010.
+	Secp256r1 = secp256r1Algo{}
+)
 
 type (
 	DeriveFn   func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error)
@@ -69,3 +86,93 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: bzArr}
 	}
 }
+
+type bls12_381Algo struct{}
+
+func (s bls12_381Algo) Name() PubKeyType {
+	return Bls12_381Type
+}
+
+// Derive derives and returns the secret of the bls12_381 private key for the
+// given seed and HD path. The secret is derived as a secp256k1 private key.
+func (s bls12_381Algo) Derive() DeriveFn {
+	return Secp256k1.Derive()
+}
+
+// Generate generates a bls12_381 private key from the given secret.
+func (s bls12_381Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		privKey, err := bls12_381.GenPrivKeyFromSecret(bz)
+		if err != nil {
+			panic(err)
+		}
+
+		return &privKey
+	}
+}
+
+type ed25519Algo struct{}
+
+func (s ed25519Algo) Name() PubKeyType {
+	return Ed25519Type
+}
+
+// Derive derives and returns the seed of the ed25519 private key for the given
+// seed and HD path, which must only have hardened indexes.
+func (s ed25519Algo) Derive() DeriveFn {
+	return slip10Derive(SLIP10Ed25519)
+}
+
+// Generate generates an ed25519 private key from the given seed.
+func (s ed25519Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		seed := make([]byte, stded25519.SeedSize)
+		copy(seed, bz)
+
+		return &ed25519.PrivKey{Key: stded25519.NewKeyFromSeed(seed)}
+	}
+}
+
+type secp256r1Algo struct{}
+
+func (s secp256r1Algo) Name() PubKeyType {
+	return Secp256r1Type
+}
+
+// Derive derives and returns the secp256r1 private key for the given seed and
+// HD path.
+func (s secp256r1Algo) Derive() DeriveFn {
+	return slip10Derive(SLIP10Nist256p1)
+}
+
+// Generate generates a secp256r1 private key from the given bytes.
+func (s secp256r1Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		bzArr := make([]byte, 32)
+		copy(bzArr, bz)
+
+		privKey, err := secp256r1.NewPrivKeyFromBytes(bzArr)
+		if err != nil {
+			panic(err)
+		}
+
+		return privKey
+	}
+}
+
+// slip10Derive returns the DeriveFn of the given SLIP-0010 curve.
+func slip10Derive(c SLIP10Curve) DeriveFn {
+	return func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error) {
+		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		masterPriv, ch := ComputeSLIP10MastersFromSeed(c, seed)
+		if len(hdPath) == 0 {
+			return masterPriv[:], nil
+		}
+
+		return DeriveSLIP10PrivateKeyForPath(c, masterPriv, ch, hdPath)
+	}
+}
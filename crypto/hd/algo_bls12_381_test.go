@@ -0,0 +1,33 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package hd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+)
+
+func TestBls12_381Algo(t *testing.T) {
+	mnemonic := "equip will roof matter pink blind book anxiety banner elbow sun young"
+	path := hd.CreateHDPath(118, 0, 0).String()
+
+	derive := func(path string) *bls12_381.PrivKey {
+		bz, err := hd.Bls12_381.Derive()(mnemonic, "", path)
+		require.NoError(t, err)
+		return hd.Bls12_381.Generate()(bz).(*bls12_381.PrivKey)
+	}
+
+	privKey := derive(path)
+	require.Equal(t, hd.Bls12_381Type, hd.Bls12_381.Name())
+	require.Equal(t, string(hd.Bls12_381Type), privKey.Type())
+	require.True(t, privKey.Equals(derive(path)))
+	require.False(t, privKey.Equals(derive(hd.CreateHDPath(118, 0, 1).String())))
+
+	sig, err := privKey.Sign([]byte("msg"))
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().VerifySignature([]byte("msg"), sig))
+}
@@ -279,3 +279,10 @@ func i64(key, data []byte) (il, ir [32]byte) {
 func CreateHDPath(coinType, account, index uint32) *BIP44Params {
 	return NewFundraiserParams(account, coinType, index)
 }
+
+// CreateHardenedHDPath returns the BIP 44 path of the account and index
+// parameters with all its indexes hardened, as required by the SLIP-0010
+// derivation of ed25519 keys.
+func CreateHardenedHDPath(coinType, account, index uint32) string {
+	return fmt.Sprintf("m/44'/%d'/%d'/0'/%d'", coinType, account, index)
+}
@@ -0,0 +1,124 @@
+package hd
+
+import (
+	"crypto/elliptic"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// SLIP10Curve is a curve of the SLIP-0010 HD derivation scheme, which
+// generalizes BIP-32 to other curves than secp256k1:
+//   - https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+type SLIP10Curve struct {
+	// seedKey is the HMAC key deriving the master key from the seed.
+	seedKey string
+	// curve is the curve of the keys, nil for ed25519 which only supports
+	// hardened derivation.
+	curve elliptic.Curve
+}
+
+var (
+	// SLIP10Ed25519 derives ed25519 private keys. Only hardened derivation is
+	// supported.
+	SLIP10Ed25519 = SLIP10Curve{seedKey: "ed25519 seed"}
+	// SLIP10Nist256p1 derives secp256r1 (NIST P-256) private keys.
+	SLIP10Nist256p1 = SLIP10Curve{seedKey: "Nist256p1 seed", curve: elliptic.P256()}
+)
+
+// ComputeSLIP10MastersFromSeed returns the master secret key, and chain code of
+// the given curve.
+func ComputeSLIP10MastersFromSeed(c SLIP10Curve, seed []byte) (secret, chainCode [32]byte) {
+	secret, chainCode = i64([]byte(c.seedKey), seed)
+	// the master key of a weierstrass curve must be a valid scalar
+	for c.curve != nil && !c.isValidScalar(secret[:]) {
+		secret, chainCode = i64([]byte(c.seedKey), append(secret[:], chainCode[:]...))
+	}
+
+	return secret, chainCode
+}
+
+// DeriveSLIP10PrivateKeyForPath derives the private key of the given curve by
+// following the SLIP-0010 path from privKeyBytes, using the given chainCode.
+// Paths of ed25519 keys must only have hardened indexes.
+func DeriveSLIP10PrivateKeyForPath(c SLIP10Curve, privKeyBytes, chainCode [32]byte, path string) ([]byte, error) {
+	path = strings.TrimRightFunc(path, func(r rune) bool { return r == filepath.Separator })
+	parts := strings.Split(path, "/")
+
+	switch {
+	case parts[0] == path:
+		return nil, fmt.Errorf("path '%s' doesn't contain '/' separators", path)
+	case strings.TrimSpace(parts[0]) == "m":
+		parts = parts[1:]
+	}
+
+	data := privKeyBytes
+	for i, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("path %q with split element #%d is an empty string", path, i)
+		}
+		harden := part[len(part)-1:] == "'"
+		if harden {
+			part = part[:len(part)-1]
+		} else if c.curve == nil {
+			return nil, fmt.Errorf("invalid SLIP-0010 path %s: ed25519 only supports hardened derivation", path)
+		}
+
+		idx, err := strconv.ParseUint(part, 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SLIP-0010 path %s: %w", path, err)
+		}
+
+		data, chainCode = c.derivePrivateKey(data, chainCode, uint32(idx), harden)
+	}
+
+	derivedKey := make([]byte, 32)
+	copy(derivedKey, data[:])
+
+	return derivedKey, nil
+}
+
+// derivePrivateKey derives the child private key with index and chainCode.
+// It returns the new private key and new chain code.
+func (c SLIP10Curve) derivePrivateKey(privKeyBytes, chainCode [32]byte, index uint32, harden bool) ([32]byte, [32]byte) {
+	var data []byte
+	if harden {
+		index |= 0x80000000
+		data = append([]byte{0}, privKeyBytes[:]...)
+	} else {
+		x, y := c.curve.ScalarBaseMult(privKeyBytes[:])
+		data = elliptic.MarshalCompressed(c.curve, x, y)
+	}
+	data = append(data, uint32ToBytes(index)...)
+
+	il, ir := i64(chainCode[:], data)
+	if c.curve == nil {
+		return il, ir
+	}
+
+	// an invalid child key is derived again from the right half
+	for {
+		if c.isValidScalar(il[:]) {
+			n := c.curve.Params().N
+			k := new(big.Int).Add(new(big.Int).SetBytes(il[:]), new(big.Int).SetBytes(privKeyBytes[:]))
+			k.Mod(k, n)
+			if k.Sign() != 0 {
+				var child [32]byte
+				k.FillBytes(child[:])
+				return child, ir
+			}
+		}
+
+		data = append(append([]byte{1}, ir[:]...), uint32ToBytes(index)...)
+		il, ir = i64(chainCode[:], data)
+	}
+}
+
+// isValidScalar returns true if bz is a non-zero scalar lower than the order
+// of the curve.
+func (c SLIP10Curve) isValidScalar(bz []byte) bool {
+	k := new(big.Int).SetBytes(bz)
+	return k.Sign() != 0 && k.Cmp(c.curve.Params().N) < 0
+}
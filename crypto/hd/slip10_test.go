@@ -0,0 +1,117 @@
+package hd_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+)
+
+// The test vectors 1 of SLIP-0010:
+// https://github.com/satoshilabs/slips/blob/master/slip-0010.md#test-vector-1-for-ed25519
+func TestDeriveSLIP10PrivateKeyForPath(t *testing.T) {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		curve     hd.SLIP10Curve
+		path      string
+		chainCode string
+		privKey   string
+	}{
+		{hd.SLIP10Ed25519, "m", "90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb", "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7"},
+		{hd.SLIP10Ed25519, "m/0'", "", "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3"},
+		{hd.SLIP10Ed25519, "m/0'/1'", "", "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2"},
+		{hd.SLIP10Ed25519, "m/0'/1'/2'", "", "92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9"},
+		{hd.SLIP10Ed25519, "m/0'/1'/2'/2'", "", "30d1dc7e5fc04c31219ab25a27ae00b50f6fd66622f6e9c913253d6511d1e662"},
+		{hd.SLIP10Ed25519, "m/0'/1'/2'/2'/1000000000'", "", "8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793"},
+		{hd.SLIP10Nist256p1, "m", "beeb672fe4621673f722f38529c07392fecaa61015c80c34f29ce8b41b3cb6ea", "612091aaa12e22dd2abef664f8a01a82cae99ad7441b7ef8110424915c268bc2"},
+		{hd.SLIP10Nist256p1, "m/0'", "", "6939694369114c67917a182c59ddb8cafc3004e63ca5d3b84403ba8613debc0c"},
+		{hd.SLIP10Nist256p1, "m/0'/1", "", "284e9d38d07d21e4e281b645089a94f4cf5a5a81369acf151a1c3a57f18b2129"},
+		{hd.SLIP10Nist256p1, "m/0'/1/2'", "", "694596e8a54f252c960eb771a3c41e7e32496d03b954aeb90f61635b8e092aa7"},
+		{hd.SLIP10Nist256p1, "m/0'/1/2'/2", "", "5996c37fd3dd2679039b23ed6f70b506c6b56b3cb5e424681fb0fa64caf82aaa"},
+		{hd.SLIP10Nist256p1, "m/0'/1/2'/2/1000000000", "", "21c4f269ef0a5fd1badf47eeacebeeaa3de22eb8e5b0adcd0f27dd99d34d0119"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			master, chainCode := hd.ComputeSLIP10MastersFromSeed(tc.curve, seed)
+			if tc.path == "m" {
+				require.Equal(t, tc.privKey, hex.EncodeToString(master[:]))
+				require.Equal(t, tc.chainCode, hex.EncodeToString(chainCode[:]))
+				return
+			}
+
+			privKey, err := hd.DeriveSLIP10PrivateKeyForPath(tc.curve, master, chainCode, tc.path)
+			require.NoError(t, err)
+			require.Equal(t, tc.privKey, hex.EncodeToString(privKey))
+		})
+	}
+}
+
+func TestDeriveSLIP10PrivateKeyForPathInvalid(t *testing.T) {
+	master, chainCode := hd.ComputeSLIP10MastersFromSeed(hd.SLIP10Ed25519, []byte("seed"))
+
+	for _, path := range []string{"m/44'/118'/0'/0/0", "m/44'/118'/0'/0'/0", "m/44'/118'/0'//0'", "m/44'/x'", "m/2147483648'", "44'"} {
+		_, err := hd.DeriveSLIP10PrivateKeyForPath(hd.SLIP10Ed25519, master, chainCode, path)
+		require.Error(t, err, path)
+	}
+
+	_, err := hd.DeriveSLIP10PrivateKeyForPath(hd.SLIP10Ed25519, master, chainCode, "m/44'/118'/0'/0/0")
+	require.ErrorContains(t, err, "ed25519 only supports hardened derivation")
+
+	// non-hardened indexes are supported by weierstrass curves
+	master, chainCode = hd.ComputeSLIP10MastersFromSeed(hd.SLIP10Nist256p1, []byte("seed"))
+	_, err = hd.DeriveSLIP10PrivateKeyForPath(hd.SLIP10Nist256p1, master, chainCode, "m/44'/118'/0'/0/0")
+	require.NoError(t, err)
+}
+
+func TestEd25519Algo(t *testing.T) {
+	mnemonic := "equip will roof matter pink blind book anxiety banner elbow sun young"
+	path := hd.CreateHardenedHDPath(118, 0, 0)
+	require.Equal(t, "m/44'/118'/0'/0'/0'", path)
+
+	derive := func(path string) *ed25519.PrivKey {
+		bz, err := hd.Ed25519.Derive()(mnemonic, "", path)
+		require.NoError(t, err)
+		return hd.Ed25519.Generate()(bz).(*ed25519.PrivKey)
+	}
+
+	privKey := derive(path)
+	require.Equal(t, hd.Ed25519Type, hd.Ed25519.Name())
+	require.Equal(t, string(hd.Ed25519Type), privKey.Type())
+	require.True(t, privKey.Equals(derive(path)))
+	require.False(t, privKey.Equals(derive(hd.CreateHardenedHDPath(118, 0, 1))))
+
+	sig, err := privKey.Sign([]byte("msg"))
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().VerifySignature([]byte("msg"), sig))
+
+	_, err = hd.Ed25519.Derive()(mnemonic, "", hd.CreateHDPath(118, 0, 0).String())
+	require.ErrorContains(t, err, "ed25519 only supports hardened derivation")
+}
+
+func TestSecp256r1Algo(t *testing.T) {
+	mnemonic := "equip will roof matter pink blind book anxiety banner elbow sun young"
+	path := hd.CreateHDPath(118, 0, 0).String()
+
+	derive := func(path string) *secp256r1.PrivKey {
+		bz, err := hd.Secp256r1.Derive()(mnemonic, "", path)
+		require.NoError(t, err)
+		return hd.Secp256r1.Generate()(bz).(*secp256r1.PrivKey)
+	}
+
+	privKey := derive(path)
+	require.Equal(t, hd.Secp256r1Type, hd.Secp256r1.Name())
+	require.Equal(t, string(hd.Secp256r1Type), privKey.Type())
+	require.True(t, privKey.Equals(derive(path)))
+	require.False(t, privKey.Equals(derive(hd.CreateHDPath(118, 0, 1).String())))
+
+	sig, err := privKey.Sign([]byte("msg"))
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().VerifySignature([]byte("msg"), sig))
+}
@@ -29,6 +29,8 @@
 //		v0.38.1. It stores the keyring encrypted within the app's configuration directory.
 //		This keyring will request a password each time it is accessed, which may occur
 //		multiple times in a single command resulting in repeated password prompts.
+//		Keys are encrypted in the v2 armor format, with a key derived from the password
+//		with Argon2id. Keys encrypted by former versions are re-encrypted when read.
 //	kwallet	This backend uses KDE Wallet Manager as a credentials management application:
 //		https://github.com/KDE/kwallet
 //	pass	This backend uses the pass command line utility to store and retrieve keys:
@@ -39,4 +41,8 @@
 //		be unlocked and it should be used only for testing purposes.
 //	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 //		are discarded when the process terminates or the type instance is garbage collected.
+//	remote	This backend signs with a remote signer implementing the RemoteSigner gRPC service,
+//		whose address is set with the WithRemoteSigner option. The private keys never leave
+//		the signer, which can be backed by a HSM or a KMS. NewRemoteSignerServer serves
+//		any keyring with this protocol.
 package keyring
@@ -0,0 +1,303 @@
+package keyring
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/99designs/keyring"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/mtibben/percent"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+)
+
+// blockTypeKeyringItem is the armor block type of the items of the file
+// backend.
+const blockTypeKeyringItem = "COSMOS KEYRING ITEM"
+
+// armorPrefix starts the items stored in the v2 armor format, unlike the JWE
+// items of the legacy file keyring.
+var armorPrefix = []byte("-----BEGIN ")
+
+// fileKeyring is the keyring of the file backend. Each item is stored in a
+// file of its directory, encrypted in the v2 armor format with a key derived
+// from the keyring passphrase with Argon2id.
+//
+// Items stored by former versions, encrypted with PBES2 by the legacy file
+// keyring, are still read as is. They are only re-encrypted in the v2 format
+// by migrateLegacyItems, which is one-way: former versions can not read the
+// migrated items.
+type fileKeyring struct {
+	dir          string
+	passwordFunc keyring.PromptFunc
+	legacy       keyring.Keyring
+
+	mu       sync.Mutex
+	password string
+	// salt is the salt of the items written, shared by the items of the
+	// keyring so that its key is derived once.
+	salt []byte
+	// keys caches the keys derived from the passphrase by parameters and salt.
+	keys map[string][]byte
+}
+
+var _ keyring.Keyring = &fileKeyring{}
+
+func newFileKeyring(cfg keyring.Config) (keyring.Keyring, error) {
+	k := &fileKeyring{
+		dir:          cfg.FileDir,
+		passwordFunc: cfg.FilePasswordFunc,
+		keys:         make(map[string][]byte),
+	}
+
+	legacy, err := keyring.Open(keyring.Config{
+		AllowedBackends:  []keyring.BackendType{keyring.FileBackend},
+		ServiceName:      cfg.ServiceName,
+		FileDir:          cfg.FileDir,
+		FilePasswordFunc: func(string) (string, error) { return k.unlock() },
+	})
+	if err != nil {
+		return nil, err
+	}
+	k.legacy = legacy
+
+	return k, nil
+}
+
+// Get implements keyring.Keyring.
+func (k *fileKeyring) Get(key string) (keyring.Item, error) {
+	filename, err := k.filename(key)
+	if err != nil {
+		return keyring.Item{}, err
+	}
+
+	bz, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return keyring.Item{}, keyring.ErrKeyNotFound
+	} else if err != nil {
+		return keyring.Item{}, err
+	}
+
+	if !bytes.HasPrefix(bz, armorPrefix) {
+		return k.legacy.Get(key)
+	}
+
+	blockType, _, payload, err := crypto.DecryptArmorV2(string(bz), k.deriveKey)
+	if err != nil {
+		return keyring.Item{}, err
+	}
+	if blockType != blockTypeKeyringItem {
+		return keyring.Item{}, fmt.Errorf("unrecognized armor type %q, expected: %q", blockType, blockTypeKeyringItem)
+	}
+
+	var item keyring.Item
+	err = json.Unmarshal(payload, &item)
+
+	return item, err
+}
+
+// migrateLegacyItems re-encrypts the items stored by the legacy file keyring
+// in the v2 armor format. Only the key records and their address indexes are
+// items: the other files of the directory, such as the keyhash file of the
+// passphrase, are left untouched.
+func (k *fileKeyring) migrateLegacyItems() error {
+	keys, err := k.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if !strings.HasSuffix(key, "."+infoSuffix) && !strings.HasSuffix(key, "."+addressSuffix) {
+			continue
+		}
+
+		filename, err := k.filename(key)
+		if err != nil {
+			return err
+		}
+
+		if fi, err := os.Stat(filename); err != nil {
+			return err
+		} else if fi.IsDir() {
+			continue
+		}
+
+		bz, err := os.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		if bytes.HasPrefix(bz, armorPrefix) {
+			continue
+		}
+
+		item, err := k.legacy.Get(key)
+		if err != nil {
+			return fmt.Errorf("failed to read keyring item %s: %w", key, err)
+		}
+		if err := k.Set(item); err != nil {
+			return fmt.Errorf("failed to migrate keyring item %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// GetMetadata implements keyring.Keyring. As all the data of an item is
+// encrypted, only its modification time is returned.
+func (k *fileKeyring) GetMetadata(key string) (keyring.Metadata, error) {
+	filename, err := k.filename(key)
+	if err != nil {
+		return keyring.Metadata{}, err
+	}
+
+	stat, err := os.Stat(filename)
+	if os.IsNotExist(err) {
+		return keyring.Metadata{}, keyring.ErrKeyNotFound
+	} else if err != nil {
+		return keyring.Metadata{}, err
+	}
+
+	return keyring.Metadata{ModificationTime: stat.ModTime()}, nil
+}
+
+// Set implements keyring.Keyring.
+func (k *fileKeyring) Set(item keyring.Item) error {
+	payload, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+
+	armor, err := crypto.EncryptArmorV2(blockTypeKeyringItem, nil, payload, crypto.DefaultArgon2Params, k.itemSalt(), k.deriveKey)
+	if err != nil {
+		return err
+	}
+
+	filename, err := k.filename(item.Key)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, []byte(armor), 0o600)
+}
+
+// Remove implements keyring.Keyring.
+func (k *fileKeyring) Remove(key string) error {
+	filename, err := k.filename(key)
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(filename)
+}
+
+// Keys implements keyring.Keyring.
+func (k *fileKeyring) Keys() ([]string, error) {
+	dir, err := k.resolveDir()
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(files))
+	for _, f := range files {
+		keys = append(keys, percent.Decode(f.Name()))
+	}
+
+	return keys, nil
+}
+
+// deriveKey implements crypto.KeyDeriver with the keyring passphrase, caching
+// the derived keys.
+func (k *fileKeyring) deriveKey(params crypto.Argon2Params, salt []byte) ([]byte, error) {
+	password, err := k.unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	cacheKey := fmt.Sprintf("%d/%d/%d/%X", params.Time, params.Memory, params.Threads, salt)
+	if key, ok := k.keys[cacheKey]; ok {
+		return key, nil
+	}
+
+	key, err := crypto.PassphraseKeyDeriver(password)(params, salt)
+	if err != nil {
+		return nil, err
+	}
+	k.keys[cacheKey] = key
+
+	// reuse the salt of the items read for the items written
+	if k.salt == nil && params == crypto.DefaultArgon2Params {
+		k.salt = salt
+	}
+
+	return key, nil
+}
+
+// itemSalt returns the salt of the items written.
+func (k *fileKeyring) itemSalt() []byte {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.salt == nil {
+		k.salt = cmtcrypto.CRandBytes(16)
+	}
+
+	return k.salt
+}
+
+// unlock returns the keyring passphrase, prompting for it the first time.
+func (k *fileKeyring) unlock() (string, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.password != "" {
+		return k.password, nil
+	}
+
+	dir, err := k.resolveDir()
+	if err != nil {
+		return "", err
+	}
+
+	password, err := k.passwordFunc(fmt.Sprintf("Enter passphrase to unlock %q", dir))
+	if err != nil {
+		return "", err
+	}
+	k.password = password
+
+	return password, nil
+}
+
+func (k *fileKeyring) filename(key string) (string, error) {
+	dir, err := k.resolveDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, percent.Encode(key, "/")), nil
+}
+
+func (k *fileKeyring) resolveDir() (string, error) {
+	if k.dir == "" {
+		return "", fmt.Errorf("no directory provided for file keyring")
+	}
+
+	dir, err := keyring.ExpandTilde(k.dir)
+	if err != nil {
+		return "", err
+	}
+
+	return dir, os.MkdirAll(dir, 0o700)
+}
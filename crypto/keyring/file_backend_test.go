@@ -0,0 +1,79 @@
+package keyring
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/99designs/keyring"
+	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestFileKeyring(t *testing.T) {
+	dir := t.TempDir()
+	config := func(password string) keyring.Config {
+		return keyring.Config{
+			AllowedBackends:  []keyring.BackendType{keyring.FileBackend},
+			ServiceName:      "cosmos",
+			FileDir:          dir,
+			FilePasswordFunc: func(string) (string, error) { return password, nil },
+		}
+	}
+
+	// an item stored by the legacy file keyring
+	legacy, err := keyring.Open(config("password"))
+	require.NoError(t, err)
+	require.NoError(t, legacy.Set(keyring.Item{Key: "legacy.info", Data: []byte("legacy")}))
+	// next to the keyhash file of the passphrase, which is not an item
+	keyhash := []byte("$2a$10$keyhash")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "keyhash"), keyhash, 0o600))
+
+	db, err := newFileKeyring(config("password"))
+	require.NoError(t, err)
+	require.NoError(t, db.Set(keyring.Item{Key: "new.info", Data: []byte("new")}))
+
+	keys, err := db.Keys()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"keyhash", "legacy.info", "new.info"}, keys)
+
+	// is read as is
+	item, err := db.Get("legacy.info")
+	require.NoError(t, err)
+	require.Equal(t, []byte("legacy"), item.Data)
+	bz, err := os.ReadFile(filepath.Join(dir, "legacy.info"))
+	require.NoError(t, err)
+	require.False(t, bytes.HasPrefix(bz, []byte("-----BEGIN ")))
+
+	// and re-encrypted when migrated, leaving the keyhash file as is
+	require.NoError(t, db.(*fileKeyring).migrateLegacyItems())
+	bz, err = os.ReadFile(filepath.Join(dir, "keyhash"))
+	require.NoError(t, err)
+	require.Equal(t, keyhash, bz)
+	for _, key := range []string{"legacy.info", "new.info"} {
+		bz, err := os.ReadFile(filepath.Join(dir, key))
+		require.NoError(t, err)
+		require.True(t, bytes.HasPrefix(bz, []byte("-----BEGIN COSMOS KEYRING ITEM-----")), key)
+	}
+
+	db, err = newFileKeyring(config("password"))
+	require.NoError(t, err)
+	for key, data := range map[string]string{"legacy.info": "legacy", "new.info": "new"} {
+		item, err := db.Get(key)
+		require.NoError(t, err)
+		require.Equal(t, []byte(data), item.Data)
+	}
+
+	db, err = newFileKeyring(config("wrongpassword"))
+	require.NoError(t, err)
+	_, err = db.Get("new.info")
+	require.ErrorIs(t, err, sdkerrors.ErrWrongPassword)
+
+	require.NoError(t, db.Remove("new.info"))
+	_, err = db.Get("new.info")
+	require.ErrorIs(t, err, keyring.ErrKeyNotFound)
+	_, err = db.GetMetadata("new.info")
+	require.ErrorIs(t, err, keyring.ErrKeyNotFound)
+}
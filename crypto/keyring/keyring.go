@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/99designs/keyring"
 	"github.com/cockroachdb/errors"
@@ -21,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/ledger"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -57,7 +59,7 @@ var (
 
 // Keyring exposes operations over a backend supported by github.com/99designs/keyring.
 type Keyring interface {
-	// Get the backend type used in the keyring config: "file", "os", "kwallet", "pass", "test", "memory".
+	// Get the backend type used in the keyring config: "file", "os", "kwallet", "pass", "test", "memory", "remote".
 	Backend() string
 	// List all keys.
 	List() ([]*Record, error)
@@ -151,6 +153,15 @@ type Exporter interface {
 // Option overrides keyring configuration options.
 type Option func(options *Options)
 
+// WithSupportedAlgos adds signing algorithms to the ones supported by default.
+// It lets an app opt in the hd.Ed25519 algorithm, whose keys can only sign the
+// transactions of the chains accepting ed25519 account public keys.
+func WithSupportedAlgos(algos ...SignatureAlgo) Option {
+	return func(options *Options) {
+		options.SupportedAlgos = append(options.SupportedAlgos, algos...)
+	}
+}
+
 // NewInMemory creates a transient keyring useful for testing
 // purposes and on-the-fly key generation.
 // Keybase options can be applied when generating this new Keybase.
@@ -166,7 +177,7 @@ func NewInMemoryWithKeyring(kr keyring.Keyring, cdc codec.Codec, opts ...Option)
 
 // New creates a new instance of a keyring.
 // Keyring options can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "remote".
 func newKeyringGeneric(
 	appName, backend, rootDir string, userInput io.Reader, cdc codec.Codec, opts ...Option,
 ) (Keyring, error) {
@@ -178,10 +189,12 @@ func newKeyringGeneric(
 	switch backend {
 	case BackendMemory:
 		return NewInMemory(cdc, opts...), err
+	case BackendRemote:
+		return newRemoteFromOptions(cdc, opts...)
 	case BackendTest:
 		db, err = keyring.Open(newTestBackendKeyringConfig(appName, rootDir))
 	case BackendFile:
-		db, err = keyring.Open(newFileBackendKeyringConfig(appName, rootDir, userInput))
+		db, err = newFileKeyring(newFileBackendKeyringConfig(appName, rootDir, userInput))
 	case BackendOS:
 		db, err = keyring.Open(newOSBackendKeyringConfig(appName, rootDir, userInput))
 	case BackendKWallet:
@@ -210,9 +223,13 @@ func newKeystore(kr keyring.Keyring, cdc codec.Codec, backend string, opts ...Op
 	// Default options for keybase, these can be overwritten using the
 	// Option function
 	options := Options{
-		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1, hd.Secp256r1},
 		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
 	}
+	// bls12_381 keys are only usable when built in
+	if bls12_381.Enabled {
+		options.SupportedAlgos = append(options.SupportedAlgos, hd.Bls12_381)
+	}
 
 	for _, optionFn := range opts {
 		optionFn(&options)
@@ -280,8 +297,30 @@ func (ks keystore) ExportPubKeyArmorByAddress(address sdk.Address) (string, erro
 	return ks.ExportPubKeyArmor(k.Name)
 }
 
-// ExportPrivKeyArmor exports encrypted privKey
+// ExportPrivKeyArmor exports encrypted privKey in the v2 armor format.
 func (ks keystore) ExportPrivKeyArmor(uid, encryptPassphrase string) (armor string, err error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	priv, err := extractPrivKeyFromRecord(k)
+	if err != nil {
+		return "", err
+	}
+
+	local := k.GetLocal()
+	metadata := crypto.KeyMetadata{Algo: priv.Type(), HDPath: local.HdPath}
+	if local.CreatedAt != nil {
+		metadata.CreatedAt = *local.CreatedAt
+	}
+
+	return crypto.EncryptArmorPrivKeyV2(priv, encryptPassphrase, metadata), nil
+}
+
+// ExportPrivKeyArmorLegacy exports encrypted privKey in the legacy armor
+// format, which former versions can import.
+func (ks keystore) ExportPrivKeyArmorLegacy(uid, encryptPassphrase string) (armor string, err error) {
 	priv, err := ks.ExportPrivateKeyObject(uid)
 	if err != nil {
 		return "", err
@@ -321,12 +360,12 @@ func (ks keystore) ImportPrivKey(uid, armor, passphrase string) error {
 		}
 	}
 
-	privKey, _, err := crypto.UnarmorDecryptPrivKey(armor, passphrase)
+	privKey, metadata, err := crypto.UnarmorDecryptPrivKeyWithMetadata(armor, passphrase)
 	if err != nil {
 		return errorsmod.Wrap(err, "failed to decrypt private key")
 	}
 
-	_, err = ks.writeLocalKey(uid, privKey)
+	_, err = ks.writeLocalKey(uid, privKey, metadata.HDPath, metadata.CreatedAt)
 	if err != nil {
 		return err
 	}
@@ -350,7 +389,7 @@ func (ks keystore) ImportPrivKeyHex(uid, privKey, algoStr string) error {
 		return err
 	}
 	priv := algo.Generate()(decodedPriv)
-	_, err = ks.writeLocalKey(uid, priv)
+	_, err = ks.writeLocalKey(uid, priv, "", time.Time{})
 	if err != nil {
 		return err
 	}
@@ -593,7 +632,7 @@ func (ks keystore) NewAccount(name, mnemonic, bip39Passphrase, hdPath string, al
 		return nil, ErrDuplicatedAddress
 	}
 
-	return ks.writeLocalKey(name, privKey)
+	return ks.writeLocalKey(name, privKey, hdPath, time.Now())
 }
 
 func (ks keystore) isSupportedSigningAlgo(algo SignatureAlgo) bool {
@@ -794,12 +833,21 @@ func newRealPrompt(dir string, buf io.Reader) func(string) (string, error) {
 	}
 }
 
-func (ks keystore) writeLocalKey(name string, privKey types.PrivKey) (*Record, error) {
+// writeLocalKey persists a local key, with the HD path it was derived with and
+// its creation time if known.
+func (ks keystore) writeLocalKey(name string, privKey types.PrivKey, hdPath string, createdAt time.Time) (*Record, error) {
 	k, err := NewLocalRecord(name, privKey, privKey.PubKey())
 	if err != nil {
 		return nil, err
 	}
 
+	local := k.GetLocal()
+	local.HdPath = hdPath
+	if !createdAt.IsZero() {
+		createdAt = createdAt.UTC()
+		local.CreatedAt = &createdAt
+	}
+
 	return k, ks.writeRecord(k)
 }
 
@@ -930,6 +978,24 @@ func (ks keystore) MigrateAll() ([]*Record, error) {
 	return recs, nil
 }
 
+// MigrateFileBackend re-encrypts the items stored by former versions in the
+// keyring of the file backend in the v2 armor format. The migration is
+// one-way: former versions can not read the migrated items. It does nothing
+// for the other backends.
+func MigrateFileBackend(kr Keyring) error {
+	ks, ok := kr.(keystore)
+	if !ok {
+		return nil
+	}
+
+	db, ok := ks.db.(*fileKeyring)
+	if !ok {
+		return nil
+	}
+
+	return db.migrateLegacyItems()
+}
+
 // migrate converts keyring.Item from amino to proto serialization format.
 // the `key` argument can be a key uid (e.g. "alice") or with the '.info'
 // suffix (e.g. "alice.info").
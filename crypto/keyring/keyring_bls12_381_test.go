@@ -0,0 +1,44 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package keyring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestInMemoryBls12_381(t *testing.T) {
+	cstore := NewInMemory(getCodec())
+
+	supported, _ := cstore.SupportedAlgorithms()
+	require.True(t, supported.Contains(hd.Bls12_381))
+
+	k, _, err := cstore.NewMnemonic("bls", English, types.FullFundraiserPath, DefaultBIP39Passphrase, hd.Bls12_381)
+	require.NoError(t, err)
+	pubKey, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.IsType(t, &bls12_381.PubKey{}, pubKey)
+
+	msg := []byte("msg")
+	sig, signPubKey, err := cstore.Sign("bls", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(signPubKey))
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	// the key is restored from its armor
+	armor, err := cstore.ExportPrivKeyArmor("bls", "passphrase")
+	require.NoError(t, err)
+	require.NoError(t, cstore.Delete("bls"))
+	require.NoError(t, cstore.ImportPrivKey("bls", armor, "passphrase"))
+	k, err = cstore.Key("bls")
+	require.NoError(t, err)
+	restored, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(restored))
+}
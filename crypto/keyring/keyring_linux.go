@@ -7,6 +7,7 @@ import (
 	"io"
 
 	"github.com/99designs/keyring"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/ledger"
@@ -40,6 +41,10 @@ type Options struct {
 	LedgerSigSkipDERConv bool
 	// KeyctlScope defines the scope of the keyctl's keyring.
 	KeyctlScope string
+	// RemoteSignerAddr defines the address of the signer of the remote backend.
+	RemoteSignerAddr string
+	// RemoteSignerDialOptions defines the options used to dial the remote signer.
+	RemoteSignerDialOptions []grpc.DialOption
 }
 
 func newKeyctlBackendConfig(appName, _ string, _ io.Reader, opts ...Option) keyring.Config {
@@ -60,7 +65,7 @@ func newKeyctlBackendConfig(appName, _ string, _ io.Reader, opts ...Option) keyr
 
 // New creates a new instance of a keyring.
 // Keyring options can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "keyctl".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "remote", "keyctl".
 func New(
 	appName, backend, rootDir string, userInput io.Reader, cdc codec.Codec, opts ...Option,
 ) (Keyring, error) {
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/99designs/keyring"
 	cmtcrypto "github.com/cometbft/cometbft/crypto"
@@ -39,6 +40,7 @@ const (
 
 func init() {
 	crypto.BcryptSecurityParameter = 1
+	crypto.DefaultArgon2Params = crypto.Argon2Params{Time: 1, Memory: 64, Threads: 1}
 }
 
 func getCodec() codec.Codec {
@@ -694,6 +696,12 @@ func TestExportImportPrivKeyArmor(t *testing.T) {
 			importedAddr, err := importedRecord.GetAddress()
 			require.NoError(t, err)
 			require.True(t, addr.Equals(importedAddr))
+
+			// the metadata of the key is exported along with it
+			require.Equal(t, sdk.FullFundraiserPath, record.GetLocal().HdPath)
+			require.NotNil(t, record.GetLocal().CreatedAt)
+			require.Equal(t, sdk.FullFundraiserPath, importedRecord.GetLocal().HdPath)
+			require.Equal(t, record.GetLocal().CreatedAt.Truncate(time.Second), *importedRecord.GetLocal().CreatedAt)
 		})
 	}
 }
@@ -1193,6 +1201,54 @@ func TestInMemoryWithKeyring(t *testing.T) {
 	})
 }
 
+func TestInMemorySLIP10(t *testing.T) {
+	// ed25519 keys are opt-in
+	supported, _ := NewInMemory(getCodec()).SupportedAlgorithms()
+	require.False(t, supported.Contains(hd.Ed25519))
+	require.True(t, supported.Contains(hd.Secp256r1))
+
+	cstore := NewInMemory(getCodec(), WithSupportedAlgos(hd.Ed25519))
+	supported, _ = cstore.SupportedAlgorithms()
+	require.True(t, supported.Contains(hd.Ed25519))
+
+	// ed25519 keys are only derived on hardened paths
+	_, _, err := cstore.NewMnemonic("ed25519", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Ed25519)
+	require.ErrorContains(t, err, "ed25519 only supports hardened derivation")
+
+	for _, tc := range []struct {
+		algo SignatureAlgo
+		path string
+	}{
+		{hd.Ed25519, hd.CreateHardenedHDPath(sdk.CoinType, 0, 0)},
+		{hd.Secp256r1, sdk.FullFundraiserPath},
+	} {
+		uid := string(tc.algo.Name())
+		k, _, err := cstore.NewMnemonic(uid, English, tc.path, DefaultBIP39Passphrase, tc.algo)
+		require.NoError(t, err)
+		pubKey, err := k.GetPubKey()
+		require.NoError(t, err)
+		require.Equal(t, uid, pubKey.Type())
+
+		msg := []byte("msg")
+		sig, signPubKey, err := cstore.Sign(uid, msg, signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		require.True(t, pubKey.Equals(signPubKey))
+		require.True(t, pubKey.VerifySignature(msg, sig))
+
+		// the key is restored from its armor
+		armor, err := cstore.ExportPrivKeyArmor(uid, "passphrase")
+		require.NoError(t, err)
+		require.NoError(t, cstore.Delete(uid))
+		require.NoError(t, cstore.ImportPrivKey(uid, armor, "passphrase"))
+		k, err = cstore.Key(uid)
+		require.NoError(t, err)
+		restored, err := k.GetPubKey()
+		require.NoError(t, err)
+		require.True(t, pubKey.Equals(restored))
+		require.Equal(t, tc.path, k.GetLocal().HdPath)
+	}
+}
+
 func TestInMemoryCreateMultisig(t *testing.T) {
 	cdc := getCodec()
 	kb, err := New("keybasename", "memory", "", nil, cdc)
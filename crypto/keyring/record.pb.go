@@ -7,17 +7,21 @@ import (
 	fmt "fmt"
 	hd "github.com/cosmos/cosmos-sdk/crypto/hd"
 	_ "github.com/cosmos/gogoproto/gogoproto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	any "github.com/cosmos/gogoproto/types/any"
 	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -147,6 +151,10 @@ func (*Record) XXX_OneofWrappers() []interface{} {
 // Local item
 type Record_Local struct {
 	PrivKey *any.Any `protobuf:"bytes,1,opt,name=priv_key,json=privKey,proto3" json:"priv_key,omitempty"`
+	// hd_path is the HD path the private key was derived with, if any.
+	HdPath string `protobuf:"bytes,3,opt,name=hd_path,json=hdPath,proto3" json:"hd_path,omitempty"`
+	// created_at is the creation time of the key, if known.
+	CreatedAt *time.Time `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
 }
 
 func (m *Record_Local) Reset()         { *m = Record_Local{} }
@@ -307,33 +315,37 @@ func init() {
 }
 
 var fileDescriptor_36d640103edea005 = []byte{
-	// 411 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbd, 0xae, 0xd3, 0x30,
-	0x1c, 0xc5, 0x1d, 0x6e, 0x3e, 0xb8, 0x66, 0xb3, 0xee, 0x10, 0x22, 0x64, 0x55, 0x48, 0x40, 0x25,
-	0x54, 0x5b, 0x85, 0x0e, 0x4c, 0x95, 0x5a, 0x31, 0x14, 0x95, 0x8a, 0x2a, 0x23, 0x0b, 0xca, 0x87,
-	0x9b, 0x44, 0x4d, 0xe2, 0xc8, 0x49, 0x2a, 0xe5, 0x2d, 0x18, 0x79, 0xa4, 0x8e, 0x1d, 0x19, 0xa1,
-	0xd9, 0x78, 0x0a, 0x64, 0x3b, 0x1d, 0xa8, 0x04, 0x65, 0x8a, 0x23, 0xff, 0xce, 0xff, 0x9c, 0x63,
-	0xfd, 0xe1, 0x8b, 0x88, 0xd7, 0x05, 0xaf, 0x69, 0x24, 0xba, 0xaa, 0xe1, 0x74, 0xcf, 0x3a, 0x91,
-	0x95, 0x09, 0x3d, 0x4c, 0xa9, 0x60, 0x11, 0x17, 0x31, 0xa9, 0x04, 0x6f, 0x38, 0x72, 0x35, 0x46,
-	0x34, 0x46, 0x06, 0x8c, 0x1c, 0xa6, 0xde, 0x43, 0xc2, 0x13, 0xae, 0x20, 0x2a, 0x4f, 0x9a, 0xf7,
-	0x9e, 0x26, 0x9c, 0x27, 0x39, 0xa3, 0xea, 0x2f, 0x6c, 0x77, 0x34, 0x28, 0xbb, 0xe1, 0xea, 0xd9,
-	0x9f, 0x8e, 0x69, 0x2c, 0xcd, 0xd2, 0xc1, 0xe8, 0xf9, 0xaf, 0x3b, 0x68, 0xfb, 0xca, 0x19, 0x21,
-	0x68, 0x96, 0x41, 0xc1, 0x5c, 0x63, 0x64, 0x8c, 0xef, 0x7d, 0x75, 0x46, 0x13, 0xe8, 0x54, 0x6d,
-	0xf8, 0x65, 0xcf, 0x3a, 0xf7, 0xd1, 0xc8, 0x18, 0x3f, 0x79, 0xf3, 0x40, 0xb4, 0x13, 0xb9, 0x38,
-	0x91, 0x45, 0xd9, 0xf9, 0x76, 0xd5, 0x86, 0x6b, 0xd6, 0xa1, 0x39, 0xb4, 0x72, 0x1e, 0x05, 0xb9,
-	0x7b, 0xa7, 0xe0, 0x97, 0xe4, 0x6f, 0x35, 0x88, 0xf6, 0x24, 0x1f, 0x25, 0xbd, 0x02, 0xbe, 0x96,
-	0xa1, 0x05, 0xb4, 0x73, 0x16, 0x27, 0x4c, 0xb8, 0xa6, 0x1a, 0xf0, 0xea, 0xf6, 0x00, 0x85, 0xaf,
-	0x80, 0x3f, 0x08, 0x65, 0x84, 0xa2, 0xcd, 0x9b, 0xcc, 0xb5, 0xfe, 0x33, 0xc2, 0x46, 0xd2, 0x32,
-	0x82, 0x92, 0xa1, 0xf7, 0xd0, 0xe1, 0xbb, 0x5d, 0x9e, 0x95, 0xcc, 0xb5, 0xd5, 0x84, 0xf1, 0xcd,
-	0x09, 0x9f, 0x34, 0xbf, 0x02, 0xfe, 0x45, 0xea, 0xbd, 0x83, 0x96, 0xaa, 0x86, 0x28, 0x7c, 0x5c,
-	0x89, 0xec, 0xa0, 0x5e, 0xd0, 0xf8, 0xc7, 0x0b, 0x3a, 0x92, 0x5a, 0xb3, 0xce, 0x9b, 0x43, 0x5b,
-	0x77, 0x42, 0x33, 0x68, 0x56, 0x41, 0x93, 0x0e, 0xb2, 0xd1, 0x55, 0x8c, 0x34, 0x96, 0x09, 0x96,
-	0x1f, 0xb6, 0xb3, 0xd9, 0x36, 0x10, 0x41, 0x51, 0xfb, 0x8a, 0xf6, 0x1c, 0x68, 0xa9, 0x46, 0xde,
-	0x3d, 0x74, 0x86, 0x60, 0x4b, 0x1b, 0x9a, 0x59, 0xc3, 0x8a, 0xe5, 0xe6, 0xf8, 0x13, 0x83, 0xe3,
-	0x19, 0x1b, 0xa7, 0x33, 0x36, 0x7e, 0x9c, 0xb1, 0xf1, 0xb5, 0xc7, 0xe0, 0x5b, 0x8f, 0xc1, 0xa9,
-	0xc7, 0xe0, 0x7b, 0x8f, 0xc1, 0xe7, 0xd7, 0x49, 0xd6, 0xa4, 0x6d, 0x48, 0x22, 0x5e, 0xd0, 0xcb,
-	0xde, 0xa8, 0xcf, 0xa4, 0x8e, 0xf7, 0x57, 0x4b, 0x1b, 0xda, 0xaa, 0xc1, 0xdb, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x47, 0x24, 0x2f, 0xa9, 0xd4, 0x02, 0x00, 0x00,
+	// 478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x93, 0x2d, 0x4d, 0x56, 0x73, 0x41, 0xd6, 0x24, 0x42, 0x84, 0xb2, 0x0a, 0x09, 0xa8,
+	0x84, 0x66, 0x6b, 0xd0, 0xf3, 0x50, 0x2b, 0x0e, 0xe5, 0xcf, 0x44, 0x65, 0x71, 0xe2, 0x52, 0x39,
+	0x89, 0x9b, 0x44, 0x4d, 0xe2, 0xc8, 0x71, 0x2a, 0xe5, 0x5b, 0xec, 0xb8, 0x8f, 0x34, 0x89, 0xcb,
+	0x8e, 0xdc, 0x80, 0xf6, 0x8b, 0x20, 0xdb, 0xe9, 0x81, 0x22, 0x18, 0xa7, 0xba, 0xf2, 0xef, 0x7d,
+	0xde, 0xe7, 0x79, 0x92, 0x80, 0x67, 0x31, 0x6f, 0x4a, 0xde, 0xe0, 0x58, 0x74, 0xb5, 0xe4, 0x78,
+	0xcd, 0x3a, 0x91, 0x57, 0x29, 0xde, 0x5c, 0x60, 0xc1, 0x62, 0x2e, 0x12, 0x54, 0x0b, 0x2e, 0x39,
+	0xf4, 0x0d, 0x86, 0x0c, 0x86, 0x7a, 0x0c, 0x6d, 0x2e, 0x82, 0xd3, 0x94, 0xa7, 0x5c, 0x43, 0x58,
+	0x9d, 0x0c, 0x1f, 0x3c, 0x4e, 0x39, 0x4f, 0x0b, 0x86, 0xf5, 0xbf, 0xa8, 0x5d, 0x61, 0x5a, 0x75,
+	0xfd, 0xd5, 0xd9, 0xe1, 0x95, 0xcc, 0x4b, 0xd6, 0x48, 0x5a, 0xd6, 0x3d, 0xf0, 0xe4, 0x77, 0x4b,
+	0x59, 0xa2, 0xdc, 0x64, 0xbd, 0x93, 0xa7, 0x5f, 0x1d, 0xe0, 0x12, 0x6d, 0x0d, 0x42, 0xe0, 0x54,
+	0xb4, 0x64, 0xbe, 0x3d, 0xb2, 0xc7, 0x43, 0xa2, 0xcf, 0xf0, 0x1c, 0x78, 0x75, 0x1b, 0x2d, 0xd7,
+	0xac, 0xf3, 0x8f, 0x46, 0xf6, 0xf8, 0xc1, 0xab, 0x53, 0x64, 0xf6, 0xa1, 0xfd, 0x3e, 0x34, 0xad,
+	0x3a, 0xe2, 0xd6, 0x6d, 0xf4, 0x81, 0x75, 0xf0, 0x12, 0x0c, 0x0a, 0x1e, 0xd3, 0xc2, 0x3f, 0xd6,
+	0xf0, 0x73, 0xf4, 0xb7, 0x9c, 0xc8, 0xec, 0x44, 0x1f, 0x15, 0x3d, 0xb7, 0x88, 0x19, 0x83, 0x53,
+	0xe0, 0x16, 0x2c, 0x49, 0x99, 0xf0, 0x1d, 0x2d, 0xf0, 0xe2, 0x7e, 0x01, 0x8d, 0xcf, 0x2d, 0xd2,
+	0x0f, 0x2a, 0x0b, 0x65, 0x5b, 0xc8, 0xdc, 0x1f, 0xfc, 0xa7, 0x85, 0x2b, 0x45, 0x2b, 0x0b, 0x7a,
+	0x0c, 0xbe, 0x05, 0x1e, 0x5f, 0xad, 0x8a, 0xbc, 0x62, 0xbe, 0xab, 0x15, 0xc6, 0xf7, 0x2a, 0x7c,
+	0x32, 0xfc, 0xdc, 0x22, 0xfb, 0xd1, 0xe0, 0xc6, 0x06, 0x03, 0x9d, 0x0d, 0x62, 0x70, 0x52, 0x8b,
+	0x7c, 0xa3, 0x2b, 0xb4, 0xff, 0x51, 0xa1, 0xa7, 0x28, 0xd5, 0xe1, 0x23, 0xe0, 0x65, 0xc9, 0xb2,
+	0xa6, 0x32, 0xd3, 0x2d, 0x0e, 0x89, 0x9b, 0x25, 0x0b, 0x2a, 0x33, 0xf8, 0x06, 0x80, 0x58, 0x30,
+	0x2a, 0x59, 0xb2, 0xa4, 0xb2, 0x2f, 0x28, 0xf8, 0x43, 0xeb, 0xf3, 0xfe, 0xf1, 0xcf, 0x9c, 0xeb,
+	0xef, 0x67, 0x36, 0x19, 0xf6, 0x33, 0x53, 0xf9, 0xde, 0x39, 0x39, 0x7a, 0x78, 0x1c, 0x5c, 0x02,
+	0xd7, 0x94, 0x06, 0x27, 0xc0, 0xd1, 0x6b, 0x8c, 0xad, 0xd1, 0x41, 0xce, 0x2c, 0x51, 0x11, 0x67,
+	0xef, 0x16, 0x93, 0xc9, 0x82, 0x0a, 0x5a, 0x36, 0x44, 0xd3, 0x81, 0x07, 0x06, 0xba, 0xb2, 0x60,
+	0x08, 0xbc, 0x3e, 0xf9, 0xcc, 0x05, 0x4e, 0x2e, 0x59, 0x39, 0xbb, 0xba, 0xfd, 0x19, 0x5a, 0xb7,
+	0xdb, 0xd0, 0xbe, 0xdb, 0x86, 0xf6, 0x8f, 0x6d, 0x68, 0x5f, 0xef, 0x42, 0xeb, 0x66, 0x17, 0x5a,
+	0x77, 0xbb, 0xd0, 0xfa, 0xb6, 0x0b, 0xad, 0x2f, 0x2f, 0xd3, 0x5c, 0x66, 0x6d, 0x84, 0x62, 0x5e,
+	0xe2, 0xfd, 0x8b, 0xa9, 0x7f, 0xce, 0x9b, 0x64, 0x7d, 0xf0, 0xd9, 0x44, 0xae, 0x4e, 0xf5, 0xfa,
+	0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc4, 0xc0, 0x3d, 0x77, 0x56, 0x03, 0x00, 0x00,
 }
 
 func (m *Record) Marshal() (dAtA []byte, err error) {
@@ -491,6 +503,23 @@ func (m *Record_Local) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CreatedAt != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CreatedAt):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintRecord(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.HdPath) > 0 {
+		i -= len(m.HdPath)
+		copy(dAtA[i:], m.HdPath)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.HdPath)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.PrivKey != nil {
 		{
 			size, err := m.PrivKey.MarshalToSizedBuffer(dAtA[:i])
@@ -676,6 +705,14 @@ func (m *Record_Local) Size() (n int) {
 		l = m.PrivKey.Size()
 		n += 1 + l + sovRecord(uint64(l))
 	}
+	l = len(m.HdPath)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CreatedAt)
+		n += 1 + l + sovRecord(uint64(l))
+	}
 	return n
 }
 
@@ -1039,6 +1076,74 @@ func (m *Record_Local) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HdPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HdPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
@@ -0,0 +1,279 @@
+package keyring
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// BackendRemote is the backend signing with a remote signer implementing the
+// RemoteSigner gRPC service.
+const BackendRemote = "remote"
+
+var _ Keyring = remoteKeyring{}
+
+// WithRemoteSigner sets the address of the remote signer used by the remote
+// backend, and the options used to dial it. Without dial options, the
+// connection is not encrypted, which is only suitable for local signers.
+func WithRemoteSigner(addr string, dialOpts ...grpc.DialOption) Option {
+	return func(options *Options) {
+		options.RemoteSignerAddr = addr
+		options.RemoteSignerDialOptions = dialOpts
+	}
+}
+
+// NewRemote returns a keyring signing with the remote signer served on the
+// given connection. The private keys never leave the remote signer, so the
+// keys of the keyring are exposed as offline records and the operations
+// managing keys are not supported.
+func NewRemote(conn grpc.ClientConnInterface, cdc codec.Codec) Keyring {
+	return remoteKeyring{
+		client: NewRemoteSignerClient(conn),
+		cdc:    cdc,
+	}
+}
+
+// newRemoteFromOptions dials the remote signer set in the keyring options.
+func newRemoteFromOptions(cdc codec.Codec, opts ...Option) (Keyring, error) {
+	var options Options
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	if options.RemoteSignerAddr == "" {
+		return nil, errorsmod.Wrap(ErrUnknownBackend, "remote signer address is not set")
+	}
+
+	dialOpts := options.RemoteSignerDialOptions
+	if len(dialOpts) == 0 {
+		dialOpts = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+
+	conn, err := grpc.NewClient(options.RemoteSignerAddr, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't connect to remote signer %s: %w", options.RemoteSignerAddr, err)
+	}
+
+	return NewRemote(conn, cdc), nil
+}
+
+type remoteKeyring struct {
+	client RemoteSignerClient
+	cdc    codec.Codec
+}
+
+// Backend returns the remote backend.
+func (rk remoteKeyring) Backend() string {
+	return BackendRemote
+}
+
+func (rk remoteKeyring) List() ([]*Record, error) {
+	res, err := rk.client.ListKeys(context.Background(), &ListKeysRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*Record, len(res.Keys))
+	for i, key := range res.Keys {
+		records[i], err = rk.record(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return records, nil
+}
+
+// SupportedAlgorithms returns no algorithms, as keys cannot be created in a
+// remote keyring.
+func (rk remoteKeyring) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return SigningAlgoList{}, SigningAlgoList{}
+}
+
+func (rk remoteKeyring) Key(uid string) (*Record, error) {
+	res, err := rk.client.GetKey(context.Background(), &GetKeyRequest{Name: uid})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, uid)
+		}
+		return nil, err
+	}
+
+	if res.Key == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, uid)
+	}
+
+	return rk.record(res.Key)
+}
+
+func (rk remoteKeyring) KeyByAddress(address sdk.Address) (*Record, error) {
+	records, err := rk.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		addr, err := record.GetAddress()
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(addr, address.Bytes()) {
+			return record, nil
+		}
+	}
+
+	return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "key with address %s not found", address)
+}
+
+func (rk remoteKeyring) Sign(uid string, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	res, err := rk.client.Sign(context.Background(), &SignRequest{
+		Name:     uid,
+		SignMode: signMode,
+		Payload:  msg,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, uid)
+		}
+		return nil, nil, err
+	}
+
+	pk, err := rk.pubKey(res.PubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res.Signature, pk, nil
+}
+
+func (rk remoteKeyring) SignByAddress(address sdk.Address, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	k, err := rk.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rk.Sign(k.Name, msg, signMode)
+}
+
+func (rk remoteKeyring) ExportPubKeyArmor(uid string) (string, error) {
+	k, err := rk.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := k.GetPubKey()
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := rk.cdc.MarshalInterface(key)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(bz, key.Type()), nil
+}
+
+func (rk remoteKeyring) ExportPubKeyArmorByAddress(address sdk.Address) (string, error) {
+	k, err := rk.KeyByAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return rk.ExportPubKeyArmor(k.Name)
+}
+
+// MigrateAll returns no records, as remote keys are never stored locally.
+func (rk remoteKeyring) MigrateAll() ([]*Record, error) {
+	return nil, nil
+}
+
+func (rk remoteKeyring) Delete(uid string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "delete")
+}
+
+func (rk remoteKeyring) DeleteByAddress(address sdk.Address) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "delete")
+}
+
+func (rk remoteKeyring) Rename(from, to string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "rename")
+}
+
+func (rk remoteKeyring) NewMnemonic(uid string, language Language, hdPath, bip39Passphrase string, algo SignatureAlgo) (*Record, string, error) {
+	return nil, "", errorsmod.Wrap(ErrRemoteUnsupported, "create key")
+}
+
+func (rk remoteKeyring) NewAccount(uid, mnemonic, bip39Passphrase, hdPath string, algo SignatureAlgo) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "create key")
+}
+
+func (rk remoteKeyring) SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coinType, account, index uint32) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "save ledger key")
+}
+
+func (rk remoteKeyring) SaveOfflineKey(uid string, pubkey types.PubKey) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "save offline key")
+}
+
+func (rk remoteKeyring) SaveMultisig(uid string, pubkey types.PubKey) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "save multisig key")
+}
+
+func (rk remoteKeyring) ImportPrivKey(uid, armor, passphrase string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "import private key")
+}
+
+func (rk remoteKeyring) ImportPrivKeyHex(uid, privKey, algoStr string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "import private key")
+}
+
+func (rk remoteKeyring) ImportPubKey(uid, armor string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "import public key")
+}
+
+func (rk remoteKeyring) ExportPrivKeyArmor(uid, encryptPassphrase string) (string, error) {
+	return "", errorsmod.Wrap(ErrRemoteUnsupported, "export private key")
+}
+
+func (rk remoteKeyring) ExportPrivKeyArmorByAddress(address sdk.Address, encryptPassphrase string) (string, error) {
+	return "", errorsmod.Wrap(ErrRemoteUnsupported, "export private key")
+}
+
+// record returns the offline record of a remote key.
+func (rk remoteKeyring) record(key *RemoteKey) (*Record, error) {
+	pk, err := rk.pubKey(key.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewOfflineRecord(key.Name, pk)
+}
+
+func (rk remoteKeyring) pubKey(any *codectypes.Any) (types.PubKey, error) {
+	if any == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "remote signer returned no public key")
+	}
+
+	var pk types.PubKey
+	if err := rk.cdc.UnpackAny(any, &pk); err != nil {
+		return nil, err
+	}
+
+	return pk, nil
+}
@@ -0,0 +1,83 @@
+package keyring
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ RemoteSignerServer = remoteSignerServer{}
+
+// NewRemoteSignerServer returns a RemoteSigner service signing with the given
+// keyring. It is the reference implementation of the protocol of the remote
+// backend, and can serve any keyring, for instance one backed by a HSM.
+func NewRemoteSignerServer(kr Keyring) RemoteSignerServer {
+	return remoteSignerServer{kr: kr}
+}
+
+type remoteSignerServer struct {
+	kr Keyring
+}
+
+// ListKeys implements RemoteSignerServer.
+func (s remoteSignerServer) ListKeys(_ context.Context, _ *ListKeysRequest) (*ListKeysResponse, error) {
+	records, err := s.kr.List()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*RemoteKey, len(records))
+	for i, record := range records {
+		keys[i] = &RemoteKey{Name: record.Name, PubKey: record.PubKey}
+	}
+
+	return &ListKeysResponse{Keys: keys}, nil
+}
+
+// GetKey implements RemoteSignerServer.
+func (s remoteSignerServer) GetKey(_ context.Context, req *GetKeyRequest) (*GetKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	record, err := s.kr.Key(req.Name)
+	if err != nil {
+		return nil, remoteSignerError(err)
+	}
+
+	return &GetKeyResponse{Key: &RemoteKey{Name: record.Name, PubKey: record.PubKey}}, nil
+}
+
+// Sign implements RemoteSignerServer.
+func (s remoteSignerServer) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sig, pk, err := s.kr.Sign(req.Name, req.Payload, req.SignMode)
+	if err != nil {
+		return nil, remoteSignerError(err)
+	}
+
+	any, err := codectypes.NewAnyWithValue(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignResponse{Signature: sig, PubKey: any}, nil
+}
+
+// remoteSignerError converts missing keys to the NotFound status, which the
+// remote backend converts back to ErrKeyNotFound.
+func remoteSignerError(err error) error {
+	if errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	return err
+}
@@ -0,0 +1,89 @@
+package keyring
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestRemoteKeyring(t *testing.T) {
+	cdc := getCodec()
+
+	signer := NewInMemory(cdc)
+	local, _, err := signer.NewMnemonic("foo", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	RegisterRemoteSignerServer(server, NewRemoteSignerServer(signer))
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	_, err = New(t.Name(), BackendRemote, t.TempDir(), nil, cdc)
+	require.ErrorIs(t, err, ErrUnknownBackend)
+
+	kr, err := New(t.Name(), BackendRemote, t.TempDir(), nil, cdc, WithRemoteSigner(lis.Addr().String()))
+	require.NoError(t, err)
+	require.Equal(t, BackendRemote, kr.Backend())
+
+	records, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "foo", records[0].Name)
+	require.Equal(t, TypeOffline, records[0].GetType())
+
+	record, err := kr.Key("foo")
+	require.NoError(t, err)
+	localPk, err := local.GetPubKey()
+	require.NoError(t, err)
+	pk, err := record.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, localPk.Equals(pk))
+
+	_, err = kr.Key("bar")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	addr, err := local.GetAddress()
+	require.NoError(t, err)
+	record, err = kr.KeyByAddress(addr)
+	require.NoError(t, err)
+	require.Equal(t, "foo", record.Name)
+
+	msg := []byte("message")
+	sig, pk, err := kr.Sign("foo", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, localPk.Equals(pk))
+	require.True(t, pk.VerifySignature(msg, sig))
+
+	sig, _, err = kr.SignByAddress(addr, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, localPk.Equals(pk))
+	require.True(t, pk.VerifySignature(msg, sig))
+
+	_, _, err = kr.Sign("bar", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	armor, err := kr.ExportPubKeyArmor("foo")
+	require.NoError(t, err)
+	bz, _, err := crypto.UnarmorPubKeyBytes(armor)
+	require.NoError(t, err)
+	localBz, err := cdc.MarshalInterface(localPk)
+	require.NoError(t, err)
+	require.Equal(t, localBz, bz)
+
+	// keys are managed by the remote signer only
+	_, _, err = kr.NewMnemonic("bar", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.ErrorIs(t, err, ErrRemoteUnsupported)
+	require.ErrorIs(t, kr.Delete("foo"), ErrRemoteUnsupported)
+	_, err = kr.ExportPrivKeyArmor("foo", "passphrase")
+	require.ErrorIs(t, err, ErrRemoteUnsupported)
+}
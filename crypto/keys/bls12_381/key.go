@@ -14,6 +14,9 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
+// Enabled indicates if bls12_381 keys are built in.
+const Enabled = false
+
 // ===============================================================================================
 // Private Key
 // ===============================================================================================
@@ -32,6 +35,12 @@ func NewPrivateKeyFromBytes(bz []byte) (PrivKey, error) {
 	panic("not implemented, build flags are required to use bls12_381 keys")
 }
 
+// GenPrivKeyFromSecret generates a new key deterministically from the given
+// secret, which is hashed first unless it is 32 bytes long.
+func GenPrivKeyFromSecret(secret []byte) (PrivKey, error) {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
 // GenPrivKey generates a new key.
 func GenPrivKey() (PrivKey, error) {
 	panic("not implemented, build flags are required to use bls12_381 keys")
@@ -132,3 +141,20 @@ func (pubKey PubKey) Equals(other cryptotypes.PubKey) bool {
 func (pubKey PubKey) String() string {
 	return fmt.Sprintf("PubKeyBLS12_381{%X}", pubKey.Key)
 }
+
+// ===============================================================================================
+// Aggregation
+// ===============================================================================================
+
+// AggregateSignatures aggregates the given signatures into a single one.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
+// VerifyAggregateSignature verifies the aggregated signature of the given
+// messages by the given public keys, the i-th message being signed by the i-th
+// key. The messages must be distinct, which protects the aggregation against
+// rogue key attacks.
+func VerifyAggregateSignature(pubKeys []*PubKey, msgs [][]byte, sig []byte) bool {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
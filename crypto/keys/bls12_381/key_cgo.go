@@ -10,11 +10,19 @@ import (
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/tmhash"
+	blst "github.com/supranational/blst/bindings/go"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
+// Enabled indicates if bls12_381 keys are built in.
+const Enabled = true
+
+// dstMinPk is the domain separation tag of the signatures, the one used by
+// CometBFT for minimal-pubkey-size signatures.
+var dstMinPk = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_")
+
 // ===============================================================================================
 // Private Key
 // ===============================================================================================
@@ -39,6 +47,18 @@ func NewPrivateKeyFromBytes(bz []byte) (PrivKey, error) {
 	}, nil
 }
 
+// GenPrivKeyFromSecret generates a new key deterministically from the given
+// secret, which is hashed first unless it is 32 bytes long.
+func GenPrivKeyFromSecret(secret []byte) (PrivKey, error) {
+	secretKey, err := bls12381.GenPrivKeyFromSecret(secret)
+	if err != nil {
+		return PrivKey{}, err
+	}
+	return PrivKey{
+		Key: secretKey.Bytes(),
+	}, nil
+}
+
 // GenPrivKey generates a new key.
 func GenPrivKey() (PrivKey, error) {
 	secretKey, err := bls12381.GenPrivKey()
@@ -167,3 +187,57 @@ func (pubKey PubKey) Equals(other cryptotypes.PubKey) bool {
 func (pubKey PubKey) String() string {
 	return fmt.Sprintf("PubKeyBLS12_381{%X}", pubKey.Key)
 }
+
+// ===============================================================================================
+// Aggregation
+// ===============================================================================================
+
+// AggregateSignatures aggregates the given signatures into a single one.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+
+	var agg blst.P2Aggregate
+	if !agg.AggregateCompressed(sigs, true) {
+		return nil, errors.New("invalid signature")
+	}
+
+	return agg.ToAffine().Compress(), nil
+}
+
+// VerifyAggregateSignature verifies the aggregated signature of the given
+// messages by the given public keys, the i-th message being signed by the i-th
+// key. The messages must be distinct, which protects the aggregation against
+// rogue key attacks.
+func VerifyAggregateSignature(pubKeys []*PubKey, msgs [][]byte, sig []byte) bool {
+	if len(pubKeys) == 0 || len(pubKeys) != len(msgs) || len(sig) != bls12381.SignatureLength {
+		return false
+	}
+
+	seen := make(map[string]struct{}, len(msgs))
+	blstMsgs := make([]blst.Message, len(msgs))
+	for i, msg := range msgs {
+		if _, ok := seen[string(msg)]; ok {
+			return false
+		}
+		seen[string(msg)] = struct{}{}
+		blstMsgs[i] = msg
+	}
+
+	pks := make([]*blst.P1Affine, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		// deserialize through CometBFT for the subgroup and infinity checks
+		if _, err := bls12381.NewPublicKeyFromBytes(pubKey.Key); err != nil {
+			return false
+		}
+		pks[i] = new(blst.P1Affine).Deserialize(pubKey.Key)
+	}
+
+	signature := new(blst.P2Affine).Uncompress(sig)
+	if signature == nil {
+		return false
+	}
+
+	return signature.AggregateVerify(true, pks, false, blstMsgs, dstMinPk)
+}
@@ -0,0 +1,68 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package bls12_381_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+)
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	privKey1, err := bls12_381.GenPrivKeyFromSecret([]byte("secret"))
+	require.NoError(t, err)
+	privKey2, err := bls12_381.GenPrivKeyFromSecret([]byte("secret"))
+	require.NoError(t, err)
+	privKey3, err := bls12_381.GenPrivKeyFromSecret([]byte("other secret"))
+	require.NoError(t, err)
+
+	require.True(t, privKey1.Equals(privKey2))
+	require.False(t, privKey1.Equals(privKey3))
+}
+
+func TestAggregateSignatures(t *testing.T) {
+	var (
+		pubKeys []*bls12_381.PubKey
+		msgs    [][]byte
+		sigs    [][]byte
+	)
+	for _, msg := range []string{"msg1", "msg2", "msg3"} {
+		privKey, err := bls12_381.GenPrivKey()
+		require.NoError(t, err)
+
+		sig, err := privKey.Sign([]byte(msg))
+		require.NoError(t, err)
+
+		pubKeys = append(pubKeys, privKey.PubKey().(*bls12_381.PubKey))
+		msgs = append(msgs, []byte(msg))
+		sigs = append(sigs, sig)
+	}
+
+	aggSig, err := bls12_381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.True(t, bls12_381.VerifyAggregateSignature(pubKeys, msgs, aggSig))
+
+	// a single signature is its own aggregate
+	aggSig1, err := bls12_381.AggregateSignatures(sigs[:1])
+	require.NoError(t, err)
+	require.Equal(t, sigs[0], aggSig1)
+	require.True(t, pubKeys[0].VerifySignature(msgs[0], aggSig1))
+
+	// wrong message, missing signature, mismatched keys
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys, [][]byte{msgs[0], msgs[1], []byte("msg4")}, aggSig))
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys[:2], msgs[:2], aggSig))
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys[:2], msgs, aggSig))
+	require.False(t, bls12_381.VerifyAggregateSignature(nil, nil, aggSig))
+
+	// duplicate messages are rejected
+	sig, err := bls12_381.AggregateSignatures([][]byte{sigs[0], sigs[0]})
+	require.NoError(t, err)
+	require.False(t, bls12_381.VerifyAggregateSignature([]*bls12_381.PubKey{pubKeys[0], pubKeys[0]}, [][]byte{msgs[0], msgs[0]}, sig))
+
+	_, err = bls12_381.AggregateSignatures(nil)
+	require.Error(t, err)
+	_, err = bls12_381.AggregateSignatures([][]byte{[]byte("invalid")})
+	require.Error(t, err)
+}
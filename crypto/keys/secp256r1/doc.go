@@ -16,6 +16,10 @@ const (
 	pubKeySize = fieldSize + 1
 
 	name = "secp256r1"
+
+	// PrivKeyName is the amino route of the private key, which is only
+	// registered for the export of keyring keys.
+	PrivKeyName = "cosmos/PrivKeySecp256r1"
 )
 
 var secp256r1 elliptic.Curve
@@ -29,7 +33,9 @@ func init() {
 	}
 }
 
-// RegisterInterfaces adds secp256r1 PubKey to pubkey registry
+// RegisterInterfaces adds secp256r1 PubKey and PrivKey to the pubkey and
+// privkey registries.
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+	registry.RegisterImplementations((*cryptotypes.PrivKey)(nil), &PrivKey{})
 }
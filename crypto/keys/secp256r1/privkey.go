@@ -15,6 +15,16 @@ func GenPrivKey() (*PrivKey, error) {
 	return &PrivKey{&ecdsaSK{key}}, err
 }
 
+// NewPrivKeyFromBytes creates a secp256r1 PrivKey from its scalar bytes.
+func NewPrivKeyFromBytes(bz []byte) (*PrivKey, error) {
+	sk := &ecdsaSK{}
+	if err := sk.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	return &PrivKey{Secret: sk}, nil
+}
+
 // PubKey implements SDK PrivKey interface.
 func (m *PrivKey) PubKey() cryptotypes.PubKey {
 	return &PubKey{&ecdsaPK{m.Secret.PubKey()}}
@@ -52,6 +62,22 @@ func (m *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
 	return m.Secret.Equal(&sk2.Secret.PrivateKey)
 }
 
+// MarshalAmino overrides Amino binary marshaling.
+func (m PrivKey) MarshalAmino() ([]byte, error) {
+	return m.Bytes(), nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (m *PrivKey) UnmarshalAmino(bz []byte) error {
+	sk, err := NewPrivKeyFromBytes(bz)
+	if err != nil {
+		return err
+	}
+	m.Secret = sk.Secret
+
+	return nil
+}
+
 type ecdsaSK struct {
 	ecdsa.PrivKey
 }
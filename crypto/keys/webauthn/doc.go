@@ -0,0 +1,26 @@
+// Package webauthn implements the Cosmos-SDK compatible public key of WebAuthn
+// credentials, such as passkeys, whose signatures are WebAuthn assertions of the
+// signed bytes. The keys can be protobuf serialized and packed in Any.
+package webauthn
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const (
+	name = "webauthn"
+
+	// flagUserPresent is the user presence flag of the authenticator data.
+	flagUserPresent = 0x01
+	// minAuthenticatorDataSize is the size of the relying party id hash, the
+	// flags and the signature counter of the authenticator data.
+	minAuthenticatorDataSize = 37
+	// clientDataTypeGet is the type of the client data of assertions.
+	clientDataTypeGet = "webauthn.get"
+)
+
+// RegisterInterfaces adds webauthn PubKey to pubkey registry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+}
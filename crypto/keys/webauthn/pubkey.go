@@ -0,0 +1,162 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ErrInvalidAssertion is returned when a WebAuthn assertion does not sign the
+// given bytes with the given key.
+var ErrInvalidAssertion = errors.New("invalid webauthn assertion")
+
+var _ cryptotypes.PubKey = &PubKey{}
+
+// NewPubKey returns the public key of a credential of the given relying party
+// from its compressed secp256r1 public key.
+func NewPubKey(key []byte, relyingPartyID string) (*PubKey, error) {
+	if _, err := secp256r1.NewPubKeyFromBytes(key); err != nil {
+		return nil, err
+	}
+	if relyingPartyID == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "empty webauthn relying party id")
+	}
+
+	return &PubKey{Key: key, RelyingPartyId: relyingPartyID}, nil
+}
+
+// NewPubKeyFromPKIX returns the public key of a credential of the given relying
+// party from its DER encoded SubjectPublicKeyInfo, as returned by the
+// getPublicKey method of the attestation response of browsers.
+func NewPubKeyFromPKIX(der []byte, relyingPartyID string) (*PubKey, error) {
+	pub, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+
+	ecdsaPub, ok := pub.(*ecdsa.PublicKey)
+	if !ok || ecdsaPub.Curve != elliptic.P256() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "expected a secp256r1 public key, got %T", pub)
+	}
+
+	return NewPubKey(elliptic.MarshalCompressed(ecdsaPub.Curve, ecdsaPub.X, ecdsaPub.Y), relyingPartyID)
+}
+
+// String implements proto.Message interface.
+func (m *PubKey) String() string {
+	return fmt.Sprintf("%s{%X, %s}", name, m.Key, m.RelyingPartyId)
+}
+
+// Bytes implements SDK PubKey interface. It returns the protobuf encoding of the
+// key, which includes its relying party id.
+func (m *PubKey) Bytes() []byte {
+	if m == nil {
+		return nil
+	}
+
+	bz, err := m.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// Equals implements SDK PubKey interface.
+func (m *PubKey) Equals(other cryptotypes.PubKey) bool {
+	pk2, ok := other.(*PubKey)
+	if !ok {
+		return false
+	}
+	return bytes.Equal(m.Key, pk2.Key) && m.RelyingPartyId == pk2.RelyingPartyId
+}
+
+// Address implements SDK PubKey interface.
+func (m *PubKey) Address() cmtcrypto.Address {
+	return address.Hash(proto.MessageName(m), m.Bytes())
+}
+
+// Type returns key type name. Implements SDK PubKey interface.
+func (m *PubKey) Type() string {
+	return name
+}
+
+// VerifySignature implements SDK PubKey interface. The signature must be a
+// protobuf encoded Signature.
+func (m *PubKey) VerifySignature(msg, sig []byte) bool {
+	var assertion Signature
+	if err := assertion.Unmarshal(sig); err != nil {
+		return false
+	}
+
+	return m.VerifyAssertion(msg, &assertion) == nil
+}
+
+// VerifyAssertion checks that the given assertion signs msg with the key, i.e.
+// that its challenge is the hash of msg, that it was made for the relying party
+// of the key with the user present, and that its signature is valid.
+func (m *PubKey) VerifyAssertion(msg []byte, sig *Signature) error {
+	pk, err := secp256r1.NewPubKeyFromBytes(m.Key)
+	if err != nil {
+		return err
+	}
+
+	authData := sig.AuthenticatorData
+	if len(authData) < minAuthenticatorDataSize {
+		return fmt.Errorf("%w: authenticator data too short", ErrInvalidAssertion)
+	}
+	rpIDHash := sha256.Sum256([]byte(m.RelyingPartyId))
+	if !bytes.Equal(authData[:sha256.Size], rpIDHash[:]) {
+		return fmt.Errorf("%w: relying party is not %s", ErrInvalidAssertion, m.RelyingPartyId)
+	}
+	if authData[sha256.Size]&flagUserPresent == 0 {
+		return fmt.Errorf("%w: user not present", ErrInvalidAssertion)
+	}
+
+	var clientData struct {
+		Type      string `json:"type"`
+		Challenge string `json:"challenge"`
+	}
+	if err := json.Unmarshal(sig.ClientDataJson, &clientData); err != nil {
+		return fmt.Errorf("%w: invalid client data: %w", ErrInvalidAssertion, err)
+	}
+	if clientData.Type != clientDataTypeGet {
+		return fmt.Errorf("%w: client data type is %q", ErrInvalidAssertion, clientData.Type)
+	}
+	if clientData.Challenge != Challenge(msg) {
+		return fmt.Errorf("%w: challenge mismatch", ErrInvalidAssertion)
+	}
+
+	// the signed data is the authenticator data followed by the hash of the
+	// client data
+	clientDataHash := sha256.Sum256(sig.ClientDataJson)
+	signed := make([]byte, 0, len(authData)+sha256.Size)
+	signed = append(append(signed, authData...), clientDataHash[:]...)
+	if !pk.VerifySignature(signed, sig.Signature) {
+		return fmt.Errorf("%w: signature verification failed", ErrInvalidAssertion)
+	}
+
+	return nil
+}
+
+// Challenge returns the challenge of the assertions signing msg, as encoded in
+// their client data: the unpadded base64url encoding of the SHA-256 hash of msg.
+func Challenge(msg []byte) string {
+	h := sha256.Sum256(msg)
+	return base64.RawURLEncoding.EncodeToString(h[:])
+}
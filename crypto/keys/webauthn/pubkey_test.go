@@ -0,0 +1,133 @@
+package webauthn_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const rpID = "wallet.example.com"
+
+// assertion returns the authenticator data, client data and DER signature of
+// an assertion of msg by the given key.
+func assertion(t *testing.T, priv *ecdsa.PrivateKey, rpID string, flags byte, clientDataType string, msg []byte) ([]byte, []byte, []byte) {
+	t.Helper()
+
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	authData := append(rpIDHash[:], flags, 0, 0, 0, 1)
+	clientData := fmt.Appendf(nil, `{"type":%q,"challenge":%q,"origin":"https://%s","crossOrigin":false}`,
+		clientDataType, webauthn.Challenge(msg), rpID)
+
+	clientDataHash := sha256.Sum256(clientData)
+	h := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	der, err := ecdsa.SignASN1(rand.Reader, priv, h[:])
+	require.NoError(t, err)
+
+	return authData, clientData, der
+}
+
+func TestVerifyAssertion(t *testing.T) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	spki, err := x509.MarshalPKIXPublicKey(&priv.PublicKey)
+	require.NoError(t, err)
+	pubKey, err := webauthn.NewPubKeyFromPKIX(spki, rpID)
+	require.NoError(t, err)
+
+	msg := []byte("sign bytes")
+	sign := func(rpID string, flags byte, clientDataType string, msg []byte) []byte {
+		authData, clientData, der := assertion(t, priv, rpID, flags, clientDataType, msg)
+		sig, err := webauthn.NewSignature(authData, clientData, der)
+		require.NoError(t, err)
+		bz, err := sig.Marshal()
+		require.NoError(t, err)
+		return bz
+	}
+
+	require.True(t, pubKey.VerifySignature(msg, sign(rpID, 0x05, "webauthn.get", msg)))
+	require.False(t, pubKey.VerifySignature([]byte("other"), sign(rpID, 0x05, "webauthn.get", msg)))
+	require.False(t, pubKey.VerifySignature(msg, sign("evil.example.com", 0x05, "webauthn.get", msg)))
+	require.False(t, pubKey.VerifySignature(msg, sign(rpID, 0x04, "webauthn.get", msg)))
+	require.False(t, pubKey.VerifySignature(msg, sign(rpID, 0x05, "webauthn.create", msg)))
+	require.False(t, pubKey.VerifySignature(msg, []byte("not an assertion")))
+
+	// the same key of another relying party
+	otherPubKey, err := webauthn.NewPubKey(pubKey.Key, "other.example.com")
+	require.NoError(t, err)
+	require.False(t, otherPubKey.VerifySignature(msg, sign(rpID, 0x05, "webauthn.get", msg)))
+	require.False(t, pubKey.Equals(otherPubKey))
+	require.NotEqual(t, pubKey.Address(), otherPubKey.Address())
+
+	// high S signatures are normalized, and rejected otherwise
+	authData, clientData, der := assertion(t, priv, rpID, 0x01, "webauthn.get", msg)
+	var ecdsaSig struct{ R, S *big.Int }
+	_, err = asn1.Unmarshal(der, &ecdsaSig)
+	require.NoError(t, err)
+	order := elliptic.P256().Params().N
+	if ecdsaSig.S.Cmp(new(big.Int).Rsh(order, 1)) <= 0 {
+		ecdsaSig.S.Sub(order, ecdsaSig.S)
+	}
+	highS, err := asn1.Marshal(ecdsaSig)
+	require.NoError(t, err)
+	sig, err := webauthn.NewSignature(authData, clientData, highS)
+	require.NoError(t, err)
+	require.NoError(t, pubKey.VerifyAssertion(msg, sig))
+
+	raw := make([]byte, 64)
+	ecdsaSig.R.FillBytes(raw[:32])
+	ecdsaSig.S.FillBytes(raw[32:])
+	sig.Signature = raw
+	require.ErrorIs(t, pubKey.VerifyAssertion(msg, sig), webauthn.ErrInvalidAssertion)
+
+	_, err = webauthn.NewSignature(authData, clientData, []byte("not DER"))
+	require.ErrorIs(t, err, webauthn.ErrInvalidAssertion)
+}
+
+func TestNewPubKey(t *testing.T) {
+	_, err := webauthn.NewPubKey([]byte("invalid"), rpID)
+	require.Error(t, err)
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	key := elliptic.MarshalCompressed(priv.Curve, priv.X, priv.Y)
+	_, err = webauthn.NewPubKey(key, "")
+	require.Error(t, err)
+
+	pubKey, err := webauthn.NewPubKey(key, rpID)
+	require.NoError(t, err)
+	require.Equal(t, "webauthn", pubKey.Type())
+
+	// the key is packed in Any
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	bz, err := cdc.MarshalInterfaceJSON(pubKey)
+	require.NoError(t, err)
+	var pk cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalInterfaceJSON(bz, &pk))
+	require.True(t, pubKey.Equals(pk))
+	require.Equal(t, pubKey.Address(), pk.Address())
+
+	// keys of other curves are rejected
+	priv384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	spki, err := x509.MarshalPKIXPublicKey(&priv384.PublicKey)
+	require.NoError(t, err)
+	_, err = webauthn.NewPubKeyFromPKIX(spki, rpID)
+	require.Error(t, err)
+}
@@ -0,0 +1,51 @@
+package webauthn
+
+import (
+	"crypto/elliptic"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// NewSignature returns the signature of a WebAuthn assertion from its
+// authenticator data, its client data and its ASN.1 DER encoded signature, as
+// returned by authenticators. The signature is normalized to a low S, which
+// authenticators do not enforce.
+func NewSignature(authenticatorData, clientDataJSON, derSignature []byte) (*Signature, error) {
+	var sig struct {
+		R, S *big.Int
+	}
+	rest, err := asn1.Unmarshal(derSignature, &sig)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid DER signature: %w", ErrInvalidAssertion, err)
+	}
+	if len(rest) != 0 || sig.R.Sign() <= 0 || sig.S.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: invalid DER signature", ErrInvalidAssertion)
+	}
+
+	order := elliptic.P256().Params().N
+	if sig.R.Cmp(order) >= 0 || sig.S.Cmp(order) >= 0 {
+		return nil, fmt.Errorf("%w: invalid DER signature", ErrInvalidAssertion)
+	}
+	// low S normalization, as required by the secp256r1 keys
+	if sig.S.Cmp(new(big.Int).Rsh(order, 1)) > 0 {
+		sig.S = new(big.Int).Sub(order, sig.S)
+	}
+
+	raw := make([]byte, 64)
+	sig.R.FillBytes(raw[:32])
+	sig.S.FillBytes(raw[32:])
+
+	return &Signature{
+		AuthenticatorData: authenticatorData,
+		ClientDataJson:    clientDataJSON,
+		Signature:         raw,
+	}, nil
+}
+
+// String implements proto.Message interface.
+func (m *Signature) String() string {
+	return proto.CompactTextString(m)
+}
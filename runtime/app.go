@@ -57,6 +57,9 @@ type App struct {
 	// initChainer is the init chainer function defined by the app config.
 	// this is only required if the chain wants to add special InitChainer logic.
 	initChainer sdk.InitChainer
+	// feeEstimator tracks the finalized blocks for the fee estimation of the
+	// tx service.
+	feeEstimator *authtx.FeeEstimator
 }
 
 // RegisterModules registers the provided modules with the module manager and
@@ -147,6 +150,11 @@ func (a *App) Load(loadLatest bool) error {
 		a.ModuleManager.SetOrderMigrations(a.config.OrderMigrations...)
 	}
 
+	// the fee estimator listens to the blocks before the node starts, as the
+	// listeners are not synchronized with the block execution
+	a.feeEstimator = authtx.NewFeeEstimator(a.TxDecode, a.MinGasPrices(), authtx.DefaultFeeEstimatorBlocks)
+	a.AddABCIListener(a.feeEstimator)
+
 	if loadLatest {
 		if err := a.LoadLatestVersion(); err != nil {
 			return err
@@ -208,8 +216,9 @@ func (a *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 }
 
 // RegisterTxService implements the Application.RegisterTxService method.
+// The fee estimation of the service uses the fee estimator set up by Load.
 func (a *App) RegisterTxService(clientCtx client.Context) {
-	authtx.RegisterTxService(a.GRPCQueryRouter(), clientCtx, a.Simulate, a.interfaceRegistry)
+	authtx.RegisterTxService(a.GRPCQueryRouter(), clientCtx, a.Simulate, a.interfaceRegistry, authtx.WithFeeEstimator(a.feeEstimator))
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -10,6 +10,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 )
 
 type (
@@ -82,3 +84,22 @@ func (h *VoteExtensionHandler) VerifyVoteExtension() sdk.VerifyVoteExtensionHand
 		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
 	}
 }
+
+// wrapABCIHandlers wraps the proposal handlers and the PreBlocker of the app
+// with the ones of the upgrade readiness and of the BLS signature aggregation.
+// Both inject a transaction at the front of the proposal, so their order is
+// fixed: the readiness handlers wrap the BLS ones, hence the readiness
+// transaction comes first, followed by the BLS aggregate signature, and each
+// is stripped before the handler it wraps is called.
+func wrapABCIHandlers(
+	ak authkeeper.AccountKeeper,
+	uk *upgradekeeper.Keeper,
+	valStore baseapp.ValidatorStore,
+	prepare sdk.PrepareProposalHandler,
+	process sdk.ProcessProposalHandler,
+	preBlocker sdk.PreBlocker,
+) (sdk.PrepareProposalHandler, sdk.ProcessProposalHandler, sdk.PreBlocker) {
+	return uk.PrepareProposalHandler(ak.BLSPrepareProposalHandler(prepare)),
+		uk.ProcessProposalHandler(valStore, ak.BLSProcessProposalHandler(process)),
+		uk.PreBlocker(ak.BLSPreBlocker(preBlocker))
+}
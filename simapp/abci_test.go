@@ -0,0 +1,164 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package simapp
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/core/header"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// blsSignedTx returns a transaction signed in SIGN_MODE_DIRECT by the given
+// BLS key, whose account is created.
+func blsSignedTx(t *testing.T, app *SimApp, ctx sdk.Context, privKey bls12_381.PrivKey, memo string) []byte {
+	t.Helper()
+
+	pubKey := privKey.PubKey()
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, sdk.AccAddress(pubKey.Address()))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	anyPk, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+
+	body := tx.TxBody{Memo: memo}
+	authInfo := tx.AuthInfo{
+		SignerInfos: []*tx.SignerInfo{{
+			PublicKey: anyPk,
+			ModeInfo:  &tx.ModeInfo{Sum: &tx.ModeInfo_Single_{Single: &tx.ModeInfo_Single{Mode: signing.SignMode_SIGN_MODE_DIRECT}}},
+		}},
+		Fee: &tx.Fee{},
+	}
+	raw := tx.TxRaw{
+		BodyBytes:     app.AppCodec().MustMarshal(&body),
+		AuthInfoBytes: app.AppCodec().MustMarshal(&authInfo),
+	}
+
+	signDoc := tx.SignDoc{
+		BodyBytes:     raw.BodyBytes,
+		AuthInfoBytes: raw.AuthInfoBytes,
+		ChainId:       ctx.ChainID(),
+		AccountNumber: acc.GetAccountNumber(),
+	}
+	sig, err := privKey.Sign(app.AppCodec().MustMarshal(&signDoc))
+	require.NoError(t, err)
+	raw.Signatures = [][]byte{sig}
+
+	return app.AppCodec().MustMarshal(&raw)
+}
+
+func TestWrapABCIHandlers(t *testing.T) {
+	const chainID = "test-chain"
+	app := Setup(t, false)
+	ctx := app.NewContext(false).
+		WithChainID(chainID).
+		WithHeaderInfo(header.Info{Height: 10, ChainID: chainID}).
+		WithConsensusParams(cmtproto.ConsensusParams{Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 2}})
+	require.NoError(t, app.UpgradeKeeper.ScheduleUpgrade(ctx, upgradetypes.Plan{Name: "v2", Height: 20}))
+
+	// a validator signals its readiness in its vote extension
+	valKey := ed25519.GenPrivKey()
+	ve := app.AppCodec().MustMarshal(&upgradetypes.ReadinessVoteExtension{AppVersion: "v1.0.0", PlanName: "v2", Ready: true})
+	var cve bytes.Buffer
+	require.NoError(t, protoio.NewDelimitedWriter(&cve).WriteMsg(&cmtproto.CanonicalVoteExtension{
+		Extension: ve,
+		Height:    9,
+		ChainId:   chainID,
+	}))
+	veSig, err := valKey.Sign(cve.Bytes())
+	require.NoError(t, err)
+	validator := abci.Validator{Address: valKey.PubKey().Address(), Power: 10}
+	extCommit := abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{
+		{Validator: validator, BlockIdFlag: cmtproto.BlockIDFlagCommit, VoteExtension: ve, ExtensionSignature: veSig},
+	}}
+	ctx = ctx.WithCometInfo(baseapp.NewBlockInfo(nil, nil, nil, abci.CommitInfo{Votes: []abci.VoteInfo{{Validator: validator}}}))
+
+	valStore := mock.NewMockValidatorStore(gomock.NewController(t))
+	valPubKey, err := cryptocodec.ToCmtProtoPublicKey(valKey.PubKey())
+	require.NoError(t, err)
+	valStore.EXPECT().GetPubKeyByConsAddr(gomock.Any(), sdk.ConsAddress(validator.Address)).Return(valPubKey, nil).AnyTimes()
+
+	var txs [][]byte
+	for i := range 2 {
+		privKey, err := bls12_381.GenPrivKey()
+		require.NoError(t, err)
+		txs = append(txs, blsSignedTx(t, app, ctx, privKey, fmt.Sprintf("tx %d", i)))
+	}
+
+	var (
+		processed      [][]byte
+		preBlockCalled bool
+	)
+	prepare, process, preBlocker := wrapABCIHandlers(
+		app.AccountKeeper,
+		app.UpgradeKeeper,
+		valStore,
+		func(_ sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+			return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
+		},
+		func(_ sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+			processed = req.Txs
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+		},
+		func(sdk.Context, *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+			preBlockCalled = true
+			return &sdk.ResponsePreBlock{}, nil
+		},
+	)
+
+	// the readiness comes first, followed by the BLS aggregate signature
+	prepared, err := prepare(ctx, &abci.RequestPrepareProposal{Height: 10, MaxTxBytes: 1 << 20, Txs: txs, LocalLastCommit: extCommit})
+	require.NoError(t, err)
+	require.Len(t, prepared.Txs, len(txs)+2)
+	require.True(t, bytes.HasPrefix(prepared.Txs[0], []byte("upgrade-readiness:")))
+	require.True(t, bytes.HasPrefix(prepared.Txs[1], []byte("bls-aggregate:")))
+
+	// both are stripped before the proposal reaches the wrapped handler
+	processCtx := ctx.WithExecMode(sdk.ExecModeProcessProposal)
+	res, err := process(processCtx, &abci.RequestProcessProposal{Height: 10, Txs: prepared.Txs})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+	require.Equal(t, prepared.Txs[2:], processed)
+	for _, txBytes := range processed {
+		require.True(t, app.AccountKeeper.IsCoveredByBLSAggregate(processCtx, txBytes))
+	}
+
+	// and proposals injecting them in another order are rejected
+	swapped := append([][]byte{prepared.Txs[1], prepared.Txs[0]}, prepared.Txs[2:]...)
+	res, err = process(processCtx, &abci.RequestProcessProposal{Height: 10, Txs: swapped})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+
+	// the block aggregates the readiness and verifies the aggregate signature
+	finalizeCtx := ctx.WithExecMode(sdk.ExecModeFinalize)
+	_, err = preBlocker(finalizeCtx, &abci.RequestFinalizeBlock{Height: 10, Txs: prepared.Txs})
+	require.NoError(t, err)
+	require.True(t, preBlockCalled)
+	for _, txBytes := range prepared.Txs[2:] {
+		require.True(t, app.AccountKeeper.IsCoveredByBLSAggregate(finalizeCtx, txBytes))
+	}
+
+	readiness, err := app.UpgradeKeeper.GetUpgradeReadiness(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "v2", readiness.PlanName)
+	require.Equal(t, int64(10), readiness.ReadyPower)
+	require.Equal(t, int64(10), readiness.TotalPower)
+}
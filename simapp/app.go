@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"io"
 	"maps"
+	"os"
+	"path/filepath"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	dbm "github.com/cosmos/cosmos-db"
@@ -110,6 +112,7 @@ var (
 		authtypes.FeeCollectorName:                  nil,
 		distrtypes.ModuleName:                       nil,
 		minttypes.ModuleName:                        {authtypes.Minter},
+		stakingtypes.ModuleName:                     {authtypes.Minter, authtypes.Burner},
 		stakingtypes.BondedPoolName:                 {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName:              {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:                         {authtypes.Burner},
@@ -163,6 +166,8 @@ type SimApp struct {
 
 	// module configurator
 	configurator module.Configurator
+	// fee estimator of the tx service
+	feeEstimator *authtx.FeeEstimator
 }
 
 func init() {
@@ -230,12 +235,9 @@ func NewSimApp(
 	// }
 	// baseAppOptions = append(baseAppOptions, prepareOpt)
 
-	// create and set dummy vote extension handler
-	voteExtOp := func(bApp *baseapp.BaseApp) {
-		voteExtHandler := NewVoteExtensionHandler()
-		voteExtHandler.SetHandlers(bApp)
-	}
-	baseAppOptions = append(baseAppOptions, voteExtOp, baseapp.SetOptimisticExecution())
+	// the vote extension handlers are the ones of the upgrade readiness, set
+	// along with the proposal handlers once the keepers are created
+	baseAppOptions = append(baseAppOptions, baseapp.SetOptimisticExecution())
 
 	bApp := baseapp.NewBaseApp(appName, logger, db, txConfig.TxDecoder(), baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
@@ -265,6 +267,11 @@ func NewSimApp(
 		panic(err)
 	}
 
+	// the fee estimator listens to the blocks before the node starts, as the
+	// listeners are not synchronized with the block execution
+	feeEstimator := authtx.NewFeeEstimator(bApp.TxDecode, bApp.MinGasPrices(), authtx.DefaultFeeEstimatorBlocks)
+	bApp.AddABCIListener(feeEstimator)
+
 	app := &SimApp{
 		BaseApp:           bApp,
 		legacyAmino:       legacyAmino,
@@ -272,6 +279,7 @@ func NewSimApp(
 		txConfig:          txConfig,
 		interfaceRegistry: interfaceRegistry,
 		keys:              keys,
+		feeEstimator:      feeEstimator,
 	}
 
 	// set the BaseApp's parameter store
@@ -293,6 +301,7 @@ func NewSimApp(
 		sdk.Bech32MainPrefix,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		authkeeper.WithUnorderedTransactions(true),
+		authkeeper.WithMsgRouter(bApp.MsgServiceRouter()),
 	)
 
 	app.BankKeeper = bankkeeper.NewBaseKeeper(
@@ -403,6 +412,9 @@ func NewSimApp(
 		app.BaseApp,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	if cast.ToBool(appOpts.Get(server.FlagUpgradePreDownload)) {
+		app.UpgradeKeeper.SetPreDownload(filepath.Base(os.Args[0]))
+	}
 
 	// Register the proposal types
 	// Deprecated: Avoid adding new handlers, instead use the new proposal flow
@@ -451,13 +463,16 @@ func NewSimApp(
 	epochsKeeper := epochskeeper.NewKeeper(
 		runtime.NewKVStoreService(keys[epochstypes.StoreKey]),
 		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	app.EpochsKeeper = &epochsKeeper
 
 	app.EpochsKeeper.SetHooks(
 		epochstypes.NewMultiEpochHooks(
-		// insert epoch hooks receivers here
+			// insert epoch hooks receivers here
+			app.DistrKeeper.EpochHooks(),
+			app.MintKeeper.EpochHooks(),
 		),
 	)
 
@@ -615,7 +630,7 @@ func NewSimApp(
 
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
-	app.SetPreBlocker(app.PreBlocker)
+	app.setABCIHandlers()
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 	app.setAnteHandler(txConfig)
@@ -667,6 +682,27 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 	app.SetAnteHandler(anteHandler)
 }
 
+// setABCIHandlers sets the vote extension handlers of the upgrade readiness,
+// and wraps the default proposal handlers and the PreBlocker with the ones of
+// the upgrade readiness and of the BLS signature aggregation.
+func (app *SimApp) setABCIHandlers() {
+	app.SetExtendVoteHandler(app.UpgradeKeeper.ExtendVoteHandler())
+	app.SetVerifyVoteExtensionHandler(app.UpgradeKeeper.VerifyVoteExtensionHandler())
+
+	abciPropHandler := baseapp.NewDefaultProposalHandler(app.Mempool(), app.BaseApp)
+	prepare, process, preBlocker := wrapABCIHandlers(
+		app.AccountKeeper,
+		app.UpgradeKeeper,
+		app.StakingKeeper,
+		abciPropHandler.PrepareProposalHandler(),
+		abciPropHandler.ProcessProposalHandler(),
+		app.PreBlocker,
+	)
+	app.SetPrepareProposal(prepare)
+	app.SetProcessProposal(process)
+	app.SetPreBlocker(preBlocker)
+}
+
 func (app *SimApp) setPostHandler() {
 	postHandler, err := posthandler.NewPostHandler(
 		posthandler.HandlerOptions{},
@@ -813,7 +849,7 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *SimApp) RegisterTxService(clientCtx client.Context) {
-	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
+	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry, authtx.WithFeeEstimator(app.feeEstimator))
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -205,12 +205,9 @@ func NewSimApp(
 	// }
 	// baseAppOptions = append(baseAppOptions, prepareOpt)
 
-	// create and set dummy vote extension handler
-	voteExtOp := func(bApp *baseapp.BaseApp) {
-		voteExtHandler := NewVoteExtensionHandler()
-		voteExtHandler.SetHandlers(bApp)
-	}
-	baseAppOptions = append(baseAppOptions, voteExtOp, baseapp.SetOptimisticExecution())
+	// the vote extension handlers are the ones of the upgrade readiness, set
+	// along with the proposal handlers once the keepers are created
+	baseAppOptions = append(baseAppOptions, baseapp.SetOptimisticExecution())
 
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
@@ -252,6 +249,9 @@ func NewSimApp(
 	// set custom ante handler
 	app.setAnteHandler(app.txConfig)
 
+	// set the upgrade readiness and BLS aggregation handlers
+	app.setABCIHandlers()
+
 	if err := app.Load(loadLatest); err != nil {
 		panic(err)
 	}
@@ -259,6 +259,27 @@ func NewSimApp(
 	return app
 }
 
+// setABCIHandlers sets the vote extension handlers of the upgrade readiness,
+// and wraps the default proposal handlers and the PreBlocker with the ones of
+// the upgrade readiness and of the BLS signature aggregation.
+func (app *SimApp) setABCIHandlers() {
+	app.SetExtendVoteHandler(app.UpgradeKeeper.ExtendVoteHandler())
+	app.SetVerifyVoteExtensionHandler(app.UpgradeKeeper.VerifyVoteExtensionHandler())
+
+	abciPropHandler := baseapp.NewDefaultProposalHandler(app.Mempool(), app.BaseApp)
+	prepare, process, preBlocker := wrapABCIHandlers(
+		app.AccountKeeper,
+		app.UpgradeKeeper,
+		app.StakingKeeper,
+		abciPropHandler.PrepareProposalHandler(),
+		abciPropHandler.ProcessProposalHandler(),
+		app.App.PreBlocker,
+	)
+	app.SetPrepareProposal(prepare)
+	app.SetProcessProposal(process)
+	app.SetPreBlocker(preBlocker)
+}
+
 // setAnteHandler sets custom ante handlers.
 // "x/auth/tx" pre-defined ante handler have been disabled in app_config.
 func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
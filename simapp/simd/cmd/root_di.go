@@ -18,6 +18,8 @@ import (
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -106,6 +108,8 @@ func ProvideClientContext(
 		WithInput(os.Stdin).
 		WithAccountRetriever(types.AccountRetriever{}).
 		WithHomeDir(simapp.DefaultNodeHome).
+		// SimApp accepts ed25519 account public keys, so the keyring may create them
+		WithKeyringOptions(keyring.WithSupportedAlgos(hd.Ed25519)).
 		WithViper("") // uses by default the binary name as prefix
 
 	clientCtx, _ = config.ReadFromClientConfig(clientCtx)
@@ -10,6 +10,8 @@ import (
 	"cosmossdk.io/simapp/simd/cmd"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
 	"github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 )
@@ -41,3 +43,16 @@ func TestHomeFlagRegistration(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, result, homeDir)
 }
+
+func TestKeysAddEd25519(t *testing.T) {
+	// simd opts in the ed25519 keyring algorithm
+	rootCmd := cmd.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"keys", "add", "ed25519-key",
+		fmt.Sprintf("--%s=%s", flags.FlagKeyType, hd.Ed25519Type),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s=%s", flags.FlagHome, t.TempDir()),
+	})
+
+	require.NoError(t, svrcmd.Execute(rootCmd, "", simapp.DefaultNodeHome))
+}
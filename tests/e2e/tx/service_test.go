@@ -35,6 +35,7 @@ import (
 	authtest "github.com/cosmos/cosmos-sdk/x/auth/client/testutil"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -239,6 +240,87 @@ func (s *E2ETestSuite) TestSimulateTx_GRPCGateway() {
 	}
 }
 
+func (s *E2ETestSuite) TestTxEstimateFee_GRPC() {
+	val := s.network.Validators[0]
+	txBuilder := s.mkTxBuilder()
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *tx.EstimateFeeRequest
+		expErr    bool
+		expErrMsg string
+	}{
+		{"nil request", nil, true, "request cannot be nil"},
+		{"empty request", &tx.EstimateFeeRequest{}, true, "empty txBytes is not allowed"},
+		{"invalid gas adjustment", &tx.EstimateFeeRequest{TxBytes: txBytes, GasAdjustment: -1}, true, "invalid gas adjustment"},
+		{"valid request", &tx.EstimateFeeRequest{TxBytes: txBytes}, false, ""},
+		{"valid request with gas adjustment", &tx.EstimateFeeRequest{TxBytes: txBytes, GasAdjustment: 1.5}, false, ""},
+	}
+
+	minGasPrice := math.LegacyMustNewDecFromStr("0.000006")
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.EstimateFee(context.Background(), tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().True(res.GetGasInfo().GetGasUsed() > 0)
+			gasAdjustment := max(tc.req.GasAdjustment, 1)
+			s.Require().Equal(uint64(gasAdjustment*float64(res.GasInfo.GasUsed)), res.GasLimit)
+
+			// one estimate per priority level, in the bond denom of the
+			// minimum gas prices of the validator
+			s.Require().Len(res.Estimates, 3)
+			for i, estimate := range res.Estimates {
+				s.Require().Equal(tx.FeePriority(i+1), estimate.Priority)
+				s.Require().Equal(s.cfg.BondDenom, estimate.GasPrice.Denom)
+				s.Require().True(estimate.GasPrice.Amount.GTE(minGasPrice))
+				expFee := estimate.GasPrice.Amount.MulInt64(int64(res.GasLimit)).Ceil().RoundInt()
+				s.Require().Equal(sdk.NewCoin(s.cfg.BondDenom, expFee), estimate.Fee)
+				if i > 0 {
+					s.Require().True(estimate.GasPrice.Amount.GTE(res.Estimates[i-1].GasPrice.Amount))
+				}
+			}
+		})
+	}
+}
+
+func (s *E2ETestSuite) TestTxEstimateFee_CLI() {
+	val := s.network.Validators[0]
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	// the key name is given, as the public key of the signer is needed to
+	// simulate the transaction
+	out, err := cli.ExecTestCLICmd(val.ClientCtx, bankcli.NewSendTxCmd(addresscodec.NewBech32Codec("cosmos")), []string{
+		val.Moniker,
+		val.Address.String(),
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, math.NewInt(10))).String(),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, flags.FeesFlagAuto),
+		fmt.Sprintf("--%s=high", flags.FlagFeePriority),
+		fmt.Sprintf("--%s=1.5", flags.FlagGasAdjustment),
+	})
+	s.Require().NoError(err)
+	genTx, err := val.ClientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+	s.Require().NoError(err)
+	feeTx := genTx.(sdk.FeeTx)
+
+	// the gas is simulated, and the fees cover the minimum gas price of the
+	// validator
+	s.Require().True(feeTx.GetGas() > 0)
+	s.Require().NotEqual(uint64(flags.DefaultGasLimit), feeTx.GetGas())
+	minFee := math.LegacyMustNewDecFromStr("0.000006").MulInt64(int64(feeTx.GetGas())).Ceil().RoundInt()
+	s.Require().Len(feeTx.GetFee(), 1)
+	s.Require().Equal(s.cfg.BondDenom, feeTx.GetFee()[0].Denom)
+	s.Require().True(feeTx.GetFee()[0].Amount.GTE(minFee))
+}
+
 func (s *E2ETestSuite) TestGetTxEvents_GRPC() {
 	testCases := []struct {
 		name      string
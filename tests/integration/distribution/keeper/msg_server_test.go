@@ -767,10 +767,12 @@ func TestMsgUpdateParams(t *testing.T) {
 			msg: &distrtypes.MsgUpdateParams{
 				Authority: f.distrKeeper.GetAuthority(),
 				Params: distrtypes.Params{
-					CommunityTax:        communityTax,
-					BaseProposerReward:  math.LegacyZeroDec(),
-					BonusProposerReward: math.LegacyZeroDec(),
-					WithdrawAddrEnabled: true,
+					CommunityTax:                communityTax,
+					BaseProposerReward:          math.LegacyZeroDec(),
+					BonusProposerReward:         math.LegacyZeroDec(),
+					WithdrawAddrEnabled:         true,
+					AutoCompoundEpochIdentifier: distrtypes.DefaultAutoCompoundEpochIdentifier,
+					AutoCompoundBatchSize:       distrtypes.DefaultAutoCompoundBatchSize,
 				},
 			},
 			expErr: false,
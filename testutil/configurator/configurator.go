@@ -155,6 +155,7 @@ func AuthModule() ModuleOption {
 					{Account: "fee_collector"},
 					{Account: "distribution"},
 					{Account: "mint", Permissions: []string{"minter"}},
+					{Account: "staking", Permissions: []string{"minter", "burner"}},
 					{Account: "bonded_tokens_pool", Permissions: []string{"burner", "staking"}},
 					{Account: "not_bonded_tokens_pool", Permissions: []string{"burner", "staking"}},
 					{Account: "gov", Permissions: []string{"burner"}},
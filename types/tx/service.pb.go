@@ -5,11 +5,13 @@ package tx
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	types1 "github.com/cometbft/cometbft/proto/tendermint/types"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -105,6 +107,45 @@ func (BroadcastMode) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e0b00a618705eca7, []int{1}
 }
 
+// FeePriority is the priority level of a fee estimate.
+type FeePriority int32
+
+const (
+	// FEE_PRIORITY_UNSPECIFIED defaults to FEE_PRIORITY_MEDIUM.
+	FeePriority_FEE_PRIORITY_UNSPECIFIED FeePriority = 0
+	// FEE_PRIORITY_LOW pays the gas price of the cheapest transactions included
+	// in recent blocks.
+	FeePriority_FEE_PRIORITY_LOW FeePriority = 1
+	// FEE_PRIORITY_MEDIUM pays the median gas price of the transactions included
+	// in recent blocks.
+	FeePriority_FEE_PRIORITY_MEDIUM FeePriority = 2
+	// FEE_PRIORITY_HIGH pays the gas price of the most expensive transactions
+	// included in recent blocks.
+	FeePriority_FEE_PRIORITY_HIGH FeePriority = 3
+)
+
+var FeePriority_name = map[int32]string{
+	0: "FEE_PRIORITY_UNSPECIFIED",
+	1: "FEE_PRIORITY_LOW",
+	2: "FEE_PRIORITY_MEDIUM",
+	3: "FEE_PRIORITY_HIGH",
+}
+
+var FeePriority_value = map[string]int32{
+	"FEE_PRIORITY_UNSPECIFIED": 0,
+	"FEE_PRIORITY_LOW":         1,
+	"FEE_PRIORITY_MEDIUM":      2,
+	"FEE_PRIORITY_HIGH":        3,
+}
+
+func (x FeePriority) String() string {
+	return proto.EnumName(FeePriority_name, int32(x))
+}
+
+func (FeePriority) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{2}
+}
+
 // GetTxsEventRequest is the request type for the Service.TxsByEvents
 // RPC method.
 type GetTxsEventRequest struct {
@@ -496,6 +537,194 @@ func (m *SimulateResponse) GetResult() *types.Result {
 	return nil
 }
 
+// EstimateFeeRequest is the request type for the Service.EstimateFee
+// RPC method.
+type EstimateFeeRequest struct {
+	// tx_bytes is the raw transaction to simulate.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// gas_adjustment multiplies the gas used by the simulation into the gas
+	// limit of the estimated fees. It defaults to 1.
+	GasAdjustment float64 `protobuf:"fixed64,2,opt,name=gas_adjustment,json=gasAdjustment,proto3" json:"gas_adjustment,omitempty"`
+}
+
+func (m *EstimateFeeRequest) Reset()         { *m = EstimateFeeRequest{} }
+func (m *EstimateFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateFeeRequest) ProtoMessage()    {}
+func (*EstimateFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{6}
+}
+func (m *EstimateFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateFeeRequest.Merge(m, src)
+}
+func (m *EstimateFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateFeeRequest proto.InternalMessageInfo
+
+func (m *EstimateFeeRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func (m *EstimateFeeRequest) GetGasAdjustment() float64 {
+	if m != nil {
+		return m.GasAdjustment
+	}
+	return 0
+}
+
+// EstimateFeeResponse is the response type for the Service.EstimateFee
+// RPC method.
+type EstimateFeeResponse struct {
+	// gas_info is the information about gas used in the simulation.
+	GasInfo *types.GasInfo `protobuf:"bytes,1,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
+	// gas_limit is the gas used in the simulation, multiplied by the gas
+	// adjustment.
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// estimates are the fee estimates of each priority level, and of each denom
+	// accepted by the node.
+	Estimates []FeeEstimate `protobuf:"bytes,3,rep,name=estimates,proto3" json:"estimates"`
+}
+
+func (m *EstimateFeeResponse) Reset()         { *m = EstimateFeeResponse{} }
+func (m *EstimateFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateFeeResponse) ProtoMessage()    {}
+func (*EstimateFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{7}
+}
+func (m *EstimateFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateFeeResponse.Merge(m, src)
+}
+func (m *EstimateFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateFeeResponse proto.InternalMessageInfo
+
+func (m *EstimateFeeResponse) GetGasInfo() *types.GasInfo {
+	if m != nil {
+		return m.GasInfo
+	}
+	return nil
+}
+
+func (m *EstimateFeeResponse) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *EstimateFeeResponse) GetEstimates() []FeeEstimate {
+	if m != nil {
+		return m.Estimates
+	}
+	return nil
+}
+
+// FeeEstimate is the fee estimate of a priority level, in a denom.
+type FeeEstimate struct {
+	// priority is the priority level of the estimate.
+	Priority FeePriority `protobuf:"varint,1,opt,name=priority,proto3,enum=cosmos.tx.v1beta1.FeePriority" json:"priority,omitempty"`
+	// gas_price is the recommended gas price.
+	GasPrice types.DecCoin `protobuf:"bytes,2,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price"`
+	// fee is the fee paying the gas limit at the recommended gas price.
+	Fee types.Coin `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *FeeEstimate) Reset()         { *m = FeeEstimate{} }
+func (m *FeeEstimate) String() string { return proto.CompactTextString(m) }
+func (*FeeEstimate) ProtoMessage()    {}
+func (*FeeEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{8}
+}
+func (m *FeeEstimate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeEstimate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeEstimate.Merge(m, src)
+}
+func (m *FeeEstimate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeEstimate proto.InternalMessageInfo
+
+func (m *FeeEstimate) GetPriority() FeePriority {
+	if m != nil {
+		return m.Priority
+	}
+	return FeePriority_FEE_PRIORITY_UNSPECIFIED
+}
+
+func (m *FeeEstimate) GetGasPrice() types.DecCoin {
+	if m != nil {
+		return m.GasPrice
+	}
+	return types.DecCoin{}
+}
+
+func (m *FeeEstimate) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
 // GetTxRequest is the request type for the Service.GetTx
 // RPC method.
 type GetTxRequest struct {
@@ -507,7 +736,7 @@ func (m *GetTxRequest) Reset()         { *m = GetTxRequest{} }
 func (m *GetTxRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTxRequest) ProtoMessage()    {}
 func (*GetTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{6}
+	return fileDescriptor_e0b00a618705eca7, []int{9}
 }
 func (m *GetTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,7 +784,7 @@ func (m *GetTxResponse) Reset()         { *m = GetTxResponse{} }
 func (m *GetTxResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTxResponse) ProtoMessage()    {}
 func (*GetTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{7}
+	return fileDescriptor_e0b00a618705eca7, []int{10}
 }
 func (m *GetTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +840,7 @@ func (m *GetBlockWithTxsRequest) Reset()         { *m = GetBlockWithTxsRequest{}
 func (m *GetBlockWithTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsRequest) ProtoMessage()    {}
 func (*GetBlockWithTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{8}
+	return fileDescriptor_e0b00a618705eca7, []int{11}
 }
 func (m *GetBlockWithTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -669,7 +898,7 @@ func (m *GetBlockWithTxsResponse) Reset()         { *m = GetBlockWithTxsResponse
 func (m *GetBlockWithTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsResponse) ProtoMessage()    {}
 func (*GetBlockWithTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{9}
+	return fileDescriptor_e0b00a618705eca7, []int{12}
 }
 func (m *GetBlockWithTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -737,7 +966,7 @@ func (m *TxDecodeRequest) Reset()         { *m = TxDecodeRequest{} }
 func (m *TxDecodeRequest) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeRequest) ProtoMessage()    {}
 func (*TxDecodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{10}
+	return fileDescriptor_e0b00a618705eca7, []int{13}
 }
 func (m *TxDecodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -784,7 +1013,7 @@ func (m *TxDecodeResponse) Reset()         { *m = TxDecodeResponse{} }
 func (m *TxDecodeResponse) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeResponse) ProtoMessage()    {}
 func (*TxDecodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{11}
+	return fileDescriptor_e0b00a618705eca7, []int{14}
 }
 func (m *TxDecodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -831,7 +1060,7 @@ func (m *TxEncodeRequest) Reset()         { *m = TxEncodeRequest{} }
 func (m *TxEncodeRequest) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeRequest) ProtoMessage()    {}
 func (*TxEncodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{12}
+	return fileDescriptor_e0b00a618705eca7, []int{15}
 }
 func (m *TxEncodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,7 +1107,7 @@ func (m *TxEncodeResponse) Reset()         { *m = TxEncodeResponse{} }
 func (m *TxEncodeResponse) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeResponse) ProtoMessage()    {}
 func (*TxEncodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{13}
+	return fileDescriptor_e0b00a618705eca7, []int{16}
 }
 func (m *TxEncodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +1153,7 @@ func (m *TxEncodeAminoRequest) Reset()         { *m = TxEncodeAminoRequest{} }
 func (m *TxEncodeAminoRequest) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeAminoRequest) ProtoMessage()    {}
 func (*TxEncodeAminoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{14}
+	return fileDescriptor_e0b00a618705eca7, []int{17}
 }
 func (m *TxEncodeAminoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -970,7 +1199,7 @@ func (m *TxEncodeAminoResponse) Reset()         { *m = TxEncodeAminoResponse{} }
 func (m *TxEncodeAminoResponse) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeAminoResponse) ProtoMessage()    {}
 func (*TxEncodeAminoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{15}
+	return fileDescriptor_e0b00a618705eca7, []int{18}
 }
 func (m *TxEncodeAminoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1016,7 +1245,7 @@ func (m *TxDecodeAminoRequest) Reset()         { *m = TxDecodeAminoRequest{} }
 func (m *TxDecodeAminoRequest) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeAminoRequest) ProtoMessage()    {}
 func (*TxDecodeAminoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{16}
+	return fileDescriptor_e0b00a618705eca7, []int{19}
 }
 func (m *TxDecodeAminoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1062,7 +1291,7 @@ func (m *TxDecodeAminoResponse) Reset()         { *m = TxDecodeAminoResponse{} }
 func (m *TxDecodeAminoResponse) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeAminoResponse) ProtoMessage()    {}
 func (*TxDecodeAminoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{17}
+	return fileDescriptor_e0b00a618705eca7, []int{20}
 }
 func (m *TxDecodeAminoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1101,12 +1330,16 @@ func (m *TxDecodeAminoResponse) GetAminoJson() string {
 func init() {
 	proto.RegisterEnum("cosmos.tx.v1beta1.OrderBy", OrderBy_name, OrderBy_value)
 	proto.RegisterEnum("cosmos.tx.v1beta1.BroadcastMode", BroadcastMode_name, BroadcastMode_value)
+	proto.RegisterEnum("cosmos.tx.v1beta1.FeePriority", FeePriority_name, FeePriority_value)
 	proto.RegisterType((*GetTxsEventRequest)(nil), "cosmos.tx.v1beta1.GetTxsEventRequest")
 	proto.RegisterType((*GetTxsEventResponse)(nil), "cosmos.tx.v1beta1.GetTxsEventResponse")
 	proto.RegisterType((*BroadcastTxRequest)(nil), "cosmos.tx.v1beta1.BroadcastTxRequest")
 	proto.RegisterType((*BroadcastTxResponse)(nil), "cosmos.tx.v1beta1.BroadcastTxResponse")
 	proto.RegisterType((*SimulateRequest)(nil), "cosmos.tx.v1beta1.SimulateRequest")
 	proto.RegisterType((*SimulateResponse)(nil), "cosmos.tx.v1beta1.SimulateResponse")
+	proto.RegisterType((*EstimateFeeRequest)(nil), "cosmos.tx.v1beta1.EstimateFeeRequest")
+	proto.RegisterType((*EstimateFeeResponse)(nil), "cosmos.tx.v1beta1.EstimateFeeResponse")
+	proto.RegisterType((*FeeEstimate)(nil), "cosmos.tx.v1beta1.FeeEstimate")
 	proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
 	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
 	proto.RegisterType((*GetBlockWithTxsRequest)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsRequest")
@@ -1124,89 +1357,107 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 1310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0x51, 0x6f, 0xd3, 0x56,
-	0x14, 0xc7, 0x6b, 0x37, 0x69, 0xd2, 0x93, 0x94, 0x86, 0xdb, 0x00, 0x69, 0x80, 0x34, 0x18, 0x4a,
-	0x43, 0xa5, 0xda, 0x10, 0x40, 0x4c, 0x68, 0xd3, 0x14, 0x27, 0xa1, 0x2b, 0x0c, 0x82, 0x9c, 0x4e,
-	0x13, 0xd3, 0xa4, 0xc8, 0x49, 0x2e, 0x89, 0x47, 0x62, 0x87, 0xf8, 0x16, 0x39, 0x62, 0x68, 0xd3,
-	0x5e, 0x36, 0x4d, 0x9b, 0x36, 0x69, 0x9f, 0x60, 0xef, 0x7b, 0xe4, 0x43, 0x4c, 0x3c, 0x21, 0xf6,
-	0x32, 0xed, 0x69, 0x82, 0x3d, 0xed, 0x53, 0x4c, 0xbe, 0xbe, 0x4e, 0xec, 0xc4, 0xa6, 0x34, 0x7b,
-	0x89, 0x7c, 0x7d, 0xcf, 0x3d, 0xe7, 0x77, 0xfe, 0xf7, 0xde, 0x73, 0x1c, 0xd8, 0x68, 0x19, 0x66,
-	0xdf, 0x30, 0x25, 0x62, 0x49, 0x4f, 0xae, 0x34, 0x31, 0x51, 0xaf, 0x48, 0x26, 0x1e, 0x3e, 0xd1,
-	0x5a, 0x58, 0x1c, 0x0c, 0x0d, 0x62, 0xa0, 0xe3, 0x8e, 0x81, 0x48, 0x2c, 0x91, 0x19, 0x64, 0xcf,
-	0x74, 0x0c, 0xa3, 0xd3, 0xc3, 0x92, 0x3a, 0xd0, 0x24, 0x55, 0xd7, 0x0d, 0xa2, 0x12, 0xcd, 0xd0,
-	0x4d, 0x67, 0x41, 0xf6, 0x3c, 0xf3, 0xd8, 0x54, 0x4d, 0x2c, 0xa9, 0xcd, 0x96, 0x36, 0x76, 0x6c,
-	0x0f, 0x98, 0x51, 0x76, 0x36, 0x2c, 0xb1, 0xd8, 0xdc, 0xb6, 0xd7, 0xc1, 0xe3, 0x03, 0x3c, 0x1c,
-	0x8d, 0x6d, 0x06, 0x6a, 0x47, 0xd3, 0x69, 0x34, 0x66, 0x7b, 0x86, 0x60, 0xbd, 0x8d, 0x87, 0x7d,
-	0x4d, 0x27, 0x12, 0x19, 0x0d, 0xb0, 0x29, 0x35, 0x7b, 0x46, 0xeb, 0x51, 0xe8, 0x2c, 0xfd, 0x65,
-	0xb3, 0xeb, 0x4e, 0x9c, 0x06, 0x1d, 0x49, 0x2c, 0x4d, 0x3a, 0x10, 0xbe, 0xe3, 0x01, 0xed, 0x62,
-	0xb2, 0x6f, 0x99, 0xd5, 0x27, 0x58, 0x27, 0x0a, 0x7e, 0x7c, 0x80, 0x4d, 0x82, 0xb2, 0xb0, 0x84,
-	0xed, 0xb1, 0x99, 0xe1, 0xf2, 0x8b, 0x85, 0x65, 0x99, 0xcf, 0x70, 0x0a, 0x7b, 0x83, 0x6e, 0x03,
-	0x4c, 0xe8, 0x32, 0x7c, 0x9e, 0x2b, 0x24, 0x8a, 0x17, 0x45, 0xe6, 0xd5, 0x4e, 0x45, 0xa4, 0xa9,
-	0xb8, 0x22, 0x8a, 0xf7, 0xd5, 0x0e, 0x66, 0x7e, 0xa9, 0x1f, 0xcf, 0x6a, 0x74, 0x1d, 0xe2, 0xc6,
-	0xb0, 0x8d, 0x87, 0x8d, 0xe6, 0x28, 0xb3, 0x98, 0xe7, 0x0a, 0xc7, 0x8a, 0x59, 0x71, 0x66, 0x1b,
-	0xc4, 0x9a, 0x6d, 0x22, 0x8f, 0x94, 0x98, 0xe1, 0x3c, 0x20, 0x04, 0x91, 0x81, 0xda, 0xc1, 0x99,
-	0x48, 0x9e, 0x2b, 0x44, 0x14, 0xfa, 0x8c, 0xd2, 0x10, 0xed, 0x69, 0x7d, 0x8d, 0x64, 0xa2, 0xf4,
-	0xa5, 0x33, 0x40, 0x97, 0x20, 0x4a, 0x69, 0x32, 0x4b, 0x79, 0xae, 0xb0, 0x2c, 0xaf, 0xfd, 0xf5,
-	0x7c, 0x67, 0xd5, 0x09, 0xb0, 0x63, 0xb6, 0x1f, 0xe5, 0x2f, 0x8b, 0xd7, 0x2f, 0x2b, 0x8e, 0x85,
-	0xf0, 0x2f, 0x07, 0x6b, 0x3e, 0x29, 0xcc, 0x81, 0xa1, 0x9b, 0x18, 0x6d, 0xc1, 0x22, 0xb1, 0x1c,
-	0x21, 0x12, 0xc5, 0x13, 0x01, 0x78, 0xfb, 0x96, 0x62, 0x5b, 0xa0, 0x5d, 0x48, 0x12, 0xab, 0x31,
-	0x64, 0xeb, 0xcc, 0x0c, 0x4f, 0x57, 0x5c, 0xf0, 0x49, 0x43, 0x4f, 0x86, 0x67, 0x21, 0x33, 0x56,
-	0x12, 0x64, 0xfc, 0x6c, 0xa2, 0x3b, 0x3e, 0x85, 0x17, 0xa9, 0xc2, 0x5b, 0x87, 0x2a, 0xec, 0xac,
-	0x9e, 0x91, 0x38, 0x0d, 0x51, 0x62, 0x10, 0xb5, 0xc7, 0xc4, 0x72, 0x06, 0x02, 0x06, 0x24, 0x0f,
-	0x0d, 0xb5, 0xdd, 0x52, 0x4d, 0x62, 0x63, 0x38, 0xdb, 0xbe, 0x0e, 0x71, 0x62, 0x35, 0x9a, 0x23,
-	0x82, 0xed, 0x7c, 0xb9, 0x42, 0x52, 0x89, 0x11, 0x4b, 0xb6, 0x87, 0xe8, 0x1a, 0x44, 0xfa, 0x46,
-	0x1b, 0xd3, 0xfd, 0x3e, 0x56, 0xcc, 0x07, 0xc8, 0x30, 0xf6, 0x77, 0xd7, 0x68, 0x63, 0x85, 0x5a,
-	0x0b, 0x9f, 0xc3, 0x9a, 0x2f, 0x0c, 0x93, 0xb4, 0x0a, 0x09, 0x8f, 0x52, 0x34, 0xd4, 0xbb, 0x0a,
-	0x05, 0x13, 0xa1, 0x84, 0x1e, 0xac, 0xd6, 0xb5, 0xfe, 0x41, 0x4f, 0x25, 0xee, 0x01, 0x43, 0x97,
-	0x80, 0x27, 0x16, 0x73, 0x18, 0xbc, 0x57, 0x54, 0x20, 0x9e, 0x58, 0x48, 0xf4, 0x24, 0x6b, 0x67,
-	0x95, 0x0c, 0x38, 0x1d, 0xd7, 0xae, 0x8e, 0x15, 0x10, 0xbe, 0xe7, 0x20, 0x35, 0x09, 0xc7, 0x32,
-	0x79, 0x1f, 0xe2, 0x1d, 0xd5, 0x6c, 0x68, 0xfa, 0x43, 0x83, 0x45, 0x3d, 0x17, 0x9e, 0xc6, 0xae,
-	0x6a, 0xee, 0xe9, 0x0f, 0x0d, 0x25, 0xd6, 0x71, 0x1e, 0xd0, 0x7b, 0xb0, 0x34, 0xc4, 0xe6, 0x41,
-	0x8f, 0xb0, 0x6b, 0x94, 0x0f, 0x5f, 0xab, 0x50, 0x3b, 0x85, 0xd9, 0x0b, 0x02, 0x24, 0xe9, 0x59,
-	0x75, 0xf3, 0x46, 0x10, 0xe9, 0xaa, 0x66, 0x97, 0x32, 0x2c, 0x2b, 0xf4, 0x59, 0x78, 0x06, 0x2b,
-	0xcc, 0x86, 0xc1, 0x6e, 0x1e, 0x2a, 0x0e, 0x15, 0x66, 0x6a, 0x77, 0xf8, 0x39, 0x77, 0xe7, 0x27,
-	0x0e, 0x4e, 0xee, 0x62, 0x22, 0xdb, 0x65, 0xea, 0x53, 0x8d, 0x74, 0xf7, 0x2d, 0xd3, 0xa5, 0x3d,
-	0x09, 0x4b, 0x5d, 0xac, 0x75, 0xba, 0x84, 0xc2, 0x2c, 0x2a, 0x6c, 0x84, 0x6e, 0xcd, 0x5f, 0x5a,
-	0xbc, 0x67, 0xfe, 0xe6, 0x89, 0x57, 0xcf, 0x77, 0x8e, 0xfb, 0x37, 0xf2, 0xba, 0x58, 0x14, 0xbe,
-	0xe5, 0xe1, 0xd4, 0x0c, 0xd1, 0x51, 0x6f, 0xf9, 0x35, 0x88, 0xd3, 0xca, 0xdb, 0xd0, 0xda, 0x8c,
-	0x70, 0x5d, 0x9c, 0x54, 0x5f, 0xd1, 0xa9, 0xbb, 0x34, 0xc4, 0x5e, 0x45, 0x89, 0x51, 0xd3, 0xbd,
-	0x36, 0xda, 0x81, 0x28, 0x7d, 0x64, 0xb7, 0xf9, 0x54, 0xc8, 0x12, 0xc5, 0xb1, 0x42, 0xbb, 0x3e,
-	0x21, 0x22, 0x47, 0xaa, 0x00, 0xef, 0xa2, 0x44, 0x09, 0x56, 0xf7, 0xad, 0x0a, 0x6e, 0xd9, 0x37,
-	0xf5, 0xd0, 0xbb, 0x7f, 0x73, 0xed, 0xd5, 0xcc, 0xbd, 0xb8, 0x21, 0xdc, 0x83, 0xd4, 0xc4, 0xc5,
-	0x91, 0x0e, 0x58, 0xb0, 0xbf, 0xbb, 0x36, 0x52, 0x55, 0xf7, 0x22, 0xfd, 0x1f, 0x77, 0xb2, 0x8d,
-	0xe7, 0xba, 0x63, 0x78, 0x47, 0x4d, 0xf1, 0x36, 0xa4, 0x5d, 0x1f, 0xa5, 0xbe, 0xa6, 0x1b, 0x2e,
-	0xd7, 0x59, 0x00, 0xd5, 0x1e, 0x37, 0xbe, 0x30, 0x0d, 0x9d, 0x5d, 0xb9, 0x65, 0xfa, 0xe6, 0xb6,
-	0x69, 0xe8, 0xc1, 0xbe, 0x6a, 0x70, 0x62, 0xca, 0x17, 0x83, 0x3a, 0x07, 0x49, 0xc7, 0x59, 0x53,
-	0xd3, 0xd5, 0xe1, 0x88, 0x81, 0x25, 0xe8, 0x3b, 0x99, 0xbe, 0x0a, 0xd3, 0x3f, 0xed, 0xea, 0xef,
-	0x83, 0x9b, 0xd7, 0xdf, 0x1d, 0x1b, 0xd0, 0xe7, 0x8f, 0x01, 0xce, 0x91, 0xed, 0xf6, 0x47, 0x10,
-	0x63, 0x4d, 0x1b, 0x65, 0x20, 0x5d, 0x53, 0x2a, 0x55, 0xa5, 0x21, 0x3f, 0x68, 0x7c, 0x72, 0xaf,
-	0x7e, 0xbf, 0x5a, 0xde, 0xbb, 0xb5, 0x57, 0xad, 0xa4, 0x16, 0x50, 0x0a, 0x92, 0xe3, 0x99, 0x52,
-	0xbd, 0x9c, 0xe2, 0xd0, 0x71, 0x58, 0x19, 0xbf, 0xa9, 0x54, 0xeb, 0xe5, 0x14, 0xbf, 0xfd, 0x35,
-	0x07, 0x2b, 0xbe, 0xce, 0x82, 0x72, 0x90, 0x95, 0x95, 0x5a, 0xa9, 0x52, 0x2e, 0xd5, 0xf7, 0x1b,
-	0x77, 0x6b, 0x95, 0xea, 0x94, 0xdb, 0x33, 0x90, 0x9e, 0x9a, 0x97, 0x3f, 0xae, 0x95, 0xef, 0xa4,
-	0xb8, 0x2c, 0x1f, 0xe7, 0xd0, 0x29, 0x58, 0x9b, 0x9a, 0xad, 0x3f, 0xb8, 0x57, 0x4e, 0xf1, 0x36,
-	0xe7, 0xd4, 0x44, 0x89, 0xce, 0x2c, 0x16, 0x7f, 0x04, 0x88, 0xd5, 0x9d, 0x4f, 0x45, 0xf4, 0x14,
-	0xe2, 0x6e, 0x0f, 0x40, 0x42, 0xc0, 0x91, 0x9c, 0xea, 0x47, 0xd9, 0xf3, 0x6f, 0xb5, 0x61, 0x95,
-	0xf2, 0xe2, 0x37, 0x7f, 0xfc, 0xf3, 0x0b, 0x9f, 0xbf, 0xc9, 0x6d, 0x0b, 0xa7, 0xa5, 0x80, 0xcf,
-	0x54, 0x37, 0xe0, 0x63, 0x88, 0xd2, 0x82, 0x8e, 0x36, 0x02, 0xbc, 0x7a, 0xdb, 0x41, 0x36, 0x1f,
-	0x6e, 0xc0, 0x62, 0x6e, 0xd2, 0x98, 0x1b, 0xe8, 0xac, 0x14, 0xf4, 0x81, 0x6a, 0x4a, 0x4f, 0xed,
-	0x16, 0xf2, 0x0c, 0x7d, 0x05, 0x09, 0x4f, 0x03, 0x47, 0x9b, 0x6f, 0xeb, 0xfb, 0x93, 0xf0, 0x17,
-	0x0f, 0x33, 0x63, 0x10, 0xe7, 0x28, 0xc4, 0x69, 0x3b, 0xf1, 0x93, 0xc1, 0x1c, 0xe8, 0x4b, 0x48,
-	0x78, 0x3e, 0xca, 0x02, 0x01, 0x66, 0xbf, 0x5f, 0x03, 0x01, 0x02, 0xbe, 0xed, 0x84, 0x1c, 0x05,
-	0xc8, 0xa0, 0xb0, 0xe8, 0xbf, 0x71, 0xb0, 0x3a, 0xd5, 0x31, 0xd0, 0xa5, 0x60, 0xdf, 0x01, 0x7d,
-	0x2e, 0xbb, 0xfd, 0x2e, 0xa6, 0x0c, 0x45, 0x7e, 0x11, 0x54, 0xa9, 0x29, 0xdf, 0x16, 0xda, 0x0c,
-	0xd9, 0x25, 0xda, 0x2d, 0xa4, 0xa7, 0x4e, 0xfb, 0x7c, 0x86, 0x7e, 0xe0, 0x20, 0xee, 0x5e, 0xe2,
-	0xc0, 0xe3, 0x39, 0x55, 0xf4, 0x03, 0x8f, 0xe7, 0x74, 0x55, 0x17, 0x6e, 0xbc, 0x98, 0xbd, 0xe1,
-	0x94, 0x2b, 0x67, 0x6f, 0xdc, 0x7a, 0x00, 0x5a, 0xdb, 0x21, 0x70, 0x70, 0x9c, 0xa2, 0x17, 0x82,
-	0xe3, 0x2b, 0xf8, 0x21, 0x38, 0xfe, 0x2a, 0x3e, 0x07, 0x0e, 0x76, 0x08, 0x7e, 0xe5, 0x60, 0xc5,
-	0x57, 0x83, 0xd1, 0xd6, 0x5b, 0xe2, 0x79, 0x8b, 0x6a, 0xb6, 0x70, 0xb8, 0x21, 0xa3, 0xfb, 0x20,
-	0x8c, 0xee, 0x82, 0x4d, 0xb7, 0x11, 0x4a, 0x27, 0xd1, 0x8a, 0xca, 0x18, 0x3d, 0x65, 0x38, 0x84,
-	0x71, 0xb6, 0xf0, 0x87, 0x30, 0x06, 0x54, 0xf4, 0x39, 0x18, 0x9d, 0x0d, 0x75, 0x18, 0xe5, 0x0f,
-	0x7f, 0x7f, 0x9d, 0xe3, 0x5e, 0xbe, 0xce, 0x71, 0x7f, 0xbf, 0xce, 0x71, 0x3f, 0xbf, 0xc9, 0x2d,
-	0xbc, 0x7c, 0x93, 0x5b, 0xf8, 0xf3, 0x4d, 0x6e, 0xe1, 0xb3, 0xcd, 0x8e, 0x46, 0xba, 0x07, 0x4d,
-	0xb1, 0x65, 0xf4, 0x5d, 0x27, 0x93, 0x30, 0xee, 0x1f, 0x53, 0xab, 0xb9, 0x44, 0xff, 0x7b, 0x5e,
-	0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x02, 0x7f, 0xb9, 0x08, 0x93, 0x0f, 0x00, 0x00,
+	// 1592 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5b, 0x6f, 0x13, 0xc7,
+	0x17, 0xcf, 0xda, 0xb9, 0x38, 0xc7, 0xb9, 0x38, 0x13, 0x07, 0x1c, 0x13, 0x1c, 0xb3, 0x10, 0x12,
+	0x2c, 0xc5, 0x86, 0x10, 0xc4, 0x5f, 0xd1, 0xbf, 0x42, 0xd9, 0xd8, 0x09, 0x06, 0x82, 0xa3, 0x4d,
+	0x10, 0xa2, 0xaa, 0x64, 0xad, 0xed, 0xc1, 0x59, 0x88, 0x77, 0x1d, 0xef, 0x04, 0x6d, 0x44, 0x51,
+	0xab, 0xbe, 0xb4, 0xaa, 0x2a, 0xb5, 0x52, 0x1f, 0xfb, 0xd4, 0xe7, 0xf6, 0x91, 0x0f, 0x41, 0x79,
+	0x42, 0xf4, 0xa5, 0xea, 0x43, 0x55, 0x41, 0x9f, 0xfa, 0x29, 0xaa, 0x9d, 0x99, 0xb5, 0x77, 0xed,
+	0x59, 0x72, 0x69, 0x5f, 0xac, 0xb9, 0x9c, 0xcb, 0xef, 0x9c, 0x33, 0x67, 0x7e, 0xb3, 0x86, 0xd9,
+	0xaa, 0x69, 0x35, 0x4c, 0x2b, 0x47, 0xec, 0xdc, 0xb3, 0x6b, 0x15, 0x4c, 0xb4, 0x6b, 0x39, 0x0b,
+	0xb7, 0x9e, 0xe9, 0x55, 0x9c, 0x6d, 0xb6, 0x4c, 0x62, 0xa2, 0x09, 0x26, 0x90, 0x25, 0x76, 0x96,
+	0x0b, 0x24, 0x67, 0xea, 0xa6, 0x59, 0xdf, 0xc3, 0x39, 0xad, 0xa9, 0xe7, 0x34, 0xc3, 0x30, 0x89,
+	0x46, 0x74, 0xd3, 0xb0, 0x98, 0x42, 0xf2, 0x22, 0xb7, 0x58, 0xd1, 0x2c, 0x9c, 0xd3, 0x2a, 0x55,
+	0xbd, 0x6d, 0xd8, 0x99, 0x70, 0xa1, 0x64, 0xaf, 0x5b, 0x62, 0xf3, 0xbd, 0x8c, 0xd7, 0xc0, 0xfe,
+	0x01, 0x6e, 0x1d, 0xb6, 0x65, 0x9a, 0x5a, 0x5d, 0x37, 0xa8, 0x37, 0x2e, 0x3b, 0x43, 0xb0, 0x51,
+	0xc3, 0xad, 0x86, 0x6e, 0x90, 0x1c, 0x39, 0x6c, 0x62, 0x2b, 0x57, 0xd9, 0x33, 0xab, 0x4f, 0x03,
+	0x77, 0xe9, 0x2f, 0xdf, 0x9d, 0x66, 0x7e, 0xca, 0x74, 0x96, 0xe3, 0x61, 0xb2, 0xad, 0x94, 0x17,
+	0x82, 0xeb, 0xbc, 0x6a, 0xea, 0xae, 0xdb, 0x78, 0xdd, 0xac, 0x9b, 0x4c, 0xcf, 0x19, 0xb1, 0x55,
+	0xf9, 0xab, 0x10, 0xa0, 0x0d, 0x4c, 0x76, 0x6c, 0xab, 0xf0, 0x0c, 0x1b, 0x44, 0xc5, 0xfb, 0x07,
+	0xd8, 0x22, 0x28, 0x09, 0x83, 0xd8, 0x99, 0x5b, 0x09, 0x29, 0x1d, 0x5e, 0x18, 0x56, 0x42, 0x09,
+	0x49, 0xe5, 0x2b, 0xe8, 0x0e, 0x40, 0x27, 0xa6, 0x44, 0x28, 0x2d, 0x2d, 0x44, 0x97, 0x2e, 0x67,
+	0x39, 0x16, 0xc7, 0x7b, 0x96, 0x26, 0xc0, 0x4d, 0x7d, 0x76, 0x4b, 0xab, 0x63, 0x6e, 0x97, 0xda,
+	0xf1, 0x68, 0xa3, 0x1b, 0x10, 0x31, 0x5b, 0x35, 0xdc, 0x2a, 0x57, 0x0e, 0x13, 0xe1, 0xb4, 0xb4,
+	0x30, 0xb6, 0x94, 0xcc, 0xf6, 0x14, 0x2f, 0x5b, 0x72, 0x44, 0x94, 0x43, 0x75, 0xc8, 0x64, 0x03,
+	0x84, 0xa0, 0xbf, 0xa9, 0xd5, 0x71, 0xa2, 0x3f, 0x2d, 0x2d, 0xf4, 0xab, 0x74, 0x8c, 0xe2, 0x30,
+	0xb0, 0xa7, 0x37, 0x74, 0x92, 0x18, 0xa0, 0x8b, 0x6c, 0x82, 0xae, 0xc0, 0x00, 0x45, 0x93, 0x18,
+	0x4c, 0x4b, 0x0b, 0xc3, 0xca, 0xe4, 0xef, 0x2f, 0x17, 0xc7, 0x99, 0x83, 0x45, 0xab, 0xf6, 0x34,
+	0x7d, 0x35, 0x7b, 0xe3, 0xaa, 0xca, 0x24, 0xe4, 0xbf, 0x25, 0x98, 0xf4, 0xa5, 0xc2, 0x6a, 0x9a,
+	0x86, 0x85, 0xd1, 0x3c, 0x84, 0x89, 0xcd, 0x12, 0x11, 0x5d, 0x9a, 0x12, 0xc0, 0xdb, 0xb1, 0x55,
+	0x47, 0x02, 0x6d, 0xc0, 0x08, 0xb1, 0xcb, 0x2d, 0xae, 0x67, 0x25, 0x42, 0x54, 0xe3, 0x92, 0x2f,
+	0x35, 0xf4, 0x3c, 0x79, 0x14, 0xb9, 0xb0, 0x1a, 0x25, 0xed, 0xb1, 0x85, 0xee, 0xfa, 0x32, 0x1c,
+	0xa6, 0x19, 0x9e, 0x3f, 0x32, 0xc3, 0x4c, 0xbb, 0x27, 0xc5, 0x71, 0x18, 0x20, 0x26, 0xd1, 0xf6,
+	0x78, 0xb2, 0xd8, 0x44, 0xc6, 0x80, 0x94, 0x96, 0xa9, 0xd5, 0xaa, 0x9a, 0x45, 0x1c, 0x18, 0xac,
+	0xec, 0xd3, 0x10, 0x21, 0x76, 0xb9, 0x72, 0x48, 0xb0, 0x13, 0xaf, 0xb4, 0x30, 0xa2, 0x0e, 0x11,
+	0x5b, 0x71, 0xa6, 0x68, 0x19, 0xfa, 0x1b, 0x66, 0x0d, 0xd3, 0x7a, 0x8f, 0x2d, 0xa5, 0x05, 0x69,
+	0x68, 0xdb, 0xdb, 0x34, 0x6b, 0x58, 0xa5, 0xd2, 0xf2, 0x27, 0x30, 0xe9, 0x73, 0xc3, 0x53, 0x5a,
+	0x80, 0xa8, 0x27, 0x53, 0xd4, 0xd5, 0x71, 0x13, 0x05, 0x9d, 0x44, 0xc9, 0x7b, 0x30, 0xbe, 0xad,
+	0x37, 0x0e, 0xf6, 0x34, 0xe2, 0x1e, 0x30, 0x74, 0x05, 0x42, 0xc4, 0xe6, 0x06, 0xc5, 0xb5, 0xa2,
+	0x09, 0x0a, 0x11, 0x1b, 0x65, 0x3d, 0xc1, 0x3a, 0x51, 0x8d, 0x08, 0x4e, 0xc7, 0xf2, 0xf5, 0x76,
+	0x06, 0xe4, 0xaf, 0x25, 0x88, 0x75, 0xdc, 0xf1, 0x48, 0xfe, 0x0f, 0x91, 0xba, 0x66, 0x95, 0x75,
+	0xe3, 0xb1, 0xc9, 0xbd, 0x5e, 0x08, 0x0e, 0x63, 0x43, 0xb3, 0x8a, 0xc6, 0x63, 0x53, 0x1d, 0xaa,
+	0xb3, 0x01, 0xfa, 0x1f, 0x0c, 0xb6, 0xb0, 0x75, 0xb0, 0x47, 0x78, 0x1b, 0xa5, 0x83, 0x75, 0x55,
+	0x2a, 0xa7, 0x72, 0x79, 0xf9, 0x29, 0xa0, 0x82, 0x45, 0xf4, 0x86, 0x46, 0xf0, 0x3a, 0xc6, 0xc7,
+	0xa8, 0xdf, 0x1c, 0x8c, 0x39, 0x40, 0xb5, 0xda, 0x93, 0x03, 0x8b, 0x34, 0xb0, 0xc1, 0x5c, 0x4a,
+	0xea, 0x68, 0x5d, 0xb3, 0x56, 0xdb, 0x8b, 0x2b, 0x93, 0x6f, 0x7b, 0x1a, 0x64, 0x59, 0xfe, 0x45,
+	0x82, 0x49, 0x9f, 0xb7, 0xff, 0x24, 0xf8, 0x73, 0x30, 0xec, 0x68, 0xb3, 0xa6, 0x0d, 0xd1, 0xc3,
+	0xe9, 0x98, 0xbb, 0x47, 0xfb, 0x56, 0x81, 0x61, 0xcc, 0x3d, 0x5a, 0x89, 0x30, 0x6d, 0xa4, 0x94,
+	0xa0, 0x9c, 0xeb, 0x18, 0xbb, 0xc0, 0x94, 0xfe, 0x57, 0x7f, 0xcc, 0xf6, 0xa9, 0x1d, 0x35, 0x71,
+	0x2c, 0x6f, 0x25, 0x88, 0x7a, 0xb4, 0xd0, 0x0a, 0x44, 0x9a, 0x2d, 0xdd, 0x6c, 0xe9, 0xe4, 0x90,
+	0xc6, 0x30, 0x16, 0xe4, 0x67, 0x8b, 0x4b, 0xa9, 0x6d, 0x79, 0x74, 0x8b, 0x45, 0xd0, 0x6c, 0xe9,
+	0x55, 0xcc, 0x2b, 0x38, 0xe3, 0x4b, 0x80, 0xab, 0x9e, 0xc7, 0xd5, 0x35, 0x53, 0x37, 0x38, 0x44,
+	0x27, 0xca, 0x2d, 0x47, 0x07, 0x5d, 0x83, 0xf0, 0x63, 0x8c, 0x79, 0x87, 0x4f, 0x0b, 0x55, 0x3d,
+	0x7a, 0x8e, 0xac, 0x38, 0x28, 0x19, 0x46, 0xe8, 0xcd, 0xe5, 0x9e, 0x03, 0x04, 0xfd, 0xbb, 0x9a,
+	0xb5, 0x4b, 0x03, 0x1a, 0x56, 0xe9, 0x58, 0x7e, 0x01, 0xa3, 0x5c, 0x86, 0x57, 0x6f, 0xee, 0xc8,
+	0x56, 0xa1, 0x6d, 0xd2, 0xd5, 0xab, 0xa1, 0x53, 0xf6, 0xea, 0xb7, 0x12, 0x9c, 0xd9, 0xc0, 0x44,
+	0x71, 0xa8, 0xee, 0xa1, 0x4e, 0x76, 0x77, 0x6c, 0xcb, 0x45, 0x7b, 0x06, 0x06, 0x77, 0xb1, 0x5e,
+	0xdf, 0x25, 0x14, 0x4c, 0x58, 0xe5, 0x33, 0xb4, 0x7e, 0x7a, 0xa2, 0xf1, 0xde, 0x80, 0x2b, 0x53,
+	0x6f, 0x5f, 0x2e, 0x4e, 0xf8, 0xdb, 0xfa, 0x46, 0x76, 0x49, 0xfe, 0x32, 0x04, 0x67, 0x7b, 0x10,
+	0x9d, 0xf4, 0xce, 0x5f, 0x86, 0x08, 0x65, 0xef, 0xb2, 0x5e, 0xe3, 0x08, 0xa7, 0xb3, 0x1d, 0x06,
+	0xcf, 0x32, 0xee, 0xa6, 0x2e, 0x8a, 0x79, 0x75, 0x88, 0x8a, 0x16, 0x6b, 0x68, 0x11, 0x06, 0xe8,
+	0x90, 0x57, 0xfe, 0x6c, 0x80, 0x8a, 0xca, 0xa4, 0xd0, 0x86, 0x2f, 0x11, 0xfd, 0x27, 0xe2, 0x83,
+	0xe3, 0x64, 0x62, 0x15, 0xc6, 0x77, 0xec, 0x3c, 0xae, 0x3a, 0xf7, 0xf6, 0x91, 0x37, 0x89, 0xe0,
+	0x04, 0x2e, 0xdf, 0x94, 0xef, 0x43, 0xac, 0x63, 0xe2, 0x44, 0x07, 0x4c, 0x6c, 0x6f, 0xd3, 0x81,
+	0x54, 0x30, 0xbc, 0x90, 0xfe, 0x8d, 0x39, 0xc5, 0x81, 0xe7, 0x9a, 0xe3, 0xf0, 0x4e, 0x1a, 0xe2,
+	0x1d, 0x88, 0xbb, 0x36, 0x56, 0x1b, 0xba, 0x61, 0xba, 0xb8, 0xce, 0x03, 0x68, 0xce, 0xbc, 0xfc,
+	0xc4, 0x32, 0x0d, 0xde, 0x72, 0xc3, 0x74, 0xe5, 0x8e, 0x65, 0x1a, 0x62, 0x5b, 0x25, 0x98, 0xea,
+	0xb2, 0xc5, 0x41, 0x5d, 0x80, 0x11, 0x66, 0xac, 0xa2, 0x1b, 0x5a, 0xeb, 0x90, 0x03, 0x8b, 0xd2,
+	0x35, 0x85, 0x2e, 0x05, 0xe5, 0x3f, 0xee, 0xe6, 0xdf, 0x07, 0xee, 0xb4, 0xf6, 0xee, 0x3a, 0x00,
+	0x7d, 0xf6, 0x38, 0xc0, 0x53, 0x44, 0x9b, 0xb9, 0x0d, 0x43, 0xfc, 0x09, 0x87, 0x12, 0x10, 0x2f,
+	0xa9, 0xf9, 0x82, 0x5a, 0x56, 0x1e, 0x95, 0x1f, 0xdc, 0xdf, 0xde, 0x2a, 0xac, 0x15, 0xd7, 0x8b,
+	0x85, 0x7c, 0xac, 0x0f, 0xc5, 0x60, 0xa4, 0xbd, 0xb3, 0xba, 0xbd, 0x16, 0x93, 0xd0, 0x04, 0x8c,
+	0xb6, 0x57, 0xf2, 0x85, 0xed, 0xb5, 0x58, 0x28, 0xf3, 0xb9, 0x04, 0xa3, 0xbe, 0x77, 0x06, 0x4a,
+	0x41, 0x52, 0x51, 0x4b, 0xab, 0xf9, 0xb5, 0xd5, 0xed, 0x9d, 0xf2, 0x66, 0x29, 0x5f, 0xe8, 0x32,
+	0x3b, 0x03, 0xf1, 0xae, 0x7d, 0xe5, 0x5e, 0x69, 0xed, 0x6e, 0x4c, 0x4a, 0x86, 0x22, 0x12, 0x3a,
+	0x0b, 0x93, 0x5d, 0xbb, 0xdb, 0x8f, 0xee, 0xaf, 0xc5, 0x42, 0x0e, 0xce, 0xae, 0x8d, 0x55, 0xba,
+	0x13, 0xce, 0xec, 0x53, 0xfe, 0x70, 0xd9, 0x00, 0xcd, 0x40, 0x62, 0xbd, 0x50, 0x28, 0x6f, 0xa9,
+	0xc5, 0x92, 0x5a, 0xdc, 0xe9, 0x0e, 0x2a, 0x0e, 0x31, 0xdf, 0xee, 0xbd, 0xd2, 0xc3, 0x18, 0xf5,
+	0xea, 0x5b, 0xdd, 0x2c, 0xe4, 0x8b, 0x0f, 0x36, 0x63, 0x21, 0x34, 0x05, 0x13, 0xbe, 0x8d, 0xdb,
+	0xc5, 0x8d, 0xdb, 0xb1, 0xf0, 0xd2, 0x4f, 0x51, 0x18, 0xda, 0x66, 0x5f, 0x38, 0xe8, 0x39, 0x44,
+	0xdc, 0x47, 0x08, 0x92, 0x05, 0x5d, 0xd0, 0xf5, 0x20, 0x4a, 0x5e, 0xfc, 0xa0, 0x0c, 0xbf, 0x9c,
+	0x2f, 0x7f, 0xf1, 0xeb, 0x5f, 0xdf, 0x87, 0xd2, 0xf2, 0xb9, 0x9c, 0xe0, 0xd3, 0x8a, 0x0b, 0xaf,
+	0x48, 0x19, 0xf4, 0x83, 0x04, 0x51, 0xcf, 0x43, 0x00, 0xcd, 0x09, 0x8c, 0xf7, 0x3e, 0x4b, 0x92,
+	0x97, 0x8f, 0x12, 0xe3, 0x30, 0x3e, 0x7a, 0xdd, 0xcb, 0x6d, 0x14, 0xd9, 0x25, 0x79, 0x56, 0x80,
+	0xcc, 0x65, 0xfa, 0xb2, 0xc3, 0x8b, 0x52, 0x06, 0xed, 0xc3, 0x00, 0x65, 0x38, 0x34, 0x2b, 0xf0,
+	0xe7, 0xe5, 0xc7, 0x64, 0x3a, 0x58, 0x80, 0x43, 0x99, 0xa3, 0x7e, 0x67, 0xd1, 0xf9, 0x9c, 0xe8,
+	0xab, 0xcf, 0xca, 0x3d, 0x77, 0x38, 0xf5, 0x05, 0xfa, 0x0c, 0xa2, 0x9e, 0xf7, 0xad, 0x30, 0x1f,
+	0xbd, 0xcf, 0x6c, 0x61, 0x3e, 0x04, 0xcf, 0x64, 0xf9, 0x02, 0x05, 0x71, 0x6e, 0x45, 0xca, 0xc8,
+	0x67, 0xc4, 0x38, 0xd0, 0xa7, 0x10, 0xf5, 0x7c, 0xb3, 0x08, 0x01, 0xf4, 0x7e, 0xde, 0x09, 0x01,
+	0x08, 0x3e, 0x7d, 0xe4, 0x14, 0x05, 0x90, 0x40, 0x41, 0xde, 0x7f, 0x96, 0x60, 0xbc, 0x8b, 0x42,
+	0xd1, 0x15, 0xb1, 0x6d, 0x01, 0xf1, 0x27, 0x33, 0xc7, 0x11, 0xe5, 0x50, 0x94, 0xd7, 0x22, 0xea,
+	0xa2, 0xf8, 0xe6, 0xd1, 0x5c, 0x40, 0x95, 0x28, 0x7d, 0xe6, 0x9e, 0xb3, 0xf7, 0xc4, 0x0b, 0xf4,
+	0x8d, 0x04, 0x11, 0xf7, 0x56, 0x13, 0x36, 0x4f, 0x17, 0x0b, 0x0a, 0x9b, 0xa7, 0x9b, 0xe6, 0xe4,
+	0x9b, 0xaf, 0x7b, 0xaf, 0x3c, 0x8a, 0x2b, 0x25, 0x4f, 0x0b, 0x70, 0xd5, 0xa8, 0xb6, 0x73, 0x5e,
+	0x19, 0x1c, 0xc6, 0x02, 0x01, 0x70, 0x7c, 0x0c, 0x18, 0x00, 0xc7, 0x4f, 0x6b, 0x1f, 0x80, 0xe3,
+	0x9c, 0x23, 0x11, 0x22, 0xcc, 0x10, 0xfc, 0x28, 0xc1, 0xa8, 0x8f, 0x94, 0xd0, 0xfc, 0x07, 0xfc,
+	0x79, 0x59, 0x26, 0xb9, 0x70, 0xb4, 0x60, 0x60, 0x8b, 0x73, 0x74, 0x97, 0x1c, 0x74, 0xb3, 0x81,
+	0xe8, 0x72, 0x94, 0x62, 0x38, 0x46, 0x0f, 0x2f, 0x05, 0x60, 0xec, 0x65, 0xc2, 0x00, 0x8c, 0x02,
+	0x8a, 0x3b, 0x05, 0x46, 0x56, 0x53, 0x86, 0x51, 0xb9, 0xf5, 0xea, 0x5d, 0x4a, 0x7a, 0xf3, 0x2e,
+	0x25, 0xfd, 0xf9, 0x2e, 0x25, 0x7d, 0xf7, 0x3e, 0xd5, 0xf7, 0xe6, 0x7d, 0xaa, 0xef, 0xb7, 0xf7,
+	0xa9, 0xbe, 0x8f, 0xe7, 0xea, 0x3a, 0xd9, 0x3d, 0xa8, 0x64, 0xab, 0x66, 0xc3, 0x35, 0xd2, 0x71,
+	0xe3, 0xfe, 0xdb, 0x63, 0x57, 0x06, 0xe9, 0x5f, 0x33, 0xd7, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff,
+	0x57, 0x2d, 0xd5, 0x77, 0xe8, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1223,6 +1474,10 @@ const _ = grpc.SupportPackageIsVersion4
 type ServiceClient interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
 	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
+	// EstimateFee simulates executing a transaction and returns the recommended
+	// fees at several priority levels, based on the minimum gas prices of the
+	// node and the gas prices of the transactions included in recent blocks.
+	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
 	// GetTx fetches a tx by hash.
 	GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error)
 	// BroadcastTx broadcast transaction.
@@ -1258,6 +1513,15 @@ func (c *serviceClient) Simulate(ctx context.Context, in *SimulateRequest, opts
 	return out, nil
 }
 
+func (c *serviceClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
+	out := new(EstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error) {
 	out := new(GetTxResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/GetTx", in, out, opts...)
@@ -1334,6 +1598,10 @@ func (c *serviceClient) TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequ
 type ServiceServer interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
 	Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error)
+	// EstimateFee simulates executing a transaction and returns the recommended
+	// fees at several priority levels, based on the minimum gas prices of the
+	// node and the gas prices of the transactions included in recent blocks.
+	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
 	// GetTx fetches a tx by hash.
 	GetTx(context.Context, *GetTxRequest) (*GetTxResponse, error)
 	// BroadcastTx broadcast transaction.
@@ -1359,6 +1627,9 @@ type UnimplementedServiceServer struct {
 func (*UnimplementedServiceServer) Simulate(ctx context.Context, req *SimulateRequest) (*SimulateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
 }
+func (*UnimplementedServiceServer) EstimateFee(ctx context.Context, req *EstimateFeeRequest) (*EstimateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
 func (*UnimplementedServiceServer) GetTx(ctx context.Context, req *GetTxRequest) (*GetTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTx not implemented")
 }
@@ -1406,6 +1677,24 @@ func _Service_Simulate_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).EstimateFee(ctx, req.(*EstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_GetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTxRequest)
 	if err := dec(in); err != nil {
@@ -1559,6 +1848,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Simulate",
 			Handler:    _Service_Simulate_Handler,
 		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _Service_EstimateFee_Handler,
+		},
 		{
 			MethodName: "GetTx",
 			Handler:    _Service_GetTx_Handler,
@@ -1889,7 +2182,7 @@ func (m *SimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
+func (m *EstimateFeeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1899,27 +2192,33 @@ func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateFeeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Hash)))
+	if m.GasAdjustment != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GasAdjustment))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TxBytes)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
+func (m *EstimateFeeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1929,31 +2228,38 @@ func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateFeeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TxResponse != nil {
-		{
-			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Estimates) > 0 {
+		for iNdEx := len(m.Estimates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Estimates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
 		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.GasLimit))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.Tx != nil {
+	if m.GasInfo != nil {
 		{
-			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.GasInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1966,7 +2272,7 @@ func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
+func (m *FeeEstimate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1976,37 +2282,45 @@ func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetBlockWithTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeeEstimate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetBlockWithTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeeEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
+		i -= size
+		i = encodeVarintService(dAtA, i, uint64(size))
 	}
-	if m.Height != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Height))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.GasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintService(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Priority != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Priority))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetBlockWithTxsResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2016,43 +2330,160 @@ func (m *GetBlockWithTxsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetBlockWithTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetTxRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetBlockWithTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Block != nil {
-		{
-			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Hash)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
-	if m.BlockId != nil {
-		{
-			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxResponse != nil {
+		{
+			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockWithTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockWithTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockWithTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockWithTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockWithTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockId != nil {
+		{
+			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2463,6 +2894,60 @@ func (m *SimulateResponse) Size() (n int) {
 	return n
 }
 
+func (m *EstimateFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.GasAdjustment != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *EstimateFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasInfo != nil {
+		l = m.GasInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovService(uint64(m.GasLimit))
+	}
+	if len(m.Estimates) > 0 {
+		for _, e := range m.Estimates {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		n += 1 + sovService(uint64(m.Priority))
+	}
+	l = m.GasPrice.Size()
+	n += 1 + l + sovService(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovService(uint64(l))
+	return n
+}
+
 func (m *GetTxRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3457,6 +3942,375 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EstimateFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasAdjustment", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.GasAdjustment = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasInfo == nil {
+				m.GasInfo = &types.GasInfo{}
+			}
+			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Estimates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Estimates = append(m.Estimates, FeeEstimate{})
+			if err := m.Estimates[len(m.Estimates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeEstimate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeEstimate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= FeePriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GetTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
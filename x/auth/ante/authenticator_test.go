@@ -0,0 +1,250 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
+	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSigVerificationWithAuthenticator(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	blockTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(blockTime)
+
+	account := suite.CreateTestAccounts(1)[0]
+	require.NoError(t, account.acc.SetPubKey(account.priv.PubKey()))
+	suite.accountKeeper.SetAccount(suite.ctx, account.acc)
+	addr := account.acc.GetAddress()
+
+	sessionKey := secp256k1.GenPrivKey()
+	addAuthenticator := func(config types.SignatureAuthenticatorConfig) uint64 {
+		pk, err := codectypes.NewAnyWithValue(sessionKey.PubKey())
+		require.NoError(t, err)
+		config.PubKey = pk
+
+		bz, err := suite.encCfg.Codec.Marshal(&config)
+		require.NoError(t, err)
+
+		id, err := suite.accountKeeper.AddAuthenticator(suite.ctx, addr, authenticator.SignatureType, bz)
+		require.NoError(t, err)
+		return id
+	}
+
+	run := func(priv cryptotypes.PrivKey) error {
+		return runWithSigner(t, suite, addr, priv, testdata.NewTestMsg(addr))
+	}
+
+	// a foreign key is rejected without authenticators
+	require.ErrorIs(t, run(sessionKey), sdkerrors.ErrInvalidPubKey)
+
+	// a session key restricted to other messages
+	id := addAuthenticator(types.SignatureAuthenticatorConfig{AllowedMessages: []string{"/cosmos.bank.v1beta1.MsgSend"}})
+	require.ErrorIs(t, run(sessionKey), sdkerrors.ErrUnauthorized)
+	require.NoError(t, suite.accountKeeper.RemoveAuthenticator(suite.ctx, addr, id))
+
+	// the messages nested in an allowed message must be allowed too
+	execMsg := authz.NewMsgExec(addr, []sdk.Msg{testdata.NewTestMsg(addr)})
+	execTypeURL := sdk.MsgTypeURL(&execMsg)
+	id = addAuthenticator(types.SignatureAuthenticatorConfig{AllowedMessages: []string{execTypeURL}})
+	require.ErrorIs(t, runWithSigner(t, suite, addr, sessionKey, &execMsg), sdkerrors.ErrUnauthorized)
+	require.NoError(t, suite.accountKeeper.RemoveAuthenticator(suite.ctx, addr, id))
+	id = addAuthenticator(types.SignatureAuthenticatorConfig{AllowedMessages: []string{execTypeURL, sdk.MsgTypeURL(&testdata.TestMsg{})}})
+	require.NoError(t, runWithSigner(t, suite, addr, sessionKey, &execMsg))
+	require.NoError(t, suite.accountKeeper.RemoveAuthenticator(suite.ctx, addr, id))
+
+	// a session key which expired
+	expired := blockTime
+	id = addAuthenticator(types.SignatureAuthenticatorConfig{Expiration: &expired})
+	require.ErrorIs(t, run(sessionKey), sdkerrors.ErrUnauthorized)
+	require.NoError(t, suite.accountKeeper.RemoveAuthenticator(suite.ctx, addr, id))
+
+	expiration := blockTime.Add(time.Hour)
+	addAuthenticator(types.SignatureAuthenticatorConfig{
+		AllowedMessages: []string{sdk.MsgTypeURL(&testdata.TestMsg{})},
+		Expiration:      &expiration,
+	})
+	require.NoError(t, run(sessionKey))
+
+	// the account keeps its public key and its own signatures
+	acc := suite.accountKeeper.GetAccount(suite.ctx, addr)
+	require.True(t, account.priv.PubKey().Equals(acc.GetPubKey()))
+	require.Equal(t, uint64(2), acc.GetSequence())
+	require.NoError(t, run(account.priv))
+
+	// another key is not accepted by the authenticator
+	require.ErrorIs(t, run(secp256k1.GenPrivKey()), sdkerrors.ErrUnauthorized)
+}
+
+func TestAuthenticatorManagementWithAuthenticator(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+
+	account := suite.CreateTestAccounts(1)[0]
+	require.NoError(t, account.acc.SetPubKey(account.priv.PubKey()))
+	suite.accountKeeper.SetAccount(suite.ctx, account.acc)
+	addr := account.acc.GetAddress()
+	addrStr, err := suite.accountKeeper.AddressCodec().BytesToString(addr)
+	require.NoError(t, err)
+
+	sessionKey := secp256k1.GenPrivKey()
+	pk, err := codectypes.NewAnyWithValue(sessionKey.PubKey())
+	require.NoError(t, err)
+	addAuthenticator := func(allowedMessages ...string) uint64 {
+		bz, err := suite.encCfg.Codec.Marshal(&types.SignatureAuthenticatorConfig{PubKey: pk, AllowedMessages: allowedMessages})
+		require.NoError(t, err)
+
+		id, err := suite.accountKeeper.AddAuthenticator(suite.ctx, addr, authenticator.SignatureType, bz)
+		require.NoError(t, err)
+		return id
+	}
+
+	// a session key allowed to send any message can't manage the authenticators
+	id := addAuthenticator()
+	addMsg := &types.MsgAddAuthenticator{Sender: addrStr, Type: authenticator.SignatureType}
+	removeMsg := &types.MsgRemoveAuthenticator{Sender: addrStr, Id: id}
+	execMsg := authz.NewMsgExec(addr, []sdk.Msg{removeMsg})
+	for _, msg := range []sdk.Msg{addMsg, removeMsg, &execMsg} {
+		require.ErrorIs(t, runWithSigner(t, suite, addr, sessionKey, msg), sdkerrors.ErrUnauthorized)
+	}
+	require.NoError(t, runWithSigner(t, suite, addr, sessionKey, testdata.NewTestMsg(addr)))
+	require.NoError(t, suite.accountKeeper.RemoveAuthenticator(suite.ctx, addr, id))
+
+	// unless the messages are explicitly allowed
+	id = addAuthenticator(sdk.MsgTypeURL(removeMsg))
+	removeMsg.Id = id
+	require.NoError(t, runWithSigner(t, suite, addr, sessionKey, removeMsg))
+	require.ErrorIs(t, runWithSigner(t, suite, addr, sessionKey, addMsg), sdkerrors.ErrUnauthorized)
+
+	// the account itself manages its authenticators
+	require.NoError(t, runWithSigner(t, suite, addr, account.priv, addMsg))
+}
+
+func TestSpendLimitAuthenticator(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	blockTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(blockTime)
+
+	accounts := suite.CreateTestAccounts(2)
+	account, recipient := accounts[0], accounts[1].acc.GetAddress()
+	require.NoError(t, account.acc.SetPubKey(account.priv.PubKey()))
+	suite.accountKeeper.SetAccount(suite.ctx, account.acc)
+	addr := account.acc.GetAddress()
+
+	sessionKey := secp256k1.GenPrivKey()
+	pk, err := codectypes.NewAnyWithValue(sessionKey.PubKey())
+	require.NoError(t, err)
+	bz, err := suite.encCfg.Codec.Marshal(&types.SpendLimitAuthenticatorConfig{
+		PubKey:      pk,
+		SpendLimit:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		Period:      time.Hour,
+		PeriodReset: blockTime.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	id, err := suite.accountKeeper.AddAuthenticator(suite.ctx, addr, authenticator.SpendLimitType, bz)
+	require.NoError(t, err)
+
+	send := func(amount int64) error {
+		msg := banktypes.NewMsgSend(addr, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))
+		return runWithSigner(t, suite, addr, sessionKey, msg)
+	}
+	periodSpent := func() sdk.Coins {
+		accAuth, err := suite.accountKeeper.AccountAuthenticators.Get(suite.ctx, collections.Join(addr, id))
+		require.NoError(t, err)
+
+		var cfg types.SpendLimitAuthenticatorConfig
+		require.NoError(t, suite.encCfg.Codec.Unmarshal(accAuth.Config, &cfg))
+		return cfg.PeriodSpent
+	}
+
+	// the sends are tracked within the spend limit
+	require.NoError(t, send(60))
+	require.NoError(t, send(40))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), periodSpent())
+	require.ErrorIs(t, send(1), sdkerrors.ErrUnauthorized)
+
+	// other denoms and messages are not allowed
+	require.ErrorIs(t, runWithSigner(t, suite, addr, sessionKey, banktypes.NewMsgSend(addr, recipient, sdk.NewCoins(sdk.NewInt64Coin("atom", 1)))), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, runWithSigner(t, suite, addr, sessionKey, testdata.NewTestMsg(addr)), sdkerrors.ErrUnauthorized)
+
+	// the spent amount is reset once the period ended
+	suite.ctx = suite.ctx.WithBlockTime(blockTime.Add(time.Hour))
+	require.NoError(t, send(70))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 70)), periodSpent())
+	require.ErrorIs(t, send(31), sdkerrors.ErrUnauthorized)
+
+	// the fees paid by the account count as spent, unless a fee granter pays them
+	sendWithFee := func(amount, fee int64, feeGranter sdk.AccAddress) error {
+		msg := banktypes.NewMsgSend(addr, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))
+		return runWithSignerAndFee(t, suite, addr, sessionKey, sdk.NewCoins(sdk.NewInt64Coin("stake", fee)), feeGranter, msg)
+	}
+	require.ErrorIs(t, sendWithFee(1, 1000, nil), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, sendWithFee(1, 30, nil), sdkerrors.ErrUnauthorized)
+	require.NoError(t, sendWithFee(1, 30, recipient))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 71)), periodSpent())
+	require.NoError(t, sendWithFee(1, 28, nil))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), periodSpent())
+}
+
+// runWithSigner runs the signature verification ante handlers on a tx holding
+// the messages, signed for the account with the given key.
+func runWithSigner(t *testing.T, suite *AnteTestSuite, addr sdk.AccAddress, priv cryptotypes.PrivKey, msgs ...sdk.Msg) error {
+	t.Helper()
+	return runWithSignerAndFee(t, suite, addr, priv, nil, nil, msgs...)
+}
+
+// runWithSignerAndFee runs runWithSigner on a tx paying the given fee, granted
+// by the fee granter if set.
+func runWithSignerAndFee(t *testing.T, suite *AnteTestSuite, addr sdk.AccAddress, priv cryptotypes.PrivKey, fee sdk.Coins, feeGranter sdk.AccAddress, msgs ...sdk.Msg) error {
+	t.Helper()
+
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, suite.txBuilder.SetMsgs(msgs...))
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetFeeAmount(fee)
+	suite.txBuilder.SetFeeGranter(feeGranter)
+
+	acc := suite.accountKeeper.GetAccount(suite.ctx, addr)
+	sig := signing.SignatureV2{
+		PubKey:   priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: acc.GetSequence(),
+	}
+	require.NoError(t, suite.txBuilder.SetSignatures(sig))
+
+	signerData := xauthsigning.SignerData{
+		Address:       addr.String(),
+		ChainID:       suite.ctx.ChainID(),
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      acc.GetSequence(),
+		PubKey:        priv.PubKey(),
+	}
+	sig, err := tx.SignWithPrivKey(suite.ctx, signing.SignMode_SIGN_MODE_DIRECT, signerData, suite.txBuilder, priv, suite.clientCtx.TxConfig, acc.GetSequence())
+	require.NoError(t, err)
+	require.NoError(t, suite.txBuilder.SetSignatures(sig))
+
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewSetPubKeyDecorator(suite.accountKeeper),
+		ante.NewSigGasConsumeDecorator(suite.accountKeeper, ante.DefaultSigVerificationGasConsumer),
+		ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler()),
+		ante.NewIncrementSequenceDecorator(suite.accountKeeper),
+	)
+	_, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	return err
+}
@@ -0,0 +1,91 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package ante_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func TestSigVerificationWithBLSAggregate(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	chainID := suite.ctx.ChainID()
+
+	// signs a tx with a new bls account
+	signedTx := func() []byte {
+		priv, err := bls12_381.GenPrivKey()
+		require.NoError(t, err)
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+		suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+		txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		sig := signing.SignatureV2{
+			PubKey: priv.PubKey(),
+			Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		}
+		require.NoError(t, txBuilder.SetSignatures(sig))
+
+		signerData := xauthsigning.SignerData{
+			Address:       addr.String(),
+			ChainID:       chainID,
+			AccountNumber: acc.GetAccountNumber(),
+			PubKey:        priv.PubKey(),
+		}
+		sig, err = tx.SignWithPrivKey(suite.ctx, signing.SignMode_SIGN_MODE_DIRECT, signerData, txBuilder, &priv, suite.clientCtx.TxConfig, 0)
+		require.NoError(t, err)
+		require.NoError(t, txBuilder.SetSignatures(sig))
+
+		bz, err := suite.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+
+	prepare := suite.accountKeeper.BLSPrepareProposalHandler(func(_ sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
+	})
+	process := suite.accountKeeper.BLSProcessProposalHandler(func(_ sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	})
+
+	prepared, err := prepare(suite.ctx, &abci.RequestPrepareProposal{Txs: [][]byte{signedTx(), signedTx()}})
+	require.NoError(t, err)
+	require.Len(t, prepared.Txs, 3)
+
+	processCtx := suite.ctx.WithExecMode(sdk.ExecModeProcessProposal)
+	res, err := process(processCtx, &abci.RequestProcessProposal{Txs: prepared.Txs})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewSetPubKeyDecorator(suite.accountKeeper),
+		ante.NewSigGasConsumeDecorator(suite.accountKeeper, ante.DefaultSigVerificationGasConsumer),
+		ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler()),
+	)
+	run := func(ctx sdk.Context, txBytes []byte) error {
+		strippedTx, err := suite.clientCtx.TxConfig.TxDecoder()(txBytes)
+		require.NoError(t, err)
+
+		_, err = antehandler(ctx.WithTxBytes(txBytes), strippedTx, false)
+		return err
+	}
+
+	// the stripped signatures are only accepted when covered by the aggregate
+	require.NoError(t, run(processCtx, prepared.Txs[1]))
+	require.NoError(t, run(processCtx, prepared.Txs[2]))
+	require.ErrorIs(t, run(suite.ctx.WithExecMode(sdk.ExecModeCheck), prepared.Txs[1]), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, run(suite.ctx.WithExecMode(sdk.ExecModeFinalize), prepared.Txs[1]), sdkerrors.ErrUnauthorized)
+}
@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/core/address"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -23,6 +24,22 @@ type AccountKeeper interface {
 	TryAddUnorderedNonce(ctx sdk.Context, sender []byte, timestamp time.Time) error
 }
 
+// AuthenticatorKeeper defines the contract needed to authenticate signers with
+// the authenticators registered by their accounts. The signature decorators
+// use it when the AccountKeeper implements it.
+type AuthenticatorKeeper interface {
+	HasAuthenticators(ctx context.Context, addr sdk.AccAddress) bool
+	Authenticate(ctx sdk.Context, req authenticator.Request) error
+}
+
+// BLSAggregateKeeper defines the contract needed to accept the transactions
+// whose BLS signature was replaced by the aggregate signature of their block.
+// The signature verification decorator uses it when the AccountKeeper
+// implements it.
+type BLSAggregateKeeper interface {
+	IsCoveredByBLSAggregate(ctx sdk.Context, txBytes []byte) bool
+}
+
 // FeegrantKeeper defines the expected feegrant keeper.
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
@@ -14,15 +14,18 @@ import (
 	txsigning "cosmossdk.io/x/tx/signing"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -92,6 +95,11 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSecp256k1Pubkey
 		}
+		// the signer is authenticated by the authenticators of the account, the
+		// public key does not belong to the account
+		if usesAuthenticator(ctx, spkd.ak, signers[i], pk) {
+			continue
+		}
 		// Only make check if simulate=false
 		if !simulate && !bytes.Equal(pk.Address(), signers[i]) && ctx.IsSigverifyTx() {
 			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
@@ -154,6 +162,35 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	return next(ctx, tx, simulate)
 }
 
+// usesAuthenticator returns true if the signer is authenticated by the
+// authenticators of its account rather than by the account public key, which is
+// the case when the public key of the signer info does not belong to the account.
+func usesAuthenticator(ctx sdk.Context, ak AccountKeeper, signer []byte, pk cryptotypes.PubKey) bool {
+	if pk == nil || bytes.Equal(pk.Address(), signer) {
+		return false
+	}
+
+	authKeeper, ok := ak.(AuthenticatorKeeper)
+	return ok && authKeeper.HasAuthenticators(ctx, signer)
+}
+
+// coveredByBLSAggregate returns true if the signature of the signer was
+// stripped from the transaction because it is covered by the verified BLS
+// aggregate signature of the block.
+func coveredByBLSAggregate(ctx sdk.Context, ak AccountKeeper, pk cryptotypes.PubKey, sig signing.SignatureV2) bool {
+	if _, ok := pk.(*bls12_381.PubKey); !ok || sig.PubKey == nil || !pk.Equals(sig.PubKey) {
+		return false
+	}
+
+	data, ok := sig.Data.(*signing.SingleSignatureData)
+	if !ok || data.SignMode != signing.SignMode_SIGN_MODE_DIRECT || len(data.Signature) != 0 {
+		return false
+	}
+
+	blsKeeper, ok := ak.(BLSAggregateKeeper)
+	return ok && blsKeeper.IsCoveredByBLSAggregate(ctx, ctx.TxBytes())
+}
+
 // SigGasConsumeDecorator consumes parameter-defined amount of gas for each signature according to the passed-in SignatureVerificationGasConsumer function
 // before calling the next AnteHandler
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
@@ -200,6 +237,9 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		}
 
 		pubKey := signerAcc.GetPubKey()
+		if usesAuthenticator(ctx, sgcd.ak, signers[i], sig.PubKey) {
+			pubKey = sig.PubKey
+		}
 
 		// In simulate mode the transaction comes with no signatures, thus if the
 		// account's pubkey is nil, both signature verification and gasKVStore.Set()
@@ -354,8 +394,13 @@ func (svd SigVerificationDecorator) anteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			return err
 		}
 
-		// retrieve pubkey
+		// retrieve pubkey, which is the one of the signer info for signers
+		// authenticated by the authenticators of the account
 		pubKey := acc.GetPubKey()
+		authenticated := usesAuthenticator(ctx, svd.ak, signers[i], sig.PubKey)
+		if authenticated {
+			pubKey = sig.PubKey
+		}
 		if !simulate && pubKey == nil {
 			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
@@ -397,6 +442,30 @@ func (svd SigVerificationDecorator) anteHandle(ctx sdk.Context, tx sdk.Tx, simul
 				return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
 			}
 			txData := adaptableTx.GetSigningTxData()
+			if authenticated {
+				req := authenticator.Request{
+					Account:         signers[i],
+					PubKey:          pubKey,
+					Signature:       sig.Data,
+					SignerData:      signerData,
+					TxData:          txData,
+					SignModeHandler: svd.signModeHandler,
+					Msgs:            tx.GetMsgs(),
+				}
+				if feeTx, ok := tx.(sdk.FeeTx); ok {
+					req.Fee = feeTx.GetFee()
+					req.FeePayer = feeTx.FeePayer()
+					req.FeeGranter = feeTx.FeeGranter()
+				}
+				if err := svd.ak.(AuthenticatorKeeper).Authenticate(ctx, req); err != nil {
+					return err
+				}
+				continue
+			}
+			if coveredByBLSAggregate(ctx, svd.ak, pubKey, sig) {
+				continue
+			}
+
 			err = authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, txData)
 			if err != nil {
 				var errMsg string
@@ -602,6 +671,14 @@ func DefaultSigVerificationGasConsumer(
 		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
 		return nil
 
+	case *webauthn.PubKey:
+		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: webauthn")
+		return nil
+
+	case *bls12_381.PubKey:
+		meter.ConsumeGas(params.SigVerifyCostBls12381(), "ante verify: bls12_381")
+		return nil
+
 	case multisig.PubKey:
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
@@ -6,11 +6,14 @@ import (
 	cmtcrypto "github.com/cometbft/cometbft/crypto"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 )
 
-// This benchmark is used to assess the ante.Secp256k1ToR1GasFactor value
+// This benchmark is used to assess the ante.Secp256k1ToR1GasFactor value and
+// the gas cost of bls12_381 signatures. The bls12_381 cases require the
+// bls12381 build tag.
 func BenchmarkSig(b *testing.B) {
 	require := require.New(b)
 	msg := cmtcrypto.CRandBytes(1000)
@@ -41,4 +44,48 @@ func BenchmarkSig(b *testing.B) {
 			require.True(ok)
 		}
 	})
+
+	b.Run("bls12_381", func(b *testing.B) {
+		if !bls12_381.Enabled {
+			b.Skip("bls12_381 keys are not built in")
+		}
+		skB, err := bls12_381.GenPrivKey()
+		require.NoError(err)
+		pkB := skB.PubKey()
+		sigB, err := skB.Sign(msg)
+		require.NoError(err)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for b.Loop() {
+			ok := pkB.VerifySignature(msg, sigB)
+			require.True(ok)
+		}
+	})
+
+	b.Run("bls12_381_agg100", func(b *testing.B) {
+		if !bls12_381.Enabled {
+			b.Skip("bls12_381 keys are not built in")
+		}
+		pubKeys := make([]*bls12_381.PubKey, 100)
+		msgs := make([][]byte, 100)
+		sigs := make([][]byte, 100)
+		for i := range pubKeys {
+			sk, err := bls12_381.GenPrivKey()
+			require.NoError(err)
+			pubKeys[i] = sk.PubKey().(*bls12_381.PubKey)
+			msgs[i] = cmtcrypto.CRandBytes(1000)
+			sigs[i], err = sk.Sign(msgs[i])
+			require.NoError(err)
+		}
+		aggSig, err := bls12_381.AggregateSignatures(sigs)
+		require.NoError(err)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for b.Loop() {
+			ok := bls12_381.VerifyAggregateSignature(pubKeys, msgs, aggSig)
+			require.True(ok)
+		}
+	})
 }
@@ -0,0 +1,122 @@
+// Package authenticator defines the authenticators accounts can register to
+// authenticate the signers of their transactions, in place of the public key
+// of the account.
+package authenticator
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Request holds a signature of a transaction to authenticate on behalf of an
+// account.
+type Request struct {
+	// Account is the address of the account the signer acts for.
+	Account sdk.AccAddress
+	// PubKey is the public key of the signer info, which does not belong to the account.
+	PubKey cryptotypes.PubKey
+	// Signature is the signature of the signer.
+	Signature signing.SignatureData
+	// SignerData is the signer data the signature was produced with.
+	SignerData txsigning.SignerData
+	// TxData is the transaction data the signature was produced for.
+	TxData txsigning.TxData
+	// SignModeHandler builds the sign bytes of the transaction.
+	SignModeHandler *txsigning.HandlerMap
+	// Msgs are the messages of the transaction.
+	Msgs []sdk.Msg
+	// Fee is the fee of the transaction.
+	Fee sdk.Coins
+	// FeePayer is the account paying the fee, unless a fee granter is set.
+	FeePayer sdk.AccAddress
+	// FeeGranter is the account granting the fee, if any.
+	FeeGranter sdk.AccAddress
+}
+
+// Authenticator authenticates the signers of transactions on behalf of the
+// accounts which registered it. An account registers an authenticator type
+// with its own configuration.
+type Authenticator interface {
+	// Type returns the type of the authenticator, which accounts refer to.
+	Type() string
+
+	// ValidateConfig checks the configuration given by an account registering
+	// the authenticator.
+	ValidateConfig(config []byte) error
+
+	// Authenticate returns an error if the request is not authenticated by the
+	// authenticator with the given configuration.
+	Authenticate(ctx sdk.Context, config []byte, req Request) error
+}
+
+// Tracker is implemented by the authenticators keeping track of the requests
+// they accepted in their configuration, such as the amount spent.
+type Tracker interface {
+	// Track returns the configuration updated with the request, once the
+	// authenticator accepted it.
+	Track(ctx sdk.Context, config []byte, req Request) ([]byte, error)
+}
+
+// MessageAllowlist is implemented by the authenticators which can be
+// configured with an explicit list of the messages their signers may send.
+type MessageAllowlist interface {
+	// AllowsExplicitly returns true if the configuration explicitly lists the
+	// message type URL.
+	AllowsExplicitly(config []byte, msgTypeURL string) bool
+}
+
+// authenticatorMsgTypeURLs are the messages managing the authenticators of an
+// account.
+var authenticatorMsgTypeURLs = []string{
+	sdk.MsgTypeURL(&types.MsgAddAuthenticator{}),
+	sdk.MsgTypeURL(&types.MsgRemoveAuthenticator{}),
+}
+
+// CheckAuthenticatorMsgs returns an error if the messages, or the messages
+// nested in them, manage authenticators while the authenticator with the given
+// configuration does not explicitly allow it. This keeps a session key from
+// registering other keys or removing the ones of the account.
+func CheckAuthenticatorMsgs(auth Authenticator, config []byte, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+		for _, authMsg := range authenticatorMsgTypeURLs {
+			if typeURL != authMsg {
+				continue
+			}
+
+			allowlist, ok := auth.(MessageAllowlist)
+			if !ok || !allowlist.AllowsExplicitly(config, typeURL) {
+				return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "message %s is not explicitly allowed by the authenticator", typeURL)
+			}
+		}
+
+		nested, err := nestedMsgs(msg)
+		if err != nil {
+			return err
+		}
+		if err := CheckAuthenticatorMsgs(auth, config, nested); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nestedMsgs returns the messages nested in msg, such as the ones of an authz
+// MsgExec or of a governance proposal.
+func nestedMsgs(msg sdk.Msg) ([]sdk.Msg, error) {
+	switch m := msg.(type) {
+	case interface{ GetMessages() ([]sdk.Msg, error) }:
+		return m.GetMessages()
+	case interface{ GetMsgs() ([]sdk.Msg, error) }:
+		return m.GetMsgs()
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,130 @@
+package authenticator
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// SignatureType is the type of the SignatureAuthenticator.
+const SignatureType = "signature"
+
+var (
+	_ Authenticator    = SignatureAuthenticator{}
+	_ MessageAllowlist = SignatureAuthenticator{}
+)
+
+// SignatureAuthenticator accepts the signatures of the public key of its
+// types.SignatureAuthenticatorConfig. Restricted to some messages or given an
+// expiration, it acts as a session key.
+type SignatureAuthenticator struct {
+	cdc codec.BinaryCodec
+}
+
+// NewSignatureAuthenticator returns a SignatureAuthenticator decoding its
+// configurations with the given codec.
+func NewSignatureAuthenticator(cdc codec.BinaryCodec) SignatureAuthenticator {
+	return SignatureAuthenticator{cdc: cdc}
+}
+
+// Type implements Authenticator.
+func (SignatureAuthenticator) Type() string {
+	return SignatureType
+}
+
+// ValidateConfig implements Authenticator.
+func (a SignatureAuthenticator) ValidateConfig(config []byte) error {
+	_, err := a.decodeConfig(config)
+	return err
+}
+
+// Authenticate implements Authenticator.
+func (a SignatureAuthenticator) Authenticate(ctx sdk.Context, config []byte, req Request) error {
+	cfg, err := a.decodeConfig(config)
+	if err != nil {
+		return err
+	}
+
+	pk, err := cfg.PublicKey()
+	if err != nil {
+		return err
+	}
+	if req.PubKey == nil || !pk.Equals(req.PubKey) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "public key does not match the authenticator")
+	}
+
+	if cfg.Expiration != nil && !ctx.BlockTime().Before(*cfg.Expiration) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "authenticator expired at %s", cfg.Expiration)
+	}
+
+	if len(cfg.AllowedMessages) > 0 {
+		if err := checkAllowedMsgs(cfg.AllowedMessages, req.Msgs); err != nil {
+			return err
+		}
+	}
+
+	if err := authsigning.VerifySignature(ctx, pk, req.SignerData, req.Signature, req.SignModeHandler, req.TxData); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+
+	return nil
+}
+
+// checkAllowedMsgs returns an error if a message, or a message nested in it
+// such as the ones of an authz MsgExec, is not in the allowed messages.
+func checkAllowedMsgs(allowed []string, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if !slices.Contains(allowed, sdk.MsgTypeURL(msg)) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "message %s is not allowed by the authenticator", sdk.MsgTypeURL(msg))
+		}
+
+		nested, err := nestedMsgs(msg)
+		if err != nil {
+			return err
+		}
+		if err := checkAllowedMsgs(allowed, nested); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AllowsExplicitly implements MessageAllowlist.
+func (a SignatureAuthenticator) AllowsExplicitly(config []byte, msgTypeURL string) bool {
+	cfg, err := a.decodeConfig(config)
+	return err == nil && slices.Contains(cfg.AllowedMessages, msgTypeURL)
+}
+
+func (a SignatureAuthenticator) decodeConfig(config []byte) (types.SignatureAuthenticatorConfig, error) {
+	var cfg types.SignatureAuthenticatorConfig
+	if err := a.cdc.Unmarshal(config, &cfg); err != nil {
+		return cfg, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if cfg.PubKey == nil {
+		return cfg, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "authenticator public key cannot be empty")
+	}
+	if _, err := cfg.PublicKey(); err != nil {
+		return cfg, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+
+	for i, msg := range cfg.AllowedMessages {
+		if msg == "" {
+			return cfg, errors.New("allowed message type cannot be empty")
+		}
+		if slices.Contains(cfg.AllowedMessages[:i], msg) {
+			return cfg, fmt.Errorf("duplicate allowed message type %s", msg)
+		}
+	}
+
+	return cfg, nil
+}
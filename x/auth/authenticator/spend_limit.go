@@ -0,0 +1,191 @@
+package authenticator
+
+import (
+	"bytes"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// SpendLimitType is the type of the SpendLimitAuthenticator.
+const SpendLimitType = "spend_limit"
+
+var (
+	_ Authenticator = SpendLimitAuthenticator{}
+	_ Tracker       = SpendLimitAuthenticator{}
+)
+
+// SpendLimitAuthenticator accepts the signatures of the public key of its
+// types.SpendLimitAuthenticatorConfig for bank sends only, as long as the
+// coins sent from the account during the current period, including the fees
+// it pays, stay within the spend limit.
+type SpendLimitAuthenticator struct {
+	cdc          codec.BinaryCodec
+	addressCodec address.Codec
+}
+
+// NewSpendLimitAuthenticator returns a SpendLimitAuthenticator decoding its
+// configurations with the given codec, and the addresses of the bank messages
+// with the given address codec.
+func NewSpendLimitAuthenticator(cdc codec.BinaryCodec, addressCodec address.Codec) SpendLimitAuthenticator {
+	return SpendLimitAuthenticator{cdc: cdc, addressCodec: addressCodec}
+}
+
+// Type implements Authenticator.
+func (SpendLimitAuthenticator) Type() string {
+	return SpendLimitType
+}
+
+// ValidateConfig implements Authenticator.
+func (a SpendLimitAuthenticator) ValidateConfig(config []byte) error {
+	_, err := a.decodeConfig(config)
+	return err
+}
+
+// Authenticate implements Authenticator.
+func (a SpendLimitAuthenticator) Authenticate(ctx sdk.Context, config []byte, req Request) error {
+	cfg, err := a.decodeConfig(config)
+	if err != nil {
+		return err
+	}
+
+	pk, err := cfg.PublicKey()
+	if err != nil {
+		return err
+	}
+	if req.PubKey == nil || !pk.Equals(req.PubKey) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "public key does not match the authenticator")
+	}
+
+	if _, err := a.spend(ctx, cfg, req); err != nil {
+		return err
+	}
+
+	if err := authsigning.VerifySignature(ctx, pk, req.SignerData, req.Signature, req.SignModeHandler, req.TxData); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+
+	return nil
+}
+
+// Track implements Tracker, adding the coins sent by the request to the amount
+// spent in the current period.
+func (a SpendLimitAuthenticator) Track(ctx sdk.Context, config []byte, req Request) ([]byte, error) {
+	cfg, err := a.decodeConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err = a.spend(ctx, cfg, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.cdc.Marshal(&cfg)
+}
+
+// spend returns the configuration updated with the coins sent from the account
+// by the messages of the request and the fee it pays, resetting the amount spent once the period
+// ended. It returns an error if a message is not a bank send or if the spend
+// limit is exceeded.
+func (a SpendLimitAuthenticator) spend(ctx sdk.Context, cfg types.SpendLimitAuthenticatorConfig, req Request) (types.SpendLimitAuthenticatorConfig, error) {
+	spent := sdk.NewCoins()
+	// the fee counts as spent when it is taken from the account
+	if len(req.FeeGranter) == 0 && bytes.Equal(req.FeePayer, req.Account) {
+		spent = spent.Add(req.Fee...)
+	}
+	for _, msg := range req.Msgs {
+		coins, err := a.sentCoins(req.Account, msg)
+		if err != nil {
+			return cfg, err
+		}
+		spent = spent.Add(coins...)
+	}
+
+	blockTime := ctx.BlockTime()
+	if cfg.Period > 0 && !blockTime.Before(cfg.PeriodReset) {
+		cfg.PeriodSpent = sdk.NewCoins()
+		cfg.PeriodReset = cfg.PeriodReset.Add(cfg.Period)
+		if blockTime.After(cfg.PeriodReset) {
+			cfg.PeriodReset = blockTime.Add(cfg.Period)
+		}
+	}
+
+	cfg.PeriodSpent = cfg.PeriodSpent.Add(spent...)
+	if !cfg.PeriodSpent.IsAllLTE(cfg.SpendLimit) {
+		return cfg, sdkerrors.ErrInsufficientFunds.Wrapf("spending %s exceeds the spend limit of %s", cfg.PeriodSpent, cfg.SpendLimit)
+	}
+
+	return cfg, nil
+}
+
+// sentCoins returns the coins sent from the account by a bank send message.
+func (a SpendLimitAuthenticator) sentCoins(account sdk.AccAddress, msg sdk.Msg) (sdk.Coins, error) {
+	isAccount := func(addr string) (bool, error) {
+		bz, err := a.addressCodec.StringToBytes(addr)
+		if err != nil {
+			return false, sdkerrors.ErrInvalidAddress.Wrapf("invalid address %s: %s", addr, err)
+		}
+		return bytes.Equal(bz, account), nil
+	}
+
+	switch m := msg.(type) {
+	case *banktypes.MsgSend:
+		if ok, err := isAccount(m.FromAddress); err != nil || !ok {
+			return nil, err
+		}
+		return m.Amount, nil
+
+	case *banktypes.MsgMultiSend:
+		var coins sdk.Coins
+		for _, in := range m.Inputs {
+			ok, err := isAccount(in.Address)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				coins = coins.Add(in.Coins...)
+			}
+		}
+		return coins, nil
+
+	default:
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "message %s is not allowed by the authenticator", sdk.MsgTypeURL(msg))
+	}
+}
+
+func (a SpendLimitAuthenticator) decodeConfig(config []byte) (types.SpendLimitAuthenticatorConfig, error) {
+	var cfg types.SpendLimitAuthenticatorConfig
+	if err := a.cdc.Unmarshal(config, &cfg); err != nil {
+		return cfg, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if cfg.PubKey == nil {
+		return cfg, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "authenticator public key cannot be empty")
+	}
+	if _, err := cfg.PublicKey(); err != nil {
+		return cfg, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+
+	if len(cfg.SpendLimit) == 0 {
+		return cfg, sdkerrors.ErrInvalidCoins.Wrap("spend limit cannot be empty")
+	}
+	if err := cfg.SpendLimit.Validate(); err != nil {
+		return cfg, sdkerrors.ErrInvalidCoins.Wrapf("invalid spend limit: %s", err)
+	}
+	if err := cfg.PeriodSpent.Validate(); err != nil {
+		return cfg, sdkerrors.ErrInvalidCoins.Wrapf("invalid period spent: %s", err)
+	}
+	if cfg.Period < 0 {
+		return cfg, sdkerrors.ErrInvalidRequest.Wrap("period cannot be negative")
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,255 @@
+package cli
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+const (
+	flagAuthenticatorData = "authenticator-data"
+	flagClientDataJSON    = "client-data-json"
+	flagAssertionSig      = "assertion-signature"
+)
+
+// passkeyChallenge is the challenge of the WebAuthn assertion signing a
+// transaction.
+type passkeyChallenge struct {
+	Challenge      string `json:"challenge"`
+	RelyingPartyID string `json:"relying_party_id"`
+}
+
+// GetPasskeyCommand returns the command signing transactions with WebAuthn
+// credentials, such as passkeys.
+func GetPasskeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "passkey",
+		Short: "Sign transactions generated offline with a passkey",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sign transactions generated offline with a WebAuthn credential, such as a passkey.
+
+The credential is added to the keyring with the keys add-passkey command. The challenge command
+prints the challenge of the transaction, to request an assertion of the credential from a browser
+or an authenticator, and the sign command attaches the assertion to the transaction.
+
+Example:
+$ %[1]s keys add-passkey alice <public-key> --rp-id wallet.example.com
+$ %[1]s tx bank send alice <recipient> 10stake --generate-only > tx.json
+$ %[1]s tx passkey challenge tx.json --from alice
+$ %[1]s tx passkey sign tx.json --from alice --authenticator-data <data> --client-data-json <data> --assertion-signature <sig>
+`,
+				version.AppName,
+			),
+		),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		getPasskeyChallengeCmd(),
+		getPasskeySignCmd(),
+	)
+
+	return cmd
+}
+
+func getPasskeyChallengeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "challenge [file]",
+		Short: "Print the challenge of a transaction generated offline for a passkey",
+		Long: `Print the base64url encoded challenge of the WebAuthn assertion signing the transaction read
+from [file] with the passkey given with --from.
+
+If the --offline flag is on, the account number and sequence are not queried and must be
+set with the --account-number and --sequence flags.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, pubKey, _, signBytes, err := passkeySignBytes(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(passkeyChallenge{
+				Challenge:      webauthn.Challenge(signBytes),
+				RelyingPartyID: pubKey.RelyingPartyId,
+			})
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getPasskeySignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [file]",
+		Short: "Sign a transaction generated offline with a passkey assertion",
+		Long: `Sign the transaction read from [file] with the WebAuthn assertion of the passkey given with
+--from, and print its JSON encoding. The authenticator data, the client data JSON and the DER
+signature of the assertion are base64 encoded. The assertion is verified before the signature
+is attached.
+
+If the --offline flag is on, the account number and sequence are not queried and must be
+set with the --account-number and --sequence flags.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txBuilder, pubKey, txFactory, signBytes, err := passkeySignBytes(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			assertion := make(map[string][]byte)
+			for _, flag := range []string{flagAuthenticatorData, flagClientDataJSON, flagAssertionSig} {
+				value, _ := cmd.Flags().GetString(flag)
+				if assertion[flag], err = decodeBase64(value); err != nil {
+					return fmt.Errorf("invalid --%s: %w", flag, err)
+				}
+			}
+
+			sig, err := webauthn.NewSignature(assertion[flagAuthenticatorData], assertion[flagClientDataJSON], assertion[flagAssertionSig])
+			if err != nil {
+				return err
+			}
+			if err := pubKey.VerifyAssertion(signBytes, sig); err != nil {
+				return err
+			}
+
+			sigBytes, err := sig.Marshal()
+			if err != nil {
+				return err
+			}
+			err = txBuilder.SetSignatures(signingtypes.SignatureV2{
+				PubKey:   pubKey,
+				Data:     &signingtypes.SingleSignatureData{SignMode: txFactory.SignMode(), Signature: sigBytes},
+				Sequence: txFactory.Sequence(),
+			})
+			if err != nil {
+				return err
+			}
+
+			json, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+
+			closeFunc, err := setOutputFile(cmd)
+			if err != nil {
+				return err
+			}
+			defer closeFunc()
+
+			cmd.Printf("%s\n", json)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagAuthenticatorData, "", "The base64 encoded authenticator data of the assertion")
+	cmd.Flags().String(flagClientDataJSON, "", "The base64 encoded client data JSON of the assertion")
+	cmd.Flags().String(flagAssertionSig, "", "The base64 encoded DER signature of the assertion")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
+	_ = cmd.MarkFlagRequired(flagAuthenticatorData)
+	_ = cmd.MarkFlagRequired(flagClientDataJSON)
+	_ = cmd.MarkFlagRequired(flagAssertionSig)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// passkeySignBytes reads the transaction of the given file, sets the signer
+// info of the passkey given with --from, and returns the bytes to sign.
+func passkeySignBytes(cmd *cobra.Command, clientCtx client.Context, filename string) (client.TxBuilder, *webauthn.PubKey, tx.Factory, []byte, error) {
+	unsignedTx, err := authclient.ReadTxFromFile(clientCtx, filename)
+	if err != nil {
+		return nil, nil, tx.Factory{}, nil, err
+	}
+
+	txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+	if err != nil {
+		return nil, nil, tx.Factory{}, nil, err
+	}
+	if txFactory, err = txFactory.Prepare(clientCtx); err != nil {
+		return nil, nil, tx.Factory{}, nil, err
+	}
+	if txFactory.SignMode() == signingtypes.SignMode_SIGN_MODE_UNSPECIFIED {
+		txFactory = txFactory.WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT)
+	}
+
+	k, err := clientCtx.Keyring.Key(clientCtx.FromName)
+	if err != nil {
+		return nil, nil, tx.Factory{}, nil, err
+	}
+	pk, err := k.GetPubKey()
+	if err != nil {
+		return nil, nil, tx.Factory{}, nil, err
+	}
+	pubKey, ok := pk.(*webauthn.PubKey)
+	if !ok {
+		return nil, nil, tx.Factory{}, nil, fmt.Errorf("key %s is not a passkey: %s", clientCtx.FromName, pk.Type())
+	}
+
+	txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(unsignedTx)
+	if err != nil {
+		return nil, nil, tx.Factory{}, nil, err
+	}
+
+	// the signer info is part of the sign bytes in SIGN_MODE_DIRECT
+	err = txBuilder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &signingtypes.SingleSignatureData{SignMode: txFactory.SignMode()},
+		Sequence: txFactory.Sequence(),
+	})
+	if err != nil {
+		return nil, nil, tx.Factory{}, nil, err
+	}
+
+	addr, err := clientCtx.TxConfig.SigningContext().AddressCodec().BytesToString(pubKey.Address())
+	if err != nil {
+		return nil, nil, tx.Factory{}, nil, err
+	}
+
+	signerData := authsigning.SignerData{
+		ChainID:       txFactory.ChainID(),
+		AccountNumber: txFactory.AccountNumber(),
+		Sequence:      txFactory.Sequence(),
+		PubKey:        pubKey,
+		Address:       addr,
+	}
+	signBytes, err := authsigning.GetSignBytesAdapter(cmd.Context(), clientCtx.TxConfig.SignModeHandler(), txFactory.SignMode(), signerData, txBuilder.GetTx())
+	if err != nil {
+		return nil, nil, tx.Factory{}, nil, err
+	}
+
+	return txBuilder, pubKey, txFactory, signBytes, nil
+}
+
+// decodeBase64 decodes standard or URL base64, with or without padding.
+func decodeBase64(s string) ([]byte, error) {
+	s = strings.TrimRight(strings.TrimSpace(s), "=")
+	if strings.ContainsAny(s, "-_") {
+		return base64.RawURLEncoding.DecodeString(s)
+	}
+	return base64.RawStdEncoding.DecodeString(s)
+}
@@ -0,0 +1,413 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+const (
+	flagSessionBroadcast = "broadcast"
+	flagSessionListen    = "listen"
+)
+
+// GetMultiSignSessionCommand returns the command managing the signing sessions
+// of multisig transactions.
+func GetMultiSignSessionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "multisign-session",
+		Aliases: []string{"multi-sign-session"},
+		Short:   "Collect the signatures of a multisig transaction through a signing session",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Collect the signatures of the members of a multisig key for a transaction generated offline.
+
+A signing session holds the unsigned transaction, the multisig key and the signatures of its members.
+It is either shared as a file, or served by a local HTTP coordinator, in which case members pass the
+URL of the coordinator instead of the session file.
+
+Example:
+$ %[1]s tx multisign-session create transaction.json k1k2k3 session.json
+$ %[1]s tx multisign-session sign session.json --from k1
+$ %[1]s tx multisign-session sign session.json --from k2 --broadcast
+
+$ %[1]s tx multisign-session serve session.json --listen 127.0.0.1:8765 --broadcast
+$ %[1]s tx multisign-session sign http://127.0.0.1:8765 --from k1
+`,
+				version.AppName,
+			),
+		),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		getSessionCreateCmd(),
+		getSessionSignCmd(),
+		getSessionStatusCmd(),
+		getSessionAssembleCmd(),
+		getSessionServeCmd(),
+	)
+
+	return cmd
+}
+
+func getSessionCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [file] [multisig] [session-file]",
+		Short: "Create the signing session of a transaction generated offline by a multisig key",
+		Long: `Create a signing session of the unsigned transaction read from [file] by the multisig key [multisig],
+and write it to [session-file].
+
+If the --offline flag is on, the account number and sequence are not queried and must be
+set with the --account-number and --sequence flags.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			unsignedTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			k, err := getMultisigRecord(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+			pubKey, err := k.GetPubKey()
+			if err != nil {
+				return err
+			}
+
+			if !clientCtx.Offline {
+				addr, err := k.GetAddress()
+				if err != nil {
+					return err
+				}
+
+				accNum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
+				if err != nil {
+					return err
+				}
+				txFactory = txFactory.WithAccountNumber(accNum).WithSequence(seq)
+			}
+
+			session, err := authclient.NewSigningSession(unsignedTx, pubKey, txFactory.ChainID(), txFactory.AccountNumber(), txFactory.Sequence())
+			if err != nil {
+				return err
+			}
+
+			if err := authclient.WriteSigningSession(clientCtx, args[2], session); err != nil {
+				return err
+			}
+
+			return printSigningSessionStatus(clientCtx, session)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getSessionSignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [session]",
+		Short: "Sign the transaction of a signing session with a member key",
+		Long: `Sign the transaction of the signing session [session] with the member key given with --from.
+
+[session] is either a session file, which is updated with the signature, or the URL of a
+coordinator, to which the signature is submitted.
+
+If the --broadcast flag is on and the session is a file, the transaction is assembled and
+broadcast as soon as the threshold of the multisig is met.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// the account number and sequence are the ones of the session
+			txFactory := tx.Factory{}.
+				WithKeybase(clientCtx.Keyring).
+				WithTxConfig(clientCtx.TxConfig)
+			switch clientCtx.SignModeStr {
+			case "":
+			case flags.SignModeLegacyAminoJSON:
+				txFactory = txFactory.WithSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+			default:
+				return fmt.Errorf("multisig signing sessions only support the %s sign mode", flags.SignModeLegacyAminoJSON)
+			}
+
+			session, err := readSigningSession(cmd.Context(), clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			sig, err := session.Sign(cmd.Context(), clientCtx, txFactory, clientCtx.FromName)
+			if err != nil {
+				return err
+			}
+
+			if authclient.IsSigningSessionURL(args[0]) {
+				status, err := authclient.SubmitSigningSessionSignature(cmd.Context(), clientCtx, args[0], sig)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintObjectLegacy(status)
+			}
+
+			if err := authclient.WriteSigningSession(clientCtx, args[0], session); err != nil {
+				return err
+			}
+
+			if broadcast, _ := cmd.Flags().GetBool(flagSessionBroadcast); broadcast && session.Ready() {
+				return broadcastSigningSession(clientCtx, session)
+			}
+
+			return printSigningSessionStatus(clientCtx, session)
+		},
+	}
+
+	cmd.Flags().Bool(flagSessionBroadcast, false, "Broadcast the transaction once the threshold of the multisig is met")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getSessionStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [session]",
+		Short: "Show which members of the multisig signed the transaction of a signing session",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			session, err := readSigningSession(cmd.Context(), clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			return printSigningSessionStatus(clientCtx, session)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getSessionAssembleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assemble [session]",
+		Short: "Assemble the multisig transaction of a signing session once its threshold is met",
+		Long: `Assemble the multisig signature of the signing session [session] and print the signed
+transaction, or broadcast it if the --broadcast flag is on.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			session, err := readSigningSession(cmd.Context(), clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			if broadcast, _ := cmd.Flags().GetBool(flagSessionBroadcast); broadcast {
+				return broadcastSigningSession(clientCtx, session)
+			}
+
+			signedTx, err := session.Assemble(clientCtx.TxConfig)
+			if err != nil {
+				return err
+			}
+
+			json, err := clientCtx.TxConfig.TxJSONEncoder()(signedTx)
+			if err != nil {
+				return err
+			}
+
+			closeFunc, err := setOutputFile(cmd)
+			if err != nil {
+				return err
+			}
+			defer closeFunc()
+
+			cmd.Printf("%s\n", json)
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagSessionBroadcast, false, "Broadcast the assembled transaction")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getSessionServeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve [session-file]",
+		Short: "Coordinate a signing session over HTTP",
+		Long: `Serve the signing session [session-file] over HTTP, so that members sign it by passing the URL
+of the coordinator to the sign command. The session file is updated with every signature.
+
+If the --broadcast flag is on, the transaction is assembled and broadcast as soon as the
+threshold of the multisig is met, and the coordinator stops.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			session, err := authclient.ReadSigningSession(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			broadcast, _ := cmd.Flags().GetBool(flagSessionBroadcast)
+			if broadcast && clientCtx.Offline {
+				return errors.New("cannot broadcast tx during offline mode")
+			}
+
+			var once sync.Once
+			done := make(chan error, 1)
+			coordinator := authclient.NewSigningSessionCoordinator(clientCtx, session, func(s *authclient.SigningSession) error {
+				if err := authclient.WriteSigningSession(clientCtx, args[0], s); err != nil {
+					return err
+				}
+
+				if broadcast && s.Ready() {
+					once.Do(func() { done <- broadcastSigningSession(clientCtx, s) })
+				}
+
+				return nil
+			})
+
+			listenAddr, _ := cmd.Flags().GetString(flagSessionListen)
+			lis, err := net.Listen("tcp", listenAddr)
+			if err != nil {
+				return err
+			}
+
+			server := &http.Server{
+				Handler:           coordinator,
+				ReadHeaderTimeout: 10 * time.Second,
+			}
+			go func() {
+				if err := server.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+					done <- err
+				}
+			}()
+			status, err := session.Status(clientCtx.TxConfig.SigningContext().AddressCodec())
+			if err != nil {
+				return err
+			}
+			cmd.PrintErrf("serving signing session of %s on http://%s\n", status.Multisig, lis.Addr())
+
+			ctx := cmd.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			select {
+			case err = <-done:
+			case <-ctx.Done():
+			}
+
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if shutdownErr := server.Shutdown(shutdownCtx); err == nil {
+				err = shutdownErr
+			}
+
+			return err
+		},
+	}
+
+	cmd.Flags().String(flagSessionListen, "127.0.0.1:8765", "The address the coordinator listens on")
+	cmd.Flags().Bool(flagSessionBroadcast, false, "Broadcast the transaction once the threshold of the multisig is met")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readSigningSession reads a signing session from a file or fetches it from a
+// coordinator.
+func readSigningSession(ctx context.Context, clientCtx client.Context, location string) (*authclient.SigningSession, error) {
+	if authclient.IsSigningSessionURL(location) {
+		if ctx == nil {
+			ctx = context.Background()
+		}
+
+		return authclient.FetchSigningSession(ctx, clientCtx, location)
+	}
+
+	return authclient.ReadSigningSession(clientCtx, location)
+}
+
+// broadcastSigningSession assembles and broadcasts the transaction of a
+// signing session.
+// printSigningSessionStatus prints which members of the multisig signed the
+// transaction of the session.
+func printSigningSessionStatus(clientCtx client.Context, session *authclient.SigningSession) error {
+	status, err := session.Status(clientCtx.TxConfig.SigningContext().AddressCodec())
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintObjectLegacy(status)
+}
+
+func broadcastSigningSession(clientCtx client.Context, session *authclient.SigningSession) error {
+	if clientCtx.Offline {
+		return errors.New("cannot broadcast tx during offline mode")
+	}
+
+	signedTx, err := session.Assemble(clientCtx.TxConfig)
+	if err != nil {
+		return err
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(signedTx)
+	if err != nil {
+		return err
+	}
+
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
@@ -0,0 +1,368 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"cosmossdk.io/core/address"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// SigningSession holds the state of the signing of a transaction by the
+// members of a multisig key. Members add their signatures to the session
+// until the threshold of the multisig is met, when the multisig signature
+// can be assembled.
+type SigningSession struct {
+	ChainID       string
+	AccountNumber uint64
+	Sequence      uint64
+	PubKey        *kmultisig.LegacyAminoPubKey
+	Tx            sdk.Tx
+	Signatures    []signing.SignatureV2
+}
+
+// SigningSessionStatus describes which members of the multisig signed the
+// transaction of a signing session.
+type SigningSessionStatus struct {
+	Multisig  string                 `json:"multisig" yaml:"multisig"`
+	Threshold uint32                 `json:"threshold" yaml:"threshold"`
+	Members   []SigningSessionMember `json:"members" yaml:"members"`
+	Ready     bool                   `json:"ready" yaml:"ready"`
+}
+
+// SigningSessionMember describes whether a member signed the transaction of a
+// signing session.
+type SigningSessionMember struct {
+	Address string `json:"address" yaml:"address"`
+	Signed  bool   `json:"signed" yaml:"signed"`
+}
+
+type signingSessionJSON struct {
+	ChainID       string          `json:"chain_id"`
+	AccountNumber uint64          `json:"account_number,string"`
+	Sequence      uint64          `json:"sequence,string"`
+	PubKey        json.RawMessage `json:"pub_key"`
+	Tx            json.RawMessage `json:"tx"`
+	Signatures    json.RawMessage `json:"signatures,omitempty"`
+}
+
+// NewSigningSession returns a signing session of the given unsigned
+// transaction by the members of the multisig key.
+func NewSigningSession(unsignedTx sdk.Tx, pubKey cryptotypes.PubKey, chainID string, accNum, seq uint64) (*SigningSession, error) {
+	multisigPub, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+	if !ok {
+		return nil, fmt.Errorf("expected a multisig public key, got %T", pubKey)
+	}
+
+	if chainID == "" {
+		return nil, errors.New("set the chain id with either the --chain-id flag or config file")
+	}
+
+	sigTx, ok := unsignedTx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil, fmt.Errorf("expected Tx to be signing.SigVerifiableTx, got %T", unsignedTx)
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return nil, err
+	}
+	if !isTxSigner(multisigPub.Address(), signers) {
+		return nil, fmt.Errorf("multisig %s is not a signer of the transaction", sdk.AccAddress(multisigPub.Address()))
+	}
+
+	return &SigningSession{
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      seq,
+		PubKey:        multisigPub,
+		Tx:            unsignedTx,
+	}, nil
+}
+
+// EncodeSigningSession encodes a signing session to JSON.
+func EncodeSigningSession(clientCtx client.Context, s *SigningSession) ([]byte, error) {
+	pubKey, err := clientCtx.Codec.MarshalInterfaceJSON(s.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	txJSON, err := clientCtx.TxConfig.TxJSONEncoder()(s.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var sigs []byte
+	if len(s.Signatures) > 0 {
+		sigs, err = clientCtx.TxConfig.MarshalSignatureJSON(s.Signatures)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return json.MarshalIndent(signingSessionJSON{
+		ChainID:       s.ChainID,
+		AccountNumber: s.AccountNumber,
+		Sequence:      s.Sequence,
+		PubKey:        pubKey,
+		Tx:            txJSON,
+		Signatures:    sigs,
+	}, "", "  ")
+}
+
+// DecodeSigningSession decodes a signing session from JSON.
+func DecodeSigningSession(clientCtx client.Context, bz []byte) (*SigningSession, error) {
+	var raw signingSessionJSON
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return nil, fmt.Errorf("invalid signing session: %w", err)
+	}
+
+	var pubKey cryptotypes.PubKey
+	if err := clientCtx.Codec.UnmarshalInterfaceJSON(raw.PubKey, &pubKey); err != nil {
+		return nil, fmt.Errorf("invalid signing session public key: %w", err)
+	}
+
+	unsignedTx, err := clientCtx.TxConfig.TxJSONDecoder()(raw.Tx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signing session transaction: %w", err)
+	}
+
+	s, err := NewSigningSession(unsignedTx, pubKey, raw.ChainID, raw.AccountNumber, raw.Sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(raw.Signatures) > 0 {
+		s.Signatures, err = clientCtx.TxConfig.UnmarshalSignatureJSON(raw.Signatures)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signing session signatures: %w", err)
+		}
+	}
+
+	return s, nil
+}
+
+// ReadSigningSession reads a signing session from the given file.
+func ReadSigningSession(clientCtx client.Context, filename string) (*SigningSession, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeSigningSession(clientCtx, bz)
+}
+
+// WriteSigningSession writes a signing session to the given file.
+func WriteSigningSession(clientCtx client.Context, filename string, s *SigningSession) error {
+	bz, err := EncodeSigningSession(clientCtx, s)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, bz, 0o600)
+}
+
+// Sign signs the transaction of the session with the `name` key, which must be
+// a member of the multisig, and adds the signature to the session.
+func (s *SigningSession) Sign(ctx context.Context, clientCtx client.Context, txFactory tx.Factory, name string) (signing.SignatureV2, error) {
+	switch txFactory.SignMode() {
+	case signing.SignMode_SIGN_MODE_UNSPECIFIED:
+		txFactory = txFactory.WithSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	case signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
+	default:
+		return signing.SignatureV2{}, fmt.Errorf("multisig signing sessions only support the %s sign mode", signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	}
+
+	txBuilder, err := s.txBuilder(clientCtx.TxConfig)
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+
+	txFactory = txFactory.
+		WithChainID(s.ChainID).
+		WithAccountNumber(s.AccountNumber).
+		WithSequence(s.Sequence)
+	if err := tx.Sign(ctx, txFactory, name, txBuilder, true); err != nil {
+		return signing.SignatureV2{}, err
+	}
+
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+	if len(sigs) != 1 {
+		return signing.SignatureV2{}, fmt.Errorf("expected one signature, got %d", len(sigs))
+	}
+
+	return sigs[0], s.AddSignature(ctx, clientCtx.TxConfig, sigs[0])
+}
+
+// AddSignature verifies the signature of a member of the multisig and adds it
+// to the session, replacing any previous signature of the member.
+func (s *SigningSession) AddSignature(ctx context.Context, txCfg client.TxConfig, sig signing.SignatureV2) error {
+	if sig.PubKey == nil {
+		return errors.New("signature has no public key")
+	}
+
+	// only single signatures are combined into the multisig signature
+	if data, ok := sig.Data.(*signing.SingleSignatureData); !ok || data.SignMode != signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+		return fmt.Errorf("multisig signing sessions only support single signatures in the %s sign mode", signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	}
+
+	addr := sdk.AccAddress(sig.PubKey.Address())
+	if s.memberIndex(addr) < 0 {
+		return fmt.Errorf("%s is not a member of multisig %s", addr, sdk.AccAddress(s.PubKey.Address()))
+	}
+
+	if sig.Sequence != s.Sequence {
+		return fmt.Errorf("signature of %s has sequence %d, expected %d", addr, sig.Sequence, s.Sequence)
+	}
+
+	anyPk, err := codectypes.NewAnyWithValue(sig.PubKey)
+	if err != nil {
+		return err
+	}
+	addrStr, err := txCfg.SigningContext().AddressCodec().BytesToString(addr)
+	if err != nil {
+		return err
+	}
+	signerData := txsigning.SignerData{
+		ChainID:       s.ChainID,
+		AccountNumber: s.AccountNumber,
+		Sequence:      s.Sequence,
+		Address:       addrStr,
+		PubKey: &anypb.Any{
+			TypeUrl: anyPk.TypeUrl,
+			Value:   anyPk.Value,
+		},
+	}
+
+	adaptableTx, ok := s.Tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return fmt.Errorf("expected Tx to be signing.V2AdaptableTx, got %T", s.Tx)
+	}
+	err = authsigning.VerifySignature(ctx, sig.PubKey, signerData, sig.Data, txCfg.SignModeHandler(), adaptableTx.GetSigningTxData())
+	if err != nil {
+		return fmt.Errorf("couldn't verify signature for address %s: %w", addr, err)
+	}
+
+	for i, prev := range s.Signatures {
+		if bytes.Equal(prev.PubKey.Address(), sig.PubKey.Address()) {
+			s.Signatures[i] = sig
+			return nil
+		}
+	}
+	s.Signatures = append(s.Signatures, sig)
+
+	return nil
+}
+
+// Status returns which members of the multisig signed the transaction, with
+// their addresses encoded by the address codec.
+func (s *SigningSession) Status(addressCodec address.Codec) (SigningSessionStatus, error) {
+	signed := make(map[string]bool, len(s.Signatures))
+	for _, sig := range s.Signatures {
+		addr, err := addressCodec.BytesToString(sig.PubKey.Address())
+		if err != nil {
+			return SigningSessionStatus{}, err
+		}
+		signed[addr] = true
+	}
+
+	multisigAddr, err := addressCodec.BytesToString(s.PubKey.Address())
+	if err != nil {
+		return SigningSessionStatus{}, err
+	}
+	status := SigningSessionStatus{
+		Multisig:  multisigAddr,
+		Threshold: s.PubKey.Threshold,
+		Ready:     s.Ready(),
+	}
+	for _, pk := range s.PubKey.GetPubKeys() {
+		addr, err := addressCodec.BytesToString(pk.Address())
+		if err != nil {
+			return SigningSessionStatus{}, err
+		}
+		status.Members = append(status.Members, SigningSessionMember{Address: addr, Signed: signed[addr]})
+	}
+
+	return status, nil
+}
+
+// Ready returns true if enough members signed the transaction to meet the
+// threshold of the multisig.
+func (s *SigningSession) Ready() bool {
+	return len(s.Signatures) >= int(s.PubKey.Threshold)
+}
+
+// Assemble returns the transaction of the session signed by the multisig,
+// once its threshold is met.
+func (s *SigningSession) Assemble(txCfg client.TxConfig) (sdk.Tx, error) {
+	if !s.Ready() {
+		return nil, fmt.Errorf("multisig threshold not met: %d of %d signatures", len(s.Signatures), s.PubKey.Threshold)
+	}
+
+	multisigSig := multisig.NewMultisig(len(s.PubKey.PubKeys))
+	for _, sig := range s.Signatures {
+		if err := multisig.AddSignatureV2(multisigSig, sig, s.PubKey.GetPubKeys()); err != nil {
+			return nil, err
+		}
+	}
+
+	txBuilder, err := s.txBuilder(txCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   s.PubKey,
+		Data:     multisigSig,
+		Sequence: s.Sequence,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return txBuilder.GetTx(), nil
+}
+
+// txBuilder returns a builder of a copy of the transaction of the session, so
+// that signing it leaves the session unchanged.
+func (s *SigningSession) txBuilder(txCfg client.TxConfig) (client.TxBuilder, error) {
+	bz, err := txCfg.TxJSONEncoder()(s.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	txCopy, err := txCfg.TxJSONDecoder()(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	return txCfg.WrapTxBuilder(txCopy)
+}
+
+func (s *SigningSession) memberIndex(addr sdk.AccAddress) int {
+	for i, pk := range s.PubKey.GetPubKeys() {
+		if bytes.Equal(pk.Address(), addr) {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,180 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// Paths served by the signing session coordinator.
+const (
+	SigningSessionPath           = "/session"
+	SigningSessionSignaturesPath = "/signatures"
+)
+
+// maxSigningSessionRequestSize bounds the size of the signatures submitted to
+// a coordinator.
+const maxSigningSessionRequestSize = 1 << 20
+
+// SigningSessionCoordinator serves a signing session over HTTP, so that the
+// members of the multisig can fetch its transaction and submit their
+// signatures without sharing files.
+type SigningSessionCoordinator struct {
+	mu        sync.Mutex
+	clientCtx client.Context
+	session   *SigningSession
+	onUpdate  func(*SigningSession) error
+}
+
+var _ http.Handler = &SigningSessionCoordinator{}
+
+// NewSigningSessionCoordinator returns a coordinator of the given session.
+// onUpdate is called each time a signature is added to the session, for
+// instance to persist it or to broadcast the transaction once it is ready.
+func NewSigningSessionCoordinator(clientCtx client.Context, session *SigningSession, onUpdate func(*SigningSession) error) *SigningSessionCoordinator {
+	return &SigningSessionCoordinator{
+		clientCtx: clientCtx,
+		session:   session,
+		onUpdate:  onUpdate,
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (c *SigningSessionCoordinator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch {
+	case r.URL.Path == SigningSessionPath && r.Method == http.MethodGet:
+		bz, err := EncodeSigningSession(c.clientCtx, c.session)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, bz)
+
+	case r.URL.Path == SigningSessionSignaturesPath && r.Method == http.MethodPost:
+		bz, err := io.ReadAll(io.LimitReader(r.Body, maxSigningSessionRequestSize))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		sigs, err := c.clientCtx.TxConfig.UnmarshalSignatureJSON(bz)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		for _, sig := range sigs {
+			if err := c.session.AddSignature(r.Context(), c.clientCtx.TxConfig, sig); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		if c.onUpdate != nil {
+			if err := c.onUpdate(c.session); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		status, err := c.session.Status(c.clientCtx.TxConfig.SigningContext().AddressCodec())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		bz, err = json.Marshal(status)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, bz)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// IsSigningSessionURL returns true if the given session location is the URL
+// of a coordinator rather than a file.
+func IsSigningSessionURL(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
+
+// FetchSigningSession fetches the signing session served by the coordinator
+// at the given URL.
+func FetchSigningSession(ctx context.Context, clientCtx client.Context, url string) (*SigningSession, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(url, "/")+SigningSessionPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := doSigningSessionRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeSigningSession(clientCtx, bz)
+}
+
+// SubmitSigningSessionSignature submits a signature to the coordinator at the
+// given URL, and returns the status of the session.
+func SubmitSigningSessionSignature(ctx context.Context, clientCtx client.Context, url string, sig signing.SignatureV2) (SigningSessionStatus, error) {
+	body, err := clientCtx.TxConfig.MarshalSignatureJSON([]signing.SignatureV2{sig})
+	if err != nil {
+		return SigningSessionStatus{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(url, "/")+SigningSessionSignaturesPath, bytes.NewReader(body))
+	if err != nil {
+		return SigningSessionStatus{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	bz, err := doSigningSessionRequest(req)
+	if err != nil {
+		return SigningSessionStatus{}, err
+	}
+
+	var status SigningSessionStatus
+	if err := json.Unmarshal(bz, &status); err != nil {
+		return SigningSessionStatus{}, err
+	}
+
+	return status, nil
+}
+
+func doSigningSessionRequest(req *http.Request) ([]byte, error) {
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	bz, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("signing session coordinator returned %s: %s", res.Status, strings.TrimSpace(string(bz)))
+	}
+
+	return bz, nil
+}
+
+func writeJSON(w http.ResponseWriter, bz []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(bz)
+}
@@ -0,0 +1,137 @@
+package client_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSigningSession(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{})
+	clientCtx := client.Context{}.
+		WithCodec(encCfg.Codec).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry).
+		WithTxConfig(encCfg.TxConfig)
+
+	kr := keyring.NewInMemory(encCfg.Codec)
+	pubKeys := make([]cryptotypes.PubKey, 3)
+	for i, name := range []string{"k1", "k2", "k3", "other"} {
+		record, _, err := kr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		if i < len(pubKeys) {
+			pubKeys[i], err = record.GetPubKey()
+			require.NoError(t, err)
+		}
+	}
+	multisigPub := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	multisigAddr := sdk.AccAddress(multisigPub.Address())
+
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(multisigAddr, sdk.AccAddress(pubKeys[0].Address()), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))))
+	txBuilder.SetGasLimit(200000)
+
+	_, err := authclient.NewSigningSession(txBuilder.GetTx(), pubKeys[0], "test-chain", 1, 2)
+	require.ErrorContains(t, err, "expected a multisig public key")
+
+	session, err := authclient.NewSigningSession(txBuilder.GetTx(), multisigPub, "test-chain", 1, 2)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	txFactory := tx.Factory{}.WithKeybase(kr).WithTxConfig(encCfg.TxConfig)
+
+	// the first member signs the session shared as a file
+	_, err = session.Sign(ctx, clientCtx, txFactory, "k1")
+	require.NoError(t, err)
+	_, err = session.Sign(ctx, clientCtx, txFactory, "other")
+	require.ErrorContains(t, err, "is not a member")
+
+	// only single signatures in the amino JSON sign mode are accepted
+	directBuilder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, directBuilder.SetMsgs(txBuilder.GetTx().GetMsgs()...))
+	directBuilder.SetGasLimit(200000)
+	directFactory := txFactory.WithSignMode(signing.SignMode_SIGN_MODE_DIRECT).WithChainID("test-chain").WithAccountNumber(1).WithSequence(2)
+	require.NoError(t, tx.Sign(ctx, directFactory, "k3", directBuilder, true))
+	directSigs, err := directBuilder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.ErrorContains(t, session.AddSignature(ctx, encCfg.TxConfig, directSigs[0]), "only support single signatures")
+	multiSig := signing.SignatureV2{PubKey: pubKeys[2], Data: &signing.MultiSignatureData{}, Sequence: 2}
+	require.ErrorContains(t, session.AddSignature(ctx, encCfg.TxConfig, multiSig), "only support single signatures")
+
+	filename := filepath.Join(t.TempDir(), "session.json")
+	require.NoError(t, authclient.WriteSigningSession(clientCtx, filename, session))
+	session, err = authclient.ReadSigningSession(clientCtx, filename)
+	require.NoError(t, err)
+
+	status, err := session.Status(encCfg.TxConfig.SigningContext().AddressCodec())
+	require.NoError(t, err)
+	require.Equal(t, multisigAddr.String(), status.Multisig)
+	require.Len(t, status.Members, 3)
+	require.True(t, status.Members[0].Signed)
+	require.False(t, status.Members[1].Signed)
+	require.False(t, status.Ready)
+	_, err = session.Assemble(encCfg.TxConfig)
+	require.ErrorContains(t, err, "threshold not met")
+
+	// the second member signs through a coordinator
+	var updates int
+	server := httptest.NewServer(authclient.NewSigningSessionCoordinator(clientCtx, session, func(*authclient.SigningSession) error {
+		updates++
+		return nil
+	}))
+	defer server.Close()
+
+	remote, err := authclient.FetchSigningSession(ctx, clientCtx, server.URL)
+	require.NoError(t, err)
+	sig, err := remote.Sign(ctx, clientCtx, txFactory, "k2")
+	require.NoError(t, err)
+
+	status, err = authclient.SubmitSigningSessionSignature(ctx, clientCtx, server.URL, sig)
+	require.NoError(t, err)
+	require.True(t, status.Ready)
+	require.Equal(t, 1, updates)
+
+	// a signature for another sequence is rejected
+	sig.Sequence++
+	_, err = authclient.SubmitSigningSessionSignature(ctx, clientCtx, server.URL, sig)
+	require.ErrorContains(t, err, "400 Bad Request")
+
+	signedTx, err := session.Assemble(encCfg.TxConfig)
+	require.NoError(t, err)
+
+	sigs, err := signedTx.(authsigning.Tx).GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+
+	anyPk, err := codectypes.NewAnyWithValue(multisigPub)
+	require.NoError(t, err)
+	signerData := txsigning.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      2,
+		Address:       multisigAddr.String(),
+		PubKey:        &anypb.Any{TypeUrl: anyPk.TypeUrl, Value: anyPk.Value},
+	}
+	txData := signedTx.(authsigning.V2AdaptableTx).GetSigningTxData()
+	require.NoError(t, authsigning.VerifySignature(ctx, multisigPub, signerData, sigs[0].Data, encCfg.TxConfig.SignModeHandler(), txData))
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AddAuthenticator registers an authenticator of the given type and
+// configuration for an account, and returns its id.
+func (ak AccountKeeper) AddAuthenticator(ctx context.Context, addr sdk.AccAddress, authType string, config []byte) (uint64, error) {
+	auth, ok := ak.authenticators[authType]
+	if !ok {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown authenticator type %s", authType)
+	}
+
+	if err := auth.ValidateConfig(config); err != nil {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid %s authenticator config: %s", authType, err)
+	}
+
+	authenticators, err := ak.GetAuthenticators(ctx, addr)
+	if err != nil {
+		return 0, err
+	}
+	if len(authenticators) >= types.MaxAuthenticatorsPerAccount {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account cannot have more than %d authenticators", types.MaxAuthenticatorsPerAccount)
+	}
+
+	addrStr, err := ak.addressCodec.BytesToString(addr)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := ak.AuthenticatorSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	// ids start at 1, as the sequence starts at 0
+	id++
+
+	err = ak.AccountAuthenticators.Set(ctx, collections.Join(addr, id), types.AccountAuthenticator{
+		Account: addrStr,
+		Id:      id,
+		Type:    authType,
+		Config:  config,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddAuthenticator,
+			sdk.NewAttribute(types.AttributeKeyAccount, addrStr),
+			sdk.NewAttribute(types.AttributeKeyAuthenticatorID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAuthenticatorType, authType),
+		),
+	)
+
+	return id, nil
+}
+
+// RemoveAuthenticator removes an authenticator of an account.
+func (ak AccountKeeper) RemoveAuthenticator(ctx context.Context, addr sdk.AccAddress, id uint64) error {
+	key := collections.Join(addr, id)
+	auth, err := ak.AccountAuthenticators.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrapf(sdkerrors.ErrNotFound, "authenticator %d of %s", id, addr)
+		}
+		return err
+	}
+
+	if err := ak.AccountAuthenticators.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveAuthenticator,
+			sdk.NewAttribute(types.AttributeKeyAccount, auth.Account),
+			sdk.NewAttribute(types.AttributeKeyAuthenticatorID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAuthenticatorType, auth.Type),
+		),
+	)
+
+	return nil
+}
+
+// GetAuthenticators returns the authenticators of an account.
+func (ak AccountKeeper) GetAuthenticators(ctx context.Context, addr sdk.AccAddress) ([]types.AccountAuthenticator, error) {
+	iter, err := ak.AccountAuthenticators.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr))
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// HasAuthenticators returns true if the account registered authenticators.
+func (ak AccountKeeper) HasAuthenticators(ctx context.Context, addr sdk.AccAddress) bool {
+	iter, err := ak.AccountAuthenticators.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr))
+	if err != nil {
+		return false
+	}
+	defer iter.Close()
+
+	return iter.Valid()
+}
+
+// Authenticate authenticates a signer on behalf of an account with the
+// authenticators of the account. It succeeds as soon as one of them accepts
+// the request, whose configuration is then updated if it tracks the requests.
+// Each authenticator tried consumes the gas of a secp256k1 signature
+// verification. Messages managing authenticators are only accepted by the
+// authenticators explicitly allowing them.
+func (ak AccountKeeper) Authenticate(ctx sdk.Context, req authenticator.Request) error {
+	authenticators, err := ak.GetAuthenticators(ctx, req.Account)
+	if err != nil {
+		return err
+	}
+
+	lastErr := errors.New("no authenticator registered")
+	params := ak.GetParams(ctx)
+	for _, accAuth := range authenticators {
+		auth, ok := ak.authenticators[accAuth.Type]
+		if !ok {
+			// the authenticator type is no longer registered by the app
+			continue
+		}
+
+		if lastErr = authenticator.CheckAuthenticatorMsgs(auth, accAuth.Config, req.Msgs); lastErr != nil {
+			continue
+		}
+
+		ctx.GasMeter().ConsumeGas(params.SigVerifyCostSecp256k1, "authenticator: "+accAuth.Type)
+		if lastErr = auth.Authenticate(ctx, accAuth.Config, req); lastErr != nil {
+			continue
+		}
+
+		tracker, ok := auth.(authenticator.Tracker)
+		if !ok {
+			return nil
+		}
+
+		accAuth.Config, err = tracker.Track(ctx, accAuth.Config, req)
+		if err != nil {
+			return err
+		}
+		return ak.AccountAuthenticators.Set(ctx, collections.Join(req.Account, accAuth.Id), accAuth)
+	}
+
+	return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "no authenticator of %s accepts the signature: %s", req.Account, lastErr)
+}
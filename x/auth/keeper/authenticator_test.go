@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) signatureAuthenticatorConfig() []byte {
+	pk, err := codectypes.NewAnyWithValue(secp256k1.GenPrivKey().PubKey())
+	suite.Require().NoError(err)
+
+	bz, err := suite.encCfg.Codec.Marshal(&types.SignatureAuthenticatorConfig{PubKey: pk})
+	suite.Require().NoError(err)
+	return bz
+}
+
+func (suite *KeeperTestSuite) TestAddRemoveAuthenticator() {
+	alice := sdk.AccAddress("alice_______________")
+
+	res, err := suite.msgServer.AddAuthenticator(suite.ctx, &types.MsgAddAuthenticator{
+		Sender: alice.String(),
+		Type:   authenticator.SignatureType,
+		Config: suite.signatureAuthenticatorConfig(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Id)
+
+	suite.Require().True(suite.accountKeeper.HasAuthenticators(suite.ctx, alice))
+
+	_, err = suite.msgServer.AddAuthenticator(suite.ctx, &types.MsgAddAuthenticator{
+		Sender: alice.String(),
+		Type:   "unknown",
+		Config: suite.signatureAuthenticatorConfig(),
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = suite.msgServer.AddAuthenticator(suite.ctx, &types.MsgAddAuthenticator{
+		Sender: alice.String(),
+		Type:   authenticator.SignatureType,
+		Config: []byte("invalid"),
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	for i := 1; i < types.MaxAuthenticatorsPerAccount; i++ {
+		_, err = suite.accountKeeper.AddAuthenticator(suite.ctx, alice, authenticator.SignatureType, suite.signatureAuthenticatorConfig())
+		suite.Require().NoError(err)
+	}
+	_, err = suite.accountKeeper.AddAuthenticator(suite.ctx, alice, authenticator.SignatureType, suite.signatureAuthenticatorConfig())
+	suite.Require().ErrorContains(err, "cannot have more than")
+
+	queryRes, err := suite.queryClient.AccountAuthenticators(suite.ctx, &types.QueryAccountAuthenticatorsRequest{Address: alice.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(queryRes.Authenticators, types.MaxAuthenticatorsPerAccount)
+
+	_, err = suite.msgServer.RemoveAuthenticator(suite.ctx, &types.MsgRemoveAuthenticator{Sender: alice.String(), Id: 1})
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.RemoveAuthenticator(suite.ctx, &types.MsgRemoveAuthenticator{Sender: alice.String(), Id: 1})
+	suite.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	authenticators, err := suite.accountKeeper.GetAuthenticators(suite.ctx, alice)
+	suite.Require().NoError(err)
+	suite.Require().Len(authenticators, types.MaxAuthenticatorsPerAccount-1)
+}
+
+func (suite *KeeperTestSuite) TestAuthenticatorGenesis() {
+	alice := sdk.AccAddress("alice_______________")
+	_, err := suite.accountKeeper.AddAuthenticator(suite.ctx, alice, authenticator.SignatureType, suite.signatureAuthenticatorConfig())
+	suite.Require().NoError(err)
+	_, err = suite.accountKeeper.AddAuthenticator(suite.ctx, alice, authenticator.SignatureType, suite.signatureAuthenticatorConfig())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.accountKeeper.Params.Set(suite.ctx, types.DefaultParams()))
+	genState := suite.accountKeeper.ExportGenesis(suite.ctx)
+	suite.Require().Len(genState.Authenticators, 2)
+	suite.Require().NoError(types.ValidateGenesis(*genState))
+
+	suite.SetupTest()
+	suite.accountKeeper.InitGenesis(suite.ctx, *genState)
+
+	authenticators, err := suite.accountKeeper.GetAuthenticators(suite.ctx, alice)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genState.Authenticators, authenticators)
+
+	// ids keep increasing after the import
+	id, err := suite.accountKeeper.AddAuthenticator(suite.ctx, alice, authenticator.SignatureType, suite.signatureAuthenticatorConfig())
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), id)
+}
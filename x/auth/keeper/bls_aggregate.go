@@ -0,0 +1,318 @@
+package keeper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// blsAggregateTxPrefix prefixes the BLS aggregate signature injected by the
+// proposer as the first transaction of a block.
+var blsAggregateTxPrefix = []byte("bls-aggregate:")
+
+// blsAggregates holds the hashes of the transactions covered by the verified
+// aggregate signatures of the current proposal and of the block being
+// finalized, which the signature verification of the ante handler relies on.
+type blsAggregates struct {
+	mu       sync.Mutex
+	proposal blsCoverage
+	block    blsCoverage
+}
+
+// blsCoverage is the set of transactions covered by a verified aggregate
+// signature.
+type blsCoverage struct {
+	// digest identifies the aggregate signature and the transactions it covers.
+	digest [32]byte
+	txs    map[[32]byte]struct{}
+}
+
+// blsSignedTx is a transaction signed by a single BLS key in SIGN_MODE_DIRECT.
+type blsSignedTx struct {
+	raw       tx.TxRaw
+	pubKey    *bls12_381.PubKey
+	signBytes []byte
+}
+
+// BLSPrepareProposalHandler wraps the given handler to aggregate the
+// signatures of the transactions of the proposal signed by a single BLS key in
+// SIGN_MODE_DIRECT. Their signatures are stripped and the aggregate signature
+// is injected as the first transaction of the proposal. Transactions whose
+// signature is invalid, or whose signer account does not exist yet, are left
+// untouched.
+//
+// Stripping the signatures changes the hashes of the aggregated transactions.
+func (ak AccountKeeper) BLSPrepareProposalHandler(next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		resp, err := next(ctx, req)
+		if err != nil || !bls12_381.Enabled {
+			return resp, err
+		}
+
+		var (
+			sigs     [][]byte
+			indexes  []int
+			stripped [][]byte
+		)
+		seen := make(map[string]struct{})
+		for i, txBytes := range resp.Txs {
+			signedTx, ok := ak.decodeBLSSignedTx(ctx, txBytes)
+			if !ok || len(signedTx.raw.Signatures[0]) == 0 {
+				continue
+			}
+			// aggregated messages must be distinct
+			if _, ok := seen[string(signedTx.signBytes)]; ok {
+				continue
+			}
+			if !signedTx.pubKey.VerifySignature(signedTx.signBytes, signedTx.raw.Signatures[0]) {
+				continue
+			}
+
+			sig := signedTx.raw.Signatures[0]
+			signedTx.raw.Signatures = [][]byte{{}}
+			bz, err := signedTx.raw.Marshal()
+			if err != nil {
+				return nil, err
+			}
+
+			seen[string(signedTx.signBytes)] = struct{}{}
+			sigs = append(sigs, sig)
+			indexes = append(indexes, i)
+			stripped = append(stripped, bz)
+		}
+
+		// a single signature is not worth aggregating
+		if len(sigs) < 2 {
+			return resp, nil
+		}
+
+		aggSig, err := bls12_381.AggregateSignatures(sigs)
+		if err != nil {
+			return nil, err
+		}
+
+		txs := make([][]byte, 0, len(resp.Txs)+1)
+		txs = append(txs, append(append([]byte{}, blsAggregateTxPrefix...), aggSig...))
+		txs = append(txs, resp.Txs...)
+		for j, i := range indexes {
+			txs[i+1] = stripped[j]
+		}
+
+		resp.Txs = txs
+		return resp, nil
+	}
+}
+
+// BLSProcessProposalHandler wraps the given handler to verify the BLS
+// aggregate signature injected as the first transaction of the proposal
+// against the transactions whose signature was stripped, and to strip it
+// before calling the handler. Proposals with an invalid aggregate signature
+// are rejected.
+func (ak AccountKeeper) BLSProcessProposalHandler(next sdk.ProcessProposalHandler) sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if len(req.Txs) == 0 || !bytes.HasPrefix(req.Txs[0], blsAggregateTxPrefix) {
+			ak.blsAggregates.setProposal(blsCoverage{})
+			return next(ctx, req)
+		}
+
+		coverage, err := ak.verifyBLSAggregate(ctx, req.Txs[0], req.Txs[1:])
+		if err != nil {
+			ak.Logger(ctx).Error("proposal with invalid bls aggregate signature", "height", req.Height, "err", err)
+			ak.blsAggregates.setProposal(blsCoverage{})
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+		ak.blsAggregates.setProposal(coverage)
+
+		nextReq := *req
+		nextReq.Txs = req.Txs[1:]
+		return next(ctx, &nextReq)
+	}
+}
+
+// BLSPreBlocker wraps the given PreBlocker to verify the BLS aggregate
+// signature injected in the block before its transactions are executed. The
+// verification of the last processed proposal is reused when it covers the
+// same transactions. If the aggregate signature is invalid, the transactions
+// whose signature was stripped fail.
+func (ak AccountKeeper) BLSPreBlocker(next sdk.PreBlocker) sdk.PreBlocker {
+	return func(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		var coverage blsCoverage
+		// other injected transactions may precede the aggregate signature
+		for i, txBytes := range req.Txs {
+			if !bytes.HasPrefix(txBytes, blsAggregateTxPrefix) {
+				continue
+			}
+
+			digest := blsAggregateDigest(txBytes, req.Txs[i+1:])
+			if proposal := ak.blsAggregates.getProposal(); proposal.txs != nil && proposal.digest == digest {
+				coverage = proposal
+				break
+			}
+
+			var err error
+			coverage, err = ak.verifyBLSAggregate(ctx, txBytes, req.Txs[i+1:])
+			if err != nil {
+				ak.Logger(ctx).Error("block with invalid bls aggregate signature", "height", req.Height, "err", err)
+				coverage = blsCoverage{}
+			}
+			break
+		}
+		ak.blsAggregates.setBlock(coverage)
+
+		return next(ctx, req)
+	}
+}
+
+// IsCoveredByBLSAggregate returns true if the given transaction is covered by
+// the verified BLS aggregate signature of the proposal being processed or of
+// the block being finalized.
+func (ak AccountKeeper) IsCoveredByBLSAggregate(ctx sdk.Context, txBytes []byte) bool {
+	var coverage blsCoverage
+	switch ctx.ExecMode() {
+	case sdk.ExecModeProcessProposal:
+		coverage = ak.blsAggregates.getProposal()
+	case sdk.ExecModeFinalize:
+		coverage = ak.blsAggregates.getBlock()
+	default:
+		return false
+	}
+
+	_, ok := coverage.txs[sha256.Sum256(txBytes)]
+	return ok
+}
+
+// verifyBLSAggregate verifies the aggregate signature of the given injected
+// transaction against the given transactions whose signature was stripped, and
+// returns the set of covered transactions.
+func (ak AccountKeeper) verifyBLSAggregate(ctx sdk.Context, aggTx []byte, txs [][]byte) (blsCoverage, error) {
+	if !bls12_381.Enabled {
+		return blsCoverage{}, errors.New("bls12_381 keys are not built in")
+	}
+
+	var (
+		pubKeys []*bls12_381.PubKey
+		msgs    [][]byte
+	)
+	coverage := blsCoverage{
+		digest: blsAggregateDigest(aggTx, txs),
+		txs:    make(map[[32]byte]struct{}),
+	}
+	for _, txBytes := range txs {
+		signedTx, ok := ak.decodeBLSSignedTx(ctx, txBytes)
+		if !ok || len(signedTx.raw.Signatures[0]) != 0 {
+			continue
+		}
+
+		pubKeys = append(pubKeys, signedTx.pubKey)
+		msgs = append(msgs, signedTx.signBytes)
+		coverage.txs[sha256.Sum256(txBytes)] = struct{}{}
+	}
+
+	if len(pubKeys) == 0 {
+		return blsCoverage{}, errors.New("no transaction covered by the aggregate signature")
+	}
+
+	if !bls12_381.VerifyAggregateSignature(pubKeys, msgs, aggTx[len(blsAggregateTxPrefix):]) {
+		return blsCoverage{}, errors.New("invalid aggregate signature")
+	}
+
+	return coverage, nil
+}
+
+// decodeBLSSignedTx decodes the given transaction and computes its sign bytes
+// if it is signed by a single BLS key in SIGN_MODE_DIRECT, and its signer
+// account exists.
+func (ak AccountKeeper) decodeBLSSignedTx(ctx sdk.Context, txBytes []byte) (blsSignedTx, bool) {
+	var signedTx blsSignedTx
+	if err := signedTx.raw.Unmarshal(txBytes); err != nil || len(signedTx.raw.Signatures) != 1 {
+		return signedTx, false
+	}
+
+	var authInfo tx.AuthInfo
+	if err := authInfo.Unmarshal(signedTx.raw.AuthInfoBytes); err != nil || len(authInfo.SignerInfos) != 1 {
+		return signedTx, false
+	}
+
+	signerInfo := authInfo.SignerInfos[0]
+	single := signerInfo.ModeInfo.GetSingle()
+	if single == nil || single.Mode != signing.SignMode_SIGN_MODE_DIRECT || signerInfo.PublicKey == nil {
+		return signedTx, false
+	}
+
+	var pubKey cryptotypes.PubKey
+	if err := ak.cdc.UnpackAny(signerInfo.PublicKey, &pubKey); err != nil {
+		return signedTx, false
+	}
+	blsPubKey, ok := pubKey.(*bls12_381.PubKey)
+	if !ok {
+		return signedTx, false
+	}
+
+	acc := ak.GetAccount(ctx, sdk.AccAddress(blsPubKey.Address()))
+	if acc == nil {
+		return signedTx, false
+	}
+
+	signDoc := tx.SignDoc{
+		BodyBytes:     signedTx.raw.BodyBytes,
+		AuthInfoBytes: signedTx.raw.AuthInfoBytes,
+		ChainId:       ctx.ChainID(),
+		AccountNumber: acc.GetAccountNumber(),
+	}
+	signBytes, err := signDoc.Marshal()
+	if err != nil {
+		return signedTx, false
+	}
+
+	signedTx.pubKey = blsPubKey
+	signedTx.signBytes = signBytes
+	return signedTx, true
+}
+
+// blsAggregateDigest identifies an aggregate signature and the transactions
+// following it.
+func blsAggregateDigest(aggTx []byte, txs [][]byte) [32]byte {
+	h := sha256.New()
+	h.Write(aggTx)
+	for _, txBytes := range txs {
+		txHash := sha256.Sum256(txBytes)
+		h.Write(txHash[:])
+	}
+
+	var digest [32]byte
+	copy(digest[:], h.Sum(nil))
+	return digest
+}
+
+func (a *blsAggregates) getProposal() blsCoverage {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.proposal
+}
+
+func (a *blsAggregates) setProposal(coverage blsCoverage) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.proposal = coverage
+}
+
+func (a *blsAggregates) getBlock() blsCoverage {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.block
+}
+
+func (a *blsAggregates) setBlock(coverage blsCoverage) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.block = coverage
+}
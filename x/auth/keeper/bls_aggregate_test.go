@@ -0,0 +1,136 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package keeper_test
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// blsSignedTx returns a transaction signed in SIGN_MODE_DIRECT by the given
+// BLS key, whose account is created if needed.
+func (suite *KeeperTestSuite) blsSignedTx(privKey bls12_381.PrivKey, memo string) []byte {
+	pubKey := privKey.PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	acc := suite.accountKeeper.GetAccount(suite.ctx, addr)
+	if acc == nil {
+		acc = suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+		suite.accountKeeper.SetAccount(suite.ctx, acc)
+	}
+
+	anyPk, err := codectypes.NewAnyWithValue(pubKey)
+	suite.Require().NoError(err)
+
+	body := tx.TxBody{Memo: memo}
+	authInfo := tx.AuthInfo{
+		SignerInfos: []*tx.SignerInfo{{
+			PublicKey: anyPk,
+			ModeInfo:  &tx.ModeInfo{Sum: &tx.ModeInfo_Single_{Single: &tx.ModeInfo_Single{Mode: signing.SignMode_SIGN_MODE_DIRECT}}},
+		}},
+		Fee: &tx.Fee{},
+	}
+	raw := tx.TxRaw{
+		BodyBytes:     suite.encCfg.Codec.MustMarshal(&body),
+		AuthInfoBytes: suite.encCfg.Codec.MustMarshal(&authInfo),
+	}
+
+	signDoc := tx.SignDoc{
+		BodyBytes:     raw.BodyBytes,
+		AuthInfoBytes: raw.AuthInfoBytes,
+		ChainId:       suite.ctx.ChainID(),
+		AccountNumber: acc.GetAccountNumber(),
+	}
+	sig, err := privKey.Sign(suite.encCfg.Codec.MustMarshal(&signDoc))
+	suite.Require().NoError(err)
+	raw.Signatures = [][]byte{sig}
+
+	return suite.encCfg.Codec.MustMarshal(&raw)
+}
+
+func (suite *KeeperTestSuite) TestBLSAggregate() {
+	suite.ctx = suite.ctx.WithChainID("test-chain")
+	ak := suite.accountKeeper
+
+	var txs [][]byte
+	for i := range 3 {
+		privKey, err := bls12_381.GenPrivKey()
+		suite.Require().NoError(err)
+		txs = append(txs, suite.blsSignedTx(privKey, fmt.Sprintf("tx %d", i)))
+	}
+	// a transaction with an invalid signature is not aggregated
+	privKey, err := bls12_381.GenPrivKey()
+	suite.Require().NoError(err)
+	invalidTx := suite.blsSignedTx(privKey, "invalid")
+	var raw tx.TxRaw
+	suite.Require().NoError(raw.Unmarshal(invalidTx))
+	raw.Signatures[0][0] ^= 1
+	invalidTx = suite.encCfg.Codec.MustMarshal(&raw)
+	txs = append(txs, invalidTx, []byte("not a tx"))
+
+	var processed [][]byte
+	prepare := ak.BLSPrepareProposalHandler(func(_ sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
+	})
+	process := ak.BLSProcessProposalHandler(func(_ sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		processed = req.Txs
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	})
+	preBlock := ak.BLSPreBlocker(func(sdk.Context, *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		return &sdk.ResponsePreBlock{}, nil
+	})
+
+	prepared, err := prepare(suite.ctx, &abci.RequestPrepareProposal{Txs: txs})
+	suite.Require().NoError(err)
+	suite.Require().Len(prepared.Txs, len(txs)+1)
+	for i, txBytes := range prepared.Txs[1:4] {
+		raw = tx.TxRaw{}
+		suite.Require().NoError(raw.Unmarshal(txBytes))
+		suite.Require().Empty(raw.Signatures[0], i)
+	}
+	suite.Require().Equal(txs[3:], prepared.Txs[4:])
+
+	// the stripped transactions are covered in ProcessProposal
+	processCtx := suite.ctx.WithExecMode(sdk.ExecModeProcessProposal)
+	res, err := process(processCtx, &abci.RequestProcessProposal{Txs: prepared.Txs})
+	suite.Require().NoError(err)
+	suite.Require().Equal(abci.ResponseProcessProposal_ACCEPT, res.Status)
+	suite.Require().Equal(prepared.Txs[1:], processed)
+	for _, txBytes := range prepared.Txs[1:4] {
+		suite.Require().True(ak.IsCoveredByBLSAggregate(processCtx, txBytes))
+		suite.Require().False(ak.IsCoveredByBLSAggregate(suite.ctx.WithExecMode(sdk.ExecModeCheck), txBytes))
+	}
+	suite.Require().False(ak.IsCoveredByBLSAggregate(processCtx, invalidTx))
+
+	// and in FinalizeBlock, behind other injected transactions
+	finalizeCtx := suite.ctx.WithExecMode(sdk.ExecModeFinalize)
+	suite.Require().False(ak.IsCoveredByBLSAggregate(finalizeCtx, prepared.Txs[1]))
+	_, err = preBlock(finalizeCtx, &abci.RequestFinalizeBlock{Txs: append([][]byte{[]byte("injected")}, prepared.Txs...)})
+	suite.Require().NoError(err)
+	for _, txBytes := range prepared.Txs[1:4] {
+		suite.Require().True(ak.IsCoveredByBLSAggregate(finalizeCtx, txBytes))
+	}
+
+	// a proposal missing an aggregated transaction is rejected
+	tampered := append([][]byte{prepared.Txs[0]}, prepared.Txs[2:]...)
+	res, err = process(processCtx, &abci.RequestProcessProposal{Txs: tampered})
+	suite.Require().NoError(err)
+	suite.Require().Equal(abci.ResponseProcessProposal_REJECT, res.Status)
+	suite.Require().False(ak.IsCoveredByBLSAggregate(processCtx, prepared.Txs[2]))
+
+	// and so are the stripped transactions of such a block
+	_, err = preBlock(finalizeCtx, &abci.RequestFinalizeBlock{Txs: tampered})
+	suite.Require().NoError(err)
+	suite.Require().False(ak.IsCoveredByBLSAggregate(finalizeCtx, prepared.Txs[2]))
+
+	// a single signature is not aggregated
+	prepared, err = prepare(suite.ctx, &abci.RequestPrepareProposal{Txs: txs[:1]})
+	suite.Require().NoError(err)
+	suite.Require().Equal(txs[:1], prepared.Txs)
+}
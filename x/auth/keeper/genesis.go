@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -31,6 +33,31 @@ func (ak AccountKeeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		ak.SetAccount(ctx, acc)
 	}
 
+	for _, proposal := range data.MultisigProposals {
+		addr, err := ak.addressCodec.StringToBytes(proposal.Account)
+		if err != nil {
+			panic(err)
+		}
+		if err := ak.setMultisigProposal(ctx, addr, proposal); err != nil {
+			panic(err)
+		}
+	}
+
+	var lastAuthenticatorID uint64
+	for _, auth := range data.Authenticators {
+		addr, err := ak.addressCodec.StringToBytes(auth.Account)
+		if err != nil {
+			panic(err)
+		}
+		if err := ak.AccountAuthenticators.Set(ctx, collections.Join(sdk.AccAddress(addr), auth.Id), auth); err != nil {
+			panic(err)
+		}
+		lastAuthenticatorID = max(lastAuthenticatorID, auth.Id)
+	}
+	if err := ak.AuthenticatorSequence.Set(ctx, lastAuthenticatorID); err != nil {
+		panic(err)
+	}
+
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
 }
 
@@ -45,5 +72,22 @@ func (ak AccountKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	genState := types.NewGenesisState(params, genAccounts)
+	err := ak.MultisigProposals.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], proposal types.MultisigProposal) (bool, error) {
+		genState.MultisigProposals = append(genState.MultisigProposals, proposal)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = ak.AccountAuthenticators.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], auth types.AccountAuthenticator) (bool, error) {
+		genState.Authenticators = append(genState.Authenticators, auth)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return genState
 }
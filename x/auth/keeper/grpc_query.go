@@ -9,6 +9,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -245,3 +247,71 @@ func (s queryServer) AccountInfo(ctx context.Context, req *types.QueryAccountInf
 		},
 	}, nil
 }
+
+// MultisigProposal returns a proposal of a multisig account
+func (s queryServer) MultisigProposal(ctx context.Context, req *types.QueryMultisigProposalRequest) (*types.QueryMultisigProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := s.k.addressCodec.StringToBytes(req.Account)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid multisig address: %s", err)
+	}
+
+	proposal, err := s.k.MultisigProposals.Get(ctx, collections.Join(sdk.AccAddress(addr), req.ProposalId))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "multisig proposal %d of %s not found", req.ProposalId, req.Account)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMultisigProposalResponse{Proposal: &proposal}, nil
+}
+
+// MultisigProposals returns all the proposals of a multisig account
+func (s queryServer) MultisigProposals(ctx context.Context, req *types.QueryMultisigProposalsRequest) (*types.QueryMultisigProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := s.k.addressCodec.StringToBytes(req.Account)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid multisig address: %s", err)
+	}
+
+	proposals, pageRes, err := query.CollectionPaginate(
+		ctx,
+		s.k.MultisigProposals,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, uint64], proposal types.MultisigProposal) (types.MultisigProposal, error) {
+			return proposal, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMultisigProposalsResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+// AccountAuthenticators returns the authenticators of an account
+func (s queryServer) AccountAuthenticators(ctx context.Context, req *types.QueryAccountAuthenticatorsRequest) (*types.QueryAccountAuthenticatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := s.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	authenticators, err := s.k.GetAuthenticators(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountAuthenticatorsResponse{Authenticators: authenticators}, nil
+}
@@ -15,10 +15,12 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -100,6 +102,16 @@ type AccountKeeper struct {
 	// The prototypical AccountI constructor.
 	proto func() sdk.AccountI
 
+	// msgRouter routes the messages of the multisig proposals.
+	msgRouter baseapp.MessageRouter
+
+	// authenticators are the authenticator types accounts can register, by type.
+	authenticators map[string]authenticator.Authenticator
+
+	// blsAggregates holds the transactions covered by the verified BLS
+	// aggregate signatures of the current proposal and block.
+	blsAggregates *blsAggregates
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -110,6 +122,17 @@ type AccountKeeper struct {
 	AccountNumber   collections.Sequence
 	Accounts        *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
 	UnorderedNonces collections.KeySet[collections.Pair[int64, []byte]]
+
+	// MultisigProposals key: multisig address + proposal id | value: MultisigProposal
+	MultisigProposals collections.Map[collections.Pair[sdk.AccAddress, uint64], types.MultisigProposal]
+	// MultisigProposalsQueue key: voting end unix nanos + multisig address + proposal id, of the pending proposals
+	MultisigProposalsQueue collections.KeySet[collections.Triple[int64, sdk.AccAddress, uint64]]
+	// MultisigSequence is used to derive the addresses of the multisig accounts.
+	MultisigSequence collections.Sequence
+	// AccountAuthenticators key: account address + authenticator id | value: AccountAuthenticator
+	AccountAuthenticators collections.Map[collections.Pair[sdk.AccAddress, uint64], types.AccountAuthenticator]
+	// AuthenticatorSequence is the sequence of the authenticator ids.
+	AuthenticatorSequence collections.Sequence
 }
 
 type InitOption func(*AccountKeeper)
@@ -123,6 +146,24 @@ func WithUnorderedTransactions(enable bool) InitOption {
 	}
 }
 
+// WithMsgRouter sets the router executing the messages of multisig proposals.
+// Multisig proposals cannot be submitted without it.
+func WithMsgRouter(router baseapp.MessageRouter) InitOption {
+	return func(ak *AccountKeeper) {
+		ak.msgRouter = router
+	}
+}
+
+// WithAuthenticators registers authenticator types accounts can use in
+// addition to the built-in signature and spend limit authenticators.
+func WithAuthenticators(authenticators ...authenticator.Authenticator) InitOption {
+	return func(ak *AccountKeeper) {
+		for _, auth := range authenticators {
+			ak.authenticators[auth.Type()] = auth
+		}
+	}
+}
+
 var _ AccountKeeperI = &AccountKeeper{}
 
 // NewAccountKeeper returns a new AccountKeeperI that uses go-amino to
@@ -154,6 +195,25 @@ func NewAccountKeeper(
 		AccountNumber:   collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
 		Accounts:        collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
 		UnorderedNonces: collections.NewKeySet(sb, types.UnorderedNoncesKey, "unordered_nonces", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey)),
+		MultisigProposals: collections.NewMap(
+			sb, types.MultisigProposalsKeyPrefix, "multisig_proposals",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), codec.CollValue[types.MultisigProposal](cdc),
+		),
+		MultisigProposalsQueue: collections.NewKeySet(
+			sb, types.MultisigProposalsQueueKeyPrefix, "multisig_proposals_queue",
+			collections.TripleKeyCodec(collections.Int64Key, sdk.AccAddressKey, collections.Uint64Key),
+		),
+		MultisigSequence: collections.NewSequence(sb, types.MultisigSequenceKey, "multisig_sequence"),
+		AccountAuthenticators: collections.NewMap(
+			sb, types.AccountAuthenticatorsKeyPrefix, "account_authenticators",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), codec.CollValue[types.AccountAuthenticator](cdc),
+		),
+		AuthenticatorSequence: collections.NewSequence(sb, types.AuthenticatorSequenceKey, "authenticator_sequence"),
+		authenticators: map[string]authenticator.Authenticator{
+			authenticator.SignatureType:  authenticator.NewSignatureAuthenticator(cdc),
+			authenticator.SpendLimitType: authenticator.NewSpendLimitAuthenticator(cdc, ac),
+		},
+		blsAggregates: &blsAggregates{},
 	}
 	schema, err := sb.Build()
 	if err != nil {
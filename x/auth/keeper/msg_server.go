@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -39,3 +41,104 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (ms msgServer) CreateMultisig(ctx context.Context, msg *types.MsgCreateMultisig) (*types.MsgCreateMultisigResponse, error) {
+	if _, err := ms.ak.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	addr, err := ms.ak.CreateMultisig(ctx, msg.Members, msg.Threshold, msg.VotingPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	addrStr, err := ms.ak.addressCodec.BytesToString(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateMultisigResponse{Address: addrStr}, nil
+}
+
+func (ms msgServer) UpdateMultisig(ctx context.Context, msg *types.MsgUpdateMultisig) (*types.MsgUpdateMultisigResponse, error) {
+	addr, err := ms.ak.addressCodec.StringToBytes(msg.Account)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid multisig address: %s", err)
+	}
+
+	if err := ms.ak.UpdateMultisig(ctx, addr, msg.Members, msg.Threshold, msg.VotingPeriod); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateMultisigResponse{}, nil
+}
+
+func (ms msgServer) SubmitMultisigProposal(ctx context.Context, msg *types.MsgSubmitMultisigProposal) (*types.MsgSubmitMultisigProposalResponse, error) {
+	proposer, err := ms.ak.addressCodec.StringToBytes(msg.Proposer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
+	}
+
+	addr, err := ms.ak.addressCodec.StringToBytes(msg.Account)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid multisig address: %s", err)
+	}
+
+	msgs, err := tx.GetMsgs(msg.Messages, "multisig proposal")
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := ms.ak.SubmitMultisigProposal(ctx, addr, proposer, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitMultisigProposalResponse{ProposalId: proposal.Id, Status: proposal.Status}, nil
+}
+
+func (ms msgServer) VoteMultisigProposal(ctx context.Context, msg *types.MsgVoteMultisigProposal) (*types.MsgVoteMultisigProposalResponse, error) {
+	voter, err := ms.ak.addressCodec.StringToBytes(msg.Voter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+
+	addr, err := ms.ak.addressCodec.StringToBytes(msg.Account)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid multisig address: %s", err)
+	}
+
+	proposal, err := ms.ak.VoteMultisigProposal(ctx, addr, msg.ProposalId, voter, msg.Option)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgVoteMultisigProposalResponse{Status: proposal.Status}, nil
+}
+
+func (ms msgServer) AddAuthenticator(ctx context.Context, msg *types.MsgAddAuthenticator) (*types.MsgAddAuthenticatorResponse, error) {
+	sender, err := ms.ak.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	id, err := ms.ak.AddAuthenticator(ctx, sender, msg.Type, msg.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddAuthenticatorResponse{Id: id}, nil
+}
+
+func (ms msgServer) RemoveAuthenticator(ctx context.Context, msg *types.MsgRemoveAuthenticator) (*types.MsgRemoveAuthenticatorResponse, error) {
+	sender, err := ms.ak.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := ms.ak.RemoveAuthenticator(ctx, sender, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveAuthenticatorResponse{}, nil
+}
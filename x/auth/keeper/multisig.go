@@ -0,0 +1,411 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetMultisigAccount returns the multisig account at the given address.
+func (ak AccountKeeper) GetMultisigAccount(ctx context.Context, addr sdk.AccAddress) (*types.MultisigAccount, error) {
+	acc, err := ak.Accounts.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+		}
+		return nil, err
+	}
+
+	multisig, ok := acc.(*types.MultisigAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "account %s is not a multisig account", addr)
+	}
+
+	return multisig, nil
+}
+
+// CreateMultisig creates a multisig account with the given members, threshold
+// and voting period, and returns its address.
+func (ak AccountKeeper) CreateMultisig(ctx context.Context, members []types.MultisigMember, threshold uint64, votingPeriod time.Duration) (sdk.AccAddress, error) {
+	members, err := types.NormalizeMultisigMembers(ak.addressCodec, members)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := types.ValidateMultisig(ak.addressCodec, members, threshold, votingPeriod); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// derive an address which is not in use yet, the multisig members are not
+	// part of the derivation so that they can change without changing the address.
+	var addr sdk.AccAddress
+	for {
+		seq, err := ak.MultisigSequence.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		addr = address.Module(types.ModuleName, types.MultisigDerivationKey, sdk.Uint64ToBigEndian(seq))
+		if !ak.HasAccount(ctx, addr) {
+			break
+		}
+	}
+
+	addrStr, err := ak.addressCodec.BytesToString(addr)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if member.Address == addrStr {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "multisig account cannot be one of its own members")
+		}
+	}
+
+	baseAccount := ak.NewAccountWithAddress(ctx, addr).(*types.BaseAccount)
+	ak.SetAccount(ctx, types.NewMultisigAccount(baseAccount, members, threshold, votingPeriod))
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateMultisig,
+			sdk.NewAttribute(types.AttributeKeyMultisig, addrStr),
+		),
+	)
+
+	return addr, nil
+}
+
+// UpdateMultisig replaces the members, threshold and voting period of a
+// multisig account. The pending proposals of the account are aborted, as they
+// were voted on by the previous members.
+func (ak AccountKeeper) UpdateMultisig(ctx context.Context, addr sdk.AccAddress, members []types.MultisigMember, threshold uint64, votingPeriod time.Duration) error {
+	multisig, err := ak.GetMultisigAccount(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	members, err = types.NormalizeMultisigMembers(ak.addressCodec, members)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := types.ValidateMultisig(ak.addressCodec, members, threshold, votingPeriod); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	for _, member := range members {
+		if member.Address == multisig.Address {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "multisig account cannot be one of its own members")
+		}
+	}
+
+	multisig.Members = members
+	multisig.Threshold = threshold
+	multisig.VotingPeriod = votingPeriod
+	ak.SetAccount(ctx, multisig)
+
+	var pending []types.MultisigProposal
+	err = ak.MultisigProposals.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr), func(_ collections.Pair[sdk.AccAddress, uint64], proposal types.MultisigProposal) (bool, error) {
+		if proposal.Status == types.MULTISIG_PROPOSAL_STATUS_PENDING {
+			pending = append(pending, proposal)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, proposal := range pending {
+		proposal.Status = types.MULTISIG_PROPOSAL_STATUS_ABORTED
+		if err := ak.setMultisigProposal(ctx, addr, proposal); err != nil {
+			return err
+		}
+		emitMultisigProposalEvent(ctx, proposal, nil)
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateMultisig,
+			sdk.NewAttribute(types.AttributeKeyMultisig, multisig.Address),
+		),
+	)
+
+	return nil
+}
+
+// SubmitMultisigProposal submits a proposal to execute the given messages on
+// behalf of a multisig account. The submission counts as a yes vote of the
+// proposer, so the proposal may be executed right away.
+func (ak AccountKeeper) SubmitMultisigProposal(ctx context.Context, addr, proposer sdk.AccAddress, msgs []sdk.Msg) (types.MultisigProposal, error) {
+	multisig, err := ak.GetMultisigAccount(ctx, addr)
+	if err != nil {
+		return types.MultisigProposal{}, err
+	}
+
+	proposerStr, err := ak.addressCodec.BytesToString(proposer)
+	if err != nil {
+		return types.MultisigProposal{}, err
+	}
+	if multisig.MemberWeight(proposerStr) == 0 {
+		return types.MultisigProposal{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a member of multisig %s", proposerStr, multisig.Address)
+	}
+
+	if err := ak.validateMultisigMsgs(addr, msgs); err != nil {
+		return types.MultisigProposal{}, err
+	}
+
+	anys, err := tx.SetMsgs(msgs)
+	if err != nil {
+		return types.MultisigProposal{}, err
+	}
+
+	proposal := types.MultisigProposal{
+		Id:        multisig.NextProposalId,
+		Account:   multisig.Address,
+		Proposer:  proposerStr,
+		Messages:  anys,
+		VotingEnd: sdk.UnwrapSDKContext(ctx).BlockTime().Add(multisig.VotingPeriod),
+		Votes:     []types.MultisigVote{{Voter: proposerStr, Option: types.MULTISIG_VOTE_OPTION_YES}},
+		Status:    types.MULTISIG_PROPOSAL_STATUS_PENDING,
+	}
+
+	multisig.NextProposalId++
+	ak.SetAccount(ctx, multisig)
+
+	return ak.tallyMultisigProposal(ctx, multisig, proposal, msgs)
+}
+
+// VoteMultisigProposal records the vote of a member on a pending multisig
+// proposal, and executes or rejects the proposal once its outcome is decided.
+func (ak AccountKeeper) VoteMultisigProposal(ctx context.Context, addr sdk.AccAddress, proposalID uint64, voter sdk.AccAddress, option types.MultisigVoteOption) (types.MultisigProposal, error) {
+	if option != types.MULTISIG_VOTE_OPTION_YES && option != types.MULTISIG_VOTE_OPTION_NO {
+		return types.MultisigProposal{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid vote option %s", option)
+	}
+
+	multisig, err := ak.GetMultisigAccount(ctx, addr)
+	if err != nil {
+		return types.MultisigProposal{}, err
+	}
+
+	proposal, err := ak.MultisigProposals.Get(ctx, collections.Join(addr, proposalID))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.MultisigProposal{}, errorsmod.Wrapf(sdkerrors.ErrNotFound, "multisig proposal %d of %s", proposalID, multisig.Address)
+		}
+		return types.MultisigProposal{}, err
+	}
+
+	if proposal.Status != types.MULTISIG_PROPOSAL_STATUS_PENDING {
+		return types.MultisigProposal{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "multisig proposal %d is not pending: %s", proposalID, proposal.Status)
+	}
+	if sdk.UnwrapSDKContext(ctx).BlockTime().After(proposal.VotingEnd) {
+		return types.MultisigProposal{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "voting period of multisig proposal %d has ended", proposalID)
+	}
+
+	voterStr, err := ak.addressCodec.BytesToString(voter)
+	if err != nil {
+		return types.MultisigProposal{}, err
+	}
+	if multisig.MemberWeight(voterStr) == 0 {
+		return types.MultisigProposal{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a member of multisig %s", voterStr, multisig.Address)
+	}
+	if proposal.HasVoted(voterStr) {
+		return types.MultisigProposal{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s already voted on multisig proposal %d", voterStr, proposalID)
+	}
+
+	proposal.Votes = append(proposal.Votes, types.MultisigVote{Voter: voterStr, Option: option})
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMultisigVote,
+			sdk.NewAttribute(types.AttributeKeyMultisig, multisig.Address),
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposalID, 10)),
+			sdk.NewAttribute(types.AttributeKeyVoter, voterStr),
+			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
+		),
+	)
+
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return types.MultisigProposal{}, err
+	}
+
+	return ak.tallyMultisigProposal(ctx, multisig, proposal, msgs)
+}
+
+// tallyMultisigProposal executes the proposal if it reached the threshold, or
+// rejects it if it can no longer reach it, then stores it.
+func (ak AccountKeeper) tallyMultisigProposal(ctx context.Context, multisig *types.MultisigAccount, proposal types.MultisigProposal, msgs []sdk.Msg) (types.MultisigProposal, error) {
+	addr, err := ak.addressCodec.StringToBytes(multisig.Address)
+	if err != nil {
+		return types.MultisigProposal{}, err
+	}
+
+	var execErr error
+	yes, no := proposal.Tally(*multisig)
+	switch {
+	case yes >= multisig.Threshold:
+		// the proposal is stored as executed before running its messages so
+		// that they cannot vote on it again.
+		proposal.Status = types.MULTISIG_PROPOSAL_STATUS_EXECUTED
+		if err := ak.MultisigProposals.Set(ctx, collections.Join(sdk.AccAddress(addr), proposal.Id), proposal); err != nil {
+			return types.MultisigProposal{}, err
+		}
+
+		if execErr = ak.executeMultisigMsgs(ctx, msgs); execErr != nil {
+			proposal.Status = types.MULTISIG_PROPOSAL_STATUS_FAILED
+		}
+	case multisig.TotalWeight()-no < multisig.Threshold:
+		proposal.Status = types.MULTISIG_PROPOSAL_STATUS_REJECTED
+	}
+
+	if err := ak.setMultisigProposal(ctx, sdk.AccAddress(addr), proposal); err != nil {
+		return types.MultisigProposal{}, err
+	}
+
+	emitMultisigProposalEvent(ctx, proposal, execErr)
+
+	return proposal, nil
+}
+
+// setMultisigProposal stores the proposal of the multisig account, and keeps it
+// in the queue of the pending proposals only while it is pending.
+func (ak AccountKeeper) setMultisigProposal(ctx context.Context, addr sdk.AccAddress, proposal types.MultisigProposal) error {
+	if err := ak.MultisigProposals.Set(ctx, collections.Join(addr, proposal.Id), proposal); err != nil {
+		return err
+	}
+
+	queueKey := collections.Join3(proposal.VotingEnd.UnixNano(), addr, proposal.Id)
+	if proposal.Status == types.MULTISIG_PROPOSAL_STATUS_PENDING {
+		return ak.MultisigProposalsQueue.Set(ctx, queueKey)
+	}
+
+	return ak.MultisigProposalsQueue.Remove(ctx, queueKey)
+}
+
+// ExpireMultisigProposals marks the pending multisig proposals whose voting
+// period ended before the block time as expired.
+func (ak AccountKeeper) ExpireMultisigProposals(ctx context.Context) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime().UnixNano()
+
+	var expired []collections.Triple[int64, sdk.AccAddress, uint64]
+	err := ak.MultisigProposalsQueue.Walk(ctx, nil, func(key collections.Triple[int64, sdk.AccAddress, uint64]) (bool, error) {
+		if key.K1() >= blockTime {
+			return true, nil
+		}
+		expired = append(expired, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		proposal, err := ak.MultisigProposals.Get(ctx, collections.Join(key.K2(), key.K3()))
+		if err != nil {
+			return err
+		}
+
+		proposal.Status = types.MULTISIG_PROPOSAL_STATUS_EXPIRED
+		if err := ak.setMultisigProposal(ctx, key.K2(), proposal); err != nil {
+			return err
+		}
+		emitMultisigProposalEvent(ctx, proposal, nil)
+	}
+
+	return nil
+}
+
+// validateMultisigMsgs checks that the multisig account is the only signer of
+// each message and that the messages can be routed.
+func (ak AccountKeeper) validateMultisigMsgs(addr sdk.AccAddress, msgs []sdk.Msg) error {
+	if len(msgs) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "multisig proposal must contain at least one message")
+	}
+
+	if ak.msgRouter == nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "multisig proposals require a message router")
+	}
+
+	cdc, ok := ak.cdc.(codec.Codec)
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrLogic, "cannot get message signers with %T", ak.cdc)
+	}
+
+	for _, msg := range msgs {
+		signers, _, err := cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return err
+		}
+
+		if len(signers) != 1 || !bytes.Equal(signers[0], addr) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "multisig account must be the only signer of %s", sdk.MsgTypeURL(msg))
+		}
+
+		if ak.msgRouter.Handler(msg) == nil {
+			return sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+	}
+
+	return nil
+}
+
+// executeMultisigMsgs executes the messages of a multisig proposal. Either all
+// of them are applied or none.
+func (ak AccountKeeper) executeMultisigMsgs(ctx context.Context, msgs []sdk.Msg) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, write := sdkCtx.CacheContext()
+
+	var events sdk.Events
+	for i, msg := range msgs {
+		if m, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return err
+			}
+		}
+
+		handler := ak.msgRouter.Handler(msg)
+		if handler == nil {
+			return sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+
+		res, err := handler(cacheCtx, msg)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to execute message %d", i)
+		}
+
+		for _, event := range res.Events {
+			event.Attributes = append(event.Attributes, abci.EventAttribute{Key: "multisig_msg_index", Value: strconv.Itoa(i)})
+			events = append(events, sdk.Event(event))
+		}
+	}
+
+	write()
+	sdkCtx.EventManager().EmitEvents(events)
+
+	return nil
+}
+
+func emitMultisigProposalEvent(ctx context.Context, proposal types.MultisigProposal, execErr error) {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyMultisig, proposal.Account),
+		sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposal.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyProposer, proposal.Proposer),
+		sdk.NewAttribute(types.AttributeKeyStatus, proposal.Status.String()),
+	}
+	if execErr != nil {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyError, execErr.Error()))
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(types.EventTypeMultisigProposal, attrs...))
+}
@@ -0,0 +1,261 @@
+package keeper_test
+
+import (
+	"strings"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) createMultisig(members ...sdk.AccAddress) sdk.AccAddress {
+	msg := &types.MsgCreateMultisig{
+		Creator:      members[0].String(),
+		Threshold:    2,
+		VotingPeriod: time.Hour,
+	}
+	for _, member := range members {
+		msg.Members = append(msg.Members, types.MultisigMember{Address: member.String(), Weight: 1})
+	}
+
+	res, err := suite.msgServer.CreateMultisig(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	addr, err := sdk.AccAddressFromBech32(res.Address)
+	suite.Require().NoError(err)
+	return addr
+}
+
+func (suite *KeeperTestSuite) submitMultisigProposal(multisig, proposer sdk.AccAddress, msgs ...sdk.Msg) (*types.MsgSubmitMultisigProposalResponse, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		suite.Require().NoError(err)
+		anys[i] = any
+	}
+
+	return suite.msgServer.SubmitMultisigProposal(suite.ctx, &types.MsgSubmitMultisigProposal{
+		Proposer: proposer.String(),
+		Account:  multisig.String(),
+		Messages: anys,
+	})
+}
+
+func (suite *KeeperTestSuite) TestCreateMultisig() {
+	alice, bob := sdk.AccAddress("alice_______________"), sdk.AccAddress("bob_________________")
+
+	first := suite.createMultisig(alice, bob)
+	second := suite.createMultisig(alice, bob)
+	suite.Require().NotEqual(first, second)
+
+	multisig, err := suite.accountKeeper.GetMultisigAccount(suite.ctx, first)
+	suite.Require().NoError(err)
+	suite.Require().Nil(multisig.GetPubKey())
+	suite.Require().Error(multisig.SetPubKey(nil))
+	suite.Require().Equal(uint64(1), multisig.NextProposalId)
+	suite.Require().NoError(multisig.Validate())
+
+	_, err = suite.msgServer.CreateMultisig(suite.ctx, &types.MsgCreateMultisig{
+		Creator:      alice.String(),
+		Members:      []types.MultisigMember{{Address: alice.String(), Weight: 1}},
+		Threshold:    2,
+		VotingPeriod: time.Hour,
+	})
+	suite.Require().ErrorContains(err, "exceeds the total weight")
+
+	// the members are compared by address, whatever their spelling
+	_, err = suite.msgServer.CreateMultisig(suite.ctx, &types.MsgCreateMultisig{
+		Creator:      alice.String(),
+		Members:      []types.MultisigMember{{Address: alice.String(), Weight: 1}, {Address: strings.ToUpper(alice.String()), Weight: 1}},
+		Threshold:    2,
+		VotingPeriod: time.Hour,
+	})
+	suite.Require().ErrorContains(err, "duplicate multisig member")
+
+	// and stored in the spelling of the address codec
+	res, err := suite.msgServer.CreateMultisig(suite.ctx, &types.MsgCreateMultisig{
+		Creator:      alice.String(),
+		Members:      []types.MultisigMember{{Address: strings.ToUpper(alice.String()), Weight: 1}},
+		Threshold:    1,
+		VotingPeriod: time.Hour,
+	})
+	suite.Require().NoError(err)
+	addr, err := sdk.AccAddressFromBech32(res.Address)
+	suite.Require().NoError(err)
+	multisig, err = suite.accountKeeper.GetMultisigAccount(suite.ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.MultisigMember{{Address: alice.String(), Weight: 1}}, multisig.Members)
+
+	_, err = suite.accountKeeper.GetMultisigAccount(suite.ctx, alice)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestMultisigProposalExecution() {
+	alice, bob, carol := sdk.AccAddress("alice_______________"), sdk.AccAddress("bob_________________"), sdk.AccAddress("carol_______________")
+	addr := suite.createMultisig(alice, bob, carol)
+
+	// rotate carol out of the multisig, which keeps its address
+	update := &types.MsgUpdateMultisig{
+		Account:      addr.String(),
+		Members:      []types.MultisigMember{{Address: alice.String(), Weight: 1}, {Address: bob.String(), Weight: 1}},
+		Threshold:    2,
+		VotingPeriod: time.Hour,
+	}
+
+	_, err := suite.submitMultisigProposal(addr, sdk.AccAddress("dave________________"), update)
+	suite.Require().ErrorContains(err, "is not a member")
+
+	_, err = suite.submitMultisigProposal(addr, alice, &types.MsgUpdateMultisig{Account: alice.String()})
+	suite.Require().ErrorContains(err, "must be the only signer")
+
+	res, err := suite.submitMultisigProposal(addr, alice, update)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.ProposalId)
+	suite.Require().Equal(types.MULTISIG_PROPOSAL_STATUS_PENDING, res.Status)
+
+	// a pending proposal is aborted once the members change
+	pending, err := suite.submitMultisigProposal(addr, carol, update)
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.VoteMultisigProposal(suite.ctx, &types.MsgVoteMultisigProposal{
+		Voter: alice.String(), Account: addr.String(), ProposalId: res.ProposalId, Option: types.MULTISIG_VOTE_OPTION_NO,
+	})
+	suite.Require().ErrorContains(err, "already voted")
+
+	vote, err := suite.msgServer.VoteMultisigProposal(suite.ctx, &types.MsgVoteMultisigProposal{
+		Voter: bob.String(), Account: addr.String(), ProposalId: res.ProposalId, Option: types.MULTISIG_VOTE_OPTION_YES,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.MULTISIG_PROPOSAL_STATUS_EXECUTED, vote.Status)
+
+	multisig, err := suite.accountKeeper.GetMultisigAccount(suite.ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(update.Members, multisig.Members)
+	suite.Require().Equal(uint64(3), multisig.NextProposalId)
+
+	proposal, err := suite.queryClient.MultisigProposal(suite.ctx, &types.QueryMultisigProposalRequest{Account: addr.String(), ProposalId: pending.ProposalId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.MULTISIG_PROPOSAL_STATUS_ABORTED, proposal.Proposal.Status)
+
+	_, err = suite.msgServer.VoteMultisigProposal(suite.ctx, &types.MsgVoteMultisigProposal{
+		Voter: bob.String(), Account: addr.String(), ProposalId: pending.ProposalId, Option: types.MULTISIG_VOTE_OPTION_YES,
+	})
+	suite.Require().ErrorContains(err, "is not pending")
+
+	// a proposal whose messages fail is not applied
+	res, err = suite.submitMultisigProposal(addr, alice, &types.MsgUpdateMultisig{Account: addr.String(), Members: update.Members, Threshold: 3, VotingPeriod: time.Hour})
+	suite.Require().NoError(err)
+	vote, err = suite.msgServer.VoteMultisigProposal(suite.ctx, &types.MsgVoteMultisigProposal{
+		Voter: bob.String(), Account: addr.String(), ProposalId: res.ProposalId, Option: types.MULTISIG_VOTE_OPTION_YES,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.MULTISIG_PROPOSAL_STATUS_FAILED, vote.Status)
+
+	multisig, err = suite.accountKeeper.GetMultisigAccount(suite.ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), multisig.Threshold)
+
+	proposals, err := suite.queryClient.MultisigProposals(suite.ctx, &types.QueryMultisigProposalsRequest{Account: addr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(proposals.Proposals, 3)
+}
+
+func (suite *KeeperTestSuite) TestMultisigProposalRejection() {
+	alice, bob, carol := sdk.AccAddress("alice_______________"), sdk.AccAddress("bob_________________"), sdk.AccAddress("carol_______________")
+	addr := suite.createMultisig(alice, bob, carol)
+	update := &types.MsgUpdateMultisig{
+		Account:      addr.String(),
+		Members:      []types.MultisigMember{{Address: alice.String(), Weight: 1}},
+		Threshold:    1,
+		VotingPeriod: time.Hour,
+	}
+
+	res, err := suite.submitMultisigProposal(addr, alice, update)
+	suite.Require().NoError(err)
+
+	vote, err := suite.msgServer.VoteMultisigProposal(suite.ctx, &types.MsgVoteMultisigProposal{
+		Voter: bob.String(), Account: addr.String(), ProposalId: res.ProposalId, Option: types.MULTISIG_VOTE_OPTION_NO,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.MULTISIG_PROPOSAL_STATUS_PENDING, vote.Status)
+
+	vote, err = suite.msgServer.VoteMultisigProposal(suite.ctx, &types.MsgVoteMultisigProposal{
+		Voter: carol.String(), Account: addr.String(), ProposalId: res.ProposalId, Option: types.MULTISIG_VOTE_OPTION_NO,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.MULTISIG_PROPOSAL_STATUS_REJECTED, vote.Status)
+
+	// votes are only accepted during the voting period
+	res, err = suite.submitMultisigProposal(addr, alice, update)
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.VoteMultisigProposal(suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(2*time.Hour)), &types.MsgVoteMultisigProposal{
+		Voter: bob.String(), Account: addr.String(), ProposalId: res.ProposalId, Option: types.MULTISIG_VOTE_OPTION_YES,
+	})
+	suite.Require().ErrorContains(err, "voting period")
+}
+
+func (suite *KeeperTestSuite) TestMultisigProposalExpiry() {
+	alice, bob := sdk.AccAddress("alice_______________"), sdk.AccAddress("bob_________________")
+	addr := suite.createMultisig(alice, bob)
+
+	res, err := suite.submitMultisigProposal(addr, alice, &types.MsgUpdateMultisig{Account: addr.String()})
+	suite.Require().NoError(err)
+	decided, err := suite.submitMultisigProposal(addr, alice, &types.MsgUpdateMultisig{Account: addr.String()})
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.VoteMultisigProposal(suite.ctx, &types.MsgVoteMultisigProposal{
+		Voter: bob.String(), Account: addr.String(), ProposalId: decided.ProposalId, Option: types.MULTISIG_VOTE_OPTION_NO,
+	})
+	suite.Require().NoError(err)
+
+	status := func(ctx sdk.Context, proposalID uint64) types.MultisigProposalStatus {
+		proposal, err := suite.accountKeeper.MultisigProposals.Get(ctx, collections.Join(addr, proposalID))
+		suite.Require().NoError(err)
+		return proposal.Status
+	}
+
+	// a proposal is pending until the end of its voting period
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(suite.accountKeeper.ExpireMultisigProposals(ctx))
+	suite.Require().Equal(types.MULTISIG_PROPOSAL_STATUS_PENDING, status(ctx, res.ProposalId))
+
+	ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour + time.Second))
+	suite.Require().NoError(suite.accountKeeper.ExpireMultisigProposals(ctx))
+	suite.Require().Equal(types.MULTISIG_PROPOSAL_STATUS_EXPIRED, status(ctx, res.ProposalId))
+	suite.Require().Equal(types.MULTISIG_PROPOSAL_STATUS_REJECTED, status(ctx, decided.ProposalId))
+
+	has, err := suite.accountKeeper.MultisigProposalsQueue.Has(ctx, collections.Join3(ctx.BlockTime().Add(-time.Second).UnixNano(), addr, res.ProposalId))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
+
+func (suite *KeeperTestSuite) TestMultisigGenesis() {
+	alice, bob := sdk.AccAddress("alice_______________"), sdk.AccAddress("bob_________________")
+	addr := suite.createMultisig(alice, bob)
+
+	_, err := suite.submitMultisigProposal(addr, alice, &types.MsgUpdateMultisig{Account: addr.String()})
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.accountKeeper.Params.Set(suite.ctx, types.DefaultParams()))
+	genState := suite.accountKeeper.ExportGenesis(suite.ctx)
+	suite.Require().Len(genState.MultisigProposals, 1)
+	suite.Require().NoError(types.ValidateGenesis(*genState))
+
+	suite.SetupTest()
+	suite.accountKeeper.InitGenesis(suite.ctx, *genState)
+
+	multisig, err := suite.accountKeeper.GetMultisigAccount(suite.ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), multisig.NextProposalId)
+
+	proposal, err := suite.accountKeeper.MultisigProposals.Get(suite.ctx, collections.Join(addr, uint64(1)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(genState.MultisigProposals[0].Votes, proposal.Votes)
+
+	// the pending proposals are queued to expire
+	has, err := suite.accountKeeper.MultisigProposalsQueue.Has(suite.ctx, collections.Join3(proposal.VotingEnd.UnixNano(), addr, proposal.Id))
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+}
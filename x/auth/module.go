@@ -13,6 +13,7 @@ import (
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -97,7 +98,8 @@ type AppModule struct {
 	legacySubspace exported.Subspace
 }
 
-// PreBlock cleans up expired unordered transaction nonces from state.
+// PreBlock cleans up expired unordered transaction nonces from state and
+// expires the multisig proposals whose voting period ended.
 // Please ensure to add `x/auth`'s module name to the OrderPreBlocker list in your application.
 func (am AppModule) PreBlock(ctx context.Context) (appmodule.ResponsePreBlock, error) {
 	start := telemetry.Now()                                                                   //nolint:staticcheck // TODO: switch to OpenTelemetry
@@ -108,6 +110,9 @@ func (am AppModule) PreBlock(ctx context.Context) (appmodule.ResponsePreBlock, e
 			return nil, err
 		}
 	}
+	if err := am.accountKeeper.ExpireMultisigProposals(ctx); err != nil {
+		return nil, err
+	}
 	return &sdk.ResponsePreBlock{ConsensusParamsChanged: false}, nil
 }
 
@@ -215,6 +220,7 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 
 	AddressCodec            address.Codec
+	MsgServiceRouter        baseapp.MessageRouter
 	RandomGenesisAccountsFn types.RandomGenesisAccountsFn `optional:"true"`
 	AccountI                func() sdk.AccountI           `optional:"true"`
 
@@ -249,7 +255,10 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AccountI = types.ProtoBaseAccount
 	}
 
-	keeperOpts := []keeper.InitOption{keeper.WithUnorderedTransactions(in.Config.EnableUnorderedTransactions)}
+	keeperOpts := []keeper.InitOption{
+		keeper.WithUnorderedTransactions(in.Config.EnableUnorderedTransactions),
+		keeper.WithMsgRouter(in.MsgServiceRouter),
+	}
 
 	k := keeper.NewAccountKeeper(in.Cdc, in.StoreService, in.AccountI, maccPerms, in.AddressCodec, in.Config.Bech32Prefix, authority.String(), keeperOpts...)
 	m := NewAppModule(in.Cdc, k, in.RandomGenesisAccountsFn, in.LegacySubspace)
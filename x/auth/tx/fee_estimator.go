@@ -0,0 +1,153 @@
+package tx
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// DefaultFeeEstimatorBlocks is the default number of recent blocks whose
+// transactions are tracked by a FeeEstimator.
+const DefaultFeeEstimatorBlocks = 20
+
+// feePriorityPercentiles are the percentiles of the gas prices of the recent
+// transactions recommended at each priority level.
+var feePriorityPercentiles = []struct {
+	priority   txtypes.FeePriority
+	percentile int
+}{
+	{txtypes.FeePriority_FEE_PRIORITY_LOW, 25},
+	{txtypes.FeePriority_FEE_PRIORITY_MEDIUM, 50},
+	{txtypes.FeePriority_FEE_PRIORITY_HIGH, 90},
+}
+
+// FeeEstimator tracks the gas prices paid by the transactions included in the
+// recent blocks, and recommends gas prices at several priority levels, never
+// lower than the minimum gas prices of the node.
+//
+// It is an ABCIListener, which must be added to the streaming manager of the
+// app before the node starts to track the blocks, and is used by the EstimateFee method of the tx
+// service when given to RegisterTxService with WithFeeEstimator.
+type FeeEstimator struct {
+	txDecoder    sdk.TxDecoder
+	minGasPrices sdk.DecCoins
+	maxBlocks    int
+
+	mu sync.RWMutex
+	// blocks are the gas prices of the transactions of the recent blocks,
+	// oldest first.
+	blocks [][]sdk.DecCoins
+}
+
+var _ storetypes.ABCIListener = (*FeeEstimator)(nil)
+
+// NewFeeEstimator returns a FeeEstimator tracking the transactions of the
+// given number of recent blocks, and recommending gas prices no lower than
+// minGasPrices.
+func NewFeeEstimator(txDecoder sdk.TxDecoder, minGasPrices sdk.DecCoins, maxBlocks int) *FeeEstimator {
+	return &FeeEstimator{
+		txDecoder:    txDecoder,
+		minGasPrices: minGasPrices,
+		maxBlocks:    maxBlocks,
+	}
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener, tracking the gas
+// prices of the transactions of the block.
+func (e *FeeEstimator) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, _ abci.ResponseFinalizeBlock) error {
+	gasPrices := make([]sdk.DecCoins, 0, len(req.Txs))
+	for _, txBytes := range req.Txs {
+		// skip the data injected by the proposer, such as vote extensions
+		tx, err := e.txDecoder(txBytes)
+		if err != nil {
+			continue
+		}
+
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok || feeTx.GetGas() == 0 {
+			continue
+		}
+
+		gasLimit := math.LegacyNewDecFromInt(math.NewIntFromUint64(feeTx.GetGas()))
+		gasPrices = append(gasPrices, sdk.NewDecCoinsFromCoins(feeTx.GetFee()...).QuoDec(gasLimit))
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.blocks = append(e.blocks, gasPrices)
+	if len(e.blocks) > e.maxBlocks {
+		e.blocks = e.blocks[len(e.blocks)-e.maxBlocks:]
+	}
+
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener.
+func (e *FeeEstimator) ListenCommit(context.Context, abci.ResponseCommit, []*storetypes.StoreKVPair) error {
+	return nil
+}
+
+// GasPrices returns the recommended gas prices of each priority level. The gas
+// prices are in the denoms of the minimum gas prices of the node, or in the
+// denoms paid by the recent transactions if it has none.
+func (e *FeeEstimator) GasPrices() map[txtypes.FeePriority]sdk.DecCoins {
+	samples := e.samples()
+
+	denoms := make([]string, 0, len(samples))
+	if e.minGasPrices.IsZero() {
+		for denom := range samples {
+			denoms = append(denoms, denom)
+		}
+		sort.Strings(denoms)
+	} else {
+		for _, minGasPrice := range e.minGasPrices {
+			denoms = append(denoms, minGasPrice.Denom)
+		}
+	}
+
+	gasPrices := make(map[txtypes.FeePriority]sdk.DecCoins, len(feePriorityPercentiles))
+	for _, level := range feePriorityPercentiles {
+		prices := make(sdk.DecCoins, 0, len(denoms))
+		for _, denom := range denoms {
+			price := e.minGasPrices.AmountOf(denom)
+			if denomSamples := samples[denom]; len(denomSamples) > 0 {
+				price = math.LegacyMaxDec(price, denomSamples[(len(denomSamples)-1)*level.percentile/100])
+			}
+			prices = append(prices, sdk.NewDecCoinFromDec(denom, price))
+		}
+		gasPrices[level.priority] = prices
+	}
+
+	return gasPrices
+}
+
+// samples returns the sorted gas prices paid by the recent transactions, by
+// denom.
+func (e *FeeEstimator) samples() map[string][]math.LegacyDec {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	samples := make(map[string][]math.LegacyDec)
+	for _, block := range e.blocks {
+		for _, gasPrices := range block {
+			for _, gasPrice := range gasPrices {
+				samples[gasPrice.Denom] = append(samples[gasPrice.Denom], gasPrice.Amount)
+			}
+		}
+	}
+
+	for _, denomSamples := range samples {
+		sort.Slice(denomSamples, func(i, j int) bool { return denomSamples[i].LT(denomSamples[j]) })
+	}
+
+	return samples
+}
@@ -0,0 +1,79 @@
+package tx_test
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+func TestFeeEstimator(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	decCoins := func(s string) sdk.DecCoins {
+		coins, err := sdk.ParseDecCoins(s)
+		require.NoError(t, err)
+		return coins
+	}
+	mkTx := func(fees string, gas uint64) []byte {
+		coins, err := sdk.ParseCoinsNormalized(fees)
+		require.NoError(t, err)
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		txBuilder.SetFeeAmount(coins)
+		txBuilder.SetGasLimit(gas)
+		bz, err := encCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+	finalizeBlock := func(e *tx.FeeEstimator, txs ...[]byte) {
+		require.NoError(t, e.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Txs: txs}, abci.ResponseFinalizeBlock{}))
+	}
+	gasPrices := func(low, medium, high string) map[txtypes.FeePriority]sdk.DecCoins {
+		return map[txtypes.FeePriority]sdk.DecCoins{
+			txtypes.FeePriority_FEE_PRIORITY_LOW:    decCoins(low),
+			txtypes.FeePriority_FEE_PRIORITY_MEDIUM: decCoins(medium),
+			txtypes.FeePriority_FEE_PRIORITY_HIGH:   decCoins(high),
+		}
+	}
+
+	e := tx.NewFeeEstimator(encCfg.TxConfig.TxDecoder(), decCoins("0.01stake"), 2)
+
+	// without any recent transaction, the minimum gas prices are recommended
+	require.Equal(t, gasPrices("0.01stake", "0.01stake", "0.01stake"), e.GasPrices())
+
+	finalizeBlock(e,
+		mkTx("100stake", 1000),
+		mkTx("200stake", 1000),
+		[]byte("vote extensions"),
+		mkTx("5stake", 1000),
+		mkTx("300stake,1atom", 1000),
+		mkTx("100stake", 0),
+	)
+	finalizeBlock(e, mkTx("400stake", 1000))
+	require.Equal(t, gasPrices("0.1stake", "0.2stake", "0.3stake"), e.GasPrices())
+
+	// the transactions of the blocks older than the last two are not tracked
+	finalizeBlock(e)
+	require.Equal(t, gasPrices("0.4stake", "0.4stake", "0.4stake"), e.GasPrices())
+	finalizeBlock(e)
+	require.Equal(t, gasPrices("0.01stake", "0.01stake", "0.01stake"), e.GasPrices())
+
+	// without minimum gas prices, the denoms paid by the recent transactions
+	// are recommended
+	e = tx.NewFeeEstimator(encCfg.TxConfig.TxDecoder(), nil, 2)
+	for _, prices := range e.GasPrices() {
+		require.Empty(t, prices)
+	}
+	finalizeBlock(e, mkTx("100stake,2atom", 1000), mkTx("300stake", 1000))
+	require.Equal(t, gasPrices("0.002atom,0.1stake", "0.002atom,0.1stake", "0.002atom,0.1stake"), e.GasPrices())
+}
@@ -3,6 +3,7 @@ package tx
 import (
 	"context"
 	"fmt"
+	"math"
 	"strings"
 
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
@@ -11,6 +12,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -29,15 +32,32 @@ type txServer struct {
 	clientCtx         client.Context
 	simulate          baseAppSimulateFn
 	interfaceRegistry codectypes.InterfaceRegistry
+	feeEstimator      *FeeEstimator
+}
+
+// ServiceOption configures the Tx service server.
+type ServiceOption func(*txServer)
+
+// WithFeeEstimator sets the FeeEstimator recommending the gas prices of the
+// EstimateFee method, which is disabled without it.
+func WithFeeEstimator(feeEstimator *FeeEstimator) ServiceOption {
+	return func(s *txServer) {
+		s.feeEstimator = feeEstimator
+	}
 }
 
 // NewTxServer creates a new Tx service server.
-func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
-	return txServer{
+func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry, opts ...ServiceOption) txtypes.ServiceServer {
+	s := txServer{
 		clientCtx:         clientCtx,
 		simulate:          simulate,
 		interfaceRegistry: interfaceRegistry,
 	}
+	for _, opt := range opts {
+		opt(&s)
+	}
+
+	return s
 }
 
 var _ txtypes.ServiceServer = txServer{}
@@ -106,6 +126,53 @@ func (s txServer) Simulate(_ context.Context, req *txtypes.SimulateRequest) (*tx
 	}, nil
 }
 
+// EstimateFee implements the ServiceServer.EstimateFee RPC method.
+func (s txServer) EstimateFee(_ context.Context, req *txtypes.EstimateFeeRequest) (*txtypes.EstimateFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if s.feeEstimator == nil {
+		return nil, status.Error(codes.Unimplemented, "fee estimation is not enabled on this node")
+	}
+	if len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty txBytes is not allowed")
+	}
+
+	gasAdjustment := req.GasAdjustment
+	switch {
+	case gasAdjustment == 0:
+		gasAdjustment = 1
+	case gasAdjustment < 0 || math.IsNaN(gasAdjustment) || math.IsInf(gasAdjustment, 0):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid gas adjustment %v", gasAdjustment)
+	}
+
+	gasInfo, _, err := s.simulate(req.TxBytes)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "%v with gas used: '%d'", err, gasInfo.GasUsed)
+	}
+
+	gasLimit := uint64(gasAdjustment * float64(gasInfo.GasUsed))
+	gasLimitDec := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gasLimit))
+
+	gasPrices := s.feeEstimator.GasPrices()
+	estimates := make([]txtypes.FeeEstimate, 0, len(feePriorityPercentiles)*len(gasPrices[txtypes.FeePriority_FEE_PRIORITY_MEDIUM]))
+	for _, level := range feePriorityPercentiles {
+		for _, gasPrice := range gasPrices[level.priority] {
+			estimates = append(estimates, txtypes.FeeEstimate{
+				Priority: level.priority,
+				GasPrice: gasPrice,
+				Fee:      sdk.NewCoin(gasPrice.Denom, gasPrice.Amount.Mul(gasLimitDec).Ceil().RoundInt()),
+			})
+		}
+	}
+
+	return &txtypes.EstimateFeeResponse{
+		GasInfo:   &gasInfo,
+		GasLimit:  gasLimit,
+		Estimates: estimates,
+	}, nil
+}
+
 // GetTx implements the ServiceServer.GetTx RPC method.
 func (s txServer) GetTx(_ context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
 	if req == nil {
@@ -310,10 +377,11 @@ func RegisterTxService(
 	clientCtx client.Context,
 	simulateFn baseAppSimulateFn,
 	interfaceRegistry codectypes.InterfaceRegistry,
+	opts ...ServiceOption,
 ) {
 	txtypes.RegisterServiceServer(
 		qrt,
-		NewTxServer(clientCtx, simulateFn, interfaceRegistry),
+		NewTxServer(clientCtx, simulateFn, interfaceRegistry, opts...),
 	)
 }
 
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxAuthenticatorsPerAccount is the maximum number of authenticators an
+// account can register, as each of them may be tried for every signature.
+const MaxAuthenticatorsPerAccount = 10
+
+// Authenticator event types and attribute keys.
+const (
+	EventTypeAddAuthenticator    = "add_authenticator"
+	EventTypeRemoveAuthenticator = "remove_authenticator"
+
+	AttributeKeyAccount           = "account"
+	AttributeKeyAuthenticatorID   = "authenticator_id"
+	AttributeKeyAuthenticatorType = "authenticator_type"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = (*SignatureAuthenticatorConfig)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*SpendLimitAuthenticatorConfig)(nil)
+)
+
+// PublicKey returns the public key of the signature authenticator.
+func (c SignatureAuthenticatorConfig) PublicKey() (cryptotypes.PubKey, error) {
+	pk, ok := c.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", (cryptotypes.PubKey)(nil), c.PubKey.GetCachedValue())
+	}
+
+	return pk, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (c SignatureAuthenticatorConfig) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	return unpacker.UnpackAny(c.PubKey, &pk)
+}
+
+// PublicKey returns the public key of the spend limit authenticator.
+func (c SpendLimitAuthenticatorConfig) PublicKey() (cryptotypes.PubKey, error) {
+	pk, ok := c.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", (cryptotypes.PubKey)(nil), c.PubKey.GetCachedValue())
+	}
+
+	return pk, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (c SpendLimitAuthenticatorConfig) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	return unpacker.UnpackAny(c.PubKey, &pk)
+}
+
+// ValidateAccountAuthenticators checks that the authenticators of the genesis
+// state have unique ids and do not exceed the limit per account.
+func ValidateAccountAuthenticators(authenticators []AccountAuthenticator) error {
+	ids := make(map[uint64]struct{}, len(authenticators))
+	perAccount := make(map[string]int)
+	for _, auth := range authenticators {
+		if _, err := sdk.AccAddressFromBech32(auth.Account); err != nil {
+			return fmt.Errorf("invalid authenticator account address %s: %w", auth.Account, err)
+		}
+		if auth.Id == 0 {
+			return fmt.Errorf("authenticator id of %s must be positive", auth.Account)
+		}
+		if _, ok := ids[auth.Id]; ok {
+			return fmt.Errorf("duplicate authenticator id %d", auth.Id)
+		}
+		ids[auth.Id] = struct{}{}
+
+		if auth.Type == "" {
+			return fmt.Errorf("type of authenticator %d cannot be empty", auth.Id)
+		}
+
+		perAccount[auth.Account]++
+		if perAccount[auth.Account] > MaxAuthenticatorsPerAccount {
+			return fmt.Errorf("account %s has more than %d authenticators", auth.Account, MaxAuthenticatorsPerAccount)
+		}
+	}
+
+	return nil
+}
@@ -19,8 +19,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&ModuleAccount{}, "cosmos-sdk/ModuleAccount", nil)
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/auth/Params", nil)
 	cdc.RegisterConcrete(&ModuleCredential{}, "cosmos-sdk/GroupAccountCredential", nil)
+	cdc.RegisterConcrete(&MultisigAccount{}, "cosmos-sdk/MultisigAccount", nil)
 
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/auth/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateMultisig{}, "cosmos-sdk/MsgCreateMultisig")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateMultisig{}, "cosmos-sdk/MsgUpdateMultisig")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitMultisigProposal{}, "cosmos-sdk/MsgSubmitMultisigProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgVoteMultisigProposal{}, "cosmos-sdk/MsgVoteMultisigProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgAddAuthenticator{}, "cosmos-sdk/MsgAddAuthenticator")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveAuthenticator{}, "cosmos-sdk/MsgRemoveAuthenticator")
 
 	legacytx.RegisterLegacyAminoCodec(cdc)
 }
@@ -33,6 +40,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*AccountI)(nil),
 		&BaseAccount{},
 		&ModuleAccount{},
+		&MultisigAccount{},
 	)
 
 	registry.RegisterInterface(
@@ -40,6 +48,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.AccountI)(nil),
 		&BaseAccount{},
 		&ModuleAccount{},
+		&MultisigAccount{},
 	)
 
 	registry.RegisterInterface(
@@ -47,6 +56,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*GenesisAccount)(nil),
 		&BaseAccount{},
 		&ModuleAccount{},
+		&MultisigAccount{},
 	)
 
 	registry.RegisterInterface(
@@ -57,5 +67,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgCreateMultisig{},
+		&MsgUpdateMultisig{},
+		&MsgSubmitMultisigProposal{},
+		&MsgVoteMultisigProposal{},
+		&MsgAddAuthenticator{},
+		&MsgRemoveAuthenticator{},
 	)
 }
@@ -40,6 +40,11 @@ func (g GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, proposal := range g.MultisigProposals {
+		if err := proposal.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -72,7 +77,49 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	if err := ValidateGenAccounts(genAccs); err != nil {
+		return err
+	}
+
+	if err := ValidateMultisigProposals(genAccs, data.MultisigProposals); err != nil {
+		return err
+	}
+
+	return ValidateAccountAuthenticators(data.Authenticators)
+}
+
+// ValidateMultisigProposals validates the multisig proposals of the genesis
+// state against the multisig accounts they belong to.
+func ValidateMultisigProposals(accounts GenesisAccounts, proposals []MultisigProposal) error {
+	multisigs := make(map[string]*MultisigAccount)
+	for _, acc := range accounts {
+		if multisig, ok := acc.(*MultisigAccount); ok {
+			multisigs[multisig.Address] = multisig
+		}
+	}
+
+	seen := make(map[string]struct{}, len(proposals))
+	for _, proposal := range proposals {
+		if err := ValidateMultisigProposal(proposal); err != nil {
+			return err
+		}
+
+		multisig, ok := multisigs[proposal.Account]
+		if !ok {
+			return fmt.Errorf("multisig proposal %d belongs to unknown multisig account %s", proposal.Id, proposal.Account)
+		}
+		if proposal.Id >= multisig.NextProposalId {
+			return fmt.Errorf("multisig proposal %d of %s is not below the next proposal id %d", proposal.Id, proposal.Account, multisig.NextProposalId)
+		}
+
+		key := fmt.Sprintf("%s/%d", proposal.Account, proposal.Id)
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate multisig proposal %d of %s", proposal.Id, proposal.Account)
+		}
+		seen[key] = struct{}{}
+	}
+
+	return nil
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -31,6 +32,10 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*any.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// multisig_proposals are the proposals of the multisig accounts.
+	MultisigProposals []MultisigProposal `protobuf:"bytes,3,rep,name=multisig_proposals,json=multisigProposals,proto3" json:"multisig_proposals"`
+	// authenticators are the authenticators registered by the accounts.
+	Authenticators []AccountAuthenticator `protobuf:"bytes,4,rep,name=authenticators,proto3" json:"authenticators"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,6 +85,20 @@ func (m *GenesisState) GetAccounts() []*any.Any {
 	return nil
 }
 
+func (m *GenesisState) GetMultisigProposals() []MultisigProposal {
+	if m != nil {
+		return m.MultisigProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuthenticators() []AccountAuthenticator {
+	if m != nil {
+		return m.Authenticators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
 }
@@ -87,24 +106,31 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x42, 0xe4, 0x05,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x48, 0xa9, 0x81, 0x91, 0x8b, 0xc7, 0x1d,
-	0xe2, 0x94, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x3b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc,
-	0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0x4e, 0xd3, 0x0b, 0x00, 0x2b,
-	0x71, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x42,
-	0x06, 0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a,
-	0xdc, 0x46, 0x22, 0x7a, 0x10, 0x7f, 0xe8, 0xc1, 0xfc, 0xa1, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57,
-	0xe5, 0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99,
-	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0xaf, 0x41, 0x28, 0xdd, 0xe2, 0x94,
-	0x6c, 0xfd, 0x0a, 0x88, 0x3f, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x86, 0x1b, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x70, 0x8d, 0xea, 0x6c, 0x01, 0x00, 0x00,
+	// 382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x4e, 0x2a, 0x31,
+	0x14, 0xc6, 0x67, 0xe0, 0x86, 0xdc, 0x3b, 0xdc, 0xdc, 0x1b, 0x46, 0x12, 0x07, 0x4c, 0x46, 0x24,
+	0x31, 0x82, 0x09, 0x2d, 0xa0, 0x6e, 0x4d, 0xc0, 0x85, 0x2b, 0x13, 0x82, 0x3b, 0x37, 0xa6, 0x33,
+	0xd6, 0x32, 0x4a, 0xa7, 0x13, 0xda, 0x31, 0xf2, 0x16, 0x3e, 0x86, 0x4b, 0x17, 0x3e, 0x04, 0x4b,
+	0x96, 0x46, 0x13, 0x63, 0x60, 0xe1, 0x6b, 0x98, 0x69, 0x8b, 0x7f, 0xc8, 0xb0, 0x69, 0x9a, 0x9e,
+	0xdf, 0x39, 0xdf, 0xd7, 0xef, 0x58, 0x5b, 0x3e, 0xe3, 0x94, 0x71, 0x88, 0x62, 0x31, 0x80, 0x37,
+	0x2d, 0x0f, 0x0b, 0xd4, 0x82, 0x04, 0x87, 0x98, 0x07, 0x1c, 0x44, 0x23, 0x26, 0x98, 0xbd, 0xa6,
+	0x10, 0x90, 0x20, 0x40, 0x23, 0xe5, 0x12, 0x61, 0x8c, 0x0c, 0x31, 0x94, 0x88, 0x17, 0x5f, 0x42,
+	0x14, 0x8e, 0x15, 0x5f, 0x2e, 0x12, 0x46, 0x98, 0xbc, 0xc2, 0xe4, 0xa6, 0x5f, 0xdd, 0x34, 0x21,
+	0x39, 0x52, 0xd5, 0xab, 0x69, 0x75, 0x1a, 0x0f, 0x45, 0xc0, 0x03, 0xa2, 0x99, 0x9d, 0x55, 0x33,
+	0x70, 0x28, 0x02, 0x1f, 0x09, 0x36, 0xd2, 0x60, 0x49, 0x81, 0xe7, 0xca, 0x85, 0xf6, 0xaf, 0x4a,
+	0x05, 0x44, 0x83, 0x90, 0x41, 0x79, 0xaa, 0xa7, 0xea, 0x4b, 0xc6, 0xfa, 0x7b, 0xac, 0xbe, 0x7c,
+	0x2a, 0x90, 0xc0, 0xf6, 0xa1, 0x95, 0x8b, 0xd0, 0x08, 0x51, 0xee, 0x98, 0x15, 0xb3, 0x96, 0x6f,
+	0x6f, 0x80, 0x94, 0x08, 0x40, 0x4f, 0x22, 0xdd, 0x3f, 0x93, 0xd7, 0x4d, 0xe3, 0xfe, 0xfd, 0x61,
+	0xd7, 0xec, 0xeb, 0x2e, 0xbb, 0x69, 0xfd, 0x46, 0xbe, 0xcf, 0xe2, 0x50, 0x70, 0x27, 0x53, 0xc9,
+	0xd6, 0xf2, 0xed, 0x22, 0x50, 0x79, 0x81, 0x45, 0x5e, 0xa0, 0x13, 0x8e, 0xfb, 0x9f, 0x94, 0x4d,
+	0x2d, 0x7b, 0xf1, 0xd7, 0xc4, 0x74, 0xc4, 0x38, 0x1a, 0x72, 0x27, 0x2b, 0x7b, 0xb7, 0x53, 0xd5,
+	0x4f, 0x34, 0xde, 0xd3, 0x74, 0x77, 0x3d, 0xf1, 0xf1, 0xfc, 0xd8, 0xf8, 0xaf, 0xe8, 0x06, 0xbf,
+	0xb8, 0xae, 0x34, 0xc1, 0xc1, 0x7e, 0xbf, 0x40, 0x97, 0x50, 0x6e, 0x5f, 0x59, 0xff, 0x7e, 0xc4,
+	0xc6, 0x9d, 0x5f, 0x52, 0xaa, 0x9e, 0x2a, 0xd5, 0x51, 0x2e, 0x3b, 0xdf, 0x3b, 0x56, 0xcb, 0x2d,
+	0x4d, 0xee, 0x1e, 0x4d, 0x66, 0xae, 0x39, 0x9d, 0xb9, 0xe6, 0xdb, 0xcc, 0x35, 0xef, 0xe6, 0xae,
+	0x31, 0x9d, 0xbb, 0xc6, 0xd3, 0xdc, 0x35, 0xce, 0xea, 0x24, 0x10, 0x83, 0xd8, 0x03, 0x3e, 0xa3,
+	0x7a, 0x47, 0xf0, 0x6b, 0x18, 0xbc, 0x55, 0x6b, 0x16, 0xe3, 0x08, 0x73, 0x2f, 0x27, 0x73, 0xdb,
+	0xfb, 0x08, 0x00, 0x00, 0xff, 0xff, 0x40, 0x5d, 0xb4, 0x5f, 0xaf, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +153,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Authenticators) > 0 {
+		for iNdEx := len(m.Authenticators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MultisigProposals) > 0 {
+		for iNdEx := len(m.MultisigProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MultisigProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -179,6 +233,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MultisigProposals) > 0 {
+		for _, e := range m.MultisigProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Authenticators) > 0 {
+		for _, e := range m.Authenticators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -284,6 +350,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultisigProposals = append(m.MultisigProposals, MultisigProposal{})
+			if err := m.MultisigProposals[len(m.MultisigProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authenticators = append(m.Authenticators, AccountAuthenticator{})
+			if err := m.Authenticators[len(m.Authenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
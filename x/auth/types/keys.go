@@ -25,6 +25,24 @@ var (
 	// account number is stored.
 	GlobalAccountNumberKey = collections.NewPrefix(2)
 
+	// MultisigProposalsKeyPrefix prefix for the multisig proposals, by account address and proposal id.
+	MultisigProposalsKeyPrefix = collections.NewPrefix(3)
+
+	// MultisigSequenceKey identifies the prefix where the sequence used to derive
+	// multisig account addresses is stored.
+	MultisigSequenceKey = collections.NewPrefix(4)
+
+	// AccountAuthenticatorsKeyPrefix prefix for the account authenticators, by account address and authenticator id.
+	AccountAuthenticatorsKeyPrefix = collections.NewPrefix(5)
+
+	// AuthenticatorSequenceKey identifies the prefix where the sequence of the
+	// authenticator ids is stored.
+	AuthenticatorSequenceKey = collections.NewPrefix(6)
+
+	// MultisigProposalsQueueKeyPrefix prefix for the pending multisig proposals,
+	// by voting end, account address and proposal id.
+	MultisigProposalsQueueKeyPrefix = collections.NewPrefix(7)
+
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
 
@@ -0,0 +1,259 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/core/address"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// Multisig event types and attribute keys.
+const (
+	EventTypeCreateMultisig   = "create_multisig"
+	EventTypeUpdateMultisig   = "update_multisig"
+	EventTypeMultisigProposal = "multisig_proposal"
+	EventTypeMultisigVote     = "multisig_vote"
+
+	AttributeKeyMultisig   = "multisig"
+	AttributeKeyProposalID = "proposal_id"
+	AttributeKeyProposer   = "proposer"
+	AttributeKeyVoter      = "voter"
+	AttributeKeyOption     = "option"
+	AttributeKeyStatus     = "status"
+	AttributeKeyError      = "error"
+)
+
+// MaxMultisigMembers is the maximum number of members of a multisig account,
+// which bounds the size of the account and the cost of counting its votes.
+const MaxMultisigMembers = 100
+
+// MultisigDerivationKey is the derivation key of the multisig account
+// addresses, which are derived from the x/auth module address.
+var MultisigDerivationKey = []byte("multisig")
+
+var (
+	_ GenesisAccount                     = (*MultisigAccount)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*MultisigProposal)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*MsgSubmitMultisigProposal)(nil)
+)
+
+// NewMultisigAccount returns a multisig account with the given members,
+// threshold and voting period.
+func NewMultisigAccount(ba *BaseAccount, members []MultisigMember, threshold uint64, votingPeriod time.Duration) *MultisigAccount {
+	return &MultisigAccount{
+		BaseAccount:    ba,
+		Members:        members,
+		Threshold:      threshold,
+		VotingPeriod:   votingPeriod,
+		NextProposalId: 1,
+	}
+}
+
+// SetPubKey implements AccountI. Multisig accounts cannot have a public key.
+func (ma MultisigAccount) SetPubKey(pubKey cryptotypes.PubKey) error {
+	return errors.New("not supported for multisig accounts")
+}
+
+// Validate checks for errors on the account fields.
+func (ma MultisigAccount) Validate() error {
+	if ma.BaseAccount == nil {
+		return errors.New("uninitialized MultisigAccount: BaseAccount is nil")
+	}
+
+	if ma.PubKey != nil {
+		return errors.New("multisig account cannot have a public key")
+	}
+
+	if ma.NextProposalId == 0 {
+		return errors.New("multisig next proposal id must be positive")
+	}
+
+	ac := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	if err := ValidateMultisig(ac, ma.Members, ma.Threshold, ma.VotingPeriod); err != nil {
+		return err
+	}
+
+	for _, member := range ma.Members {
+		if member.Address == ma.Address {
+			return errors.New("multisig account cannot be one of its own members")
+		}
+	}
+
+	return ma.BaseAccount.Validate()
+}
+
+// MemberWeight returns the weight of the given member, zero if the address is
+// not a member of the multisig.
+func (ma MultisigAccount) MemberWeight(addr string) uint64 {
+	for _, member := range ma.Members {
+		if member.Address == addr {
+			return member.Weight
+		}
+	}
+
+	return 0
+}
+
+// TotalWeight returns the total weight of the members of the multisig.
+func (ma MultisigAccount) TotalWeight() uint64 {
+	var total uint64
+	for _, member := range ma.Members {
+		total += member.Weight
+	}
+
+	return total
+}
+
+// NormalizeMultisigMembers returns the members with their addresses encoded
+// by the address codec, so that a member has a single spelling.
+func NormalizeMultisigMembers(ac address.Codec, members []MultisigMember) ([]MultisigMember, error) {
+	normalized := make([]MultisigMember, len(members))
+	for i, member := range members {
+		bz, err := ac.StringToBytes(member.Address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid multisig member address %s: %w", member.Address, err)
+		}
+		addr, err := ac.BytesToString(bz)
+		if err != nil {
+			return nil, err
+		}
+		normalized[i] = MultisigMember{Address: addr, Weight: member.Weight}
+	}
+
+	return normalized, nil
+}
+
+// ValidateMultisig checks the members, threshold and voting period of a
+// multisig account. The member addresses must be encoded by the address codec,
+// as returned by NormalizeMultisigMembers.
+func ValidateMultisig(ac address.Codec, members []MultisigMember, threshold uint64, votingPeriod time.Duration) error {
+	if len(members) == 0 {
+		return errors.New("multisig members cannot be empty")
+	}
+	if len(members) > MaxMultisigMembers {
+		return fmt.Errorf("multisig cannot have more than %d members, got %d", MaxMultisigMembers, len(members))
+	}
+
+	var total uint64
+	seen := make([][]byte, 0, len(members))
+	for _, member := range members {
+		bz, err := ac.StringToBytes(member.Address)
+		if err != nil {
+			return fmt.Errorf("invalid multisig member address %s: %w", member.Address, err)
+		}
+		if addr, err := ac.BytesToString(bz); err != nil {
+			return err
+		} else if addr != member.Address {
+			return fmt.Errorf("multisig member address %s must be encoded as %s", member.Address, addr)
+		}
+		for _, other := range seen {
+			if bytes.Equal(bz, other) {
+				return fmt.Errorf("duplicate multisig member %s", member.Address)
+			}
+		}
+		seen = append(seen, bz)
+
+		if member.Weight == 0 {
+			return fmt.Errorf("weight of multisig member %s must be positive", member.Address)
+		}
+		if total+member.Weight < total {
+			return errors.New("total weight of multisig members overflows")
+		}
+		total += member.Weight
+	}
+
+	if threshold == 0 {
+		return errors.New("multisig threshold must be positive")
+	}
+	if threshold > total {
+		return fmt.Errorf("multisig threshold %d exceeds the total weight %d", threshold, total)
+	}
+
+	if votingPeriod <= 0 {
+		return errors.New("multisig voting period must be positive")
+	}
+
+	return nil
+}
+
+// GetMsgs unpacks the messages of the proposal.
+func (p MultisigProposal) GetMsgs() ([]sdk.Msg, error) {
+	return tx.GetMsgs(p.Messages, "multisig proposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p MultisigProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return tx.UnpackInterfaces(unpacker, p.Messages)
+}
+
+// Tally returns the total weight of the yes and no votes of the proposal,
+// weighted by the members of the given multisig account.
+func (p MultisigProposal) Tally(account MultisigAccount) (yes, no uint64) {
+	for _, vote := range p.Votes {
+		switch vote.Option {
+		case MULTISIG_VOTE_OPTION_YES:
+			yes += account.MemberWeight(vote.Voter)
+		case MULTISIG_VOTE_OPTION_NO:
+			no += account.MemberWeight(vote.Voter)
+		}
+	}
+
+	return yes, no
+}
+
+// HasVoted returns true if the given member voted on the proposal.
+func (p MultisigProposal) HasVoted(voter string) bool {
+	for _, vote := range p.Votes {
+		if vote.Voter == voter {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateMultisigProposal checks the fields of a multisig proposal.
+func ValidateMultisigProposal(p MultisigProposal) error {
+	if p.Id == 0 {
+		return errors.New("multisig proposal id must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Account); err != nil {
+		return fmt.Errorf("invalid multisig account address %s: %w", p.Account, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Proposer); err != nil {
+		return fmt.Errorf("invalid proposer address %s: %w", p.Proposer, err)
+	}
+	if len(p.Messages) == 0 {
+		return fmt.Errorf("multisig proposal %d has no messages", p.Id)
+	}
+	if _, ok := MultisigProposalStatus_name[int32(p.Status)]; !ok || p.Status == MULTISIG_PROPOSAL_STATUS_UNSPECIFIED {
+		return fmt.Errorf("invalid status of multisig proposal %d: %s", p.Id, p.Status)
+	}
+
+	seen := make(map[string]struct{}, len(p.Votes))
+	for _, vote := range p.Votes {
+		if _, ok := seen[vote.Voter]; ok {
+			return fmt.Errorf("duplicate vote of %s on multisig proposal %d", vote.Voter, p.Id)
+		}
+		seen[vote.Voter] = struct{}{}
+
+		if vote.Option != MULTISIG_VOTE_OPTION_YES && vote.Option != MULTISIG_VOTE_OPTION_NO {
+			return fmt.Errorf("invalid vote option of %s on multisig proposal %d: %s", vote.Voter, p.Id, vote.Option)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgSubmitMultisigProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return tx.UnpackInterfaces(unpacker, m.Messages)
+}
@@ -0,0 +1,1511 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/auth/v1beta1/multisig.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	any "github.com/cosmos/gogoproto/types/any"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MultisigVoteOption enumerates the valid vote options of a multisig proposal.
+type MultisigVoteOption int32
+
+const (
+	// MULTISIG_VOTE_OPTION_UNSPECIFIED defines a no-op vote option.
+	MULTISIG_VOTE_OPTION_UNSPECIFIED MultisigVoteOption = 0
+	// MULTISIG_VOTE_OPTION_YES approves the proposal.
+	MULTISIG_VOTE_OPTION_YES MultisigVoteOption = 1
+	// MULTISIG_VOTE_OPTION_NO rejects the proposal.
+	MULTISIG_VOTE_OPTION_NO MultisigVoteOption = 2
+)
+
+var MultisigVoteOption_name = map[int32]string{
+	0: "MULTISIG_VOTE_OPTION_UNSPECIFIED",
+	1: "MULTISIG_VOTE_OPTION_YES",
+	2: "MULTISIG_VOTE_OPTION_NO",
+}
+
+var MultisigVoteOption_value = map[string]int32{
+	"MULTISIG_VOTE_OPTION_UNSPECIFIED": 0,
+	"MULTISIG_VOTE_OPTION_YES":         1,
+	"MULTISIG_VOTE_OPTION_NO":          2,
+}
+
+func (x MultisigVoteOption) String() string {
+	return proto.EnumName(MultisigVoteOption_name, int32(x))
+}
+
+func (MultisigVoteOption) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c70681833aa92507, []int{0}
+}
+
+// MultisigProposalStatus defines the status of a multisig proposal.
+type MultisigProposalStatus int32
+
+const (
+	// MULTISIG_PROPOSAL_STATUS_UNSPECIFIED defines the default status.
+	MULTISIG_PROPOSAL_STATUS_UNSPECIFIED MultisigProposalStatus = 0
+	// MULTISIG_PROPOSAL_STATUS_PENDING is the status of a proposal open for votes.
+	MULTISIG_PROPOSAL_STATUS_PENDING MultisigProposalStatus = 1
+	// MULTISIG_PROPOSAL_STATUS_EXECUTED is the status of a proposal whose
+	// messages were executed successfully.
+	MULTISIG_PROPOSAL_STATUS_EXECUTED MultisigProposalStatus = 2
+	// MULTISIG_PROPOSAL_STATUS_FAILED is the status of a proposal whose messages
+	// failed to execute. None of its messages are applied.
+	MULTISIG_PROPOSAL_STATUS_FAILED MultisigProposalStatus = 3
+	// MULTISIG_PROPOSAL_STATUS_REJECTED is the status of a proposal which can no
+	// longer reach the threshold.
+	MULTISIG_PROPOSAL_STATUS_REJECTED MultisigProposalStatus = 4
+	// MULTISIG_PROPOSAL_STATUS_ABORTED is the status of a proposal which was
+	// pending when the members of the multisig changed.
+	MULTISIG_PROPOSAL_STATUS_ABORTED MultisigProposalStatus = 5
+	// MULTISIG_PROPOSAL_STATUS_EXPIRED is the status of a proposal whose voting
+	// period ended before it was decided.
+	MULTISIG_PROPOSAL_STATUS_EXPIRED MultisigProposalStatus = 6
+)
+
+var MultisigProposalStatus_name = map[int32]string{
+	0: "MULTISIG_PROPOSAL_STATUS_UNSPECIFIED",
+	1: "MULTISIG_PROPOSAL_STATUS_PENDING",
+	2: "MULTISIG_PROPOSAL_STATUS_EXECUTED",
+	3: "MULTISIG_PROPOSAL_STATUS_FAILED",
+	4: "MULTISIG_PROPOSAL_STATUS_REJECTED",
+	5: "MULTISIG_PROPOSAL_STATUS_ABORTED",
+	6: "MULTISIG_PROPOSAL_STATUS_EXPIRED",
+}
+
+var MultisigProposalStatus_value = map[string]int32{
+	"MULTISIG_PROPOSAL_STATUS_UNSPECIFIED": 0,
+	"MULTISIG_PROPOSAL_STATUS_PENDING":     1,
+	"MULTISIG_PROPOSAL_STATUS_EXECUTED":    2,
+	"MULTISIG_PROPOSAL_STATUS_FAILED":      3,
+	"MULTISIG_PROPOSAL_STATUS_REJECTED":    4,
+	"MULTISIG_PROPOSAL_STATUS_ABORTED":     5,
+	"MULTISIG_PROPOSAL_STATUS_EXPIRED":     6,
+}
+
+func (x MultisigProposalStatus) String() string {
+	return proto.EnumName(MultisigProposalStatus_name, int32(x))
+}
+
+func (MultisigProposalStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c70681833aa92507, []int{1}
+}
+
+// MultisigMember defines a member of an on-chain multisig account.
+type MultisigMember struct {
+	// address is the member's account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the voting weight of the member.
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *MultisigMember) Reset()         { *m = MultisigMember{} }
+func (m *MultisigMember) String() string { return proto.CompactTextString(m) }
+func (*MultisigMember) ProtoMessage()    {}
+func (*MultisigMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c70681833aa92507, []int{0}
+}
+func (m *MultisigMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultisigMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultisigMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultisigMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultisigMember.Merge(m, src)
+}
+func (m *MultisigMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultisigMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultisigMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultisigMember proto.InternalMessageInfo
+
+func (m *MultisigMember) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MultisigMember) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// MultisigAccount defines an on-chain multisig account. It cannot sign
+// transactions, its messages are executed once members holding at least
+// threshold weight approve a proposal.
+type MultisigAccount struct {
+	*BaseAccount `protobuf:"bytes,1,opt,name=base_account,json=baseAccount,proto3,embedded=base_account" json:"base_account,omitempty"`
+	// members are the members of the multisig and their weights.
+	Members []MultisigMember `protobuf:"bytes,2,rep,name=members,proto3" json:"members"`
+	// threshold is the total weight of yes votes needed to execute a proposal.
+	Threshold uint64 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// voting_period is the duration during which a proposal can be voted on.
+	VotingPeriod time.Duration `protobuf:"bytes,4,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period"`
+	// next_proposal_id is the id assigned to the next proposal of the multisig.
+	NextProposalId uint64 `protobuf:"varint,5,opt,name=next_proposal_id,json=nextProposalId,proto3" json:"next_proposal_id,omitempty"`
+}
+
+func (m *MultisigAccount) Reset()         { *m = MultisigAccount{} }
+func (m *MultisigAccount) String() string { return proto.CompactTextString(m) }
+func (*MultisigAccount) ProtoMessage()    {}
+func (*MultisigAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c70681833aa92507, []int{1}
+}
+func (m *MultisigAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultisigAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultisigAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultisigAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultisigAccount.Merge(m, src)
+}
+func (m *MultisigAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultisigAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultisigAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultisigAccount proto.InternalMessageInfo
+
+// MultisigVote defines the vote of a multisig member on a proposal.
+type MultisigVote struct {
+	// voter is the address of the member.
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	// option is the vote option.
+	Option MultisigVoteOption `protobuf:"varint,2,opt,name=option,proto3,enum=cosmos.auth.v1beta1.MultisigVoteOption" json:"option,omitempty"`
+}
+
+func (m *MultisigVote) Reset()         { *m = MultisigVote{} }
+func (m *MultisigVote) String() string { return proto.CompactTextString(m) }
+func (*MultisigVote) ProtoMessage()    {}
+func (*MultisigVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c70681833aa92507, []int{2}
+}
+func (m *MultisigVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultisigVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultisigVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultisigVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultisigVote.Merge(m, src)
+}
+func (m *MultisigVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultisigVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultisigVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultisigVote proto.InternalMessageInfo
+
+func (m *MultisigVote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *MultisigVote) GetOption() MultisigVoteOption {
+	if m != nil {
+		return m.Option
+	}
+	return MULTISIG_VOTE_OPTION_UNSPECIFIED
+}
+
+// MultisigProposal defines a set of messages to execute on behalf of a
+// multisig account.
+type MultisigProposal struct {
+	// id is the unique id of the proposal within the multisig account.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// account is the address of the multisig account.
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// proposer is the address of the member who submitted the proposal.
+	Proposer string `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// messages are the messages executed once the proposal reaches the threshold.
+	Messages []*any.Any `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
+	// voting_end is the time after which the proposal can no longer be voted on.
+	VotingEnd time.Time `protobuf:"bytes,5,opt,name=voting_end,json=votingEnd,proto3,stdtime" json:"voting_end"`
+	// votes are the votes cast on the proposal.
+	Votes []MultisigVote `protobuf:"bytes,6,rep,name=votes,proto3" json:"votes"`
+	// status is the status of the proposal.
+	Status MultisigProposalStatus `protobuf:"varint,7,opt,name=status,proto3,enum=cosmos.auth.v1beta1.MultisigProposalStatus" json:"status,omitempty"`
+}
+
+func (m *MultisigProposal) Reset()         { *m = MultisigProposal{} }
+func (m *MultisigProposal) String() string { return proto.CompactTextString(m) }
+func (*MultisigProposal) ProtoMessage()    {}
+func (*MultisigProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c70681833aa92507, []int{3}
+}
+func (m *MultisigProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultisigProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultisigProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultisigProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultisigProposal.Merge(m, src)
+}
+func (m *MultisigProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultisigProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultisigProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultisigProposal proto.InternalMessageInfo
+
+func (m *MultisigProposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MultisigProposal) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *MultisigProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *MultisigProposal) GetMessages() []*any.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *MultisigProposal) GetVotingEnd() time.Time {
+	if m != nil {
+		return m.VotingEnd
+	}
+	return time.Time{}
+}
+
+func (m *MultisigProposal) GetVotes() []MultisigVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *MultisigProposal) GetStatus() MultisigProposalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return MULTISIG_PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.auth.v1beta1.MultisigVoteOption", MultisigVoteOption_name, MultisigVoteOption_value)
+	proto.RegisterEnum("cosmos.auth.v1beta1.MultisigProposalStatus", MultisigProposalStatus_name, MultisigProposalStatus_value)
+	proto.RegisterType((*MultisigMember)(nil), "cosmos.auth.v1beta1.MultisigMember")
+	proto.RegisterType((*MultisigAccount)(nil), "cosmos.auth.v1beta1.MultisigAccount")
+	proto.RegisterType((*MultisigVote)(nil), "cosmos.auth.v1beta1.MultisigVote")
+	proto.RegisterType((*MultisigProposal)(nil), "cosmos.auth.v1beta1.MultisigProposal")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/auth/v1beta1/multisig.proto", fileDescriptor_c70681833aa92507)
+}
+
+var fileDescriptor_c70681833aa92507 = []byte{
+	// 860 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x3d, 0x6f, 0xdb, 0x46,
+	0x18, 0x16, 0x25, 0x59, 0x8e, 0xcf, 0x8e, 0xc3, 0x5e, 0x8c, 0x94, 0x51, 0x0d, 0x49, 0x71, 0x52,
+	0x54, 0x75, 0x61, 0x32, 0x51, 0xd3, 0x25, 0x4b, 0xa1, 0x0f, 0x26, 0x61, 0x61, 0x8b, 0x04, 0x29,
+	0x1b, 0x69, 0x17, 0x82, 0x32, 0xaf, 0x14, 0x51, 0x93, 0xa7, 0xf2, 0x4e, 0x6e, 0x8c, 0xfe, 0x81,
+	0xa0, 0x53, 0x86, 0x16, 0xe8, 0x58, 0xa0, 0x40, 0xd1, 0xa5, 0x40, 0x06, 0xff, 0x88, 0xa0, 0x93,
+	0x91, 0xa9, 0x53, 0x5a, 0xd8, 0x43, 0xfe, 0x46, 0xc1, 0xbb, 0xa3, 0xed, 0xc8, 0xb4, 0xbc, 0x08,
+	0xba, 0xf7, 0x7d, 0x9e, 0x7b, 0x3f, 0x9e, 0x47, 0x3a, 0xb0, 0xb6, 0x8b, 0x49, 0x84, 0x89, 0xe6,
+	0x4d, 0xe8, 0x48, 0xdb, 0x7f, 0x30, 0x44, 0xd4, 0x7b, 0xa0, 0x45, 0x93, 0x3d, 0x1a, 0x92, 0x30,
+	0x50, 0xc7, 0x09, 0xa6, 0x18, 0xde, 0xe4, 0x18, 0x35, 0xc5, 0xa8, 0x02, 0x53, 0xfd, 0xc0, 0x8b,
+	0xc2, 0x18, 0x6b, 0xec, 0x93, 0xe3, 0xaa, 0xb7, 0x39, 0xce, 0x65, 0x27, 0x4d, 0x90, 0x78, 0x6a,
+	0x25, 0xc0, 0x01, 0xe6, 0xf1, 0xf4, 0x5b, 0x46, 0x08, 0x30, 0x0e, 0xf6, 0x90, 0xc6, 0x4e, 0xc3,
+	0xc9, 0xb7, 0x9a, 0x17, 0x1f, 0x88, 0x54, 0x6d, 0x3a, 0xe5, 0x4f, 0x12, 0x8f, 0x86, 0x38, 0x16,
+	0xf9, 0xfa, 0x74, 0x9e, 0x86, 0x11, 0x22, 0xd4, 0x8b, 0xc6, 0xd9, 0x05, 0x79, 0x83, 0xb1, 0x09,
+	0x58, 0x7e, 0xed, 0x7b, 0xb0, 0xbc, 0x25, 0xc6, 0xdc, 0x42, 0xd1, 0x10, 0x25, 0xb0, 0x05, 0xe6,
+	0x3d, 0xdf, 0x4f, 0x10, 0x21, 0x8a, 0xd4, 0x90, 0x9a, 0x0b, 0x1d, 0xe5, 0xcd, 0xe1, 0xc6, 0x8a,
+	0x18, 0xa3, 0xcd, 0x33, 0x0e, 0x4d, 0xc2, 0x38, 0xb0, 0x33, 0x20, 0xbc, 0x05, 0x2a, 0x3f, 0xa0,
+	0x30, 0x18, 0x51, 0xa5, 0xd8, 0x90, 0x9a, 0x65, 0x5b, 0x9c, 0x1e, 0xdd, 0x7c, 0x73, 0xb8, 0x71,
+	0x83, 0x53, 0x37, 0x88, 0xff, 0x5d, 0xe3, 0xbe, 0xfa, 0xc5, 0xc3, 0xb5, 0x5f, 0x4a, 0xe0, 0x46,
+	0x56, 0xb3, 0xbd, 0xbb, 0x8b, 0x27, 0x31, 0x85, 0x06, 0x58, 0x1a, 0x7a, 0x04, 0xb9, 0x1e, 0x3f,
+	0xb3, 0xca, 0x8b, 0xad, 0x86, 0x9a, 0xb3, 0x72, 0xb5, 0xe3, 0x11, 0x24, 0x78, 0x9d, 0xf2, 0xd1,
+	0xdb, 0xba, 0x64, 0x2f, 0x0e, 0xcf, 0x42, 0xf0, 0x29, 0x98, 0x8f, 0xd8, 0x24, 0x44, 0x29, 0x36,
+	0x4a, 0xcd, 0xc5, 0xd6, 0xdd, 0xdc, 0x5b, 0xde, 0x9f, 0xba, 0xb3, 0xf0, 0xfa, 0x6d, 0xbd, 0xf0,
+	0xe7, 0xbb, 0x57, 0xeb, 0x92, 0x9d, 0xd1, 0xe1, 0x2a, 0x58, 0xa0, 0xa3, 0x04, 0x91, 0x11, 0xde,
+	0xf3, 0x95, 0x12, 0x1b, 0xec, 0x2c, 0x00, 0xb7, 0xc0, 0xf5, 0x7d, 0x4c, 0xc3, 0x38, 0x70, 0xc7,
+	0x28, 0x09, 0xb1, 0xaf, 0x94, 0x59, 0xcf, 0xb7, 0x55, 0x2e, 0x89, 0x9a, 0x49, 0xa2, 0xf6, 0x84,
+	0x64, 0x9d, 0xeb, 0x69, 0x8d, 0x5f, 0xff, 0xad, 0x4b, 0xbc, 0xce, 0x12, 0xa7, 0x5b, 0x8c, 0x0d,
+	0x9b, 0x40, 0x8e, 0xd1, 0x73, 0x9a, 0xba, 0x66, 0x8c, 0x89, 0xb7, 0xe7, 0x86, 0xbe, 0x32, 0xc7,
+	0x6a, 0x2e, 0xa7, 0x71, 0x4b, 0x84, 0x0d, 0xff, 0xd1, 0xce, 0x8b, 0xdf, 0xea, 0x85, 0xbf, 0x0f,
+	0x37, 0x56, 0xf3, 0xc6, 0x12, 0x5b, 0x30, 0x72, 0x16, 0xff, 0xd3, 0xbb, 0x57, 0xeb, 0xd5, 0xb3,
+	0x98, 0x36, 0xa5, 0xc1, 0xda, 0xcf, 0x12, 0x58, 0xca, 0x62, 0x3b, 0x98, 0x22, 0xa8, 0x82, 0xb9,
+	0x7d, 0x4c, 0x51, 0x72, 0xa5, 0x0f, 0x38, 0x0c, 0x7e, 0x09, 0x2a, 0x78, 0x9c, 0x4e, 0xca, 0x5c,
+	0xb0, 0xdc, 0xfa, 0x64, 0xe6, 0xe2, 0xd3, 0x12, 0x26, 0x83, 0xdb, 0x82, 0x96, 0x6f, 0x97, 0x3f,
+	0x4a, 0x40, 0xce, 0x38, 0xd9, 0x16, 0xe0, 0x32, 0x28, 0x86, 0x3e, 0xeb, 0xab, 0x6c, 0x17, 0x43,
+	0x9f, 0x99, 0x56, 0x58, 0xa7, 0x78, 0xa5, 0x69, 0x85, 0x51, 0x1e, 0x82, 0x6b, 0x7c, 0xd9, 0x28,
+	0x61, 0xea, 0xce, 0x22, 0x9d, 0x22, 0xe1, 0x7d, 0x70, 0x2d, 0x42, 0x84, 0x78, 0x01, 0x22, 0x4a,
+	0x99, 0xf9, 0x6b, 0xe5, 0x82, 0xe2, 0xed, 0xf8, 0xc0, 0x3e, 0x45, 0xc1, 0xa7, 0x00, 0x08, 0xa3,
+	0xa0, 0x98, 0x6b, 0xba, 0xd8, 0xaa, 0x5e, 0xe0, 0x0c, 0xb2, 0x1f, 0x2e, 0xb7, 0xc9, 0xcb, 0x53,
+	0x9b, 0x2c, 0x70, 0xb2, 0x1e, 0xfb, 0xb0, 0xc3, 0x05, 0x21, 0x4a, 0x85, 0x15, 0xbe, 0x73, 0xe5,
+	0x7e, 0xcf, 0xdb, 0x9a, 0x53, 0x61, 0x17, 0x54, 0x08, 0xf5, 0xe8, 0x84, 0x28, 0xf3, 0x4c, 0xa4,
+	0xcf, 0x66, 0x5e, 0x92, 0x2d, 0xdc, 0x61, 0x14, 0x5b, 0x50, 0x73, 0x85, 0x5a, 0xff, 0x11, 0xc0,
+	0x8b, 0xda, 0xc2, 0x7b, 0xa0, 0xb1, 0xb5, 0xbd, 0x39, 0x30, 0x1c, 0xe3, 0x89, 0xbb, 0x63, 0x0e,
+	0x74, 0xd7, 0xb4, 0x06, 0x86, 0xd9, 0x77, 0xb7, 0xfb, 0x8e, 0xa5, 0x77, 0x8d, 0xc7, 0x86, 0xde,
+	0x93, 0x0b, 0x70, 0x15, 0x28, 0xb9, 0xa8, 0xaf, 0x75, 0x47, 0x96, 0xe0, 0x47, 0xe0, 0xc3, 0xdc,
+	0x6c, 0xdf, 0x94, 0x8b, 0xd5, 0xf2, 0x8b, 0xdf, 0x6b, 0x85, 0xf5, 0xbf, 0x8a, 0xe0, 0x56, 0x7e,
+	0xd3, 0xb0, 0x09, 0xee, 0x9d, 0xb2, 0x2d, 0xdb, 0xb4, 0x4c, 0xa7, 0xbd, 0xe9, 0x3a, 0x83, 0xf6,
+	0x60, 0xdb, 0x99, 0xea, 0xe2, 0x7c, 0xaf, 0xd3, 0x48, 0x4b, 0xef, 0xf7, 0x8c, 0xfe, 0x13, 0x59,
+	0x82, 0x1f, 0x83, 0x3b, 0x97, 0xa2, 0xf4, 0x67, 0x7a, 0x77, 0x7b, 0xa0, 0xf7, 0xe4, 0x22, 0xbc,
+	0x0b, 0xea, 0x97, 0xc2, 0x1e, 0xb7, 0x8d, 0x4d, 0xbd, 0x27, 0x97, 0x66, 0xde, 0x65, 0xeb, 0x5f,
+	0xe9, 0xdd, 0xf4, 0xae, 0xf2, 0xcc, 0xc6, 0xda, 0x1d, 0xd3, 0x4e, 0x51, 0x73, 0x33, 0x51, 0xfa,
+	0x33, 0xcb, 0xb0, 0xf5, 0x9e, 0x5c, 0xe1, 0xfb, 0xea, 0x74, 0x5f, 0x1f, 0xd7, 0xa4, 0xa3, 0xe3,
+	0x9a, 0xf4, 0xdf, 0x71, 0x4d, 0x7a, 0x79, 0x52, 0x2b, 0x1c, 0x9d, 0xd4, 0x0a, 0xff, 0x9c, 0xd4,
+	0x0a, 0xdf, 0x7c, 0x1a, 0x84, 0x74, 0x34, 0x19, 0xaa, 0xbb, 0x38, 0x12, 0x8f, 0x97, 0x76, 0xee,
+	0x4f, 0xe3, 0x39, 0x7f, 0x49, 0xe8, 0xc1, 0x18, 0x91, 0x61, 0x85, 0xb9, 0xf7, 0xf3, 0xff, 0x03,
+	0x00, 0x00, 0xff, 0xff, 0xb6, 0x33, 0x31, 0xc2, 0x3e, 0x07, 0x00, 0x00,
+}
+
+func (m *MultisigMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultisigMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultisigMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMultisig(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultisigAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultisigAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultisigAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextProposalId != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.NextProposalId))
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMultisig(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.Threshold != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultisig(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BaseAccount != nil {
+		{
+			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMultisig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultisigVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultisigVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultisigVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Option != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintMultisig(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultisigProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultisigProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultisigProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultisig(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingEnd):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintMultisig(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultisig(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintMultisig(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintMultisig(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMultisig(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMultisig(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MultisigMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovMultisig(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *MultisigAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovMultisig(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovMultisig(uint64(m.Threshold))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod)
+	n += 1 + l + sovMultisig(uint64(l))
+	if m.NextProposalId != 0 {
+		n += 1 + sovMultisig(uint64(m.NextProposalId))
+	}
+	return n
+}
+
+func (m *MultisigVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	if m.Option != 0 {
+		n += 1 + sovMultisig(uint64(m.Option))
+	}
+	return n
+}
+
+func (m *MultisigProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMultisig(uint64(m.Id))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovMultisig(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingEnd)
+	n += 1 + l + sovMultisig(uint64(l))
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovMultisig(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovMultisig(uint64(m.Status))
+	}
+	return n
+}
+
+func sovMultisig(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMultisig(x uint64) (n int) {
+	return sovMultisig(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MultisigMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultisigMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultisigMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultisigAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultisigAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultisigAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAccount == nil {
+				m.BaseAccount = &BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, MultisigMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextProposalId", wireType)
+			}
+			m.NextProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultisigVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultisigVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultisigVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= MultisigVoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultisigProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultisigProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultisigProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &any.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.VotingEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, MultisigVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MultisigProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMultisig(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMultisig
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMultisig
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMultisig
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMultisig        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMultisig          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMultisig = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestValidateMultisig(t *testing.T) {
+	alice := sdk.AccAddress("alice_______________").String()
+	bob := sdk.AccAddress("bob_________________").String()
+	ac := addresscodec.NewBech32Codec("cosmos")
+
+	tooMany := make([]types.MultisigMember, types.MaxMultisigMembers+1)
+	for i := range tooMany {
+		tooMany[i] = types.MultisigMember{Address: sdk.AccAddress(fmt.Sprintf("member%014d", i)).String(), Weight: 1}
+	}
+
+	testCases := []struct {
+		name      string
+		members   []types.MultisigMember
+		threshold uint64
+		period    time.Duration
+		expErr    string
+	}{
+		{"valid", []types.MultisigMember{{Address: alice, Weight: 1}, {Address: bob, Weight: 2}}, 3, time.Hour, ""},
+		{"no members", nil, 1, time.Hour, "members cannot be empty"},
+		{"invalid member", []types.MultisigMember{{Address: "invalid", Weight: 1}}, 1, time.Hour, "invalid multisig member address"},
+		{"duplicate member", []types.MultisigMember{{Address: alice, Weight: 1}, {Address: alice, Weight: 1}}, 1, time.Hour, "duplicate multisig member"},
+		{"upper case member", []types.MultisigMember{{Address: strings.ToUpper(alice), Weight: 1}}, 1, time.Hour, "must be encoded as " + alice},
+		{"too many members", tooMany, 1, time.Hour, "cannot have more than 100 members"},
+		{"zero weight", []types.MultisigMember{{Address: alice, Weight: 0}}, 1, time.Hour, "must be positive"},
+		{"zero threshold", []types.MultisigMember{{Address: alice, Weight: 1}}, 0, time.Hour, "threshold must be positive"},
+		{"unreachable threshold", []types.MultisigMember{{Address: alice, Weight: 1}}, 2, time.Hour, "exceeds the total weight"},
+		{"no voting period", []types.MultisigMember{{Address: alice, Weight: 1}}, 1, 0, "voting period must be positive"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateMultisig(ac, tc.members, tc.threshold, tc.period)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestNormalizeMultisigMembers(t *testing.T) {
+	alice := sdk.AccAddress("alice_______________").String()
+	ac := addresscodec.NewBech32Codec("cosmos")
+
+	members, err := types.NormalizeMultisigMembers(ac, []types.MultisigMember{{Address: strings.ToUpper(alice), Weight: 1}, {Address: alice, Weight: 2}})
+	require.NoError(t, err)
+	require.Equal(t, []types.MultisigMember{{Address: alice, Weight: 1}, {Address: alice, Weight: 2}}, members)
+	require.ErrorContains(t, types.ValidateMultisig(ac, members, 1, time.Hour), "duplicate multisig member")
+
+	_, err = types.NormalizeMultisigMembers(ac, []types.MultisigMember{{Address: "invalid", Weight: 1}})
+	require.ErrorContains(t, err, "invalid multisig member address")
+}
@@ -37,7 +37,11 @@ var _ cdctypes.UnpackInterfacesMessage = &Grant{}
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (g Grant) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 	var authorization Authorization
-	return unpacker.UnpackAny(g.Authorization, &authorization)
+	if err := unpacker.UnpackAny(g.Authorization, &authorization); err != nil {
+		return err
+	}
+
+	return unpackConstraints(unpacker, g.Constraints)
 }
 
 // GetAuthorization returns the cached value from the Grant.Authorization if present.
@@ -53,6 +57,21 @@ func (g Grant) GetAuthorization() (Authorization, error) {
 	return a, nil
 }
 
+// SetConstraints converts the constraints to Any and sets them on the Grant.
+func (g *Grant) SetConstraints(constraints []Constraint) error {
+	anys, err := packConstraints(constraints)
+	if err != nil {
+		return err
+	}
+	g.Constraints = anys
+	return nil
+}
+
+// GetConstraints returns the cached values from the Grant.Constraints.
+func (g Grant) GetConstraints() ([]Constraint, error) {
+	return unpackCachedConstraints(g.Constraints)
+}
+
 func (g Grant) ValidateBasic() error {
 	if g.Authorization == nil {
 		return sdkerrors.ErrInvalidType.Wrap("authorization is nil")
@@ -63,5 +82,72 @@ func (g Grant) ValidateBasic() error {
 	if !ok {
 		return sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (Authorization)(nil), av)
 	}
-	return a.ValidateBasic()
+	if err := a.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return validateConstraints(g.Constraints)
+}
+
+func packConstraints(constraints []Constraint) ([]*cdctypes.Any, error) {
+	if len(constraints) == 0 {
+		return nil, nil
+	}
+
+	anys := make([]*cdctypes.Any, len(constraints))
+	for i, c := range constraints {
+		any, err := cdctypes.NewAnyWithValue(c)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+	return anys, nil
+}
+
+func unpackConstraints(unpacker cdctypes.AnyUnpacker, anys []*cdctypes.Any) error {
+	for _, any := range anys {
+		var constraint Constraint
+		if err := unpacker.UnpackAny(any, &constraint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unpackCachedConstraints(anys []*cdctypes.Any) ([]Constraint, error) {
+	constraints := make([]Constraint, len(anys))
+	for i, any := range anys {
+		if any == nil {
+			return nil, sdkerrors.ErrInvalidType.Wrap("constraint is nil")
+		}
+		cv := any.GetCachedValue()
+		c, ok := cv.(Constraint)
+		if !ok {
+			return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (Constraint)(nil), cv)
+		}
+		constraints[i] = c
+	}
+	return constraints, nil
+}
+
+func validateConstraints(anys []*cdctypes.Any) error {
+	constraints, err := unpackCachedConstraints(anys)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(constraints))
+	for _, c := range constraints {
+		name := proto.MessageName(c)
+		if _, ok := seen[name]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate constraint %s", name)
+		}
+		seen[name] = struct{}{}
+
+		if err := c.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -29,6 +29,14 @@ const (
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
 	FlagAllowList         = "allow-list"
+	FlagMaxExecutions     = "max-executions"
+	FlagPeriodSpendLimit  = "period-spend-limit"
+	FlagSpendPeriod       = "spend-period"
+	FlagAllowedField      = "allowed-field"
+	FlagNotBefore         = "not-before"
+	FlagNotAfter          = "not-after"
+	FlagRedelegatable     = "redelegatable"
+	FlagParentGranter     = "parent-granter"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -65,7 +73,16 @@ func NewCmdGrantAuthorization(ac address.Codec) *cobra.Command {
 Examples:
  $ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
  $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
-	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
+
+Constraints restricting how the grant may be used can be attached to any authorization type:
+ $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.bank.v1beta1.MsgSend --max-executions=5 \
+	--period-spend-limit=100stake --spend-period=24h --allowed-field=to_address=cosmos1rec.. --from=cosmos1sk..
+
+A grantee holding a redelegatable grant can pass it on to another account with a sub-grant:
+ $ %s tx %s grant cosmos1bot.. generic --msg-type=/cosmos.bank.v1beta1.MsgSend --parent-granter=cosmos1org.. \
+	--expiration=1735689600 --from=cosmos1ops..
+	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName,
+				version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -198,11 +215,45 @@ Examples:
 				return err
 			}
 
-			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expire)
+			granter := clientCtx.GetFromAddress()
+			parentGranter, err := cmd.Flags().GetString(FlagParentGranter)
+			if err != nil {
+				return err
+			}
+			if parentGranter != "" {
+				granter, err = ac.StringToBytes(parentGranter)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg, err := authz.NewMsgGrant(granter, grantee, authorization, expire)
+			if err != nil {
+				return err
+			}
+
+			msg.Grant.Redelegatable, err = cmd.Flags().GetBool(FlagRedelegatable)
 			if err != nil {
 				return err
 			}
 
+			constraints, err := getConstraints(cmd)
+			if err != nil {
+				return err
+			}
+
+			if len(constraints) > 0 {
+				if err := msg.SetConstraints(constraints); err != nil {
+					return err
+				}
+			}
+
+			// a sub-grant is granted on behalf of the parent granter
+			if parentGranter != "" {
+				execMsg := authz.NewMsgExec(clientCtx.GetFromAddress(), []sdk.Msg{msg})
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &execMsg)
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -213,9 +264,86 @@ Examples:
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagAllowList, []string{}, "Allowed addresses grantee is allowed to send funds separated by ,")
 	cmd.Flags().Int64(FlagExpiration, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry. Default is 0.")
+	cmd.Flags().Uint64(FlagMaxExecutions, 0, "Maximum number of times the grant can be executed. Set zero (0) for no limit.")
+	cmd.Flags().String(FlagPeriodSpendLimit, "", "Coins the grantee can spend through the grant, per spend period if one is set")
+	cmd.Flags().Duration(FlagSpendPeriod, 0, "Period after which the period spend limit resets, e.g. 24h. Requires --period-spend-limit.")
+	cmd.Flags().StringArray(FlagAllowedField, []string{}, "Allowed values of a msg field, in the form <field.path>=<value1>,<value2>. Can be repeated.")
+	cmd.Flags().Int64(FlagNotBefore, 0, "Time as Unix timestamp before which the grant can not be used. Set zero (0) to disable.")
+	cmd.Flags().Int64(FlagNotAfter, 0, "Time as Unix timestamp after which the grant can not be used. Set zero (0) to disable.")
+	cmd.Flags().Bool(FlagRedelegatable, false, "Allow the grantee to pass the grant on to other accounts with sub-grants")
+	cmd.Flags().String(FlagParentGranter, "", "Granter of the redelegatable grant held by the signer, to create a sub-grant of it")
 	return cmd
 }
 
+func getConstraints(cmd *cobra.Command) ([]authz.Constraint, error) {
+	var constraints []authz.Constraint
+
+	maxExecutions, err := cmd.Flags().GetUint64(FlagMaxExecutions)
+	if err != nil {
+		return nil, err
+	}
+	if maxExecutions > 0 {
+		constraints = append(constraints, authz.NewMaxExecutionsConstraint(maxExecutions))
+	}
+
+	limit, err := cmd.Flags().GetString(FlagPeriodSpendLimit)
+	if err != nil {
+		return nil, err
+	}
+	period, err := cmd.Flags().GetDuration(FlagSpendPeriod)
+	if err != nil {
+		return nil, err
+	}
+	if limit != "" {
+		spendLimit, err := sdk.ParseCoinsNormalized(limit)
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, &authz.SpendLimitConstraint{SpendLimit: spendLimit, Period: period})
+	} else if period != 0 {
+		return nil, fmt.Errorf("--%s requires --%s", FlagSpendPeriod, FlagPeriodSpendLimit)
+	}
+
+	allowedFields, err := cmd.Flags().GetStringArray(FlagAllowedField)
+	if err != nil {
+		return nil, err
+	}
+	if len(allowedFields) > 0 {
+		allowlist := &authz.FieldAllowlistConstraint{}
+		for _, field := range allowedFields {
+			path, values, ok := strings.Cut(field, "=")
+			if !ok || path == "" || values == "" {
+				return nil, fmt.Errorf("invalid allowed field %q, expected <field.path>=<value1>,<value2>", field)
+			}
+			allowlist.Fields = append(allowlist.Fields, authz.FieldAllowlist{FieldPath: path, AllowedValues: strings.Split(values, ",")})
+		}
+		constraints = append(constraints, allowlist)
+	}
+
+	notBefore, err := cmd.Flags().GetInt64(FlagNotBefore)
+	if err != nil {
+		return nil, err
+	}
+	notAfter, err := cmd.Flags().GetInt64(FlagNotAfter)
+	if err != nil {
+		return nil, err
+	}
+	if notBefore != 0 || notAfter != 0 {
+		window := &authz.TimeWindowConstraint{}
+		if notBefore != 0 {
+			t := time.Unix(notBefore, 0)
+			window.NotBefore = &t
+		}
+		if notAfter != 0 {
+			t := time.Unix(notAfter, 0)
+			window.NotAfter = &t
+		}
+		constraints = append(constraints, window)
+	}
+
+	return constraints, nil
+}
+
 func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
 	exp, err := cmd.Flags().GetInt64(FlagExpiration)
 	if err != nil {
@@ -17,6 +17,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 	cdc.RegisterInterface((*Authorization)(nil), nil)
 	cdc.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization", nil)
+
+	cdc.RegisterInterface((*Constraint)(nil), nil)
+	cdc.RegisterConcrete(&MaxExecutionsConstraint{}, "cosmos-sdk/MaxExecutionsConstraint", nil)
+	cdc.RegisterConcrete(&SpendLimitConstraint{}, "cosmos-sdk/SpendLimitConstraint", nil)
+	cdc.RegisterConcrete(&FieldAllowlistConstraint{}, "cosmos-sdk/FieldAllowlistConstraint", nil)
+	cdc.RegisterConcrete(&TimeWindowConstraint{}, "cosmos-sdk/TimeWindowConstraint", nil)
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -33,5 +39,14 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&GenericAuthorization{},
 	)
 
+	registry.RegisterInterface(
+		"cosmos.authz.v1beta1.Constraint",
+		(*Constraint)(nil),
+		&MaxExecutionsConstraint{},
+		&SpendLimitConstraint{},
+		&FieldAllowlistConstraint{},
+		&TimeWindowConstraint{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, MsgServiceDesc())
 }
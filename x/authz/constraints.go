@@ -0,0 +1,312 @@
+package authz
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// coinFullName is the full proto name of the message spend limits are computed from.
+const coinFullName protoreflect.FullName = "cosmos.base.v1beta1.Coin"
+
+// Constraint represents a restriction on the messages that can be executed with
+// a grant. Constraints apply to any authorization and are checked in addition
+// to it.
+type Constraint interface {
+	proto.Message
+
+	// Accept determines whether the constraint permits the provided message to
+	// be executed, and if so provides an updated constraint instance. The message
+	// is provided through protoreflect so that any of its fields can be inspected.
+	Accept(ctx context.Context, msg protoreflect.Message) (ConstraintAcceptResponse, error)
+
+	// ValidateBasic does a simple validation check that
+	// doesn't require access to any other information.
+	ValidateBasic() error
+}
+
+// ConstraintAcceptResponse instruments the controller of an authz message if the
+// constraint is satisfied and if the grant should be updated or deleted.
+type ConstraintAcceptResponse struct {
+	// If Accept=true, the constraint is satisfied by the message.
+	Accept bool
+	// If Delete=true, the grant must be deleted after executing the message.
+	Delete bool
+	// If Updated != nil, the controller must store the updated constraint in
+	// place of the current one.
+	Updated Constraint
+}
+
+var (
+	_ Constraint = &MaxExecutionsConstraint{}
+	_ Constraint = &SpendLimitConstraint{}
+	_ Constraint = &FieldAllowlistConstraint{}
+	_ Constraint = &TimeWindowConstraint{}
+)
+
+// NewMaxExecutionsConstraint creates a new MaxExecutionsConstraint object.
+func NewMaxExecutionsConstraint(maxExecutions uint64) *MaxExecutionsConstraint {
+	return &MaxExecutionsConstraint{Remaining: maxExecutions}
+}
+
+// Accept implements Constraint.Accept.
+func (c MaxExecutionsConstraint) Accept(_ context.Context, _ protoreflect.Message) (ConstraintAcceptResponse, error) {
+	if c.Remaining == 0 {
+		return ConstraintAcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("no executions remaining")
+	}
+
+	if c.Remaining == 1 {
+		return ConstraintAcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return ConstraintAcceptResponse{Accept: true, Updated: &MaxExecutionsConstraint{Remaining: c.Remaining - 1}}, nil
+}
+
+// ValidateBasic implements Constraint.ValidateBasic.
+func (c MaxExecutionsConstraint) ValidateBasic() error {
+	if c.Remaining == 0 {
+		return errors.New("max executions must be positive")
+	}
+	return nil
+}
+
+// Accept implements Constraint.Accept.
+func (c SpendLimitConstraint) Accept(ctx context.Context, msg protoreflect.Message) (ConstraintAcceptResponse, error) {
+	spent, err := MsgCoins(msg)
+	if err != nil {
+		return ConstraintAcceptResponse{}, err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if c.Period > 0 && !blockTime.Before(c.PeriodReset) {
+		c.PeriodSpent = sdk.NewCoins()
+		c.PeriodReset = c.PeriodReset.Add(c.Period)
+		if blockTime.After(c.PeriodReset) {
+			c.PeriodReset = blockTime.Add(c.Period)
+		}
+	}
+
+	periodSpent := c.PeriodSpent.Add(spent...)
+	if !periodSpent.IsAllLTE(c.SpendLimit) {
+		return ConstraintAcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("spending %s exceeds the spend limit of %s", periodSpent, c.SpendLimit)
+	}
+
+	return ConstraintAcceptResponse{Accept: true, Updated: &SpendLimitConstraint{
+		SpendLimit:  c.SpendLimit,
+		Period:      c.Period,
+		PeriodSpent: periodSpent,
+		PeriodReset: c.PeriodReset,
+	}}, nil
+}
+
+// ValidateBasic implements Constraint.ValidateBasic.
+func (c SpendLimitConstraint) ValidateBasic() error {
+	if len(c.SpendLimit) == 0 {
+		return sdkerrors.ErrInvalidCoins.Wrap("spend limit cannot be empty")
+	}
+	if err := c.SpendLimit.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid spend limit: %s", err)
+	}
+	if err := c.PeriodSpent.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid period spent: %s", err)
+	}
+	if c.Period < 0 {
+		return errors.New("period cannot be negative")
+	}
+	return nil
+}
+
+// Accept implements Constraint.Accept.
+func (c FieldAllowlistConstraint) Accept(_ context.Context, msg protoreflect.Message) (ConstraintAcceptResponse, error) {
+	for _, field := range c.Fields {
+		values, err := FieldValues(msg, field.FieldPath)
+		if err != nil {
+			return ConstraintAcceptResponse{}, err
+		}
+
+		for _, value := range values {
+			if !slices.Contains(field.AllowedValues, value) {
+				return ConstraintAcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("value %q of field %s is not allowed", value, field.FieldPath)
+			}
+		}
+	}
+
+	return ConstraintAcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Constraint.ValidateBasic.
+func (c FieldAllowlistConstraint) ValidateBasic() error {
+	if len(c.Fields) == 0 {
+		return errors.New("field allowlist cannot be empty")
+	}
+
+	seen := make(map[string]struct{}, len(c.Fields))
+	for _, field := range c.Fields {
+		if field.FieldPath == "" {
+			return errors.New("field path cannot be empty")
+		}
+		if _, ok := seen[field.FieldPath]; ok {
+			return fmt.Errorf("duplicate field path %s", field.FieldPath)
+		}
+		seen[field.FieldPath] = struct{}{}
+
+		if len(field.AllowedValues) == 0 {
+			return fmt.Errorf("allowed values of field %s cannot be empty", field.FieldPath)
+		}
+	}
+	return nil
+}
+
+// Accept implements Constraint.Accept.
+func (c TimeWindowConstraint) Accept(ctx context.Context, _ protoreflect.Message) (ConstraintAcceptResponse, error) {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if c.NotBefore != nil && blockTime.Before(*c.NotBefore) {
+		return ConstraintAcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("grant can not be used before %s", c.NotBefore)
+	}
+
+	if c.NotAfter != nil && blockTime.After(*c.NotAfter) {
+		return ConstraintAcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("grant can not be used after %s", c.NotAfter)
+	}
+
+	return ConstraintAcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Constraint.ValidateBasic.
+func (c TimeWindowConstraint) ValidateBasic() error {
+	if c.NotBefore == nil && c.NotAfter == nil {
+		return errors.New("time window must have a start or an end")
+	}
+	if c.NotBefore != nil && c.NotAfter != nil && c.NotAfter.Before(*c.NotBefore) {
+		return fmt.Errorf("time window end %s is before its start %s", c.NotAfter, c.NotBefore)
+	}
+	return nil
+}
+
+// MsgCoins returns the sum of all the cosmos.base.v1beta1.Coin values found in
+// the fields of msg, including nested messages.
+func MsgCoins(msg protoreflect.Message) (sdk.Coins, error) {
+	coins := sdk.NewCoins()
+	if err := collectCoins(msg, &coins); err != nil {
+		return nil, err
+	}
+	return coins, nil
+}
+
+func collectCoins(msg protoreflect.Message, coins *sdk.Coins) error {
+	desc := msg.Descriptor()
+	if desc.FullName() == coinFullName {
+		amountStr := msg.Get(desc.Fields().ByName("amount")).String()
+		if amountStr == "" {
+			return nil
+		}
+
+		amount, ok := math.NewIntFromString(amountStr)
+		if !ok {
+			return sdkerrors.ErrInvalidCoins.Wrap("invalid coin amount")
+		}
+
+		coin := sdk.Coin{Denom: msg.Get(desc.Fields().ByName("denom")).String(), Amount: amount}
+		if err := coin.Validate(); err != nil {
+			return sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+		}
+
+		*coins = coins.Add(coin)
+		return nil
+	}
+
+	var err error
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind || fd.IsMap() {
+			return true
+		}
+
+		if fd.IsList() {
+			list := v.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = collectCoins(list.Get(i).Message(), coins)
+			}
+		} else {
+			err = collectCoins(v.Message(), coins)
+		}
+		return err == nil
+	})
+	return err
+}
+
+// FieldValues returns the string representations of the values found at the dot
+// separated fieldPath of msg. All elements of repeated fields along the path are
+// returned.
+func FieldValues(msg protoreflect.Message, fieldPath string) ([]string, error) {
+	return fieldValues(msg, strings.Split(fieldPath, "."), fieldPath)
+}
+
+func fieldValues(msg protoreflect.Message, path []string, fieldPath string) ([]string, error) {
+	fd := msg.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+	if fd == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("field %s not found in %s", fieldPath, msg.Descriptor().FullName())
+	}
+
+	v := msg.Get(fd)
+	var elems []protoreflect.Value
+	if fd.IsList() {
+		for i := 0; i < v.List().Len(); i++ {
+			elems = append(elems, v.List().Get(i))
+		}
+	} else {
+		elems = []protoreflect.Value{v}
+	}
+
+	var values []string
+	for _, elem := range elems {
+		if len(path) > 1 {
+			if fd.Kind() != protoreflect.MessageKind || fd.IsMap() {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf("field path %s does not resolve to a message field", fieldPath)
+			}
+
+			nested, err := fieldValues(elem.Message(), path[1:], fieldPath)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, nested...)
+			continue
+		}
+
+		value, err := fieldValueString(fd, elem)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("field path %s: %s", fieldPath, err)
+		}
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+func fieldValueString(fd protoreflect.FieldDescriptor, v protoreflect.Value) (string, error) {
+	switch {
+	case fd.IsMap():
+		return "", errors.New("map fields are not supported")
+	case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+		return "", errors.New("field path must end at a scalar field")
+	case fd.Kind() == protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name()), nil
+		}
+		return strconv.Itoa(int(v.Enum())), nil
+	case fd.Kind() == protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(v.Bytes()), nil
+	default:
+		return v.String(), nil
+	}
+}
@@ -0,0 +1,159 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+func newSendMsg(to string, coins ...*basev1beta1.Coin) *bankv1beta1.MsgSend {
+	return &bankv1beta1.MsgSend{FromAddress: "granter", ToAddress: to, Amount: coins}
+}
+
+func TestMaxExecutionsConstraint(t *testing.T) {
+	testCtx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey(authz.ModuleName), storetypes.NewTransientStoreKey("transient_test"))
+	msg := newSendMsg("recipient").ProtoReflect()
+
+	require.Error(t, authz.NewMaxExecutionsConstraint(0).ValidateBasic())
+
+	c := authz.NewMaxExecutionsConstraint(2)
+	require.NoError(t, c.ValidateBasic())
+
+	resp, err := c.Accept(testCtx.Ctx, msg)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, authz.NewMaxExecutionsConstraint(1), resp.Updated)
+
+	resp, err = resp.Updated.Accept(testCtx.Ctx, msg)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+}
+
+func TestSpendLimitConstraint(t *testing.T) {
+	testCtx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey(authz.ModuleName), storetypes.NewTransientStoreKey("transient_test"))
+	now := time.Unix(1_000_000, 0).UTC()
+	ctx := testCtx.Ctx.WithBlockTime(now)
+
+	limit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.Error(t, (&authz.SpendLimitConstraint{}).ValidateBasic())
+	require.Error(t, (&authz.SpendLimitConstraint{SpendLimit: limit, Period: -time.Hour}).ValidateBasic())
+
+	c := &authz.SpendLimitConstraint{SpendLimit: limit, Period: time.Hour, PeriodReset: now.Add(time.Hour)}
+	require.NoError(t, c.ValidateBasic())
+
+	resp, err := c.Accept(ctx, newSendMsg("recipient", &basev1beta1.Coin{Denom: "stake", Amount: "60"}).ProtoReflect())
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	updated := resp.Updated.(*authz.SpendLimitConstraint)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), updated.PeriodSpent)
+
+	// spending over the limit within the same period fails
+	_, err = updated.Accept(ctx, newSendMsg("recipient", &basev1beta1.Coin{Denom: "stake", Amount: "50"}).ProtoReflect())
+	require.ErrorContains(t, err, "exceeds the spend limit")
+
+	// other denoms are not allowed at all
+	_, err = updated.Accept(ctx, newSendMsg("recipient", &basev1beta1.Coin{Denom: "atom", Amount: "1"}).ProtoReflect())
+	require.ErrorContains(t, err, "exceeds the spend limit")
+
+	// the spent amount resets once the period is over
+	resp, err = updated.Accept(ctx.WithBlockTime(now.Add(time.Hour)), newSendMsg("recipient", &basev1beta1.Coin{Denom: "stake", Amount: "50"}).ProtoReflect())
+	require.NoError(t, err)
+	updated = resp.Updated.(*authz.SpendLimitConstraint)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), updated.PeriodSpent)
+	require.Equal(t, now.Add(2*time.Hour), updated.PeriodReset)
+
+	// without a period the limit applies to the lifetime of the grant
+	lifetime := &authz.SpendLimitConstraint{SpendLimit: limit, PeriodSpent: limit}
+	_, err = lifetime.Accept(ctx.WithBlockTime(now.AddDate(1, 0, 0)), newSendMsg("recipient", &basev1beta1.Coin{Denom: "stake", Amount: "1"}).ProtoReflect())
+	require.ErrorContains(t, err, "exceeds the spend limit")
+}
+
+func TestFieldAllowlistConstraint(t *testing.T) {
+	testCtx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey(authz.ModuleName), storetypes.NewTransientStoreKey("transient_test"))
+
+	require.Error(t, (&authz.FieldAllowlistConstraint{}).ValidateBasic())
+	require.Error(t, (&authz.FieldAllowlistConstraint{Fields: []authz.FieldAllowlist{{FieldPath: "to_address"}}}).ValidateBasic())
+	require.Error(t, (&authz.FieldAllowlistConstraint{Fields: []authz.FieldAllowlist{
+		{FieldPath: "to_address", AllowedValues: []string{"a"}},
+		{FieldPath: "to_address", AllowedValues: []string{"b"}},
+	}}).ValidateBasic())
+
+	c := &authz.FieldAllowlistConstraint{Fields: []authz.FieldAllowlist{
+		{FieldPath: "to_address", AllowedValues: []string{"alice", "bob"}},
+		{FieldPath: "amount.denom", AllowedValues: []string{"stake"}},
+	}}
+	require.NoError(t, c.ValidateBasic())
+
+	testCases := []struct {
+		name   string
+		msg    *bankv1beta1.MsgSend
+		errMsg string
+	}{
+		{"allowed", newSendMsg("bob", &basev1beta1.Coin{Denom: "stake", Amount: "1"}), ""},
+		{"recipient not allowed", newSendMsg("eve", &basev1beta1.Coin{Denom: "stake", Amount: "1"}), "value \"eve\" of field to_address is not allowed"},
+		{"one of the denoms not allowed", newSendMsg("alice", &basev1beta1.Coin{Denom: "stake", Amount: "1"}, &basev1beta1.Coin{Denom: "atom", Amount: "1"}), "value \"atom\" of field amount.denom is not allowed"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := c.Accept(testCtx.Ctx, tc.msg.ProtoReflect())
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.Nil(t, resp.Updated)
+		})
+	}
+
+	unknown := &authz.FieldAllowlistConstraint{Fields: []authz.FieldAllowlist{{FieldPath: "amount.unknown", AllowedValues: []string{"x"}}}}
+	_, err := unknown.Accept(testCtx.Ctx, newSendMsg("bob", &basev1beta1.Coin{Denom: "stake", Amount: "1"}).ProtoReflect())
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestTimeWindowConstraint(t *testing.T) {
+	testCtx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey(authz.ModuleName), storetypes.NewTransientStoreKey("transient_test"))
+	now := time.Unix(1_000_000, 0).UTC()
+	before, after := now.Add(-time.Hour), now.Add(time.Hour)
+	msg := newSendMsg("recipient").ProtoReflect()
+
+	require.Error(t, (&authz.TimeWindowConstraint{}).ValidateBasic())
+	require.Error(t, (&authz.TimeWindowConstraint{NotBefore: &after, NotAfter: &before}).ValidateBasic())
+
+	c := &authz.TimeWindowConstraint{NotBefore: &before, NotAfter: &after}
+	require.NoError(t, c.ValidateBasic())
+
+	resp, err := c.Accept(testCtx.Ctx.WithBlockTime(now), msg)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+
+	_, err = c.Accept(testCtx.Ctx.WithBlockTime(before.Add(-time.Second)), msg)
+	require.ErrorContains(t, err, "can not be used before")
+
+	_, err = c.Accept(testCtx.Ctx.WithBlockTime(after.Add(time.Second)), msg)
+	require.ErrorContains(t, err, "can not be used after")
+}
+
+func TestMsgCoins(t *testing.T) {
+	coins, err := authz.MsgCoins(newSendMsg("recipient",
+		&basev1beta1.Coin{Denom: "stake", Amount: "10"},
+		&basev1beta1.Coin{Denom: "atom", Amount: "5"},
+	).ProtoReflect())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 10)), coins)
+
+	_, err = authz.MsgCoins(newSendMsg("recipient", &basev1beta1.Coin{Denom: "stake", Amount: "ten"}).ProtoReflect())
+	require.Error(t, err)
+}
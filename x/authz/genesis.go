@@ -48,5 +48,14 @@ func (data GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (msg GrantAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 	var a Authorization
-	return unpacker.UnpackAny(msg.Authorization, &a)
+	if err := unpacker.UnpackAny(msg.Authorization, &a); err != nil {
+		return err
+	}
+
+	return unpackConstraints(unpacker, msg.Constraints)
+}
+
+// GetConstraints returns the cached values from the GrantAuthorization.Constraints.
+func (msg GrantAuthorization) GetConstraints() ([]Constraint, error) {
+	return unpackCachedConstraints(msg.Constraints)
 }
@@ -28,7 +28,24 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *authz.GenesisState) {
 			panic("expected authorization")
 		}
 
-		err = k.SaveGrant(ctx, grantee, granter, a, entry.Expiration)
+		grant, err := authz.NewGrant(now, a, entry.Expiration)
+		if err != nil {
+			panic(err)
+		}
+
+		constraints, err := entry.GetConstraints()
+		if err != nil {
+			panic(err)
+		}
+
+		if err := grant.SetConstraints(constraints); err != nil {
+			panic(err)
+		}
+
+		grant.Redelegatable = entry.Redelegatable
+		grant.ParentGrantee = entry.ParentGrantee
+
+		err = k.saveGrant(ctx, grantee, granter, grant)
 		if err != nil {
 			panic(err)
 		}
@@ -44,6 +61,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *authz.GenesisState {
 			Grantee:       grantee.String(),
 			Expiration:    grant.Expiration,
 			Authorization: grant.Authorization,
+			Constraints:   grant.Constraints,
+			Redelegatable: grant.Redelegatable,
+			ParentGrantee: grant.ParentGrantee,
 		})
 		return false
 	})
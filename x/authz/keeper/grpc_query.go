@@ -54,6 +54,9 @@ func (k Keeper) Grants(ctx context.Context, req *authz.QueryGrantsRequest) (*aut
 			Grants: []*authz.Grant{{
 				Authorization: authorizationAny,
 				Expiration:    grant.Expiration,
+				Constraints:   grant.Constraints,
+				Redelegatable: grant.Redelegatable,
+				ParentGrantee: grant.ParentGrantee,
 			}},
 		}, nil
 	}
@@ -75,6 +78,9 @@ func (k Keeper) Grants(ctx context.Context, req *authz.QueryGrantsRequest) (*aut
 		return &authz.Grant{
 			Authorization: authorizationAny,
 			Expiration:    auth.Expiration,
+			Constraints:   auth.Constraints,
+			Redelegatable: auth.Redelegatable,
+			ParentGrantee: auth.ParentGrantee,
 		}, nil
 	}, func() *authz.Grant {
 		return &authz.Grant{}
@@ -120,6 +126,9 @@ func (k Keeper) GranterGrants(ctx context.Context, req *authz.QueryGranterGrants
 			Grantee:       grantee.String(),
 			Authorization: any,
 			Expiration:    auth.Expiration,
+			Constraints:   auth.Constraints,
+			Redelegatable: auth.Redelegatable,
+			ParentGrantee: auth.ParentGrantee,
 		}, nil
 	}, func() *authz.Grant {
 		return &authz.Grant{}
@@ -166,6 +175,9 @@ func (k Keeper) GranteeGrants(ctx context.Context, req *authz.QueryGranteeGrants
 		return &authz.GrantAuthorization{
 			Authorization: authorizationAny,
 			Expiration:    auth.Expiration,
+			Constraints:   auth.Constraints,
+			Redelegatable: auth.Redelegatable,
+			ParentGrantee: auth.ParentGrantee,
 			Granter:       granter.String(),
 			Grantee:       req.Grantee,
 		}, nil
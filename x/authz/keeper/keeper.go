@@ -10,6 +10,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	corestoretypes "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
@@ -78,23 +79,38 @@ func (k Keeper) getGrant(ctx context.Context, skey []byte) (grant authz.Grant, f
 }
 
 func (k Keeper) update(ctx context.Context, grantee, granter sdk.AccAddress, updated authz.Authorization) error {
-	skey := grantStoreKey(grantee, granter, updated.MsgTypeURL())
+	return k.updateGrant(ctx, grantee, granter, updated.MsgTypeURL(), updated, nil)
+}
+
+// updateGrant replaces the authorization and the constraints of a grant. A nil
+// authorization or constraints slice leaves the stored value unchanged.
+func (k Keeper) updateGrant(ctx context.Context, grantee, granter sdk.AccAddress, msgType string, updated authz.Authorization, constraints []authz.Constraint) error {
+	skey := grantStoreKey(grantee, granter, msgType)
 	grant, found := k.getGrant(ctx, skey)
 	if !found {
 		return authz.ErrNoAuthorizationFound
 	}
 
-	msg, ok := updated.(proto.Message)
-	if !ok {
-		return sdkerrors.ErrPackAny.Wrapf("cannot proto marshal %T", updated)
+	if updated != nil {
+		msg, ok := updated.(proto.Message)
+		if !ok {
+			return sdkerrors.ErrPackAny.Wrapf("cannot proto marshal %T", updated)
+		}
+
+		cdcAny, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return err
+		}
+
+		grant.Authorization = cdcAny
 	}
 
-	cdcAny, err := codectypes.NewAnyWithValue(msg)
-	if err != nil {
-		return err
+	if constraints != nil {
+		if err := grant.SetConstraints(constraints); err != nil {
+			return err
+		}
 	}
 
-	grant.Authorization = cdcAny
 	store := k.storeService.OpenKVStore(ctx)
 
 	return store.Set(skey, k.cdc.MustMarshal(&grant))
@@ -105,10 +121,9 @@ func (k Keeper) update(ctx context.Context, grantee, granter sdk.AccAddress, upd
 func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
 	results := make([][]byte, len(msgs))
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	now := sdkCtx.BlockTime()
 
 	for i, msg := range msgs {
-		signers, _, err := k.cdc.GetMsgV1Signers(msg)
+		signers, msgV2, err := k.cdc.GetMsgV1Signers(msg)
 		if err != nil {
 			return nil, err
 		}
@@ -126,36 +141,22 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 
 			grant, found := k.getGrant(ctx, skey)
 			if !found {
+				// A grantee holding a redelegatable grant can pass it on by
+				// granting it on behalf of the granter.
+				if msgGrant, ok := msg.(*authz.MsgGrant); ok {
+					if results[i], err = k.subGrant(sdkCtx, grantee, msgGrant); err != nil {
+						return nil, err
+					}
+					continue
+				}
+
 				return nil, errorsmod.Wrapf(authz.ErrNoAuthorizationFound,
 					"failed to get grant with given granter: %s, grantee: %s & msgType: %s ", sdk.AccAddress(granter), grantee, sdk.MsgTypeURL(msg))
 			}
 
-			if grant.Expiration != nil && grant.Expiration.Before(now) {
-				return nil, authz.ErrAuthorizationExpired
-			}
-
-			authorization, err := grant.GetAuthorization()
-			if err != nil {
-				return nil, err
-			}
-
-			resp, err := authorization.Accept(sdkCtx, msg)
-			if err != nil {
-				return nil, err
-			}
-
-			if resp.Delete {
-				err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
-			} else if resp.Updated != nil {
-				err = k.update(ctx, grantee, granter, resp.Updated)
-			}
-			if err != nil {
+			if err := k.acceptGrant(sdkCtx, grantee, granter, grant, msg, msgV2.ProtoReflect()); err != nil {
 				return nil, err
 			}
-
-			if !resp.Accept {
-				return nil, sdkerrors.ErrUnauthorized
-			}
 		}
 
 		handler := k.router.Handler(msg)
@@ -186,23 +187,137 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 	return results, nil
 }
 
+// acceptGrant checks that the grant allows the grantee to execute msg on behalf
+// of the granter and updates or deletes it accordingly. Sub-grants are checked
+// up to the grant given by the granter, so that every grant of the chain
+// accepts the message.
+func (k Keeper) acceptGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, grant authz.Grant, msg sdk.Msg, msgV2 protoreflect.Message) error {
+	if grant.Expiration != nil && grant.Expiration.Before(ctx.BlockTime()) {
+		return authz.ErrAuthorizationExpired
+	}
+
+	authorization, err := grant.GetAuthorization()
+	if err != nil {
+		return err
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	constraints, deleteGrant, err := k.acceptConstraints(ctx, grant, msgV2)
+	if err != nil {
+		return err
+	}
+
+	msgType := sdk.MsgTypeURL(msg)
+	if resp.Delete || deleteGrant {
+		err = k.DeleteGrant(ctx, grantee, granter, msgType)
+	} else if resp.Updated != nil || constraints != nil {
+		err = k.updateGrant(ctx, grantee, granter, msgType, resp.Updated, constraints)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !resp.Accept {
+		return sdkerrors.ErrUnauthorized
+	}
+
+	if grant.ParentGrantee == "" {
+		return nil
+	}
+
+	parentGrantee, parent, err := k.getParentGrant(ctx, granter, grant, msgType)
+	if err != nil {
+		return err
+	}
+
+	return k.acceptGrant(ctx, parentGrantee, granter, parent, msg, msgV2)
+}
+
+// acceptConstraints checks the constraints of a grant against the message executed
+// with it. It returns the updated constraints if any of them changed, and whether
+// the grant must be deleted after executing the message.
+func (k Keeper) acceptConstraints(ctx context.Context, grant authz.Grant, msg protoreflect.Message) ([]authz.Constraint, bool, error) {
+	constraints, err := grant.GetConstraints()
+	if err != nil {
+		return nil, false, err
+	}
+
+	updated := false
+	deleteGrant := false
+	for i, constraint := range constraints {
+		resp, err := constraint.Accept(ctx, msg)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if !resp.Accept {
+			return nil, false, sdkerrors.ErrUnauthorized.Wrapf("constraint %s not satisfied", proto.MessageName(constraint))
+		}
+
+		deleteGrant = deleteGrant || resp.Delete
+		if resp.Updated != nil {
+			constraints[i] = resp.Updated
+			updated = true
+		}
+	}
+
+	if !updated {
+		return nil, deleteGrant, nil
+	}
+
+	return constraints, deleteGrant, nil
+}
+
 // SaveGrant method grants the provided authorization to the grantee on the granter's account
 // with the provided expiration time and insert authorization key into the grants queue. If there is an existing authorization grant for the
 // same `sdk.Msg` type, this grant overwrites that.
 func (k Keeper) SaveGrant(ctx context.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	msgType := authorization.MsgTypeURL()
-	store := k.storeService.OpenKVStore(ctx)
-	skey := grantStoreKey(grantee, granter, msgType)
+	return k.SaveGrantWithConstraints(ctx, grantee, granter, authorization, expiration, nil)
+}
 
+// SaveGrantWithConstraints behaves like SaveGrant, and additionally restricts the
+// messages that can be executed with the grant by the provided constraints.
+func (k Keeper) SaveGrantWithConstraints(ctx context.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time, constraints []authz.Constraint) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	grant, err := authz.NewGrant(sdkCtx.BlockTime(), authorization, expiration)
 	if err != nil {
 		return err
 	}
 
+	if err := grant.SetConstraints(constraints); err != nil {
+		return err
+	}
+
+	return k.saveGrant(ctx, grantee, granter, grant)
+}
+
+// saveGrant stores the grant, keeping the grant queue and the sub-grant index
+// in sync with it.
+func (k Keeper) saveGrant(ctx context.Context, grantee, granter sdk.AccAddress, grant authz.Grant) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	authorization, err := grant.GetAuthorization()
+	if err != nil {
+		return err
+	}
+
+	msgType := authorization.MsgTypeURL()
+	store := k.storeService.OpenKVStore(ctx)
+	skey := grantStoreKey(grantee, granter, msgType)
+	expiration := grant.Expiration
+
 	var oldExp *time.Time
 	if oldGrant, found := k.getGrant(ctx, skey); found {
 		oldExp = oldGrant.Expiration
+
+		if oldGrant.ParentGrantee != "" && oldGrant.ParentGrantee != grant.ParentGrantee {
+			if err := k.deleteSubGrantIndex(ctx, grantee, granter, oldGrant, msgType); err != nil {
+				return err
+			}
+		}
 	}
 
 	if oldExp != nil && (expiration == nil || !oldExp.Equal(*expiration)) {
@@ -218,6 +333,17 @@ func (k Keeper) SaveGrant(ctx context.Context, grantee, granter sdk.AccAddress,
 		}
 	}
 
+	if grant.ParentGrantee != "" {
+		parentGrantee, err := k.authKeeper.AddressCodec().StringToBytes(grant.ParentGrantee)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid parent grantee address: %s", err)
+		}
+
+		if err := store.Set(subGrantKey(granter, parentGrantee, grantee, msgType), []byte{}); err != nil {
+			return err
+		}
+	}
+
 	bz, err := k.cdc.Marshal(&grant)
 	if err != nil {
 		return err
@@ -229,7 +355,7 @@ func (k Keeper) SaveGrant(ctx context.Context, grantee, granter sdk.AccAddress,
 	}
 
 	return sdkCtx.EventManager().EmitTypedEvent(&authz.EventGrant{
-		MsgTypeUrl: authorization.MsgTypeURL(),
+		MsgTypeUrl: msgType,
 		Granter:    granter.String(),
 		Grantee:    grantee.String(),
 	})
@@ -257,6 +383,14 @@ func (k Keeper) DeleteGrant(ctx context.Context, grantee, granter sdk.AccAddress
 		return err
 	}
 
+	if err := k.deleteSubGrantIndex(ctx, grantee, granter, grant, msgType); err != nil {
+		return err
+	}
+
+	if err := k.revokeSubGrants(ctx, granter, grantee, msgType); err != nil {
+		return err
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	return sdkCtx.EventManager().EmitTypedEvent(&authz.EventRevoke{
 		MsgTypeUrl: msgType,
@@ -413,6 +547,8 @@ func (k Keeper) removeFromGrantQueue(ctx context.Context, grantKey []byte, grant
 }
 
 // DequeueAndDeleteExpiredGrants deletes expired grants from the state and grant queue.
+// The sub-grants derived from the deleted grants are scheduled for deletion
+// in the next block.
 func (k Keeper) DequeueAndDeleteExpiredGrants(ctx context.Context) error {
 	store := k.storeService.OpenKVStore(ctx)
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -421,29 +557,56 @@ func (k Keeper) DequeueAndDeleteExpiredGrants(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	defer iterator.Close()
 
+	var (
+		queueKeys  [][]byte
+		queueItems []authz.GrantQueueItem
+	)
 	for ; iterator.Valid(); iterator.Next() {
 		var queueItem authz.GrantQueueItem
 		if err := k.cdc.Unmarshal(iterator.Value(), &queueItem); err != nil {
+			iterator.Close()
 			return err
 		}
 
-		_, granter, grantee, err := parseGrantQueueKey(iterator.Key())
+		queueKeys = append(queueKeys, bytes.Clone(iterator.Key()))
+		queueItems = append(queueItems, queueItem)
+	}
+
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for i, key := range queueKeys {
+		_, granter, grantee, err := parseGrantQueueKey(key)
 		if err != nil {
 			return err
 		}
 
-		err = store.Delete(iterator.Key())
+		err = store.Delete(key)
 		if err != nil {
 			return err
 		}
 
-		for _, typeURL := range queueItem.MsgTypeUrls {
-			err = store.Delete(grantStoreKey(grantee, granter, typeURL))
+		for _, typeURL := range queueItems[i].MsgTypeUrls {
+			skey := grantStoreKey(grantee, granter, typeURL)
+			grant, found := k.getGrant(ctx, skey)
+			if !found {
+				continue
+			}
+
+			err = store.Delete(skey)
 			if err != nil {
 				return err
 			}
+
+			if err := k.deleteSubGrantIndex(ctx, grantee, granter, grant, typeURL); err != nil {
+				return err
+			}
+
+			if err := k.revokeSubGrants(ctx, granter, grantee, typeURL); err != nil {
+				return err
+			}
 		}
 	}
 
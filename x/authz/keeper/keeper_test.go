@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
@@ -306,6 +308,147 @@ func (s *TestSuite) TestDispatchAction() {
 	}
 }
 
+func (s *TestSuite) TestDispatchActionWithConstraints() {
+	require := s.Require()
+	granterAddr := s.addrs[0]
+	granteeAddr := s.addrs[1]
+	recipientAddr := s.addrs[2]
+	now := s.ctx.BlockTime()
+	expiration := now.AddDate(0, 1, 0)
+	a := banktypes.NewSendAuthorization(coins1000, nil)
+
+	send := func(to sdk.AccAddress, amount sdk.Coins) []sdk.Msg {
+		return []sdk.Msg{&banktypes.MsgSend{
+			Amount:      amount,
+			FromAddress: granterAddr.String(),
+			ToAddress:   to.String(),
+		}}
+	}
+
+	constraints := []authz.Constraint{
+		authz.NewMaxExecutionsConstraint(2),
+		&authz.SpendLimitConstraint{SpendLimit: coins100, Period: time.Hour, PeriodReset: now.Add(time.Hour)},
+		&authz.FieldAllowlistConstraint{Fields: []authz.FieldAllowlist{
+			{FieldPath: "to_address", AllowedValues: []string{recipientAddr.String()}},
+		}},
+	}
+	require.NoError(s.authzKeeper.SaveGrantWithConstraints(s.ctx, granteeAddr, granterAddr, a, &expiration, constraints))
+
+	s.T().Log("verify a message not matching the field allowlist is rejected")
+	_, err := s.authzKeeper.DispatchActions(s.ctx, granteeAddr, send(s.addrs[3], coins10))
+	require.ErrorContains(err, "is not allowed")
+
+	s.T().Log("verify the spend limit constraint is enforced on top of the authorization")
+	_, err = s.authzKeeper.DispatchActions(s.ctx, granteeAddr, send(recipientAddr, coins100.Add(coins10...)))
+	require.ErrorContains(err, "exceeds the spend limit")
+
+	s.T().Log("verify a valid message updates the constraints")
+	_, err = s.authzKeeper.DispatchActions(s.ctx, granteeAddr, send(recipientAddr, coins10))
+	require.NoError(err)
+
+	authorization, _ := s.authzKeeper.GetAuthorization(s.ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	require.Equal(coins1000.Sub(coins10...), authorization.(*banktypes.SendAuthorization).SpendLimit)
+
+	res, err := s.queryClient.GranterGrants(s.ctx, &authz.QueryGranterGrantsRequest{Granter: granterAddr.String()})
+	require.NoError(err)
+	require.Len(res.Grants, 1)
+	require.NoError(res.Grants[0].UnpackInterfaces(s.encCfg.InterfaceRegistry))
+	stored, err := res.Grants[0].GetConstraints()
+	require.NoError(err)
+	require.Len(stored, 3)
+	require.Equal(authz.NewMaxExecutionsConstraint(1), stored[0])
+	require.Equal(coins10, stored[1].(*authz.SpendLimitConstraint).PeriodSpent)
+
+	s.T().Log("verify the grant is removed after the last allowed execution")
+	_, err = s.authzKeeper.DispatchActions(s.ctx, granteeAddr, send(recipientAddr, coins10))
+	require.NoError(err)
+
+	grants, err := s.authzKeeper.GetAuthorizations(s.ctx, granteeAddr, granterAddr)
+	require.NoError(err)
+	require.Len(grants, 0)
+}
+
+func (s *TestSuite) TestSubGrants() {
+	require := s.Require()
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+		return authtypes.NewBaseAccountWithAddress(addr)
+	}).AnyTimes()
+
+	granterAddr, opsAddr, botAddr, otherAddr, recipientAddr := s.addrs[0], s.addrs[1], s.addrs[2], s.addrs[3], s.addrs[4]
+	ctx := s.ctx
+	now := ctx.BlockTime()
+	oneMonth, oneDay := now.AddDate(0, 1, 0), now.AddDate(0, 0, 1)
+
+	grant := func(granter, grantee sdk.AccAddress, limit sdk.Coins, expiration *time.Time, redelegatable bool) *authz.MsgGrant {
+		msg, err := authz.NewMsgGrant(granter, grantee, banktypes.NewSendAuthorization(limit, nil), expiration)
+		require.NoError(err)
+		msg.Grant.Redelegatable = redelegatable
+		return msg
+	}
+	send := func(amount sdk.Coins) []sdk.Msg {
+		return []sdk.Msg{&banktypes.MsgSend{FromAddress: granterAddr.String(), ToAddress: recipientAddr.String(), Amount: amount}}
+	}
+
+	_, err := s.msgSrvr.Grant(ctx, grant(granterAddr, opsAddr, coins100, &oneMonth, true))
+	require.NoError(err)
+	_, err = s.msgSrvr.Grant(ctx, grant(granterAddr, otherAddr, coins100, &oneMonth, false))
+	require.NoError(err)
+
+	s.T().Log("verify a grant which is not redelegatable can't be passed on")
+	_, err = s.authzKeeper.DispatchActions(ctx, otherAddr, []sdk.Msg{grant(granterAddr, botAddr, coins10, &oneDay, false)})
+	require.ErrorContains(err, "is not redelegatable")
+
+	s.T().Log("verify a sub-grant can't outlive its parent")
+	_, err = s.authzKeeper.DispatchActions(ctx, opsAddr, []sdk.Msg{grant(granterAddr, botAddr, coins10, nil, false)})
+	require.ErrorContains(err, "must expire no later than its parent grant")
+
+	s.T().Log("verify a sub-grant can't be given to the granter")
+	_, err = s.authzKeeper.DispatchActions(ctx, opsAddr, []sdk.Msg{grant(granterAddr, granterAddr, coins10, &oneDay, false)})
+	require.ErrorIs(err, authz.ErrGranteeIsGranter)
+
+	s.T().Log("verify a sub-grant can't replace a grant given by the granter")
+	_, err = s.authzKeeper.DispatchActions(ctx, opsAddr, []sdk.Msg{grant(granterAddr, otherAddr, coins10, &oneDay, false)})
+	require.ErrorContains(err, "is not derived from the grant of")
+
+	s.T().Log("verify a redelegatable grant can be passed on")
+	limit := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	_, err = s.authzKeeper.DispatchActions(ctx, opsAddr, []sdk.Msg{grant(granterAddr, botAddr, limit, &oneDay, false)})
+	require.NoError(err)
+
+	res, err := s.queryClient.Grants(ctx, &authz.QueryGrantsRequest{Granter: granterAddr.String(), Grantee: botAddr.String()})
+	require.NoError(err)
+	require.Len(res.Grants, 1)
+	require.Equal(opsAddr.String(), res.Grants[0].ParentGrantee)
+
+	s.T().Log("verify executing a sub-grant is accepted by every grant of the chain")
+	_, err = s.authzKeeper.DispatchActions(ctx, botAddr, send(coins10))
+	require.NoError(err)
+
+	authorization, _ := s.authzKeeper.GetAuthorization(ctx, botAddr, granterAddr, bankSendAuthMsgType)
+	require.Equal(limit.Sub(coins10...), authorization.(*banktypes.SendAuthorization).SpendLimit)
+	authorization, _ = s.authzKeeper.GetAuthorization(ctx, opsAddr, granterAddr, bankSendAuthMsgType)
+	require.Equal(coins100.Sub(coins10...), authorization.(*banktypes.SendAuthorization).SpendLimit)
+
+	_, err = s.authzKeeper.DispatchActions(ctx, botAddr, send(limit))
+	require.ErrorContains(err, "requested amount is more than spend limit")
+
+	s.T().Log("verify sub-grants are revoked with their parent")
+	_, err = s.msgSrvr.Revoke(ctx, &authz.MsgRevoke{Granter: granterAddr.String(), Grantee: opsAddr.String(), MsgTypeUrl: bankSendAuthMsgType})
+	require.NoError(err)
+
+	_, err = s.authzKeeper.DispatchActions(ctx, botAddr, send(coins10))
+	require.ErrorContains(err, "was revoked")
+
+	_, expiration := s.authzKeeper.GetAuthorization(ctx, botAddr, granterAddr, bankSendAuthMsgType)
+	require.Equal(now, *expiration)
+
+	ctx = ctx.WithBlockTime(now.Add(time.Second))
+	require.NoError(s.authzKeeper.DequeueAndDeleteExpiredGrants(ctx))
+	grants, err := s.authzKeeper.GetAuthorizations(ctx, botAddr, granterAddr)
+	require.NoError(err)
+	require.Len(grants, 0)
+}
+
 // Tests that all msg events included in an authz MsgExec tx
 // Ref: https://github.com/cosmos/cosmos-sdk/issues/9501
 func (s *TestSuite) TestDispatchedEvents() {
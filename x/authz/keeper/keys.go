@@ -15,9 +15,11 @@ import (
 //
 // - 0x01<grant_Bytes>: Grant
 // - 0x02<grant_expiration_Bytes>: GrantQueueItem
+// - 0x03<sub_grant_Bytes>: []byte{}
 var (
 	GrantKey         = []byte{0x01} // prefix for each key
 	GrantQueuePrefix = []byte{0x02}
+	SubGrantPrefix   = []byte{0x03}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -53,6 +55,38 @@ func parseGrantStoreKey(key []byte) (granterAddr, granteeAddr sdk.AccAddress, ms
 	return granterAddr, granteeAddr, conv.UnsafeBytesToStr(key[(granteeAddrEndIndex + 1):])
 }
 
+// subGrantKey - return the key indexing a sub-grant under the grant it was derived from
+// Key format is:
+//
+//	0x03<granterAddressLen (1 Byte)><granterAddress_Bytes><parentGranteeAddressLen (1 Byte)><parentGranteeAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgType_Bytes>
+func subGrantKey(granter, parentGrantee, grantee sdk.AccAddress, msgType string) []byte {
+	m := conv.UnsafeStrToBytes(msgType)
+	granter = address.MustLengthPrefix(granter)
+	parentGrantee = address.MustLengthPrefix(parentGrantee)
+	grantee = address.MustLengthPrefix(grantee)
+
+	return sdk.AppendLengthPrefixedBytes(SubGrantPrefix, granter, parentGrantee, grantee, m)
+}
+
+// subGrantsPrefix - return the prefix of the sub-grants derived from the grants of parentGrantee
+// on the granter's account
+func subGrantsPrefix(granter, parentGrantee sdk.AccAddress) []byte {
+	granter = address.MustLengthPrefix(granter)
+	parentGrantee = address.MustLengthPrefix(parentGrantee)
+
+	return sdk.AppendLengthPrefixedBytes(SubGrantPrefix, granter, parentGrantee)
+}
+
+// parseSubGrantKey splits the grantee address and the msg type from a sub-grant key,
+// given the prefix length of the granter and parent grantee
+func parseSubGrantKey(key []byte, prefixLen int) (granteeAddr sdk.AccAddress, msgType string) {
+	granteeAddrLen, granteeAddrLenEndIndex := sdk.ParseLengthPrefixedBytes(key, prefixLen, 1)
+	granteeAddr, granteeAddrEndIndex := sdk.ParseLengthPrefixedBytes(key, granteeAddrLenEndIndex+1, int(granteeAddrLen[0]))
+
+	kv.AssertKeyAtLeastLength(key, granteeAddrEndIndex+1)
+	return granteeAddr, conv.UnsafeBytesToStr(key[(granteeAddrEndIndex + 1):])
+}
+
 // parseGrantQueueKey split expiration time, granter and grantee from the grant queue key
 func parseGrantQueueKey(key []byte) (time.Time, sdk.AccAddress, sdk.AccAddress, error) {
 	// key is of format:
@@ -30,34 +30,11 @@ func (k Keeper) Grant(goCtx context.Context, msg *authz.MsgGrant) (*authz.MsgGra
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
 	}
 
-	if err := msg.Grant.ValidateBasic(); err != nil {
-		return nil, err
+	if msg.Grant.ParentGrantee != "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("parent grantee is set by the module for sub-grants and must be empty")
 	}
 
-	// create the account if it is not in account state
-	ctx := sdk.UnwrapSDKContext(goCtx)
-	granteeAcc := k.authKeeper.GetAccount(ctx, grantee)
-	if granteeAcc == nil {
-		if k.bankKeeper.BlockedAddr(grantee) {
-			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to receive funds", grantee)
-		}
-
-		granteeAcc = k.authKeeper.NewAccountWithAddress(ctx, grantee)
-		k.authKeeper.SetAccount(ctx, granteeAcc)
-	}
-
-	authorization, err := msg.GetAuthorization()
-	if err != nil {
-		return nil, err
-	}
-
-	t := authorization.MsgTypeURL()
-	if k.router.HandlerByTypeURL(t) == nil {
-		return nil, sdkerrors.ErrInvalidType.Wrapf("%s doesn't exist.", t)
-	}
-
-	err = k.SaveGrant(ctx, grantee, granter, authorization, msg.Grant.Expiration)
-	if err != nil {
+	if err := k.grant(sdk.UnwrapSDKContext(goCtx), grantee, granter, msg.Grant, ""); err != nil {
 		return nil, err
 	}
 
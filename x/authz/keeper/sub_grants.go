@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// subGrant creates the sub-grant requested by msg, derived from the redelegatable
+// grant held by parentGrantee on the granter's account for the same message type.
+// It returns the encoded MsgGrantResponse.
+func (k Keeper) subGrant(ctx sdk.Context, parentGrantee sdk.AccAddress, msg *authz.MsgGrant) ([]byte, error) {
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	grantee, err := k.authKeeper.AddressCodec().StringToBytes(msg.Grantee)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+
+	if bytes.Equal(grantee, granter) {
+		return nil, authz.ErrGranteeIsGranter
+	}
+
+	if bytes.Equal(grantee, parentGrantee) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("sub-grant grantee cannot be the grantee of the parent grant")
+	}
+
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+
+	msgType := authorization.MsgTypeURL()
+	parent, found := k.getGrant(ctx, grantStoreKey(parentGrantee, granter, msgType))
+	if !found {
+		return nil, errorsmod.Wrapf(authz.ErrNoAuthorizationFound,
+			"failed to get grant with given granter: %s, grantee: %s & msgType: %s ", sdk.AccAddress(granter), parentGrantee, sdk.MsgTypeURL(msg))
+	}
+
+	if !parent.Redelegatable {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("grant of %s for %s is not redelegatable", parentGrantee, msgType)
+	}
+
+	if err := k.validateGrantChain(ctx, granter, parent, msgType); err != nil {
+		return nil, err
+	}
+
+	if parent.Expiration != nil && (msg.Grant.Expiration == nil || msg.Grant.Expiration.After(*parent.Expiration)) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("sub-grant must expire no later than its parent grant at %s", parent.Expiration)
+	}
+
+	parentGranteeStr, err := k.authKeeper.AddressCodec().BytesToString(parentGrantee)
+	if err != nil {
+		return nil, err
+	}
+
+	// a sub-grant can only replace a sub-grant derived from the same parent
+	if existing, found := k.getGrant(ctx, grantStoreKey(grantee, granter, msgType)); found && existing.ParentGrantee != parentGranteeStr {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s already holds a grant for %s which is not derived from the grant of %s", msg.Grantee, msgType, parentGranteeStr)
+	}
+
+	if err := k.grant(ctx, grantee, granter, msg.Grant, parentGranteeStr); err != nil {
+		return nil, err
+	}
+
+	return k.cdc.Marshal(&authz.MsgGrantResponse{})
+}
+
+// grant validates and saves the grant given by the granter to the grantee. A
+// non empty parentGrantee makes it a sub-grant of the grant held by parentGrantee.
+func (k Keeper) grant(ctx sdk.Context, grantee, granter sdk.AccAddress, g authz.Grant, parentGrantee string) error {
+	if err := g.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// create the account if it is not in account state
+	granteeAcc := k.authKeeper.GetAccount(ctx, grantee)
+	if granteeAcc == nil {
+		if k.bankKeeper.BlockedAddr(grantee) {
+			return sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to receive funds", grantee)
+		}
+
+		granteeAcc = k.authKeeper.NewAccountWithAddress(ctx, grantee)
+		k.authKeeper.SetAccount(ctx, granteeAcc)
+	}
+
+	authorization, err := g.GetAuthorization()
+	if err != nil {
+		return err
+	}
+
+	t := authorization.MsgTypeURL()
+	if k.router.HandlerByTypeURL(t) == nil {
+		return sdkerrors.ErrInvalidType.Wrapf("%s doesn't exist.", t)
+	}
+
+	constraints, err := g.GetConstraints()
+	if err != nil {
+		return err
+	}
+
+	grant, err := authz.NewGrant(ctx.BlockTime(), authorization, g.Expiration)
+	if err != nil {
+		return err
+	}
+
+	if err := grant.SetConstraints(constraints); err != nil {
+		return err
+	}
+
+	grant.Redelegatable = g.Redelegatable
+	grant.ParentGrantee = parentGrantee
+
+	return k.saveGrant(ctx, grantee, granter, grant)
+}
+
+// getParentGrant returns the redelegatable grant the provided sub-grant was
+// derived from, along with its grantee.
+func (k Keeper) getParentGrant(ctx context.Context, granter sdk.AccAddress, grant authz.Grant, msgType string) (sdk.AccAddress, authz.Grant, error) {
+	parentGrantee, err := k.authKeeper.AddressCodec().StringToBytes(grant.ParentGrantee)
+	if err != nil {
+		return nil, authz.Grant{}, sdkerrors.ErrInvalidAddress.Wrapf("invalid parent grantee address: %s", err)
+	}
+
+	parent, found := k.getGrant(ctx, grantStoreKey(parentGrantee, granter, msgType))
+	if !found || !parent.Redelegatable {
+		return nil, authz.Grant{}, errorsmod.Wrapf(authz.ErrNoAuthorizationFound,
+			"parent grant of %s for %s was revoked", sdk.AccAddress(parentGrantee), msgType)
+	}
+
+	return parentGrantee, parent, nil
+}
+
+// validateGrantChain checks that the grant and all the grants it was derived
+// from are still valid.
+func (k Keeper) validateGrantChain(ctx sdk.Context, granter sdk.AccAddress, grant authz.Grant, msgType string) error {
+	for {
+		if grant.Expiration != nil && grant.Expiration.Before(ctx.BlockTime()) {
+			return authz.ErrAuthorizationExpired
+		}
+
+		if grant.ParentGrantee == "" {
+			return nil
+		}
+
+		_, parent, err := k.getParentGrant(ctx, granter, grant, msgType)
+		if err != nil {
+			return err
+		}
+		grant = parent
+	}
+}
+
+// deleteSubGrantIndex removes the grant from the sub-grants of its parent, if
+// it is a sub-grant.
+func (k Keeper) deleteSubGrantIndex(ctx context.Context, grantee, granter sdk.AccAddress, grant authz.Grant, msgType string) error {
+	if grant.ParentGrantee == "" {
+		return nil
+	}
+
+	parentGrantee, err := k.authKeeper.AddressCodec().StringToBytes(grant.ParentGrantee)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid parent grantee address: %s", err)
+	}
+
+	return k.storeService.OpenKVStore(ctx).Delete(subGrantKey(granter, parentGrantee, grantee, msgType))
+}
+
+// revokeSubGrants schedules the sub-grants derived from the grant of parentGrantee
+// for deletion, by moving their expiration to the current block time in the
+// grant queue. Sub-grants already expiring by then are left untouched.
+func (k Keeper) revokeSubGrants(ctx context.Context, granter, parentGrantee sdk.AccAddress, msgType string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+	store := k.storeService.OpenKVStore(ctx)
+
+	parentGranteeStr, err := k.authKeeper.AddressCodec().BytesToString(parentGrantee)
+	if err != nil {
+		return err
+	}
+
+	prefix := subGrantsPrefix(granter, parentGrantee)
+	iter := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), prefix)
+
+	var grantees []sdk.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		grantee, typeURL := parseSubGrantKey(iter.Key(), len(prefix))
+		if typeURL == msgType {
+			grantees = append(grantees, bytes.Clone(grantee))
+		}
+	}
+
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for _, grantee := range grantees {
+		if err := store.Delete(subGrantKey(granter, parentGrantee, grantee, msgType)); err != nil {
+			return err
+		}
+
+		skey := grantStoreKey(grantee, granter, msgType)
+		grant, found := k.getGrant(ctx, skey)
+		if !found || grant.ParentGrantee != parentGranteeStr || (grant.Expiration != nil && !grant.Expiration.After(now)) {
+			continue
+		}
+
+		if grant.Expiration != nil {
+			if err := k.removeFromGrantQueue(ctx, skey, granter, grantee, *grant.Expiration); err != nil {
+				return err
+			}
+		}
+
+		if err := k.insertIntoGrantQueue(ctx, granter, grantee, msgType, now); err != nil {
+			return err
+		}
+
+		grant.Expiration = &now
+		if err := store.Set(skey, k.cdc.MustMarshal(&grant)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -52,6 +52,11 @@ func (msg *MsgGrant) SetAuthorization(a Authorization) error {
 	return nil
 }
 
+// SetConstraints converts the constraints to Any and adds them to MsgGrant.Grant.
+func (msg *MsgGrant) SetConstraints(constraints []Constraint) error {
+	return msg.Grant.SetConstraints(constraints)
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (msg MsgExec) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 	for _, x := range msg.Msgs {
@@ -23,3 +23,39 @@ func (k MockBankKeeper) UpdateParams(goCtx context.Context, req *bank.MsgUpdateP
 func (k MockBankKeeper) SetSendEnabled(goCtx context.Context, req *bank.MsgSetSendEnabled) (*bank.MsgSetSendEnabledResponse, error) {
 	return nil, nil
 }
+
+func (k MockBankKeeper) CreateDenom(goCtx context.Context, req *bank.MsgCreateDenom) (*bank.MsgCreateDenomResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) Mint(goCtx context.Context, req *bank.MsgMint) (*bank.MsgMintResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) Burn(goCtx context.Context, req *bank.MsgBurn) (*bank.MsgBurnResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) ChangeDenomAdmin(goCtx context.Context, req *bank.MsgChangeDenomAdmin) (*bank.MsgChangeDenomAdminResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetDenomMetadata(goCtx context.Context, req *bank.MsgSetDenomMetadata) (*bank.MsgSetDenomMetadataResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) ForceTransfer(goCtx context.Context, req *bank.MsgForceTransfer) (*bank.MsgForceTransferResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) CreateDenomMetadata(goCtx context.Context, req *bank.MsgCreateDenomMetadata) (*bank.MsgCreateDenomMetadataResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) UpdateDenomMetadata(goCtx context.Context, req *bank.MsgUpdateDenomMetadata) (*bank.MsgUpdateDenomMetadataResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) DeleteDenomMetadata(goCtx context.Context, req *bank.MsgDeleteDenomMetadata) (*bank.MsgDeleteDenomMetadataResponse, error) {
+	return nil, nil
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
@@ -16,7 +17,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
-var FlagSplit = "split"
+var (
+	FlagSplit         = "split"
+	FlagMintToAddress = "mint-to"
+)
 
 // NewTxCmd returns a root CLI command handler for all x/bank transaction commands.
 func NewTxCmd(ac address.Codec) *cobra.Command {
@@ -31,6 +35,12 @@ func NewTxCmd(ac address.Codec) *cobra.Command {
 	txCmd.AddCommand(
 		NewSendTxCmd(ac),
 		NewMultiSendTxCmd(ac),
+		NewCreateDenomTxCmd(ac),
+		NewMintTxCmd(ac),
+		NewBurnTxCmd(ac),
+		NewChangeDenomAdminTxCmd(ac),
+		NewSetDenomMetadataTxCmd(ac),
+		NewForceTransferTxCmd(ac),
 	)
 
 	return txCmd
@@ -157,3 +167,250 @@ When using '--dry-run' a key name cannot be used, only a bech32 address.`,
 
 	return cmd
 }
+
+// The token factory commands are hand written rather than generated by autocli,
+// as their messages are not part of a released cosmossdk.io/api module yet.
+
+// NewCreateDenomTxCmd returns a CLI command handler for creating a MsgCreateDenom transaction.
+func NewCreateDenomTxCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-denom [subdenom]",
+		Short: "Create a token factory denom factory/{sender}/{subdenom} administered by the sender.",
+		Long: `Create a token factory denom factory/{sender}/{subdenom} administered by the sender.
+The denom creation fee set in the bank params is charged to the sender.`,
+		Example: fmt.Sprintf("%s tx bank create-denom mytoken --from mykey", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateDenom(sender, args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMintTxCmd returns a CLI command handler for creating a MsgMint transaction.
+func NewMintTxCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mint [amount]",
+		Short:   "Mint tokens of a token factory denom administered by the sender.",
+		Long:    "Mint tokens of a token factory denom administered by the sender. The tokens are minted to the sender unless --mint-to is set.",
+		Example: fmt.Sprintf("%s tx bank mint 1000factory/cosmos1.../mytoken --mint-to cosmos1... --from mykey", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			mintTo, err := cmd.Flags().GetString(FlagMintToAddress)
+			if err != nil {
+				return err
+			}
+
+			if mintTo != "" {
+				if _, err := ac.StringToBytes(mintTo); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgMint(sender, amount, mintTo)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMintToAddress, "", "Address to mint the tokens to, defaults to the sender")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewBurnTxCmd returns a CLI command handler for creating a MsgBurn transaction.
+func NewBurnTxCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "burn [amount]",
+		Short:   "Burn tokens of a token factory denom administered by the sender from the sender's balance.",
+		Example: fmt.Sprintf("%s tx bank burn 1000factory/cosmos1.../mytoken --from mykey", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurn(sender, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewChangeDenomAdminTxCmd returns a CLI command handler for creating a MsgChangeDenomAdmin transaction.
+func NewChangeDenomAdminTxCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-denom-admin [denom] [new_admin]",
+		Short: "Change the admin of a token factory denom.",
+		Long: `Change the admin of a token factory denom.
+Passing an empty new admin ("") renounces the administration of the denom for good.`,
+		Example: fmt.Sprintf("%s tx bank change-denom-admin factory/cosmos1.../mytoken cosmos1... --from mykey", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if args[1] != "" {
+				if _, err := ac.StringToBytes(args[1]); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgChangeDenomAdmin(sender, args[0], args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetDenomMetadataTxCmd returns a CLI command handler for creating a MsgSetDenomMetadata transaction.
+func NewSetDenomMetadataTxCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [metadata_file]",
+		Short: "Set the metadata of a token factory denom from a JSON file.",
+		Long: `Set the metadata of a token factory denom from a JSON file.
+The base of the metadata must be the token factory denom, e.g.:
+
+{
+  "description": "My token",
+  "denom_units": [
+    {"denom": "factory/cosmos1.../mytoken", "exponent": 0},
+    {"denom": "mytoken", "exponent": 6}
+  ],
+  "base": "factory/cosmos1.../mytoken",
+  "display": "mytoken",
+  "name": "My token",
+  "symbol": "MTK"
+}`,
+		Example: fmt.Sprintf("%s tx bank set-denom-metadata metadata.json --from mykey", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var metadata types.Metadata
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &metadata); err != nil {
+				return fmt.Errorf("invalid metadata file: %w", err)
+			}
+
+			msg := types.NewMsgSetDenomMetadata(sender, metadata)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewForceTransferTxCmd returns a CLI command handler for creating a MsgForceTransfer transaction.
+func NewForceTransferTxCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-transfer [amount] [from_address] [to_address]",
+		Short: "Move tokens of a token factory denom administered by the sender between two accounts.",
+		Long: `Move tokens of a token factory denom administered by the sender between two accounts.
+Force transfers must be enabled in the bank params.`,
+		Example: fmt.Sprintf("%s tx bank force-transfer 1000factory/cosmos1.../mytoken cosmos1... cosmos1... --from mykey", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			for _, addr := range args[1:] {
+				if _, err := ac.StringToBytes(addr); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgForceTransfer(sender, amount, args[1], args[2])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -53,6 +53,16 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, fd := range genState.FactoryDenoms {
+		if err := fd.Validate(k.ak.AddressCodec()); err != nil {
+			panic(err)
+		}
+
+		if err := k.setDenomAuthorityMetadata(ctx, fd.Denom, fd.AuthorityMetadata); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -69,5 +79,11 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+
+	rv.FactoryDenoms, err = k.GetAllFactoryDenoms(ctx)
+	if err != nil {
+		panic(fmt.Errorf("unable to fetch token factory denoms %w", err))
+	}
+
 	return rv
 }
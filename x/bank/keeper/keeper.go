@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
 )
 
 var _ Keeper = (*BaseKeeper)(nil)
@@ -37,6 +38,7 @@ type Keeper interface {
 	GetDenomMetaData(ctx context.Context, denom string) (types.Metadata, bool)
 	HasDenomMetaData(ctx context.Context, denom string) bool
 	SetDenomMetaData(ctx context.Context, denomMetaData types.Metadata)
+	DeleteDenomMetaData(ctx context.Context, denom string)
 	GetAllDenomMetaData(ctx context.Context) []types.Metadata
 	IterateAllDenomMetaData(ctx context.Context, cb func(types.Metadata) bool)
 
@@ -57,6 +59,15 @@ type Keeper interface {
 	DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	CreateDenom(ctx context.Context, creator sdk.AccAddress, subdenom string) (string, error)
+	GetDenomAuthorityMetadata(ctx context.Context, denom string) (types.DenomAuthorityMetadata, error)
+	GetAllFactoryDenoms(ctx context.Context) ([]types.FactoryDenom, error)
+	MintFactoryDenom(ctx context.Context, admin, recipient sdk.AccAddress, amount sdk.Coin) error
+	BurnFactoryDenom(ctx context.Context, admin sdk.AccAddress, amount sdk.Coin) error
+	ChangeDenomAdmin(ctx context.Context, admin sdk.AccAddress, denom, newAdmin string) error
+	SetFactoryDenomMetadata(ctx context.Context, admin sdk.AccAddress, metadata types.Metadata) error
+	ForceTransfer(ctx context.Context, admin, from, to sdk.AccAddress, amount sdk.Coin) error
+
 	types.QueryServer
 }
 
@@ -69,6 +80,10 @@ type BaseKeeper struct {
 	storeService           store.KVStoreService
 	mintCoinsRestrictionFn types.MintingRestrictionFn
 	logger                 log.Logger
+
+	// denomCreationFeeRecipient is the name of the module account receiving
+	// the token factory denom creation fees.
+	denomCreationFeeRecipient string
 }
 
 func (k BaseKeeper) WithObjStoreKey(okey storetypes.StoreKey) BaseKeeper {
@@ -110,15 +125,23 @@ func NewBaseKeeper(
 	logger = logger.With(log.ModuleKey, "x/"+types.ModuleName)
 
 	return BaseKeeper{
-		BaseSendKeeper:         NewBaseSendKeeper(cdc, storeService, ak, blockedAddrs, authority, logger),
-		ak:                     ak,
-		cdc:                    cdc,
-		storeService:           storeService,
-		mintCoinsRestrictionFn: types.NoOpMintingRestrictionFn,
-		logger:                 logger,
+		BaseSendKeeper:            NewBaseSendKeeper(cdc, storeService, ak, blockedAddrs, authority, logger),
+		ak:                        ak,
+		cdc:                       cdc,
+		storeService:              storeService,
+		mintCoinsRestrictionFn:    types.NoOpMintingRestrictionFn,
+		logger:                    logger,
+		denomCreationFeeRecipient: protocolpooltypes.ModuleName,
 	}
 }
 
+// WithDenomCreationFeeRecipient sets the module account receiving the token
+// factory denom creation fees, which defaults to the protocol pool.
+func (k BaseKeeper) WithDenomCreationFeeRecipient(moduleName string) BaseKeeper {
+	k.denomCreationFeeRecipient = moduleName
+	return k
+}
+
 // WithMintCoinsRestriction restricts the bank Keeper used within a specific module to
 // have restricted permissions on minting via function passed in parameter.
 // Previous restriction functions can be nested as such:
@@ -144,6 +167,10 @@ func (k BaseKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccA
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
+	if err := k.applySendHooks(ctx, types.SendFlowDelegate, delegatorAddr, moduleAccAddr, amt); err != nil {
+		return err
+	}
+
 	balances := sdk.NewCoins()
 
 	for _, coin := range amt {
@@ -193,6 +220,10 @@ func (k BaseKeeper) UndelegateCoins(ctx context.Context, moduleAccAddr, delegato
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
+	if err := k.applySendHooks(ctx, types.SendFlowUndelegate, moduleAccAddr, delegatorAddr, amt); err != nil {
+		return err
+	}
+
 	err := k.subUnlockedCoins(ctx, moduleAccAddr, amt)
 	if err != nil {
 		return err
@@ -266,6 +297,11 @@ func (k BaseKeeper) SetDenomMetaData(ctx context.Context, denomMetaData types.Me
 	_ = k.BaseViewKeeper.DenomMetadata.Set(ctx, denomMetaData.Base, denomMetaData)
 }
 
+// DeleteDenomMetaData deletes the denomination metadata
+func (k BaseKeeper) DeleteDenomMetaData(ctx context.Context, denom string) {
+	_ = k.BaseViewKeeper.DenomMetadata.Remove(ctx, denom)
+}
+
 // SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress.
 // It will panic if the module account does not exist. An error is returned if
 // the recipient address is black-listed or if sending the tokens fails.
@@ -374,6 +410,10 @@ func (k BaseKeeper) MintCoins(ctx context.Context, moduleName string, amounts sd
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amounts.String())
 	}
 
+	if err := k.applySendHooks(ctx, types.SendFlowMint, nil, acc.GetAddress(), amounts); err != nil {
+		return err
+	}
+
 	err = k.addCoins(ctx, acc.GetAddress(), amounts)
 	if err != nil {
 		return err
@@ -410,6 +450,10 @@ func (k BaseKeeper) BurnCoins(ctx context.Context, moduleName string, amounts sd
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amounts.String())
 	}
 
+	if err := k.applySendHooks(ctx, types.SendFlowBurn, acc.GetAddress(), nil, amounts); err != nil {
+		return err
+	}
+
 	err := k.subUnlockedCoins(ctx, acc.GetAddress(), amounts)
 	if err != nil {
 		return err
@@ -324,6 +324,76 @@ func (suite *KeeperTestSuite) TestPrependSendRestriction() {
 	suite.Require().Equal([]int{2, 1}, calls, "restriction calls from original bank keeper")
 }
 
+func (suite *KeeperTestSuite) TestSendHooks() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	bk := suite.bankKeeper
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, bk, accAddrs[0], balances))
+
+	var mints []sdk.Coins
+	frozen := banktypes.SendHook{
+		Name:   "frozen",
+		Flows:  banktypes.SendFlowSend,
+		Denoms: []string{barDenom},
+		Fn: func(_ context.Context, _ banktypes.SendFlow, _, _ sdk.AccAddress, _ sdk.Coins) error {
+			return errors.New("bar is frozen")
+		},
+	}
+	tax := banktypes.SendHook{
+		Name:   "tax",
+		Flows:  banktypes.SendFlowSend,
+		Denoms: []string{fooDenom},
+		Gas:    1_000_000,
+		Fn: func(ctx context.Context, _ banktypes.SendFlow, fromAddr, _ sdk.AccAddress, _ sdk.Coins) error {
+			return bk.SendCoins(ctx, fromAddr, accAddrs[2], sdk.NewCoins(newFooCoin(1)))
+		},
+	}
+	mint := banktypes.SendHook{
+		Name:  "mint",
+		Flows: banktypes.SendFlowMint | banktypes.SendFlowBurn,
+		Fn: func(_ context.Context, flow banktypes.SendFlow, _, _ sdk.AccAddress, amt sdk.Coins) error {
+			if flow == banktypes.SendFlowMint {
+				mints = append(mints, amt)
+			}
+			return nil
+		},
+	}
+
+	require.NoError(bk.RegisterSendHook(frozen))
+	require.NoError(bk.RegisterSendHook(tax))
+	require.NoError(bk.RegisterSendHook(mint))
+	require.ErrorContains(bk.RegisterSendHook(tax), "already registered")
+	require.Error(bk.RegisterSendHook(banktypes.SendHook{Name: "invalid", Flows: banktypes.SendFlowSend}))
+	require.Equal([]string{"frozen", "tax", "mint"}, []string{
+		bk.GetSendHooks()[0].Name, bk.GetSendHooks()[1].Name, bk.GetSendHooks()[2].Name,
+	})
+
+	// sending the frozen denom is rejected before any balance changes
+	err := bk.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10), newBarCoin(10)))
+	require.ErrorContains(err, "send hook frozen: bar is frozen")
+	require.Equal(balances, bk.GetAllBalances(ctx, accAddrs[0]))
+
+	// the tax is collected by a nested send which does not run the hooks again
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), accAddrs[0]).Return(acc0)
+	suite.authKeeper.EXPECT().HasAccount(gomock.Any(), accAddrs[2]).Return(true)
+	gasBefore := ctx.GasMeter().GasConsumed()
+	require.NoError(bk.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	require.GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, tax.Gas)
+	require.Equal(sdk.NewCoins(newFooCoin(89), newBarCoin(50)), bk.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(10)), bk.GetAllBalances(ctx, accAddrs[1]))
+	require.Equal(sdk.NewCoins(newFooCoin(1)), bk.GetAllBalances(ctx, accAddrs[2]))
+
+	// mints only run the hooks covering the mint flow
+	suite.mockMintCoins(mintAcc)
+	require.NoError(bk.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(newBarCoin(5))))
+	require.Equal([]sdk.Coins{sdk.NewCoins(newBarCoin(5))}, mints)
+}
+
 func (suite *KeeperTestSuite) TestGetAuthority() {
 	storeService := runtime.NewKVStoreService(storetypes.NewKVStoreKey(banktypes.StoreKey))
 	NewKeeperWithAuthority := func(authority string) keeper.BaseKeeper {
@@ -182,3 +182,186 @@ func (k msgServer) SetSendEnabled(goCtx context.Context, msg *types.MsgSetSendEn
 
 	return &types.MsgSetSendEnabledResponse{}, nil
 }
+
+func (k msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	sender, err := k.stringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	denom, err := k.Keeper.CreateDenom(goCtx, sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	sender, err := k.stringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	recipient := sender
+	if msg.MintToAddress != "" {
+		recipient, err = k.stringToBytes(msg.MintToAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid mint to address: %s", err)
+		}
+	}
+
+	if err := k.MintFactoryDenom(goCtx, sender, recipient, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintResponse{}, nil
+}
+
+func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	sender, err := k.stringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := k.BurnFactoryDenom(goCtx, sender, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+func (k msgServer) ChangeDenomAdmin(goCtx context.Context, msg *types.MsgChangeDenomAdmin) (*types.MsgChangeDenomAdminResponse, error) {
+	sender, err := k.stringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := k.Keeper.ChangeDenomAdmin(goCtx, sender, msg.Denom, msg.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChangeDenomAdminResponse{}, nil
+}
+
+func (k msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	sender, err := k.stringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := k.SetFactoryDenomMetadata(goCtx, sender, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+func (k msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
+	sender, err := k.stringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	from, err := k.stringToBytes(msg.TransferFromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid transfer from address: %s", err)
+	}
+
+	to, err := k.stringToBytes(msg.TransferToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid transfer to address: %s", err)
+	}
+
+	if err := k.Keeper.ForceTransfer(goCtx, sender, from, to, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgForceTransferResponse{}, nil
+}
+
+func (k msgServer) CreateDenomMetadata(goCtx context.Context, msg *types.MsgCreateDenomMetadata) (*types.MsgCreateDenomMetadataResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if k.HasDenomMetaData(goCtx, msg.Metadata.Base) {
+		return nil, errorsmod.Wrapf(types.ErrDuplicateEntry, "metadata for denom %s already exists", msg.Metadata.Base)
+	}
+
+	if err := k.setDenomMetadata(goCtx, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDenomMetadataResponse{}, nil
+}
+
+func (k msgServer) UpdateDenomMetadata(goCtx context.Context, msg *types.MsgUpdateDenomMetadata) (*types.MsgUpdateDenomMetadataResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if !k.HasDenomMetaData(goCtx, msg.Metadata.Base) {
+		return nil, errorsmod.Wrapf(types.ErrDenomMetadataNotFound, "denom %s", msg.Metadata.Base)
+	}
+
+	if err := k.setDenomMetadata(goCtx, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateDenomMetadataResponse{}, nil
+}
+
+func (k msgServer) DeleteDenomMetadata(goCtx context.Context, msg *types.MsgDeleteDenomMetadata) (*types.MsgDeleteDenomMetadataResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if !k.HasDenomMetaData(goCtx, msg.Denom) {
+		return nil, errorsmod.Wrapf(types.ErrDenomMetadataNotFound, "denom %s", msg.Denom)
+	}
+
+	k.DeleteDenomMetaData(goCtx, msg.Denom)
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDeleteDenomMetadata,
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+	))
+
+	return &types.MsgDeleteDenomMetadataResponse{}, nil
+}
+
+// setDenomMetadata validates the metadata of a denom with an existing supply
+// and stores it.
+func (k msgServer) setDenomMetadata(goCtx context.Context, metadata types.Metadata) error {
+	if err := metadata.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := metadata.ValidateURI(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if !k.HasSupply(goCtx, metadata.Base) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "denom %s has no supply", metadata.Base)
+	}
+
+	k.SetDenomMetaData(goCtx, metadata)
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetDenomMetadata,
+		sdk.NewAttribute(types.AttributeKeyDenom, metadata.Base),
+	))
+
+	return nil
+}
+
+// stringToBytes decodes addr with the address codec of the underlying BaseKeeper.
+func (k msgServer) stringToBytes(addr string) ([]byte, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	return base.ak.AddressCodec().StringToBytes(addr)
+}
@@ -1,10 +1,15 @@
 package keeper_test
 
 import (
+	"go.uber.org/mock/gomock"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
 )
 
 var govAcc = authtypes.NewEmptyModuleAccount(govtypes.ModuleName, authtypes.Minter)
@@ -357,3 +362,185 @@ func (suite *KeeperTestSuite) TestMsgSetSendEnabled() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgTokenFactory() {
+	require := suite.Require()
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+
+	admin := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	holder := authtypes.NewBaseAccountWithAddress(accAddrs[1])
+	protocolPoolAcc := authtypes.NewEmptyModuleAccount(protocolpooltypes.ModuleName)
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	params := banktypes.DefaultParams()
+	params.DenomCreationFee = fee
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	// the creation fee is charged to the creator
+	suite.authKeeper.EXPECT().GetModuleAddress(protocolpooltypes.ModuleName).Return(nil)
+	_, err := suite.msgServer.CreateDenom(ctx, banktypes.NewMsgCreateDenom(admin.Address, "token"))
+	require.ErrorContains(err, "module account protocolpool does not exist")
+
+	suite.authKeeper.EXPECT().GetModuleAddress(protocolpooltypes.ModuleName).Return(protocolPoolAcc.GetAddress()).AnyTimes()
+	suite.mockFundAccount(admin.GetAddress())
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, admin.GetAddress(), fee))
+
+	suite.mockSendCoinsFromAccountToModule(admin, protocolPoolAcc)
+	res, err := suite.msgServer.CreateDenom(ctx, banktypes.NewMsgCreateDenom(admin.Address, "token"))
+	require.NoError(err)
+	denom := res.NewTokenDenom
+	require.Equal("factory/"+admin.Address+"/token", denom)
+	require.Equal(fee, suite.bankKeeper.GetAllBalances(ctx, protocolPoolAcc.GetAddress()))
+	require.True(suite.bankKeeper.GetAllBalances(ctx, admin.GetAddress()).IsZero())
+
+	metadata, found := suite.bankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(found)
+	require.Equal(denom, metadata.Base)
+
+	_, err = suite.msgServer.CreateDenom(ctx, banktypes.NewMsgCreateDenom(admin.Address, "token"))
+	require.ErrorIs(err, banktypes.ErrDenomExists)
+
+	queryRes, err := suite.queryClient.DenomsFromCreator(ctx, &banktypes.QueryDenomsFromCreatorRequest{Creator: admin.Address})
+	require.NoError(err)
+	require.Equal([]string{denom}, queryRes.Denoms)
+
+	// only the admin can mint, burn and manage the denom
+	amount := sdk.NewInt64Coin(denom, 100)
+	_, err = suite.msgServer.Mint(ctx, banktypes.NewMsgMint(holder.Address, amount, ""))
+	require.ErrorIs(err, banktypes.ErrUnauthorizedDenomAdmin)
+
+	_, err = suite.msgServer.Mint(ctx, banktypes.NewMsgMint(admin.Address, amount, accAddrs[4].String()))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	suite.authKeeper.EXPECT().HasAccount(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+	_, err = suite.msgServer.Mint(ctx, banktypes.NewMsgMint(admin.Address, amount, ""))
+	require.NoError(err)
+	_, err = suite.msgServer.Mint(ctx, banktypes.NewMsgMint(admin.Address, amount, holder.Address))
+	require.NoError(err)
+	require.Equal(amount.Add(amount), suite.bankKeeper.GetSupply(ctx, denom))
+	require.Equal(amount, suite.bankKeeper.GetBalance(ctx, holder.GetAddress(), denom))
+
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), admin.GetAddress()).Return(admin).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), holder.GetAddress()).Return(holder).AnyTimes()
+	_, err = suite.msgServer.Burn(ctx, banktypes.NewMsgBurn(admin.Address, sdk.NewInt64Coin(denom, 40)))
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(denom, 160), suite.bankKeeper.GetSupply(ctx, denom))
+	require.Equal(sdk.NewInt64Coin(denom, 60), suite.bankKeeper.GetBalance(ctx, admin.GetAddress(), denom))
+
+	metadata.Description = "my token"
+	_, err = suite.msgServer.SetDenomMetadata(ctx, banktypes.NewMsgSetDenomMetadata(holder.Address, metadata))
+	require.ErrorIs(err, banktypes.ErrUnauthorizedDenomAdmin)
+	_, err = suite.msgServer.SetDenomMetadata(ctx, banktypes.NewMsgSetDenomMetadata(admin.Address, metadata))
+	require.NoError(err)
+	metadata, _ = suite.bankKeeper.GetDenomMetaData(ctx, denom)
+	require.Equal("my token", metadata.Description)
+
+	// force transfers must be enabled in the params
+	forceTransfer := banktypes.NewMsgForceTransfer(admin.Address, sdk.NewInt64Coin(denom, 50), holder.Address, admin.Address)
+	_, err = suite.msgServer.ForceTransfer(ctx, forceTransfer)
+	require.ErrorIs(err, banktypes.ErrForceTransferDisabled)
+
+	params.EnableForceTransfer = true
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+	_, err = suite.msgServer.ForceTransfer(ctx, forceTransfer)
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(denom, 50), suite.bankKeeper.GetBalance(ctx, holder.GetAddress(), denom))
+
+	// the admin can be transferred and renounced
+	_, err = suite.msgServer.ChangeDenomAdmin(ctx, banktypes.NewMsgChangeDenomAdmin(admin.Address, denom, holder.Address))
+	require.NoError(err)
+	_, err = suite.msgServer.Mint(ctx, banktypes.NewMsgMint(admin.Address, amount, ""))
+	require.ErrorIs(err, banktypes.ErrUnauthorizedDenomAdmin)
+
+	_, err = suite.msgServer.ChangeDenomAdmin(ctx, banktypes.NewMsgChangeDenomAdmin(holder.Address, denom, ""))
+	require.NoError(err)
+	authRes, err := suite.queryClient.DenomAuthorityMetadata(ctx, &banktypes.QueryDenomAuthorityMetadataRequest{Denom: denom})
+	require.NoError(err)
+	require.Empty(authRes.AuthorityMetadata.Admin)
+	_, err = suite.msgServer.Mint(ctx, banktypes.NewMsgMint(holder.Address, amount, ""))
+	require.ErrorIs(err, banktypes.ErrUnauthorizedDenomAdmin)
+
+	// the factory denoms are carried over genesis
+	genesis := suite.bankKeeper.ExportGenesis(ctx)
+	require.Equal([]banktypes.FactoryDenom{{Denom: denom}}, genesis.FactoryDenoms)
+	require.NoError(genesis.Validate())
+}
+
+func (suite *KeeperTestSuite) TestMsgDenomMetadata() {
+	require := suite.Require()
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	authority := suite.bankKeeper.GetAuthority()
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(100))))
+
+	res, err := suite.queryClient.DenomsWithoutMetadata(ctx, &banktypes.QueryDenomsWithoutMetadataRequest{})
+	require.NoError(err)
+	require.Equal([]string{barDenom, fooDenom}, res.Denoms)
+
+	metadata := banktypes.Metadata{
+		Description: "The foo token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: fooDenom, Exponent: 0},
+			{Denom: "kfoo", Exponent: 3},
+		},
+		Base:    fooDenom,
+		Display: "kfoo",
+		Name:    "Foo",
+		Symbol:  "FOO",
+		URI:     "https://example.com/foo.json",
+	}
+
+	_, err = suite.msgServer.CreateDenomMetadata(ctx, banktypes.NewMsgCreateDenomMetadata(accAddrs[0].String(), metadata))
+	require.ErrorContains(err, "invalid authority")
+
+	noSupply := metadata
+	noSupply.Base, noSupply.DenomUnits = "baz", []*banktypes.DenomUnit{{Denom: "baz"}, {Denom: "kfoo", Exponent: 3}}
+	_, err = suite.msgServer.CreateDenomMetadata(ctx, banktypes.NewMsgCreateDenomMetadata(authority, noSupply))
+	require.ErrorContains(err, "denom baz has no supply")
+
+	invalidURI := metadata
+	invalidURI.URIHash = "not a hash"
+	_, err = suite.msgServer.CreateDenomMetadata(ctx, banktypes.NewMsgCreateDenomMetadata(authority, invalidURI))
+	require.ErrorContains(err, "must be a hex encoded SHA-256 hash")
+
+	_, err = suite.msgServer.UpdateDenomMetadata(ctx, banktypes.NewMsgUpdateDenomMetadata(authority, metadata))
+	require.ErrorIs(err, banktypes.ErrDenomMetadataNotFound)
+
+	_, err = suite.msgServer.CreateDenomMetadata(ctx, banktypes.NewMsgCreateDenomMetadata(authority, metadata))
+	require.NoError(err)
+	got, found := suite.bankKeeper.GetDenomMetaData(ctx, fooDenom)
+	require.True(found)
+	require.Equal(metadata, got)
+	requireEventEmitted(suite, ctx, banktypes.EventTypeSetDenomMetadata)
+
+	_, err = suite.msgServer.CreateDenomMetadata(ctx, banktypes.NewMsgCreateDenomMetadata(authority, metadata))
+	require.ErrorIs(err, banktypes.ErrDuplicateEntry)
+
+	res, err = suite.queryClient.DenomsWithoutMetadata(ctx, &banktypes.QueryDenomsWithoutMetadataRequest{})
+	require.NoError(err)
+	require.Equal([]string{barDenom}, res.Denoms)
+
+	metadata.Description = "The updated foo token"
+	_, err = suite.msgServer.UpdateDenomMetadata(ctx, banktypes.NewMsgUpdateDenomMetadata(authority, metadata))
+	require.NoError(err)
+	got, _ = suite.bankKeeper.GetDenomMetaData(ctx, fooDenom)
+	require.Equal("The updated foo token", got.Description)
+
+	_, err = suite.msgServer.DeleteDenomMetadata(ctx, banktypes.NewMsgDeleteDenomMetadata(authority, barDenom))
+	require.ErrorIs(err, banktypes.ErrDenomMetadataNotFound)
+
+	_, err = suite.msgServer.DeleteDenomMetadata(ctx, banktypes.NewMsgDeleteDenomMetadata(authority, fooDenom))
+	require.NoError(err)
+	require.False(suite.bankKeeper.HasDenomMetaData(ctx, fooDenom))
+	requireEventEmitted(suite, ctx, banktypes.EventTypeDeleteDenomMetadata)
+}
+
+func requireEventEmitted(suite *KeeperTestSuite, ctx sdk.Context, eventType string) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return
+		}
+	}
+	suite.FailNowf("event not emitted", "no %s event", eventType)
+}
@@ -27,6 +27,9 @@ type SendKeeper interface {
 	PrependSendRestriction(restriction types.SendRestrictionFn)
 	ClearSendRestriction()
 
+	RegisterSendHook(hook types.SendHook) error
+	GetSendHooks() []types.SendHook
+
 	InputOutputCoins(ctx context.Context, input types.Input, outputs []types.Output) error
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 
@@ -71,6 +74,7 @@ type BaseSendKeeper struct {
 	authority string
 
 	sendRestriction *sendRestriction
+	sendHooks       *sendHooks
 }
 
 func NewBaseSendKeeper(
@@ -94,6 +98,7 @@ func NewBaseSendKeeper(
 		authority:       authority,
 		logger:          logger,
 		sendRestriction: newSendRestriction(),
+		sendHooks:       newSendHooks(),
 	}
 }
 
@@ -181,6 +186,10 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 			return err
 		}
 
+		if err := k.applySendHooks(ctx, types.SendFlowSend, inAddress, outAddress, out.Coins); err != nil {
+			return err
+		}
+
 		sending = append(sending, toSend{
 			Address:    outAddress,
 			AddressStr: out.Address,
@@ -232,6 +241,10 @@ func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccA
 		return err
 	}
 
+	if err := k.applySendHooks(ctx, types.SendFlowSend, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
 	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
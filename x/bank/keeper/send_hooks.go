@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// sendHooksRunningKey is the context key marking the calls made from within a
+// send hook.
+type sendHooksRunningKey struct{}
+
+// sendHooks is the ordered registry of the send hooks of a keeper.
+// It exists so that hooks can be registered in the SendKeeper without needing to have a pointer receiver.
+type sendHooks struct {
+	hooks []types.SendHook
+}
+
+// newSendHooks creates a new empty sendHooks registry.
+func newSendHooks() *sendHooks {
+	return &sendHooks{}
+}
+
+// RegisterSendHook adds the provided hook to run after the previously
+// registered hooks. Hook names must be unique.
+func (k BaseSendKeeper) RegisterSendHook(hook types.SendHook) error {
+	if err := hook.Validate(); err != nil {
+		return err
+	}
+
+	if slices.ContainsFunc(k.sendHooks.hooks, func(h types.SendHook) bool { return h.Name == hook.Name }) {
+		return fmt.Errorf("send hook %s is already registered", hook.Name)
+	}
+
+	k.sendHooks.hooks = append(k.sendHooks.hooks, hook)
+	return nil
+}
+
+// GetSendHooks returns the registered send hooks, in the order they run.
+func (k BaseSendKeeper) GetSendHooks() []types.SendHook {
+	return slices.Clone(k.sendHooks.hooks)
+}
+
+// applySendHooks calls the send hooks covering the flow and any of the denoms
+// of amt, charging their gas. It is a no-op when called from within a hook.
+func (k BaseSendKeeper) applySendHooks(ctx context.Context, flow types.SendFlow, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if len(k.sendHooks.hooks) == 0 {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if running, _ := sdkCtx.Value(sendHooksRunningKey{}).(bool); running {
+		return nil
+	}
+
+	hookCtx := sdkCtx.WithValue(sendHooksRunningKey{}, true)
+	for _, hook := range k.sendHooks.hooks {
+		gated := hook.GatedCoins(flow, amt)
+		if gated.Empty() {
+			continue
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(hook.Gas, "send hook "+hook.Name)
+		if err := hook.Fn(hookCtx, flow, fromAddr, toAddr, gated); err != nil {
+			return fmt.Errorf("send hook %s: %w", hook.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,274 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// CreateDenom creates the token factory denom factory/{creator}/{subdenom} with
+// creator as its admin. The denom creation fee is charged to the creator and
+// sent to the denom creation fee recipient module account.
+func (k BaseKeeper) CreateDenom(ctx context.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	creatorStr, err := k.ak.AddressCodec().BytesToString(creator)
+	if err != nil {
+		return "", err
+	}
+
+	denom, err := types.GetFactoryDenom(creatorStr, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	key := collections.Join(creatorStr, subdenom)
+	if has, err := k.FactoryDenoms.Has(ctx, key); err != nil {
+		return "", err
+	} else if has || k.HasSupply(ctx, denom) || k.HasDenomMetaData(ctx, denom) {
+		return "", types.ErrDenomExists.Wrapf("denom %s already exists", denom)
+	}
+
+	if fee := k.GetParams(ctx).DenomCreationFee; !fee.IsZero() {
+		if k.ak.GetModuleAddress(k.denomCreationFeeRecipient) == nil {
+			return "", errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", k.denomCreationFeeRecipient)
+		}
+
+		if err := k.SendCoinsFromAccountToModule(ctx, creator, k.denomCreationFeeRecipient, fee); err != nil {
+			return "", errorsmod.Wrap(err, "failed to pay the denom creation fee")
+		}
+	}
+
+	if err := k.FactoryDenoms.Set(ctx, key, types.DenomAuthorityMetadata{Admin: creatorStr}); err != nil {
+		return "", err
+	}
+
+	k.SetDenomMetaData(ctx, types.Metadata{
+		Base:       denom,
+		Display:    denom,
+		Name:       denom,
+		Symbol:     denom,
+		DenomUnits: []*types.DenomUnit{{Denom: denom, Exponent: 0}},
+	})
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateDenom,
+		sdk.NewAttribute(types.AttributeKeyCreator, creatorStr),
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+	))
+
+	return denom, nil
+}
+
+// GetDenomAuthorityMetadata returns the authorities of a token factory denom.
+func (k BaseViewKeeper) GetDenomAuthorityMetadata(ctx context.Context, denom string) (types.DenomAuthorityMetadata, error) {
+	creator, subdenom, err := types.ParseFactoryDenom(denom)
+	if err != nil {
+		return types.DenomAuthorityMetadata{}, err
+	}
+
+	metadata, err := k.FactoryDenoms.Get(ctx, collections.Join(creator, subdenom))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DenomAuthorityMetadata{}, types.ErrInvalidFactoryDenom.Wrapf("denom %s does not exist", denom)
+	}
+
+	return metadata, err
+}
+
+// setDenomAuthorityMetadata sets the authorities of a token factory denom.
+func (k BaseKeeper) setDenomAuthorityMetadata(ctx context.Context, denom string, metadata types.DenomAuthorityMetadata) error {
+	creator, subdenom, err := types.ParseFactoryDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	return k.FactoryDenoms.Set(ctx, collections.Join(creator, subdenom), metadata)
+}
+
+// GetAllFactoryDenoms returns all the token factory denoms along with their authorities.
+func (k BaseViewKeeper) GetAllFactoryDenoms(ctx context.Context) ([]types.FactoryDenom, error) {
+	denoms := make([]types.FactoryDenom, 0)
+	err := k.FactoryDenoms.Walk(ctx, nil, func(key collections.Pair[string, string], metadata types.DenomAuthorityMetadata) (bool, error) {
+		denom, err := types.GetFactoryDenom(key.K1(), key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		denoms = append(denoms, types.FactoryDenom{Denom: denom, AuthorityMetadata: metadata})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return denoms, nil
+}
+
+// checkDenomAdmin returns an error if admin is not the admin of the token
+// factory denom.
+func (k BaseKeeper) checkDenomAdmin(ctx context.Context, admin sdk.AccAddress, denom string) error {
+	metadata, err := k.GetDenomAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	adminStr, err := k.ak.AddressCodec().BytesToString(admin)
+	if err != nil {
+		return err
+	}
+
+	if metadata.Admin == "" || metadata.Admin != adminStr {
+		return types.ErrUnauthorizedDenomAdmin.Wrapf("%s is not the admin of %s", adminStr, denom)
+	}
+
+	return nil
+}
+
+// MintFactoryDenom mints amount of a token factory denom administered by admin
+// to the recipient account.
+func (k BaseKeeper) MintFactoryDenom(ctx context.Context, admin, recipient sdk.AccAddress, amount sdk.Coin) error {
+	if err := k.checkDenomAdmin(ctx, admin, amount.Denom); err != nil {
+		return err
+	}
+
+	if !amount.IsValid() || !amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+
+	if k.BlockedAddr(recipient) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipient)
+	}
+
+	amounts := sdk.NewCoins(amount)
+	if err := k.applySendHooks(ctx, types.SendFlowMint, nil, recipient, amounts); err != nil {
+		return err
+	}
+
+	if err := k.addCoins(ctx, recipient, amounts); err != nil {
+		return err
+	}
+
+	k.ensureAccountCreated(ctx, recipient)
+	k.setSupply(ctx, k.GetSupply(ctx, amount.Denom).Add(amount))
+
+	k.logger.Debug("minted token factory coins", "amount", amount.String(), "to", recipient.String())
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		types.NewCoinMintEvent(recipient, amounts),
+	)
+
+	return nil
+}
+
+// BurnFactoryDenom burns amount of a token factory denom from the balance of
+// its admin.
+func (k BaseKeeper) BurnFactoryDenom(ctx context.Context, admin sdk.AccAddress, amount sdk.Coin) error {
+	if err := k.checkDenomAdmin(ctx, admin, amount.Denom); err != nil {
+		return err
+	}
+
+	if !amount.IsValid() || !amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+
+	amounts := sdk.NewCoins(amount)
+	if err := k.applySendHooks(ctx, types.SendFlowBurn, admin, nil, amounts); err != nil {
+		return err
+	}
+
+	if err := k.subUnlockedCoins(ctx, admin, amounts); err != nil {
+		return err
+	}
+
+	k.setSupply(ctx, k.GetSupply(ctx, amount.Denom).Sub(amount))
+
+	k.logger.Debug("burned token factory coins", "amount", amount.String(), "from", admin.String())
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		types.NewCoinBurnEvent(admin, amounts),
+	)
+
+	return nil
+}
+
+// ChangeDenomAdmin transfers the administration of a token factory denom from
+// admin to newAdmin. An empty newAdmin renounces it for good.
+func (k BaseKeeper) ChangeDenomAdmin(ctx context.Context, admin sdk.AccAddress, denom, newAdmin string) error {
+	if err := k.checkDenomAdmin(ctx, admin, denom); err != nil {
+		return err
+	}
+
+	if newAdmin != "" {
+		if _, err := k.ak.AddressCodec().StringToBytes(newAdmin); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", err)
+		}
+	}
+
+	if err := k.setDenomAuthorityMetadata(ctx, denom, types.DenomAuthorityMetadata{Admin: newAdmin}); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeChangeDenomAdmin,
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		sdk.NewAttribute(types.AttributeKeyNewAdmin, newAdmin),
+	))
+
+	return nil
+}
+
+// SetFactoryDenomMetadata sets the metadata of a token factory denom
+// administered by admin.
+func (k BaseKeeper) SetFactoryDenomMetadata(ctx context.Context, admin sdk.AccAddress, metadata types.Metadata) error {
+	if err := k.checkDenomAdmin(ctx, admin, metadata.Base); err != nil {
+		return err
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := metadata.ValidateURI(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.SetDenomMetaData(ctx, metadata)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetDenomMetadata,
+		sdk.NewAttribute(types.AttributeKeyDenom, metadata.Base),
+	))
+
+	return nil
+}
+
+// ForceTransfer moves amount of a token factory denom administered by admin
+// from one account to another, regardless of the will of the sender. It is only
+// allowed if enabled in the params.
+func (k BaseKeeper) ForceTransfer(ctx context.Context, admin, from, to sdk.AccAddress, amount sdk.Coin) error {
+	if !k.GetParams(ctx).EnableForceTransfer {
+		return types.ErrForceTransferDisabled
+	}
+
+	if err := k.checkDenomAdmin(ctx, admin, amount.Denom); err != nil {
+		return err
+	}
+
+	if !amount.IsValid() || !amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+
+	if k.BlockedAddr(from) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "cannot force transfer from %s", from)
+	}
+
+	if k.BlockedAddr(to) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", to)
+	}
+
+	return k.SendCoins(ctx, from, to, sdk.NewCoins(amount))
+}
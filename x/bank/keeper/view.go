@@ -67,6 +67,9 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+	// FactoryDenoms maps the (creator, subdenom) pair of a token factory denom
+	// to its authorities.
+	FactoryDenoms collections.Map[collections.Pair[string, string], types.DenomAuthorityMetadata]
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
@@ -82,6 +85,8 @@ func NewBaseViewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService,
 		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
 		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		FactoryDenoms: collections.NewMap(sb, types.DenomAuthorityMetadataPrefix, "denom_authority_metadata",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.DenomAuthorityMetadata](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -11,6 +11,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // SendCoinsFromAccountToModuleVirtual sends coins from account to a virtual module account.
@@ -56,6 +57,10 @@ func (k BaseSendKeeper) SendCoinsToVirtual(ctx context.Context, fromAddr, toAddr
 		return err
 	}
 
+	if err := k.applySendHooks(ctx, types.SendFlowSend, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
 	k.addVirtualCoins(ctx, toAddr, amt)
 	k.emitSendCoinsEvents(ctx, fromAddr, toAddr, amt)
 	return nil
@@ -74,6 +79,10 @@ func (k BaseSendKeeper) SendCoinsFromVirtual(ctx context.Context, fromAddr, toAd
 		return err
 	}
 
+	if err := k.applySendHooks(ctx, types.SendFlowSend, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
 	err = k.addCoins(ctx, toAddr, amt)
 	if err != nil {
 		return err
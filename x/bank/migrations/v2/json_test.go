@@ -75,8 +75,11 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"denom_metadata": [],
+	"factory_denoms": [],
 	"params": {
 		"default_send_enabled": false,
+		"denom_creation_fee": [],
+		"enable_force_transfer": false,
 		"send_enabled": []
 	},
 	"send_enabled": [],
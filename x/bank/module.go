@@ -214,6 +214,7 @@ func init() {
 		&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
 		appmodule.Invoke(InvokeSetSendRestrictions),
+		appmodule.Invoke(InvokeRegisterSendHooks),
 	)
 }
 
@@ -308,3 +309,25 @@ func InvokeSetSendRestrictions(
 
 	return nil
 }
+
+// InvokeRegisterSendHooks registers the send hooks provided by the other
+// modules, ordered by module name. A hook without a name is named after the
+// module providing it.
+func InvokeRegisterSendHooks(
+	keeper keeper.BaseKeeper,
+	hooks map[string]types.SendHook,
+) error {
+	modules := slices.Sorted(maps.Keys(hooks))
+	for _, module := range modules {
+		hook := hooks[module]
+		if hook.Name == "" {
+			hook.Name = module
+		}
+
+		if err := keeper.RegisterSendHook(hook); err != nil {
+			return fmt.Errorf("failed to register send hook of module %s: %w", module, err)
+		}
+	}
+
+	return nil
+}
@@ -37,6 +37,12 @@ type Params struct {
 	// As of cosmos-sdk 0.47, this only exists for backwards compatibility of genesis files.
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"` // Deprecated: Do not use.
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// denom_creation_fee is the fee charged for creating a token factory denom.
+	// It is sent to the protocol pool, unless configured otherwise by the chain.
+	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee"`
+	// enable_force_transfer allows the admin of a token factory denom to move
+	// tokens of the denom between any accounts.
+	EnableForceTransfer bool `protobuf:"varint,4,opt,name=enable_force_transfer,json=enableForceTransfer,proto3" json:"enable_force_transfer,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -87,6 +93,20 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetDenomCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DenomCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetEnableForceTransfer() bool {
+	if m != nil {
+		return m.EnableForceTransfer
+	}
+	return false
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -442,6 +462,53 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
+// DenomAuthorityMetadata specifies the authorities of a token factory denom.
+type DenomAuthorityMetadata struct {
+	// admin is the account allowed to mint, burn and manage the denom. An empty
+	// admin means the denom can't be managed anymore.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
+func (m *DenomAuthorityMetadata) String() string { return proto.CompactTextString(m) }
+func (*DenomAuthorityMetadata) ProtoMessage()    {}
+func (*DenomAuthorityMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *DenomAuthorityMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomAuthorityMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomAuthorityMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomAuthorityMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomAuthorityMetadata.Merge(m, src)
+}
+func (m *DenomAuthorityMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomAuthorityMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomAuthorityMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomAuthorityMetadata proto.InternalMessageInfo
+
+func (m *DenomAuthorityMetadata) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -450,57 +517,64 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*DenomAuthorityMetadata)(nil), "cosmos.bank.v1beta1.DenomAuthorityMetadata")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 705 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbf, 0x6f, 0x13, 0x49,
-	0x14, 0xf6, 0xf8, 0x77, 0xc6, 0x39, 0x9d, 0x6e, 0x62, 0xe9, 0x26, 0x3e, 0xdd, 0xda, 0x72, 0x73,
-	0x8e, 0xef, 0x6c, 0xe7, 0xc7, 0x09, 0x09, 0x37, 0x08, 0x87, 0x5f, 0x2e, 0x10, 0x68, 0xa3, 0x08,
-	0x89, 0xc6, 0x1a, 0x7b, 0x07, 0x7b, 0x95, 0xdd, 0x99, 0xd5, 0xce, 0x6c, 0x88, 0x5b, 0x2a, 0x44,
-	0x45, 0x4d, 0x15, 0x51, 0x21, 0x44, 0xe1, 0x22, 0x3d, 0x6d, 0x94, 0x2a, 0xa2, 0x42, 0x29, 0x02,
-	0x72, 0x0a, 0xe7, 0xcf, 0x40, 0x3b, 0xbb, 0x6b, 0x3b, 0xc2, 0xa1, 0x44, 0xa2, 0xb1, 0xe7, 0xbd,
-	0xef, 0x7b, 0xef, 0x7d, 0xf3, 0xf6, 0xdb, 0x85, 0x5a, 0x8f, 0x0b, 0x9b, 0x8b, 0x46, 0x97, 0xb0,
-	0xbd, 0xc6, 0xfe, 0x46, 0x97, 0x4a, 0xb2, 0xa1, 0x82, 0xba, 0xe3, 0x72, 0xc9, 0xd1, 0x4a, 0x80,
-	0xd7, 0x55, 0x2a, 0xc4, 0x0b, 0xf9, 0x3e, 0xef, 0x73, 0x85, 0x37, 0xfc, 0x53, 0x40, 0x2d, 0xac,
-	0x06, 0xd4, 0x4e, 0x00, 0x84, 0x75, 0x01, 0x34, 0x9b, 0x22, 0xe8, 0x74, 0x4a, 0x8f, 0x9b, 0x2c,
-	0xc4, 0xff, 0x0c, 0x71, 0x5b, 0xf4, 0x1b, 0xfb, 0x1b, 0xfe, 0x5f, 0x08, 0xfc, 0x41, 0x6c, 0x93,
-	0xf1, 0x86, 0xfa, 0x0d, 0x52, 0xe5, 0xb7, 0x00, 0xa6, 0x1f, 0x13, 0x97, 0xd8, 0x02, 0xdd, 0x87,
-	0xcb, 0x82, 0x32, 0xa3, 0x43, 0x19, 0xe9, 0x5a, 0xd4, 0xc0, 0xa0, 0x94, 0xa8, 0xe4, 0x36, 0x4b,
-	0xf5, 0x05, 0x9a, 0xeb, 0x3b, 0x94, 0x19, 0x77, 0x03, 0x5e, 0x2b, 0x8e, 0x81, 0x9e, 0x13, 0xb3,
-	0x04, 0x5a, 0x87, 0x79, 0x83, 0x3e, 0x23, 0x9e, 0x25, 0x3b, 0x57, 0x1a, 0xc6, 0x4b, 0xa0, 0x92,
-	0xd5, 0x51, 0x88, 0xcd, 0xb5, 0x68, 0xfe, 0xfd, 0x6a, 0x32, 0xaa, 0xe2, 0x60, 0x50, 0x4d, 0x18,
-	0x7b, 0x8d, 0x83, 0x60, 0x85, 0x81, 0xb2, 0xf2, 0x36, 0xcc, 0xcd, 0xb1, 0x51, 0x1e, 0xa6, 0x0c,
-	0xca, 0xb8, 0x8d, 0x41, 0x09, 0x54, 0x96, 0xf4, 0x20, 0x40, 0x18, 0x66, 0xae, 0x0e, 0x8a, 0xc2,
-	0x66, 0xf2, 0xf2, 0xb0, 0x08, 0xca, 0x27, 0x00, 0xa6, 0xda, 0xcc, 0xf1, 0x24, 0xda, 0x84, 0x19,
-	0x62, 0x18, 0x2e, 0x15, 0x22, 0xe8, 0xd0, 0xc2, 0x9f, 0x8e, 0x6a, 0xf9, 0xf0, 0x9a, 0xb7, 0x03,
-	0x64, 0x47, 0xba, 0x26, 0xeb, 0xeb, 0x11, 0x11, 0x3d, 0x87, 0x29, 0x7f, 0xc3, 0x02, 0xc7, 0xd5,
-	0x56, 0x56, 0x67, 0x5b, 0x11, 0x74, 0xba, 0x95, 0x6d, 0x6e, 0xb2, 0xd6, 0xbd, 0xe3, 0xf3, 0x62,
-	0xec, 0xfd, 0x97, 0x62, 0xa5, 0x6f, 0xca, 0x81, 0xd7, 0xad, 0xf7, 0xb8, 0x1d, 0x3e, 0xbe, 0xc6,
-	0xdc, 0x05, 0xe5, 0xd0, 0xa1, 0x42, 0x15, 0x88, 0x37, 0x93, 0x51, 0x75, 0xd9, 0xa2, 0x7d, 0xd2,
-	0x1b, 0x76, 0xd4, 0x8c, 0x77, 0x93, 0x51, 0x15, 0xe8, 0xc1, 0xbc, 0x66, 0xfe, 0xe5, 0x61, 0x31,
-	0x76, 0x79, 0x58, 0x8c, 0xbd, 0x98, 0x8c, 0xaa, 0x91, 0x9c, 0xf2, 0x47, 0x00, 0xd3, 0x8f, 0x3c,
-	0xf9, 0xcb, 0xdd, 0x26, 0x1b, 0xdd, 0xa6, 0xfc, 0x01, 0xc0, 0xf4, 0x8e, 0xe7, 0x38, 0xd6, 0xd0,
-	0x57, 0x23, 0xb9, 0x24, 0x56, 0xe8, 0xb8, 0x9f, 0xa1, 0x46, 0xcd, 0x6b, 0xae, 0x85, 0x6a, 0xc0,
-	0xc9, 0x51, 0xed, 0xaf, 0x85, 0x36, 0x57, 0x02, 0xdb, 0x18, 0x94, 0x9f, 0xc0, 0xa5, 0x3b, 0xbe,
-	0xcd, 0x76, 0x99, 0x29, 0xaf, 0x31, 0x60, 0x01, 0x66, 0xe9, 0x81, 0xc3, 0x19, 0x65, 0x52, 0x39,
-	0xf0, 0x37, 0x7d, 0x1a, 0xfb, 0xe6, 0x24, 0x96, 0x49, 0x04, 0x15, 0x38, 0x51, 0x4a, 0x54, 0x96,
-	0xf4, 0x28, 0x2c, 0x9f, 0xc5, 0x61, 0xf6, 0x21, 0x95, 0xc4, 0x20, 0x92, 0xa0, 0x12, 0xcc, 0x19,
-	0x54, 0xf4, 0x5c, 0xd3, 0x91, 0x26, 0x67, 0x61, 0xfb, 0xf9, 0x14, 0xba, 0xe5, 0x33, 0x18, 0xb7,
-	0x3b, 0x1e, 0x33, 0x65, 0xf4, 0xfc, 0xb4, 0x85, 0xef, 0xe8, 0x54, 0xaf, 0x0e, 0x8d, 0xe8, 0x28,
-	0x10, 0x82, 0x49, 0x7f, 0xaf, 0x38, 0xa1, 0x7a, 0xab, 0xb3, 0xaf, 0xce, 0x30, 0x85, 0x63, 0x91,
-	0x21, 0x4e, 0xaa, 0x74, 0x14, 0xa2, 0x7f, 0x60, 0x92, 0x11, 0x9b, 0xe2, 0x94, 0x72, 0xd6, 0xca,
-	0xd9, 0x51, 0xed, 0xf7, 0xd9, 0xa2, 0x4b, 0xeb, 0xf5, 0xff, 0xb7, 0x74, 0x45, 0x40, 0xff, 0xc2,
-	0xb4, 0x18, 0xda, 0x5d, 0x6e, 0xe1, 0xf4, 0xf5, 0xd4, 0x90, 0x82, 0xfe, 0x83, 0x09, 0xcf, 0x35,
-	0x71, 0x46, 0x31, 0x0b, 0xe3, 0xf3, 0x62, 0x62, 0x57, 0x6f, 0x7f, 0x5f, 0x70, 0x43, 0xf7, 0x69,
-	0xe8, 0x26, 0xcc, 0x7a, 0xae, 0xd9, 0x19, 0x10, 0x31, 0xc0, 0x59, 0x55, 0xa2, 0x8d, 0xcf, 0x8b,
-	0x99, 0x5d, 0xbd, 0xfd, 0x80, 0x88, 0xc1, 0xa2, 0xb2, 0x8c, 0xe7, 0x9a, 0x3e, 0xd6, 0xda, 0x3e,
-	0x1e, 0x6b, 0xe0, 0x74, 0xac, 0x81, 0xaf, 0x63, 0x0d, 0xbc, 0xbe, 0xd0, 0x62, 0xa7, 0x17, 0x5a,
-	0xec, 0xf3, 0x85, 0x16, 0x7b, 0xba, 0xf6, 0x43, 0x07, 0x85, 0x9f, 0x1f, 0x65, 0xa4, 0x6e, 0x5a,
-	0x7d, 0x29, 0xb7, 0xbe, 0x05, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x05, 0x03, 0x28, 0xdd, 0x05, 0x00,
-	0x00,
+	// 813 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xc4, 0xf1, 0x8f, 0x4c, 0x8a, 0x80, 0x89, 0xa1, 0xdb, 0x20, 0xd6, 0xd6, 0x5e, 0x48,
+	0x03, 0xb1, 0x9b, 0xb6, 0x20, 0xe1, 0x0b, 0xaa, 0x03, 0x29, 0x39, 0x20, 0xd0, 0xa6, 0x11, 0x12,
+	0x97, 0xd5, 0xd8, 0x3b, 0xb6, 0x47, 0xdd, 0x9d, 0x59, 0xed, 0xcc, 0x96, 0xfa, 0xca, 0x09, 0xc1,
+	0x85, 0x33, 0x12, 0x52, 0x8e, 0x08, 0x71, 0xf0, 0x21, 0x77, 0xae, 0x55, 0x4f, 0x55, 0x4f, 0xa8,
+	0x87, 0x80, 0x9c, 0x83, 0xfb, 0x67, 0xa0, 0xf9, 0xb1, 0xb6, 0x2b, 0xbb, 0x44, 0x5c, 0x90, 0x7a,
+	0xf1, 0xee, 0x9b, 0xef, 0x7b, 0x6f, 0xbe, 0xf9, 0xf6, 0xbd, 0x31, 0x74, 0x7b, 0x5c, 0xc4, 0x5c,
+	0xb4, 0xba, 0x98, 0xdd, 0x6f, 0x3d, 0xd8, 0xef, 0x12, 0x89, 0xf7, 0x75, 0xd0, 0x4c, 0x52, 0x2e,
+	0x39, 0xda, 0x32, 0x78, 0x53, 0x2f, 0x59, 0x7c, 0xbb, 0x36, 0xe0, 0x03, 0xae, 0xf1, 0x96, 0x7a,
+	0x33, 0xd4, 0xed, 0x6b, 0x86, 0x1a, 0x18, 0xc0, 0xe6, 0x19, 0x68, 0xbe, 0x8b, 0x20, 0xb3, 0x5d,
+	0x7a, 0x9c, 0x32, 0x8b, 0x5f, 0xb5, 0x78, 0x2c, 0x06, 0xad, 0x07, 0xfb, 0xea, 0x61, 0x81, 0x37,
+	0x71, 0x4c, 0x19, 0x6f, 0xe9, 0x5f, 0xb3, 0xe4, 0xfd, 0x58, 0x84, 0xe5, 0xaf, 0x70, 0x8a, 0x63,
+	0x81, 0xee, 0xc2, 0x2b, 0x82, 0xb0, 0x30, 0x20, 0x0c, 0x77, 0x23, 0x12, 0x3a, 0xa0, 0x51, 0xdc,
+	0xd9, 0xbc, 0xd9, 0x68, 0xae, 0xd0, 0xdc, 0x3c, 0x26, 0x2c, 0xfc, 0xcc, 0xf0, 0x3a, 0x6b, 0x0e,
+	0xf0, 0x37, 0xc5, 0x7c, 0x01, 0xdd, 0x80, 0xb5, 0x90, 0xf4, 0x71, 0x16, 0xc9, 0xe0, 0x85, 0x82,
+	0x6b, 0x0d, 0xb0, 0x53, 0xf5, 0x91, 0xc5, 0x16, 0x4a, 0xa0, 0x5f, 0x00, 0x44, 0x21, 0x61, 0x3c,
+	0x0e, 0x7a, 0x29, 0xc1, 0x92, 0x72, 0x16, 0xf4, 0x09, 0x71, 0x8a, 0x5a, 0xc1, 0xb5, 0xb9, 0x02,
+	0x41, 0x66, 0x0a, 0x0e, 0x38, 0x65, 0x9d, 0x7b, 0x8f, 0xce, 0xeb, 0x85, 0xdf, 0xfe, 0xaa, 0xef,
+	0x0c, 0xa8, 0x1c, 0x66, 0xdd, 0x66, 0x8f, 0xc7, 0xd6, 0x2a, 0xfb, 0xd8, 0x13, 0xe1, 0xfd, 0x96,
+	0x1c, 0x25, 0x44, 0xe8, 0x04, 0xf1, 0xec, 0x6c, 0xef, 0xf5, 0x39, 0xd2, 0xb8, 0xd1, 0xfc, 0xf0,
+	0xf6, 0xcf, 0xd3, 0xf1, 0xee, 0x95, 0x88, 0x0c, 0x70, 0x6f, 0x14, 0x28, 0x13, 0x85, 0xff, 0x86,
+	0x96, 0x72, 0x60, 0x95, 0x1c, 0x12, 0x82, 0xee, 0xc2, 0xb7, 0xcc, 0x21, 0x82, 0x3e, 0x4f, 0x7b,
+	0x24, 0x90, 0x29, 0x66, 0xa2, 0x4f, 0x52, 0x67, 0x5d, 0x1d, 0xa9, 0xb3, 0xb5, 0xa2, 0xac, 0xbf,
+	0x65, 0x32, 0x0e, 0x55, 0xc2, 0x3d, 0xcb, 0x6f, 0xbf, 0xfb, 0xc3, 0x74, 0xbc, 0xeb, 0x2c, 0x68,
+	0x7b, 0x68, 0x7a, 0xc5, 0x7c, 0x02, 0xef, 0x00, 0x6e, 0x2e, 0xda, 0x52, 0x83, 0x25, 0x2d, 0xc5,
+	0x01, 0x0d, 0xb0, 0xb3, 0xe1, 0x9b, 0x00, 0x39, 0xb0, 0xf2, 0xa2, 0xa3, 0x79, 0xd8, 0x5e, 0x7f,
+	0x7e, 0x5a, 0x07, 0xde, 0x63, 0x00, 0x4b, 0x47, 0x2c, 0xc9, 0x24, 0xba, 0x09, 0x2b, 0x38, 0x0c,
+	0x53, 0x22, 0x84, 0xa9, 0xd0, 0x71, 0x9e, 0x9e, 0xed, 0xd5, 0xac, 0x9b, 0x77, 0x0c, 0x72, 0x2c,
+	0x53, 0xca, 0x06, 0x7e, 0x4e, 0x44, 0xdf, 0xc2, 0x92, 0x76, 0xc1, 0x59, 0xbb, 0xcc, 0xfc, 0xc3,
+	0xff, 0x6a, 0xfe, 0x92, 0xd3, 0xbf, 0x4e, 0xc7, 0xbb, 0xc0, 0x37, 0xfb, 0xb5, 0x6b, 0xdf, 0x9f,
+	0xd6, 0x0b, 0xcf, 0x4f, 0xeb, 0x85, 0xef, 0xa6, 0xe3, 0xdd, 0x5c, 0x8e, 0xf7, 0x07, 0x80, 0xe5,
+	0x2f, 0x33, 0xf9, 0xca, 0x9d, 0xa6, 0x9a, 0x9f, 0xc6, 0xfb, 0x1d, 0xc0, 0xf2, 0x71, 0x96, 0x24,
+	0xd1, 0x48, 0xa9, 0x91, 0x5c, 0xe2, 0xc8, 0x8e, 0xd6, 0xff, 0xa1, 0x46, 0xef, 0xd7, 0xbe, 0x6e,
+	0xd5, 0x80, 0xc7, 0x67, 0x7b, 0xef, 0xac, 0x9c, 0x67, 0x2d, 0xf0, 0xc8, 0x01, 0xde, 0xd7, 0x70,
+	0xe3, 0x53, 0xd5, 0x66, 0x27, 0x8c, 0xca, 0x97, 0x34, 0xe0, 0x36, 0xac, 0x92, 0x87, 0x09, 0x67,
+	0x84, 0x49, 0xdd, 0x81, 0xaf, 0xf9, 0xb3, 0x58, 0x35, 0x27, 0x8e, 0x28, 0x16, 0x44, 0xe8, 0xe9,
+	0xdd, 0xf0, 0xf3, 0xd0, 0x7b, 0xb6, 0x06, 0xab, 0x5f, 0x10, 0x89, 0x43, 0x2c, 0x31, 0x6a, 0xc0,
+	0xcd, 0x90, 0x88, 0x5e, 0x4a, 0x13, 0x35, 0x62, 0xb6, 0xfc, 0xe2, 0x12, 0xfa, 0x44, 0x31, 0xd4,
+	0x8d, 0x90, 0x31, 0x2a, 0xf3, 0xef, 0xe7, 0xae, 0xbc, 0x8c, 0x66, 0x7a, 0x7d, 0x18, 0xe6, 0xaf,
+	0x02, 0x21, 0xb8, 0xae, 0x7c, 0x75, 0x8a, 0xba, 0xb6, 0x7e, 0x57, 0xea, 0x42, 0x2a, 0x92, 0x08,
+	0x8f, 0xf4, 0xe4, 0x6e, 0xf8, 0x79, 0x88, 0xde, 0x83, 0xeb, 0x0c, 0xc7, 0xc4, 0x29, 0xe9, 0xce,
+	0x5a, 0x1e, 0xe8, 0xdb, 0xb7, 0x7c, 0x4d, 0x40, 0xef, 0xc3, 0xb2, 0x18, 0xc5, 0x5d, 0x1e, 0x39,
+	0xe5, 0x97, 0x53, 0x2d, 0x05, 0x7d, 0x00, 0x8b, 0x59, 0x4a, 0x9d, 0x8a, 0x66, 0x6e, 0x4f, 0xce,
+	0xeb, 0xc5, 0x13, 0xff, 0x68, 0x39, 0xe1, 0x23, 0x5f, 0xd1, 0xd0, 0xc7, 0xb0, 0x9a, 0xa5, 0x34,
+	0x18, 0x62, 0x31, 0x74, 0xaa, 0x3a, 0xc5, 0x9d, 0x9c, 0xd7, 0x2b, 0x27, 0xfe, 0xd1, 0xe7, 0x58,
+	0x0c, 0x57, 0xa5, 0x55, 0xb2, 0x94, 0x2a, 0xcc, 0xc3, 0xf0, 0x6d, 0xed, 0xc2, 0x9d, 0x4c, 0x0e,
+	0x79, 0x4a, 0xe5, 0x68, 0xe6, 0x74, 0x13, 0x96, 0x70, 0x18, 0x53, 0x76, 0xe9, 0xcc, 0x18, 0x5a,
+	0xfb, 0xaa, 0x6a, 0x93, 0xa7, 0xcb, 0xf7, 0x59, 0xe7, 0xe0, 0xd1, 0xc4, 0x05, 0x4f, 0x26, 0x2e,
+	0xf8, 0x7b, 0xe2, 0x82, 0x9f, 0x2e, 0xdc, 0xc2, 0x93, 0x0b, 0xb7, 0xf0, 0xe7, 0x85, 0x5b, 0xf8,
+	0xe6, 0xfa, 0xbf, 0x36, 0xa9, 0xbd, 0xe1, 0x74, 0xaf, 0x76, 0xcb, 0xfa, 0x5f, 0xe7, 0xd6, 0x3f,
+	0x01, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x6c, 0xc2, 0x9e, 0x29, 0x07, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -559,6 +633,30 @@ func (this *Supply) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomAuthorityMetadata)
+	if !ok {
+		that2, ok := that.(DenomAuthorityMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Admin != that1.Admin {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -579,6 +677,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EnableForceTransfer {
+		i--
+		if m.EnableForceTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DenomCreationFee) > 0 {
+		for iNdEx := len(m.DenomCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.DefaultSendEnabled {
 		i--
 		if m.DefaultSendEnabled {
@@ -901,6 +1023,36 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomAuthorityMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -927,6 +1079,15 @@ func (m *Params) Size() (n int) {
 	if m.DefaultSendEnabled {
 		n += 2
 	}
+	if len(m.DenomCreationFee) > 0 {
+		for _, e := range m.DenomCreationFee {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if m.EnableForceTransfer {
+		n += 2
+	}
 	return n
 }
 
@@ -1064,6 +1225,19 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *DenomAuthorityMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1153,6 +1327,60 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCreationFee = append(m.DenomCreationFee, types.Coin{})
+			if err := m.DenomCreationFee[len(m.DenomCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableForceTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableForceTransfer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -2033,6 +2261,88 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomAuthorityMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomAuthorityMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
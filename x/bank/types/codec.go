@@ -16,6 +16,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgMultiSend{}, "cosmos-sdk/MsgMultiSend")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/bank/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgSetSendEnabled{}, "cosmos-sdk/MsgSetSendEnabled")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateDenom{}, "cosmos-sdk/x/bank/MsgCreateDenom")
+	legacy.RegisterAminoMsg(cdc, &MsgMint{}, "cosmos-sdk/x/bank/MsgMint")
+	legacy.RegisterAminoMsg(cdc, &MsgBurn{}, "cosmos-sdk/x/bank/MsgBurn")
+	legacy.RegisterAminoMsg(cdc, &MsgChangeDenomAdmin{}, "cosmos-sdk/x/bank/MsgChangeDenomAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomMetadata{}, "cosmos-sdk/x/bank/MsgSetDenomMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgForceTransfer{}, "cosmos-sdk/x/bank/MsgForceTransfer")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateDenomMetadata{}, "cosmos-sdk/MsgCreateDenomMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateDenomMetadata{}, "cosmos-sdk/MsgUpdateDenomMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgDeleteDenomMetadata{}, "cosmos-sdk/MsgDeleteDenomMetadata")
 
 	cdc.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization", nil)
 	cdc.RegisterConcrete(&Params{}, "cosmos-sdk/x/bank/Params", nil)
@@ -26,6 +35,15 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSend{},
 		&MsgMultiSend{},
 		&MsgUpdateParams{},
+		&MsgCreateDenom{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgChangeDenomAdmin{},
+		&MsgSetDenomMetadata{},
+		&MsgForceTransfer{},
+		&MsgCreateDenomMetadata{},
+		&MsgUpdateDenomMetadata{},
+		&MsgDeleteDenomMetadata{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
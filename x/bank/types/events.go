@@ -21,6 +21,18 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// token factory events name and attributes
+	EventTypeCreateDenom      = "create_denom"
+	EventTypeChangeDenomAdmin = "change_denom_admin"
+
+	// denom metadata events name
+	EventTypeSetDenomMetadata    = "set_denom_metadata"
+	EventTypeDeleteDenomMetadata = "delete_denom_metadata"
+
+	AttributeKeyDenom    = "denom"
+	AttributeKeyCreator  = "creator"
+	AttributeKeyNewAdmin = "new_admin"
 )
 
 // NewCoinSpentEvent constructs a new coin spent sdk.Event
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FactoryDenomPrefix is the prefix of the denoms created through the token factory.
+	FactoryDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of the subdenom of a token factory denom.
+	MaxSubdenomLength = 44
+)
+
+// GetFactoryDenom returns the token factory denom created by creator for the
+// given subdenom, of the form factory/{creator}/{subdenom}.
+func GetFactoryDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) == 0 {
+		return "", ErrInvalidFactoryDenom.Wrap("subdenom cannot be empty")
+	}
+
+	if len(subdenom) > MaxSubdenomLength {
+		return "", ErrInvalidFactoryDenom.Wrapf("subdenom is longer than %d characters", MaxSubdenomLength)
+	}
+
+	if strings.Contains(creator, "/") {
+		return "", ErrInvalidFactoryDenom.Wrapf("invalid creator %s", creator)
+	}
+
+	denom := strings.Join([]string{FactoryDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", ErrInvalidFactoryDenom.Wrap(err.Error())
+	}
+
+	return denom, nil
+}
+
+// ParseFactoryDenom splits a token factory denom into its creator and subdenom.
+// The subdenom may itself contain slashes.
+func ParseFactoryDenom(denom string) (creator, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", ErrInvalidFactoryDenom.Wrap(err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 || parts[0] != FactoryDenomPrefix {
+		return "", "", ErrInvalidFactoryDenom.Wrapf("denom %s is not of the form %s/{creator}/{subdenom}", denom, FactoryDenomPrefix)
+	}
+
+	creator, subdenom = parts[1], parts[2]
+	if creator == "" || subdenom == "" {
+		return "", "", ErrInvalidFactoryDenom.Wrapf("denom %s is not of the form %s/{creator}/{subdenom}", denom, FactoryDenomPrefix)
+	}
+
+	if len(subdenom) > MaxSubdenomLength {
+		return "", "", ErrInvalidFactoryDenom.Wrapf("subdenom is longer than %d characters", MaxSubdenomLength)
+	}
+
+	return creator, subdenom, nil
+}
+
+// Validate performs basic validation of the token factory denom, decoding its
+// creator and admin with the given address codec.
+func (fd FactoryDenom) Validate(addressCodec address.Codec) error {
+	creator, _, err := ParseFactoryDenom(fd.Denom)
+	if err != nil {
+		return err
+	}
+
+	if _, err := addressCodec.StringToBytes(creator); err != nil {
+		return fmt.Errorf("invalid creator of denom %s: %w", fd.Denom, err)
+	}
+
+	if fd.AuthorityMetadata.Admin != "" {
+		if _, err := addressCodec.StringToBytes(fd.AuthorityMetadata.Admin); err != nil {
+			return fmt.Errorf("invalid admin of denom %s: %w", fd.Denom, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestFactoryDenom(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+
+	testCases := []struct {
+		name     string
+		subdenom string
+		expErr   bool
+	}{
+		{"valid", "token", false},
+		{"valid with slashes", "my/token", false},
+		{"empty subdenom", "", true},
+		{"subdenom too long", strings.Repeat("a", types.MaxSubdenomLength+1), true},
+		{"invalid characters", "tok%en", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			denom, err := types.GetFactoryDenom(creator, tc.subdenom)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidFactoryDenom)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "factory/"+creator+"/"+tc.subdenom, denom)
+
+			gotCreator, gotSubdenom, err := types.ParseFactoryDenom(denom)
+			require.NoError(t, err)
+			require.Equal(t, creator, gotCreator)
+			require.Equal(t, tc.subdenom, gotSubdenom)
+		})
+	}
+
+	for _, denom := range []string{"stake", "ibc/" + creator, "factory/" + creator, "factory//token"} {
+		_, _, err := types.ParseFactoryDenom(denom)
+		require.ErrorIs(t, err, types.ErrInvalidFactoryDenom, denom)
+	}
+
+	ac := addresscodec.NewBech32Codec(sdk.Bech32MainPrefix)
+	require.NoError(t, types.FactoryDenom{Denom: "factory/" + creator + "/token", AuthorityMetadata: types.DenomAuthorityMetadata{Admin: creator}}.Validate(ac))
+	require.NoError(t, types.FactoryDenom{Denom: "factory/" + creator + "/token"}.Validate(ac))
+	require.Error(t, types.FactoryDenom{Denom: "factory/creator/token"}.Validate(ac))
+	require.Error(t, types.FactoryDenom{Denom: "factory/" + creator + "/token", AuthorityMetadata: types.DenomAuthorityMetadata{Admin: "admin"}}.Validate(ac))
+	require.Error(t, types.FactoryDenom{Denom: "factory/" + creator + "/token"}.Validate(addresscodec.NewBech32Codec("osmo")))
+}
@@ -62,6 +62,21 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	seenFactoryDenoms := make(map[string]bool)
+	for _, fd := range gs.FactoryDenoms {
+		if seenFactoryDenoms[fd.Denom] {
+			return fmt.Errorf("duplicate token factory denom %s", fd.Denom)
+		}
+
+		// the creator and admin addresses are validated with the address
+		// codec of the chain in InitGenesis
+		if _, _, err := ParseFactoryDenom(fd.Denom); err != nil {
+			return err
+		}
+
+		seenFactoryDenoms[fd.Denom] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -40,6 +40,8 @@ type GenesisState struct {
 	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
 	// send_enabled defines the denoms where send is enabled or disabled.
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// factory_denoms defines the denoms created through the token factory.
+	FactoryDenoms []FactoryDenom `protobuf:"bytes,6,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +112,13 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetFactoryDenoms() []FactoryDenom {
+	if m != nil {
+		return m.FactoryDenoms
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -152,45 +161,106 @@ func (m *Balance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Balance proto.InternalMessageInfo
 
+// FactoryDenom defines a token factory denom and its authorities, used in the
+// bank module's genesis state.
+type FactoryDenom struct {
+	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata"`
+}
+
+func (m *FactoryDenom) Reset()         { *m = FactoryDenom{} }
+func (m *FactoryDenom) String() string { return proto.CompactTextString(m) }
+func (*FactoryDenom) ProtoMessage()    {}
+func (*FactoryDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f007de11b420c6e, []int{2}
+}
+func (m *FactoryDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FactoryDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FactoryDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FactoryDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FactoryDenom.Merge(m, src)
+}
+func (m *FactoryDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FactoryDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FactoryDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FactoryDenom proto.InternalMessageInfo
+
+func (m *FactoryDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FactoryDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
+	if m != nil {
+		return m.AuthorityMetadata
+	}
+	return DenomAuthorityMetadata{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.bank.v1beta1.GenesisState")
 	proto.RegisterType((*Balance)(nil), "cosmos.bank.v1beta1.Balance")
+	proto.RegisterType((*FactoryDenom)(nil), "cosmos.bank.v1beta1.FactoryDenom")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xbf, 0x6e, 0xd3, 0x50,
-	0x14, 0xc6, 0x6d, 0x42, 0xd3, 0xf6, 0x26, 0x80, 0x30, 0x1d, 0xdc, 0x52, 0x9c, 0xd0, 0x29, 0x54,
-	0x8a, 0x4d, 0x03, 0x12, 0x12, 0x03, 0x12, 0x8e, 0x80, 0x09, 0x81, 0x9a, 0x8d, 0x25, 0xba, 0xb6,
-	0x8f, 0x8c, 0xd5, 0xf8, 0x5e, 0x2b, 0xe7, 0x16, 0xc8, 0x1b, 0x30, 0x32, 0x33, 0x75, 0x44, 0x4c,
-	0x1d, 0xfa, 0x00, 0x88, 0xa9, 0x63, 0xc5, 0x84, 0x18, 0x00, 0x25, 0x43, 0x79, 0x0c, 0xe4, 0x7b,
-	0x6e, 0xfe, 0x48, 0x44, 0x8c, 0x5d, 0x6c, 0xcb, 0xdf, 0x77, 0x7e, 0xdf, 0x39, 0xe7, 0xda, 0xec,
-	0x76, 0x2c, 0x31, 0x97, 0x18, 0x44, 0x5c, 0x1c, 0x04, 0x6f, 0xf6, 0x22, 0x50, 0x7c, 0x2f, 0x48,
-	0x41, 0x00, 0x66, 0xe8, 0x17, 0x43, 0xa9, 0xa4, 0x73, 0x83, 0x2c, 0x7e, 0x69, 0xf1, 0x8d, 0x65,
-	0x6b, 0x23, 0x95, 0xa9, 0xd4, 0x7a, 0x50, 0x3e, 0x91, 0x75, 0xcb, 0x9b, 0xd1, 0x10, 0x66, 0xb4,
-	0x58, 0x66, 0xe2, 0x1f, 0x7d, 0x21, 0x4d, 0x73, 0x49, 0xdf, 0x24, 0xbd, 0x4f, 0x60, 0x93, 0x4b,
-	0xd2, 0x75, 0x9e, 0x67, 0x42, 0x06, 0xfa, 0x4a, 0xaf, 0x76, 0xbe, 0x56, 0x58, 0xfd, 0x19, 0xb5,
-	0xda, 0x53, 0x5c, 0x81, 0xf3, 0x88, 0x55, 0x0b, 0x3e, 0xe4, 0x39, 0xba, 0x76, 0xd3, 0x6e, 0xd5,
-	0x3a, 0x37, 0xfd, 0x25, 0xad, 0xfb, 0x2f, 0xb5, 0x25, 0x5c, 0x3f, 0xfd, 0xd9, 0xb0, 0x3e, 0x9d,
-	0x1f, 0xef, 0xda, 0xfb, 0xa6, 0xca, 0xe9, 0xb2, 0xb5, 0x88, 0x0f, 0xb8, 0x88, 0x01, 0xdd, 0x4b,
-	0xcd, 0x4a, 0xab, 0xd6, 0xd9, 0x5e, 0x4a, 0x08, 0xc9, 0xb4, 0x88, 0x98, 0x15, 0x3a, 0x23, 0x56,
-	0xc5, 0xc3, 0xa2, 0x18, 0x8c, 0xdc, 0x8a, 0x46, 0x6c, 0xce, 0x11, 0x08, 0x33, 0x44, 0x57, 0x66,
-	0x22, 0x7c, 0x5a, 0xd6, 0x7f, 0xfe, 0xd5, 0x68, 0xa5, 0x99, 0x7a, 0x7d, 0x18, 0xf9, 0xb1, 0xcc,
-	0xcd, 0xd0, 0xe6, 0xd6, 0xc6, 0xe4, 0x20, 0x50, 0xa3, 0x02, 0x50, 0x17, 0xe0, 0xc7, 0xf3, 0xe3,
-	0xdd, 0xfa, 0x00, 0x52, 0x1e, 0x8f, 0xfa, 0xe5, 0x5a, 0xd1, 0xf4, 0x4f, 0x81, 0xce, 0x0b, 0x76,
-	0x35, 0x01, 0x21, 0xf3, 0x7e, 0x0e, 0x8a, 0x27, 0x5c, 0x71, 0xf7, 0xb2, 0x6e, 0xe1, 0xd6, 0xd2,
-	0x29, 0x9e, 0x1b, 0xd3, 0xe2, 0x18, 0x57, 0x74, 0xfd, 0x54, 0x71, 0x38, 0xab, 0x23, 0x88, 0xa4,
-	0x0f, 0x82, 0x47, 0x03, 0x48, 0xdc, 0x15, 0x8d, 0x6b, 0x2e, 0xc5, 0xf5, 0x40, 0x24, 0x4f, 0xc8,
-	0x17, 0x6e, 0x97, 0xc4, 0x1f, 0x27, 0xed, 0x6b, 0xf3, 0x31, 0x9a, 0x77, 0xfd, 0xfb, 0x0f, 0x28,
-	0xa4, 0x86, 0x73, 0xeb, 0xce, 0x17, 0x9b, 0xad, 0x9a, 0x7d, 0x3a, 0x1d, 0xb6, 0xca, 0x93, 0x64,
-	0x08, 0x48, 0x07, 0xb8, 0x1e, 0xba, 0xdf, 0x4e, 0xda, 0x1b, 0x26, 0xec, 0x31, 0x29, 0x3d, 0x35,
-	0xcc, 0x44, 0xba, 0x3f, 0x35, 0x3a, 0x6f, 0xd9, 0x8a, 0xde, 0x84, 0x39, 0xb0, 0x0b, 0xd8, 0x36,
-	0xe5, 0x3d, 0x5c, 0x7b, 0x7f, 0xd4, 0xb0, 0xfe, 0x1c, 0x35, 0xac, 0xb0, 0x7b, 0x3a, 0xf6, 0xec,
-	0xb3, 0xb1, 0x67, 0xff, 0x1e, 0x7b, 0xf6, 0x87, 0x89, 0x67, 0x9d, 0x4d, 0x3c, 0xeb, 0xfb, 0xc4,
-	0xb3, 0x5e, 0xdd, 0xf9, 0x6f, 0xd4, 0x3b, 0xfa, 0x0f, 0x74, 0x62, 0x54, 0xd5, 0xdf, 0xf4, 0xbd,
-	0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x58, 0x75, 0x60, 0x91, 0x03, 0x00, 0x00,
+	// 571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x18, 0xb5, 0xdb, 0x24, 0x6d, 0x2f, 0x69, 0x51, 0xaf, 0x91, 0x70, 0x4b, 0x71, 0xd2, 0x4c, 0xa1,
+	0x28, 0x36, 0x0d, 0x45, 0x48, 0x1d, 0x90, 0xea, 0x40, 0x99, 0x10, 0x28, 0xd9, 0x58, 0xc2, 0xd9,
+	0xbe, 0xba, 0x56, 0xe3, 0xbb, 0xc8, 0x77, 0x01, 0xfc, 0x1f, 0x30, 0x32, 0xc3, 0xd2, 0x05, 0x09,
+	0x31, 0x75, 0xe8, 0x1f, 0xc0, 0xd8, 0xb1, 0xea, 0x84, 0x18, 0x00, 0x25, 0x43, 0xf9, 0x33, 0x90,
+	0xef, 0x2e, 0x3f, 0x44, 0x2d, 0x46, 0x96, 0xfc, 0xf0, 0x7b, 0xdf, 0x7b, 0xdf, 0xf7, 0xee, 0x3b,
+	0x83, 0x2d, 0x8f, 0xb2, 0x88, 0x32, 0xdb, 0x45, 0xe4, 0xd8, 0x7e, 0xbd, 0xe3, 0x62, 0x8e, 0x76,
+	0xec, 0x00, 0x13, 0xcc, 0x42, 0x66, 0xf5, 0x63, 0xca, 0x29, 0x5c, 0x93, 0x14, 0x2b, 0xa5, 0x58,
+	0x8a, 0xb2, 0x51, 0x0e, 0x68, 0x40, 0x05, 0x6e, 0xa7, 0xbf, 0x24, 0x75, 0xc3, 0x9c, 0xa8, 0x31,
+	0x3c, 0x51, 0xf3, 0x68, 0x48, 0xae, 0xe1, 0x33, 0x6e, 0x42, 0x57, 0xe2, 0xeb, 0x12, 0xef, 0x4a,
+	0x61, 0xe5, 0x2b, 0xa1, 0x55, 0x14, 0x85, 0x84, 0xda, 0xe2, 0x53, 0x3e, 0xaa, 0x7d, 0xca, 0x81,
+	0xd2, 0x53, 0xd9, 0x6a, 0x87, 0x23, 0x8e, 0xe1, 0x23, 0x50, 0xe8, 0xa3, 0x18, 0x45, 0xcc, 0xd0,
+	0xab, 0x7a, 0xbd, 0xd8, 0xbc, 0x65, 0x65, 0xb4, 0x6e, 0xbd, 0x10, 0x14, 0x67, 0xe9, 0xfc, 0x47,
+	0x45, 0xfb, 0x7c, 0x75, 0xba, 0xad, 0xb7, 0x55, 0x15, 0x6c, 0x81, 0x45, 0x17, 0xf5, 0x10, 0xf1,
+	0x30, 0x33, 0xe6, 0xaa, 0xf3, 0xf5, 0x62, 0x73, 0x33, 0x53, 0xc1, 0x91, 0xa4, 0x59, 0x89, 0x49,
+	0x21, 0x4c, 0x40, 0x81, 0x0d, 0xfa, 0xfd, 0x5e, 0x62, 0xcc, 0x0b, 0x89, 0xf5, 0xa9, 0x04, 0xc3,
+	0x13, 0x89, 0x16, 0x0d, 0x89, 0x73, 0x90, 0xd6, 0x7f, 0xf9, 0x59, 0xa9, 0x07, 0x21, 0x3f, 0x1a,
+	0xb8, 0x96, 0x47, 0x23, 0x35, 0xb4, 0xfa, 0x6a, 0x30, 0xff, 0xd8, 0xe6, 0x49, 0x1f, 0x33, 0x51,
+	0xc0, 0x3e, 0x5c, 0x9d, 0x6e, 0x97, 0x7a, 0x38, 0x40, 0x5e, 0xd2, 0x4d, 0x63, 0x65, 0xaa, 0x7f,
+	0x69, 0x08, 0x9f, 0x83, 0x15, 0x1f, 0x13, 0x1a, 0x75, 0x23, 0xcc, 0x91, 0x8f, 0x38, 0x32, 0x72,
+	0xa2, 0x85, 0xdb, 0x99, 0x53, 0x3c, 0x53, 0xa4, 0xd9, 0x31, 0x96, 0x45, 0xfd, 0x18, 0x81, 0x08,
+	0x94, 0x18, 0x26, 0x7e, 0x17, 0x13, 0xe4, 0xf6, 0xb0, 0x6f, 0xe4, 0x85, 0x5c, 0x35, 0x53, 0xae,
+	0x83, 0x89, 0xff, 0x44, 0xf2, 0x9c, 0xcd, 0x54, 0xf1, 0xfb, 0x59, 0xe3, 0xc6, 0x74, 0x8c, 0xea,
+	0x3d, 0x6b, 0xf7, 0xa1, 0x34, 0x29, 0xb2, 0x29, 0x15, 0x22, 0xb0, 0x72, 0x88, 0x3c, 0x4e, 0xe3,
+	0xa4, 0x2b, 0xbc, 0x99, 0x51, 0x10, 0x26, 0x5b, 0x99, 0x26, 0x07, 0x92, 0xfa, 0x38, 0x65, 0x3a,
+	0x37, 0x33, 0x5d, 0x1e, 0xec, 0xb6, 0x97, 0x0f, 0x67, 0x68, 0xac, 0xf6, 0x55, 0x07, 0x0b, 0xea,
+	0xc8, 0x60, 0x13, 0x2c, 0x20, 0xdf, 0x8f, 0x31, 0x93, 0x3b, 0xb2, 0xe4, 0x18, 0x97, 0x67, 0x8d,
+	0xb2, 0xb2, 0xda, 0x97, 0x48, 0x87, 0xc7, 0x21, 0x09, 0xda, 0x63, 0x22, 0x7c, 0x03, 0xf2, 0x22,
+	0x6c, 0xb5, 0x13, 0xff, 0xe1, 0x40, 0xa5, 0xdf, 0xde, 0xe2, 0xbb, 0x93, 0x8a, 0xf6, 0xfb, 0xa4,
+	0xa2, 0xd5, 0x3e, 0xea, 0xa0, 0x34, 0x3b, 0x3b, 0x2c, 0x83, 0xbc, 0x88, 0x4b, 0x4e, 0xd1, 0x96,
+	0x7f, 0xe0, 0x2b, 0x00, 0xd1, 0x80, 0x1f, 0xd1, 0x38, 0xe4, 0xc9, 0x74, 0x09, 0xe6, 0xc4, 0x65,
+	0xb8, 0x9b, 0x19, 0xa8, 0x50, 0xdb, 0x1f, 0xd7, 0x4c, 0x56, 0x22, 0x97, 0x0e, 0xd2, 0x5e, 0x45,
+	0x7f, 0x03, 0x7b, 0x6b, 0x97, 0xd7, 0xf3, 0x76, 0x5a, 0xe7, 0x43, 0x53, 0xbf, 0x18, 0x9a, 0xfa,
+	0xaf, 0xa1, 0xa9, 0xbf, 0x1f, 0x99, 0xda, 0xc5, 0xc8, 0xd4, 0xbe, 0x8d, 0x4c, 0xed, 0xe5, 0x9d,
+	0x7f, 0x06, 0xf1, 0x56, 0xbe, 0x08, 0x44, 0x1e, 0x6e, 0x41, 0x5c, 0xea, 0xfb, 0x7f, 0x02, 0x00,
+	0x00, 0xff, 0xff, 0x71, 0x38, 0xb9, 0xd8, 0x92, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -213,6 +283,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FactoryDenoms) > 0 {
+		for iNdEx := len(m.FactoryDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FactoryDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -326,6 +410,46 @@ func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FactoryDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FactoryDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FactoryDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -369,6 +493,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FactoryDenoms) > 0 {
+		for _, e := range m.FactoryDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -391,6 +521,21 @@ func (m *Balance) Size() (n int) {
 	return n
 }
 
+func (m *FactoryDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.AuthorityMetadata.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -595,6 +740,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FactoryDenoms = append(m.FactoryDenoms, FactoryDenom{})
+			if err := m.FactoryDenoms[len(m.FactoryDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -732,6 +911,121 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FactoryDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FactoryDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FactoryDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
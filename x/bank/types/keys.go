@@ -35,6 +35,9 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// DenomAuthorityMetadataPrefix is the prefix for the authorities of token factory denoms.
+	DenomAuthorityMetadataPrefix = collections.NewPrefix(6)
 )
 
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
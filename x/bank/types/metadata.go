@@ -1,8 +1,10 @@
 package types
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"net/url"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -77,6 +79,32 @@ func (m Metadata) Validate() error {
 	return nil
 }
 
+// ValidateURI checks that the URI of the metadata, if any, is an absolute URI
+// and that its hash, if any, is a hex encoded SHA-256 hash of a document at
+// that URI.
+func (m Metadata) ValidateURI() error {
+	if m.URI != "" {
+		u, err := url.ParseRequestURI(m.URI)
+		if err != nil {
+			return fmt.Errorf("invalid metadata uri: %w", err)
+		}
+		if u.Scheme == "" {
+			return fmt.Errorf("metadata uri %s must be absolute", m.URI)
+		}
+	}
+
+	if m.URIHash != "" {
+		if m.URI == "" {
+			return errors.New("metadata uri hash requires an uri")
+		}
+		if bz, err := hex.DecodeString(m.URIHash); err != nil || len(bz) != 32 {
+			return fmt.Errorf("metadata uri hash %s must be a hex encoded SHA-256 hash", m.URIHash)
+		}
+	}
+
+	return nil
+}
+
 // Validate performs a basic validation of the denomination unit fields
 func (du DenomUnit) Validate() error {
 	if err := sdk.ValidateDenom(du.Denom); err != nil {
@@ -273,3 +273,31 @@ func TestMarshalJSONMetaData(t *testing.T) {
 		})
 	}
 }
+
+func TestMetadataValidateURI(t *testing.T) {
+	testCases := []struct {
+		name   string
+		uri    string
+		hash   string
+		expErr bool
+	}{
+		{"no uri", "", "", false},
+		{"uri without hash", "https://example.com/token.json", "", false},
+		{"uri with hash", "ipfs://QmToken", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", false},
+		{"relative uri", "token.json", "", true},
+		{"hash without uri", "", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", true},
+		{"hash not hex", "https://example.com/token.json", "hash", true},
+		{"hash too short", "https://example.com/token.json", "e3b0c442", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.Metadata{URI: tc.uri, URIHash: tc.hash}.ValidateURI()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -8,6 +8,15 @@ var (
 	_ sdk.Msg = &MsgSend{}
 	_ sdk.Msg = &MsgMultiSend{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgCreateDenom{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgChangeDenomAdmin{}
+	_ sdk.Msg = &MsgSetDenomMetadata{}
+	_ sdk.Msg = &MsgForceTransfer{}
+	_ sdk.Msg = &MsgCreateDenomMetadata{}
+	_ sdk.Msg = &MsgUpdateDenomMetadata{}
+	_ sdk.Msg = &MsgDeleteDenomMetadata{}
 )
 
 // NewMsgSend - construct a msg to send coins from one account to another.
@@ -28,3 +37,53 @@ func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefau
 		UseDefaultFor: useDefaultFor,
 	}
 }
+
+// NewMsgCreateDenom creates a message to create the token factory denom
+// factory/{sender}/{subdenom}.
+func NewMsgCreateDenom(sender, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{Sender: sender, Subdenom: subdenom}
+}
+
+// NewMsgMint creates a message to mint tokens of a token factory denom to the
+// given address.
+func NewMsgMint(sender string, amount sdk.Coin, mintToAddress string) *MsgMint {
+	return &MsgMint{Sender: sender, Amount: amount, MintToAddress: mintToAddress}
+}
+
+// NewMsgBurn creates a message to burn tokens of a token factory denom.
+func NewMsgBurn(sender string, amount sdk.Coin) *MsgBurn {
+	return &MsgBurn{Sender: sender, Amount: amount}
+}
+
+// NewMsgChangeDenomAdmin creates a message to change the admin of a token
+// factory denom.
+func NewMsgChangeDenomAdmin(sender, denom, newAdmin string) *MsgChangeDenomAdmin {
+	return &MsgChangeDenomAdmin{Sender: sender, Denom: denom, NewAdmin: newAdmin}
+}
+
+// NewMsgSetDenomMetadata creates a message to set the metadata of a token
+// factory denom.
+func NewMsgSetDenomMetadata(sender string, metadata Metadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{Sender: sender, Metadata: metadata}
+}
+
+// NewMsgForceTransfer creates a message to move tokens of a token factory denom
+// between two accounts.
+func NewMsgForceTransfer(sender string, amount sdk.Coin, from, to string) *MsgForceTransfer {
+	return &MsgForceTransfer{Sender: sender, Amount: amount, TransferFromAddress: from, TransferToAddress: to}
+}
+
+// NewMsgCreateDenomMetadata creates a message to create the metadata of a denom.
+func NewMsgCreateDenomMetadata(authority string, metadata Metadata) *MsgCreateDenomMetadata {
+	return &MsgCreateDenomMetadata{Authority: authority, Metadata: metadata}
+}
+
+// NewMsgUpdateDenomMetadata creates a message to update the metadata of a denom.
+func NewMsgUpdateDenomMetadata(authority string, metadata Metadata) *MsgUpdateDenomMetadata {
+	return &MsgUpdateDenomMetadata{Authority: authority, Metadata: metadata}
+}
+
+// NewMsgDeleteDenomMetadata creates a message to delete the metadata of a denom.
+func NewMsgDeleteDenomMetadata(authority, denom string) *MsgDeleteDenomMetadata {
+	return &MsgDeleteDenomMetadata{Authority: authority, Denom: denom}
+}
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendFlow is a set of the balance movements a SendHook can cover.
+type SendFlow uint32
+
+const (
+	// SendFlowSend covers the transfers between accounts, including module accounts.
+	SendFlowSend SendFlow = 1 << iota
+	// SendFlowMint covers the minting of coins.
+	SendFlowMint
+	// SendFlowBurn covers the burning of coins.
+	SendFlowBurn
+	// SendFlowDelegate covers the delegation of coins to a module account.
+	SendFlowDelegate
+	// SendFlowUndelegate covers the undelegation of coins from a module account.
+	SendFlowUndelegate
+
+	// SendFlowAll covers all the balance movements.
+	SendFlowAll = SendFlowSend | SendFlowMint | SendFlowBurn | SendFlowDelegate | SendFlowUndelegate
+)
+
+var sendFlowNames = []struct {
+	flow SendFlow
+	name string
+}{
+	{SendFlowSend, "send"},
+	{SendFlowMint, "mint"},
+	{SendFlowBurn, "burn"},
+	{SendFlowDelegate, "delegate"},
+	{SendFlowUndelegate, "undelegate"},
+}
+
+// Covers returns true if all the flows of other are part of f.
+func (f SendFlow) Covers(other SendFlow) bool {
+	return other != 0 && f&other == other
+}
+
+// String implements fmt.Stringer.
+func (f SendFlow) String() string {
+	var names []string
+	for _, fn := range sendFlowNames {
+		if f.Covers(fn.flow) {
+			names = append(names, fn.name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// A SendHookFn is called before coins move in one of the flows covered by its
+// SendHook. Returning an error aborts the balance movement.
+//
+// For mints fromAddr is nil and for burns toAddr is nil. The amount only
+// contains the coins of the denoms the hook is gated on.
+//
+// The bank keeper calls made from a SendHookFn with the provided context don't
+// trigger the send hooks again, so that a hook can, for instance, collect a
+// transfer tax.
+type SendHookFn func(ctx context.Context, flow SendFlow, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+
+// SendHook is a named hook on the balance movements of the bank module.
+type SendHook struct {
+	// Name identifies the hook in the registry of the bank keeper.
+	Name string
+	// Flows are the balance movements the hook is called for.
+	Flows SendFlow
+	// Denoms gate the hook to the movements of the given denoms. The hook is
+	// called for all denoms if empty.
+	Denoms []string
+	// Gas is consumed every time the hook is called, in addition to the gas
+	// consumed by the hook itself.
+	Gas uint64
+	// Fn is the function called by the hook.
+	Fn SendHookFn
+}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (SendHook) IsOnePerModuleType() {}
+
+// Validate performs a basic validation of the send hook.
+func (h SendHook) Validate() error {
+	if strings.TrimSpace(h.Name) == "" {
+		return errors.New("send hook name cannot be blank")
+	}
+
+	if h.Flows == 0 || !SendFlowAll.Covers(h.Flows) {
+		return fmt.Errorf("invalid flows %d of send hook %s", h.Flows, h.Name)
+	}
+
+	for _, denom := range h.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid denom of send hook %s: %w", h.Name, err)
+		}
+	}
+
+	if h.Fn == nil {
+		return fmt.Errorf("send hook %s has no function", h.Name)
+	}
+
+	return nil
+}
+
+// GatedCoins returns the coins of amt the hook is called for in flow.
+func (h SendHook) GatedCoins(flow SendFlow, amt sdk.Coins) sdk.Coins {
+	if !h.Flows.Covers(flow) {
+		return nil
+	}
+
+	if len(h.Denoms) == 0 {
+		return amt
+	}
+
+	var gated sdk.Coins
+	for _, coin := range amt {
+		if slices.Contains(h.Denoms, coin.Denom) {
+			gated = append(gated, coin)
+		}
+	}
+	return gated
+}
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func noOpSendHookFn(_ context.Context, _ types.SendFlow, _, _ sdk.AccAddress, _ sdk.Coins) error {
+	return nil
+}
+
+func TestSendFlow(t *testing.T) {
+	flows := types.SendFlowSend | types.SendFlowMint
+	require.True(t, flows.Covers(types.SendFlowSend))
+	require.True(t, flows.Covers(types.SendFlowSend|types.SendFlowMint))
+	require.False(t, flows.Covers(types.SendFlowBurn))
+	require.False(t, flows.Covers(0))
+	require.True(t, types.SendFlowAll.Covers(types.SendFlowUndelegate))
+	require.Equal(t, "send|mint", flows.String())
+}
+
+func TestSendHookValidate(t *testing.T) {
+	valid := types.SendHook{Name: "hook", Flows: types.SendFlowSend, Denoms: []string{"foo"}, Fn: noOpSendHookFn}
+	require.NoError(t, valid.Validate())
+
+	testCases := []struct {
+		name   string
+		modify func(h *types.SendHook)
+		errMsg string
+	}{
+		{"blank name", func(h *types.SendHook) { h.Name = " " }, "name cannot be blank"},
+		{"no flows", func(h *types.SendHook) { h.Flows = 0 }, "invalid flows"},
+		{"unknown flow", func(h *types.SendHook) { h.Flows = 1 << 10 }, "invalid flows"},
+		{"invalid denom", func(h *types.SendHook) { h.Denoms = []string{"1foo"} }, "invalid denom"},
+		{"no function", func(h *types.SendHook) { h.Fn = nil }, "has no function"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hook := valid
+			tc.modify(&hook)
+			require.ErrorContains(t, hook.Validate(), tc.errMsg)
+		})
+	}
+}
+
+func TestSendHookGatedCoins(t *testing.T) {
+	amt := sdk.NewCoins(sdk.NewInt64Coin("bar", 1), sdk.NewInt64Coin("foo", 2))
+
+	all := types.SendHook{Flows: types.SendFlowSend | types.SendFlowBurn}
+	require.Equal(t, amt, all.GatedCoins(types.SendFlowSend, amt))
+	require.Empty(t, all.GatedCoins(types.SendFlowMint, amt))
+
+	gated := types.SendHook{Flows: types.SendFlowSend, Denoms: []string{"foo", "baz"}}
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 2)), gated.GatedCoins(types.SendFlowSend, amt))
+	require.Empty(t, gated.GatedCoins(types.SendFlowSend, sdk.NewCoins(sdk.NewInt64Coin("bar", 1))))
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -44,6 +45,7 @@ func NewTxCmd(valAc, ac address.Codec) *cobra.Command {
 		NewFundCommunityPoolCmd(ac),
 		NewDepositValidatorRewardsPoolCmd(valAc, ac),
 		NewWithdrawValidatorCommissionCmd(valAc, ac),
+		NewSetAutoCompoundCmd(valAc, ac),
 	)
 
 	return distTxCmd
@@ -332,3 +334,52 @@ func NewWithdrawValidatorCommissionCmd(valCodec, ac address.Codec) *cobra.Comman
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewSetAutoCompoundCmd returns a CLI command handler for creating a
+// MsgSetAutoCompound transaction.
+func NewSetAutoCompoundCmd(valCodec, ac address.Codec) *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [validator-addr] [true|false]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Opt a delegation in or out of auto-compounding of its rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Opt a delegation in or out of auto-compounding. The rewards of an
+auto-compounding delegation are re-delegated to the same validator at the end
+of every auto-compounding epoch, as long as they are withdrawn to the delegator.
+
+Example:
+$ %s tx distribution set-auto-compound %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj true --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if _, err := valCodec.StringToBytes(args[0]); err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid auto-compound setting %q: %w", args[1], err)
+			}
+
+			msg := types.NewMsgSetAutoCompound(delAddr, args[0], enabled)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -36,5 +36,10 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 
 	// record the proposer for when we pay out on the next block
 	consAddr := sdk.ConsAddress(ctx.BlockHeader().ProposerAddress)
-	return k.SetPreviousProposerConsAddr(ctx, consAddr)
+	if err := k.SetPreviousProposerConsAddr(ctx, consAddr); err != nil {
+		return err
+	}
+
+	// re-delegate the rewards of the next batch of auto-compounding delegations
+	return k.ProcessAutoCompoundRound(ctx)
 }
@@ -0,0 +1,255 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetDelegationAutoCompound opts the delegation of delAddr to valAddr in or out of
+// auto-compounding. Opting in requires auto-compounding to be enabled and the
+// delegation to exist.
+func (k Keeper) SetDelegationAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) error {
+	key := collections.Join(delAddr, valAddr)
+	if !enabled {
+		return k.AutoCompound.Remove(ctx, key)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.IsAutoCompoundEnabled() {
+		return types.ErrAutoCompoundDisabled
+	}
+
+	del, err := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if err != nil && !errors.Is(err, stakingtypes.ErrNoDelegation) {
+		return err
+	}
+
+	if del == nil {
+		return types.ErrNoDelegationExists
+	}
+
+	return k.AutoCompound.Set(ctx, key)
+}
+
+// GetDelegatorAutoCompound returns the validators a delegator auto-compounds
+// its rewards for.
+func (k Keeper) GetDelegatorAutoCompound(ctx context.Context, delAddr sdk.AccAddress) ([]sdk.ValAddress, error) {
+	iter, err := k.AutoCompound.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delAddr))
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]sdk.ValAddress, 0, len(keys))
+	for _, key := range keys {
+		validators = append(validators, key.K2())
+	}
+
+	return validators, nil
+}
+
+// StartAutoCompoundRound starts re-delegating the rewards of all
+// auto-compounding delegations. The round is processed in batches by
+// ProcessAutoCompoundRound. A round that is still in progress is not
+// restarted, so that every delegation is processed before starting over.
+func (k Keeper) StartAutoCompoundRound(ctx context.Context, epochNumber int64) error {
+	round, err := k.ActiveAutoCompoundRound.Get(ctx)
+	if err == nil {
+		k.Logger(ctx).Info(
+			"auto-compounding round still in progress, skipping epoch",
+			"round_epoch", round.EpochNumber, "epoch", epochNumber,
+		)
+		return nil
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	return k.ActiveAutoCompoundRound.Set(ctx, types.AutoCompoundRound{EpochNumber: epochNumber})
+}
+
+// ProcessAutoCompoundRound re-delegates the rewards of the next batch of
+// auto-compounding delegations of the round in progress. The round is removed
+// once all delegations have been processed.
+func (k Keeper) ProcessAutoCompoundRound(ctx context.Context) error {
+	round, err := k.ActiveAutoCompoundRound.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// auto-compounding was disabled while the round was in progress
+	if !params.IsAutoCompoundEnabled() {
+		return k.ActiveAutoCompoundRound.Remove(ctx)
+	}
+
+	ranger := new(collections.Range[collections.Pair[sdk.AccAddress, sdk.ValAddress]])
+	if round.NextDelegatorAddress != "" {
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(round.NextDelegatorAddress)
+		if err != nil {
+			return err
+		}
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(round.NextValidatorAddress)
+		if err != nil {
+			return err
+		}
+		ranger = ranger.StartInclusive(collections.Join(sdk.AccAddress(delAddr), sdk.ValAddress(valAddr)))
+	}
+
+	iter, err := k.AutoCompound.Iterate(ctx, ranger)
+	if err != nil {
+		return err
+	}
+
+	// collect the batch before processing it, as compounding may remove
+	// entries from the set
+	var batch []collections.Pair[sdk.AccAddress, sdk.ValAddress]
+	for ; iter.Valid() && uint32(len(batch)) < params.AutoCompoundBatchSize; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		batch = append(batch, key)
+	}
+
+	var next *collections.Pair[sdk.AccAddress, sdk.ValAddress]
+	if iter.Valid() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		next = &key
+	}
+	iter.Close()
+
+	for _, key := range batch {
+		compounded, err := k.compoundDelegationRewards(ctx, key.K1(), key.K2(), round.EpochNumber)
+		if err != nil {
+			// a single delegation failing to compound must not halt the round
+			k.Logger(ctx).Error(
+				"failed to auto-compound delegation rewards",
+				"delegator", key.K1().String(), "validator", key.K2().String(), "error", err,
+			)
+			continue
+		}
+		if compounded {
+			round.Compounded++
+		}
+	}
+
+	if next == nil {
+		k.Logger(ctx).Info(
+			"finished auto-compounding round",
+			"epoch", round.EpochNumber, "compounded", round.Compounded,
+		)
+		return k.ActiveAutoCompoundRound.Remove(ctx)
+	}
+
+	round.NextDelegatorAddress, err = k.authKeeper.AddressCodec().BytesToString(next.K1())
+	if err != nil {
+		return err
+	}
+	round.NextValidatorAddress, err = k.stakingKeeper.ValidatorAddressCodec().BytesToString(next.K2())
+	if err != nil {
+		return err
+	}
+
+	return k.ActiveAutoCompoundRound.Set(ctx, round)
+}
+
+// compoundDelegationRewards withdraws the rewards of a delegation and
+// delegates the bond denom part of them back to the same validator. The
+// rewards are only compounded when they are withdrawn to the delegator itself.
+// Delegations that no longer exist are opted out of auto-compounding.
+// All state changes are discarded if compounding fails.
+func (k Keeper) compoundDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, epochNumber int64) (bool, error) {
+	del, err := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if err != nil && !errors.Is(err, stakingtypes.ErrNoDelegation) {
+		return false, err
+	}
+
+	if del == nil {
+		return false, k.AutoCompound.Remove(ctx, collections.Join(delAddr, valAddr))
+	}
+
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return false, err
+	}
+
+	if !withdrawAddr.Equals(delAddr) {
+		return false, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, write := sdkCtx.CacheContext()
+
+	rewards, err := k.WithdrawDelegationRewards(cacheCtx, delAddr, valAddr)
+	if err != nil {
+		return false, err
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(cacheCtx)
+	if err != nil {
+		return false, err
+	}
+
+	amount := rewards.AmountOf(bondDenom)
+	if !amount.IsPositive() {
+		return false, nil
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(cacheCtx, valAddr)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := k.stakingKeeper.Delegate(cacheCtx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return false, errorsmod.Wrap(err, "failed to delegate rewards")
+	}
+
+	delAddrStr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return false, err
+	}
+	valAddrStr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return false, err
+	}
+
+	cacheCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddrStr),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddrStr),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatInt(epochNumber, 10)),
+		),
+	)
+	write()
+
+	return true, nil
+}
@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func setupAutoCompoundKeeper(t *testing.T) (sdk.Context, keeper.Keeper, *distrtestutil.MockBankKeeper, *distrtestutil.MockStakingKeeper) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+	return ctx, distrKeeper, bankKeeper, stakingKeeper
+}
+
+func TestSetDelegationAutoCompound(t *testing.T) {
+	ctx, distrKeeper, _, stakingKeeper := setupAutoCompoundKeeper(t)
+
+	valAddr := sdk.ValAddress(valConsAddr0)
+	otherValAddr := sdk.ValAddress(valConsAddr1)
+	addr := sdk.AccAddress(valConsAddr2)
+	del := stakingtypes.NewDelegation(addr.String(), valAddr.String(), math.LegacyNewDec(100))
+
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, otherValAddr).Return(nil, stakingtypes.ErrNoDelegation).AnyTimes()
+
+	// opting in requires a delegation
+	err := distrKeeper.SetDelegationAutoCompound(ctx, addr, otherValAddr, true)
+	require.ErrorIs(t, err, disttypes.ErrNoDelegationExists)
+
+	require.NoError(t, distrKeeper.SetDelegationAutoCompound(ctx, addr, valAddr, true))
+	validators, err := distrKeeper.GetDelegatorAutoCompound(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.ValAddress{valAddr}, validators)
+
+	// opting out
+	require.NoError(t, distrKeeper.SetDelegationAutoCompound(ctx, addr, valAddr, false))
+	validators, err = distrKeeper.GetDelegatorAutoCompound(ctx, addr)
+	require.NoError(t, err)
+	require.Empty(t, validators)
+
+	// removing the delegation opts it out
+	require.NoError(t, distrKeeper.SetDelegationAutoCompound(ctx, addr, valAddr, true))
+	require.NoError(t, distrKeeper.Hooks().BeforeDelegationRemoved(ctx, addr, valAddr))
+	has, err := distrKeeper.AutoCompound.Has(ctx, collections.Join(addr, valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// opting in is not possible while auto-compounding is disabled
+	params := disttypes.DefaultParams()
+	params.AutoCompoundEpochIdentifier = ""
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	err = distrKeeper.SetDelegationAutoCompound(ctx, addr, valAddr, true)
+	require.ErrorIs(t, err, disttypes.ErrAutoCompoundDisabled)
+}
+
+func TestAutoCompoundRound(t *testing.T) {
+	ctx, distrKeeper, bankKeeper, stakingKeeper := setupAutoCompoundKeeper(t)
+
+	params := disttypes.DefaultParams()
+	params.AutoCompoundBatchSize = 1
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// create validator with no commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+	del := stakingtypes.NewDelegation(addr.String(), valAddr.String(), val.DelegatorShares)
+
+	// a delegation that was removed without the hooks being called
+	removedValAddr := sdk.ValAddress(valConsAddr1)
+
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, removedValAddr).Return(nil, stakingtypes.ErrNoDelegation).AnyTimes()
+
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr))
+	require.NoError(t, distrKeeper.SetDelegationAutoCompound(ctx, addr, valAddr, true))
+	require.NoError(t, distrKeeper.AutoCompound.Set(ctx, collections.Join(addr, removedValAddr)))
+
+	// allocate some rewards
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(10)}}
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+	// the end of another epoch does not start a round
+	require.NoError(t, distrKeeper.EpochHooks().AfterEpochEnd(ctx, "week", 1))
+	has, err := distrKeeper.ActiveAutoCompoundRound.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.NoError(t, distrKeeper.EpochHooks().AfterEpochEnd(ctx, params.AutoCompoundEpochIdentifier, 3))
+	round, err := distrKeeper.ActiveAutoCompoundRound.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, disttypes.AutoCompoundRound{EpochNumber: 3}, round)
+
+	// the rewards are withdrawn to the delegator and delegated back
+	rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10)))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, rewards).Return(nil)
+	stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil)
+	stakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(val, nil)
+	stakingKeeper.EXPECT().Delegate(gomock.Any(), addr, math.NewInt(10), stakingtypes.Unbonded, val, true).Return(math.LegacyNewDec(10), nil)
+
+	// a single delegation is processed per block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, distrKeeper.ProcessAutoCompoundRound(ctx))
+	round, err = distrKeeper.ActiveAutoCompoundRound.Get(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, round.NextValidatorAddress)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, distrKeeper.ProcessAutoCompoundRound(ctx))
+	has, err = distrKeeper.ActiveAutoCompoundRound.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the removed delegation was opted out
+	validators, err := distrKeeper.GetDelegatorAutoCompound(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.ValAddress{valAddr}, validators)
+}
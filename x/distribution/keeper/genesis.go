@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
@@ -114,6 +117,25 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, entry := range data.AutoCompoundEntries {
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(entry.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(entry.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.AutoCompound.Set(ctx, collections.Join(sdk.AccAddress(delAddr), sdk.ValAddress(valAddr)))
+		if err != nil {
+			panic(err)
+		}
+	}
+	if data.AutoCompoundRound != nil {
+		if err := k.ActiveAutoCompoundRound.Set(ctx, *data.AutoCompoundRound); err != nil {
+			panic(err)
+		}
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -230,5 +252,28 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	autoCompound := make([]types.AutoCompoundEntry, 0)
+	err = k.AutoCompound.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress]) (stop bool, err error) {
+		autoCompound = append(autoCompound, types.AutoCompoundEntry{
+			DelegatorAddress: key.K1().String(),
+			ValidatorAddress: key.K2().String(),
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var round *types.AutoCompoundRound
+	activeRound, err := k.ActiveAutoCompoundRound.Get(ctx)
+	if err == nil {
+		round = &activeRound
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+
+	genesis := types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	genesis.AutoCompoundEntries = autoCompound
+	genesis.AutoCompoundRound = round
+	return genesis
 }
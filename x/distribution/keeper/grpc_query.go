@@ -6,6 +6,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 
@@ -374,3 +375,47 @@ func (k Querier) CommunityPool(ctx context.Context, _ *types.QueryCommunityPoolR
 
 	return &types.QueryCommunityPoolResponse{Pool: pool.CommunityPool}, nil
 }
+
+// DelegatorAutoCompound queries the validators a delegator auto-compounds its
+// rewards for
+func (k Querier) DelegatorAutoCompound(ctx context.Context, req *types.QueryDelegatorAutoCompoundRequest) (*types.QueryDelegatorAutoCompoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddrs, err := k.GetDelegatorAutoCompound(ctx, delAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]string, 0, len(valAddrs))
+	for _, valAddr := range valAddrs {
+		valStr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, valStr)
+	}
+
+	return &types.QueryDelegatorAutoCompoundResponse{Validators: validators}, nil
+}
+
+// AutoCompoundRound queries the auto-compounding round in progress
+func (k Querier) AutoCompoundRound(ctx context.Context, _ *types.QueryAutoCompoundRoundRequest) (*types.QueryAutoCompoundRoundResponse, error) {
+	round, err := k.ActiveAutoCompoundRound.Get(ctx)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return &types.QueryAutoCompoundRoundResponse{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAutoCompoundRoundResponse{Round: &round}, nil
+}
@@ -3,10 +3,13 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -155,6 +158,31 @@ func (h Hooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddre
 	return h.k.initializeDelegation(ctx, valAddr, delAddr)
 }
 
+// AfterConsensusPubKeyUpdate sends the key rotation fee, held by the staking
+// module account, to the community pool.
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx context.Context, _, _ cryptotypes.PubKey, rotationFee sdk.Coin) error {
+	if !rotationFee.IsPositive() {
+		return nil
+	}
+
+	feeCoins := sdk.NewCoins(rotationFee)
+	if h.k.HasExternalCommunityPool() {
+		return h.k.bankKeeper.SendCoinsFromModuleToModule(ctx, stakingtypes.ModuleName, h.k.externalCommunityPool.GetCommunityPoolModule(), feeCoins)
+	}
+
+	if err := h.k.bankKeeper.SendCoinsFromModuleToModule(ctx, stakingtypes.ModuleName, types.ModuleName, feeCoins); err != nil {
+		return err
+	}
+
+	feePool, err := h.k.FeePool.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(feeCoins...)...)
+	return h.k.FeePool.Set(ctx, feePool)
+}
+
 // BeforeValidatorSlashed records the slash event
 func (h Hooks) BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddress, fraction sdkmath.LegacyDec) error {
 	return h.k.updateValidatorSlashFraction(ctx, valAddr, fraction)
@@ -172,10 +200,43 @@ func (h Hooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress
 	return nil
 }
 
-func (h Hooks) BeforeDelegationRemoved(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
-	return nil
+// BeforeDelegationRemoved opts the removed delegation out of auto-compounding.
+func (h Hooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.AutoCompound.Remove(ctx, collections.Join(delAddr, valAddr))
 }
 
 func (h Hooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
 	return nil
 }
+
+// EpochHooks wraps the distribution keeper to implement the x/epochs hooks.
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks returns the x/epochs hooks of the distribution keeper.
+func (k Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// AfterEpochEnd starts an auto-compounding round at the end of the
+// auto-compounding epoch.
+func (h EpochHooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	params, err := h.k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.IsAutoCompoundEnabled() || params.AutoCompoundEpochIdentifier != epochIdentifier {
+		return nil
+	}
+
+	return h.k.StartAutoCompoundRound(ctx, epochNumber)
+}
+
+// BeforeEpochStart implements epochstypes.EpochHooks.
+func (h EpochHooks) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
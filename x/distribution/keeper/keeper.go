@@ -29,6 +29,11 @@ type Keeper struct {
 	Schema  collections.Schema
 	Params  collections.Item[types.Params]
 	FeePool collections.Item[types.FeePool]
+	// AutoCompound contains the delegations, keyed by delegator and validator,
+	// whose rewards are re-delegated at the end of every auto-compounding epoch.
+	AutoCompound collections.KeySet[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
+	// ActiveAutoCompoundRound is the auto-compounding round in progress, if any.
+	ActiveAutoCompoundRound collections.Item[types.AutoCompoundRound]
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 
@@ -67,16 +72,23 @@ func NewKeeper(
 
 	sb := collections.NewSchemaBuilder(storeService)
 	k := Keeper{
-		storeService:          storeService,
-		cdc:                   cdc,
-		authKeeper:            ak,
-		bankKeeper:            bk,
-		stakingKeeper:         sk,
-		feeCollectorName:      feeCollectorName,
-		authority:             authority,
-		Params:                collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		FeePool:               collections.NewItem(sb, types.FeePoolKey, "fee_pool", codec.CollValue[types.FeePool](cdc)),
-		externalCommunityPool: nil,
+		storeService:     storeService,
+		cdc:              cdc,
+		authKeeper:       ak,
+		bankKeeper:       bk,
+		stakingKeeper:    sk,
+		feeCollectorName: feeCollectorName,
+		authority:        authority,
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		FeePool:          collections.NewItem(sb, types.FeePoolKey, "fee_pool", codec.CollValue[types.FeePool](cdc)),
+		AutoCompound: collections.NewKeySet(
+			sb,
+			types.AutoCompoundKey,
+			"auto_compound",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey),
+		),
+		ActiveAutoCompoundRound: collections.NewItem(sb, types.AutoCompoundRoundKey, "auto_compound_round", codec.CollValue[types.AutoCompoundRound](cdc)),
+		externalCommunityPool:   nil,
 	}
 
 	schema, err := sb.Build()
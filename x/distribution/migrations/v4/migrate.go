@@ -0,0 +1,44 @@
+package v4
+
+import (
+	"cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+const (
+	ModuleName = "distribution"
+)
+
+var ParamsKey = []byte{0x09}
+
+// MigrateStore migrates the x/distribution module state from the consensus version 3 to
+// version 4. Specifically, it sets the auto-compounding parameters to their defaults.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	store := storeService.OpenKVStore(ctx)
+	bz, err := store.Get(ParamsKey)
+	if err != nil {
+		return err
+	}
+
+	var currParams types.Params
+	if err := cdc.Unmarshal(bz, &currParams); err != nil {
+		return err
+	}
+
+	currParams.AutoCompoundEpochIdentifier = types.DefaultAutoCompoundEpochIdentifier
+	currParams.AutoCompoundBatchSize = types.DefaultAutoCompoundBatchSize
+
+	if err := currParams.ValidateBasic(); err != nil {
+		return err
+	}
+
+	bz, err = cdc.Marshal(&currParams)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(ParamsKey, bz)
+}
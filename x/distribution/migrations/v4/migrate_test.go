@@ -0,0 +1,44 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	v4 "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v4"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(v4.ModuleName)
+	storeService := runtime.NewKVStoreService(storeKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// params stored before v4 have no auto-compounding parameters
+	oldParams := types.Params{
+		CommunityTax:        sdkmath.LegacyNewDecWithPrec(5, 2),
+		BaseProposerReward:  sdkmath.LegacyZeroDec(),
+		BonusProposerReward: sdkmath.LegacyZeroDec(),
+		WithdrawAddrEnabled: false,
+	}
+	store.Set(v4.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v4.MigrateStore(ctx, storeService, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v4.ParamsKey), &res))
+	require.Equal(t, oldParams.CommunityTax, res.CommunityTax)
+	require.False(t, res.WithdrawAddrEnabled)
+	require.Equal(t, types.DefaultAutoCompoundEpochIdentifier, res.AutoCompoundEpochIdentifier)
+	require.Equal(t, types.DefaultAutoCompoundBatchSize, res.AutoCompoundBatchSize)
+}
@@ -38,6 +38,13 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                        `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_epoch_identifier is the x/epochs identifier of the epoch at
+	// the end of which the rewards of auto-compounding delegations are
+	// re-delegated. An empty identifier disables auto-compounding.
+	AutoCompoundEpochIdentifier string `protobuf:"bytes,5,opt,name=auto_compound_epoch_identifier,json=autoCompoundEpochIdentifier,proto3" json:"auto_compound_epoch_identifier,omitempty"`
+	// auto_compound_batch_size is the maximum number of auto-compounding
+	// delegations processed in a single block.
+	AutoCompoundBatchSize uint32 `protobuf:"varint,6,opt,name=auto_compound_batch_size,json=autoCompoundBatchSize,proto3" json:"auto_compound_batch_size,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +87,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundEpochIdentifier() string {
+	if m != nil {
+		return m.AutoCompoundEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetAutoCompoundBatchSize() uint32 {
+	if m != nil {
+		return m.AutoCompoundBatchSize
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -623,6 +644,137 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// AutoCompoundEntry is a delegation that has opted into auto-compounding of
+// its rewards.
+type AutoCompoundEntry struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *AutoCompoundEntry) Reset()         { *m = AutoCompoundEntry{} }
+func (m *AutoCompoundEntry) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundEntry) ProtoMessage()    {}
+func (*AutoCompoundEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *AutoCompoundEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundEntry.Merge(m, src)
+}
+func (m *AutoCompoundEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundEntry proto.InternalMessageInfo
+
+func (m *AutoCompoundEntry) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *AutoCompoundEntry) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// AutoCompoundRound tracks the progress of re-delegating the rewards of all
+// auto-compounding delegations after the end of an epoch. The round is
+// processed in batches of at most auto_compound_batch_size delegations per
+// block, in the order of the delegator and validator addresses.
+type AutoCompoundRound struct {
+	// epoch_number is the number of the epoch whose end started the round.
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// next_delegator_address and next_validator_address identify the next
+	// delegation to process. They are empty until the first batch was processed.
+	NextDelegatorAddress string `protobuf:"bytes,2,opt,name=next_delegator_address,json=nextDelegatorAddress,proto3" json:"next_delegator_address,omitempty"`
+	NextValidatorAddress string `protobuf:"bytes,3,opt,name=next_validator_address,json=nextValidatorAddress,proto3" json:"next_validator_address,omitempty"`
+	// compounded is the number of delegations whose rewards were re-delegated
+	// so far in this round.
+	Compounded uint64 `protobuf:"varint,4,opt,name=compounded,proto3" json:"compounded,omitempty"`
+}
+
+func (m *AutoCompoundRound) Reset()         { *m = AutoCompoundRound{} }
+func (m *AutoCompoundRound) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundRound) ProtoMessage()    {}
+func (*AutoCompoundRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{13}
+}
+func (m *AutoCompoundRound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundRound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundRound.Merge(m, src)
+}
+func (m *AutoCompoundRound) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundRound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundRound proto.InternalMessageInfo
+
+func (m *AutoCompoundRound) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *AutoCompoundRound) GetNextDelegatorAddress() string {
+	if m != nil {
+		return m.NextDelegatorAddress
+	}
+	return ""
+}
+
+func (m *AutoCompoundRound) GetNextValidatorAddress() string {
+	if m != nil {
+		return m.NextValidatorAddress
+	}
+	return ""
+}
+
+func (m *AutoCompoundRound) GetCompounded() uint64 {
+	if m != nil {
+		return m.Compounded
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -636,6 +788,8 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*AutoCompoundEntry)(nil), "cosmos.distribution.v1beta1.AutoCompoundEntry")
+	proto.RegisterType((*AutoCompoundRound)(nil), "cosmos.distribution.v1beta1.AutoCompoundRound")
 }
 
 func init() {
@@ -643,71 +797,84 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1012 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x34, 0x89, 0xd3, 0x4c, 0xdb, 0x84, 0x4e, 0x9c, 0xd4, 0x71, 0x8b, 0x6d, 0x56, 0xaa,
-	0x30, 0x81, 0xd8, 0xa4, 0x48, 0x08, 0xe5, 0xd6, 0xd8, 0xad, 0x40, 0x2a, 0x34, 0xda, 0x20, 0x90,
-	0xe0, 0xb0, 0x1a, 0xef, 0x4e, 0xec, 0x21, 0xbb, 0x33, 0xcb, 0xcc, 0xd8, 0x49, 0x0e, 0xdc, 0x03,
-	0x07, 0xe0, 0x06, 0xea, 0xa9, 0x82, 0x4b, 0xc5, 0x29, 0x87, 0xfc, 0x88, 0x8a, 0x53, 0x55, 0x10,
-	0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0x7e, 0x05, 0x9a, 0x9d, 0xf1, 0xae, 0x13, 0x42, 0x81, 0x22,
-	0x8b, 0x4b, 0x94, 0x79, 0x6f, 0xf6, 0x7d, 0xdf, 0xf7, 0xe6, 0x9b, 0x37, 0x86, 0x75, 0x9f, 0xcb,
-	0x88, 0xcb, 0x46, 0x40, 0xa5, 0x12, 0xb4, 0xdd, 0x53, 0x94, 0xb3, 0x46, 0x7f, 0xb9, 0x4d, 0x14,
-	0x5e, 0x3e, 0x11, 0xac, 0xc7, 0x82, 0x2b, 0x8e, 0xae, 0x9a, 0xfd, 0xf5, 0x13, 0x29, 0xbb, 0xbf,
-	0x54, 0xe8, 0xf0, 0x0e, 0x4f, 0xf6, 0x35, 0xf4, 0x7f, 0xe6, 0x93, 0x52, 0xd9, 0x42, 0xb4, 0xb1,
-	0x24, 0x69, 0x69, 0x9f, 0x53, 0x5b, 0xb2, 0xb4, 0x60, 0xf2, 0x9e, 0xf9, 0xd0, 0xd6, 0x37, 0xa9,
-	0xcb, 0x38, 0xa2, 0x8c, 0x37, 0x92, 0xbf, 0x26, 0xe4, 0xdc, 0x1b, 0x83, 0xf9, 0x35, 0x2c, 0x70,
-	0x24, 0xd1, 0xfb, 0xf0, 0x92, 0xcf, 0xa3, 0xa8, 0xc7, 0xa8, 0xda, 0xf1, 0x14, 0xde, 0x2e, 0x82,
-	0x2a, 0xa8, 0x4d, 0xad, 0xbe, 0xfa, 0xf0, 0xa0, 0x92, 0xfb, 0xe9, 0xa0, 0x62, 0xa9, 0xca, 0x60,
-	0xb3, 0x4e, 0x79, 0x23, 0xc2, 0xaa, 0x5b, 0xbf, 0x43, 0x3a, 0xd8, 0xdf, 0x69, 0x11, 0xff, 0xf1,
-	0xfe, 0x12, 0xb4, 0x48, 0x2d, 0xe2, 0x3f, 0x38, 0xde, 0x5b, 0x04, 0xee, 0xc5, 0xb4, 0xd8, 0xdb,
-	0x78, 0x1b, 0x7d, 0x00, 0x0b, 0x9a, 0xb0, 0x66, 0x15, 0x73, 0x49, 0x84, 0x27, 0xc8, 0x16, 0x16,
-	0x41, 0xf1, 0x5c, 0x82, 0xf1, 0xda, 0xd3, 0x61, 0x14, 0x81, 0x8b, 0x74, 0xd5, 0x35, 0x5b, 0xd4,
-	0x4d, 0x6a, 0xa2, 0x10, 0xce, 0xb5, 0x39, 0xeb, 0xc9, 0x3f, 0x81, 0x8d, 0xfd, 0x47, 0xb0, 0xd9,
-	0xa4, 0xec, 0x29, 0xb4, 0x1b, 0x70, 0x6e, 0x8b, 0xaa, 0x6e, 0x20, 0xf0, 0x96, 0x87, 0x83, 0x40,
-	0x78, 0x84, 0xe1, 0x76, 0x48, 0x82, 0xe2, 0x78, 0x15, 0xd4, 0xce, 0xbb, 0xb3, 0x83, 0xe4, 0xcd,
-	0x20, 0x10, 0xb7, 0x4c, 0x6a, 0xe5, 0xfa, 0x27, 0xc7, 0x7b, 0x8b, 0x55, 0x03, 0xb0, 0x24, 0x83,
-	0xcd, 0xc6, 0xf6, 0x49, 0xc7, 0x98, 0x13, 0x71, 0x7e, 0x00, 0xb0, 0xf4, 0x0e, 0x0e, 0x69, 0x80,
-	0x15, 0x17, 0xaf, 0x53, 0xa9, 0xb8, 0xa0, 0x3e, 0x0e, 0x0d, 0xb0, 0x44, 0x9f, 0x02, 0x78, 0xc5,
-	0xef, 0x45, 0xbd, 0x10, 0x2b, 0xda, 0x27, 0x56, 0xa4, 0x27, 0xb0, 0xa2, 0xbc, 0x08, 0xaa, 0x63,
-	0xb5, 0x0b, 0x37, 0xae, 0x59, 0x3f, 0xd6, 0x75, 0x97, 0x06, 0xbe, 0xd2, 0x8a, 0x9a, 0x9c, 0x32,
-	0xd3, 0x88, 0x6f, 0x7e, 0xae, 0xbc, 0xd8, 0xa1, 0xaa, 0xdb, 0x6b, 0xd7, 0x7d, 0x1e, 0x59, 0xbf,
-	0x34, 0x86, 0xa8, 0xa9, 0x9d, 0x98, 0xc8, 0xc1, 0x37, 0xd2, 0x9c, 0xed, 0x5c, 0x06, 0x6b, 0xc8,
-	0xb8, 0x1a, 0x14, 0x3d, 0x0f, 0x67, 0x04, 0xd9, 0x20, 0x82, 0x30, 0x9f, 0x78, 0x3e, 0xef, 0x31,
-	0x95, 0x9c, 0xef, 0x25, 0x77, 0x3a, 0x0d, 0x37, 0x75, 0xd4, 0xf9, 0x1a, 0xc0, 0x2b, 0xa9, 0xb0,
-	0x66, 0x4f, 0x08, 0xc2, 0xd4, 0x40, 0x55, 0x0c, 0x27, 0x8d, 0x12, 0x39, 0x62, 0x11, 0x03, 0x18,
-	0x34, 0x0f, 0xf3, 0x31, 0x11, 0x94, 0x1b, 0x37, 0x8e, 0xbb, 0x76, 0xe5, 0x7c, 0x09, 0x60, 0x39,
-	0x65, 0x79, 0xd3, 0xb7, 0x9a, 0x49, 0xd0, 0xe4, 0x51, 0x44, 0xa5, 0xa4, 0x9c, 0xa1, 0x3e, 0x84,
-	0x7e, 0xba, 0x1a, 0x31, 0xdf, 0x21, 0x24, 0xe7, 0x33, 0x00, 0xaf, 0xa6, 0xd4, 0xee, 0xf6, 0x94,
-	0x54, 0x98, 0x05, 0x94, 0x75, 0xfe, 0xb7, 0x26, 0x6a, 0x46, 0xb3, 0x29, 0xa3, 0xf5, 0x10, 0xcb,
-	0xee, 0xad, 0x3e, 0x61, 0x0a, 0xbd, 0x00, 0x9f, 0xe9, 0x0f, 0xc2, 0x9e, 0x6d, 0x33, 0x48, 0xda,
-	0x3c, 0x93, 0xc6, 0xd7, 0x92, 0x30, 0x7a, 0x13, 0x9e, 0xdf, 0x10, 0xd8, 0xd7, 0x37, 0xc0, 0xce,
-	0x85, 0xe5, 0x7f, 0x7d, 0x55, 0xdd, 0xb4, 0x84, 0xf3, 0x31, 0x80, 0x85, 0x33, 0x18, 0x49, 0xf4,
-	0x21, 0x9c, 0xcf, 0x28, 0x49, 0x9d, 0xf0, 0x48, 0x92, 0xb1, 0xbd, 0x7a, 0xb9, 0xfe, 0x84, 0xa9,
-	0x5c, 0x3f, 0xa3, 0xe4, 0xea, 0x94, 0xe6, 0x69, 0x1a, 0x52, 0xe8, 0x9f, 0x01, 0xe9, 0xec, 0x02,
-	0x38, 0x79, 0x9b, 0x90, 0x35, 0xce, 0x43, 0xf4, 0x11, 0x9c, 0xce, 0xe6, 0x6c, 0xcc, 0x79, 0x38,
-	0xe2, 0x23, 0xca, 0xa6, 0xba, 0x86, 0x77, 0xbe, 0x38, 0x07, 0x4b, 0xcd, 0xe1, 0xc8, 0x7a, 0x4c,
-	0x58, 0x60, 0x86, 0x1a, 0x0e, 0x51, 0x01, 0x4e, 0x28, 0xaa, 0x42, 0x62, 0xa6, 0xbf, 0x6b, 0x16,
-	0xa8, 0x0a, 0x2f, 0x04, 0x44, 0xfa, 0x82, 0xc6, 0xd9, 0xe9, 0xb8, 0xc3, 0x21, 0x74, 0x0d, 0x4e,
-	0x09, 0xe2, 0xd3, 0x98, 0x12, 0xa6, 0xcc, 0xa0, 0x75, 0xb3, 0x00, 0xda, 0x81, 0x79, 0x1c, 0x25,
-	0x03, 0x61, 0x3c, 0xd1, 0xba, 0x70, 0xa6, 0xd6, 0x44, 0xe8, 0x6d, 0x2b, 0xb4, 0xf6, 0x0f, 0x84,
-	0x26, 0x2a, 0xef, 0x1d, 0xef, 0x2d, 0x5e, 0x0c, 0x13, 0x3b, 0x78, 0x7e, 0x26, 0xdb, 0x02, 0xae,
-	0xd4, 0x76, 0xef, 0x57, 0x72, 0xbf, 0xdd, 0xaf, 0xe4, 0xbe, 0xdd, 0x5f, 0x2a, 0x59, 0xd4, 0x0e,
-	0xef, 0x0f, 0x81, 0x32, 0xa5, 0x39, 0x03, 0xe7, 0x7b, 0x00, 0xe7, 0x5a, 0x44, 0x57, 0xd2, 0xa7,
-	0xa7, 0xb0, 0x50, 0x94, 0x75, 0xde, 0x60, 0x1b, 0xc9, 0x60, 0x8b, 0x05, 0xe9, 0x53, 0xae, 0x1f,
-	0x95, 0x61, 0x0f, 0x4f, 0x0f, 0xc2, 0xd6, 0xc2, 0x77, 0xe0, 0x84, 0x54, 0x78, 0x93, 0x58, 0xff,
-	0x3e, 0xed, 0xdb, 0x69, 0x8a, 0xa0, 0x16, 0xcc, 0x77, 0x09, 0xed, 0x74, 0x4d, 0x43, 0xc7, 0x57,
-	0x5f, 0xfa, 0xfd, 0xa0, 0x32, 0xe3, 0x0b, 0xa2, 0x87, 0x2d, 0xf3, 0x4c, 0xea, 0xab, 0xe3, 0xbd,
-	0xc5, 0xd3, 0x31, 0xdb, 0x00, 0xb3, 0x70, 0x7e, 0x05, 0x70, 0xc1, 0xca, 0xa2, 0x9c, 0xa5, 0x02,
-	0xed, 0xf3, 0xf5, 0x16, 0xbc, 0x9c, 0x5d, 0x06, 0xfd, 0x7e, 0x11, 0x29, 0xed, 0xcb, 0xff, 0xdc,
-	0xe3, 0xfd, 0xa5, 0x67, 0x2d, 0xb5, 0x6c, 0x0e, 0x9a, 0x2d, 0xeb, 0x4a, 0xe8, 0x71, 0x93, 0xdd,
-	0x6d, 0x1b, 0x47, 0x0c, 0xe6, 0xd3, 0xa7, 0x7d, 0x94, 0xae, 0xb6, 0x28, 0x2b, 0xe3, 0xfa, 0x78,
-	0x9d, 0xef, 0x00, 0xbc, 0xfe, 0xd7, 0xa6, 0x7e, 0x97, 0xaa, 0x6e, 0x8b, 0xc4, 0x5c, 0x52, 0x35,
-	0x22, 0x7f, 0xcf, 0x0f, 0xf9, 0x5b, 0xa7, 0xec, 0x0a, 0x15, 0xe1, 0x64, 0x60, 0x80, 0x8b, 0x13,
-	0x49, 0x62, 0xb0, 0x5c, 0x71, 0x76, 0xff, 0xd6, 0x92, 0xab, 0x77, 0x1f, 0x1c, 0x96, 0xc1, 0xc3,
-	0xc3, 0x32, 0x78, 0x74, 0x58, 0x06, 0xbf, 0x1c, 0x96, 0xc1, 0xe7, 0x47, 0xe5, 0xdc, 0xa3, 0xa3,
-	0x72, 0xee, 0xc7, 0xa3, 0x72, 0xee, 0xbd, 0xe5, 0x27, 0xf6, 0xec, 0xd4, 0x2f, 0x8a, 0xa4, 0x85,
-	0xed, 0x7c, 0xf2, 0xa3, 0xef, 0x95, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x78, 0xae, 0xbc, 0xbc,
-	0xa7, 0x0a, 0x00, 0x00,
+	// 1222 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x24, 0xae, 0xdb, 0x4e, 0x3f, 0x42, 0x26, 0x4e, 0xea, 0xa6, 0xc5, 0x71, 0x57, 0xaa,
+	0x08, 0x81, 0xd8, 0x4d, 0xf9, 0x10, 0xca, 0xad, 0xf9, 0xa8, 0xa8, 0x54, 0xda, 0x68, 0x83, 0x28,
+	0x82, 0xc3, 0x6a, 0xbc, 0x3b, 0xb1, 0x87, 0x7a, 0x67, 0x96, 0x99, 0x59, 0x37, 0xa9, 0xc4, 0xbd,
+	0x70, 0x00, 0x0e, 0x48, 0x20, 0x4e, 0x15, 0x5c, 0x2a, 0x4e, 0x39, 0xe4, 0x8e, 0xc4, 0xa9, 0xe2,
+	0x54, 0x05, 0x84, 0x50, 0x0f, 0x05, 0xd2, 0x43, 0x10, 0x7f, 0x05, 0x9a, 0x0f, 0xaf, 0x1d, 0xc7,
+	0xb4, 0x25, 0x28, 0xe2, 0x62, 0x65, 0xde, 0xdb, 0xf7, 0x7e, 0xbf, 0xdf, 0x7b, 0x6f, 0x3e, 0x02,
+	0x2b, 0x21, 0x97, 0x31, 0x97, 0xd5, 0x88, 0x4a, 0x25, 0x68, 0x2d, 0x55, 0x94, 0xb3, 0x6a, 0x6b,
+	0xb6, 0x46, 0x14, 0x9e, 0xdd, 0x65, 0xac, 0x24, 0x82, 0x2b, 0x8e, 0xce, 0xd8, 0xef, 0x2b, 0xbb,
+	0x5c, 0xee, 0xfb, 0x89, 0x42, 0x9d, 0xd7, 0xb9, 0xf9, 0xae, 0xaa, 0xff, 0xb2, 0x21, 0x13, 0x25,
+	0x07, 0x51, 0xc3, 0x92, 0x64, 0xa9, 0x43, 0x4e, 0x5d, 0xca, 0x89, 0xd3, 0xd6, 0x1f, 0xd8, 0x40,
+	0x97, 0xdf, 0xba, 0x46, 0x70, 0x4c, 0x19, 0xaf, 0x9a, 0x5f, 0x6b, 0xf2, 0x7e, 0xc8, 0xc1, 0xfc,
+	0x32, 0x16, 0x38, 0x96, 0xe8, 0x7d, 0x78, 0x22, 0xe4, 0x71, 0x9c, 0x32, 0xaa, 0xd6, 0x03, 0x85,
+	0xd7, 0x8a, 0xa0, 0x0c, 0xa6, 0x8e, 0xce, 0xbf, 0x7e, 0xff, 0xd1, 0xe4, 0xc0, 0xc3, 0x47, 0x93,
+	0x8e, 0xaa, 0x8c, 0x6e, 0x56, 0x28, 0xaf, 0xc6, 0x58, 0x35, 0x2a, 0x57, 0x49, 0x1d, 0x87, 0xeb,
+	0x8b, 0x24, 0xdc, 0xda, 0x9c, 0x81, 0x0e, 0x69, 0x91, 0x84, 0xf7, 0x76, 0x36, 0xa6, 0x81, 0x7f,
+	0x3c, 0x4b, 0xf6, 0x36, 0x5e, 0x43, 0x1f, 0xc0, 0x82, 0x26, 0xac, 0x59, 0x25, 0x5c, 0x12, 0x11,
+	0x08, 0x72, 0x0b, 0x8b, 0xa8, 0x38, 0x68, 0x30, 0xde, 0xd8, 0x1f, 0x46, 0x11, 0xf8, 0x48, 0x67,
+	0x5d, 0x76, 0x49, 0x7d, 0x93, 0x13, 0x35, 0xe1, 0x58, 0x8d, 0xb3, 0x54, 0xee, 0x01, 0x1b, 0xfa,
+	0x8f, 0x60, 0xa3, 0x26, 0x6d, 0x0f, 0xda, 0x45, 0x38, 0x76, 0x8b, 0xaa, 0x46, 0x24, 0xf0, 0xad,
+	0x00, 0x47, 0x91, 0x08, 0x08, 0xc3, 0xb5, 0x26, 0x89, 0x8a, 0xb9, 0x32, 0x98, 0x3a, 0xe2, 0x8f,
+	0xb6, 0x9d, 0x97, 0xa2, 0x48, 0x2c, 0x59, 0x17, 0x7a, 0x17, 0x96, 0x70, 0xaa, 0x78, 0x10, 0xf2,
+	0x38, 0xe1, 0x29, 0x8b, 0x02, 0x92, 0xf0, 0xb0, 0x11, 0xd0, 0x88, 0x30, 0x45, 0x57, 0x29, 0x11,
+	0xc5, 0x43, 0x86, 0xea, 0xe8, 0xc3, 0xcd, 0x99, 0x61, 0xcb, 0x63, 0x46, 0x46, 0x37, 0xcb, 0x17,
+	0x2a, 0xaf, 0xbd, 0xea, 0x9f, 0xd1, 0xa1, 0x0b, 0x2e, 0x72, 0x49, 0x07, 0x5e, 0xc9, 0xe2, 0xd0,
+	0x55, 0x58, 0xdc, 0x9d, 0xb9, 0x86, 0x55, 0xd8, 0x08, 0x24, 0xbd, 0x4d, 0x8a, 0xf9, 0x32, 0x98,
+	0x3a, 0xd1, 0x3f, 0xe7, 0x58, 0x77, 0xce, 0x79, 0x1d, 0xb2, 0x42, 0x6f, 0x93, 0xb9, 0xf3, 0x9f,
+	0xec, 0x6c, 0x4c, 0x97, 0x3b, 0x1f, 0x57, 0xd7, 0x76, 0x4f, 0xb6, 0x9d, 0x1c, 0xef, 0x17, 0x00,
+	0x27, 0xde, 0xc1, 0x4d, 0x1a, 0x61, 0xc5, 0xc5, 0x9b, 0x54, 0x2a, 0x2e, 0x68, 0x88, 0x9b, 0xb6,
+	0x40, 0x12, 0x7d, 0x0a, 0xe0, 0xa9, 0x30, 0x8d, 0xd3, 0x26, 0x56, 0xb4, 0x45, 0x5c, 0x33, 0x02,
+	0x81, 0x15, 0xe5, 0x45, 0x50, 0x1e, 0x9a, 0x3a, 0x76, 0xf1, 0xac, 0xdb, 0x37, 0x15, 0xdd, 0xcd,
+	0xf6, 0xfc, 0xeb, 0xca, 0x2f, 0x70, 0xca, 0x6c, 0xc3, 0xbe, 0xfb, 0x6d, 0xf2, 0xa5, 0x3a, 0x55,
+	0x8d, 0xb4, 0x56, 0x09, 0x79, 0xec, 0xe6, 0xba, 0xda, 0x45, 0x4d, 0xad, 0x27, 0x44, 0xb6, 0x63,
+	0xa4, 0x9d, 0xc1, 0xb1, 0x0e, 0xac, 0x25, 0xe3, 0x6b, 0x50, 0xf4, 0x02, 0x1c, 0x16, 0x64, 0x95,
+	0x08, 0xc2, 0x42, 0x12, 0x84, 0x3c, 0x65, 0xca, 0xcc, 0xe1, 0x09, 0xff, 0x64, 0x66, 0x5e, 0xd0,
+	0x56, 0xef, 0x5b, 0x00, 0x4f, 0x65, 0xc2, 0x16, 0x52, 0x21, 0x08, 0x53, 0x6d, 0x55, 0x09, 0x3c,
+	0x6c, 0x95, 0xc8, 0x03, 0x16, 0xd1, 0x86, 0x41, 0xe3, 0x30, 0x9f, 0x10, 0x41, 0xb9, 0xdd, 0x35,
+	0x39, 0xdf, 0xad, 0xbc, 0xaf, 0x00, 0x2c, 0x65, 0x2c, 0x2f, 0x85, 0x4e, 0x33, 0x89, 0x16, 0x78,
+	0x1c, 0x53, 0x29, 0x29, 0x67, 0xa8, 0x05, 0x61, 0x98, 0xad, 0x0e, 0x98, 0x6f, 0x17, 0x92, 0xf7,
+	0x19, 0x80, 0x67, 0x32, 0x6a, 0xd7, 0x53, 0x25, 0x15, 0x66, 0x11, 0x65, 0xf5, 0xff, 0xad, 0x88,
+	0x9a, 0xd1, 0x68, 0xc6, 0x68, 0xa5, 0x89, 0x65, 0x63, 0xa9, 0x45, 0x98, 0x42, 0x2f, 0xc2, 0xe7,
+	0x5a, 0x6d, 0x73, 0xe0, 0xca, 0x0c, 0x4c, 0x99, 0x87, 0x33, 0xfb, 0xb2, 0x31, 0xa3, 0xb7, 0xe0,
+	0x91, 0x55, 0x81, 0x43, 0xbd, 0x03, 0xdc, 0xf9, 0x35, 0xfb, 0xaf, 0x8f, 0x14, 0x3f, 0x4b, 0xe1,
+	0x7d, 0x0c, 0x60, 0xa1, 0x0f, 0x23, 0x89, 0x3e, 0x84, 0xe3, 0x1d, 0x4a, 0x52, 0x3b, 0x02, 0x62,
+	0x3c, 0xae, 0x56, 0x17, 0x2a, 0x4f, 0xb8, 0x3d, 0x2a, 0x7d, 0x52, 0xce, 0x1f, 0xd5, 0x3c, 0x6d,
+	0x41, 0x0a, 0xad, 0x3e, 0x90, 0xde, 0x1d, 0x00, 0x0f, 0x5f, 0x26, 0x64, 0x99, 0xf3, 0x26, 0xfa,
+	0x08, 0x9e, 0xec, 0xdc, 0x07, 0x09, 0xe7, 0xcd, 0x03, 0x6e, 0x51, 0xe7, 0xf6, 0xd1, 0xf0, 0xde,
+	0x97, 0x83, 0x70, 0x62, 0xa1, 0xdb, 0xb2, 0x92, 0x10, 0x16, 0xd9, 0xc3, 0x17, 0x37, 0x51, 0x01,
+	0x1e, 0x52, 0x54, 0x35, 0x89, 0xbd, 0xa5, 0x7c, 0xbb, 0x40, 0x65, 0x78, 0x2c, 0x22, 0x32, 0x14,
+	0x34, 0xe9, 0x74, 0xc7, 0xef, 0x36, 0xa1, 0xb3, 0xf0, 0xa8, 0x20, 0x21, 0x4d, 0x28, 0x61, 0xca,
+	0x5e, 0x08, 0x7e, 0xc7, 0x80, 0xd6, 0x61, 0x1e, 0xc7, 0xe6, 0x40, 0xc8, 0x19, 0xad, 0xa7, 0xfb,
+	0x6a, 0x35, 0x42, 0x2f, 0x3b, 0xa1, 0x53, 0xcf, 0x20, 0xd4, 0xa8, 0xfc, 0x7a, 0x67, 0x63, 0xfa,
+	0x78, 0xd3, 0x8c, 0x43, 0x10, 0x76, 0x64, 0x3b, 0xc0, 0xb9, 0xa9, 0x3b, 0x77, 0x27, 0x07, 0xfe,
+	0xbc, 0x3b, 0x39, 0xf0, 0xe3, 0xe6, 0xcc, 0x84, 0x43, 0xad, 0xf3, 0x56, 0x17, 0x28, 0x53, 0x9a,
+	0x33, 0xf0, 0x7e, 0x06, 0x70, 0x6c, 0x91, 0xe8, 0x4c, 0xba, 0x7b, 0x0a, 0x0b, 0x45, 0x59, 0xfd,
+	0x0a, 0x5b, 0x35, 0x07, 0x5b, 0x22, 0x48, 0x8b, 0x72, 0x7d, 0xf9, 0x75, 0xcf, 0xf0, 0xc9, 0xb6,
+	0xd9, 0x8d, 0xf0, 0x55, 0x78, 0x48, 0x2a, 0x7c, 0x93, 0xb8, 0xf9, 0xdd, 0xef, 0x1d, 0x6f, 0x93,
+	0xa0, 0x45, 0x98, 0x6f, 0x10, 0x5a, 0x6f, 0xd8, 0x82, 0xe6, 0xe6, 0x5f, 0xfe, 0xeb, 0xd1, 0xe4,
+	0x70, 0x28, 0x88, 0x3e, 0x6c, 0x59, 0x60, 0x5d, 0xdf, 0xec, 0x6c, 0x4c, 0xf7, 0xda, 0x5c, 0x01,
+	0xec, 0xc2, 0xfb, 0x03, 0xc0, 0xd3, 0x4e, 0x16, 0xe5, 0x2c, 0x13, 0xe8, 0xae, 0xd9, 0x6b, 0x70,
+	0xa4, 0xb3, 0x19, 0xf4, 0x3d, 0x4b, 0xa4, 0x74, 0x2f, 0x94, 0x73, 0x5b, 0x9b, 0x33, 0xcf, 0x3b,
+	0x6a, 0x9d, 0x73, 0xd0, 0x7e, 0xb2, 0xa2, 0x84, 0x3e, 0x6e, 0x3a, 0x7b, 0xdb, 0xd9, 0x11, 0x83,
+	0xf9, 0xec, 0x09, 0x72, 0x90, 0x53, 0xed, 0x50, 0xe6, 0x72, 0xba, 0xbd, 0xde, 0x4f, 0x00, 0x9e,
+	0xff, 0xe7, 0xa1, 0xbe, 0x41, 0x55, 0x63, 0x91, 0x24, 0x5c, 0x52, 0x75, 0x40, 0xf3, 0x3d, 0xde,
+	0x35, 0xdf, 0xda, 0xe5, 0x56, 0xa8, 0x08, 0x0f, 0x47, 0x16, 0xd8, 0xbe, 0x3c, 0xfc, 0xf6, 0x72,
+	0xce, 0xbb, 0xf3, 0xd4, 0x91, 0xf4, 0xbe, 0x07, 0x70, 0xe4, 0x52, 0xf7, 0xa3, 0x84, 0x29, 0xb1,
+	0x8e, 0x96, 0xe0, 0x48, 0xd4, 0x6e, 0x62, 0x4f, 0xc7, 0x8a, 0x5b, 0x9b, 0x33, 0x05, 0x97, 0xb0,
+	0xa7, 0x51, 0x59, 0x48, 0xbb, 0x51, 0x7d, 0x1b, 0x3f, 0xb8, 0xef, 0xc6, 0xcf, 0x8d, 0x6e, 0xed,
+	0x7d, 0xff, 0x78, 0x5f, 0x0c, 0xee, 0x56, 0xe0, 0xeb, 0x1f, 0x74, 0x0e, 0x1e, 0xb7, 0x0f, 0x33,
+	0x96, 0xc6, 0x35, 0x22, 0x0c, 0xf9, 0x21, 0xff, 0x98, 0xb1, 0x5d, 0x33, 0x26, 0x74, 0x0d, 0x8e,
+	0x33, 0xb2, 0xa6, 0x82, 0xbd, 0x4a, 0x07, 0x9f, 0xa2, 0xb4, 0xa0, 0xe3, 0x16, 0x7b, 0xd5, 0xde,
+	0x70, 0xf9, 0xf6, 0x4a, 0x1e, 0x7a, 0x56, 0xc9, 0x26, 0x71, 0xaf, 0x0f, 0x95, 0xcc, 0x0b, 0xc0,
+	0x88, 0x73, 0x6f, 0xd3, 0x9c, 0xdf, 0x65, 0xe9, 0x5b, 0x96, 0xf9, 0xeb, 0xf7, 0xb6, 0x4b, 0xe0,
+	0xfe, 0x76, 0x09, 0x3c, 0xd8, 0x2e, 0x81, 0xdf, 0xb7, 0x4b, 0xe0, 0xf3, 0xc7, 0xa5, 0x81, 0x07,
+	0x8f, 0x4b, 0x03, 0xbf, 0x3e, 0x2e, 0x0d, 0xbc, 0x37, 0xfb, 0xc4, 0xcd, 0xd0, 0xf3, 0x54, 0x34,
+	0x7b, 0xa3, 0x96, 0x37, 0xff, 0x75, 0xbc, 0xf2, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x60, 0x12,
+	0x74, 0x85, 0x28, 0x0d, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -741,6 +908,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundEpochIdentifier != that1.AutoCompoundEpochIdentifier {
+		return false
+	}
+	if this.AutoCompoundBatchSize != that1.AutoCompoundBatchSize {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1048,6 +1221,66 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *AutoCompoundEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AutoCompoundEntry)
+	if !ok {
+		that2, ok := that.(AutoCompoundEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DelegatorAddress != that1.DelegatorAddress {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	return true
+}
+func (this *AutoCompoundRound) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AutoCompoundRound)
+	if !ok {
+		that2, ok := that.(AutoCompoundRound)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EpochNumber != that1.EpochNumber {
+		return false
+	}
+	if this.NextDelegatorAddress != that1.NextDelegatorAddress {
+		return false
+	}
+	if this.NextValidatorAddress != that1.NextValidatorAddress {
+		return false
+	}
+	if this.Compounded != that1.Compounded {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1068,6 +1301,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundBatchSize != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundBatchSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AutoCompoundEpochIdentifier) > 0 {
+		i -= len(m.AutoCompoundEpochIdentifier)
+		copy(dAtA[i:], m.AutoCompoundEpochIdentifier)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.AutoCompoundEpochIdentifier)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1584,6 +1829,90 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoCompoundEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoCompoundRound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundRound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundRound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Compounded != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Compounded))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NextValidatorAddress) > 0 {
+		i -= len(m.NextValidatorAddress)
+		copy(dAtA[i:], m.NextValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.NextValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NextDelegatorAddress) > 0 {
+		i -= len(m.NextDelegatorAddress)
+		copy(dAtA[i:], m.NextDelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.NextDelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -1610,6 +1939,13 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	l = len(m.AutoCompoundEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.AutoCompoundBatchSize != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundBatchSize))
+	}
 	return n
 }
 
@@ -1815,6 +2151,46 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
+func (m *AutoCompoundEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func (m *AutoCompoundRound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovDistribution(uint64(m.EpochNumber))
+	}
+	l = len(m.NextDelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.NextValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.Compounded != 0 {
+		n += 1 + sovDistribution(uint64(m.Compounded))
+	}
+	return n
+}
+
 func sovDistribution(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1972,17 +2348,68 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundEpochIdentifier", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundBatchSize", wireType)
+			}
+			m.AutoCompoundBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -3266,6 +3693,272 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoCompoundEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoCompoundRound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundRound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundRound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextDelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compounded", wireType)
+			}
+			m.Compounded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compounded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
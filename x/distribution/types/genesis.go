@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -36,6 +38,7 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoCompoundEntries:             []AutoCompoundEntry{},
 	}
 }
 
@@ -44,5 +47,21 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+
+	seen := make(map[AutoCompoundEntry]struct{}, len(gs.AutoCompoundEntries))
+	for _, entry := range gs.AutoCompoundEntries {
+		if entry.DelegatorAddress == "" || entry.ValidatorAddress == "" {
+			return fmt.Errorf("invalid auto-compound entry, empty address: %s", entry.String())
+		}
+		if _, ok := seen[entry]; ok {
+			return fmt.Errorf("duplicate auto-compound entry: %s", entry.String())
+		}
+		seen[entry] = struct{}{}
+	}
+
+	if gs.AutoCompoundRound != nil && gs.AutoCompoundRound.EpochNumber < 0 {
+		return fmt.Errorf("invalid auto-compound round epoch number: %d", gs.AutoCompoundRound.EpochNumber)
+	}
+
 	return gs.FeePool.ValidateGenesis()
 }
@@ -348,6 +348,11 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// auto_compound_entries defines the auto-compounding delegations at genesis.
+	AutoCompoundEntries []AutoCompoundEntry `protobuf:"bytes,11,rep,name=auto_compound_entries,json=autoCompoundEntries,proto3" json:"auto_compound_entries"`
+	// auto_compound_round defines the auto-compounding round in progress at
+	// genesis, if any.
+	AutoCompoundRound *AutoCompoundRound `protobuf:"bytes,12,opt,name=auto_compound_round,json=autoCompoundRound,proto3" json:"auto_compound_round,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -399,66 +404,71 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 938 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0x25, 0x4d, 0x26, 0x45, 0x34, 0xd3, 0x34, 0x6c, 0xd2, 0x76, 0x9d, 0x94, 0x1e,
-	0x0a, 0x28, 0x6b, 0x12, 0x10, 0x54, 0x45, 0x20, 0x35, 0x6e, 0xcb, 0x8f, 0x03, 0x8d, 0x6c, 0x09,
-	0x04, 0x42, 0xb2, 0xc6, 0xbb, 0xe3, 0xf5, 0x08, 0x7b, 0xc7, 0x9a, 0x19, 0xaf, 0x01, 0x89, 0x03,
-	0xa7, 0x22, 0xc4, 0x81, 0x13, 0xe2, 0x58, 0x71, 0xaa, 0x90, 0x90, 0x38, 0xf4, 0x8f, 0xe8, 0xb1,
-	0xaa, 0x38, 0x70, 0x02, 0xe4, 0x1c, 0x40, 0x1c, 0xf9, 0x0b, 0xd0, 0xce, 0xcc, 0xee, 0x8e, 0xb3,
-	0xdb, 0xad, 0x1b, 0xf0, 0xa5, 0x8d, 0x67, 0xde, 0x7b, 0xdf, 0xf7, 0xbd, 0xf7, 0xf6, 0xbd, 0x01,
-	0xcf, 0x7b, 0x94, 0x0f, 0x28, 0xaf, 0xfb, 0x84, 0x0b, 0x46, 0x3a, 0x23, 0x41, 0x68, 0x58, 0x8f,
-	0x76, 0x3b, 0x58, 0xa0, 0xdd, 0x7a, 0x80, 0x43, 0xcc, 0x09, 0x77, 0x87, 0x8c, 0x0a, 0x0a, 0xcf,
-	0x29, 0x53, 0xd7, 0x34, 0x75, 0xb5, 0xe9, 0xe6, 0x5a, 0x40, 0x03, 0x2a, 0xed, 0xea, 0xf1, 0x5f,
-	0xca, 0x65, 0xd3, 0xd1, 0xd1, 0x3b, 0x88, 0xe3, 0x34, 0xaa, 0x47, 0x49, 0xa8, 0xef, 0xdd, 0x32,
-	0xf4, 0x29, 0x1c, 0x65, 0xbf, 0xa1, 0xec, 0xdb, 0x0a, 0x48, 0xf3, 0x51, 0x57, 0xab, 0x68, 0x40,
-	0x42, 0x5a, 0x97, 0xff, 0xaa, 0xa3, 0x8b, 0x3f, 0x59, 0xe0, 0xec, 0x75, 0xdc, 0xc7, 0x01, 0x12,
-	0x94, 0x7d, 0x40, 0x44, 0xcf, 0x67, 0x68, 0xfc, 0x4e, 0xd8, 0xa5, 0xf0, 0x06, 0x58, 0xf5, 0x93,
-	0x8b, 0x36, 0xf2, 0x7d, 0x86, 0x39, 0xb7, 0xad, 0x2d, 0xeb, 0xf2, 0xf2, 0xbe, 0xfd, 0xf0, 0xde,
-	0xce, 0x9a, 0x8e, 0x7c, 0x4d, 0xdd, 0xb4, 0x04, 0x23, 0x61, 0xd0, 0x3c, 0x9d, 0xba, 0xe8, 0x73,
-	0xd8, 0x00, 0xa7, 0xc7, 0x3a, 0x6c, 0x1a, 0xa5, 0xfa, 0x98, 0x28, 0xcf, 0x24, 0x1e, 0xfa, 0xf8,
-	0xea, 0xd2, 0x57, 0x77, 0x6a, 0x95, 0xbf, 0xee, 0xd4, 0x2a, 0x17, 0x6f, 0x57, 0xc1, 0xf6, 0xfb,
-	0xa8, 0x4f, 0xfc, 0x18, 0xe3, 0xd6, 0x48, 0x70, 0x81, 0x42, 0x3f, 0xf6, 0xc1, 0x63, 0xc4, 0x7c,
-	0xde, 0xc4, 0x1e, 0x65, 0x3e, 0x7c, 0x0f, 0xac, 0x46, 0x89, 0xd1, 0x11, 0xee, 0xdb, 0x0f, 0xef,
-	0xed, 0x5c, 0xd0, 0xa8, 0x69, 0xa0, 0x23, 0x22, 0xa2, 0x23, 0xe7, 0xf0, 0xb6, 0x05, 0xce, 0xd0,
-	0x0c, 0xac, 0xcd, 0x14, 0x9a, 0x5d, 0xdd, 0x5a, 0xb8, 0xbc, 0xb2, 0x77, 0x5e, 0x97, 0xc8, 0x8d,
-	0x4b, 0x98, 0x54, 0xdb, 0xbd, 0x8e, 0xbd, 0x06, 0x25, 0xe1, 0xfe, 0x95, 0xfb, 0xbf, 0xd5, 0x2a,
-	0x3f, 0xfe, 0x5e, 0x7b, 0x31, 0x20, 0xa2, 0x37, 0xea, 0xb8, 0x1e, 0x1d, 0xe8, 0xaa, 0xe8, 0xff,
-	0x76, 0xb8, 0xff, 0x49, 0x5d, 0x7c, 0x36, 0xc4, 0x3c, 0xf1, 0xe1, 0x77, 0xff, 0xfc, 0xf9, 0x05,
-	0xab, 0x09, 0x69, 0x4e, 0x9f, 0x91, 0x89, 0xbf, 0x2d, 0x70, 0x29, 0x13, 0xe0, 0x79, 0xa3, 0xc1,
-	0xa8, 0x8f, 0x04, 0xf6, 0x1b, 0x74, 0x30, 0x20, 0x9c, 0x13, 0x1a, 0xce, 0x29, 0x19, 0x3d, 0xb0,
-	0x82, 0x32, 0x38, 0x59, 0xcc, 0x95, 0xbd, 0xd7, 0xdd, 0x92, 0xce, 0x77, 0xcb, 0x79, 0xee, 0x2f,
-	0xc7, 0x29, 0x52, 0x9a, 0xcd, 0xd0, 0x86, 0xd8, 0x7f, 0x2c, 0xb0, 0x95, 0x06, 0x79, 0x9b, 0x70,
-	0x41, 0x19, 0xf1, 0x50, 0x7f, 0xbe, 0x55, 0x5f, 0x07, 0x8b, 0x43, 0xcc, 0x08, 0x55, 0x1a, 0x4f,
-	0x34, 0xf5, 0x2f, 0xf8, 0x31, 0x38, 0x99, 0x34, 0xc0, 0x82, 0x14, 0xff, 0xda, 0x6c, 0xe2, 0x73,
-	0xbc, 0x4d, 0xe1, 0x49, 0x48, 0x43, 0xf4, 0x2f, 0x16, 0xb8, 0x90, 0x3a, 0x37, 0x46, 0x8c, 0xe1,
-	0x50, 0xcc, 0x57, 0xf1, 0x87, 0x99, 0x32, 0x55, 0xd6, 0x57, 0x66, 0x53, 0x36, 0x4d, 0xee, 0x31,
-	0xb2, 0x7e, 0xa8, 0x82, 0x73, 0xe9, 0xc8, 0x69, 0x09, 0xc4, 0x04, 0x09, 0x83, 0x78, 0xe4, 0x68,
-	0x51, 0xff, 0xd3, 0xe0, 0x29, 0xcc, 0x4d, 0xf5, 0xf8, 0xb9, 0xe9, 0x80, 0xa7, 0xb9, 0x26, 0xdb,
-	0x26, 0x61, 0x97, 0xea, 0xda, 0xef, 0x95, 0x66, 0xa8, 0x50, 0xa7, 0x99, 0x9f, 0x53, 0xdc, 0xb8,
-	0x30, 0x92, 0xf4, 0x5d, 0x15, 0x6c, 0xa4, 0xd4, 0x5a, 0x7d, 0xc4, 0x7b, 0x37, 0x22, 0x99, 0xe1,
-	0x79, 0x75, 0x7a, 0x0f, 0x93, 0xa0, 0x27, 0x92, 0x4e, 0x57, 0xbf, 0x8c, 0x2f, 0x60, 0x61, 0xea,
-	0x0b, 0xa0, 0xe0, 0x6c, 0x86, 0xcf, 0x63, 0x76, 0x6d, 0x1c, 0xd3, 0xb3, 0x4f, 0xc8, 0x9c, 0xbc,
-	0x34, 0x5b, 0xd7, 0x64, 0xb2, 0xcc, 0x8c, 0x9c, 0x89, 0xf2, 0xf7, 0x46, 0x62, 0xbe, 0x59, 0x06,
-	0xa7, 0xde, 0x52, 0x3b, 0xb7, 0x25, 0x90, 0xc0, 0xf0, 0x26, 0x58, 0x1c, 0x22, 0x86, 0x06, 0x2a,
-	0x01, 0x2b, 0x7b, 0xcf, 0x95, 0x82, 0x1f, 0x48, 0x53, 0x13, 0x4f, 0x7b, 0xc3, 0x77, 0xc1, 0x52,
-	0x17, 0xe3, 0xf6, 0x90, 0xd2, 0xbe, 0x6e, 0xfe, 0x4b, 0xa5, 0x91, 0x6e, 0x62, 0x7c, 0x40, 0x69,
-	0x7f, 0xaa, 0xd9, 0xbb, 0xea, 0x0c, 0x8e, 0x81, 0x9d, 0xb5, 0x70, 0xba, 0xfe, 0xe2, 0xae, 0x89,
-	0x47, 0xc6, 0xc2, 0xec, 0x6d, 0x63, 0x6e, 0x64, 0x13, 0x69, 0xdd, 0x2f, 0xb2, 0xe0, 0xf1, 0xb7,
-	0x33, 0x64, 0x38, 0x22, 0x74, 0x24, 0x1f, 0x00, 0x43, 0xca, 0x31, 0x93, 0x45, 0x29, 0xfd, 0x76,
-	0x12, 0x97, 0x03, 0xed, 0x01, 0x3f, 0x2f, 0x5e, 0x77, 0x4f, 0x49, 0xea, 0x6f, 0xce, 0x56, 0xdd,
-	0x47, 0x2d, 0x67, 0x53, 0x46, 0xc1, 0x86, 0x83, 0xdf, 0x5b, 0x60, 0xdb, 0x68, 0xee, 0x6c, 0x1d,
-	0xb4, 0xbd, 0x74, 0x63, 0x70, 0x7b, 0x51, 0x52, 0xb9, 0xf6, 0x1f, 0xb6, 0x4e, 0x9e, 0x4d, 0x2d,
-	0x2a, 0x75, 0xe0, 0xf0, 0x6b, 0x0b, 0x9c, 0xcf, 0xa8, 0xf5, 0xd2, 0x71, 0x9e, 0x26, 0xe8, 0xa4,
-	0x64, 0xf5, 0xc6, 0x31, 0xd7, 0x41, 0x9e, 0xd1, 0x66, 0xf4, 0x48, 0x63, 0xf8, 0xa5, 0x05, 0x36,
-	0x32, 0x32, 0x9e, 0x9a, 0xc0, 0x29, 0x93, 0x25, 0xc9, 0xe4, 0xea, 0x71, 0xc6, 0x77, 0x9e, 0xc6,
-	0xb3, 0x51, 0xb1, 0x25, 0xfc, 0xc2, 0xec, 0xf3, 0xa9, 0xe9, 0xc8, 0xed, 0x65, 0xc9, 0xe0, 0xca,
-	0x93, 0x8f, 0xc7, 0x3c, 0x7e, 0xd6, 0xed, 0xa6, 0x1d, 0x87, 0x63, 0xb0, 0x5e, 0x38, 0x86, 0xb8,
-	0x0d, 0x24, 0xf8, 0xab, 0x4f, 0x3a, 0x87, 0xf2, 0xd0, 0x6b, 0x05, 0xd3, 0xc8, 0x58, 0x66, 0xfb,
-	0xb7, 0xee, 0x4e, 0x1c, 0xeb, 0xfe, 0xc4, 0xb1, 0x1e, 0x4c, 0x1c, 0xeb, 0x8f, 0x89, 0x63, 0x7d,
-	0x7b, 0xe8, 0x54, 0x1e, 0x1c, 0x3a, 0x95, 0x5f, 0x0f, 0x9d, 0xca, 0x47, 0xbb, 0xa5, 0x6f, 0xbe,
-	0x4f, 0xa7, 0xdf, 0xf4, 0xf2, 0x09, 0xd8, 0x59, 0x94, 0xef, 0xf2, 0x97, 0xff, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0xbe, 0x75, 0xf7, 0x68, 0x75, 0x0c, 0x00, 0x00,
+	// 1024 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0x3a, 0xfd, 0xa6, 0xc9, 0x38, 0x5f, 0xb5, 0x99, 0xfc, 0x60, 0x93, 0xa6, 0x76, 0x52,
+	0x7a, 0x28, 0xa0, 0xac, 0x9b, 0x50, 0xa0, 0x2a, 0x02, 0x29, 0x76, 0x53, 0x7e, 0x1c, 0x68, 0xe4,
+	0x48, 0x20, 0x10, 0x92, 0x35, 0xde, 0x9d, 0xd8, 0x23, 0xec, 0x1d, 0x6b, 0x66, 0x76, 0x4d, 0x91,
+	0x90, 0x40, 0x42, 0x2a, 0xe2, 0xc4, 0x09, 0x71, 0xac, 0x38, 0x55, 0x48, 0x48, 0x1c, 0xf2, 0x47,
+	0xf4, 0x58, 0x55, 0x1c, 0x10, 0x07, 0x40, 0xc9, 0x01, 0xc4, 0x91, 0xbf, 0x00, 0xed, 0xcc, 0xec,
+	0xee, 0xd8, 0xde, 0x6e, 0xdd, 0x40, 0x2e, 0x4e, 0x3c, 0xf3, 0xde, 0xfb, 0x7c, 0x3e, 0xef, 0xbd,
+	0x7d, 0x6f, 0x0d, 0x9e, 0x73, 0x29, 0xef, 0x51, 0x5e, 0xf5, 0x08, 0x17, 0x8c, 0xb4, 0x02, 0x41,
+	0xa8, 0x5f, 0x0d, 0xb7, 0x5a, 0x58, 0xa0, 0xad, 0x6a, 0x1b, 0xfb, 0x98, 0x13, 0xee, 0xf4, 0x19,
+	0x15, 0x14, 0x5e, 0x50, 0xa6, 0x8e, 0x69, 0xea, 0x68, 0xd3, 0xd5, 0xc5, 0x36, 0x6d, 0x53, 0x69,
+	0x57, 0x8d, 0xfe, 0x53, 0x2e, 0xab, 0x65, 0x1d, 0xbd, 0x85, 0x38, 0x4e, 0xa2, 0xba, 0x94, 0xf8,
+	0xfa, 0xde, 0xc9, 0x43, 0x1f, 0xc2, 0x51, 0xf6, 0x2b, 0xca, 0xbe, 0xa9, 0x80, 0x34, 0x1f, 0x75,
+	0x35, 0x8f, 0x7a, 0xc4, 0xa7, 0x55, 0xf9, 0xa9, 0x8e, 0x2e, 0xfd, 0x60, 0x81, 0xa5, 0x9b, 0xb8,
+	0x8b, 0xdb, 0x48, 0x50, 0xf6, 0x1e, 0x11, 0x1d, 0x8f, 0xa1, 0xc1, 0x5b, 0xfe, 0x01, 0x85, 0xbb,
+	0x60, 0xde, 0x8b, 0x2f, 0x9a, 0xc8, 0xf3, 0x18, 0xe6, 0xdc, 0xb6, 0xd6, 0xad, 0x2b, 0xb3, 0x35,
+	0xfb, 0xd1, 0xe1, 0xe6, 0xa2, 0x8e, 0xbc, 0xa3, 0x6e, 0xf6, 0x05, 0x23, 0x7e, 0xbb, 0x71, 0x3e,
+	0x71, 0xd1, 0xe7, 0xb0, 0x0e, 0xce, 0x0f, 0x74, 0xd8, 0x24, 0x4a, 0xf1, 0x09, 0x51, 0xce, 0xc5,
+	0x1e, 0xfa, 0xf8, 0xc6, 0xcc, 0x97, 0xf7, 0x2a, 0x85, 0x3f, 0xef, 0x55, 0x0a, 0x97, 0xee, 0x16,
+	0xc1, 0xc6, 0xbb, 0xa8, 0x4b, 0xbc, 0x08, 0xe3, 0x76, 0x20, 0xb8, 0x40, 0xbe, 0x17, 0xf9, 0xe0,
+	0x01, 0x62, 0x1e, 0x6f, 0x60, 0x97, 0x32, 0x0f, 0xbe, 0x03, 0xe6, 0xc3, 0xd8, 0x68, 0x84, 0xfb,
+	0xc6, 0xa3, 0xc3, 0xcd, 0x8b, 0x1a, 0x35, 0x09, 0x34, 0x22, 0x22, 0x1c, 0x39, 0x87, 0x77, 0x2d,
+	0xb0, 0x40, 0x53, 0xb0, 0x26, 0x53, 0x68, 0x76, 0x71, 0x7d, 0xea, 0x4a, 0x69, 0x7b, 0x4d, 0x97,
+	0xc8, 0x89, 0x4a, 0x18, 0x57, 0xdb, 0xb9, 0x89, 0xdd, 0x3a, 0x25, 0x7e, 0xed, 0xfa, 0x83, 0x5f,
+	0x2b, 0x85, 0xef, 0x7f, 0xab, 0xbc, 0xd0, 0x26, 0xa2, 0x13, 0xb4, 0x1c, 0x97, 0xf6, 0x74, 0x55,
+	0xf4, 0x9f, 0x4d, 0xee, 0x7d, 0x54, 0x15, 0x77, 0xfa, 0x98, 0xc7, 0x3e, 0xfc, 0xfe, 0x1f, 0x3f,
+	0x3e, 0x6f, 0x35, 0x20, 0x1d, 0xd3, 0x67, 0x64, 0xe2, 0x2f, 0x0b, 0x5c, 0x4e, 0x05, 0xb8, 0x6e,
+	0xd0, 0x0b, 0xba, 0x48, 0x60, 0xaf, 0x4e, 0x7b, 0x3d, 0xc2, 0x39, 0xa1, 0xfe, 0x29, 0x25, 0xa3,
+	0x03, 0x4a, 0x28, 0x85, 0x93, 0xc5, 0x2c, 0x6d, 0xbf, 0xea, 0xe4, 0x74, 0xbe, 0x93, 0xcf, 0xb3,
+	0x36, 0x1b, 0xa5, 0x48, 0x69, 0x36, 0x43, 0x1b, 0x62, 0xff, 0xb6, 0xc0, 0x7a, 0x12, 0xe4, 0x4d,
+	0xc2, 0x05, 0x65, 0xc4, 0x45, 0xdd, 0xd3, 0xad, 0xfa, 0x32, 0x98, 0xee, 0x63, 0x46, 0xa8, 0xd2,
+	0x78, 0xa6, 0xa1, 0xbf, 0xc1, 0x0f, 0xc1, 0xd9, 0xb8, 0x01, 0xa6, 0xa4, 0xf8, 0x57, 0x26, 0x13,
+	0x3f, 0xc6, 0xdb, 0x14, 0x1e, 0x87, 0x34, 0x44, 0xff, 0x64, 0x81, 0x8b, 0x89, 0x73, 0x3d, 0x60,
+	0x0c, 0xfb, 0xe2, 0x74, 0x15, 0xbf, 0x9f, 0x2a, 0x53, 0x65, 0xbd, 0x36, 0x99, 0xb2, 0x61, 0x72,
+	0x4f, 0x90, 0xf5, 0x5d, 0x11, 0x5c, 0x48, 0x46, 0xce, 0xbe, 0x40, 0x4c, 0x10, 0xbf, 0x1d, 0x8d,
+	0x1c, 0x2d, 0xea, 0x3f, 0x1a, 0x3c, 0x99, 0xb9, 0x29, 0x9e, 0x3c, 0x37, 0x2d, 0xf0, 0x7f, 0xae,
+	0xc9, 0x36, 0x89, 0x7f, 0x40, 0x75, 0xed, 0xb7, 0x73, 0x33, 0x94, 0xa9, 0xd3, 0xcc, 0xcf, 0x1c,
+	0x37, 0x2e, 0x8c, 0x24, 0x7d, 0x53, 0x04, 0x2b, 0x09, 0xb5, 0xfd, 0x2e, 0xe2, 0x9d, 0xdd, 0x50,
+	0x66, 0xf8, 0xb4, 0x3a, 0xbd, 0x83, 0x49, 0xbb, 0x23, 0xe2, 0x4e, 0x57, 0xdf, 0x8c, 0x27, 0x60,
+	0x6a, 0xe8, 0x09, 0xa0, 0x60, 0x29, 0xc5, 0xe7, 0x11, 0xbb, 0x26, 0x8e, 0xe8, 0xd9, 0x67, 0x64,
+	0x4e, 0xae, 0x4e, 0xd6, 0x35, 0xa9, 0x2c, 0x33, 0x23, 0x0b, 0xe1, 0xf8, 0xbd, 0x91, 0x98, 0x2f,
+	0x4a, 0x60, 0xee, 0x0d, 0xb5, 0x73, 0xf7, 0x05, 0x12, 0x18, 0xde, 0x02, 0xd3, 0x7d, 0xc4, 0x50,
+	0x4f, 0x25, 0xa0, 0xb4, 0xfd, 0x6c, 0x2e, 0xf8, 0x9e, 0x34, 0x35, 0xf1, 0xb4, 0x37, 0x7c, 0x1b,
+	0xcc, 0x1c, 0x60, 0xdc, 0xec, 0x53, 0xda, 0xd5, 0xcd, 0x7f, 0x39, 0x37, 0xd2, 0x2d, 0x8c, 0xf7,
+	0x28, 0xed, 0x0e, 0x35, 0xfb, 0x81, 0x3a, 0x83, 0x03, 0x60, 0xa7, 0x2d, 0x9c, 0xac, 0xbf, 0xa8,
+	0x6b, 0xa2, 0x91, 0x31, 0x35, 0x79, 0xdb, 0x98, 0x1b, 0xd9, 0x44, 0x5a, 0xf6, 0xb2, 0x2c, 0x78,
+	0xf4, 0xec, 0xf4, 0x19, 0x0e, 0x09, 0x0d, 0xe4, 0x0b, 0x40, 0x9f, 0x72, 0xcc, 0x64, 0x51, 0x72,
+	0x9f, 0x9d, 0xd8, 0x65, 0x4f, 0x7b, 0xc0, 0x4f, 0xb2, 0xd7, 0xdd, 0xff, 0x24, 0xf5, 0xd7, 0x27,
+	0xab, 0xee, 0xe3, 0x96, 0xb3, 0x29, 0x23, 0x63, 0xc3, 0xc1, 0x6f, 0x2d, 0xb0, 0x61, 0x34, 0x77,
+	0xba, 0x0e, 0x9a, 0x6e, 0xb2, 0x31, 0xb8, 0x3d, 0x2d, 0xa9, 0xec, 0xfc, 0x8b, 0xad, 0x33, 0xce,
+	0xa6, 0x12, 0xe6, 0x3a, 0x70, 0xf8, 0x95, 0x05, 0xd6, 0x52, 0x6a, 0x9d, 0x64, 0x9c, 0x27, 0x09,
+	0x3a, 0x2b, 0x59, 0xbd, 0x76, 0xc2, 0x75, 0x30, 0xce, 0x68, 0x35, 0x7c, 0xac, 0x31, 0xfc, 0xdc,
+	0x02, 0x2b, 0x29, 0x19, 0x57, 0x4d, 0xe0, 0x84, 0xc9, 0x8c, 0x64, 0x72, 0xe3, 0x24, 0xe3, 0x7b,
+	0x9c, 0xc6, 0x33, 0x61, 0xb6, 0x25, 0xfc, 0xd4, 0xec, 0xf3, 0xa1, 0xe9, 0xc8, 0xed, 0x59, 0xc9,
+	0xe0, 0xfa, 0xd3, 0x8f, 0xc7, 0x71, 0xfc, 0xb4, 0xdb, 0x4d, 0x3b, 0x0e, 0x07, 0x60, 0x39, 0x73,
+	0x0c, 0x71, 0x1b, 0x48, 0xf0, 0x97, 0x9f, 0x76, 0x0e, 0x8d, 0x43, 0x2f, 0x66, 0x4c, 0x23, 0x0e,
+	0x3f, 0xb3, 0xc0, 0x12, 0x0a, 0x04, 0x8d, 0x5a, 0xb2, 0x4f, 0x03, 0xdf, 0x6b, 0x62, 0x5f, 0x30,
+	0x82, 0xb9, 0x5d, 0x92, 0xc0, 0x4e, 0x2e, 0xf0, 0x4e, 0x20, 0x68, 0x5d, 0x3b, 0xee, 0xfa, 0x82,
+	0xdd, 0xa9, 0xad, 0x45, 0x80, 0xbf, 0x1c, 0x6e, 0x9e, 0x4b, 0xdf, 0x08, 0xd7, 0xaf, 0x3a, 0x2f,
+	0x5d, 0xd3, 0x13, 0x11, 0x8d, 0x38, 0x10, 0xcc, 0x21, 0x07, 0x0b, 0xc3, 0x0c, 0x58, 0xf4, 0x69,
+	0xcf, 0xc9, 0xc9, 0x35, 0x39, 0x7e, 0x23, 0xfa, 0xa8, 0x2d, 0x64, 0x60, 0x37, 0xe6, 0xd1, 0xa8,
+	0x5d, 0x3a, 0x86, 0x6b, 0xb7, 0xef, 0x1f, 0x95, 0xad, 0x07, 0x47, 0x65, 0xeb, 0xe1, 0x51, 0xd9,
+	0xfa, 0xfd, 0xa8, 0x6c, 0x7d, 0x7d, 0x5c, 0x2e, 0x3c, 0x3c, 0x2e, 0x17, 0x7e, 0x3e, 0x2e, 0x17,
+	0x3e, 0xd8, 0xca, 0x7d, 0xd7, 0xfd, 0x78, 0xf8, 0xb7, 0x8c, 0x7c, 0xf5, 0x6d, 0x4d, 0xcb, 0xdf,
+	0x23, 0x2f, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x8d, 0x23, 0xca, 0xcd, 0x6d, 0x0d, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -784,6 +794,32 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundRound != nil {
+		{
+			size, err := m.AutoCompoundRound.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.AutoCompoundEntries) > 0 {
+		for iNdEx := len(m.AutoCompoundEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1103,6 +1139,16 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundEntries) > 0 {
+		for _, e := range m.AutoCompoundEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AutoCompoundRound != nil {
+		l = m.AutoCompoundRound.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -2371,6 +2417,76 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundEntries = append(m.AutoCompoundEntries, AutoCompoundEntry{})
+			if err := m.AutoCompoundEntries[len(m.AutoCompoundEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundRound", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoCompoundRound == nil {
+				m.AutoCompoundRound = &AutoCompoundRound{}
+			}
+			if err := m.AutoCompoundRound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
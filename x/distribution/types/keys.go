@@ -43,6 +43,10 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0a<accAddrLen (1 Byte)><accAddr_Bytes><valAddr_Bytes>: []byte{}
+//
+// - 0x0b: AutoCompoundRound
 var (
 	FeePoolKey                        = collections.NewPrefix(0) // key for global distribution state
 	ProposerKey                       = []byte{0x01}             // key for the proposer operator address
@@ -56,6 +60,9 @@ var (
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
 
 	ParamsKey = collections.NewPrefix(9) // key for distribution module params
+
+	AutoCompoundKey      = collections.NewPrefix(10) // key for auto-compounding delegations
+	AutoCompoundRoundKey = collections.NewPrefix(11) // key for the auto-compounding round in progress
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -2,23 +2,42 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	"cosmossdk.io/math"
 )
 
+// Default auto-compounding parameter values
+const (
+	DefaultAutoCompoundEpochIdentifier        = "day"
+	DefaultAutoCompoundBatchSize       uint32 = 100
+)
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        math.LegacyNewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
-		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
-		WithdrawAddrEnabled: true,
+		CommunityTax:                math.LegacyNewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:          math.LegacyZeroDec(),            // deprecated
+		BonusProposerReward:         math.LegacyZeroDec(),            // deprecated
+		WithdrawAddrEnabled:         true,
+		AutoCompoundEpochIdentifier: DefaultAutoCompoundEpochIdentifier,
+		AutoCompoundBatchSize:       DefaultAutoCompoundBatchSize,
 	}
 }
 
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	return validateCommunityTax(p.CommunityTax)
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+
+	return validateAutoCompound(p.AutoCompoundEpochIdentifier, p.AutoCompoundBatchSize)
+}
+
+// IsAutoCompoundEnabled returns true if delegation rewards are auto-compounded
+// at the end of the configured epoch.
+func (p Params) IsAutoCompoundEnabled() bool {
+	return p.AutoCompoundEpochIdentifier != ""
 }
 
 func validateCommunityTax(i any) error {
@@ -48,3 +67,17 @@ func validateWithdrawAddrEnabled(i any) error {
 
 	return nil
 }
+
+func validateAutoCompound(epochIdentifier string, batchSize uint32) error {
+	if epochIdentifier == "" {
+		return nil
+	}
+	if strings.TrimSpace(epochIdentifier) != epochIdentifier {
+		return fmt.Errorf("auto-compound epoch identifier cannot have leading or trailing spaces: %q", epochIdentifier)
+	}
+	if batchSize == 0 {
+		return fmt.Errorf("auto-compound batch size must be positive when auto-compounding is enabled")
+	}
+
+	return nil
+}
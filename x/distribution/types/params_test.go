@@ -45,6 +45,22 @@ func TestParams_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestParams_ValidateAutoCompound(t *testing.T) {
+	p := types.DefaultParams()
+	require.NoError(t, p.ValidateBasic())
+
+	p.AutoCompoundBatchSize = 0
+	require.Error(t, p.ValidateBasic())
+
+	p.AutoCompoundEpochIdentifier = ""
+	require.NoError(t, p.ValidateBasic())
+	require.False(t, p.IsAutoCompoundEnabled())
+
+	p.AutoCompoundEpochIdentifier = " day"
+	p.AutoCompoundBatchSize = 10
+	require.Error(t, p.ValidateBasic())
+}
+
 func TestDefaultParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().ValidateBasic())
 }
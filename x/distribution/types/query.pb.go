@@ -952,6 +952,172 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryDelegatorAutoCompoundRequest is the request type for the
+// Query/DelegatorAutoCompound RPC method.
+type QueryDelegatorAutoCompoundRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) Reset()         { *m = QueryDelegatorAutoCompoundRequest{} }
+func (m *QueryDelegatorAutoCompoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundRequest) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundRequest.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundRequest proto.InternalMessageInfo
+
+// QueryDelegatorAutoCompoundResponse is the response type for the
+// Query/DelegatorAutoCompound RPC method.
+type QueryDelegatorAutoCompoundResponse struct {
+	// validators defines the validators the delegator auto-compounds its
+	// rewards for.
+	Validators []string `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Reset()         { *m = QueryDelegatorAutoCompoundResponse{} }
+func (m *QueryDelegatorAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundResponse) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundResponse.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundResponse proto.InternalMessageInfo
+
+// QueryAutoCompoundRoundRequest is the request type for the
+// Query/AutoCompoundRound RPC method.
+type QueryAutoCompoundRoundRequest struct {
+}
+
+func (m *QueryAutoCompoundRoundRequest) Reset()         { *m = QueryAutoCompoundRoundRequest{} }
+func (m *QueryAutoCompoundRoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundRoundRequest) ProtoMessage()    {}
+func (*QueryAutoCompoundRoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{22}
+}
+func (m *QueryAutoCompoundRoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundRoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundRoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundRoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundRoundRequest.Merge(m, src)
+}
+func (m *QueryAutoCompoundRoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundRoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundRoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundRoundRequest proto.InternalMessageInfo
+
+// QueryAutoCompoundRoundResponse is the response type for the
+// Query/AutoCompoundRound RPC method.
+type QueryAutoCompoundRoundResponse struct {
+	// round is the auto-compounding round in progress, nil if there is none.
+	Round *AutoCompoundRound `protobuf:"bytes,1,opt,name=round,proto3" json:"round,omitempty"`
+}
+
+func (m *QueryAutoCompoundRoundResponse) Reset()         { *m = QueryAutoCompoundRoundResponse{} }
+func (m *QueryAutoCompoundRoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundRoundResponse) ProtoMessage()    {}
+func (*QueryAutoCompoundRoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{23}
+}
+func (m *QueryAutoCompoundRoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundRoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundRoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundRoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundRoundResponse.Merge(m, src)
+}
+func (m *QueryAutoCompoundRoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundRoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundRoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundRoundResponse proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundRoundResponse) GetRound() *AutoCompoundRound {
+	if m != nil {
+		return m.Round
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -973,6 +1139,10 @@ func init() {
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryDelegatorAutoCompoundRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundRequest")
+	proto.RegisterType((*QueryDelegatorAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundResponse")
+	proto.RegisterType((*QueryAutoCompoundRoundRequest)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundRoundRequest")
+	proto.RegisterType((*QueryAutoCompoundRoundResponse)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundRoundResponse")
 }
 
 func init() {
@@ -980,87 +1150,97 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xce, 0xb8, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x9e, 0x46, 0xc8, 0xd9, 0xa4, 0x4e, 0x70,
-	0x68, 0x13, 0x35, 0x8a, 0xb7, 0x49, 0xa4, 0x52, 0x12, 0x2a, 0x88, 0x9d, 0x84, 0xa2, 0x46, 0x69,
-	0xeb, 0x16, 0x22, 0x40, 0x95, 0xb5, 0xf6, 0x6e, 0x36, 0x0b, 0xf6, 0x8e, 0xb3, 0xb3, 0x8e, 0x89,
-	0xaa, 0x5e, 0xca, 0xa5, 0xf4, 0x84, 0xe0, 0xc2, 0x91, 0x23, 0xe2, 0xc4, 0xa1, 0x9c, 0xe1, 0xc0,
-	0xa1, 0xc7, 0xaa, 0x48, 0x88, 0x13, 0xa0, 0x04, 0x89, 0x72, 0x00, 0x71, 0xe3, 0x8a, 0x76, 0x66,
-	0xd6, 0xde, 0xb5, 0xd7, 0xeb, 0x7f, 0xf2, 0x25, 0xb1, 0x66, 0xe7, 0x7d, 0xdf, 0xfb, 0xde, 0x7b,
-	0x33, 0xfb, 0xd9, 0x30, 0x9b, 0x27, 0xb4, 0x48, 0xa8, 0xac, 0x1a, 0xd4, 0xb6, 0x8c, 0x5c, 0xd9,
-	0x36, 0x88, 0x29, 0x1f, 0x2c, 0xe6, 0x34, 0x5b, 0x59, 0x94, 0xf7, 0xcb, 0x9a, 0x75, 0x98, 0x2c,
-	0x59, 0xc4, 0x26, 0x78, 0x82, 0x6f, 0x4c, 0x7a, 0x37, 0x26, 0xc5, 0x46, 0xe9, 0xa2, 0x40, 0xc9,
-	0x29, 0x54, 0xe3, 0x51, 0x55, 0x8c, 0x92, 0xa2, 0x1b, 0xa6, 0xc2, 0x76, 0x33, 0x20, 0x69, 0x4c,
-	0x27, 0x3a, 0x61, 0x1f, 0x65, 0xe7, 0x93, 0x58, 0x9d, 0xd4, 0x09, 0xd1, 0x0b, 0x9a, 0xac, 0x94,
-	0x0c, 0x59, 0x31, 0x4d, 0x62, 0xb3, 0x10, 0x2a, 0x9e, 0xc6, 0xbd, 0xf8, 0x2e, 0x72, 0x9e, 0x18,
-	0x2e, 0x66, 0x32, 0x4c, 0x85, 0x2f, 0x63, 0xbe, 0x7f, 0x9c, 0xef, 0xcf, 0xf2, 0x34, 0x84, 0x32,
-	0xfe, 0x28, 0xaa, 0x14, 0x0d, 0x93, 0xc8, 0xec, 0x2f, 0x5f, 0x4a, 0x8c, 0x01, 0xbe, 0xe5, 0x68,
-	0xba, 0xa9, 0x58, 0x4a, 0x91, 0x66, 0xb4, 0xfd, 0xb2, 0x46, 0xed, 0xc4, 0x5d, 0x38, 0xeb, 0x5b,
-	0xa5, 0x25, 0x62, 0x52, 0x0d, 0x6f, 0xc2, 0x50, 0x89, 0xad, 0xc4, 0xd0, 0x34, 0x9a, 0x3b, 0xbd,
-	0x34, 0x93, 0x0c, 0x29, 0x5c, 0x92, 0x07, 0xa7, 0x86, 0x9f, 0xfc, 0x32, 0x35, 0xf0, 0xd5, 0x1f,
-	0xdf, 0x5c, 0x44, 0x19, 0x11, 0x9d, 0xa8, 0xc0, 0x79, 0x06, 0xff, 0x8e, 0x52, 0x30, 0x54, 0xc5,
-	0x26, 0xd6, 0xba, 0x27, 0xfe, 0x2d, 0x73, 0x97, 0x88, 0x3c, 0xf0, 0x36, 0x44, 0x0f, 0xdc, 0x3d,
-	0x59, 0x45, 0x55, 0x2d, 0x8d, 0x72, 0xee, 0xe1, 0xd4, 0x4b, 0xcf, 0x1e, 0x2f, 0x9c, 0x13, 0xf4,
-	0x55, 0x9c, 0x35, 0xbe, 0xe5, 0xb6, 0x6d, 0x19, 0xa6, 0x9e, 0x19, 0x3d, 0xa8, 0x5b, 0x4f, 0xfc,
-	0x1d, 0x81, 0x0b, 0xad, 0x98, 0x85, 0xd6, 0x2d, 0x18, 0x25, 0x25, 0xcd, 0xea, 0x8e, 0x79, 0xc4,
-	0x0d, 0x15, 0xcb, 0xf8, 0x01, 0x82, 0x28, 0xd5, 0x0a, 0xbb, 0xd9, 0x1c, 0x31, 0xd5, 0xac, 0xa5,
-	0x55, 0x14, 0x4b, 0xa5, 0xb1, 0xc8, 0xf4, 0x89, 0xb9, 0xd3, 0x4b, 0x93, 0x6e, 0x15, 0x9d, 0x09,
-	0xa8, 0x56, 0x6f, 0x5d, 0xcb, 0xa7, 0x89, 0x61, 0xa6, 0xae, 0x38, 0xe5, 0xfb, 0xfa, 0xd7, 0xa9,
-	0x79, 0xdd, 0xb0, 0xf7, 0xca, 0xb9, 0x64, 0x9e, 0x14, 0x45, 0x53, 0xc5, 0xbf, 0x05, 0xaa, 0x7e,
-	0x28, 0xdb, 0x87, 0x25, 0x8d, 0xba, 0x31, 0x94, 0x57, 0x7b, 0xc4, 0x21, 0x4c, 0x11, 0x53, 0xcd,
-	0x70, 0x3a, 0xbc, 0x0f, 0x90, 0x27, 0xc5, 0xa2, 0x41, 0xa9, 0x41, 0xcc, 0xd8, 0x89, 0x36, 0xc8,
-	0x97, 0xbb, 0x20, 0xcf, 0x78, 0x48, 0x12, 0x87, 0x30, 0xeb, 0xaf, 0xf7, 0x8d, 0xb2, 0x4d, 0x6d,
-	0xc5, 0x54, 0x9d, 0x2a, 0xf1, 0xb4, 0xfa, 0xd5, 0xeb, 0x4f, 0x10, 0xcc, 0xb5, 0xe6, 0x16, 0xdd,
-	0xbe, 0x0b, 0xa7, 0xdc, 0xa6, 0xf0, 0xd1, 0xbe, 0x12, 0x3a, 0xda, 0x21, 0x90, 0xde, 0x79, 0x77,
-	0x31, 0x13, 0xfb, 0x30, 0xe5, 0x4f, 0x25, 0x5d, 0x2d, 0x51, 0xbf, 0xe4, 0x3f, 0x42, 0x30, 0xdd,
-	0x9c, 0x53, 0xc8, 0xde, 0xf5, 0x4d, 0x04, 0x57, 0xbe, 0xda, 0x9e, 0xf2, 0xb5, 0x7c, 0xbe, 0x5c,
-	0x2c, 0x17, 0x14, 0x5b, 0x53, 0x6b, 0xc0, 0x5e, 0xf1, 0xde, 0x31, 0x78, 0x14, 0x81, 0x49, 0x7f,
-	0x32, 0xb7, 0x0b, 0x0a, 0xdd, 0xd3, 0xfa, 0xd5, 0x7c, 0x3c, 0x0b, 0x23, 0xd4, 0x56, 0x2c, 0xdb,
-	0x30, 0xf5, 0xec, 0x9e, 0x66, 0xe8, 0x7b, 0x76, 0x2c, 0x32, 0x8d, 0xe6, 0x06, 0x33, 0x2f, 0xb8,
-	0xcb, 0xd7, 0xd8, 0x2a, 0x9e, 0x81, 0x33, 0x1a, 0x6b, 0x9f, 0xbb, 0xed, 0x04, 0xdb, 0xf6, 0x7f,
-	0xbe, 0x28, 0x36, 0x6d, 0x02, 0xd4, 0xae, 0xfa, 0xd8, 0x20, 0x2b, 0xd3, 0x05, 0xdf, 0xc1, 0xe1,
-	0x6f, 0x93, 0xda, 0xcd, 0xa7, 0x6b, 0x42, 0x59, 0xc6, 0x13, 0xb9, 0x32, 0xf8, 0xf0, 0xcb, 0xa9,
-	0x81, 0xc4, 0x77, 0x08, 0xce, 0x35, 0x29, 0x86, 0x68, 0xcb, 0xdb, 0x70, 0x8a, 0xf2, 0xa5, 0x18,
-	0x62, 0xa7, 0xf4, 0x52, 0x7b, 0x3d, 0x61, 0x38, 0x1b, 0x07, 0x9a, 0x69, 0xfb, 0xa6, 0x50, 0x60,
-	0xe1, 0x37, 0x7d, 0x32, 0x22, 0x4c, 0xc6, 0x6c, 0x4b, 0x19, 0x3c, 0x27, 0xaf, 0x8e, 0xc4, 0xf7,
-	0xae, 0x82, 0x75, 0xad, 0xa0, 0xe9, 0x6c, 0xad, 0xee, 0x30, 0x6f, 0x40, 0x54, 0xe5, 0xcf, 0x1a,
-	0xfa, 0x19, 0x7b, 0xf6, 0x78, 0x61, 0x4c, 0x90, 0xd6, 0xb5, 0xb1, 0x1a, 0xe2, 0xb6, 0x31, 0x70,
-	0x2c, 0x22, 0x5d, 0x8f, 0xc5, 0xca, 0xff, 0x9c, 0x06, 0x3c, 0x77, 0x9a, 0xf0, 0x19, 0x82, 0x78,
-	0x33, 0x09, 0xa2, 0x0b, 0x25, 0xef, 0x9d, 0xd0, 0xcf, 0x8b, 0xba, 0x7a, 0x4d, 0x94, 0x21, 0x51,
-	0x97, 0xd3, 0x1d, 0x62, 0x2b, 0x85, 0xbe, 0xd4, 0xd6, 0x53, 0x8b, 0x7f, 0x10, 0xcc, 0x84, 0xf2,
-	0x8a, 0x82, 0xbc, 0x5f, 0x5f, 0x90, 0xcb, 0xa1, 0x63, 0x59, 0x43, 0x5b, 0x77, 0xb9, 0x39, 0x62,
-	0xd0, 0x15, 0x89, 0x0b, 0x70, 0xd2, 0x76, 0x48, 0xfb, 0xfc, 0x52, 0xe4, 0x24, 0x09, 0x4b, 0x5c,
-	0xc8, 0xd5, 0xcc, 0xaa, 0x23, 0xd4, 0xbf, 0x32, 0x6f, 0x89, 0x0b, 0x39, 0x90, 0x53, 0x94, 0x38,
-	0x0e, 0x50, 0x1d, 0x5a, 0x5e, 0xe5, 0xe1, 0x8c, 0x67, 0xc5, 0x83, 0x56, 0x81, 0x97, 0xfd, 0x68,
-	0x3b, 0x86, 0xbd, 0xa7, 0x5a, 0x4a, 0x45, 0x10, 0xf7, 0x4d, 0xc6, 0x81, 0x30, 0x6f, 0xcd, 0x89,
-	0x85, 0x96, 0x34, 0x8c, 0x56, 0xc4, 0xa3, 0xb6, 0x89, 0x47, 0x2a, 0x7e, 0x30, 0x0f, 0xef, 0x04,
-	0x8c, 0x33, 0x5e, 0xe7, 0x6d, 0x53, 0x36, 0x0d, 0xfb, 0xf0, 0x26, 0x21, 0x05, 0xd7, 0xb0, 0x3e,
-	0x44, 0x20, 0x05, 0x3d, 0x15, 0xa9, 0x7c, 0x00, 0x83, 0x25, 0x42, 0x0a, 0x7d, 0x3e, 0xc7, 0x8c,
-	0x63, 0xe9, 0x87, 0x28, 0x9c, 0x64, 0xa9, 0xe0, 0x2f, 0x10, 0x0c, 0x71, 0x13, 0x8c, 0xe5, 0xd0,
-	0x93, 0xd2, 0xe8, 0xc0, 0xa5, 0x4b, 0xed, 0x07, 0x70, 0x8d, 0x89, 0xf9, 0x07, 0x3f, 0xfe, 0xfe,
-	0x79, 0xe4, 0x3c, 0x9e, 0x91, 0xc3, 0xbe, 0x30, 0x70, 0x07, 0x8e, 0xff, 0x44, 0x30, 0xde, 0xd4,
-	0x03, 0xe3, 0x54, 0x6b, 0xf2, 0x56, 0xd6, 0x5d, 0x4a, 0xf7, 0x84, 0x21, 0x34, 0xa5, 0x99, 0xa6,
-	0xab, 0x78, 0x35, 0x54, 0x53, 0xed, 0x7c, 0xc8, 0xf7, 0x1a, 0x5e, 0x17, 0xf7, 0xf1, 0xc7, 0x11,
-	0x98, 0x08, 0x31, 0x6c, 0x78, 0xbd, 0x83, 0x4c, 0x9b, 0xda, 0x57, 0x69, 0xa3, 0x47, 0x14, 0xa1,
-	0x78, 0x87, 0x29, 0xbe, 0x85, 0x6f, 0xf4, 0xa0, 0x58, 0x26, 0x35, 0x7c, 0xf7, 0xbb, 0x06, 0x3e,
-	0x42, 0x70, 0x36, 0xc0, 0x0a, 0xe2, 0xd7, 0x3a, 0xc8, 0xbb, 0xc1, 0xb5, 0x4a, 0x57, 0xbb, 0x8c,
-	0x16, 0x6a, 0xb7, 0x99, 0xda, 0x6b, 0x78, 0xb3, 0x17, 0xb5, 0x35, 0x9f, 0x89, 0x7f, 0x42, 0x30,
-	0x5a, 0xef, 0xaa, 0xf0, 0xab, 0x1d, 0xe4, 0xe8, 0xb7, 0xa5, 0xd2, 0x4a, 0x37, 0xa1, 0x42, 0xdb,
-	0x75, 0xa6, 0x6d, 0x03, 0xa7, 0x7b, 0xd1, 0xe6, 0x5a, 0xb7, 0xbf, 0x10, 0x44, 0x1b, 0x9c, 0x0a,
-	0x6e, 0x23, 0xbd, 0x66, 0x0e, 0x4d, 0x5a, 0xed, 0x2a, 0x56, 0x68, 0xcb, 0x32, 0x6d, 0xef, 0xe2,
-	0x9d, 0x50, 0x6d, 0xd5, 0x97, 0x08, 0x95, 0xef, 0x35, 0xbc, 0x83, 0xee, 0xcb, 0x62, 0x32, 0x03,
-	0xcf, 0xec, 0x73, 0x04, 0x2f, 0x06, 0xbb, 0x11, 0xfc, 0x7a, 0x27, 0x89, 0x07, 0xf8, 0x27, 0xe9,
-	0x8d, 0xee, 0x01, 0x3a, 0x6a, 0x6d, 0x7b, 0xf2, 0xd9, 0xc1, 0x0c, 0xb0, 0x04, 0xed, 0x1c, 0xcc,
-	0xe6, 0xee, 0xa5, 0x9d, 0x83, 0x19, 0xe2, 0x43, 0xda, 0x3c, 0x98, 0x2d, 0x14, 0xd6, 0x66, 0x1b,
-	0xff, 0x8b, 0x20, 0xd6, 0xcc, 0x30, 0xe0, 0xb5, 0x0e, 0x72, 0x0d, 0x76, 0x39, 0x52, 0xaa, 0x17,
-	0x08, 0xa1, 0xf9, 0x0e, 0xd3, 0xbc, 0x8d, 0xb7, 0x7a, 0xd1, 0x5c, 0xef, 0x78, 0xf0, 0xb7, 0x08,
-	0xce, 0xf8, 0x4c, 0x09, 0xbe, 0xdc, 0x3a, 0xd7, 0x20, 0x8f, 0x23, 0xbd, 0xd2, 0x71, 0x9c, 0x10,
-	0xb6, 0xcc, 0x84, 0x2d, 0xe0, 0xf9, 0x50, 0x61, 0x79, 0x37, 0x36, 0xeb, 0xd8, 0x98, 0xd4, 0xf5,
-	0x27, 0x47, 0x71, 0xf4, 0xf4, 0x28, 0x8e, 0x7e, 0x3b, 0x8a, 0xa3, 0x4f, 0x8f, 0xe3, 0x03, 0x4f,
-	0x8f, 0xe3, 0x03, 0x3f, 0x1f, 0xc7, 0x07, 0xde, 0x5b, 0x0c, 0x35, 0x46, 0x1f, 0xf9, 0xd1, 0x99,
-	0x4f, 0xca, 0x0d, 0xb1, 0x1f, 0x1b, 0x97, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x2b, 0x1c,
-	0xf2, 0x92, 0x15, 0x00, 0x00,
+	// 1435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xce, 0xb8, 0x69, 0x4b, 0x5f, 0x29, 0x49, 0xa6, 0x2d, 0x72, 0x37, 0xad, 0x93, 0x6e, 0x68,
+	0x13, 0xb5, 0x8a, 0x37, 0x4d, 0x4b, 0x29, 0x29, 0xa5, 0xc4, 0x76, 0x4a, 0xab, 0x54, 0xfd, 0x71,
+	0x0b, 0x15, 0xa0, 0xca, 0x5a, 0x7b, 0xb7, 0xce, 0x82, 0xbd, 0xe3, 0xec, 0x4f, 0x4c, 0x54, 0xf5,
+	0x52, 0x90, 0x28, 0x3d, 0x21, 0xb8, 0x70, 0x84, 0x1b, 0xe2, 0xc4, 0xa1, 0x9c, 0xe1, 0x58, 0x71,
+	0xaa, 0x8a, 0x84, 0xe0, 0x02, 0x28, 0x41, 0xa2, 0x1c, 0x40, 0xdc, 0xb8, 0x21, 0xb4, 0x33, 0xb3,
+	0xf6, 0xae, 0xbd, 0xbb, 0x5e, 0xdb, 0xf8, 0xd2, 0x5a, 0xb3, 0xf3, 0xde, 0xf7, 0xbe, 0xf7, 0xde,
+	0xbc, 0xf9, 0x46, 0x81, 0xe9, 0x12, 0x31, 0xab, 0xc4, 0x94, 0x14, 0xcd, 0xb4, 0x0c, 0xad, 0x68,
+	0x5b, 0x1a, 0xd1, 0xa5, 0xb5, 0x63, 0x45, 0xd5, 0x92, 0x8f, 0x49, 0xab, 0xb6, 0x6a, 0xac, 0xa7,
+	0x6b, 0x06, 0xb1, 0x08, 0x1e, 0x67, 0x1b, 0xd3, 0xde, 0x8d, 0x69, 0xbe, 0x51, 0x38, 0xc2, 0xbd,
+	0x14, 0x65, 0x53, 0x65, 0x56, 0x0d, 0x1f, 0x35, 0xb9, 0xac, 0xe9, 0x32, 0xdd, 0x4d, 0x1d, 0x09,
+	0x7b, 0xca, 0xa4, 0x4c, 0xe8, 0x4f, 0xc9, 0xf9, 0xc5, 0x57, 0xf7, 0x97, 0x09, 0x29, 0x57, 0x54,
+	0x49, 0xae, 0x69, 0x92, 0xac, 0xeb, 0xc4, 0xa2, 0x26, 0x26, 0xff, 0x9a, 0xf2, 0xfa, 0x77, 0x3d,
+	0x97, 0x88, 0xe6, 0xfa, 0x4c, 0x47, 0xb1, 0xf0, 0x45, 0xcc, 0xf6, 0xef, 0x63, 0xfb, 0x0b, 0x2c,
+	0x0c, 0xce, 0x8c, 0x7d, 0x1a, 0x93, 0xab, 0x9a, 0x4e, 0x24, 0xfa, 0x2f, 0x5b, 0x12, 0xf7, 0x00,
+	0xbe, 0xea, 0x70, 0xba, 0x22, 0x1b, 0x72, 0xd5, 0xcc, 0xab, 0xab, 0xb6, 0x6a, 0x5a, 0xe2, 0x4d,
+	0xd8, 0xed, 0x5b, 0x35, 0x6b, 0x44, 0x37, 0x55, 0x7c, 0x0e, 0xb6, 0xd5, 0xe8, 0x4a, 0x12, 0x4d,
+	0xa2, 0x99, 0x9d, 0xf3, 0x53, 0xe9, 0x88, 0xc4, 0xa5, 0x99, 0x71, 0x66, 0xc7, 0xc3, 0x9f, 0x27,
+	0x86, 0xbe, 0xf8, 0xfd, 0xab, 0x23, 0x28, 0xcf, 0xad, 0xc5, 0x3a, 0x1c, 0xa2, 0xee, 0x5f, 0x97,
+	0x2b, 0x9a, 0x22, 0x5b, 0xc4, 0xc8, 0x79, 0xec, 0x2f, 0xe8, 0xb7, 0x08, 0x8f, 0x03, 0x5f, 0x82,
+	0xb1, 0x35, 0x77, 0x4f, 0x41, 0x56, 0x14, 0x43, 0x35, 0x19, 0xf6, 0x8e, 0xcc, 0xc1, 0xc7, 0x0f,
+	0x66, 0x0f, 0x70, 0xf8, 0x86, 0x9f, 0x45, 0xb6, 0xe5, 0x9a, 0x65, 0x68, 0x7a, 0x39, 0x3f, 0xba,
+	0xd6, 0xb2, 0x2e, 0xfe, 0x95, 0x80, 0xc3, 0x9d, 0x90, 0x39, 0xd7, 0x8b, 0x30, 0x4a, 0x6a, 0xaa,
+	0xd1, 0x1b, 0xf2, 0x88, 0x6b, 0xca, 0x97, 0xf1, 0x5d, 0x04, 0x63, 0xa6, 0x5a, 0xb9, 0x55, 0x28,
+	0x12, 0x5d, 0x29, 0x18, 0x6a, 0x5d, 0x36, 0x14, 0x33, 0x99, 0x98, 0xdc, 0x32, 0xb3, 0x73, 0x7e,
+	0xbf, 0x9b, 0x45, 0xa7, 0x03, 0x1a, 0xd9, 0xcb, 0xa9, 0xa5, 0x2c, 0xd1, 0xf4, 0xcc, 0x29, 0x27,
+	0x7d, 0x5f, 0xfe, 0x32, 0x71, 0xb4, 0xac, 0x59, 0x2b, 0x76, 0x31, 0x5d, 0x22, 0x55, 0x5e, 0x54,
+	0xfe, 0xdf, 0xac, 0xa9, 0xbc, 0x23, 0x59, 0xeb, 0x35, 0xd5, 0x74, 0x6d, 0x4c, 0x96, 0xed, 0x11,
+	0x07, 0x30, 0x43, 0x74, 0x25, 0xcf, 0xe0, 0xf0, 0x2a, 0x40, 0x89, 0x54, 0xab, 0x9a, 0x69, 0x6a,
+	0x44, 0x4f, 0x6e, 0x89, 0x01, 0x7e, 0xbc, 0x07, 0xf0, 0xbc, 0x07, 0x44, 0x5c, 0x87, 0x69, 0x7f,
+	0xbe, 0x2f, 0xdb, 0x96, 0x69, 0xc9, 0xba, 0xe2, 0x64, 0x89, 0x85, 0x35, 0xa8, 0x5a, 0x7f, 0x88,
+	0x60, 0xa6, 0x33, 0x36, 0xaf, 0xf6, 0x4d, 0xd8, 0xee, 0x16, 0x85, 0xb5, 0xf6, 0xa9, 0xc8, 0xd6,
+	0x8e, 0x70, 0xe9, 0xed, 0x77, 0xd7, 0xa7, 0xb8, 0x0a, 0x13, 0xfe, 0x50, 0xb2, 0x8d, 0x14, 0x0d,
+	0x8a, 0xfe, 0x7d, 0x04, 0x93, 0xe1, 0x98, 0x9c, 0xf6, 0x2d, 0x5f, 0x47, 0x30, 0xe6, 0xa7, 0xe3,
+	0x31, 0x5f, 0x2c, 0x95, 0xec, 0xaa, 0x5d, 0x91, 0x2d, 0x55, 0x69, 0x3a, 0xf6, 0x92, 0xf7, 0xb6,
+	0xc1, 0xfd, 0x04, 0xec, 0xf7, 0x07, 0x73, 0xad, 0x22, 0x9b, 0x2b, 0xea, 0xa0, 0x8a, 0x8f, 0xa7,
+	0x61, 0xc4, 0xb4, 0x64, 0xc3, 0xd2, 0xf4, 0x72, 0x61, 0x45, 0xd5, 0xca, 0x2b, 0x56, 0x32, 0x31,
+	0x89, 0x66, 0x86, 0xf3, 0xcf, 0xb8, 0xcb, 0xe7, 0xe9, 0x2a, 0x9e, 0x82, 0x5d, 0x2a, 0x2d, 0x9f,
+	0xbb, 0x6d, 0x0b, 0xdd, 0xf6, 0x34, 0x5b, 0xe4, 0x9b, 0xce, 0x01, 0x34, 0x47, 0x7d, 0x72, 0x98,
+	0xa6, 0xe9, 0xb0, 0xef, 0xe0, 0xb0, 0xdb, 0xa4, 0x39, 0xf9, 0xca, 0x2a, 0x67, 0x96, 0xf7, 0x58,
+	0x2e, 0x0c, 0xdf, 0xfb, 0x6c, 0x62, 0x48, 0xfc, 0x06, 0xc1, 0x81, 0x90, 0x64, 0xf0, 0xb2, 0xbc,
+	0x06, 0xdb, 0x4d, 0xb6, 0x94, 0x44, 0xf4, 0x94, 0xce, 0xc5, 0xab, 0x09, 0xf5, 0xb3, 0xb4, 0xa6,
+	0xea, 0x96, 0xaf, 0x0b, 0xb9, 0x2f, 0xfc, 0xaa, 0x8f, 0x46, 0x82, 0xd2, 0x98, 0xee, 0x48, 0x83,
+	0xc5, 0xe4, 0xe5, 0x21, 0x7e, 0xeb, 0x32, 0xc8, 0xa9, 0x15, 0xb5, 0x4c, 0xd7, 0x5a, 0x0e, 0xf3,
+	0x12, 0x8c, 0x29, 0xec, 0x5b, 0x5b, 0x3d, 0x93, 0x8f, 0x1f, 0xcc, 0xee, 0xe1, 0xa0, 0x2d, 0x65,
+	0x6c, 0x98, 0xb8, 0x65, 0x0c, 0x6c, 0x8b, 0x44, 0xcf, 0x6d, 0xb1, 0xf0, 0x94, 0x53, 0x80, 0x27,
+	0x4e, 0x11, 0x3e, 0x46, 0x90, 0x0a, 0xa3, 0xc0, 0xab, 0x50, 0xf3, 0xce, 0x84, 0x41, 0x0e, 0xea,
+	0xc6, 0x98, 0xb0, 0x41, 0x6c, 0x89, 0xe9, 0x3a, 0xb1, 0xe4, 0xca, 0x40, 0x72, 0xeb, 0xc9, 0xc5,
+	0xdf, 0x08, 0xa6, 0x22, 0x71, 0x79, 0x42, 0xde, 0x6a, 0x4d, 0xc8, 0xc9, 0xc8, 0xb6, 0x6c, 0x7a,
+	0xcb, 0xb9, 0xd8, 0xcc, 0x63, 0xd0, 0x88, 0xc4, 0x15, 0xd8, 0x6a, 0x39, 0xa0, 0x03, 0xbe, 0x14,
+	0x19, 0x88, 0x68, 0xf0, 0x81, 0xdc, 0x88, 0xac, 0xd1, 0x42, 0x83, 0x4b, 0xf3, 0x45, 0x3e, 0x90,
+	0x03, 0x31, 0x79, 0x8a, 0x53, 0x00, 0x8d, 0xa6, 0x65, 0x59, 0xde, 0x91, 0xf7, 0xac, 0x78, 0xbc,
+	0xd5, 0xe1, 0x39, 0xbf, 0xb7, 0x1b, 0x9a, 0xb5, 0xa2, 0x18, 0x72, 0x9d, 0x03, 0x0f, 0x8c, 0xc6,
+	0x1a, 0x17, 0x6f, 0xe1, 0xc0, 0x9c, 0x4b, 0x16, 0x46, 0xeb, 0xfc, 0x53, 0x6c, 0xe0, 0x91, 0xba,
+	0xdf, 0x99, 0x07, 0x77, 0x1c, 0xf6, 0x51, 0x5c, 0xe7, 0xb6, 0xb1, 0x75, 0xcd, 0x5a, 0xbf, 0x42,
+	0x48, 0xc5, 0x15, 0xac, 0xf7, 0x10, 0x08, 0x41, 0x5f, 0x79, 0x28, 0x6f, 0xc3, 0x70, 0x8d, 0x90,
+	0xca, 0x80, 0xcf, 0x31, 0xc5, 0x10, 0x3f, 0x40, 0x70, 0xd0, 0x9f, 0xa0, 0x45, 0xdb, 0x22, 0x59,
+	0x52, 0xad, 0x11, 0xdb, 0xd1, 0x62, 0xff, 0x6b, 0x59, 0xc6, 0xdd, 0xf4, 0x3c, 0x7e, 0x30, 0x3b,
+	0xd2, 0x0c, 0x6f, 0x72, 0x2e, 0xfd, 0xfc, 0x09, 0xf1, 0x7d, 0xe4, 0x9f, 0x27, 0xad, 0x91, 0xf0,
+	0xe4, 0x2c, 0xb6, 0xf7, 0x5c, 0x9c, 0xe9, 0xea, 0x6d, 0xcb, 0xc8, 0x30, 0x4e, 0xf0, 0xcb, 0xc2,
+	0x07, 0xee, 0xc9, 0xc5, 0xc2, 0xee, 0x20, 0xab, 0xdb, 0x7c, 0x3e, 0x07, 0x58, 0xf1, 0xb8, 0x73,
+	0xb0, 0xd5, 0x70, 0x16, 0xb8, 0x6e, 0x49, 0x47, 0x0e, 0xa3, 0x76, 0x37, 0xcc, 0x38, 0x10, 0x7c,
+	0xfe, 0xf3, 0xbd, 0xb0, 0x95, 0xa2, 0xe3, 0x4f, 0x11, 0x6c, 0x63, 0x0f, 0x19, 0x2c, 0x45, 0x02,
+	0xb4, 0xbf, 0xa2, 0x84, 0xb9, 0xf8, 0x06, 0x8c, 0x92, 0x78, 0xf4, 0xee, 0xf7, 0xbf, 0x7d, 0x92,
+	0x38, 0x84, 0xa7, 0xa4, 0xa8, 0x47, 0x1f, 0x7b, 0x45, 0xe1, 0x3f, 0x10, 0xec, 0x0b, 0x7d, 0xc7,
+	0xe0, 0x4c, 0x67, 0xf0, 0x4e, 0xcf, 0x2f, 0x21, 0xdb, 0x97, 0x0f, 0xce, 0x29, 0x4b, 0x39, 0x9d,
+	0xc1, 0xa7, 0x23, 0x39, 0x35, 0x9b, 0x49, 0xba, 0xdd, 0x76, 0xe5, 0xdf, 0xc1, 0xef, 0x25, 0x60,
+	0x3c, 0x42, 0x74, 0xe3, 0x5c, 0x17, 0x91, 0x86, 0x3e, 0x41, 0x84, 0xa5, 0x3e, 0xbd, 0x70, 0xc6,
+	0x37, 0x28, 0xe3, 0xab, 0xf8, 0x72, 0x1f, 0x8c, 0x25, 0xd2, 0xf4, 0xef, 0xbe, 0x17, 0xf1, 0x06,
+	0x82, 0xdd, 0x01, 0x72, 0x1e, 0xbf, 0xd4, 0x45, 0xdc, 0x6d, 0x2f, 0x0f, 0xe1, 0x4c, 0x8f, 0xd6,
+	0x9c, 0xed, 0x25, 0xca, 0xf6, 0x3c, 0x3e, 0xd7, 0x0f, 0xdb, 0xe6, 0x5b, 0x01, 0xff, 0x80, 0x60,
+	0xb4, 0x55, 0x19, 0xe3, 0x17, 0xbb, 0x88, 0xd1, 0xff, 0xb4, 0x10, 0x16, 0x7a, 0x31, 0xe5, 0xdc,
+	0x96, 0x29, 0xb7, 0x25, 0x9c, 0xed, 0x87, 0x9b, 0x2b, 0xbf, 0xff, 0x44, 0x30, 0xd6, 0xa6, 0x36,
+	0x71, 0x8c, 0xf0, 0xc2, 0x54, 0xb6, 0x70, 0xba, 0x27, 0x5b, 0xce, 0xad, 0x40, 0xb9, 0xbd, 0x81,
+	0x6f, 0x44, 0x72, 0x6b, 0xdc, 0x38, 0xa6, 0x74, 0xbb, 0xed, 0xc2, 0xba, 0x23, 0xf1, 0xce, 0x0c,
+	0x3c, 0xb3, 0x4f, 0x10, 0x3c, 0x1b, 0xac, 0x28, 0xf1, 0xd9, 0x6e, 0x02, 0x0f, 0xd0, 0xc0, 0xc2,
+	0x2b, 0xbd, 0x3b, 0xe8, 0xaa, 0xb4, 0xf1, 0xe8, 0xd3, 0x83, 0x19, 0x20, 0xeb, 0xe2, 0x1c, 0xcc,
+	0x70, 0x05, 0x1a, 0xe7, 0x60, 0x46, 0x68, 0xc9, 0x98, 0x07, 0xb3, 0x03, 0xc3, 0x66, 0x6f, 0xe3,
+	0x7f, 0x10, 0x24, 0xc3, 0x44, 0x1f, 0x5e, 0xec, 0x22, 0xd6, 0x60, 0xa5, 0x2a, 0x64, 0xfa, 0x71,
+	0xc1, 0x39, 0x5f, 0xa7, 0x9c, 0x2f, 0xe1, 0x8b, 0xfd, 0x70, 0x6e, 0x55, 0xad, 0xf8, 0x6b, 0x04,
+	0xbb, 0x7c, 0xc2, 0x12, 0x9f, 0xec, 0x1c, 0x6b, 0x90, 0x4e, 0x15, 0x5e, 0xe8, 0xda, 0x8e, 0x13,
+	0x3b, 0x4e, 0x89, 0xcd, 0xe2, 0xa3, 0x91, 0xc4, 0x4a, 0xae, 0x6d, 0xc1, 0x91, 0xa2, 0xf8, 0x5f,
+	0x04, 0x7b, 0x03, 0xb5, 0x1f, 0x7e, 0xb9, 0x8b, 0x5c, 0x07, 0xc8, 0x57, 0xe1, 0x6c, 0xcf, 0xf6,
+	0x9c, 0x8f, 0xf2, 0x5d, 0xbb, 0xec, 0xa2, 0x14, 0x97, 0xf1, 0x85, 0x7e, 0x6a, 0x27, 0xdb, 0x16,
+	0x29, 0x94, 0x5c, 0x9a, 0x3f, 0x21, 0x18, 0x6b, 0x53, 0x7e, 0x71, 0x46, 0x6e, 0x98, 0x56, 0x8d,
+	0x33, 0x72, 0x43, 0x15, 0xab, 0xb8, 0x1c, 0x46, 0x7a, 0x1e, 0xcf, 0x45, 0x92, 0xf6, 0xb1, 0x2a,
+	0x50, 0xe1, 0x9a, 0x59, 0x7e, 0xb8, 0x91, 0x42, 0x8f, 0x36, 0x52, 0xe8, 0xd7, 0x8d, 0x14, 0xfa,
+	0x68, 0x33, 0x35, 0xf4, 0x68, 0x33, 0x35, 0xf4, 0xe3, 0x66, 0x6a, 0xe8, 0xcd, 0x63, 0x91, 0x2f,
+	0x97, 0x77, 0xfd, 0x10, 0xf4, 0x21, 0x53, 0xdc, 0x46, 0xff, 0x1a, 0x70, 0xfc, 0xbf, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0x71, 0x32, 0x12, 0x07, 0x33, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1098,6 +1278,11 @@ type QueryClient interface {
 	//
 	// WARNING: This query will fail if an external community pool is used.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// DelegatorAutoCompound queries the validators a delegator auto-compounds
+	// its rewards for.
+	DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error)
+	// AutoCompoundRound queries the auto-compounding round in progress.
+	AutoCompoundRound(ctx context.Context, in *QueryAutoCompoundRoundRequest, opts ...grpc.CallOption) (*QueryAutoCompoundRoundResponse, error)
 }
 
 type queryClient struct {
@@ -1198,6 +1383,24 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error) {
+	out := new(QueryDelegatorAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AutoCompoundRound(ctx context.Context, in *QueryAutoCompoundRoundRequest, opts ...grpc.CallOption) (*QueryAutoCompoundRoundResponse, error) {
+	out := new(QueryAutoCompoundRoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/AutoCompoundRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1223,6 +1426,11 @@ type QueryServer interface {
 	//
 	// WARNING: This query will fail if an external community pool is used.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// DelegatorAutoCompound queries the validators a delegator auto-compounds
+	// its rewards for.
+	DelegatorAutoCompound(context.Context, *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error)
+	// AutoCompoundRound queries the auto-compounding round in progress.
+	AutoCompoundRound(context.Context, *QueryAutoCompoundRoundRequest) (*QueryAutoCompoundRoundResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1259,6 +1467,12 @@ func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, r
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorAutoCompound(ctx context.Context, req *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoCompound not implemented")
+}
+func (*UnimplementedQueryServer) AutoCompoundRound(ctx context.Context, req *QueryAutoCompoundRoundRequest) (*QueryAutoCompoundRoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompoundRound not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1444,6 +1658,42 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, req.(*QueryDelegatorAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AutoCompoundRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundRoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompoundRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/AutoCompoundRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompoundRound(ctx, req.(*QueryAutoCompoundRoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
@@ -1489,6 +1739,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "DelegatorAutoCompound",
+			Handler:    _Query_DelegatorAutoCompound_Handler,
+		},
+		{
+			MethodName: "AutoCompoundRound",
+			Handler:    _Query_AutoCompoundRound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -2202,6 +2460,126 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDelegatorAutoCompoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundRoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundRoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundRoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundRoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundRoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundRoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Round != nil {
+		{
+			size, err := m.Round.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2505,11 +2883,61 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryDelegatorAutoCompoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryDelegatorAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAutoCompoundRoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAutoCompoundRoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != nil {
+		l = m.Round.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -4342,6 +4770,306 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDelegatorAutoCompoundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCompoundRoundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundRoundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundRoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCompoundRoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundRoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundRoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Round == nil {
+				m.Round = &AutoCompoundRound{}
+			}
+			if err := m.Round.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -639,6 +639,85 @@ func (m *MsgDepositValidatorRewardsPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositValidatorRewardsPoolResponse proto.InternalMessageInfo
 
+// MsgSetAutoCompound opts a delegation in or out of auto-compounding. The
+// rewards of auto-compounding delegations are re-delegated to the same
+// validator at the end of every auto-compounding epoch.
+type MsgSetAutoCompound struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Enabled          bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{14}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+// MsgSetAutoCompoundResponse defines the Msg/SetAutoCompound response type.
+type MsgSetAutoCompoundResponse struct {
+}
+
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{15}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
@@ -654,6 +733,8 @@ func init() {
 	proto.RegisterType((*MsgCommunityPoolSpendResponse)(nil), "cosmos.distribution.v1beta1.MsgCommunityPoolSpendResponse")
 	proto.RegisterType((*MsgDepositValidatorRewardsPool)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPool")
 	proto.RegisterType((*MsgDepositValidatorRewardsPoolResponse)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPoolResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "cosmos.distribution.v1beta1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.MsgSetAutoCompoundResponse")
 }
 
 func init() {
@@ -661,69 +742,74 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 991 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xce, 0xb4, 0xa2, 0xa2, 0xb3, 0x2b, 0x75, 0xeb, 0x2d, 0x6a, 0xd7, 0xdd, 0x75, 0x8a, 0x17,
-	0x4a, 0x55, 0x51, 0xbb, 0x29, 0xed, 0x56, 0x04, 0x21, 0x44, 0xbb, 0x44, 0x42, 0xc2, 0xb0, 0x4a,
-	0x04, 0x08, 0x2e, 0x95, 0x13, 0x1b, 0x77, 0x44, 0xed, 0xb1, 0x3c, 0x93, 0x64, 0x73, 0x03, 0x04,
-	0x02, 0x21, 0x0e, 0x48, 0x9c, 0xe0, 0xc2, 0x1e, 0x57, 0x88, 0x43, 0x0e, 0x11, 0xf0, 0x13, 0x56,
-	0x9c, 0x56, 0x3d, 0x21, 0x0e, 0xb0, 0x4a, 0x0f, 0x41, 0xe2, 0x0f, 0x70, 0x42, 0xc8, 0xf6, 0xd8,
-	0xb1, 0x63, 0xc7, 0x4e, 0xca, 0x6a, 0xf7, 0xd2, 0x56, 0x33, 0xef, 0x7d, 0xf3, 0xbd, 0x6f, 0xde,
-	0x7c, 0xcf, 0x85, 0xcf, 0x34, 0x30, 0x31, 0x31, 0x91, 0x35, 0x44, 0xa8, 0x83, 0xea, 0x4d, 0x8a,
-	0xb0, 0x25, 0xb7, 0x4a, 0x75, 0x9d, 0xaa, 0x25, 0x99, 0xde, 0x96, 0x6c, 0x07, 0x53, 0xcc, 0xad,
-	0xfa, 0x51, 0x52, 0x34, 0x4a, 0x62, 0x51, 0xfc, 0x92, 0x81, 0x0d, 0xec, 0xc5, 0xc9, 0xee, 0x5f,
-	0x7e, 0x0a, 0x2f, 0x30, 0xe0, 0xba, 0x4a, 0xf4, 0x10, 0xb0, 0x81, 0x91, 0xc5, 0xf6, 0xaf, 0xf8,
-	0xfb, 0x47, 0x7e, 0x22, 0xc3, 0xf7, 0xb7, 0x96, 0x59, 0xaa, 0x49, 0x0c, 0xb9, 0x55, 0x72, 0x7f,
-	0xb1, 0x8d, 0x45, 0xd5, 0x44, 0x16, 0x96, 0xbd, 0x9f, 0x6c, 0x49, 0xca, 0xe2, 0x1f, 0xa3, 0xeb,
-	0xc5, 0x8b, 0x7f, 0x03, 0xf8, 0x94, 0x42, 0x8c, 0x9a, 0x4e, 0xdf, 0x45, 0xf4, 0x58, 0x73, 0xd4,
-	0xf6, 0xab, 0x9a, 0xe6, 0xe8, 0x84, 0x70, 0xaf, 0xc1, 0x45, 0x4d, 0x3f, 0xd1, 0x0d, 0x95, 0x62,
-	0xe7, 0x48, 0xf5, 0x17, 0x57, 0xc0, 0x1a, 0xd8, 0x98, 0x3f, 0x58, 0x39, 0xed, 0x6d, 0x2d, 0x31,
-	0x8a, 0x2c, 0xbc, 0x46, 0x1d, 0x64, 0x19, 0xd5, 0x4b, 0x61, 0x4a, 0x00, 0x73, 0x08, 0x2f, 0xb5,
-	0x19, 0x72, 0x88, 0x32, 0x93, 0x83, 0xb2, 0xd0, 0x8e, 0x73, 0x29, 0x57, 0xbe, 0xb8, 0x53, 0x2c,
-	0xfc, 0x75, 0xa7, 0x58, 0xf8, 0x64, 0xd0, 0xdd, 0x4c, 0xd2, 0xfa, 0x72, 0xd0, 0xdd, 0xbc, 0xee,
-	0x23, 0x6d, 0x11, 0xed, 0x43, 0x59, 0x21, 0x86, 0x82, 0x35, 0xf4, 0x41, 0x67, 0xa4, 0x26, 0xb1,
-	0x08, 0xaf, 0xa5, 0x16, 0x5b, 0xd5, 0x89, 0x8d, 0x2d, 0xa2, 0x8b, 0xff, 0x02, 0xc8, 0x2b, 0xc4,
-	0x08, 0xb6, 0x6f, 0x06, 0x27, 0x55, 0xf5, 0xb6, 0xea, 0x68, 0x0f, 0x4b, 0x93, 0x37, 0xe1, 0x62,
-	0x4b, 0x3d, 0x41, 0x5a, 0x0c, 0xc6, 0x17, 0xe5, 0xe9, 0xd3, 0xde, 0xd6, 0x35, 0x06, 0xf3, 0x4e,
-	0x10, 0x33, 0x82, 0xd7, 0x1a, 0x59, 0x2f, 0xbf, 0x9e, 0x2f, 0xcf, 0x7a, 0x5c, 0x9e, 0x91, 0x02,
-	0x11, 0xb6, 0xfc, 0x0a, 0xc5, 0x1e, 0x80, 0xe2, 0x78, 0x01, 0x02, 0x9d, 0xb8, 0x0e, 0x9c, 0x53,
-	0x4d, 0xdc, 0xb4, 0xe8, 0x0a, 0x58, 0x9b, 0xdd, 0xb8, 0xb0, 0x73, 0x85, 0xf5, 0x9d, 0xe4, 0xb6,
-	0x77, 0xf0, 0x12, 0xa4, 0x43, 0x8c, 0xac, 0x83, 0xca, 0xbd, 0x3f, 0x8a, 0x85, 0x1f, 0xfe, 0x2c,
-	0x6e, 0x18, 0x88, 0x1e, 0x37, 0xeb, 0x52, 0x03, 0x9b, 0xac, 0xbd, 0xe5, 0x08, 0x27, 0xda, 0xb1,
-	0x75, 0xe2, 0x25, 0x90, 0xef, 0x06, 0xdd, 0xcd, 0x8b, 0xee, 0xb1, 0x8d, 0xce, 0x91, 0xfb, 0x40,
-	0xc8, 0xdd, 0x41, 0x77, 0x13, 0x54, 0xd9, 0x81, 0xe5, 0xcb, 0xa7, 0xbd, 0xad, 0x85, 0x61, 0xe6,
-	0xda, 0xb6, 0xb4, 0x7b, 0x43, 0xfc, 0x05, 0x40, 0x21, 0x42, 0x3b, 0x54, 0xee, 0x10, 0x9b, 0x26,
-	0x22, 0x04, 0x61, 0x2b, 0x5d, 0x74, 0x70, 0x7e, 0xd1, 0xe3, 0x3d, 0x99, 0x80, 0x4e, 0xe9, 0xc9,
-	0x08, 0xbb, 0x21, 0x2f, 0xf1, 0x67, 0x00, 0xd7, 0xb3, 0xa9, 0x87, 0xaa, 0x7f, 0x05, 0x26, 0x97,
-	0xfd, 0xbd, 0x69, 0x65, 0xff, 0x3d, 0x29, 0x6b, 0xf6, 0x4d, 0x88, 0x9f, 0xcd, 0xc0, 0x25, 0x85,
-	0x18, 0x95, 0xa6, 0xa5, 0xb9, 0x64, 0x9b, 0x16, 0xa2, 0x9d, 0x5b, 0x18, 0x9f, 0x3c, 0xc6, 0xee,
-	0xe0, 0x6e, 0xc0, 0x79, 0x4d, 0xb7, 0x31, 0x41, 0x14, 0x3b, 0xb9, 0x3e, 0x33, 0x0c, 0x2d, 0x97,
-	0xa3, 0xb7, 0x39, 0x5c, 0x77, 0x6f, 0xb1, 0x18, 0xbf, 0xc5, 0x44, 0xb9, 0xa2, 0x00, 0xaf, 0xa6,
-	0xad, 0x87, 0xa6, 0xf2, 0x00, 0xc0, 0x05, 0x85, 0x18, 0x6f, 0xdb, 0x9a, 0x4a, 0xf5, 0x5b, 0xaa,
-	0xa3, 0x9a, 0xc4, 0xe5, 0xa9, 0x36, 0xe9, 0x31, 0x76, 0x10, 0xed, 0xe4, 0x3a, 0xc8, 0x30, 0x94,
-	0xab, 0xc0, 0x39, 0xdb, 0x43, 0xf0, 0x8a, 0xbb, 0xb0, 0x73, 0x5d, 0xca, 0x18, 0x45, 0x92, 0x7f,
-	0xd8, 0xc1, 0xbc, 0x2b, 0x32, 0xd3, 0xc9, 0xcf, 0x2e, 0x2b, 0xc9, 0x57, 0xb4, 0xef, 0x95, 0x1e,
-	0x1e, 0xe5, 0x96, 0xfe, 0x5c, 0xa4, 0xf4, 0xd8, 0x44, 0x19, 0x29, 0x47, 0x94, 0xe0, 0xf2, 0xc8,
-	0x52, 0x50, 0x7d, 0xda, 0x7b, 0xdd, 0x17, 0x7f, 0x9a, 0xf1, 0xc6, 0x4e, 0x4c, 0xaf, 0x9a, 0xad,
-	0x5b, 0xda, 0xb9, 0x85, 0xb9, 0x0a, 0xe7, 0x1d, 0xbd, 0x81, 0x6c, 0xa4, 0x5b, 0xd4, 0xbf, 0xf8,
-	0xea, 0x70, 0x21, 0xd2, 0x91, 0xb3, 0x8f, 0xda, 0xaf, 0xde, 0x98, 0x48, 0xe9, 0xf5, 0x51, 0xa5,
-	0xe5, 0x54, 0x79, 0xc4, 0x5d, 0x6f, 0x82, 0x25, 0x37, 0xb2, 0xe5, 0xfe, 0x67, 0xc6, 0xb3, 0xc7,
-	0x9b, 0x7e, 0x5b, 0x87, 0x16, 0xe3, 0x9b, 0x3a, 0xf1, 0xde, 0x6c, 0xec, 0xe1, 0x80, 0x89, 0x1f,
-	0xce, 0xc3, 0x9e, 0x65, 0x8f, 0xf3, 0xa6, 0x6a, 0x81, 0x07, 0x24, 0x24, 0xdc, 0xdb, 0x4e, 0xda,
-	0xc2, 0xb3, 0x69, 0x37, 0x36, 0x54, 0x98, 0x69, 0x2b, 0xbe, 0xec, 0xb9, 0x7b, 0x86, 0xf2, 0x19,
-	0x37, 0xb7, 0xb7, 0xbd, 0xf3, 0xe3, 0x93, 0x70, 0x56, 0x21, 0x06, 0xf7, 0x29, 0x80, 0x5c, 0xca,
-	0x47, 0xda, 0x4e, 0xe6, 0xf3, 0x4f, 0xfd, 0xd6, 0xe1, 0xcb, 0xd3, 0xe7, 0x84, 0x13, 0xe8, 0x1b,
-	0x00, 0x97, 0xc7, 0x7d, 0x1c, 0xed, 0xe7, 0xe1, 0x8e, 0x49, 0xe4, 0x5f, 0x39, 0x67, 0x62, 0xc8,
-	0xea, 0x7b, 0x00, 0x57, 0xb3, 0x46, 0xff, 0x4b, 0x93, 0x1e, 0x90, 0x92, 0xcc, 0x1f, 0xfe, 0x8f,
-	0xe4, 0x90, 0xe1, 0xc7, 0x00, 0x2e, 0x26, 0xe7, 0x64, 0x29, 0x0f, 0x3a, 0x91, 0xc2, 0xbf, 0x38,
-	0x75, 0x4a, 0xc8, 0xe1, 0x73, 0x00, 0x2f, 0xc6, 0x66, 0xd0, 0xf3, 0x79, 0x58, 0xd1, 0x68, 0x7e,
-	0x77, 0x9a, 0xe8, 0x70, 0xf6, 0x5d, 0xfe, 0x35, 0x69, 0x47, 0xdc, 0xb7, 0x00, 0x72, 0x29, 0xd6,
-	0x9f, 0xdb, 0xcc, 0xc9, 0x9c, 0xfc, 0x66, 0x1e, 0x6f, 0x95, 0xe9, 0xdc, 0x7a, 0x00, 0xae, 0x66,
-	0xf9, 0x64, 0x6e, 0x2f, 0x65, 0x24, 0xe7, 0xf7, 0xd2, 0x04, 0x3e, 0x91, 0x42, 0x7b, 0x6f, 0x9b,
-	0x7f, 0xe2, 0x23, 0xd7, 0xca, 0x0e, 0xde, 0xba, 0xdb, 0x17, 0xc0, 0xbd, 0xbe, 0x00, 0xee, 0xf7,
-	0x05, 0xf0, 0xa0, 0x2f, 0x80, 0xaf, 0xcf, 0x84, 0xc2, 0xfd, 0x33, 0xa1, 0xf0, 0xdb, 0x99, 0x50,
-	0x78, 0xbf, 0x94, 0x69, 0x94, 0xb7, 0xe3, 0x23, 0xde, 0xf3, 0xcd, 0xfa, 0x9c, 0xf7, 0x6f, 0xe2,
-	0x0b, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x7f, 0x5d, 0xe8, 0xdd, 0x18, 0x0f, 0x00, 0x00,
+	// 1068 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x38, 0x22, 0xe0, 0x69, 0xa5, 0x34, 0xdb, 0xa0, 0xb8, 0x9b, 0x66, 0x1d, 0xb6, 0x10,
+	0xa2, 0x88, 0xec, 0xc6, 0xc1, 0x69, 0x84, 0x11, 0x42, 0x8d, 0x4b, 0x24, 0x24, 0x0c, 0x95, 0x23,
+	0x40, 0x70, 0x89, 0xd6, 0xde, 0x61, 0x33, 0x22, 0xbb, 0xb3, 0xda, 0x19, 0x27, 0xf5, 0x0d, 0x10,
+	0x08, 0x84, 0x38, 0x54, 0xe2, 0x44, 0x2f, 0xf4, 0x58, 0x71, 0xca, 0xc1, 0x02, 0x6e, 0x5c, 0x2b,
+	0x4e, 0x55, 0x4e, 0x88, 0x03, 0x54, 0xc9, 0x21, 0x48, 0xfc, 0x03, 0x9c, 0x10, 0xda, 0x9f, 0xde,
+	0x5f, 0xde, 0xb5, 0x43, 0x45, 0xb8, 0x24, 0xf6, 0xcc, 0xfb, 0xde, 0x7c, 0xef, 0x9b, 0x37, 0xef,
+	0x3d, 0x19, 0x3e, 0xdb, 0x21, 0x54, 0x27, 0x54, 0x56, 0x31, 0x65, 0x16, 0x6e, 0x77, 0x19, 0x26,
+	0x86, 0xbc, 0x5f, 0x6d, 0x23, 0xa6, 0x54, 0x65, 0x76, 0x5b, 0x32, 0x2d, 0xc2, 0x08, 0x37, 0xe7,
+	0x5a, 0x49, 0x61, 0x2b, 0xc9, 0xb3, 0xe2, 0x67, 0x34, 0xa2, 0x11, 0xc7, 0x4e, 0xb6, 0x3f, 0xb9,
+	0x10, 0x5e, 0xf0, 0x1c, 0xb7, 0x15, 0x8a, 0x02, 0x87, 0x1d, 0x82, 0x0d, 0x6f, 0xff, 0x8a, 0xbb,
+	0xbf, 0xe3, 0x02, 0x3d, 0xff, 0xee, 0xd6, 0xac, 0x07, 0xd5, 0xa9, 0x26, 0xef, 0x57, 0xed, 0x7f,
+	0xde, 0xc6, 0xb4, 0xa2, 0x63, 0x83, 0xc8, 0xce, 0x5f, 0x6f, 0x49, 0xca, 0xe2, 0x1f, 0xa1, 0xeb,
+	0xd8, 0x8b, 0x7f, 0x02, 0xf8, 0x74, 0x93, 0x6a, 0xdb, 0x88, 0xbd, 0x8b, 0xd9, 0xae, 0x6a, 0x29,
+	0x07, 0x37, 0x54, 0xd5, 0x42, 0x94, 0x72, 0xaf, 0xc1, 0x69, 0x15, 0xed, 0x21, 0x4d, 0x61, 0xc4,
+	0xda, 0x51, 0xdc, 0xc5, 0x32, 0x58, 0x00, 0x4b, 0xa5, 0xcd, 0xf2, 0x51, 0x7f, 0x65, 0xc6, 0xa3,
+	0xe8, 0x99, 0x6f, 0x33, 0x0b, 0x1b, 0x5a, 0xeb, 0x52, 0x00, 0xf1, 0xdd, 0x34, 0xe0, 0xa5, 0x03,
+	0xcf, 0x73, 0xe0, 0xa5, 0x98, 0xe3, 0x65, 0xea, 0x20, 0xca, 0xa5, 0xbe, 0xf5, 0xc5, 0xbd, 0x4a,
+	0xe1, 0x8f, 0x7b, 0x95, 0xc2, 0x27, 0xa7, 0x87, 0xcb, 0x49, 0x5a, 0x5f, 0x9e, 0x1e, 0x2e, 0x5f,
+	0x73, 0x3d, 0xad, 0x50, 0xf5, 0x43, 0xb9, 0x49, 0xb5, 0x26, 0x51, 0xf1, 0x07, 0xbd, 0x58, 0x4c,
+	0x62, 0x05, 0xce, 0xa7, 0x06, 0xdb, 0x42, 0xd4, 0x24, 0x06, 0x45, 0xe2, 0xdf, 0x00, 0xf2, 0x4d,
+	0xaa, 0xf9, 0xdb, 0x37, 0xfd, 0x93, 0x5a, 0xe8, 0x40, 0xb1, 0xd4, 0xc7, 0xa5, 0xc9, 0x9b, 0x70,
+	0x7a, 0x5f, 0xd9, 0xc3, 0x6a, 0xc4, 0x8d, 0x2b, 0xca, 0x33, 0x47, 0xfd, 0x95, 0x79, 0xcf, 0xcd,
+	0x3b, 0xbe, 0x4d, 0xcc, 0xdf, 0x7e, 0x6c, 0xbd, 0xfe, 0x7a, 0xbe, 0x3c, 0x8b, 0x51, 0x79, 0x62,
+	0x01, 0x62, 0x62, 0xb8, 0x11, 0x8a, 0x7d, 0x00, 0xc5, 0xe1, 0x02, 0xf8, 0x3a, 0x71, 0x3d, 0x38,
+	0xa9, 0xe8, 0xa4, 0x6b, 0xb0, 0x32, 0x58, 0x98, 0x58, 0xba, 0xb0, 0x76, 0xc5, 0xcb, 0x3b, 0xc9,
+	0x4e, 0x6f, 0xff, 0x25, 0x48, 0x0d, 0x82, 0x8d, 0xcd, 0xad, 0x07, 0xbf, 0x55, 0x0a, 0xdf, 0xfd,
+	0x5e, 0x59, 0xd2, 0x30, 0xdb, 0xed, 0xb6, 0xa5, 0x0e, 0xd1, 0xbd, 0xf4, 0x96, 0x43, 0x9c, 0x58,
+	0xcf, 0x44, 0xd4, 0x01, 0xd0, 0xbb, 0xa7, 0x87, 0xcb, 0x17, 0xed, 0x63, 0x3b, 0xbd, 0x1d, 0xfb,
+	0x81, 0xd0, 0xfb, 0xa7, 0x87, 0xcb, 0xa0, 0xe5, 0x1d, 0x58, 0xbf, 0x7c, 0xd4, 0x5f, 0x99, 0x1a,
+	0x20, 0x17, 0x56, 0xa5, 0xda, 0x75, 0xf1, 0x47, 0x00, 0x85, 0x10, 0xed, 0x40, 0xb9, 0x06, 0xd1,
+	0x75, 0x4c, 0x29, 0x26, 0x46, 0xba, 0xe8, 0xe0, 0xec, 0xa2, 0x47, 0x73, 0x32, 0xe1, 0x3a, 0x25,
+	0x27, 0x43, 0xec, 0x06, 0xbc, 0xc4, 0x1f, 0x00, 0x5c, 0xcc, 0xa6, 0x1e, 0xa8, 0xfe, 0x15, 0x18,
+	0x5d, 0xf6, 0xf7, 0xc6, 0x95, 0xfd, 0xd7, 0xa4, 0xac, 0xd9, 0x37, 0x21, 0x7e, 0x56, 0x84, 0x33,
+	0x4d, 0xaa, 0x6d, 0x75, 0x0d, 0xd5, 0x26, 0xdb, 0x35, 0x30, 0xeb, 0xdd, 0x22, 0x64, 0xef, 0x1c,
+	0xb3, 0x83, 0xbb, 0x0e, 0x4b, 0x2a, 0x32, 0x09, 0xc5, 0x8c, 0x58, 0xb9, 0x75, 0x66, 0x60, 0x5a,
+	0xaf, 0x87, 0x6f, 0x73, 0xb0, 0x6e, 0xdf, 0x62, 0x25, 0x7a, 0x8b, 0x89, 0x70, 0x45, 0x01, 0x5e,
+	0x4d, 0x5b, 0x0f, 0x8a, 0xca, 0x23, 0x00, 0xa7, 0x9a, 0x54, 0x7b, 0xdb, 0x54, 0x15, 0x86, 0x6e,
+	0x29, 0x96, 0xa2, 0x53, 0x9b, 0xa7, 0xd2, 0x65, 0xbb, 0xc4, 0xc2, 0xac, 0x97, 0x5b, 0x41, 0x06,
+	0xa6, 0xdc, 0x16, 0x9c, 0x34, 0x1d, 0x0f, 0x4e, 0x70, 0x17, 0xd6, 0xae, 0x49, 0x19, 0xad, 0x48,
+	0x72, 0x0f, 0xdb, 0x2c, 0xd9, 0x22, 0x7b, 0x3a, 0xb9, 0xe8, 0x7a, 0x33, 0xf9, 0x8a, 0x36, 0x9c,
+	0xd0, 0x83, 0xa3, 0xec, 0xd0, 0x9f, 0x0f, 0x85, 0x1e, 0xe9, 0x28, 0xb1, 0x70, 0x44, 0x09, 0xce,
+	0xc6, 0x96, 0xfc, 0xe8, 0xd3, 0xde, 0xeb, 0x86, 0xf8, 0x7d, 0xd1, 0x69, 0x3b, 0x11, 0xbd, 0xb6,
+	0x4d, 0x64, 0xa8, 0x67, 0x16, 0xe6, 0x2a, 0x2c, 0x59, 0xa8, 0x83, 0x4d, 0x8c, 0x0c, 0xe6, 0x5e,
+	0x7c, 0x6b, 0xb0, 0x10, 0xca, 0xc8, 0x89, 0xff, 0xba, 0x5e, 0xbd, 0x31, 0x92, 0xd2, 0x8b, 0x71,
+	0xa5, 0xe5, 0x54, 0x79, 0xc4, 0x9a, 0xd3, 0xc1, 0x92, 0x1b, 0xd9, 0x72, 0xff, 0x55, 0x74, 0xca,
+	0xe3, 0x4d, 0x37, 0xad, 0x83, 0x12, 0xe3, 0x16, 0x75, 0xea, 0xbc, 0xd9, 0xc8, 0xc3, 0x01, 0x23,
+	0x3f, 0x9c, 0xc7, 0xdd, 0xcb, 0xce, 0xf3, 0xa6, 0xb6, 0xfd, 0x1a, 0x90, 0x90, 0x70, 0x7d, 0x35,
+	0x59, 0x16, 0x9e, 0x4b, 0xbb, 0xb1, 0x81, 0xc2, 0x9e, 0xb6, 0xe2, 0x2b, 0x4e, 0x75, 0xcf, 0x50,
+	0x3e, 0xe3, 0xe6, 0xd6, 0x57, 0xc5, 0xbb, 0x45, 0xc8, 0xb9, 0x23, 0xcb, 0x8d, 0x2e, 0x23, 0x0d,
+	0xa2, 0x9b, 0xa4, 0x6b, 0xfc, 0x5f, 0x07, 0x11, 0xae, 0x0c, 0x9f, 0x44, 0x86, 0xd2, 0xde, 0x43,
+	0x6a, 0x79, 0x62, 0x01, 0x2c, 0x3d, 0xd5, 0xf2, 0xbf, 0x66, 0x69, 0x5b, 0x1b, 0x3e, 0xb5, 0xcc,
+	0x47, 0x4b, 0x6f, 0x4c, 0x05, 0xb1, 0xea, 0x0c, 0x6b, 0xb1, 0xd5, 0x2c, 0x3d, 0x6b, 0x6b, 0x3f,
+	0x95, 0xe0, 0x44, 0x93, 0x6a, 0xdc, 0xa7, 0x00, 0x72, 0x29, 0x43, 0xef, 0x5a, 0x66, 0x39, 0x4d,
+	0x9d, 0x1d, 0xf9, 0xfa, 0xf8, 0x98, 0xa0, 0xa3, 0x7f, 0x0d, 0xe0, 0xec, 0xb0, 0x61, 0x73, 0x23,
+	0xcf, 0xef, 0x10, 0x20, 0xff, 0xea, 0x19, 0x81, 0x01, 0xab, 0x6f, 0x01, 0x9c, 0xcb, 0x1a, 0xa5,
+	0x5e, 0x1e, 0xf5, 0x80, 0x14, 0x30, 0xdf, 0xf8, 0x17, 0xe0, 0x80, 0xe1, 0xc7, 0x00, 0x4e, 0x27,
+	0xe7, 0x8e, 0x6a, 0x9e, 0xeb, 0x04, 0x84, 0x7f, 0x69, 0x6c, 0x48, 0xc0, 0xe1, 0x73, 0x00, 0x2f,
+	0x46, 0x7a, 0xfa, 0x0b, 0x79, 0xbe, 0xc2, 0xd6, 0x7c, 0x6d, 0x1c, 0xeb, 0x60, 0x96, 0xb8, 0xfc,
+	0x73, 0xb2, 0xbc, 0x73, 0xdf, 0x00, 0xc8, 0xa5, 0xb4, 0xd2, 0xdc, 0x64, 0x4e, 0x62, 0xf2, 0x93,
+	0x79, 0x78, 0xeb, 0x49, 0xe7, 0xd6, 0x07, 0x70, 0x2e, 0xab, 0xef, 0xe4, 0xe6, 0x52, 0x06, 0x38,
+	0x3f, 0x97, 0x46, 0xa8, 0xbb, 0x29, 0xb4, 0xd7, 0x57, 0xb9, 0x3b, 0x00, 0x4e, 0xc5, 0x8b, 0xae,
+	0x3c, 0xc2, 0x43, 0x0f, 0x03, 0xf8, 0x8d, 0x31, 0x01, 0x59, 0x94, 0x6a, 0xfc, 0x13, 0x1f, 0xd9,
+	0xdd, 0x6a, 0xf3, 0xad, 0xfb, 0xc7, 0x02, 0x78, 0x70, 0x2c, 0x80, 0x87, 0xc7, 0x02, 0x78, 0x74,
+	0x2c, 0x80, 0x3b, 0x27, 0x42, 0xe1, 0xe1, 0x89, 0x50, 0xf8, 0xe5, 0x44, 0x28, 0xbc, 0x5f, 0xcd,
+	0xec, 0x85, 0xb7, 0xa3, 0x53, 0x9c, 0xd3, 0x1a, 0xdb, 0x93, 0xce, 0x2f, 0x01, 0x2f, 0xfe, 0x13,
+	0x00, 0x00, 0xff, 0xff, 0xc2, 0xc3, 0x01, 0x67, 0xfb, 0x10, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -951,6 +1037,27 @@ func (this *MsgDepositValidatorRewardsPoolResponse) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *MsgSetAutoCompoundResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetAutoCompoundResponse)
+	if !ok {
+		that2, ok := that.(MsgSetAutoCompoundResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -991,6 +1098,9 @@ type MsgClient interface {
 	// DepositValidatorRewardsPool defines a method to provide additional rewards
 	// to delegators to a specific validator.
 	DepositValidatorRewardsPool(ctx context.Context, in *MsgDepositValidatorRewardsPool, opts ...grpc.CallOption) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// SetAutoCompound defines a method to opt a delegation in or out of
+	// auto-compounding of its rewards.
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
 }
 
 type msgClient struct {
@@ -1064,6 +1174,15 @@ func (c *msgClient) DepositValidatorRewardsPool(ctx context.Context, in *MsgDepo
 	return out, nil
 }
 
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -1093,6 +1212,9 @@ type MsgServer interface {
 	// DepositValidatorRewardsPool defines a method to provide additional rewards
 	// to delegators to a specific validator.
 	DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// SetAutoCompound defines a method to opt a delegation in or out of
+	// auto-compounding of its rewards.
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1120,6 +1242,9 @@ func (*UnimplementedMsgServer) CommunityPoolSpend(ctx context.Context, req *MsgC
 func (*UnimplementedMsgServer) DepositValidatorRewardsPool(ctx context.Context, req *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositValidatorRewardsPool not implemented")
 }
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1251,6 +1376,24 @@ func _Msg_DepositValidatorRewardsPool_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Msg",
@@ -1284,6 +1427,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DepositValidatorRewardsPool",
 			Handler:    _Msg_DepositValidatorRewardsPool_Handler,
 		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -1768,6 +1915,76 @@ func (m *MsgDepositValidatorRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1981,6 +2198,35 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3242,6 +3488,190 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
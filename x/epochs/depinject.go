@@ -12,8 +12,10 @@ import (
 	"cosmossdk.io/depinject/appconfig"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/epochs/keeper"
 	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -44,7 +46,11 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
-	k := keeper.NewKeeper(in.StoreService, in.Cdc)
+	// the module config doesn't have an authority, so the governance module
+	// account is always used
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	k := keeper.NewKeeper(in.StoreService, in.Cdc, authority.String())
 	m := NewAppModule(&k)
 	return ModuleOutputs{EpochKeeper: m.keeper, Module: m}
 }
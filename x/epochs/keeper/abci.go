@@ -20,8 +20,8 @@ func (k *Keeper) BeginBlocker(ctx sdk.Context) error {
 		ctx,
 		nil,
 		func(key string, epochInfo types.EpochInfo) (stop bool, err error) {
-			// If blocktime < initial epoch start time, return
-			if blockTime.Before(epochInfo.StartTime) {
+			// If the epoch is paused or blocktime < initial epoch start time, return
+			if epochInfo.Paused || blockTime.Before(epochInfo.StartTime) {
 				return false, nil
 			}
 			// if epoch counting hasn't started, signal we need to start.
@@ -58,6 +58,11 @@ func (k *Keeper) BeginBlocker(ctx sdk.Context) error {
 
 				epochInfo.CurrentEpoch += 1
 				epochInfo.CurrentEpochStartTime = epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+				// a duration changed while the epoch was running applies from the new epoch
+				if epochInfo.PendingDuration != 0 {
+					epochInfo.Duration = epochInfo.PendingDuration
+					epochInfo.PendingDuration = 0
+				}
 				ctx.Logger().Debug(fmt.Sprintf("Starting epoch with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
 			}
 
@@ -1,9 +1,14 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/epochs/types"
 )
 
@@ -39,6 +44,120 @@ func (k *Keeper) AddEpochInfo(ctx sdk.Context, epoch types.EpochInfo) error {
 	return k.EpochInfo.Set(ctx, epoch.Identifier, epoch)
 }
 
+// PauseEpoch stops the epoch with the given identifier from ticking until it is
+// resumed.
+func (k *Keeper) PauseEpoch(ctx sdk.Context, identifier string) error {
+	epoch, err := k.getEpochInfo(ctx, identifier)
+	if err != nil {
+		return err
+	}
+	if epoch.Paused {
+		return sdkerrors.ErrInvalidRequest.Wrapf("epoch with identifier %s is already paused", identifier)
+	}
+
+	epoch.Paused = true
+	if err := k.EpochInfo.Set(ctx, identifier, epoch); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventEpochPaused{
+		Identifier:  identifier,
+		EpochNumber: epoch.CurrentEpoch,
+	}); err != nil {
+		return err
+	}
+
+	return k.AfterEpochUpdated(ctx, epoch)
+}
+
+// ResumeEpoch resumes the paused epoch with the given identifier. The current
+// epoch is restarted at the block time, so that the epochs missed while the
+// epoch was paused are skipped rather than all ending in the next blocks. A
+// pending duration is applied to the restarted epoch.
+func (k *Keeper) ResumeEpoch(ctx sdk.Context, identifier string) error {
+	epoch, err := k.getEpochInfo(ctx, identifier)
+	if err != nil {
+		return err
+	}
+	if !epoch.Paused {
+		return sdkerrors.ErrInvalidRequest.Wrapf("epoch with identifier %s is not paused", identifier)
+	}
+
+	epoch.Paused = false
+	if epoch.EpochCountingStarted {
+		epoch.CurrentEpochStartTime = ctx.BlockTime()
+		epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+		if epoch.PendingDuration != 0 {
+			epoch.Duration = epoch.PendingDuration
+			epoch.PendingDuration = 0
+		}
+	} else if epoch.StartTime.Before(ctx.BlockTime()) {
+		epoch.StartTime = ctx.BlockTime()
+	}
+
+	if err := k.EpochInfo.Set(ctx, identifier, epoch); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventEpochResumed{
+		Identifier:     identifier,
+		EpochNumber:    epoch.CurrentEpoch,
+		EpochStartTime: epoch.CurrentEpochStartTime.Unix(),
+	}); err != nil {
+		return err
+	}
+
+	return k.AfterEpochUpdated(ctx, epoch)
+}
+
+// UpdateEpochDuration changes the duration of the epoch with the given
+// identifier. The duration of an epoch which has started is not changed while
+// it runs: the new duration applies from the next epoch.
+func (k *Keeper) UpdateEpochDuration(ctx sdk.Context, identifier string, duration time.Duration) error {
+	if duration <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("epoch duration must be positive, got %s", duration)
+	}
+
+	epoch, err := k.getEpochInfo(ctx, identifier)
+	if err != nil {
+		return err
+	}
+
+	pending := epoch.EpochCountingStarted && duration != epoch.Duration
+	if epoch.EpochCountingStarted {
+		epoch.PendingDuration = 0
+		if pending {
+			epoch.PendingDuration = duration
+		}
+	} else {
+		epoch.Duration = duration
+	}
+
+	if err := k.EpochInfo.Set(ctx, identifier, epoch); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventEpochDurationUpdated{
+		Identifier: identifier,
+		Duration:   duration,
+		Pending:    pending,
+	}); err != nil {
+		return err
+	}
+
+	return k.AfterEpochUpdated(ctx, epoch)
+}
+
+// getEpochInfo returns the epoch info of the given identifier, with an error
+// naming the identifier if it doesn't exist.
+func (k *Keeper) getEpochInfo(ctx sdk.Context, identifier string) (types.EpochInfo, error) {
+	epoch, err := k.EpochInfo.Get(ctx, identifier)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.EpochInfo{}, sdkerrors.ErrNotFound.Wrapf("epoch with identifier %s not found", identifier)
+	}
+	return epoch, err
+}
+
 // AllEpochInfos iterate through epochs to return all epochs info.
 func (k *Keeper) AllEpochInfos(ctx sdk.Context) ([]types.EpochInfo, error) {
 	var epochs []types.EpochInfo
@@ -25,3 +25,19 @@ func (k *Keeper) AfterEpochEnd(ctx context.Context, identifier string, epochNumb
 func (k *Keeper) BeforeEpochStart(ctx context.Context, identifier string, epochNumber int64) error {
 	return k.Hooks().BeforeEpochStart(ctx, identifier, epochNumber)
 }
+
+// AfterEpochAdded is called after a new epoch is added by the module authority.
+func (k *Keeper) AfterEpochAdded(ctx context.Context, epoch types.EpochInfo) error {
+	if h, ok := k.Hooks().(types.EpochUpdateHooks); ok {
+		return h.AfterEpochAdded(ctx, epoch)
+	}
+	return nil
+}
+
+// AfterEpochUpdated is called after an epoch is paused, resumed or has its duration changed.
+func (k *Keeper) AfterEpochUpdated(ctx context.Context, epoch types.EpochInfo) error {
+	if h, ok := k.Hooks().(types.EpochUpdateHooks); ok {
+		return h.AfterEpochUpdated(ctx, epoch)
+	}
+	return nil
+}
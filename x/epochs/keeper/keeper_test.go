@@ -15,8 +15,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	epochskeeper "github.com/cosmos/cosmos-sdk/x/epochs/keeper"
 	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type KeeperTestSuite struct {
@@ -55,6 +57,7 @@ func Setup(t *testing.T) (sdk.Context, epochskeeper.Keeper) {
 	epochsKeeper := epochskeeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	epochsKeeper.SetHooks(types.NewMultiEpochHooks())
 	ctx = ctx.WithBlockTime(time.Now().UTC()).WithBlockHeight(1).WithChainID("epochs")
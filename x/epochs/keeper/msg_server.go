@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type msgServer struct {
+	*Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the epochs MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// AddEpoch implements types.MsgServer.
+func (k msgServer) AddEpoch(goCtx context.Context, msg *types.MsgAddEpoch) (*types.MsgAddEpochResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Duration <= 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("epoch duration must be positive, got %s", msg.Duration)
+	}
+
+	// an epoch starting in the past would end all of its missed epochs in the
+	// next blocks
+	if !msg.StartTime.IsZero() && msg.StartTime.Before(ctx.BlockTime()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("epoch start time %s is before the block time %s", msg.StartTime, ctx.BlockTime())
+	}
+
+	epoch := types.NewGenesisEpochInfo(msg.Identifier, msg.Duration)
+	epoch.StartTime = msg.StartTime
+	if err := k.AddEpochInfo(ctx, epoch); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	epoch, err := k.GetEpochInfo(ctx, msg.Identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventEpochAdded{
+		Identifier: epoch.Identifier,
+		StartTime:  epoch.StartTime,
+		Duration:   epoch.Duration,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.AfterEpochAdded(ctx, epoch); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddEpochResponse{}, nil
+}
+
+// PauseEpoch implements types.MsgServer.
+func (k msgServer) PauseEpoch(goCtx context.Context, msg *types.MsgPauseEpoch) (*types.MsgPauseEpochResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.PauseEpoch(sdk.UnwrapSDKContext(goCtx), msg.Identifier); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPauseEpochResponse{}, nil
+}
+
+// ResumeEpoch implements types.MsgServer.
+func (k msgServer) ResumeEpoch(goCtx context.Context, msg *types.MsgResumeEpoch) (*types.MsgResumeEpochResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.ResumeEpoch(sdk.UnwrapSDKContext(goCtx), msg.Identifier); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResumeEpochResponse{}, nil
+}
+
+// UpdateEpochDuration implements types.MsgServer.
+func (k msgServer) UpdateEpochDuration(goCtx context.Context, msg *types.MsgUpdateEpochDuration) (*types.MsgUpdateEpochDurationResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.UpdateEpochDuration(sdk.UnwrapSDKContext(goCtx), msg.Identifier, msg.Duration); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateEpochDurationResponse{}, nil
+}
+
+func (k msgServer) checkAuthority(authority string) error {
+	if k.GetAuthority() != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), authority)
+	}
+	return nil
+}
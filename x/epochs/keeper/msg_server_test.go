@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	epochskeeper "github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ types.EpochUpdateHooks = &updateHooks{}
+
+type updateHooks struct {
+	added, updated []types.EpochInfo
+}
+
+func (h *updateHooks) AfterEpochEnd(_ context.Context, _ string, _ int64) error    { return nil }
+func (h *updateHooks) BeforeEpochStart(_ context.Context, _ string, _ int64) error { return nil }
+
+func (h *updateHooks) AfterEpochAdded(_ context.Context, epoch types.EpochInfo) error {
+	h.added = append(h.added, epoch)
+	return nil
+}
+
+func (h *updateHooks) AfterEpochUpdated(_ context.Context, epoch types.EpochInfo) error {
+	h.updated = append(h.updated, epoch)
+	return nil
+}
+
+func (s *KeeperTestSuite) TestMsgAddEpoch() {
+	authority := s.EpochsKeeper.GetAuthority()
+	ctx := s.Ctx
+
+	// use a keeper with update hooks, provided through depinject
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx = testCtx.Ctx.WithBlockTime(ctx.BlockTime()).WithBlockHeight(ctx.BlockHeight())
+	k := epochskeeper.NewKeeper(runtime.NewKVStoreService(key), moduletestutil.MakeTestEncodingConfig().Codec, authority)
+	hooks := &updateHooks{}
+	k.SetHooks(types.NewMultiEpochHooks(types.EpochHooksWrapper{EpochHooks: hooks}))
+	msgServer := epochskeeper.NewMsgServerImpl(&k)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgAddEpoch
+		expErr error
+	}{
+		{"invalid authority", &types.MsgAddEpoch{Authority: "invalid", Identifier: "daily", Duration: time.Hour}, govtypes.ErrInvalidSigner},
+		{"zero duration", &types.MsgAddEpoch{Authority: authority, Identifier: "daily"}, sdkerrors.ErrInvalidRequest},
+		{"start time in the past", &types.MsgAddEpoch{Authority: authority, Identifier: "daily", StartTime: ctx.BlockTime().Add(-time.Second), Duration: time.Hour}, sdkerrors.ErrInvalidRequest},
+		{"empty identifier", &types.MsgAddEpoch{Authority: authority, Duration: time.Hour}, sdkerrors.ErrInvalidRequest},
+		{"valid", &types.MsgAddEpoch{Authority: authority, Identifier: "daily", Duration: 24 * time.Hour}, nil},
+		{"duplicate identifier", &types.MsgAddEpoch{Authority: authority, Identifier: "daily", Duration: time.Hour}, sdkerrors.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := msgServer.AddEpoch(ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	epoch, err := k.GetEpochInfo(ctx, "daily")
+	s.Require().NoError(err)
+	s.Require().Equal(ctx.BlockTime(), epoch.StartTime)
+	s.Require().Equal(24*time.Hour, epoch.Duration)
+	s.Require().Equal([]types.EpochInfo{epoch}, hooks.added)
+	s.Require().Empty(hooks.updated)
+
+	// the new epoch starts ticking in the next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	s.Require().NoError(k.BeginBlocker(ctx))
+	epoch, err = k.GetEpochInfo(ctx, "daily")
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), epoch.CurrentEpoch)
+}
+
+func (s *KeeperTestSuite) TestMsgPauseResumeEpoch() {
+	authority := s.EpochsKeeper.GetAuthority()
+	msgServer := epochskeeper.NewMsgServerImpl(&s.EpochsKeeper)
+	ctx := s.Ctx
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(ctx))
+
+	_, err := msgServer.PauseEpoch(ctx, &types.MsgPauseEpoch{Authority: "invalid", Identifier: "hour"})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = msgServer.PauseEpoch(ctx, &types.MsgPauseEpoch{Authority: authority, Identifier: "unknown"})
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+	_, err = msgServer.ResumeEpoch(ctx, &types.MsgResumeEpoch{Authority: authority, Identifier: "hour"})
+	s.Require().ErrorContains(err, "is not paused")
+
+	_, err = msgServer.PauseEpoch(ctx, &types.MsgPauseEpoch{Authority: authority, Identifier: "hour"})
+	s.Require().NoError(err)
+	_, err = msgServer.PauseEpoch(ctx, &types.MsgPauseEpoch{Authority: authority, Identifier: "hour"})
+	s.Require().ErrorContains(err, "already paused")
+
+	// a paused epoch doesn't tick
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(5 * time.Hour))
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(ctx))
+	epoch, err := s.EpochsKeeper.GetEpochInfo(ctx, "hour")
+	s.Require().NoError(err)
+	s.Require().True(epoch.Paused)
+	s.Require().Equal(int64(1), epoch.CurrentEpoch)
+
+	// resuming restarts the current epoch instead of catching up the missed ones
+	_, err = msgServer.ResumeEpoch(ctx, &types.MsgResumeEpoch{Authority: authority, Identifier: "hour"})
+	s.Require().NoError(err)
+	epoch, err = s.EpochsKeeper.GetEpochInfo(ctx, "hour")
+	s.Require().NoError(err)
+	s.Require().False(epoch.Paused)
+	s.Require().Equal(int64(1), epoch.CurrentEpoch)
+	s.Require().Equal(ctx.BlockTime(), epoch.CurrentEpochStartTime)
+	s.Require().Equal(ctx.BlockHeight(), epoch.CurrentEpochStartHeight)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Hour + time.Second))
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(ctx))
+	epoch, err = s.EpochsKeeper.GetEpochInfo(ctx, "hour")
+	s.Require().NoError(err)
+	s.Require().Equal(int64(2), epoch.CurrentEpoch)
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateEpochDuration() {
+	authority := s.EpochsKeeper.GetAuthority()
+	msgServer := epochskeeper.NewMsgServerImpl(&s.EpochsKeeper)
+	ctx := s.Ctx
+
+	_, err := msgServer.UpdateEpochDuration(ctx, &types.MsgUpdateEpochDuration{Authority: "invalid", Identifier: "hour", Duration: time.Minute})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = msgServer.UpdateEpochDuration(ctx, &types.MsgUpdateEpochDuration{Authority: authority, Identifier: "hour"})
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// the duration of an epoch which hasn't started is changed immediately
+	_, err = msgServer.UpdateEpochDuration(ctx, &types.MsgUpdateEpochDuration{Authority: authority, Identifier: "minute", Duration: 2 * time.Minute})
+	s.Require().NoError(err)
+	epoch, err := s.EpochsKeeper.GetEpochInfo(ctx, "minute")
+	s.Require().NoError(err)
+	s.Require().Equal(2*time.Minute, epoch.Duration)
+	s.Require().Zero(epoch.PendingDuration)
+
+	// the duration of a running epoch is changed once the current epoch ends
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(ctx))
+	_, err = msgServer.UpdateEpochDuration(ctx, &types.MsgUpdateEpochDuration{Authority: authority, Identifier: "hour", Duration: 2 * time.Hour})
+	s.Require().NoError(err)
+	epoch, err = s.EpochsKeeper.GetEpochInfo(ctx, "hour")
+	s.Require().NoError(err)
+	s.Require().Equal(time.Hour, epoch.Duration)
+	s.Require().Equal(2*time.Hour, epoch.PendingDuration)
+	s.Require().True(slices.ContainsFunc(ctx.EventManager().Events(), func(e sdk.Event) bool {
+		return e.Type == "cosmos.epochs.v1beta1.EventEpochDurationUpdated"
+	}))
+
+	start := epoch.CurrentEpochStartTime
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(start.Add(time.Hour + time.Second))
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(ctx))
+	epoch, err = s.EpochsKeeper.GetEpochInfo(ctx, "hour")
+	s.Require().NoError(err)
+	s.Require().Equal(int64(2), epoch.CurrentEpoch)
+	s.Require().Equal(start.Add(time.Hour), epoch.CurrentEpochStartTime)
+	s.Require().Equal(2*time.Hour, epoch.Duration)
+	s.Require().Zero(epoch.PendingDuration)
+
+	// the new duration is used by the following epoch
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(start.Add(2*time.Hour + time.Second))
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(ctx))
+	epoch, err = s.EpochsKeeper.GetEpochInfo(ctx, "hour")
+	s.Require().NoError(err)
+	s.Require().Equal(int64(2), epoch.CurrentEpoch)
+}
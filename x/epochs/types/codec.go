@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the x/epochs messages on the provided
+// LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgAddEpoch{}, "cosmos-sdk/x/epochs/MsgAddEpoch")
+	legacy.RegisterAminoMsg(cdc, &MsgPauseEpoch{}, "cosmos-sdk/x/epochs/MsgPauseEpoch")
+	legacy.RegisterAminoMsg(cdc, &MsgResumeEpoch{}, "cosmos-sdk/x/epochs/MsgResumeEpoch")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateEpochDuration{}, "cosmos-sdk/x/epochs/MsgUpdateDuration")
+}
+
+// RegisterInterfaces registers the x/epochs messages on the provided registry.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAddEpoch{},
+		&MsgPauseEpoch{},
+		&MsgResumeEpoch{},
+		&MsgUpdateEpochDuration{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -71,6 +72,12 @@ type EpochInfo struct {
 	// current_epoch_start_height is the block height at which the current epoch
 	// started. (The block height at which the timer last ticked)
 	CurrentEpochStartHeight int64 `protobuf:"varint,8,opt,name=current_epoch_start_height,json=currentEpochStartHeight,proto3" json:"current_epoch_start_height,omitempty"`
+	// paused is true when the epoch timer was paused by the module authority.
+	// A paused epoch does not tick.
+	Paused bool `protobuf:"varint,9,opt,name=paused,proto3" json:"paused,omitempty"`
+	// pending_duration, if non-zero, replaces the duration once the current epoch
+	// ends. It is set when the duration of a running epoch is changed.
+	PendingDuration time.Duration `protobuf:"bytes,10,opt,name=pending_duration,json=pendingDuration,proto3,stdduration" json:"pending_duration,omitempty"`
 }
 
 func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
@@ -155,6 +162,20 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 	return 0
 }
 
+func (m *EpochInfo) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *EpochInfo) GetPendingDuration() time.Duration {
+	if m != nil {
+		return m.PendingDuration
+	}
+	return 0
+}
+
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
 	Epochs []EpochInfo `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
@@ -210,35 +231,40 @@ func init() {
 }
 
 var fileDescriptor_3a3d6d4398875177 = []byte{
-	// 445 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0xcd, 0x91, 0x10, 0x9c, 0x6b, 0x91, 0xd0, 0xa9, 0x05, 0x93, 0xe1, 0x62, 0xb5, 0x8b, 0x25,
-	0xe0, 0x4e, 0x2d, 0x6c, 0x48, 0x0c, 0x29, 0x15, 0x7f, 0x06, 0x06, 0x07, 0x16, 0x24, 0x14, 0x39,
-	0xce, 0xe5, 0x7c, 0x02, 0xfb, 0x2c, 0xdf, 0xcf, 0x88, 0x7e, 0x8b, 0x8e, 0x7c, 0x20, 0x86, 0x8e,
-	0x1d, 0x99, 0x0a, 0x4a, 0x36, 0x3e, 0x05, 0xf2, 0x9d, 0x1d, 0x05, 0x9a, 0xa5, 0x93, 0xed, 0x7b,
-	0xef, 0xf7, 0xde, 0xbd, 0x9f, 0x1f, 0x3e, 0x4c, 0xb4, 0xc9, 0xb4, 0xe1, 0xa2, 0xd0, 0x49, 0x6a,
-	0xf8, 0xd7, 0xa3, 0x99, 0x80, 0xf8, 0x88, 0x4b, 0x91, 0x0b, 0xa3, 0x0c, 0x2b, 0x4a, 0x0d, 0x9a,
-	0xec, 0x3b, 0x12, 0x73, 0x24, 0xd6, 0x90, 0x86, 0x7b, 0x52, 0x4b, 0x6d, 0x19, 0xbc, 0x7e, 0x73,
-	0xe4, 0x21, 0x95, 0x5a, 0xcb, 0x2f, 0x82, 0xdb, 0xaf, 0x59, 0xb5, 0xe0, 0xf3, 0xaa, 0x8c, 0x41,
-	0xe9, 0xbc, 0xc1, 0x47, 0xff, 0xe3, 0xa0, 0x32, 0x61, 0x20, 0xce, 0x0a, 0x47, 0x38, 0xf8, 0xd1,
-	0xc5, 0x83, 0xd3, 0xda, 0xe9, 0x4d, 0xbe, 0xd0, 0x84, 0x62, 0xac, 0xe6, 0x22, 0x07, 0xb5, 0x50,
-	0xa2, 0xf4, 0x51, 0x80, 0xc2, 0x41, 0xb4, 0x71, 0x42, 0x4e, 0x30, 0x36, 0x10, 0x97, 0x30, 0xad,
-	0x65, 0xfc, 0x5b, 0x01, 0x0a, 0x77, 0x8e, 0x87, 0xcc, 0x79, 0xb0, 0xd6, 0x83, 0xbd, 0x6f, 0x3d,
-	0xc6, 0xde, 0xc5, 0xd5, 0xa8, 0x73, 0xfe, 0x6b, 0x84, 0xa2, 0x81, 0x9d, 0xab, 0x11, 0xf2, 0x01,
-	0x7b, 0xed, 0x2d, 0xfd, 0xae, 0x95, 0x78, 0x78, 0x4d, 0xe2, 0x65, 0x43, 0x18, 0xd3, 0x5a, 0xe1,
-	0xcf, 0xd5, 0x88, 0xb4, 0x23, 0x8f, 0x75, 0xa6, 0x40, 0x64, 0x05, 0x9c, 0x7d, 0xaf, 0x75, 0xd7,
-	0x52, 0xe4, 0x10, 0xdf, 0x4d, 0xaa, 0xb2, 0x14, 0x39, 0x4c, 0xed, 0xea, 0xfc, 0x5e, 0x80, 0xc2,
-	0x6e, 0xb4, 0xdb, 0x1c, 0xda, 0x90, 0xe4, 0x13, 0xf6, 0xff, 0x21, 0x4d, 0x37, 0xe2, 0xdc, 0xbe,
-	0x41, 0x9c, 0xfd, 0x4d, 0xd5, 0xc9, 0x3a, 0xda, 0x33, 0x7c, 0xdf, 0xc9, 0x26, 0xba, 0xca, 0x41,
-	0xe5, 0xd2, 0xe9, 0x8b, 0xb9, 0xdf, 0x0f, 0x50, 0xe8, 0x45, 0x7b, 0x16, 0x3d, 0x69, 0xc0, 0x89,
-	0xc3, 0xc8, 0x73, 0x3c, 0xdc, 0x76, 0xa9, 0x54, 0x28, 0x99, 0x82, 0xef, 0xd9, 0x18, 0x0f, 0xae,
-	0x19, 0xbe, 0xb6, 0xf0, 0xdb, 0x9e, 0x77, 0xe7, 0x9e, 0x77, 0xf0, 0x0e, 0xef, 0xbe, 0x72, 0x2d,
-	0x9a, 0x40, 0x0c, 0x82, 0xbc, 0xc0, 0x7d, 0xd7, 0x1f, 0x1f, 0x05, 0xdd, 0x70, 0xe7, 0x38, 0x60,
-	0x5b, 0x5b, 0xc5, 0xd6, 0xbf, 0x7e, 0xdc, 0xab, 0xb3, 0x45, 0xcd, 0xd4, 0xf8, 0xf4, 0x62, 0x49,
-	0xd1, 0xe5, 0x92, 0xa2, 0xdf, 0x4b, 0x8a, 0xce, 0x57, 0xb4, 0x73, 0xb9, 0xa2, 0x9d, 0x9f, 0x2b,
-	0xda, 0xf9, 0xf8, 0x48, 0x2a, 0x48, 0xab, 0x19, 0x4b, 0x74, 0xc6, 0x9b, 0x3a, 0xbb, 0xc7, 0x13,
-	0x33, 0xff, 0xcc, 0xbf, 0xb5, 0xdd, 0x86, 0xb3, 0x42, 0x98, 0x59, 0xdf, 0x2e, 0xf1, 0xe9, 0xdf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x71, 0xa8, 0x51, 0xe8, 0xf9, 0x02, 0x00, 0x00,
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xcd, 0x7c, 0xc9, 0x17, 0x9c, 0x69, 0x51, 0xab, 0xa1, 0x05, 0xd7, 0x0b, 0xc7, 0x6a, 0x37,
+	0x96, 0x4a, 0xc7, 0xb4, 0x94, 0x05, 0x42, 0x62, 0xe1, 0x52, 0xf1, 0xb3, 0x60, 0xe1, 0xc0, 0x06,
+	0x09, 0x45, 0x8e, 0x3d, 0x71, 0x46, 0x60, 0x8f, 0xe5, 0x19, 0x23, 0xba, 0xe2, 0x15, 0xba, 0xe4,
+	0x41, 0x78, 0x88, 0x2e, 0xbb, 0x44, 0x2c, 0x0a, 0x4a, 0x76, 0x2c, 0x79, 0x02, 0x34, 0x3f, 0x0e,
+	0x69, 0x1b, 0x09, 0xb1, 0xf3, 0xcc, 0x39, 0xf7, 0x9c, 0x7b, 0x7c, 0xe7, 0xc2, 0x9d, 0x84, 0xf1,
+	0x9c, 0xf1, 0x80, 0x94, 0x2c, 0x99, 0xf0, 0xe0, 0xc3, 0xfe, 0x88, 0x88, 0x78, 0x3f, 0xc8, 0x48,
+	0x41, 0x38, 0xe5, 0xb8, 0xac, 0x98, 0x60, 0x68, 0x53, 0x93, 0xb0, 0x26, 0x61, 0x43, 0x72, 0x36,
+	0x32, 0x96, 0x31, 0xc5, 0x08, 0xe4, 0x97, 0x26, 0x3b, 0x6e, 0xc6, 0x58, 0xf6, 0x9e, 0x04, 0xea,
+	0x34, 0xaa, 0xc7, 0x41, 0x5a, 0x57, 0xb1, 0xa0, 0xac, 0x30, 0x78, 0xff, 0x2a, 0x2e, 0x68, 0x4e,
+	0xb8, 0x88, 0xf3, 0xd2, 0x10, 0xb6, 0xb4, 0xdb, 0x50, 0x2b, 0x1b, 0x6b, 0x75, 0xd8, 0xfe, 0xd5,
+	0x81, 0xbd, 0x63, 0xd9, 0xc4, 0xf3, 0x62, 0xcc, 0x90, 0x0b, 0x21, 0x4d, 0x49, 0x21, 0xe8, 0x98,
+	0x92, 0xca, 0x06, 0x1e, 0xf0, 0x7b, 0xd1, 0xc2, 0x0d, 0x3a, 0x82, 0x90, 0x8b, 0xb8, 0x12, 0x43,
+	0xe9, 0x60, 0xff, 0xe7, 0x01, 0x7f, 0xe5, 0xc0, 0xc1, 0xda, 0x1e, 0x37, 0xf6, 0xf8, 0x55, 0x63,
+	0x1f, 0x5a, 0x67, 0x17, 0xfd, 0xd6, 0xe9, 0xf7, 0x3e, 0x88, 0x7a, 0xaa, 0x4e, 0x22, 0xe8, 0x35,
+	0xb4, 0x9a, 0x00, 0x76, 0x5b, 0x49, 0x6c, 0x5d, 0x93, 0x78, 0x62, 0x08, 0xa1, 0x2b, 0x15, 0x7e,
+	0x5e, 0xf4, 0x51, 0x53, 0x72, 0x97, 0xe5, 0x54, 0x90, 0xbc, 0x14, 0x27, 0x9f, 0xa5, 0xee, 0x5c,
+	0x0a, 0xed, 0xc0, 0x9b, 0x49, 0x5d, 0x55, 0xa4, 0x10, 0x43, 0xf5, 0x57, 0xed, 0x8e, 0x07, 0xfc,
+	0x76, 0xb4, 0x6a, 0x2e, 0x55, 0x48, 0xf4, 0x16, 0xda, 0x97, 0x48, 0xc3, 0x85, 0x38, 0xff, 0xff,
+	0x43, 0x9c, 0xcd, 0x45, 0xd5, 0xc1, 0x3c, 0xda, 0x21, 0xbc, 0xad, 0x65, 0x13, 0x56, 0x17, 0x82,
+	0x16, 0x99, 0xd6, 0x27, 0xa9, 0xdd, 0xf5, 0x80, 0x6f, 0x45, 0x1b, 0x0a, 0x3d, 0x32, 0xe0, 0x40,
+	0x63, 0xe8, 0x11, 0x74, 0x96, 0x35, 0x35, 0x21, 0x34, 0x9b, 0x08, 0xdb, 0x52, 0x31, 0xee, 0x5c,
+	0x33, 0x7c, 0xa6, 0x60, 0xb4, 0x0b, 0xbb, 0x65, 0x5c, 0x73, 0x92, 0xda, 0x3d, 0x69, 0x11, 0xde,
+	0xfa, 0xf6, 0x65, 0x6f, 0x4d, 0x8f, 0x78, 0x8f, 0xa7, 0xef, 0xbc, 0x7b, 0xf8, 0xc1, 0x61, 0x64,
+	0x28, 0xe8, 0x13, 0x5c, 0x2f, 0x49, 0x91, 0xca, 0xc6, 0xe6, 0x23, 0x80, 0x7f, 0x1b, 0xc1, 0x43,
+	0x33, 0x02, 0xe7, 0x6a, 0xe9, 0xe5, 0x51, 0x2c, 0xf3, 0x5d, 0x33, 0x25, 0x8d, 0xd6, 0x8b, 0x8e,
+	0x75, 0x63, 0xdd, 0xda, 0x7e, 0x09, 0x57, 0x9f, 0xea, 0x75, 0x18, 0x88, 0x58, 0x10, 0xf4, 0x18,
+	0x76, 0xf5, 0x22, 0xd8, 0xc0, 0x6b, 0xfb, 0x2b, 0x07, 0x1e, 0x5e, 0xba, 0x1e, 0x78, 0xfe, 0x50,
+	0xc3, 0x8e, 0xec, 0x29, 0x32, 0x55, 0xe1, 0xf1, 0xd9, 0xd4, 0x05, 0xe7, 0x53, 0x17, 0xfc, 0x98,
+	0xba, 0xe0, 0x74, 0xe6, 0xb6, 0xce, 0x67, 0x6e, 0xeb, 0xeb, 0xcc, 0x6d, 0xbd, 0xd9, 0xcd, 0xa8,
+	0x98, 0xd4, 0x23, 0x9c, 0xb0, 0xdc, 0xbc, 0xfb, 0xe0, 0x4f, 0x8f, 0xc1, 0xc7, 0x66, 0x49, 0xc5,
+	0x49, 0x49, 0xf8, 0xa8, 0xab, 0xb2, 0xdf, 0xff, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x6e, 0x1c, 0x2e,
+	0x7f, 0xc2, 0x03, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -261,6 +287,24 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PendingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PendingDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x52
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.CurrentEpochStartHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpochStartHeight))
 		i--
@@ -276,12 +320,12 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CurrentEpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CurrentEpochStartTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CurrentEpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CurrentEpochStartTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x2a
 	if m.CurrentEpoch != 0 {
@@ -289,21 +333,21 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x20
 	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintGenesis(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x1a
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
 	if err3 != nil {
 		return 0, err3
 	}
 	i -= n3
 	i = encodeVarintGenesis(dAtA, i, uint64(n3))
 	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
 	dAtA[i] = 0x12
 	if len(m.Identifier) > 0 {
 		i -= len(m.Identifier)
@@ -388,6 +432,11 @@ func (m *EpochInfo) Size() (n int) {
 	if m.CurrentEpochStartHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.CurrentEpochStartHeight))
 	}
+	if m.Paused {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PendingDuration)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -630,6 +679,59 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PendingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -12,7 +12,20 @@ type EpochHooks interface {
 	BeforeEpochStart(ctx context.Context, epochIdentifier string, epochNumber int64) error
 }
 
-var _ EpochHooks = MultiEpochHooks{}
+// EpochUpdateHooks can be implemented by EpochHooks to react to the epochs
+// being added or updated by the module authority.
+type EpochUpdateHooks interface {
+	// AfterEpochAdded is called after a new epoch is added.
+	AfterEpochAdded(ctx context.Context, epoch EpochInfo) error
+	// AfterEpochUpdated is called after an epoch is paused, resumed or has its
+	// duration changed.
+	AfterEpochUpdated(ctx context.Context, epoch EpochInfo) error
+}
+
+var (
+	_ EpochHooks       = MultiEpochHooks{}
+	_ EpochUpdateHooks = MultiEpochHooks{}
+)
 
 // MultiEpochHooks combines multiple hooks, all hook functions are run in array sequence.
 type MultiEpochHooks []EpochHooks
@@ -39,6 +52,38 @@ func (h MultiEpochHooks) BeforeEpochStart(ctx context.Context, epochIdentifier s
 	return errs
 }
 
+// AfterEpochAdded is called after a new epoch is added, for the hooks implementing EpochUpdateHooks.
+func (h MultiEpochHooks) AfterEpochAdded(ctx context.Context, epoch EpochInfo) error {
+	var errs error
+	for i := range h {
+		if uh, ok := updateHooks(h[i]); ok {
+			errs = errors.Join(errs, uh.AfterEpochAdded(ctx, epoch))
+		}
+	}
+	return errs
+}
+
+// AfterEpochUpdated is called after an epoch is updated, for the hooks implementing EpochUpdateHooks.
+func (h MultiEpochHooks) AfterEpochUpdated(ctx context.Context, epoch EpochInfo) error {
+	var errs error
+	for i := range h {
+		if uh, ok := updateHooks(h[i]); ok {
+			errs = errors.Join(errs, uh.AfterEpochUpdated(ctx, epoch))
+		}
+	}
+	return errs
+}
+
+// updateHooks returns the EpochUpdateHooks implementation of the hooks, if any.
+func updateHooks(hooks EpochHooks) (EpochUpdateHooks, bool) {
+	if wrapper, ok := hooks.(EpochHooksWrapper); ok {
+		hooks = wrapper.EpochHooks
+	}
+
+	uh, ok := hooks.(EpochUpdateHooks)
+	return uh, ok
+}
+
 // EpochHooksWrapper is a wrapper for modules to inject EpochHooks using depinject.
 type EpochHooksWrapper struct{ EpochHooks }
 
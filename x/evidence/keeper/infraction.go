@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -38,6 +39,31 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 		return nil
 	}
 
+	// The validator may have rotated its consensus key since the infraction. A
+	// rotated key stops signing ValidatorUpdateDelay blocks after the rotation,
+	// any later evidence for it cannot have been produced by the validator.
+	rotation, err := k.stakingKeeper.GetConsPubKeyRotationByOldConsAddr(ctx, consAddr)
+	switch {
+	case err == nil:
+		if evidence.GetHeight() > rotation.Height+sdk.ValidatorUpdateDelay {
+			logger.Info(
+				"ignored equivocation; consensus key was not active at the infraction height",
+				"validator", consAddr,
+				"infraction_height", evidence.GetHeight(),
+				"rotation_height", rotation.Height,
+			)
+			return nil
+		}
+	case !errors.Is(err, stakingtypes.ErrNoConsPubKeyRotationFound):
+		return err
+	}
+
+	// slashing state is tracked by the current consensus address of the validator
+	consAddr, err = k.stakingKeeper.ValidatorIdentifier(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+
 	if len(validator.GetOperator()) != 0 {
 		if _, err := k.slashingKeeper.GetPubkey(ctx, consAddr.Bytes()); err != nil {
 			// Ignore evidence that cannot be handled.
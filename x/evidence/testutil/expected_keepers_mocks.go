@@ -61,6 +61,21 @@ func (mr *MockStakingKeeperMockRecorder) ConsensusAddressCodec() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsensusAddressCodec", reflect.TypeOf((*MockStakingKeeper)(nil).ConsensusAddressCodec))
 }
 
+// GetConsPubKeyRotationByOldConsAddr mocks base method.
+func (m *MockStakingKeeper) GetConsPubKeyRotationByOldConsAddr(arg0 context.Context, arg1 types0.ConsAddress) (types1.ConsPubKeyRotationHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsPubKeyRotationByOldConsAddr", arg0, arg1)
+	ret0, _ := ret[0].(types1.ConsPubKeyRotationHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsPubKeyRotationByOldConsAddr indicates an expected call of GetConsPubKeyRotationByOldConsAddr.
+func (mr *MockStakingKeeperMockRecorder) GetConsPubKeyRotationByOldConsAddr(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsPubKeyRotationByOldConsAddr", reflect.TypeOf((*MockStakingKeeper)(nil).GetConsPubKeyRotationByOldConsAddr), arg0, arg1)
+}
+
 // GetParams mocks base method.
 func (m *MockStakingKeeper) GetParams(ctx context.Context) (types1.Params, error) {
 	m.ctrl.T.Helper()
@@ -91,6 +106,21 @@ func (mr *MockStakingKeeperMockRecorder) ValidatorByConsAddr(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorByConsAddr", reflect.TypeOf((*MockStakingKeeper)(nil).ValidatorByConsAddr), arg0, arg1)
 }
 
+// ValidatorIdentifier mocks base method.
+func (m *MockStakingKeeper) ValidatorIdentifier(arg0 context.Context, arg1 types0.ConsAddress) (types0.ConsAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatorIdentifier", arg0, arg1)
+	ret0, _ := ret[0].(types0.ConsAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorIdentifier indicates an expected call of ValidatorIdentifier.
+func (mr *MockStakingKeeperMockRecorder) ValidatorIdentifier(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorIdentifier", reflect.TypeOf((*MockStakingKeeper)(nil).ValidatorIdentifier), arg0, arg1)
+}
+
 // MockSlashingKeeper is a mock of SlashingKeeper interface.
 type MockSlashingKeeper struct {
 	ctrl     *gomock.Controller
@@ -8,7 +8,9 @@ const (
 	EventTypeUpdateFeeGrant = "update_feegrant"
 	EventTypePruneFeeGrant  = "prune_feegrant"
 
-	AttributeKeyGranter = "granter"
-	AttributeKeyGrantee = "grantee"
-	AttributeKeyPruner  = "pruner"
+	AttributeKeyGranter  = "granter"
+	AttributeKeyGrantee  = "grantee"
+	AttributeKeyPruner   = "pruner"
+	AttributeKeySpent    = "spent"
+	AttributeKeyUseCount = "use_count"
 )
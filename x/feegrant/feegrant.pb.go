@@ -219,6 +219,188 @@ func (m *AllowedMsgAllowance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AllowedMsgAllowance proto.InternalMessageInfo
 
+// MsgTypeSpendCap caps the fees that can be spent by the transactions containing
+// a message type.
+type MsgTypeSpendCap struct {
+	// msg_type_url is the type URL of the message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// spend_cap is the amount of coins left to be spent by the transactions
+	// containing the message. It is updated as coins are spent.
+	SpendCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spend_cap,json=spendCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_cap"`
+}
+
+func (m *MsgTypeSpendCap) Reset()         { *m = MsgTypeSpendCap{} }
+func (m *MsgTypeSpendCap) String() string { return proto.CompactTextString(m) }
+func (*MsgTypeSpendCap) ProtoMessage()    {}
+func (*MsgTypeSpendCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{3}
+}
+func (m *MsgTypeSpendCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTypeSpendCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTypeSpendCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTypeSpendCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTypeSpendCap.Merge(m, src)
+}
+func (m *MsgTypeSpendCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTypeSpendCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTypeSpendCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTypeSpendCap proto.InternalMessageInfo
+
+func (m *MsgTypeSpendCap) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgTypeSpendCap) GetSpendCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendCap
+	}
+	return nil
+}
+
+// SpendCapAllowance creates allowance only for the message types with a spend
+// cap. The whole fee of a transaction counts against the spend cap of every
+// message type it contains.
+type SpendCapAllowance struct {
+	// allowance can be any of basic and periodic fee allowance.
+	Allowance *any.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// spend_caps are the spend caps of the allowed message types.
+	SpendCaps []MsgTypeSpendCap `protobuf:"bytes,2,rep,name=spend_caps,json=spendCaps,proto3" json:"spend_caps"`
+}
+
+func (m *SpendCapAllowance) Reset()         { *m = SpendCapAllowance{} }
+func (m *SpendCapAllowance) String() string { return proto.CompactTextString(m) }
+func (*SpendCapAllowance) ProtoMessage()    {}
+func (*SpendCapAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{4}
+}
+func (m *SpendCapAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendCapAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendCapAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendCapAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendCapAllowance.Merge(m, src)
+}
+func (m *SpendCapAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendCapAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendCapAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendCapAllowance proto.InternalMessageInfo
+
+// GrantUsage records how much a grant has spent and how often it was used.
+type GrantUsage struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address of the user being granted an allowance of another user's funds.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// spent is the amount of fees paid through the grant to date.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+	// last_used_height is the height of the block in which the grant was last used.
+	LastUsedHeight int64 `protobuf:"varint,4,opt,name=last_used_height,json=lastUsedHeight,proto3" json:"last_used_height,omitempty"`
+	// use_count is the number of transactions whose fees were paid through the grant.
+	UseCount uint64 `protobuf:"varint,5,opt,name=use_count,json=useCount,proto3" json:"use_count,omitempty"`
+}
+
+func (m *GrantUsage) Reset()         { *m = GrantUsage{} }
+func (m *GrantUsage) String() string { return proto.CompactTextString(m) }
+func (*GrantUsage) ProtoMessage()    {}
+func (*GrantUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{5}
+}
+func (m *GrantUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantUsage.Merge(m, src)
+}
+func (m *GrantUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantUsage proto.InternalMessageInfo
+
+func (m *GrantUsage) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *GrantUsage) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *GrantUsage) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+func (m *GrantUsage) GetLastUsedHeight() int64 {
+	if m != nil {
+		return m.LastUsedHeight
+	}
+	return 0
+}
+
+func (m *GrantUsage) GetUseCount() uint64 {
+	if m != nil {
+		return m.UseCount
+	}
+	return 0
+}
+
 // Grant is stored in the KVStore to record a grant with full context
 type Grant struct {
 	// granter is the address of the user granting an allowance of their funds.
@@ -233,7 +415,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{3}
+	return fileDescriptor_7279582900c30aea, []int{6}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -287,6 +469,9 @@ func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
+	proto.RegisterType((*MsgTypeSpendCap)(nil), "cosmos.feegrant.v1beta1.MsgTypeSpendCap")
+	proto.RegisterType((*SpendCapAllowance)(nil), "cosmos.feegrant.v1beta1.SpendCapAllowance")
+	proto.RegisterType((*GrantUsage)(nil), "cosmos.feegrant.v1beta1.GrantUsage")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
 }
 
@@ -295,48 +480,60 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 655 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x41, 0x4f, 0xd4, 0x40,
-	0x14, 0xde, 0x61, 0x01, 0xb3, 0xb3, 0x88, 0x50, 0x49, 0xec, 0x12, 0xd3, 0x25, 0x9b, 0x28, 0x0b,
-	0x09, 0x6d, 0xc0, 0x1b, 0x27, 0xb6, 0x18, 0x50, 0x03, 0x09, 0x29, 0x9e, 0x4c, 0x4c, 0x33, 0x6d,
-	0x87, 0x3a, 0x71, 0xdb, 0x69, 0x3a, 0x45, 0xd9, 0xab, 0x27, 0xa3, 0x07, 0x39, 0x1a, 0x4f, 0x1c,
-	0x8d, 0x27, 0x0e, 0xfc, 0x08, 0xe2, 0xc1, 0x10, 0x4f, 0x7a, 0x11, 0x03, 0x07, 0xce, 0xfe, 0x03,
-	0xd3, 0x99, 0x69, 0xb7, 0xb0, 0xa2, 0x90, 0x18, 0x2e, 0xbb, 0x33, 0x6f, 0xde, 0xf7, 0xbd, 0xef,
-	0x7b, 0xef, 0x6d, 0x16, 0xde, 0x75, 0x29, 0x0b, 0x28, 0x33, 0x36, 0x30, 0xf6, 0x63, 0x14, 0x26,
-	0xc6, 0x8b, 0x59, 0x07, 0x27, 0x68, 0x36, 0x0f, 0xe8, 0x51, 0x4c, 0x13, 0xaa, 0xdc, 0x12, 0x79,
-	0x7a, 0x1e, 0x96, 0x79, 0xe3, 0x63, 0x3e, 0xf5, 0x29, 0xcf, 0x31, 0xd2, 0x93, 0x48, 0x1f, 0xaf,
-	0xf9, 0x94, 0xfa, 0x6d, 0x6c, 0xf0, 0x9b, 0xb3, 0xb9, 0x61, 0xa0, 0xb0, 0x93, 0x3d, 0x09, 0x26,
-	0x5b, 0x60, 0x24, 0xad, 0x78, 0xd2, 0xa4, 0x18, 0x07, 0x31, 0x9c, 0x0b, 0x71, 0x29, 0x09, 0xe5,
-	0xfb, 0x28, 0x0a, 0x48, 0x48, 0x0d, 0xfe, 0x29, 0x43, 0xf5, 0xb3, 0x85, 0x12, 0x12, 0x60, 0x96,
-	0xa0, 0x20, 0xca, 0x38, 0xcf, 0x26, 0x78, 0x9b, 0x31, 0x4a, 0x08, 0x95, 0x9c, 0x8d, 0x9d, 0x3e,
-	0x38, 0x6c, 0x22, 0x46, 0xdc, 0x56, 0xbb, 0x4d, 0x5f, 0xa2, 0xd0, 0xc5, 0xca, 0x2b, 0x00, 0xab,
-	0x2c, 0xc2, 0xa1, 0x67, 0xb7, 0x49, 0x40, 0x12, 0x15, 0x4c, 0x94, 0x9b, 0xd5, 0xb9, 0x9a, 0x2e,
-	0xb5, 0xa6, 0xea, 0x32, 0xfb, 0xfa, 0x22, 0x25, 0xa1, 0xb9, 0xb4, 0xff, 0xa3, 0x5e, 0xfa, 0x74,
-	0x58, 0x6f, 0xfa, 0x24, 0x79, 0xb6, 0xe9, 0xe8, 0x2e, 0x0d, 0xa4, 0x31, 0xf9, 0x35, 0xc3, 0xbc,
-	0xe7, 0x46, 0xd2, 0x89, 0x30, 0xe3, 0x00, 0xf6, 0xe1, 0x64, 0x77, 0x7a, 0xa8, 0x8d, 0x7d, 0xe4,
-	0x76, 0xec, 0xd4, 0x1f, 0xfb, 0x78, 0xb2, 0x3b, 0x0d, 0x2c, 0xc8, 0xab, 0xae, 0xa4, 0x45, 0x95,
-	0x05, 0x08, 0xf1, 0x56, 0x44, 0x84, 0x56, 0xb5, 0x6f, 0x02, 0x34, 0xab, 0x73, 0xe3, 0xba, 0x30,
-	0xa3, 0x67, 0x66, 0xf4, 0xc7, 0x99, 0x5b, 0xb3, 0x7f, 0xfb, 0xb0, 0x0e, 0xac, 0x02, 0x66, 0x7e,
-	0xf9, 0xf3, 0xde, 0xcc, 0x9d, 0x73, 0xc6, 0xa6, 0x2f, 0x61, 0x9c, 0x1b, 0x7e, 0xf8, 0xe6, 0x64,
-	0x77, 0xba, 0x56, 0x50, 0x7a, 0xba, 0x1f, 0x8d, 0xef, 0xfd, 0x70, 0x74, 0x0d, 0xc7, 0x84, 0x7a,
-	0xc5, 0x2e, 0x3d, 0x80, 0x03, 0x4e, 0x9a, 0xa7, 0x02, 0xae, 0x6d, 0x52, 0x3f, 0xaf, 0xd4, 0x69,
-	0x36, 0xb3, 0x92, 0x36, 0x4b, 0xf8, 0x15, 0x04, 0xca, 0x02, 0x1c, 0x8c, 0x38, 0xbd, 0xb4, 0x59,
-	0xeb, 0xb1, 0x79, 0x5f, 0xce, 0xcc, 0xbc, 0x9e, 0x82, 0xdf, 0x1f, 0xd6, 0x81, 0x20, 0x90, 0x38,
-	0xe5, 0x1d, 0x80, 0x8a, 0x38, 0xda, 0xc5, 0xc1, 0x95, 0xaf, 0x6a, 0x70, 0x23, 0xa2, 0xf8, 0x7a,
-	0x77, 0x7c, 0x6f, 0x01, 0x94, 0x41, 0xdb, 0x45, 0xa1, 0x50, 0xa5, 0xf6, 0x5f, 0x95, 0x9e, 0x61,
-	0x51, 0x7a, 0x11, 0x85, 0x5c, 0x92, 0xb2, 0x02, 0x87, 0xa4, 0x98, 0x18, 0x33, 0x9c, 0xa8, 0x03,
-	0xff, 0x5c, 0x27, 0xde, 0xe8, 0xed, 0xbc, 0xd1, 0x55, 0x01, 0xb7, 0x52, 0xf4, 0xfc, 0xa3, 0x4b,
-	0x2d, 0xd6, 0xed, 0x82, 0xf2, 0x9e, 0x2d, 0x6a, 0xfc, 0x02, 0xf0, 0x26, 0xbf, 0x61, 0x6f, 0x95,
-	0xf9, 0xdd, 0xed, 0x7a, 0x0a, 0x2b, 0x28, 0xbb, 0xc8, 0x0d, 0x1b, 0xeb, 0x91, 0xdb, 0x0a, 0x3b,
-	0xe6, 0xd4, 0x85, 0xc5, 0x58, 0x5d, 0x46, 0x65, 0x0a, 0x8e, 0x20, 0x51, 0xd5, 0x0e, 0x30, 0x63,
-	0xc8, 0xc7, 0x4c, 0xed, 0x9b, 0x28, 0x37, 0x2b, 0xd6, 0x0d, 0x19, 0x5f, 0x95, 0xe1, 0xf9, 0xb5,
-	0xd7, 0x3b, 0xf5, 0xd2, 0xa5, 0x1c, 0x6b, 0x05, 0xc7, 0x7f, 0xf0, 0xd6, 0xf8, 0x02, 0xe0, 0xc0,
-	0x72, 0x4a, 0xa1, 0xcc, 0xc1, 0x6b, 0x9c, 0x0b, 0xc7, 0xdc, 0x63, 0xc5, 0x54, 0xbf, 0xee, 0xcd,
-	0x8c, 0xc9, 0x42, 0x2d, 0xcf, 0x8b, 0x31, 0x63, 0xeb, 0x49, 0x4c, 0x42, 0xdf, 0xca, 0x12, 0xbb,
-	0x18, 0xcc, 0x7f, 0x2e, 0x17, 0xc0, 0x9c, 0xe9, 0x66, 0xf9, 0x7f, 0x77, 0xd3, 0x6c, 0xed, 0x1f,
-	0x69, 0xe0, 0xe0, 0x48, 0x03, 0x3f, 0x8f, 0x34, 0xb0, 0x7d, 0xac, 0x95, 0x0e, 0x8e, 0xb5, 0xd2,
-	0xb7, 0x63, 0xad, 0xf4, 0x64, 0xf2, 0xaf, 0x8b, 0xbc, 0x95, 0xff, 0xcb, 0x38, 0x83, 0x5c, 0xc6,
-	0xbd, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x31, 0x3d, 0x53, 0x70, 0x90, 0x06, 0x00, 0x00,
+	// 839 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x4f, 0x33, 0x45,
+	0x1c, 0xee, 0xf4, 0xe3, 0xf5, 0xed, 0x14, 0xf9, 0xd8, 0x97, 0xc4, 0x2d, 0x9a, 0x6d, 0xd3, 0x44,
+	0x29, 0x24, 0xdd, 0x0a, 0xea, 0xa5, 0x27, 0xba, 0x35, 0x80, 0x06, 0x12, 0xb2, 0xc0, 0xc5, 0xc4,
+	0x6c, 0xa6, 0xbb, 0xc3, 0xb2, 0x71, 0x77, 0x67, 0xb3, 0xb3, 0x2b, 0xf4, 0xe2, 0xc1, 0x93, 0xd1,
+	0x83, 0x1c, 0x8d, 0x27, 0x8e, 0xea, 0x89, 0x03, 0x7f, 0x04, 0x7a, 0x30, 0x84, 0x93, 0x5e, 0xc4,
+	0xc0, 0x81, 0xb3, 0xff, 0x81, 0xd9, 0x99, 0xd9, 0xb6, 0xb4, 0xa0, 0x90, 0xbc, 0xe9, 0x05, 0x76,
+	0x7e, 0xf3, 0xfb, 0x78, 0x9e, 0xe7, 0xf7, 0x64, 0x52, 0xf8, 0x9e, 0x49, 0xa8, 0x47, 0x68, 0xf3,
+	0x00, 0x63, 0x3b, 0x44, 0x7e, 0xd4, 0xfc, 0x72, 0xa5, 0x8b, 0x23, 0xb4, 0xd2, 0x0f, 0xa8, 0x41,
+	0x48, 0x22, 0x22, 0xbd, 0xc5, 0xf3, 0xd4, 0x7e, 0x58, 0xe4, 0x2d, 0xcc, 0xdb, 0xc4, 0x26, 0x2c,
+	0xa7, 0x99, 0x7c, 0xf1, 0xf4, 0x85, 0xb2, 0x4d, 0x88, 0xed, 0xe2, 0x26, 0x3b, 0x75, 0xe3, 0x83,
+	0x26, 0xf2, 0x7b, 0xe9, 0x15, 0xef, 0x64, 0xf0, 0x1a, 0xd1, 0x96, 0x5f, 0x29, 0x02, 0x4c, 0x17,
+	0x51, 0xdc, 0x07, 0x62, 0x12, 0xc7, 0x17, 0xf7, 0x73, 0xc8, 0x73, 0x7c, 0xd2, 0x64, 0x7f, 0x45,
+	0xa8, 0x32, 0x3a, 0x28, 0x72, 0x3c, 0x4c, 0x23, 0xe4, 0x05, 0x69, 0xcf, 0xd1, 0x04, 0x2b, 0x0e,
+	0x51, 0xe4, 0x10, 0xd1, 0xb3, 0x76, 0x9a, 0x85, 0xd3, 0x1a, 0xa2, 0x8e, 0xd9, 0x76, 0x5d, 0x72,
+	0x84, 0x7c, 0x13, 0x4b, 0x5f, 0x03, 0x58, 0xa2, 0x01, 0xf6, 0x2d, 0xc3, 0x75, 0x3c, 0x27, 0x92,
+	0x41, 0x35, 0x57, 0x2f, 0xad, 0x96, 0x55, 0x81, 0x35, 0x41, 0x97, 0xd2, 0x57, 0x3b, 0xc4, 0xf1,
+	0xb5, 0xf5, 0x8b, 0xbf, 0x2a, 0x99, 0x5f, 0xae, 0x2b, 0x75, 0xdb, 0x89, 0x0e, 0xe3, 0xae, 0x6a,
+	0x12, 0x4f, 0x10, 0x13, 0xff, 0x1a, 0xd4, 0xfa, 0xa2, 0x19, 0xf5, 0x02, 0x4c, 0x59, 0x01, 0xfd,
+	0xf1, 0xee, 0x6c, 0x79, 0xca, 0xc5, 0x36, 0x32, 0x7b, 0x46, 0xc2, 0x8f, 0xfe, 0x74, 0x77, 0xb6,
+	0x0c, 0x74, 0xc8, 0xa6, 0x6e, 0x25, 0x43, 0xa5, 0x35, 0x08, 0xf1, 0x71, 0xe0, 0x70, 0xac, 0x72,
+	0xb6, 0x0a, 0xea, 0xa5, 0xd5, 0x05, 0x95, 0x93, 0x51, 0x53, 0x32, 0xea, 0x5e, 0xca, 0x56, 0xcb,
+	0x9f, 0x5c, 0x57, 0x80, 0x3e, 0x54, 0xd3, 0xda, 0xf8, 0xed, 0xbc, 0xf1, 0xee, 0x23, 0x6b, 0x53,
+	0xd7, 0x31, 0xee, 0x13, 0xfe, 0xe4, 0xdb, 0xbb, 0xb3, 0xe5, 0xf2, 0x10, 0xd2, 0xfb, 0x7a, 0xd4,
+	0xfe, 0xcc, 0xc3, 0xb9, 0x1d, 0x1c, 0x3a, 0xc4, 0x1a, 0x56, 0x69, 0x13, 0x16, 0xba, 0x49, 0x9e,
+	0x0c, 0x18, 0xb6, 0x45, 0xf5, 0xb1, 0x51, 0xf7, 0xbb, 0x69, 0xc5, 0x44, 0x2c, 0xce, 0x97, 0x37,
+	0x90, 0xd6, 0xe0, 0x8b, 0x80, 0xb5, 0x17, 0x34, 0xcb, 0x63, 0x34, 0x3f, 0x16, 0x3b, 0xd3, 0xde,
+	0x4c, 0x8a, 0x7f, 0xb8, 0xae, 0x00, 0xde, 0x40, 0xd4, 0x49, 0xdf, 0x03, 0x28, 0xf1, 0x4f, 0x63,
+	0x78, 0x71, 0xb9, 0x49, 0x2d, 0x6e, 0x96, 0x0f, 0xdf, 0x1d, 0xac, 0xef, 0x3b, 0x00, 0x45, 0xd0,
+	0x30, 0x91, 0xcf, 0x51, 0xc9, 0xf9, 0x49, 0xe1, 0x99, 0xe6, 0xa3, 0x3b, 0xc8, 0x67, 0x90, 0xa4,
+	0x2d, 0x38, 0x25, 0xc0, 0x84, 0x98, 0xe2, 0x48, 0x2e, 0xfc, 0xaf, 0x9d, 0x98, 0xd0, 0x27, 0x7d,
+	0xa1, 0x4b, 0xbc, 0x5c, 0x4f, 0xaa, 0x5b, 0x9f, 0x3e, 0xcb, 0x58, 0xef, 0x0c, 0x21, 0x1f, 0x73,
+	0x51, 0xed, 0x1f, 0x00, 0x5f, 0xb1, 0x13, 0xb6, 0xb6, 0xa9, 0x3d, 0x70, 0xd7, 0xe7, 0xb0, 0x88,
+	0xd2, 0x83, 0x70, 0xd8, 0xfc, 0x18, 0xdc, 0xb6, 0xdf, 0xd3, 0x96, 0x9e, 0x0c, 0x46, 0x1f, 0x74,
+	0x94, 0x96, 0xe0, 0x2c, 0xe2, 0x53, 0x0d, 0x0f, 0x53, 0x8a, 0x6c, 0x4c, 0xe5, 0x6c, 0x35, 0x57,
+	0x2f, 0xea, 0x33, 0x22, 0xbe, 0x2d, 0xc2, 0xad, 0x9d, 0x6f, 0x4e, 0x2b, 0x99, 0x67, 0x31, 0x56,
+	0x86, 0x18, 0x3f, 0xc0, 0xad, 0x76, 0x01, 0xe0, 0xcc, 0x36, 0xb5, 0xf7, 0x7a, 0x01, 0x66, 0xeb,
+	0xe9, 0xa0, 0x40, 0xaa, 0xc2, 0x29, 0x8f, 0xda, 0x46, 0xb2, 0x5a, 0x23, 0x0e, 0x5d, 0x46, 0xb9,
+	0xa8, 0x43, 0x8f, 0xa7, 0xed, 0x87, 0xae, 0xf4, 0x15, 0x2c, 0x72, 0x6f, 0x9b, 0x28, 0x60, 0x58,
+	0x27, 0xe2, 0xa4, 0x97, 0x54, 0x20, 0x6c, 0xbd, 0xba, 0x3a, 0x6f, 0xcc, 0x0c, 0x6a, 0xab, 0xef,
+	0xab, 0x1f, 0x7d, 0x58, 0xfb, 0x39, 0x0b, 0xe7, 0x52, 0x0e, 0x13, 0x5b, 0x9e, 0x0e, 0x61, 0x5f,
+	0x09, 0x2a, 0xa4, 0xa8, 0x3f, 0xfa, 0xfc, 0x8c, 0x28, 0x3d, 0xfc, 0xfe, 0x14, 0x53, 0x72, 0xb4,
+	0x85, 0x9e, 0xb5, 0xe5, 0x07, 0xa4, 0x18, 0xb5, 0xfa, 0x98, 0x2a, 0xb5, 0x5f, 0xb3, 0x10, 0x6e,
+	0x24, 0x3d, 0xf7, 0x13, 0x63, 0x49, 0xab, 0xf0, 0x0d, 0x36, 0x01, 0x87, 0x7c, 0xd9, 0x9a, 0x7c,
+	0x75, 0xde, 0x98, 0x17, 0xe3, 0xdb, 0x96, 0x15, 0x62, 0x4a, 0x77, 0xa3, 0xd0, 0xf1, 0x6d, 0x3d,
+	0x4d, 0x1c, 0xd4, 0x60, 0xf6, 0x54, 0x3e, 0xa1, 0x06, 0x4b, 0x47, 0xb0, 0x90, 0xd0, 0x9c, 0xe0,
+	0x6b, 0xc8, 0xe7, 0x49, 0x75, 0x38, 0xeb, 0x22, 0x1a, 0x19, 0x31, 0xc5, 0x96, 0x71, 0x88, 0x1d,
+	0xfb, 0x30, 0x92, 0xf3, 0x55, 0x50, 0xcf, 0xe9, 0xd3, 0x49, 0x7c, 0x9f, 0x62, 0x6b, 0x93, 0x45,
+	0xa5, 0xb7, 0x61, 0x31, 0xa6, 0xd8, 0x30, 0x49, 0xec, 0xf3, 0xb7, 0x29, 0xaf, 0xbf, 0x8c, 0x29,
+	0xee, 0x24, 0xe7, 0x87, 0x7d, 0xf7, 0x3b, 0x80, 0x05, 0xa6, 0xe5, 0xc4, 0x64, 0xbc, 0xe7, 0xe9,
+	0xdc, 0xeb, 0xf6, 0xb4, 0xd6, 0xbe, 0xb8, 0x51, 0xc0, 0xe5, 0x8d, 0x02, 0xfe, 0xbe, 0x51, 0xc0,
+	0xc9, 0xad, 0x92, 0xb9, 0xbc, 0x55, 0x32, 0x7f, 0xdc, 0x2a, 0x99, 0xcf, 0x16, 0xff, 0x73, 0x1b,
+	0xc7, 0xfd, 0x1f, 0x6a, 0xdd, 0x17, 0x0c, 0xc6, 0x07, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xd6,
+	0x75, 0xe8, 0x88, 0xd3, 0x09, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -507,6 +704,160 @@ func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTypeSpendCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTypeSpendCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTypeSpendCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendCap) > 0 {
+		for iNdEx := len(m.SpendCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendCapAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendCapAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendCapAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendCaps) > 0 {
+		for iNdEx := len(m.SpendCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UseCount != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.UseCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastUsedHeight != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.LastUsedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -632,29 +983,96 @@ func (m *AllowedMsgAllowance) Size() (n int) {
 	return n
 }
 
-func (m *Grant) Size() (n int) {
+func (m *MsgTypeSpendCap) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Granter)
+	l = len(m.MsgTypeUrl)
 	if l > 0 {
 		n += 1 + l + sovFeegrant(uint64(l))
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovFeegrant(uint64(l))
+	if len(m.SpendCap) > 0 {
+		for _, e := range m.SpendCap {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpendCapAllowance) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
 	if m.Allowance != nil {
 		l = m.Allowance.Size()
 		n += 1 + l + sovFeegrant(uint64(l))
 	}
+	if len(m.SpendCaps) > 0 {
+		for _, e := range m.SpendCaps {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovFeegrant(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *GrantUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.LastUsedHeight != 0 {
+		n += 1 + sovFeegrant(uint64(m.LastUsedHeight))
+	}
+	if m.UseCount != 0 {
+		n += 1 + sovFeegrant(uint64(m.UseCount))
+	}
+	return n
+}
+
+func (m *Grant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	return n
+}
+
+func sovFeegrant(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozFeegrant(x uint64) (n int) {
 	return sovFeegrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -1114,6 +1532,428 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgTypeSpendCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTypeSpendCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTypeSpendCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendCap = append(m.SpendCap, types.Coin{})
+			if err := m.SpendCap[len(m.SpendCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpendCapAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendCapAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendCapAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &any.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendCaps = append(m.SpendCaps, MsgTypeSpendCap{})
+			if err := m.SpendCaps[len(m.SpendCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrantUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedHeight", wireType)
+			}
+			m.LastUsedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUsedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseCount", wireType)
+			}
+			m.UseCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UseCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
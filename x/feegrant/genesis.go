@@ -37,6 +37,26 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+
+	// Usages must be unique and belong to a grant
+	seenUsages := make(map[string]struct{})
+	for _, u := range data.Usages {
+		key := fmt.Sprintf("%s|%s", u.Granter, u.Grantee)
+		if _, exists := seen[key]; !exists {
+			return fmt.Errorf("feegrant usage found without feegrant from granter %q to grantee %q", u.Granter, u.Grantee)
+		}
+		if _, exists := seenUsages[key]; exists {
+			return fmt.Errorf("duplicate feegrant usage found from granter %q to grantee %q", u.Granter, u.Grantee)
+		}
+		seenUsages[key] = struct{}{}
+
+		if !u.Spent.IsValid() {
+			return fmt.Errorf("invalid spent amount of feegrant usage from granter %q to grantee %q: %s", u.Granter, u.Grantee, u.Spent)
+		}
+		if u.LastUsedHeight < 0 {
+			return fmt.Errorf("negative last used height of feegrant usage from granter %q to grantee %q", u.Granter, u.Grantee)
+		}
+	}
 	return nil
 }
 
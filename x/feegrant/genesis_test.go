@@ -44,3 +44,18 @@ func TestDuplicateGrantsInGenesis(t *testing.T) {
 	err = ValidateGenesis(*genesisState)
 	assert.ErrorContains(t, err, "duplicate feegrant found")
 }
+
+func TestGrantUsagesInGenesis(t *testing.T) {
+	granter := sdk.AccAddress("granter_address____").String()
+	grantee := sdk.AccAddress("grantee_address____").String()
+
+	any, err := codectypes.NewAnyWithValue(&BasicAllowance{})
+	assert.NilError(t, err)
+
+	grants := []Grant{{Granter: granter, Grantee: grantee, Allowance: any}}
+	usage := GrantUsage{Granter: granter, Grantee: grantee, Spent: sdk.NewCoins(sdk.NewCoin("foo", math.NewInt(1))), UseCount: 1}
+
+	assert.NilError(t, ValidateGenesis(GenesisState{Allowances: grants, Usages: []GrantUsage{usage}}))
+	assert.ErrorContains(t, ValidateGenesis(GenesisState{Usages: []GrantUsage{usage}}), "without feegrant")
+	assert.ErrorContains(t, ValidateGenesis(GenesisState{Allowances: grants, Usages: []GrantUsage{usage, usage}}), "duplicate feegrant usage")
+}
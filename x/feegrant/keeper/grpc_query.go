@@ -118,3 +118,67 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// AllowanceUsage returns the usage of the allowance granted to the grantee by the granter.
+func (q Keeper) AllowanceUsage(c context.Context, req *feegrant.QueryAllowanceUsageRequest) (*feegrant.QueryAllowanceUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	granteeAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	has, err := q.FeeAllowance.Has(c, collections.Join[sdk.AccAddress, sdk.AccAddress](granteeAddr, granterAddr))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s", err.Error())
+	}
+	if !has {
+		return nil, status.Errorf(codes.NotFound, "fee-grant not found for granter %s and grantee %s", req.Granter, req.Grantee)
+	}
+
+	usage, err := q.GetAllowanceUsage(c, granterAddr, granteeAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s", err.Error())
+	}
+
+	return &feegrant.QueryAllowanceUsageResponse{Usage: &usage}, nil
+}
+
+// AllowanceUsagesByGranter queries the usages of all the allowances granted by the given granter
+func (q Keeper) AllowanceUsagesByGranter(c context.Context, req *feegrant.QueryAllowanceUsagesByGranterRequest) (*feegrant.QueryAllowanceUsagesByGranterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []*feegrant.GrantUsage
+	_, pageRes, err := query.CollectionFilteredPaginate(c, q.FeeAllowanceUsage, req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], usage feegrant.GrantUsage) (include bool, err error) {
+			if !sdk.AccAddress(granterAddr).Equals(key.K2()) {
+				return false, nil
+			}
+
+			usages = append(usages, &usage)
+			return true, nil
+		},
+		func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], usage feegrant.GrantUsage) (*feegrant.GrantUsage, error) {
+			return &usage, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryAllowanceUsagesByGranterResponse{Usages: usages, Pagination: pageRes}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"cosmossdk.io/collections"
@@ -30,6 +31,7 @@ type Keeper struct {
 	Schema            collections.Schema
 	FeeAllowance      collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.Grant]
 	FeeAllowanceQueue collections.Map[collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], bool]
+	FeeAllowanceUsage collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.GrantUsage]
 }
 
 var _ ante.FeegrantKeeper = &Keeper{}
@@ -56,6 +58,13 @@ func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, ak feeg
 			collections.TripleKeyCodec(sdk.TimeKey, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), // nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			collections.BoolValue,
 		),
+		FeeAllowanceUsage: collections.NewMap(
+			sb,
+			feegrant.FeeAllowanceUsageKeyPrefix,
+			"allowances_usage",
+			collections.PairKeyCodec(sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), // nolint: staticcheck // sdk.LengthPrefixedAddressKey is used to match the allowances keys
+			codec.CollValue[feegrant.GrantUsage](cdc),
+		),
 	}
 }
 
@@ -167,6 +176,10 @@ func (k Keeper) revokeAllowance(ctx context.Context, granter, grantee sdk.AccAdd
 		return err
 	}
 
+	if err := k.FeeAllowanceUsage.Remove(ctx, collections.Join(grantee, granter)); err != nil {
+		return err
+	}
+
 	exp, err := grant.ExpiresAt()
 	if err != nil {
 		return err
@@ -239,13 +252,19 @@ func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddr
 	remove, err := grant.Accept(ctx, fee, msgs)
 
 	if remove {
+		// the usage is removed along with the grant, it is only reported in the event
+		usage, usageErr := k.accumulateUsage(ctx, granter, grantee, fee)
+
 		// Ignoring the `revokeFeeAllowance` error, because the user has enough grants to perform this transaction.
 		_ = k.revokeAllowance(ctx, granter, grantee)
 		if err != nil {
 			return err
 		}
+		if usageErr != nil {
+			return usageErr
+		}
 
-		emitUseGrantEvent(ctx, granter.String(), grantee.String())
+		emitUseGrantEvent(ctx, usage, fee)
 
 		return nil
 	}
@@ -254,18 +273,65 @@ func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddr
 		return err
 	}
 
-	emitUseGrantEvent(ctx, granter.String(), grantee.String())
+	usage, err := k.accumulateUsage(ctx, granter, grantee, fee)
+	if err != nil {
+		return err
+	}
+
+	if err := k.FeeAllowanceUsage.Set(ctx, collections.Join(grantee, granter), usage); err != nil {
+		return err
+	}
+
+	emitUseGrantEvent(ctx, usage, fee)
 
 	// if fee allowance is accepted, store the updated state of the allowance
 	return k.UpdateAllowance(ctx, granter, grantee, grant)
 }
 
-func emitUseGrantEvent(ctx context.Context, granter, grantee string) {
+// GetAllowanceUsage returns the usage of the allowance between the granter and
+// grantee, which is empty if the allowance was not used yet.
+func (k Keeper) GetAllowanceUsage(ctx context.Context, granter, grantee sdk.AccAddress) (feegrant.GrantUsage, error) {
+	usage, err := k.FeeAllowanceUsage.Get(ctx, collections.Join(grantee, granter))
+	if err == nil || !errors.Is(err, collections.ErrNotFound) {
+		return usage, err
+	}
+
+	granterStr, err := k.authKeeper.AddressCodec().BytesToString(granter)
+	if err != nil {
+		return usage, err
+	}
+
+	granteeStr, err := k.authKeeper.AddressCodec().BytesToString(grantee)
+	if err != nil {
+		return usage, err
+	}
+
+	return feegrant.GrantUsage{Granter: granterStr, Grantee: granteeStr}, nil
+}
+
+// accumulateUsage returns the usage of the allowance updated with the given fee.
+func (k Keeper) accumulateUsage(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) (feegrant.GrantUsage, error) {
+	usage, err := k.GetAllowanceUsage(ctx, granter, grantee)
+	if err != nil {
+		return usage, err
+	}
+
+	usage.Spent = usage.Spent.Add(fee...)
+	usage.LastUsedHeight = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	usage.UseCount++
+
+	return usage, nil
+}
+
+func emitUseGrantEvent(ctx context.Context, usage feegrant.GrantUsage, fee sdk.Coins) {
 	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
 		sdk.NewEvent(
 			feegrant.EventTypeUseFeeGrant,
-			sdk.NewAttribute(feegrant.AttributeKeyGranter, granter),
-			sdk.NewAttribute(feegrant.AttributeKeyGrantee, grantee),
+			sdk.NewAttribute(feegrant.AttributeKeyGranter, usage.Granter),
+			sdk.NewAttribute(feegrant.AttributeKeyGrantee, usage.Grantee),
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(feegrant.AttributeKeySpent, usage.Spent.String()),
+			sdk.NewAttribute(feegrant.AttributeKeyUseCount, strconv.FormatUint(usage.UseCount, 10)),
 		),
 	)
 }
@@ -292,6 +358,21 @@ func (k Keeper) InitGenesis(ctx context.Context, data *feegrant.GenesisState) er
 			return err
 		}
 	}
+
+	for _, usage := range data.Usages {
+		granter, err := k.authKeeper.AddressCodec().StringToBytes(usage.Granter)
+		if err != nil {
+			return err
+		}
+		grantee, err := k.authKeeper.AddressCodec().StringToBytes(usage.Grantee)
+		if err != nil {
+			return err
+		}
+
+		if err := k.FeeAllowanceUsage.Set(ctx, collections.Join[sdk.AccAddress, sdk.AccAddress](grantee, granter), usage); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -304,8 +385,19 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*feegrant.GenesisState, erro
 		return false
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []feegrant.GrantUsage
+	err = k.FeeAllowanceUsage.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], usage feegrant.GrantUsage) (stop bool, err error) {
+		usages = append(usages, usage)
+		return false, nil
+	})
+
 	return &feegrant.GenesisState{
 		Allowances: grants,
+		Usages:     usages,
 	}, err
 }
 
@@ -323,6 +415,10 @@ func (k Keeper) RemoveExpiredAllowances(ctx context.Context, limit int32) error
 			return true, err
 		}
 
+		if err := k.FeeAllowanceUsage.Remove(ctx, collections.Join(grantee, granter)); err != nil {
+			return true, err
+		}
+
 		keysToRemove = append(keysToRemove, key)
 
 		count++
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
@@ -421,3 +422,61 @@ func (suite *KeeperTestSuite) TestPruneGrants() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGrantUsage() {
+	granter, grantee := suite.addrs[0], suite.addrs[3]
+	allowance := &feegrant.BasicAllowance{SpendLimit: suite.atom}
+	suite.Require().NoError(suite.feegrantKeeper.GrantAllowance(suite.ctx, granter, grantee, allowance))
+
+	// a grant which was not used has an empty usage
+	res, err := suite.feegrantKeeper.AllowanceUsage(suite.ctx, &feegrant.QueryAllowanceUsageRequest{Granter: granter.String(), Grantee: grantee.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), res.Usage.UseCount)
+	suite.Require().True(res.Usage.Spent.IsZero())
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 5))
+	ctx := suite.ctx.WithBlockHeight(7).WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, granter, grantee, fee, []sdk.Msg{}))
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx.WithBlockHeight(9), granter, grantee, fee, []sdk.Msg{}))
+
+	// a rejected fee is not recorded
+	suite.Require().Error(suite.feegrantKeeper.UseGrantedFees(ctx, granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)), []sdk.Msg{}))
+
+	res, err = suite.feegrantKeeper.AllowanceUsage(suite.ctx, &feegrant.QueryAllowanceUsageRequest{Granter: granter.String(), Grantee: grantee.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(feegrant.GrantUsage{
+		Granter:        granter.String(),
+		Grantee:        grantee.String(),
+		Spent:          sdk.NewCoins(sdk.NewInt64Coin("atom", 10)),
+		LastUsedHeight: 9,
+		UseCount:       2,
+	}, *res.Usage)
+
+	var last sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == feegrant.EventTypeUseFeeGrant {
+			last = event
+		}
+	}
+	spent, ok := last.GetAttribute(feegrant.AttributeKeySpent)
+	suite.Require().True(ok)
+	suite.Require().Equal("10atom", spent.Value)
+
+	byGranter, err := suite.feegrantKeeper.AllowanceUsagesByGranter(suite.ctx, &feegrant.QueryAllowanceUsagesByGranterRequest{Granter: granter.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(byGranter.Usages, 1)
+
+	// the usage is exported and removed with the grant
+	genesis, err := suite.feegrantKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(genesis.Usages, 1)
+	suite.Require().NoError(feegrant.ValidateGenesis(*genesis))
+
+	_, err = suite.msgSrvr.RevokeAllowance(suite.ctx, &feegrant.MsgRevokeAllowance{Granter: granter.String(), Grantee: grantee.String()})
+	suite.Require().NoError(err)
+	_, err = suite.feegrantKeeper.AllowanceUsage(suite.ctx, &feegrant.QueryAllowanceUsageRequest{Granter: granter.String(), Grantee: grantee.String()})
+	suite.Require().ErrorContains(err, "not found")
+	has, err := suite.feegrantKeeper.FeeAllowanceUsage.Has(suite.ctx, collections.Join(grantee, granter))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
@@ -26,4 +26,8 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = collections.NewPrefix(1)
+
+	// FeeAllowanceUsageKeyPrefix is the set of the kvstore for fee allowance usage data
+	// - 0x02<allowance_key_bytes>: usage
+	FeeAllowanceUsageKeyPrefix = collections.NewPrefix(2)
 )
@@ -349,6 +349,216 @@ func (m *QueryAllowancesByGranterResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAllowanceUsageRequest is the request type for the Query/AllowanceUsage RPC method.
+type QueryAllowanceUsageRequest struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address of the user being granted an allowance of another user's funds.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+}
+
+func (m *QueryAllowanceUsageRequest) Reset()         { *m = QueryAllowanceUsageRequest{} }
+func (m *QueryAllowanceUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowanceUsageRequest) ProtoMessage()    {}
+func (*QueryAllowanceUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{6}
+}
+func (m *QueryAllowanceUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowanceUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowanceUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowanceUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowanceUsageRequest.Merge(m, src)
+}
+func (m *QueryAllowanceUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowanceUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowanceUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowanceUsageRequest proto.InternalMessageInfo
+
+func (m *QueryAllowanceUsageRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *QueryAllowanceUsageRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+// QueryAllowanceUsageResponse is the response type for the Query/AllowanceUsage RPC method.
+type QueryAllowanceUsageResponse struct {
+	// usage is the usage of the allowance granted for grantee by granter.
+	Usage *GrantUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (m *QueryAllowanceUsageResponse) Reset()         { *m = QueryAllowanceUsageResponse{} }
+func (m *QueryAllowanceUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowanceUsageResponse) ProtoMessage()    {}
+func (*QueryAllowanceUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{7}
+}
+func (m *QueryAllowanceUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowanceUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowanceUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowanceUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowanceUsageResponse.Merge(m, src)
+}
+func (m *QueryAllowanceUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowanceUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowanceUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowanceUsageResponse proto.InternalMessageInfo
+
+func (m *QueryAllowanceUsageResponse) GetUsage() *GrantUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+// QueryAllowanceUsagesByGranterRequest is the request type for the Query/AllowanceUsagesByGranter RPC method.
+type QueryAllowanceUsagesByGranterRequest struct {
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// pagination defines a pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllowanceUsagesByGranterRequest) Reset()         { *m = QueryAllowanceUsagesByGranterRequest{} }
+func (m *QueryAllowanceUsagesByGranterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowanceUsagesByGranterRequest) ProtoMessage()    {}
+func (*QueryAllowanceUsagesByGranterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{8}
+}
+func (m *QueryAllowanceUsagesByGranterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowanceUsagesByGranterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowanceUsagesByGranterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowanceUsagesByGranterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowanceUsagesByGranterRequest.Merge(m, src)
+}
+func (m *QueryAllowanceUsagesByGranterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowanceUsagesByGranterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowanceUsagesByGranterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowanceUsagesByGranterRequest proto.InternalMessageInfo
+
+func (m *QueryAllowanceUsagesByGranterRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *QueryAllowanceUsagesByGranterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllowanceUsagesByGranterResponse is the response type for the Query/AllowanceUsagesByGranter RPC method.
+type QueryAllowanceUsagesByGranterResponse struct {
+	// usages of the allowances that have been issued by the granter.
+	Usages []*GrantUsage `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages,omitempty"`
+	// pagination defines a pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllowanceUsagesByGranterResponse) Reset()         { *m = QueryAllowanceUsagesByGranterResponse{} }
+func (m *QueryAllowanceUsagesByGranterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowanceUsagesByGranterResponse) ProtoMessage()    {}
+func (*QueryAllowanceUsagesByGranterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{9}
+}
+func (m *QueryAllowanceUsagesByGranterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowanceUsagesByGranterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowanceUsagesByGranterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowanceUsagesByGranterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowanceUsagesByGranterResponse.Merge(m, src)
+}
+func (m *QueryAllowanceUsagesByGranterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowanceUsagesByGranterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowanceUsagesByGranterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowanceUsagesByGranterResponse proto.InternalMessageInfo
+
+func (m *QueryAllowanceUsagesByGranterResponse) GetUsages() []*GrantUsage {
+	if m != nil {
+		return m.Usages
+	}
+	return nil
+}
+
+func (m *QueryAllowanceUsagesByGranterResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAllowanceRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceRequest")
 	proto.RegisterType((*QueryAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceResponse")
@@ -356,6 +566,10 @@ func init() {
 	proto.RegisterType((*QueryAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesResponse")
 	proto.RegisterType((*QueryAllowancesByGranterRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterRequest")
 	proto.RegisterType((*QueryAllowancesByGranterResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterResponse")
+	proto.RegisterType((*QueryAllowanceUsageRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceUsageRequest")
+	proto.RegisterType((*QueryAllowanceUsageResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceUsageResponse")
+	proto.RegisterType((*QueryAllowanceUsagesByGranterRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceUsagesByGranterRequest")
+	proto.RegisterType((*QueryAllowanceUsagesByGranterResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceUsagesByGranterResponse")
 }
 
 func init() {
@@ -363,42 +577,51 @@ func init() {
 }
 
 var fileDescriptor_59efc303945de53f = []byte{
-	// 548 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x4f, 0x6b, 0x13, 0x41,
-	0x18, 0xc6, 0x33, 0xf5, 0x1f, 0x99, 0x1e, 0x84, 0xa9, 0xda, 0xb8, 0xc8, 0x1a, 0x56, 0x68, 0xfd,
-	0x43, 0x66, 0xda, 0xa8, 0xa5, 0x8a, 0x14, 0x12, 0xc4, 0x5c, 0x35, 0x82, 0x07, 0x2f, 0x32, 0x49,
-	0x5e, 0xd7, 0xc5, 0x74, 0x27, 0xdd, 0xd9, 0xa8, 0x45, 0x8a, 0xe0, 0x27, 0x10, 0xf4, 0x13, 0x78,
-	0xf0, 0xe4, 0xa9, 0xf4, 0x03, 0x78, 0x14, 0x4f, 0xa1, 0x5e, 0x3c, 0x4a, 0xe2, 0x07, 0x91, 0xcc,
-	0xce, 0xec, 0xc6, 0x64, 0x97, 0x2c, 0xea, 0xa1, 0xb7, 0x4c, 0xe6, 0x79, 0xde, 0xf9, 0x3d, 0xef,
-	0x3b, 0x93, 0xe0, 0x4b, 0x6d, 0x21, 0xb7, 0x85, 0x64, 0x4f, 0x01, 0xdc, 0x80, 0xfb, 0x21, 0x7b,
-	0xb1, 0xde, 0x82, 0x90, 0xaf, 0xb3, 0x9d, 0x3e, 0x04, 0xbb, 0xb4, 0x17, 0x88, 0x50, 0x90, 0xe5,
-	0x48, 0x44, 0x8d, 0x88, 0x6a, 0x91, 0xb5, 0x92, 0xe5, 0x8e, 0x95, 0xaa, 0x80, 0x75, 0x55, 0xeb,
-	0x5a, 0x5c, 0x42, 0x54, 0x39, 0x56, 0xf6, 0xb8, 0xeb, 0xf9, 0x3c, 0xf4, 0x84, 0xaf, 0xb5, 0x17,
-	0x5c, 0x21, 0xdc, 0x2e, 0x30, 0xde, 0xf3, 0x18, 0xf7, 0x7d, 0x11, 0xaa, 0x4d, 0xa9, 0x77, 0xcf,
-	0x47, 0x95, 0x9e, 0xa8, 0x15, 0xd3, 0x5c, 0x6a, 0xe1, 0xbc, 0xc1, 0x67, 0x1f, 0x8c, 0x4b, 0xd7,
-	0xba, 0x5d, 0xf1, 0x92, 0xfb, 0x6d, 0x68, 0xc2, 0x4e, 0x1f, 0x64, 0x48, 0xaa, 0xf8, 0x94, 0x82,
-	0x81, 0xa0, 0x84, 0xca, 0xe8, 0x72, 0xb1, 0x5e, 0x3a, 0x3c, 0xa8, 0x9c, 0xd1, 0xde, 0x5a, 0xa7,
-	0x13, 0x80, 0x94, 0x0f, 0xc3, 0xc0, 0xf3, 0xdd, 0xa6, 0x11, 0x26, 0x1e, 0x28, 0x2d, 0xe4, 0xf3,
-	0x80, 0xf3, 0x08, 0x9f, 0x9b, 0x06, 0x90, 0x3d, 0xe1, 0x4b, 0x20, 0x77, 0x70, 0x91, 0x9b, 0x2f,
-	0x15, 0xc3, 0x62, 0xd5, 0xa6, 0x19, 0x4d, 0xa5, 0x8d, 0xf1, 0xaa, 0x99, 0x18, 0x9c, 0x0f, 0x68,
-	0xba, 0xb0, 0x9c, 0x89, 0x06, 0x79, 0xa3, 0x01, 0xb9, 0x87, 0x71, 0xd2, 0x74, 0x95, 0x6e, 0xb1,
-	0xba, 0x62, 0x68, 0xc6, 0x13, 0xa2, 0xd1, 0xec, 0x0d, 0xcf, 0x7d, 0xee, 0x9a, 0x56, 0x36, 0x27,
-	0x9c, 0xce, 0x47, 0x84, 0x97, 0x67, 0xb0, 0x74, 0xe0, 0x2d, 0x8c, 0x63, 0x7e, 0x59, 0x42, 0xe5,
-	0x63, 0x39, 0x12, 0x4f, 0x38, 0x48, 0x23, 0x85, 0x71, 0x75, 0x2e, 0x63, 0x74, 0xf8, 0x1f, 0x90,
-	0xfb, 0x08, 0x5f, 0x9c, 0x82, 0xac, 0xef, 0x36, 0xa2, 0x21, 0xff, 0xcb, 0xfd, 0xf8, 0x4f, 0x4d,
-	0xbc, 0xbd, 0x74, 0x78, 0x50, 0x39, 0x1d, 0xd9, 0x2a, 0xb2, 0xf3, 0xbc, 0xbc, 0x46, 0x6f, 0x6c,
-	0x38, 0x5f, 0x10, 0x2e, 0x67, 0x43, 0x1f, 0xb1, 0x16, 0xa7, 0x46, 0xa8, 0xee, 0x1f, 0xc7, 0x27,
-	0x54, 0x04, 0xf2, 0x19, 0xe1, 0x62, 0x9c, 0x83, 0xd0, 0x4c, 0xc2, 0xd4, 0xb7, 0x6b, 0xb1, 0xdc,
-	0xfa, 0x88, 0xcc, 0xd9, 0x7a, 0xfb, 0xfd, 0xd7, 0xfb, 0x85, 0x4d, 0xb2, 0xc1, 0xb2, 0x7e, 0x9b,
-	0xe2, 0x1e, 0xb0, 0xd7, 0x7a, 0x9a, 0x7b, 0xe6, 0x13, 0xec, 0x91, 0x4f, 0x08, 0xe3, 0xa4, 0xed,
-	0x24, 0xef, 0xf9, 0xe6, 0x45, 0x5a, 0x6b, 0xf9, 0x0d, 0x9a, 0xf8, 0xa6, 0x22, 0x66, 0xa4, 0x32,
-	0x9f, 0x58, 0x4e, 0x80, 0x0e, 0x10, 0x5e, 0x4a, 0xb9, 0x1f, 0x64, 0x33, 0x2f, 0xc0, 0xf4, 0x3b,
-	0xb0, 0x6e, 0xfd, 0x85, 0x53, 0x67, 0xb8, 0xfb, 0x6d, 0xf6, 0x0e, 0xa8, 0x58, 0xd7, 0xc8, 0x95,
-	0xcc, 0x58, 0x9e, 0x94, 0x7d, 0xe8, 0x24, 0x53, 0xa8, 0xd7, 0xbe, 0x0e, 0x6d, 0x34, 0x18, 0xda,
-	0xe8, 0xe7, 0xd0, 0x46, 0xef, 0x46, 0x76, 0x61, 0x30, 0xb2, 0x0b, 0x3f, 0x46, 0x76, 0xe1, 0xf1,
-	0xaa, 0xeb, 0x85, 0xcf, 0xfa, 0x2d, 0xda, 0x16, 0xdb, 0xa6, 0x5c, 0x72, 0x1a, 0x7b, 0x15, 0xd7,
-	0x6e, 0x9d, 0x54, 0xff, 0x05, 0xd7, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x6e, 0x7e, 0x91, 0x26,
-	0xd8, 0x06, 0x00, 0x00,
+	// 694 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x4b, 0x6f, 0xd3, 0x40,
+	0x10, 0xc7, 0xbb, 0x45, 0x6d, 0xd5, 0xa9, 0x04, 0xd2, 0x16, 0x68, 0x30, 0xc8, 0x44, 0x2e, 0xb4,
+	0x3c, 0x14, 0xbb, 0x4d, 0x9d, 0xaa, 0xe5, 0x11, 0x29, 0x11, 0x22, 0x27, 0x24, 0x08, 0x82, 0x03,
+	0x17, 0xe4, 0x24, 0x8b, 0xb1, 0x48, 0xec, 0xd4, 0xeb, 0x00, 0x15, 0xaa, 0x90, 0xf8, 0x04, 0x48,
+	0x70, 0xe1, 0xca, 0x81, 0x13, 0x27, 0x54, 0x89, 0x2b, 0xe2, 0x84, 0x72, 0x8a, 0xca, 0x85, 0x1b,
+	0x90, 0xf0, 0x41, 0x50, 0xd6, 0x6b, 0x3b, 0x0f, 0xbb, 0x71, 0xcb, 0x43, 0xbd, 0xc5, 0xf6, 0xfc,
+	0x67, 0x7f, 0xff, 0x99, 0xd9, 0xdd, 0xc0, 0x7c, 0xd9, 0xa2, 0x35, 0x8b, 0x2a, 0x0f, 0x08, 0xd1,
+	0x6d, 0xcd, 0x74, 0x94, 0xc7, 0xcb, 0x25, 0xe2, 0x68, 0xcb, 0xca, 0x46, 0x83, 0xd8, 0x9b, 0x72,
+	0xdd, 0xb6, 0x1c, 0x0b, 0xcf, 0xb9, 0x41, 0xb2, 0x17, 0x24, 0xf3, 0x20, 0x61, 0x21, 0x4a, 0xed,
+	0x47, 0xb2, 0x04, 0xc2, 0x05, 0x1e, 0x57, 0xd2, 0x28, 0x71, 0x33, 0xfb, 0x91, 0x75, 0x4d, 0x37,
+	0x4c, 0xcd, 0x31, 0x2c, 0x93, 0xc7, 0x9e, 0xd2, 0x2d, 0x4b, 0xaf, 0x12, 0x45, 0xab, 0x1b, 0x8a,
+	0x66, 0x9a, 0x96, 0xc3, 0x3e, 0x52, 0xfe, 0xf5, 0x84, 0x9b, 0xe9, 0x3e, 0x7b, 0x52, 0x38, 0x17,
+	0x7b, 0x90, 0x9e, 0xc3, 0xb1, 0x5b, 0xdd, 0xd4, 0xb9, 0x6a, 0xd5, 0x7a, 0xa2, 0x99, 0x65, 0x52,
+	0x24, 0x1b, 0x0d, 0x42, 0x1d, 0x9c, 0x86, 0x29, 0x06, 0x43, 0xec, 0x04, 0x4a, 0xa2, 0x73, 0xd3,
+	0xf9, 0xc4, 0xce, 0x76, 0xea, 0x28, 0xd7, 0xe6, 0x2a, 0x15, 0x9b, 0x50, 0x7a, 0xdb, 0xb1, 0x0d,
+	0x53, 0x2f, 0x7a, 0x81, 0x81, 0x86, 0x24, 0xc6, 0xe3, 0x69, 0x88, 0x74, 0x17, 0x8e, 0x0f, 0x02,
+	0xd0, 0xba, 0x65, 0x52, 0x82, 0xaf, 0xc0, 0xb4, 0xe6, 0xbd, 0x64, 0x0c, 0x33, 0x69, 0x51, 0x8e,
+	0x28, 0xaa, 0x5c, 0xe8, 0x3e, 0x15, 0x03, 0x81, 0xf4, 0x1a, 0x0d, 0x26, 0xa6, 0x43, 0xd6, 0x48,
+	0x5c, 0x6b, 0x04, 0x5f, 0x07, 0x08, 0x8a, 0xce, 0xdc, 0xcd, 0xa4, 0x17, 0x3c, 0x9a, 0x6e, 0x87,
+	0x64, 0xb7, 0xf7, 0x1e, 0xcf, 0x4d, 0x4d, 0xf7, 0x4a, 0x59, 0xec, 0x51, 0x4a, 0x6f, 0x11, 0xcc,
+	0x0d, 0x61, 0x71, 0xc3, 0x59, 0x00, 0x9f, 0x9f, 0x26, 0x50, 0xf2, 0x50, 0x0c, 0xc7, 0x3d, 0x0a,
+	0x5c, 0x08, 0x61, 0x5c, 0x1c, 0xc9, 0xe8, 0x2e, 0xde, 0x07, 0xf9, 0x01, 0xc1, 0xe9, 0x01, 0xc8,
+	0xfc, 0x66, 0xc1, 0x6d, 0xf2, 0x9f, 0xcc, 0xc7, 0x5f, 0x2a, 0xe2, 0xa5, 0xd9, 0x9d, 0xed, 0xd4,
+	0x11, 0x57, 0x96, 0xa2, 0x95, 0x47, 0xc9, 0x25, 0x59, 0x5d, 0x95, 0x3e, 0x21, 0x48, 0x46, 0x43,
+	0x1f, 0xb0, 0x12, 0x87, 0x5b, 0x78, 0x83, 0x40, 0xe8, 0xb7, 0x70, 0x87, 0x06, 0x25, 0xf8, 0x5f,
+	0x5b, 0x32, 0x84, 0x2d, 0xa3, 0x4a, 0x35, 0x38, 0x19, 0x8a, 0xc6, 0x0b, 0xbb, 0x0e, 0x13, 0x8d,
+	0xee, 0x0b, 0xbe, 0x51, 0xe7, 0x77, 0xaf, 0xa9, 0xab, 0x75, 0x15, 0xe1, 0xcb, 0x7d, 0x44, 0x70,
+	0x26, 0x64, 0xbd, 0x83, 0x3e, 0x87, 0x19, 0x55, 0xfa, 0x8c, 0xe0, 0xec, 0x08, 0x72, 0x5e, 0xb3,
+	0xcb, 0x30, 0xc9, 0x2a, 0xe0, 0x0d, 0x62, 0xac, 0xa2, 0x71, 0xc9, 0xbf, 0x9c, 0xc4, 0x8c, 0x9a,
+	0xfe, 0x39, 0x05, 0x13, 0xcc, 0x04, 0x7e, 0x8f, 0x60, 0xda, 0x77, 0x82, 0xe5, 0x48, 0xc4, 0xd0,
+	0x5b, 0x44, 0x50, 0x62, 0xc7, 0xbb, 0x64, 0x52, 0xf6, 0xc5, 0xd7, 0x5f, 0xaf, 0xc6, 0xd7, 0xf0,
+	0xaa, 0x12, 0x75, 0x4b, 0xfa, 0xbb, 0x51, 0x79, 0xc6, 0xfb, 0xb9, 0xe5, 0xfd, 0x22, 0x5b, 0xf8,
+	0x1d, 0x02, 0x08, 0x0e, 0x00, 0x1c, 0x77, 0x7d, 0xef, 0x6e, 0x10, 0x96, 0xe2, 0x0b, 0x38, 0x71,
+	0x86, 0x11, 0x2b, 0x38, 0x35, 0x9a, 0x98, 0xf6, 0x80, 0xb6, 0x10, 0xcc, 0x86, 0x9c, 0x54, 0x78,
+	0x2d, 0x2e, 0xc0, 0xe0, 0x4e, 0x10, 0xd6, 0xf7, 0xa1, 0xe4, 0x1e, 0xae, 0x35, 0x87, 0x4f, 0x23,
+	0x66, 0xeb, 0x22, 0x3e, 0x1f, 0x69, 0xcb, 0xa0, 0xb4, 0x41, 0x2a, 0x41, 0x17, 0x70, 0x13, 0xc1,
+	0xe1, 0xfe, 0xa1, 0xc7, 0x2b, 0x31, 0x99, 0x7a, 0xcf, 0x39, 0x41, 0xdd, 0x9b, 0x88, 0x7b, 0xb8,
+	0xd1, 0x1c, 0x9e, 0x63, 0xe6, 0x41, 0xc5, 0xe9, 0x48, 0x0f, 0x6c, 0x33, 0x85, 0x0e, 0xd2, 0x77,
+	0x04, 0x89, 0xa8, 0x1d, 0x8c, 0xaf, 0xee, 0x85, 0x70, 0xb8, 0x53, 0xd9, 0xfd, 0xca, 0x23, 0xdb,
+	0xc5, 0xad, 0xee, 0xd6, 0x2e, 0xf7, 0xdc, 0x08, 0xbc, 0xe6, 0x73, 0x5f, 0xda, 0x22, 0x6a, 0xb5,
+	0x45, 0xf4, 0xa3, 0x2d, 0xa2, 0x97, 0x1d, 0x71, 0xac, 0xd5, 0x11, 0xc7, 0xbe, 0x75, 0xc4, 0xb1,
+	0x7b, 0x8b, 0xba, 0xe1, 0x3c, 0x6c, 0x94, 0xe4, 0xb2, 0x55, 0xf3, 0xd2, 0x05, 0xab, 0x29, 0x4f,
+	0xfd, 0xdc, 0xa5, 0x49, 0xf6, 0x27, 0x72, 0xe5, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xcb,
+	0x3d, 0x12, 0x11, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -419,6 +642,10 @@ type QueryClient interface {
 	Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// AllowanceUsage returns the usage of the allowance granted to the grantee by the granter.
+	AllowanceUsage(ctx context.Context, in *QueryAllowanceUsageRequest, opts ...grpc.CallOption) (*QueryAllowanceUsageResponse, error)
+	// AllowanceUsagesByGranter returns the usages of all the allowances given by an address.
+	AllowanceUsagesByGranter(ctx context.Context, in *QueryAllowanceUsagesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowanceUsagesByGranterResponse, error)
 }
 
 type queryClient struct {
@@ -456,6 +683,24 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) AllowanceUsage(ctx context.Context, in *QueryAllowanceUsageRequest, opts ...grpc.CallOption) (*QueryAllowanceUsageResponse, error) {
+	out := new(QueryAllowanceUsageResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/AllowanceUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowanceUsagesByGranter(ctx context.Context, in *QueryAllowanceUsagesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowanceUsagesByGranterResponse, error) {
+	out := new(QueryAllowanceUsagesByGranterResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/AllowanceUsagesByGranter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Allowance returns granted allowance to the grantee by the granter.
@@ -464,6 +709,10 @@ type QueryServer interface {
 	Allowances(context.Context, *QueryAllowancesRequest) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// AllowanceUsage returns the usage of the allowance granted to the grantee by the granter.
+	AllowanceUsage(context.Context, *QueryAllowanceUsageRequest) (*QueryAllowanceUsageResponse, error)
+	// AllowanceUsagesByGranter returns the usages of all the allowances given by an address.
+	AllowanceUsagesByGranter(context.Context, *QueryAllowanceUsagesByGranterRequest) (*QueryAllowanceUsagesByGranterResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -479,6 +728,12 @@ func (*UnimplementedQueryServer) Allowances(ctx context.Context, req *QueryAllow
 func (*UnimplementedQueryServer) AllowancesByGranter(ctx context.Context, req *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (*UnimplementedQueryServer) AllowanceUsage(ctx context.Context, req *QueryAllowanceUsageRequest) (*QueryAllowanceUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowanceUsage not implemented")
+}
+func (*UnimplementedQueryServer) AllowanceUsagesByGranter(ctx context.Context, req *QueryAllowanceUsagesByGranterRequest) (*QueryAllowanceUsagesByGranterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowanceUsagesByGranter not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -538,6 +793,42 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AllowanceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowanceUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowanceUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/AllowanceUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowanceUsage(ctx, req.(*QueryAllowanceUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowanceUsagesByGranter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowanceUsagesByGranterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowanceUsagesByGranter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/AllowanceUsagesByGranter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowanceUsagesByGranter(ctx, req.(*QueryAllowanceUsagesByGranterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Query",
@@ -555,6 +846,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "AllowanceUsage",
+			Handler:    _Query_AllowanceUsage_Handler,
+		},
+		{
+			MethodName: "AllowanceUsagesByGranter",
+			Handler:    _Query_AllowanceUsagesByGranter_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
@@ -814,49 +1113,212 @@ func (m *QueryAllowancesByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAllowanceUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAllowanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryAllowanceUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowanceUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllowanceUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowanceUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowanceUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Usage != nil {
+		{
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllowancesRequest) Size() (n int) {
-	if m == nil {
+func (m *QueryAllowanceUsagesByGranterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowanceUsagesByGranterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowanceUsagesByGranterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowanceUsagesByGranterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowanceUsagesByGranterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowanceUsagesByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Usages) > 0 {
+		for iNdEx := len(m.Usages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowancesRequest) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -920,20 +1382,404 @@ func (m *QueryAllowancesByGranterResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowanceUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowanceUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowanceUsagesByGranterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowanceUsagesByGranterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Usages) > 0 {
+		for _, e := range m.Usages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &Grant{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -956,17 +1802,17 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -976,29 +1822,31 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Allowances = append(m.Allowances, &Grant{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1008,23 +1856,27 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1047,7 +1899,7 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1070,15 +1922,47 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1105,10 +1989,10 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Allowance == nil {
-				m.Allowance = &Grant{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1133,7 +2017,7 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1156,17 +2040,17 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1176,23 +2060,25 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.Allowances = append(m.Allowances, &Grant{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1224,7 +2110,7 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1251,7 +2137,7 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowanceUsageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1274,17 +2160,17 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowanceUsageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowanceUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1294,29 +2180,109 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowances = append(m.Allowances, &Grant{})
-			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowanceUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1343,10 +2309,10 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Usage == nil {
+				m.Usage = &GrantUsage{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1371,7 +2337,7 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowanceUsagesByGranterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1394,10 +2360,10 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowanceUsagesByGranterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowanceUsagesByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1489,7 +2455,7 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowanceUsagesByGranterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1512,15 +2478,15 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowanceUsagesByGranterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowanceUsagesByGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1547,8 +2513,8 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowances = append(m.Allowances, &Grant{})
-			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Usages = append(m.Usages, &GrantUsage{})
+			if err := m.Usages[len(m.Usages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
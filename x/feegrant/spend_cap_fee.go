@@ -0,0 +1,169 @@
+package feegrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ FeeAllowanceI                 = (*SpendCapAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*SpendCapAllowance)(nil)
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *SpendCapAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewSpendCapAllowance creates new fee allowance capping the fees spent per message type.
+func NewSpendCapAllowance(allowance FeeAllowanceI, spendCaps []MsgTypeSpendCap) (*SpendCapAllowance, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SpendCapAllowance{
+		Allowance: any,
+		SpendCaps: spendCaps,
+	}, nil
+}
+
+// GetAllowance returns the capped fee allowance.
+func (a *SpendCapAllowance) GetAllowance() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets the capped fee allowance.
+func (a *SpendCapAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	var err error
+	a.Allowance, err = types.NewAnyWithValue(allowance.(proto.Message))
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	return nil
+}
+
+// Accept checks that all the messages have a spend cap which covers the fee,
+// deducts the fee from the spend cap of each message type and passes the fee
+// to the capped allowance. A used up spend cap is dropped, and the allowance
+// is removed once all its spend caps are used up.
+func (a *SpendCapAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	capIndexes := make(map[string]int, len(a.SpendCaps))
+	for i, spendCap := range a.SpendCaps {
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+		capIndexes[spendCap.MsgTypeUrl] = i
+	}
+
+	// the fee counts once against the spend cap of each message type
+	spendCaps := make([]sdk.Coins, len(a.SpendCaps))
+	for _, msg := range msgs {
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+		typeURL := sdk.MsgTypeURL(msg)
+		i, ok := capIndexes[typeURL]
+		if !ok {
+			return false, errorsmod.Wrapf(ErrMessageNotAllowed, "message %s has no spend cap", typeURL)
+		}
+		if spendCaps[i] != nil {
+			continue
+		}
+
+		left, invalid := a.SpendCaps[i].SpendCap.SafeSub(fee...)
+		if invalid {
+			return false, errorsmod.Wrapf(ErrFeeLimitExceeded, "spend cap of %s", typeURL)
+		}
+		spendCaps[i] = left
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err != nil || remove {
+		return remove, err
+	}
+
+	// the used up spend caps are dropped, so that their message types are no
+	// longer allowed
+	left := make([]MsgTypeSpendCap, 0, len(a.SpendCaps))
+	for i, spendCap := range a.SpendCaps {
+		if spendCaps[i] != nil {
+			spendCap.SpendCap = spendCaps[i]
+		}
+		if !spendCap.SpendCap.IsZero() {
+			left = append(left, spendCap)
+		}
+	}
+	if len(left) == 0 {
+		return true, nil
+	}
+	a.SpendCaps = left
+
+	return false, a.SetAllowance(allowance)
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *SpendCapAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return errorsmod.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.SpendCaps) == 0 {
+		return errorsmod.Wrap(ErrNoMessages, "spend caps shouldn't be empty")
+	}
+
+	seen := make(map[string]struct{}, len(a.SpendCaps))
+	for _, spendCap := range a.SpendCaps {
+		if spendCap.MsgTypeUrl == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "spend cap message type cannot be empty")
+		}
+		if _, ok := seen[spendCap.MsgTypeUrl]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate spend cap for %s", spendCap.MsgTypeUrl)
+		}
+		seen[spendCap.MsgTypeUrl] = struct{}{}
+
+		if !spendCap.SpendCap.IsValid() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "spend cap of %s is invalid: %s", spendCap.MsgTypeUrl, spendCap.SpendCap)
+		}
+		if !spendCap.SpendCap.IsAllPositive() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "spend cap of %s must be positive", spendCap.MsgTypeUrl)
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// ExpiresAt returns the expiry time of the SpendCapAllowance.
+func (a *SpendCapAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
@@ -0,0 +1,165 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	ocproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestSpendCapAllowance(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeader(ocproto.Header{Time: time.Now()})
+
+	send := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	vote := sdk.MsgTypeURL(&govtypes.MsgVote{})
+	atom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amount)) }
+
+	newAllowance := func(limit sdk.Coins) *feegrant.SpendCapAllowance {
+		allowance, err := feegrant.NewSpendCapAllowance(&feegrant.BasicAllowance{SpendLimit: limit}, []feegrant.MsgTypeSpendCap{
+			{MsgTypeUrl: send, SpendCap: atom(100)},
+			{MsgTypeUrl: vote, SpendCap: atom(10)},
+		})
+		require.NoError(t, err)
+		require.NoError(t, allowance.ValidateBasic())
+		return allowance
+	}
+
+	cases := map[string]struct {
+		limit     sdk.Coins
+		msgs      []sdk.Msg
+		fee       sdk.Coins
+		accept    bool
+		remove    bool
+		sendCap   sdk.Coins
+		voteCap   sdk.Coins
+		remaining sdk.Coins
+	}{
+		"fee within the cap": {
+			msgs:    []sdk.Msg{&banktypes.MsgSend{}},
+			fee:     atom(40),
+			accept:  true,
+			sendCap: atom(60),
+			voteCap: atom(10),
+		},
+		"fee counts once per message type": {
+			msgs:    []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgSend{}, &govtypes.MsgVote{}},
+			fee:     atom(5),
+			accept:  true,
+			sendCap: atom(95),
+			voteCap: atom(5),
+		},
+		"fee above the cap of a message type": {
+			msgs:   []sdk.Msg{&banktypes.MsgSend{}, &govtypes.MsgVote{}},
+			fee:    atom(20),
+			accept: false,
+		},
+		"message without cap": {
+			msgs:   []sdk.Msg{&banktypes.MsgMultiSend{}},
+			fee:    atom(1),
+			accept: false,
+		},
+		"capped allowance applies": {
+			limit:  atom(10),
+			msgs:   []sdk.Msg{&banktypes.MsgSend{}},
+			fee:    atom(20),
+			accept: false,
+		},
+		"capped allowance used up": {
+			limit:  atom(20),
+			msgs:   []sdk.Msg{&banktypes.MsgSend{}},
+			fee:    atom(20),
+			accept: true,
+			remove: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			allowance := newAllowance(tc.limit)
+			remove, err := allowance.Accept(ctx, tc.fee, tc.msgs)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.remove, remove)
+			if remove {
+				return
+			}
+
+			require.Equal(t, tc.sendCap, allowance.SpendCaps[0].SpendCap)
+			require.Equal(t, tc.voteCap, allowance.SpendCaps[1].SpendCap)
+		})
+	}
+
+	// the allowance is removed once all its spend caps are used up
+	allowance := newAllowance(nil)
+	remove, err := allowance.Accept(ctx, atom(100), []sdk.Msg{&banktypes.MsgSend{}})
+	require.NoError(t, err)
+	require.False(t, remove)
+	_, err = allowance.Accept(ctx, atom(1), []sdk.Msg{&banktypes.MsgSend{}})
+	require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+	require.Len(t, allowance.SpendCaps, 1)
+	require.NoError(t, allowance.ValidateBasic())
+	remove, err = allowance.Accept(ctx, atom(10), []sdk.Msg{&govtypes.MsgVote{}})
+	require.NoError(t, err)
+	require.True(t, remove)
+}
+
+func TestSpendCapAllowanceValidateBasic(t *testing.T) {
+	send := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	cases := map[string]struct {
+		spendCaps []feegrant.MsgTypeSpendCap
+		err       string
+	}{
+		"valid": {
+			spendCaps: []feegrant.MsgTypeSpendCap{{MsgTypeUrl: send, SpendCap: sdk.NewCoins(sdk.NewInt64Coin("atom", 1))}},
+		},
+		"no spend caps": {
+			err: "spend caps shouldn't be empty",
+		},
+		"empty message type": {
+			spendCaps: []feegrant.MsgTypeSpendCap{{SpendCap: sdk.NewCoins(sdk.NewInt64Coin("atom", 1))}},
+			err:       "message type cannot be empty",
+		},
+		"duplicate message type": {
+			spendCaps: []feegrant.MsgTypeSpendCap{{MsgTypeUrl: send, SpendCap: sdk.NewCoins(sdk.NewInt64Coin("atom", 1))}, {MsgTypeUrl: send}},
+			err:       "duplicate spend cap",
+		},
+		"invalid spend cap": {
+			spendCaps: []feegrant.MsgTypeSpendCap{{MsgTypeUrl: send, SpendCap: sdk.Coins{sdk.NewInt64Coin("atom", 0)}}},
+			err:       "is invalid",
+		},
+		"empty spend cap": {
+			spendCaps: []feegrant.MsgTypeSpendCap{{MsgTypeUrl: send}},
+			err:       "must be positive",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewSpendCapAllowance(&feegrant.BasicAllowance{}, tc.spendCaps)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
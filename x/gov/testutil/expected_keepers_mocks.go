@@ -222,6 +222,34 @@ func (mr *MockBankKeeperMockRecorder) BurnCoins(ctx, moduleName, amt any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), ctx, moduleName, amt)
 }
 
+// BurnFactoryDenom mocks base method.
+func (m *MockBankKeeper) BurnFactoryDenom(ctx context.Context, admin types0.AccAddress, amount types0.Coin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BurnFactoryDenom", ctx, admin, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BurnFactoryDenom indicates an expected call of BurnFactoryDenom.
+func (mr *MockBankKeeperMockRecorder) BurnFactoryDenom(ctx, admin, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnFactoryDenom", reflect.TypeOf((*MockBankKeeper)(nil).BurnFactoryDenom), ctx, admin, amount)
+}
+
+// ChangeDenomAdmin mocks base method.
+func (m *MockBankKeeper) ChangeDenomAdmin(ctx context.Context, admin types0.AccAddress, denom, newAdmin string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeDenomAdmin", ctx, admin, denom, newAdmin)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeDenomAdmin indicates an expected call of ChangeDenomAdmin.
+func (mr *MockBankKeeperMockRecorder) ChangeDenomAdmin(ctx, admin, denom, newAdmin any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeDenomAdmin", reflect.TypeOf((*MockBankKeeper)(nil).ChangeDenomAdmin), ctx, admin, denom, newAdmin)
+}
+
 // ClearSendRestriction mocks base method.
 func (m *MockBankKeeper) ClearSendRestriction() {
 	m.ctrl.T.Helper()
@@ -234,6 +262,21 @@ func (mr *MockBankKeeperMockRecorder) ClearSendRestriction() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).ClearSendRestriction))
 }
 
+// CreateDenom mocks base method.
+func (m *MockBankKeeper) CreateDenom(ctx context.Context, creator types0.AccAddress, subdenom string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDenom", ctx, creator, subdenom)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDenom indicates an expected call of CreateDenom.
+func (mr *MockBankKeeperMockRecorder) CreateDenom(ctx, creator, subdenom any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDenom", reflect.TypeOf((*MockBankKeeper)(nil).CreateDenom), ctx, creator, subdenom)
+}
+
 // CreditVirtualAccounts mocks base method.
 func (m *MockBankKeeper) CreditVirtualAccounts(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -276,6 +319,18 @@ func (mr *MockBankKeeperMockRecorder) DelegateCoinsFromAccountToModule(ctx, send
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateCoinsFromAccountToModule", reflect.TypeOf((*MockBankKeeper)(nil).DelegateCoinsFromAccountToModule), ctx, senderAddr, recipientModule, amt)
 }
 
+// DeleteDenomMetaData mocks base method.
+func (m *MockBankKeeper) DeleteDenomMetaData(ctx context.Context, denom string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteDenomMetaData", ctx, denom)
+}
+
+// DeleteDenomMetaData indicates an expected call of DeleteDenomMetaData.
+func (mr *MockBankKeeperMockRecorder) DeleteDenomMetaData(ctx, denom any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).DeleteDenomMetaData), ctx, denom)
+}
+
 // DeleteSendEnabled mocks base method.
 func (m *MockBankKeeper) DeleteSendEnabled(ctx context.Context, denoms ...string) {
 	m.ctrl.T.Helper()
@@ -293,6 +348,21 @@ func (mr *MockBankKeeperMockRecorder) DeleteSendEnabled(ctx any, denoms ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).DeleteSendEnabled), varargs...)
 }
 
+// DenomAuthorityMetadata mocks base method.
+func (m *MockBankKeeper) DenomAuthorityMetadata(arg0 context.Context, arg1 *types1.QueryDenomAuthorityMetadataRequest) (*types1.QueryDenomAuthorityMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenomAuthorityMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryDenomAuthorityMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenomAuthorityMetadata indicates an expected call of DenomAuthorityMetadata.
+func (mr *MockBankKeeperMockRecorder) DenomAuthorityMetadata(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomAuthorityMetadata", reflect.TypeOf((*MockBankKeeper)(nil).DenomAuthorityMetadata), arg0, arg1)
+}
+
 // DenomMetadata mocks base method.
 func (m *MockBankKeeper) DenomMetadata(arg0 context.Context, arg1 *types1.QueryDenomMetadataRequest) (*types1.QueryDenomMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -353,6 +423,21 @@ func (mr *MockBankKeeperMockRecorder) DenomOwnersByQuery(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomOwnersByQuery", reflect.TypeOf((*MockBankKeeper)(nil).DenomOwnersByQuery), arg0, arg1)
 }
 
+// DenomsFromCreator mocks base method.
+func (m *MockBankKeeper) DenomsFromCreator(arg0 context.Context, arg1 *types1.QueryDenomsFromCreatorRequest) (*types1.QueryDenomsFromCreatorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenomsFromCreator", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryDenomsFromCreatorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenomsFromCreator indicates an expected call of DenomsFromCreator.
+func (mr *MockBankKeeperMockRecorder) DenomsFromCreator(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomsFromCreator", reflect.TypeOf((*MockBankKeeper)(nil).DenomsFromCreator), arg0, arg1)
+}
+
 // DenomsMetadata mocks base method.
 func (m *MockBankKeeper) DenomsMetadata(arg0 context.Context, arg1 *types1.QueryDenomsMetadataRequest) (*types1.QueryDenomsMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -368,6 +453,21 @@ func (mr *MockBankKeeperMockRecorder) DenomsMetadata(arg0, arg1 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomsMetadata", reflect.TypeOf((*MockBankKeeper)(nil).DenomsMetadata), arg0, arg1)
 }
 
+// DenomsWithoutMetadata mocks base method.
+func (m *MockBankKeeper) DenomsWithoutMetadata(arg0 context.Context, arg1 *types1.QueryDenomsWithoutMetadataRequest) (*types1.QueryDenomsWithoutMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenomsWithoutMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryDenomsWithoutMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenomsWithoutMetadata indicates an expected call of DenomsWithoutMetadata.
+func (mr *MockBankKeeperMockRecorder) DenomsWithoutMetadata(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomsWithoutMetadata", reflect.TypeOf((*MockBankKeeper)(nil).DenomsWithoutMetadata), arg0, arg1)
+}
+
 // ExportGenesis mocks base method.
 func (m *MockBankKeeper) ExportGenesis(arg0 context.Context) *types1.GenesisState {
 	m.ctrl.T.Helper()
@@ -382,6 +482,20 @@ func (mr *MockBankKeeperMockRecorder) ExportGenesis(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportGenesis", reflect.TypeOf((*MockBankKeeper)(nil).ExportGenesis), arg0)
 }
 
+// ForceTransfer mocks base method.
+func (m *MockBankKeeper) ForceTransfer(ctx context.Context, admin, from, to types0.AccAddress, amount types0.Coin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceTransfer", ctx, admin, from, to, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForceTransfer indicates an expected call of ForceTransfer.
+func (mr *MockBankKeeperMockRecorder) ForceTransfer(ctx, admin, from, to, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceTransfer", reflect.TypeOf((*MockBankKeeper)(nil).ForceTransfer), ctx, admin, from, to, amount)
+}
+
 // GetAccountsBalances mocks base method.
 func (m *MockBankKeeper) GetAccountsBalances(ctx context.Context) []types1.Balance {
 	m.ctrl.T.Helper()
@@ -424,6 +538,21 @@ func (mr *MockBankKeeperMockRecorder) GetAllDenomMetaData(ctx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).GetAllDenomMetaData), ctx)
 }
 
+// GetAllFactoryDenoms mocks base method.
+func (m *MockBankKeeper) GetAllFactoryDenoms(ctx context.Context) ([]types1.FactoryDenom, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllFactoryDenoms", ctx)
+	ret0, _ := ret[0].([]types1.FactoryDenom)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllFactoryDenoms indicates an expected call of GetAllFactoryDenoms.
+func (mr *MockBankKeeperMockRecorder) GetAllFactoryDenoms(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFactoryDenoms", reflect.TypeOf((*MockBankKeeper)(nil).GetAllFactoryDenoms), ctx)
+}
+
 // GetAllSendEnabledEntries mocks base method.
 func (m *MockBankKeeper) GetAllSendEnabledEntries(ctx context.Context) []types1.SendEnabled {
 	m.ctrl.T.Helper()
@@ -480,6 +609,21 @@ func (mr *MockBankKeeperMockRecorder) GetBlockedAddresses() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedAddresses", reflect.TypeOf((*MockBankKeeper)(nil).GetBlockedAddresses))
 }
 
+// GetDenomAuthorityMetadata mocks base method.
+func (m *MockBankKeeper) GetDenomAuthorityMetadata(ctx context.Context, denom string) (types1.DenomAuthorityMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDenomAuthorityMetadata", ctx, denom)
+	ret0, _ := ret[0].(types1.DenomAuthorityMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDenomAuthorityMetadata indicates an expected call of GetDenomAuthorityMetadata.
+func (mr *MockBankKeeperMockRecorder) GetDenomAuthorityMetadata(ctx, denom any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomAuthorityMetadata", reflect.TypeOf((*MockBankKeeper)(nil).GetDenomAuthorityMetadata), ctx, denom)
+}
+
 // GetDenomMetaData mocks base method.
 func (m *MockBankKeeper) GetDenomMetaData(ctx context.Context, denom string) (types1.Metadata, bool) {
 	m.ctrl.T.Helper()
@@ -540,6 +684,20 @@ func (mr *MockBankKeeperMockRecorder) GetSendEnabledEntry(ctx, denom any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSendEnabledEntry", reflect.TypeOf((*MockBankKeeper)(nil).GetSendEnabledEntry), ctx, denom)
 }
 
+// GetSendHooks mocks base method.
+func (m *MockBankKeeper) GetSendHooks() []types1.SendHook {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSendHooks")
+	ret0, _ := ret[0].([]types1.SendHook)
+	return ret0
+}
+
+// GetSendHooks indicates an expected call of GetSendHooks.
+func (mr *MockBankKeeperMockRecorder) GetSendHooks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSendHooks", reflect.TypeOf((*MockBankKeeper)(nil).GetSendHooks))
+}
+
 // GetSupply mocks base method.
 func (m *MockBankKeeper) GetSupply(ctx context.Context, denom string) types0.Coin {
 	m.ctrl.T.Helper()
@@ -757,6 +915,20 @@ func (mr *MockBankKeeperMockRecorder) MintCoins(ctx, moduleName, amt any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintCoins", reflect.TypeOf((*MockBankKeeper)(nil).MintCoins), ctx, moduleName, amt)
 }
 
+// MintFactoryDenom mocks base method.
+func (m *MockBankKeeper) MintFactoryDenom(ctx context.Context, admin, recipient types0.AccAddress, amount types0.Coin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MintFactoryDenom", ctx, admin, recipient, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MintFactoryDenom indicates an expected call of MintFactoryDenom.
+func (mr *MockBankKeeperMockRecorder) MintFactoryDenom(ctx, admin, recipient, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintFactoryDenom", reflect.TypeOf((*MockBankKeeper)(nil).MintFactoryDenom), ctx, admin, recipient, amount)
+}
+
 // Params mocks base method.
 func (m *MockBankKeeper) Params(arg0 context.Context, arg1 *types1.QueryParamsRequest) (*types1.QueryParamsResponse, error) {
 	m.ctrl.T.Helper()
@@ -784,6 +956,20 @@ func (mr *MockBankKeeperMockRecorder) PrependSendRestriction(restriction any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrependSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).PrependSendRestriction), restriction)
 }
 
+// RegisterSendHook mocks base method.
+func (m *MockBankKeeper) RegisterSendHook(hook types1.SendHook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterSendHook", hook)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterSendHook indicates an expected call of RegisterSendHook.
+func (mr *MockBankKeeperMockRecorder) RegisterSendHook(hook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSendHook", reflect.TypeOf((*MockBankKeeper)(nil).RegisterSendHook), hook)
+}
+
 // SendCoins mocks base method.
 func (m *MockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr types0.AccAddress, amt types0.Coins) error {
 	m.ctrl.T.Helper()
@@ -935,6 +1121,20 @@ func (mr *MockBankKeeperMockRecorder) SetDenomMetaData(ctx, denomMetaData any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomMetaData), ctx, denomMetaData)
 }
 
+// SetFactoryDenomMetadata mocks base method.
+func (m *MockBankKeeper) SetFactoryDenomMetadata(ctx context.Context, admin types0.AccAddress, metadata types1.Metadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFactoryDenomMetadata", ctx, admin, metadata)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFactoryDenomMetadata indicates an expected call of SetFactoryDenomMetadata.
+func (mr *MockBankKeeperMockRecorder) SetFactoryDenomMetadata(ctx, admin, metadata any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFactoryDenomMetadata", reflect.TypeOf((*MockBankKeeper)(nil).SetFactoryDenomMetadata), ctx, admin, metadata)
+}
+
 // SetParams mocks base method.
 func (m *MockBankKeeper) SetParams(ctx context.Context, params types1.Params) error {
 	m.ctrl.T.Helper()
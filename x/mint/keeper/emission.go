@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// EpochHooks wraps the mint keeper to implement the x/epochs hooks.
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks returns the x/epochs hooks of the mint keeper.
+func (k Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// AfterEpochEnd mints the provision of the emission schedule at the end of its
+// epochs.
+func (h EpochHooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	params, err := h.k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	schedule := params.EmissionSchedule
+	if !schedule.IsEnabled() || schedule.EpochIdentifier != epochIdentifier {
+		return nil
+	}
+
+	return h.k.MintEmission(ctx, params.MintDenom, schedule, epochNumber)
+}
+
+// BeforeEpochStart implements epochstypes.EpochHooks.
+func (h EpochHooks) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
+
+// GetEmissionState returns the progress of the emission schedule.
+func (k Keeper) GetEmissionState(ctx context.Context) (types.EmissionState, error) {
+	state, err := k.EmissionState.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.EmissionState{TotalMinted: math.ZeroInt()}, nil
+	}
+	return state, err
+}
+
+// MintEmission mints the provision of the active segment of the schedule for
+// the ended epoch, within the supply cap, and sends it to the recipients.
+func (k Keeper) MintEmission(ctx context.Context, denom string, schedule types.EmissionSchedule, epochNumber int64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	state, err := k.GetEmissionState(ctx)
+	if err != nil {
+		return err
+	}
+
+	active := schedule.ActiveSegment(epochNumber, sdkCtx.BlockTime())
+	if active == 0 {
+		return nil
+	}
+
+	if active != state.ActiveSegment {
+		state.ActiveSegment = active
+		state.SegmentEpochs = 0
+	}
+
+	amount := schedule.Segments[active-1].Provision(state.SegmentEpochs)
+	if !schedule.SupplyCap.IsNil() && schedule.SupplyCap.IsPositive() {
+		remaining := schedule.SupplyCap.Sub(k.bankKeeper.GetSupply(ctx, denom).Amount)
+		if remaining.IsNegative() {
+			remaining = math.ZeroInt()
+		}
+		amount = math.MinInt(amount, remaining)
+	}
+
+	if err := k.distributeEmission(ctx, schedule, sdk.NewCoin(denom, amount)); err != nil {
+		return err
+	}
+
+	state.SegmentEpochs++
+	if state.TotalMinted.IsNil() {
+		state.TotalMinted = math.ZeroInt()
+	}
+	state.TotalMinted = state.TotalMinted.Add(amount)
+	if err := k.EmissionState.Set(ctx, state); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEmission,
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatInt(epochNumber, 10)),
+			sdk.NewAttribute(types.AttributeKeySegment, strconv.FormatInt(active, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// distributeEmission mints the given coin and sends it to the recipients of
+// the schedule, or to the fee collector if it has none.
+func (k Keeper) distributeEmission(ctx context.Context, schedule types.EmissionSchedule, coin sdk.Coin) error {
+	if !coin.IsPositive() {
+		return nil
+	}
+
+	if err := k.MintCoins(ctx, sdk.NewCoins(coin)); err != nil {
+		return err
+	}
+
+	if len(schedule.Recipients) == 0 {
+		return k.AddCollectedFees(ctx, sdk.NewCoins(coin))
+	}
+
+	if err := k.validateEmissionRecipients(schedule); err != nil {
+		return err
+	}
+
+	for i, share := range schedule.Split(coin.Amount) {
+		if !share.IsPositive() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, schedule.Recipients[i].Module, sdk.NewCoins(sdk.NewCoin(coin.Denom, share))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateEmissionRecipients checks that the recipients of the schedule are
+// module accounts.
+func (k Keeper) validateEmissionRecipients(schedule types.EmissionSchedule) error {
+	for _, recipient := range schedule.Recipients {
+		if k.authKeeper.GetModuleAddress(recipient.Module) == nil {
+			return fmt.Errorf("emission recipient %s is not a module account", recipient.Module)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func (s *IntegrationTestSuite) TestEmissionSchedule() {
+	now := time.Unix(1_000_000, 0).UTC()
+	ctx := s.ctx.WithBlockTime(now)
+
+	params := types.DefaultParams()
+	params.EmissionSchedule = types.EmissionSchedule{
+		EpochIdentifier: "day",
+		Segments: []types.EmissionSegment{
+			// halving every 2 epochs from epoch 2
+			{StartEpoch: 2, EpochProvision: math.NewInt(1000), ReductionPeriod: 2, ReductionFactor: math.LegacyNewDecWithPrec(5, 1)},
+			// constant from epoch 5
+			{StartEpoch: 5, EpochProvision: math.NewInt(300), ReductionFactor: math.LegacyZeroDec()},
+		},
+		SupplyCap: math.NewInt(10_000),
+		Recipients: []types.EmissionRecipient{
+			{Module: authtypes.FeeCollectorName, Weight: math.LegacyNewDecWithPrec(2, 1)},
+			{Module: "community", Weight: math.LegacyNewDecWithPrec(8, 1)},
+		},
+	}
+	s.accountKeeper.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(authtypes.NewModuleAddress(authtypes.FeeCollectorName)).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAddress("community").Return(authtypes.NewModuleAddress("community")).AnyTimes()
+	_, err := s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: s.mintKeeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+
+	hooks := s.mintKeeper.EpochHooks()
+	expectEmission := func(supply int64, feeCollector, community int64) {
+		s.bankKeeper.EXPECT().GetSupply(ctx, sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, supply))
+		if total := feeCollector + community; total > 0 {
+			s.bankKeeper.EXPECT().MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, total))).Return(nil)
+		}
+		if feeCollector > 0 {
+			s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, feeCollector))).Return(nil)
+		}
+		if community > 0 {
+			s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, types.ModuleName, "community", sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, community))).Return(nil)
+		}
+	}
+
+	// the block based minting is disabled
+	s.Require().NoError(s.mintKeeper.MintFn(ctx))
+
+	// other epochs and epochs before the first segment don't mint
+	s.Require().NoError(hooks.AfterEpochEnd(ctx, "week", 5))
+	s.Require().NoError(hooks.AfterEpochEnd(ctx, "day", 1))
+
+	expectEmission(0, 200, 800)
+	s.Require().NoError(hooks.AfterEpochEnd(ctx, "day", 2))
+	expectEmission(1000, 200, 800)
+	s.Require().NoError(hooks.AfterEpochEnd(ctx, "day", 3))
+	expectEmission(2000, 100, 400)
+	s.Require().NoError(hooks.AfterEpochEnd(ctx, "day", 4))
+
+	state, err := s.mintKeeper.GetEmissionState(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.EmissionState{ActiveSegment: 1, SegmentEpochs: 3, TotalMinted: math.NewInt(2500)}, state)
+
+	// the second segment replaces the first one, within the supply cap
+	expectEmission(9800, 40, 160)
+	s.Require().NoError(hooks.AfterEpochEnd(ctx, "day", 5))
+	expectEmission(10_000, 0, 0)
+	s.Require().NoError(hooks.AfterEpochEnd(ctx, "day", 6))
+
+	resp, err := keeper.NewQueryServerImpl(s.mintKeeper).EmissionSchedule(ctx, &types.QueryEmissionScheduleRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params.EmissionSchedule, resp.Schedule)
+	s.Require().Equal(types.EmissionState{ActiveSegment: 2, SegmentEpochs: 2, TotalMinted: math.NewInt(2700)}, resp.State)
+	s.Require().Equal(math.NewInt(300), resp.NextProvision)
+}
+
+func (s *IntegrationTestSuite) TestUpdateParamsUnknownEmissionRecipient() {
+	params := types.DefaultParams()
+	params.EmissionSchedule = types.EmissionSchedule{
+		EpochIdentifier: "day",
+		Segments:        []types.EmissionSegment{{EpochProvision: math.NewInt(1)}},
+		SupplyCap:       math.ZeroInt(),
+		Recipients:      []types.EmissionRecipient{{Module: "unknown", Weight: math.LegacyOneDec()}},
+	}
+	s.accountKeeper.EXPECT().GetModuleAddress("unknown").Return(nil)
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.mintKeeper.GetAuthority(), Params: params})
+	s.Require().ErrorContains(err, "emission recipient unknown is not a module account")
+}
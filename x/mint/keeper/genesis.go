@@ -15,6 +15,14 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, ak types.AccountKeeper, data *
 		panic(err)
 	}
 
+	if err := keeper.validateEmissionRecipients(data.Params.EmissionSchedule); err != nil {
+		panic(err)
+	}
+
+	if err := keeper.EmissionState.Set(ctx, data.EmissionState); err != nil {
+		panic(err)
+	}
+
 	ak.GetModuleAccount(ctx, types.ModuleName)
 }
 
@@ -30,5 +38,12 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	return types.NewGenesisState(minter, params)
+	emissionState, err := keeper.GetEmissionState(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis := types.NewGenesisState(minter, params)
+	genesis.EmissionState = emissionState
+	return genesis
 }
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -45,3 +47,27 @@ func (q queryServer) AnnualProvisions(ctx context.Context, _ *types.QueryAnnualP
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// EmissionSchedule returns the emission schedule of the mint module and its progress.
+func (q queryServer) EmissionSchedule(ctx context.Context, _ *types.QueryEmissionScheduleRequest) (*types.QueryEmissionScheduleResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := q.k.GetEmissionState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nextProvision := math.ZeroInt()
+	if segments := params.EmissionSchedule.Segments; state.ActiveSegment > 0 && state.ActiveSegment <= int64(len(segments)) {
+		nextProvision = segments[state.ActiveSegment-1].Provision(state.SegmentEpochs)
+	}
+
+	return &types.QueryEmissionScheduleResponse{
+		Schedule:      params.EmissionSchedule,
+		State:         state,
+		NextProvision: nextProvision,
+	}, nil
+}
@@ -19,6 +19,7 @@ type Keeper struct {
 	cdc              codec.BinaryCodec
 	storeService     storetypes.KVStoreService
 	stakingKeeper    types.StakingKeeper
+	authKeeper       types.AccountKeeper
 	bankKeeper       types.BankKeeper
 	feeCollectorName string
 
@@ -29,6 +30,8 @@ type Keeper struct {
 	Schema collections.Schema
 	Params collections.Item[types.Params]
 	Minter collections.Item[types.Minter]
+	// EmissionState is the progress of the emission schedule.
+	EmissionState collections.Item[types.EmissionState]
 
 	// mintFn is a function that encompasses all minting logic run in the x/mint begin blocker.
 	mintFn MintFn
@@ -67,11 +70,13 @@ func NewKeeper(
 		cdc:              cdc,
 		storeService:     storeService,
 		stakingKeeper:    sk,
+		authKeeper:       ak,
 		bankKeeper:       bk,
 		feeCollectorName: feeCollectorName,
 		authority:        authority,
 		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		Minter:           collections.NewItem(sb, types.MinterKey, "minter", codec.CollValue[types.Minter](cdc)),
+		EmissionState:    collections.NewItem(sb, types.EmissionStateKey, "emission_state", codec.CollValue[types.EmissionState](cdc)),
 		mintFn:           DefaultMintFn(types.DefaultInflationCalculationFn),
 	}
 
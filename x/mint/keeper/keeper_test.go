@@ -27,6 +27,7 @@ type IntegrationTestSuite struct {
 	mintKeeper    keeper.Keeper
 	ctx           sdk.Context
 	msgServer     types.MsgServer
+	accountKeeper *minttestutil.MockAccountKeeper
 	stakingKeeper *minttestutil.MockStakingKeeper
 	bankKeeper    *minttestutil.MockBankKeeper
 }
@@ -59,6 +60,7 @@ func (s *IntegrationTestSuite) SetupTest() {
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	s.accountKeeper = accountKeeper
 	s.stakingKeeper = stakingKeeper
 	s.bankKeeper = bankKeeper
 
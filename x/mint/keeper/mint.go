@@ -29,6 +29,11 @@ func DefaultMintFn(ic types.InflationCalculationFn) MintFn {
 			return err
 		}
 
+		// the emission schedule mints at the end of its epochs instead
+		if params.EmissionSchedule.IsEnabled() {
+			return nil
+		}
+
 		// recalculate inflation rate
 		totalStakingSupply, err := k.StakingTokenSupply(ctx)
 		if err != nil {
@@ -33,6 +33,10 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 		return nil, err
 	}
 
+	if err := ms.validateEmissionRecipients(msg.Params.EmissionSchedule); err != nil {
+		return nil, err
+	}
+
 	if err := ms.Params.Set(ctx, msg.Params); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,157 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// IsEnabled returns true if the emission schedule has segments, in which case
+// it replaces the inflation based minting.
+func (s EmissionSchedule) IsEnabled() bool {
+	return len(s.Segments) > 0
+}
+
+// ActiveSegment returns the 1-based index of the last segment whose start has
+// been reached at the given epoch number and time, or zero if there is none.
+func (s EmissionSchedule) ActiveSegment(epochNumber int64, blockTime time.Time) int64 {
+	var active int64
+	for i, segment := range s.Segments {
+		if segment.HasStarted(epochNumber, blockTime) {
+			active = int64(i) + 1
+		}
+	}
+	return active
+}
+
+// HasStarted returns true if the segment applies at the given epoch number and time.
+func (s EmissionSegment) HasStarted(epochNumber int64, blockTime time.Time) bool {
+	if s.StartTime != nil {
+		return !blockTime.Before(*s.StartTime)
+	}
+	return epochNumber >= s.StartEpoch
+}
+
+// Provision returns the amount minted by the segment once it has minted
+// segmentEpochs epochs.
+func (s EmissionSegment) Provision(segmentEpochs int64) sdkmath.Int {
+	if s.ReductionPeriod <= 0 {
+		return s.EpochProvision
+	}
+
+	reductions := uint64(segmentEpochs / s.ReductionPeriod)
+	return sdkmath.LegacyNewDecFromInt(s.EpochProvision).Mul(s.ReductionFactor.Power(reductions)).TruncateInt()
+}
+
+// Validate performs a basic validation of the emission schedule.
+func (s EmissionSchedule) Validate() error {
+	if !s.SupplyCap.IsNil() && s.SupplyCap.IsNegative() {
+		return fmt.Errorf("emission supply cap cannot be negative: %s", s.SupplyCap)
+	}
+
+	if !s.IsEnabled() {
+		if len(s.Recipients) > 0 {
+			return errors.New("emission recipients cannot be set without segments")
+		}
+		return nil
+	}
+
+	if strings.TrimSpace(s.EpochIdentifier) == "" {
+		return errors.New("emission epoch identifier cannot be blank")
+	}
+
+	// the segments must all start either at a time or at an epoch, so that
+	// they can be ordered by start and the active one never moves backwards
+	byTime := s.Segments[0].StartTime != nil
+	var (
+		lastEpoch int64 = -1
+		lastTime  *time.Time
+	)
+	for i, segment := range s.Segments {
+		if err := segment.Validate(); err != nil {
+			return fmt.Errorf("invalid emission segment %d: %w", i+1, err)
+		}
+
+		if (segment.StartTime != nil) != byTime {
+			return fmt.Errorf("emission segment %d cannot mix start times and start epochs", i+1)
+		}
+
+		if byTime {
+			if lastTime != nil && !segment.StartTime.After(*lastTime) {
+				return fmt.Errorf("emission segment %d must start after %s", i+1, lastTime)
+			}
+			lastTime = segment.StartTime
+		} else {
+			if segment.StartEpoch <= lastEpoch {
+				return fmt.Errorf("emission segment %d must start after epoch %d", i+1, lastEpoch)
+			}
+			lastEpoch = segment.StartEpoch
+		}
+	}
+
+	if len(s.Recipients) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(s.Recipients))
+	total := sdkmath.LegacyZeroDec()
+	for _, recipient := range s.Recipients {
+		if strings.TrimSpace(recipient.Module) == "" {
+			return errors.New("emission recipient module cannot be blank")
+		}
+		if _, ok := seen[recipient.Module]; ok {
+			return fmt.Errorf("duplicate emission recipient %s", recipient.Module)
+		}
+		seen[recipient.Module] = struct{}{}
+
+		if recipient.Weight.IsNil() || !recipient.Weight.IsPositive() {
+			return fmt.Errorf("weight of emission recipient %s must be positive", recipient.Module)
+		}
+		total = total.Add(recipient.Weight)
+	}
+
+	if !total.Equal(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("emission recipient weights must sum up to 1, got %s", total)
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the emission segment.
+func (s EmissionSegment) Validate() error {
+	if s.StartEpoch < 0 {
+		return fmt.Errorf("start epoch cannot be negative: %d", s.StartEpoch)
+	}
+	if s.EpochProvision.IsNil() || s.EpochProvision.IsNegative() {
+		return fmt.Errorf("epoch provision must be non-negative: %s", s.EpochProvision)
+	}
+	if s.ReductionPeriod < 0 {
+		return fmt.Errorf("reduction period cannot be negative: %d", s.ReductionPeriod)
+	}
+	if s.ReductionPeriod > 0 {
+		if s.ReductionFactor.IsNil() || s.ReductionFactor.IsNegative() || s.ReductionFactor.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("reduction factor must be between 0 and 1: %s", s.ReductionFactor)
+		}
+	}
+	return nil
+}
+
+// Split returns the amounts of the given amount each recipient receives, in the
+// order of the recipients. The remainder of the truncated shares goes to the
+// first recipient.
+func (s EmissionSchedule) Split(amount sdkmath.Int) []sdkmath.Int {
+	shares := make([]sdkmath.Int, len(s.Recipients))
+	remainder := amount
+	for i, recipient := range s.Recipients {
+		shares[i] = sdkmath.LegacyNewDecFromInt(amount).Mul(recipient.Weight).TruncateInt()
+		remainder = remainder.Sub(shares[i])
+	}
+
+	if len(shares) > 0 {
+		shares[0] = shares[0].Add(remainder)
+	}
+	return shares
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestEmissionScheduleValidate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_000_000, 0).UTC()
+	before := now.Add(-time.Hour)
+	valid := func() EmissionSchedule {
+		return EmissionSchedule{
+			EpochIdentifier: "day",
+			Segments: []EmissionSegment{
+				{StartEpoch: 1, EpochProvision: sdkmath.NewInt(100), ReductionPeriod: 10, ReductionFactor: sdkmath.LegacyNewDecWithPrec(5, 1)},
+				{StartEpoch: 20, EpochProvision: sdkmath.NewInt(10)},
+			},
+			SupplyCap: sdkmath.NewInt(1000),
+			Recipients: []EmissionRecipient{
+				{Module: "fee_collector", Weight: sdkmath.LegacyNewDecWithPrec(3, 1)},
+				{Module: "protocolpool", Weight: sdkmath.LegacyNewDecWithPrec(7, 1)},
+			},
+		}
+	}
+	require.NoError(t, valid().Validate())
+	require.NoError(t, EmissionSchedule{SupplyCap: sdkmath.ZeroInt()}.Validate())
+	byTime := valid()
+	byTime.Segments = []EmissionSegment{
+		{StartTime: &before, EpochProvision: sdkmath.NewInt(100)},
+		{StartTime: &now, EpochProvision: sdkmath.NewInt(10)},
+	}
+	require.NoError(t, byTime.Validate())
+
+	tests := []struct {
+		name        string
+		modify      func(s *EmissionSchedule)
+		errContains string
+	}{
+		{"negative supply cap", func(s *EmissionSchedule) { s.SupplyCap = sdkmath.NewInt(-1) }, "supply cap cannot be negative"},
+		{"recipients without segments", func(s *EmissionSchedule) { s.Segments = nil }, "cannot be set without segments"},
+		{"blank epoch identifier", func(s *EmissionSchedule) { s.EpochIdentifier = "" }, "epoch identifier cannot be blank"},
+		{"negative provision", func(s *EmissionSchedule) { s.Segments[0].EpochProvision = sdkmath.NewInt(-1) }, "epoch provision must be non-negative"},
+		{"reduction factor above one", func(s *EmissionSchedule) { s.Segments[0].ReductionFactor = sdkmath.LegacyNewDec(2) }, "reduction factor must be between 0 and 1"},
+		{"unordered epochs", func(s *EmissionSchedule) {
+			s.Segments = append(s.Segments, EmissionSegment{StartEpoch: 20, EpochProvision: sdkmath.NewInt(1)})
+		}, "must start after epoch 20"},
+		{"unordered times", func(s *EmissionSchedule) {
+			s.Segments = []EmissionSegment{
+				{StartTime: &now, EpochProvision: sdkmath.NewInt(1)},
+				{StartTime: &before, EpochProvision: sdkmath.NewInt(1)},
+			}
+		}, "must start after"},
+		{"mixed start kinds", func(s *EmissionSchedule) {
+			s.Segments = append(s.Segments, EmissionSegment{StartTime: &now, EpochProvision: sdkmath.NewInt(1)})
+		}, "emission segment 3 cannot mix start times and start epochs"},
+		{"duplicate recipient", func(s *EmissionSchedule) { s.Recipients[1].Module = "fee_collector" }, "duplicate emission recipient"},
+		{"zero weight", func(s *EmissionSchedule) { s.Recipients[0].Weight = sdkmath.LegacyZeroDec() }, "must be positive"},
+		{"weights not summing to one", func(s *EmissionSchedule) { s.Recipients[0].Weight = sdkmath.LegacyNewDecWithPrec(2, 1) }, "must sum up to 1"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s := valid()
+			tc.modify(&s)
+			require.ErrorContains(t, s.Validate(), tc.errContains)
+		})
+	}
+}
+
+func TestEmissionScheduleActiveSegment(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_000_000, 0).UTC()
+	later := now.Add(time.Hour)
+	s := EmissionSchedule{Segments: []EmissionSegment{
+		{StartEpoch: 5},
+		{StartEpoch: 10},
+	}}
+
+	require.Equal(t, int64(0), s.ActiveSegment(4, now))
+	require.Equal(t, int64(1), s.ActiveSegment(5, now))
+	require.Equal(t, int64(2), s.ActiveSegment(12, now))
+
+	s = EmissionSchedule{Segments: []EmissionSegment{
+		{StartTime: &now},
+		{StartTime: &later},
+	}}
+	require.Equal(t, int64(0), s.ActiveSegment(12, now.Add(-time.Second)))
+	require.Equal(t, int64(1), s.ActiveSegment(12, now))
+	require.Equal(t, int64(2), s.ActiveSegment(0, later))
+}
+
+func TestEmissionSegmentProvision(t *testing.T) {
+	t.Parallel()
+
+	constant := EmissionSegment{EpochProvision: sdkmath.NewInt(100)}
+	require.Equal(t, sdkmath.NewInt(100), constant.Provision(1000))
+
+	halving := EmissionSegment{EpochProvision: sdkmath.NewInt(100), ReductionPeriod: 3, ReductionFactor: sdkmath.LegacyNewDecWithPrec(5, 1)}
+	require.Equal(t, sdkmath.NewInt(100), halving.Provision(2))
+	require.Equal(t, sdkmath.NewInt(50), halving.Provision(3))
+	require.Equal(t, sdkmath.NewInt(12), halving.Provision(9))
+}
+
+func TestEmissionScheduleSplit(t *testing.T) {
+	t.Parallel()
+
+	s := EmissionSchedule{Recipients: []EmissionRecipient{
+		{Module: "a", Weight: sdkmath.LegacyNewDecWithPrec(1, 1)},
+		{Module: "b", Weight: sdkmath.LegacyNewDecWithPrec(9, 1)},
+	}}
+	require.Equal(t, []sdkmath.Int{sdkmath.NewInt(2), sdkmath.NewInt(9)}, s.Split(sdkmath.NewInt(11)))
+}
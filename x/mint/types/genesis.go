@@ -2,6 +2,7 @@ package types
 
 import (
 	context "context"
+	"fmt"
 
 	"cosmossdk.io/math"
 )
@@ -29,8 +30,9 @@ func NewGenesisState(minter Minter, params Params) *GenesisState {
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Minter: DefaultInitialMinter(),
-		Params: DefaultParams(),
+		Minter:        DefaultInitialMinter(),
+		Params:        DefaultParams(),
+		EmissionState: EmissionState{TotalMinted: math.ZeroInt()},
 	}
 }
 
@@ -41,5 +43,23 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if err := data.EmissionState.Validate(data.Params.EmissionSchedule); err != nil {
+		return err
+	}
+
 	return ValidateMinter(data.Minter)
 }
+
+// Validate checks the emission state against the emission schedule.
+func (s EmissionState) Validate(schedule EmissionSchedule) error {
+	if s.ActiveSegment < 0 || s.ActiveSegment > int64(len(schedule.Segments)) {
+		return fmt.Errorf("active emission segment %d is out of range", s.ActiveSegment)
+	}
+	if s.SegmentEpochs < 0 {
+		return fmt.Errorf("emission segment epochs cannot be negative: %d", s.SegmentEpochs)
+	}
+	if !s.TotalMinted.IsNil() && s.TotalMinted.IsNegative() {
+		return fmt.Errorf("total emission cannot be negative: %s", s.TotalMinted)
+	}
+	return nil
+}
@@ -6,6 +6,8 @@ var (
 	// MinterKey is the key to use for the keeper store.
 	MinterKey = collections.NewPrefix(0)
 	ParamsKey = collections.NewPrefix(1)
+	// EmissionStateKey is the key of the progress of the emission schedule.
+	EmissionStateKey = collections.NewPrefix(2)
 )
 
 const (
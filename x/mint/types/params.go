@@ -20,6 +20,7 @@ func NewParams(mintDenom string, inflationRateChange, inflationMax, inflationMin
 		InflationMin:        inflationMin,
 		GoalBonded:          goalBonded,
 		BlocksPerYear:       blocksPerYear,
+		EmissionSchedule:    EmissionSchedule{SupplyCap: sdkmath.ZeroInt()},
 	}
 }
 
@@ -32,6 +33,7 @@ func DefaultParams() Params {
 		InflationMin:        sdkmath.LegacyNewDecWithPrec(7, 2),
 		GoalBonded:          sdkmath.LegacyNewDecWithPrec(67, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		EmissionSchedule:    EmissionSchedule{SupplyCap: sdkmath.ZeroInt()},
 	}
 }
 
@@ -55,6 +57,9 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := p.EmissionSchedule.Validate(); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
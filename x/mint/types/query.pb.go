@@ -270,6 +270,103 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
+// QueryEmissionScheduleRequest is the request type for the
+// Query/EmissionSchedule RPC method.
+type QueryEmissionScheduleRequest struct {
+}
+
+func (m *QueryEmissionScheduleRequest) Reset()         { *m = QueryEmissionScheduleRequest{} }
+func (m *QueryEmissionScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionScheduleRequest) ProtoMessage()    {}
+func (*QueryEmissionScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *QueryEmissionScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionScheduleRequest.Merge(m, src)
+}
+func (m *QueryEmissionScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionScheduleRequest proto.InternalMessageInfo
+
+// QueryEmissionScheduleResponse is the response type for the
+// Query/EmissionSchedule RPC method.
+type QueryEmissionScheduleResponse struct {
+	// schedule is the emission schedule of the params.
+	Schedule EmissionSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule"`
+	// state is the progress of the emission schedule.
+	State EmissionState `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+	// next_provision is the amount the active segment mints at the end of the
+	// next epoch, before applying the supply cap.
+	NextProvision cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=next_provision,json=nextProvision,proto3,customtype=cosmossdk.io/math.Int" json:"next_provision"`
+}
+
+func (m *QueryEmissionScheduleResponse) Reset()         { *m = QueryEmissionScheduleResponse{} }
+func (m *QueryEmissionScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionScheduleResponse) ProtoMessage()    {}
+func (*QueryEmissionScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{7}
+}
+func (m *QueryEmissionScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionScheduleResponse.Merge(m, src)
+}
+func (m *QueryEmissionScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryEmissionScheduleResponse) GetSchedule() EmissionSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return EmissionSchedule{}
+}
+
+func (m *QueryEmissionScheduleResponse) GetState() EmissionState {
+	if m != nil {
+		return m.State
+	}
+	return EmissionState{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
@@ -277,43 +374,55 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryEmissionScheduleRequest)(nil), "cosmos.mint.v1beta1.QueryEmissionScheduleRequest")
+	proto.RegisterType((*QueryEmissionScheduleResponse)(nil), "cosmos.mint.v1beta1.QueryEmissionScheduleResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 494 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4d, 0x6b, 0x14, 0x31,
-	0x18, 0xc7, 0x37, 0x8a, 0x0b, 0x1b, 0x3d, 0xb4, 0x69, 0x7d, 0x9b, 0x6d, 0xb3, 0x65, 0x84, 0xba,
-	0x56, 0x9a, 0xb0, 0x2b, 0x78, 0x14, 0x5c, 0x7b, 0x11, 0x3c, 0xd4, 0xa2, 0x17, 0x2f, 0x92, 0x9d,
-	0xc6, 0x69, 0xe8, 0x4e, 0x32, 0xdd, 0x64, 0x8a, 0x7b, 0x13, 0xf1, 0xe8, 0x41, 0xf0, 0x4b, 0xe8,
-	0xcd, 0x83, 0x5f, 0x41, 0xe8, 0xb1, 0xe8, 0x45, 0x3c, 0x14, 0xd9, 0x15, 0xfc, 0x1a, 0x32, 0x49,
-	0xa6, 0xe2, 0x74, 0x06, 0x95, 0x5e, 0x76, 0x67, 0x9e, 0xb7, 0xff, 0x2f, 0xcf, 0x3f, 0x03, 0x3b,
-	0x91, 0xd2, 0x89, 0xd2, 0x34, 0x11, 0xd2, 0xd0, 0xfd, 0xde, 0x90, 0x1b, 0xd6, 0xa3, 0x7b, 0x19,
-	0x1f, 0x4f, 0x48, 0x3a, 0x56, 0x46, 0xa1, 0x05, 0x57, 0x40, 0xf2, 0x02, 0xe2, 0x0b, 0x82, 0xc5,
-	0x58, 0xc5, 0xca, 0xe6, 0x69, 0xfe, 0xe4, 0x4a, 0x83, 0xa5, 0x58, 0xa9, 0x78, 0xc4, 0x29, 0x4b,
-	0x05, 0x65, 0x52, 0x2a, 0xc3, 0x8c, 0x50, 0x52, 0xfb, 0x2c, 0xae, 0x52, 0xb2, 0x53, 0x5d, 0x7e,
-	0x9e, 0x25, 0x42, 0x2a, 0x6a, 0x7f, 0x7d, 0xe8, 0xaa, 0x6b, 0x79, 0xea, 0x94, 0x3c, 0x88, 0x7d,
-	0x09, 0x17, 0x21, 0x7a, 0x98, 0x53, 0x6e, 0xb2, 0x31, 0x4b, 0xf4, 0x16, 0xdf, 0xcb, 0xb8, 0x36,
-	0xe1, 0x63, 0xb8, 0xf0, 0x47, 0x54, 0xa7, 0x4a, 0x6a, 0x8e, 0xee, 0xc0, 0x66, 0x6a, 0x23, 0x57,
-	0xc0, 0x0a, 0xe8, 0x9e, 0xef, 0xb7, 0x49, 0xc5, 0xa1, 0x88, 0x6b, 0x1a, 0xb4, 0x0e, 0x8e, 0x3a,
-	0x8d, 0x77, 0x3f, 0x3f, 0xac, 0x81, 0x2d, 0xdf, 0x15, 0x5e, 0x86, 0x17, 0xed, 0xd8, 0xfb, 0xf2,
-	0xd9, 0xc8, 0x9e, 0xa9, 0xd0, 0x93, 0xf0, 0x52, 0x39, 0xe1, 0x25, 0x1f, 0xc1, 0x96, 0x28, 0x82,
-	0x56, 0xf5, 0xc2, 0xe0, 0x76, 0x3e, 0xf8, 0xdb, 0x51, 0xa7, 0xed, 0xc4, 0xf5, 0xf6, 0x2e, 0x11,
-	0x8a, 0x26, 0xcc, 0xec, 0x90, 0x07, 0x3c, 0x66, 0xd1, 0x64, 0x83, 0x47, 0x9f, 0x3f, 0xae, 0x43,
-	0xcf, 0xb6, 0xc1, 0x23, 0x47, 0xf1, 0x7b, 0x50, 0x88, 0xe1, 0x92, 0xd5, 0xbb, 0x2b, 0x65, 0xc6,
-	0x46, 0x9b, 0x63, 0xb5, 0x2f, 0x74, 0xbe, 0xe2, 0x82, 0xe7, 0x15, 0x80, 0xcb, 0x35, 0x05, 0x9e,
-	0x2b, 0x82, 0xf3, 0xcc, 0xe6, 0xf2, 0xa5, 0xfa, 0xe4, 0x29, 0xf9, 0xe6, 0x58, 0x49, 0xac, 0xff,
-	0xe9, 0x2c, 0x3c, 0x67, 0x31, 0xd0, 0x0b, 0x00, 0x9b, 0x6e, 0xaf, 0xe8, 0x7a, 0xe5, 0xd2, 0x4f,
-	0x9a, 0x18, 0x74, 0xff, 0x5e, 0xe8, 0x0e, 0x13, 0x5e, 0x7b, 0xf9, 0xe5, 0xc7, 0xdb, 0x33, 0xcb,
-	0xa8, 0x4d, 0xab, 0xee, 0x96, 0x33, 0x0f, 0xbd, 0x06, 0xb0, 0x75, 0xec, 0x0f, 0x5a, 0xab, 0x1f,
-	0x5e, 0x76, 0x37, 0xb8, 0xf9, 0x4f, 0xb5, 0x9e, 0x65, 0xd5, 0xb2, 0xac, 0x20, 0x5c, 0xc9, 0x72,
-	0x6c, 0x21, 0x7a, 0x0f, 0xe0, 0x5c, 0xd9, 0x1d, 0xd4, 0xab, 0x57, 0xaa, 0xb1, 0x3a, 0xe8, 0xff,
-	0x4f, 0x8b, 0x67, 0x24, 0x96, 0xb1, 0x8b, 0x56, 0x2b, 0x19, 0x4f, 0xdc, 0x8b, 0xc1, 0xbd, 0x83,
-	0x29, 0x06, 0x87, 0x53, 0x0c, 0xbe, 0x4f, 0x31, 0x78, 0x33, 0xc3, 0x8d, 0xc3, 0x19, 0x6e, 0x7c,
-	0x9d, 0xe1, 0xc6, 0x93, 0x1b, 0xb1, 0x30, 0x3b, 0xd9, 0x90, 0x44, 0x2a, 0x29, 0x66, 0xb9, 0xbf,
-	0x75, 0xbd, 0xbd, 0x4b, 0x9f, 0xbb, 0xc1, 0x66, 0x92, 0x72, 0x3d, 0x6c, 0xda, 0x0f, 0xf6, 0xd6,
-	0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x62, 0x1a, 0x74, 0xc5, 0x6a, 0x04, 0x00, 0x00,
+	// 642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4d, 0x4f, 0x13, 0x41,
+	0x18, 0xc7, 0x3b, 0x18, 0x88, 0x1d, 0xdf, 0x60, 0x00, 0xc5, 0x02, 0x4b, 0xb3, 0x46, 0xac, 0x90,
+	0xee, 0xda, 0xa2, 0x1e, 0x3c, 0x98, 0x50, 0xf0, 0xd0, 0x84, 0x03, 0x56, 0xbd, 0x78, 0x21, 0xd3,
+	0xed, 0xb8, 0xdd, 0xd0, 0x9d, 0x29, 0x9d, 0x29, 0xa1, 0x37, 0x63, 0x38, 0x7a, 0x30, 0xf1, 0xe8,
+	0x17, 0xd0, 0x9b, 0x07, 0x8e, 0x7e, 0x00, 0xe2, 0x89, 0xe0, 0xc5, 0x78, 0x20, 0xa6, 0x35, 0xf1,
+	0x6b, 0x98, 0x9d, 0x99, 0xbe, 0xb8, 0xdd, 0xad, 0x1a, 0x2f, 0x4d, 0xfb, 0xbc, 0xfc, 0x9f, 0xdf,
+	0x3e, 0xfb, 0xfc, 0x0b, 0x97, 0x1c, 0xc6, 0x7d, 0xc6, 0x6d, 0xdf, 0xa3, 0xc2, 0xde, 0xcf, 0x95,
+	0x89, 0xc0, 0x39, 0x7b, 0xaf, 0x49, 0x1a, 0x2d, 0xab, 0xde, 0x60, 0x82, 0xa1, 0x69, 0x55, 0x60,
+	0x05, 0x05, 0x96, 0x2e, 0x48, 0xcd, 0xb8, 0xcc, 0x65, 0x32, 0x6f, 0x07, 0xdf, 0x54, 0x69, 0x6a,
+	0xc1, 0x65, 0xcc, 0xad, 0x11, 0x1b, 0xd7, 0x3d, 0x1b, 0x53, 0xca, 0x04, 0x16, 0x1e, 0xa3, 0x5c,
+	0x67, 0x8d, 0xa8, 0x49, 0x52, 0x55, 0xe5, 0xa7, 0xb0, 0xef, 0x51, 0x66, 0xcb, 0x4f, 0x1d, 0xba,
+	0xae, 0x5a, 0x76, 0xd4, 0x24, 0x0d, 0x22, 0x7f, 0x98, 0x33, 0x10, 0x3d, 0x0e, 0x28, 0xb7, 0x71,
+	0x03, 0xfb, 0xbc, 0x44, 0xf6, 0x9a, 0x84, 0x0b, 0xf3, 0x19, 0x9c, 0xfe, 0x2d, 0xca, 0xeb, 0x8c,
+	0x72, 0x82, 0x1e, 0xc2, 0x89, 0xba, 0x8c, 0xcc, 0x81, 0x34, 0xc8, 0x5c, 0xc8, 0xcf, 0x5b, 0x11,
+	0x0f, 0x65, 0xa9, 0xa6, 0x42, 0xf2, 0xf8, 0x6c, 0x29, 0xf1, 0xfe, 0xe7, 0xc7, 0x15, 0x50, 0xd2,
+	0x5d, 0xe6, 0x35, 0x38, 0x2b, 0x65, 0x8b, 0xf4, 0x45, 0x4d, 0x3e, 0x53, 0x77, 0x1e, 0x85, 0x57,
+	0xc3, 0x09, 0x3d, 0xf2, 0x29, 0x4c, 0x7a, 0xdd, 0xa0, 0x9c, 0x7a, 0xb1, 0x70, 0x3f, 0x10, 0xfe,
+	0x76, 0xb6, 0x34, 0xaf, 0x86, 0xf3, 0xca, 0xae, 0xe5, 0x31, 0xdb, 0xc7, 0xa2, 0x6a, 0x6d, 0x11,
+	0x17, 0x3b, 0xad, 0x4d, 0xe2, 0x9c, 0x1e, 0x65, 0xa1, 0x66, 0xdb, 0x24, 0x8e, 0xa2, 0xe8, 0x0b,
+	0x99, 0x06, 0x5c, 0x90, 0xf3, 0xd6, 0x29, 0x6d, 0xe2, 0xda, 0x76, 0x83, 0xed, 0x7b, 0x3c, 0x58,
+	0x71, 0x97, 0xe7, 0x10, 0xc0, 0xc5, 0x98, 0x02, 0xcd, 0xe5, 0xc0, 0x29, 0x2c, 0x73, 0xc1, 0x52,
+	0x75, 0xf2, 0x3f, 0xf9, 0x26, 0x71, 0x68, 0x98, 0xb9, 0xa6, 0x31, 0x1f, 0xf9, 0x1e, 0x0f, 0x22,
+	0x4f, 0x9c, 0x2a, 0xa9, 0x34, 0x6b, 0x44, 0x63, 0x3e, 0x98, 0x3e, 0x3d, 0xca, 0x5e, 0x51, 0x32,
+	0x59, 0x5e, 0xd9, 0x4d, 0xdf, 0xb1, 0xee, 0xdd, 0x35, 0xdf, 0x8d, 0x69, 0xf6, 0xe1, 0x2e, 0xcd,
+	0xbe, 0x05, 0xcf, 0x73, 0x1d, 0xd3, 0x2f, 0xf2, 0x66, 0xe4, 0x8b, 0x0c, 0x0b, 0x0c, 0xbe, 0xd2,
+	0x9e, 0x02, 0xda, 0x80, 0xe3, 0x5c, 0x60, 0x41, 0xe6, 0xc6, 0xa4, 0x94, 0x39, 0x5a, 0x2a, 0xa8,
+	0x1c, 0xd4, 0x51, 0xbd, 0xa8, 0x04, 0x2f, 0x53, 0x72, 0x20, 0xfa, 0xcb, 0x9c, 0x3b, 0x97, 0x06,
+	0x99, 0x64, 0x61, 0x55, 0xef, 0x72, 0x76, 0x78, 0x97, 0x45, 0x2a, 0x06, 0xb6, 0x58, 0xa4, 0xa2,
+	0x74, 0x29, 0x90, 0xe8, 0xad, 0x2f, 0x72, 0x3b, 0xf9, 0xc3, 0x71, 0x38, 0x2e, 0xb7, 0x83, 0x5e,
+	0x02, 0x38, 0xa1, 0x4e, 0x15, 0xdd, 0x8a, 0x64, 0x1e, 0xf6, 0x45, 0x2a, 0xf3, 0xe7, 0x42, 0xb5,
+	0x63, 0xf3, 0xc6, 0xab, 0x2f, 0x3f, 0xde, 0x8e, 0x2d, 0xa2, 0x79, 0x3b, 0xca, 0xae, 0xca, 0x0f,
+	0xe8, 0x35, 0x80, 0xc9, 0xde, 0xc9, 0xa3, 0x95, 0x78, 0xf1, 0xb0, 0x61, 0x52, 0xab, 0x7f, 0x55,
+	0xab, 0x59, 0x96, 0x25, 0x4b, 0x1a, 0x19, 0x91, 0x2c, 0x3d, 0x57, 0xa0, 0x0f, 0x00, 0x4e, 0x86,
+	0x0f, 0x1e, 0xe5, 0xe2, 0x27, 0xc5, 0xb8, 0x27, 0x95, 0xff, 0x97, 0x16, 0xcd, 0x68, 0x49, 0xc6,
+	0x0c, 0x5a, 0x8e, 0x64, 0x1c, 0xb2, 0x1a, 0xfa, 0x04, 0xe0, 0x64, 0xf8, 0x3e, 0x47, 0xb1, 0xc6,
+	0x58, 0x68, 0x14, 0x6b, 0x9c, 0x7f, 0xcc, 0xf5, 0xcf, 0xc3, 0x87, 0x35, 0x12, 0x9f, 0x68, 0xa5,
+	0x9d, 0xae, 0x69, 0x0a, 0x1b, 0xc7, 0x6d, 0x03, 0x9c, 0xb4, 0x0d, 0xf0, 0xbd, 0x6d, 0x80, 0x37,
+	0x1d, 0x23, 0x71, 0xd2, 0x31, 0x12, 0x5f, 0x3b, 0x46, 0xe2, 0xf9, 0x6d, 0xd7, 0x13, 0xd5, 0x66,
+	0xd9, 0x72, 0x98, 0xdf, 0xd5, 0xea, 0x8f, 0xb2, 0x0f, 0x94, 0xb0, 0x68, 0xd5, 0x09, 0x2f, 0x4f,
+	0xc8, 0xbf, 0xf0, 0xb5, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8f, 0xde, 0x28, 0x23, 0x7c, 0x06,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -334,6 +443,8 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// EmissionSchedule returns the emission schedule and its progress.
+	EmissionSchedule(ctx context.Context, in *QueryEmissionScheduleRequest, opts ...grpc.CallOption) (*QueryEmissionScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -371,6 +482,15 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) EmissionSchedule(ctx context.Context, in *QueryEmissionScheduleRequest, opts ...grpc.CallOption) (*QueryEmissionScheduleResponse, error) {
+	out := new(QueryEmissionScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/EmissionSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -379,6 +499,8 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// EmissionSchedule returns the emission schedule and its progress.
+	EmissionSchedule(context.Context, *QueryEmissionScheduleRequest) (*QueryEmissionScheduleResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -394,6 +516,9 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (*UnimplementedQueryServer) EmissionSchedule(ctx context.Context, req *QueryEmissionScheduleRequest) (*QueryEmissionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionSchedule not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -453,6 +578,24 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EmissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/EmissionSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionSchedule(ctx, req.(*QueryEmissionScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
@@ -470,6 +613,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "EmissionSchedule",
+			Handler:    _Query_EmissionSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -643,6 +790,82 @@ func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEmissionScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NextProvision.Size()
+		i -= size
+		if _, err := m.NextProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -714,6 +937,30 @@ func (m *QueryAnnualProvisionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEmissionScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEmissionScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NextProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1119,6 +1366,206 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEmissionScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextProvision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NextProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35,6 +35,21 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) error {
 		}
 	}
 
+	for _, budget := range data.Budgets {
+		recipientAddress, err := k.authKeeper.AddressCodec().StringToBytes(budget.Recipient)
+		if err != nil {
+			return fmt.Errorf("failed to decode recipient address: %w", err)
+		}
+
+		if k.bankKeeper.BlockedAddr(recipientAddress) {
+			return fmt.Errorf("recipient is blocked in the bank keeper: %s", recipientAddress)
+		}
+
+		if err := k.Budgets.Set(ctx, recipientAddress, budget); err != nil {
+			return fmt.Errorf("failed to set budget for recipient %s: %w", recipientAddress, err)
+		}
+	}
+
 	return nil
 }
 
@@ -53,5 +68,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
 
 	genState.Params = params
 
+	genState.Budgets, err = k.GetAllBudgets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return genState, nil
 }
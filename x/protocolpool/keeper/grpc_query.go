@@ -76,6 +76,47 @@ func (k Querier) ContinuousFunds(ctx context.Context, req *types.QueryContinuous
 	return &types.QueryContinuousFundsResponse{ContinuousFunds: funds}, nil
 }
 
+// Budget queries a budget by its recipient address.
+func (k Querier) Budget(ctx context.Context, req *types.QueryBudgetRequest) (*types.QueryBudgetResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	acc, err := k.authKeeper.AddressCodec().StringToBytes(req.Recipient)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", fmt.Errorf("invalid address: %w", err).Error())
+	}
+
+	budget, err := k.Keeper.Budgets.Get(sdkCtx, acc)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s", fmt.Sprintf("not found %s", req.Recipient))
+	}
+
+	return &types.QueryBudgetResponse{
+		Budget:          budget,
+		ClaimedAmount:   budget.ClaimedAmount(),
+		RemainingAmount: budget.RemainingAmount(),
+	}, nil
+}
+
+// Budgets queries all budgets in the store.
+func (k Querier) Budgets(ctx context.Context, req *types.QueryBudgetsRequest) (*types.QueryBudgetsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	budgets, err := k.GetAllBudgets(sdkCtx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s", fmt.Errorf("failed to fetch budgets: %w", err).Error())
+	}
+
+	return &types.QueryBudgetsResponse{Budgets: budgets}, nil
+}
+
 // Params queries params of x/protocolpool module.
 func (k Querier) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	"go.uber.org/mock/gomock"
 
 	"cosmossdk.io/math"
@@ -269,3 +271,33 @@ func (suite *KeeperTestSuite) TestContinuousFunds() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestBudget() {
+	amountPerPeriod := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	budget := types.Budget{
+		Recipient:       recipientAddr.String(),
+		AmountPerPeriod: amountPerPeriod,
+		TotalPeriods:    10,
+		ClaimedPeriods:  3,
+		Period:          time.Hour,
+	}
+	suite.Require().NoError(suite.poolKeeper.Budgets.Set(suite.ctx, recipientAddr, budget))
+
+	_, err := suite.queryServer.Budget(suite.ctx, nil)
+	suite.Require().ErrorContains(err, "empty request")
+
+	_, err = suite.queryServer.Budget(suite.ctx, &types.QueryBudgetRequest{Recipient: recipientAddr2.String()})
+	suite.Require().ErrorContains(err, "not found")
+
+	resp, err := suite.queryServer.Budget(suite.ctx, &types.QueryBudgetRequest{Recipient: recipientAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryBudgetResponse{
+		Budget:          budget,
+		ClaimedAmount:   amountPerPeriod.MulInt(math.NewInt(3)),
+		RemainingAmount: amountPerPeriod.MulInt(math.NewInt(7)),
+	}, resp)
+
+	budgets, err := suite.queryServer.Budgets(suite.ctx, &types.QueryBudgetsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Budget{budget}, budgets.Budgets)
+}
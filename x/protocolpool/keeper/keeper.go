@@ -31,6 +31,7 @@ type Keeper struct {
 	// State
 	Schema          collections.Schema
 	ContinuousFunds collections.Map[sdk.AccAddress, types.ContinuousFund]
+	Budgets         collections.Map[sdk.AccAddress, types.Budget]
 	Params          collections.Item[types.Params]
 }
 
@@ -58,6 +59,7 @@ func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, ak type
 		cdc:             cdc,
 		authority:       authority,
 		ContinuousFunds: collections.NewMap(sb, types.ContinuousFundsKey, "continuous_funds", sdk.AccAddressKey, codec.CollValue[types.ContinuousFund](cdc)),
+		Budgets:         collections.NewMap(sb, types.BudgetsKey, "budgets", sdk.AccAddressKey, codec.CollValue[types.Budget](cdc)),
 		Params:          collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 	}
 
@@ -106,9 +108,22 @@ func (k Keeper) GetCommunityPool(ctx sdk.Context) (sdk.Coins, error) {
 // - for each continuous fund, check if expired and remove if so
 // - for each continuous fund, distribute funds according to percentage
 // - distribute remaining funds to the community pool
+// Then get all budgets:
+// - for each budget, pay out the periods elapsed since the last payout from the community pool
+// - remove budgets that have been paid out completely
 //
 // This function is run at the BeginBlocker method and therefore must be very safe.
 func (k Keeper) DistributeFunds(ctx sdk.Context) error {
+	if err := k.distributeContinuousFunds(ctx); err != nil {
+		return err
+	}
+
+	return k.distributeBudgets(ctx)
+}
+
+// distributeContinuousFunds distributes the balance of the escrow account among the
+// continuous funds and sends the remainder to the community pool.
+func (k Keeper) distributeContinuousFunds(ctx sdk.Context) error {
 	// Get current balance of the intermediary module account
 	moduleAccount := k.authKeeper.GetModuleAccount(ctx, types.ProtocolPoolEscrowAccount)
 	if moduleAccount == nil {
@@ -190,6 +205,67 @@ func (k Keeper) DistributeFunds(ctx sdk.Context) error {
 	return nil
 }
 
+// distributeBudgets pays out the elapsed periods of all budgets from the community pool.
+// Payouts the community pool cannot cover are retried on the next distribution.
+func (k Keeper) distributeBudgets(ctx sdk.Context) error {
+	iter, err := k.Budgets.Iterate(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create iterator for budgets: %w", err)
+	}
+
+	kValues, err := iter.KeyValues()
+	if err != nil {
+		return fmt.Errorf("failed to iterate budgets: %w", err)
+	}
+
+	blockTime := ctx.BlockTime()
+	for _, kv := range kValues {
+		recipient := kv.Key
+		budget := kv.Value
+
+		elapsed := budget.ElapsedPeriods(blockTime)
+		if elapsed <= budget.ClaimedPeriods {
+			continue
+		}
+
+		periods := elapsed - budget.ClaimedPeriods
+		amount := budget.AmountPerPeriod.MulInt(math.NewIntFromUint64(periods))
+
+		// a failed send must not leave a partial payout behind
+		cacheCtx, write := ctx.CacheContext()
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, recipient, amount)
+		if err != nil {
+			switch {
+			// in the case where the address is unauthorized (blocked), remove the budget
+			case errors.Is(err, sdkerrors.ErrUnauthorized):
+				ctx.Logger().Debug("recipient is unauthorized - removing the budget", "error", err)
+				if err := k.Budgets.Remove(ctx, recipient); err != nil {
+					return fmt.Errorf("failed to remove budget for %s from Budgets: %w", recipient, err)
+				}
+				continue
+			case errors.Is(err, sdkerrors.ErrInsufficientFunds):
+				ctx.Logger().Info("community pool cannot cover the budget payout - retrying later", "recipient", budget.Recipient, "amount", amount.String())
+				continue
+			default:
+				return fmt.Errorf("failed to distribute budget for %s from Budgets: %w", recipient, err)
+			}
+		}
+		write()
+
+		budget.ClaimedPeriods = elapsed
+		if budget.ClaimedPeriods == budget.TotalPeriods {
+			err = k.Budgets.Remove(ctx, recipient)
+		} else {
+			err = k.Budgets.Set(ctx, recipient, budget)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update budget for %s: %w", recipient, err)
+		}
+	}
+
+	return nil
+}
+
 // GetAllContinuousFunds gets all continuous funds in the store.
 func (k Keeper) GetAllContinuousFunds(ctx sdk.Context) ([]types.ContinuousFund, error) {
 	it, err := k.ContinuousFunds.Iterate(ctx, nil)
@@ -204,6 +280,20 @@ func (k Keeper) GetAllContinuousFunds(ctx sdk.Context) ([]types.ContinuousFund,
 	return cf, nil
 }
 
+// GetAllBudgets gets all budgets in the store.
+func (k Keeper) GetAllBudgets(ctx sdk.Context) ([]types.Budget, error) {
+	it, err := k.Budgets.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	budgets, err := it.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	return budgets, nil
+}
+
 func (k Keeper) validateAuthority(authority string) error {
 	if _, err := k.authKeeper.AddressCodec().StringToBytes(authority); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
@@ -527,3 +527,112 @@ func TestPercentageCoinMul(t *testing.T) {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestDistributeBudgets() {
+	startTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	amountPerPeriod := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	cliffTime := startTime.Add(3 * time.Hour)
+
+	setupBudget := func(budget types.Budget) {
+		suite.SetupTest()
+		suite.ctx = suite.ctx.WithBlockTime(startTime)
+		suite.authKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ProtocolPoolEscrowAccount).Return(poolDistrAcc).AnyTimes()
+		suite.bankKeeper.EXPECT().GetBalance(gomock.Any(), poolDistrAcc.GetAddress(), sdk.DefaultBondDenom).
+			Return(sdk.NewCoin(sdk.DefaultBondDenom, math.ZeroInt())).AnyTimes()
+		suite.Require().NoError(suite.poolKeeper.Budgets.Set(suite.ctx, recipientAddr, budget))
+	}
+
+	distributeAt := func(blockTime time.Time) {
+		suite.ctx = suite.ctx.WithBlockTime(blockTime)
+		suite.Require().NoError(suite.poolKeeper.DistributeFunds(suite.ctx))
+	}
+
+	suite.Run("elapsed periods are paid out", func() {
+		setupBudget(types.Budget{
+			Recipient:       recipientAddr.String(),
+			AmountPerPeriod: amountPerPeriod,
+			TotalPeriods:    3,
+			StartTime:       startTime,
+			Period:          time.Hour,
+		})
+
+		// no period has elapsed yet
+		distributeAt(startTime.Add(30 * time.Minute))
+
+		suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipientAddr, amountPerPeriod).Return(nil).Times(1)
+		distributeAt(startTime.Add(90 * time.Minute))
+
+		budget, err := suite.poolKeeper.Budgets.Get(suite.ctx, recipientAddr)
+		suite.Require().NoError(err)
+		suite.Require().Equal(uint64(1), budget.ClaimedPeriods)
+
+		// the remaining periods are paid out at once and the budget is removed
+		suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipientAddr, amountPerPeriod.MulInt(math.NewInt(2))).Return(nil).Times(1)
+		distributeAt(startTime.Add(10 * time.Hour))
+
+		has, err := suite.poolKeeper.Budgets.Has(suite.ctx, recipientAddr)
+		suite.Require().NoError(err)
+		suite.Require().False(has)
+	})
+
+	suite.Run("nothing is paid out before the cliff", func() {
+		setupBudget(types.Budget{
+			Recipient:       recipientAddr.String(),
+			AmountPerPeriod: amountPerPeriod,
+			TotalPeriods:    5,
+			StartTime:       startTime,
+			Period:          time.Hour,
+			CliffTime:       &cliffTime,
+		})
+
+		distributeAt(startTime.Add(150 * time.Minute))
+
+		suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipientAddr, amountPerPeriod.MulInt(math.NewInt(3))).Return(nil).Times(1)
+		distributeAt(cliffTime)
+
+		budget, err := suite.poolKeeper.Budgets.Get(suite.ctx, recipientAddr)
+		suite.Require().NoError(err)
+		suite.Require().Equal(uint64(3), budget.ClaimedPeriods)
+	})
+
+	suite.Run("insufficient community pool funds are retried", func() {
+		setupBudget(types.Budget{
+			Recipient:       recipientAddr.String(),
+			AmountPerPeriod: amountPerPeriod,
+			TotalPeriods:    3,
+			StartTime:       startTime,
+			Period:          time.Hour,
+		})
+
+		suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipientAddr, amountPerPeriod).Return(sdkerrors.ErrInsufficientFunds).Times(1)
+		distributeAt(startTime.Add(time.Hour))
+
+		budget, err := suite.poolKeeper.Budgets.Get(suite.ctx, recipientAddr)
+		suite.Require().NoError(err)
+		suite.Require().Zero(budget.ClaimedPeriods)
+
+		suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipientAddr, amountPerPeriod.MulInt(math.NewInt(2))).Return(nil).Times(1)
+		distributeAt(startTime.Add(2 * time.Hour))
+
+		budget, err = suite.poolKeeper.Budgets.Get(suite.ctx, recipientAddr)
+		suite.Require().NoError(err)
+		suite.Require().Equal(uint64(2), budget.ClaimedPeriods)
+	})
+
+	suite.Run("budget of a blocked recipient is removed", func() {
+		setupBudget(types.Budget{
+			Recipient:       recipientAddr.String(),
+			AmountPerPeriod: amountPerPeriod,
+			TotalPeriods:    3,
+			StartTime:       startTime,
+			Period:          time.Hour,
+		})
+
+		suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipientAddr, amountPerPeriod).Return(sdkerrors.ErrUnauthorized).Times(1)
+		distributeAt(startTime.Add(time.Hour))
+
+		has, err := suite.poolKeeper.Budgets.Has(suite.ctx, recipientAddr)
+		suite.Require().NoError(err)
+		suite.Require().False(has)
+	})
+}
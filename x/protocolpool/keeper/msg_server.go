@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -166,6 +168,74 @@ func (k MsgServer) CancelContinuousFund(ctx context.Context, msg *types.MsgCance
 	}, nil
 }
 
+func (k MsgServer) CreateBudget(ctx context.Context, msg *types.MsgCreateBudget) (*types.MsgCreateBudgetResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	recipient, err := k.Keeper.authKeeper.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	// deny creation if we know this address is blocked from receiving funds
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return nil, fmt.Errorf("recipient is blocked in the bank keeper: %s", msg.Recipient)
+	}
+
+	has, err := k.Budgets.Has(sdkCtx, recipient)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, fmt.Errorf("budget already exists for recipient %s", msg.Recipient)
+	}
+
+	budget, err := validateBudget(sdkCtx, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Budgets.Set(sdkCtx, recipient, budget); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateBudgetResponse{}, nil
+}
+
+func (k MsgServer) ClawbackBudget(ctx context.Context, msg *types.MsgClawbackBudget) (*types.MsgClawbackBudgetResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	recipient, err := k.Keeper.authKeeper.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	budget, err := k.Budgets.Get(sdkCtx, recipient)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, fmt.Errorf("cannot claw back budget for recipient %s - does not exist", msg.Recipient)
+		}
+		return nil, fmt.Errorf("cannot get budget for recipient %w", err)
+	}
+
+	// the unpaid amount never left the community pool, so removing the budget is enough
+	if err := k.Budgets.Remove(sdkCtx, recipient); err != nil {
+		return nil, fmt.Errorf("failed to remove budget for recipient %s: %w", msg.Recipient, err)
+	}
+
+	return &types.MsgClawbackBudgetResponse{
+		Recipient:        msg.Recipient,
+		ClawedBackAmount: budget.RemainingAmount(),
+	}, nil
+}
+
 func (k MsgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -477,3 +477,135 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCreateBudget() {
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	pastTime := blockTime.Add(-time.Hour)
+	cliffTime := blockTime.Add(24 * time.Hour)
+	amountPerPeriod := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+
+	validMsg := func() *types.MsgCreateBudget {
+		return &types.MsgCreateBudget{
+			Authority:       suite.poolKeeper.GetAuthority(),
+			Recipient:       recipientAddr.String(),
+			AmountPerPeriod: amountPerPeriod,
+			TotalPeriods:    12,
+			Period:          time.Hour,
+			CliffTime:       &cliffTime,
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		malleate  func(msg *types.MsgCreateBudget)
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			malleate:  func(msg *types.MsgCreateBudget) { msg.Authority = "invalid_auth" },
+			expErrMsg: "invalid authority address",
+		},
+		{
+			name: "blocked recipient",
+			preRun: func() {
+				suite.bankKeeper.EXPECT().BlockedAddr(recipientAddr).Return(true)
+			},
+			expErrMsg: "recipient is blocked in the bank keeper",
+		},
+		{
+			name: "start time in the past",
+			preRun: func() {
+				suite.bankKeeper.EXPECT().BlockedAddr(recipientAddr).Return(false)
+			},
+			malleate:  func(msg *types.MsgCreateBudget) { msg.StartTime = &pastTime },
+			expErrMsg: "cannot be less than the current block time",
+		},
+		{
+			name: "zero periods",
+			preRun: func() {
+				suite.bankKeeper.EXPECT().BlockedAddr(recipientAddr).Return(false)
+			},
+			malleate:  func(msg *types.MsgCreateBudget) { msg.TotalPeriods = 0 },
+			expErrMsg: "total periods cannot be zero",
+		},
+		{
+			name: "budget already exists",
+			preRun: func() {
+				suite.bankKeeper.EXPECT().BlockedAddr(recipientAddr).Return(false)
+				suite.Require().NoError(suite.poolKeeper.Budgets.Set(suite.ctx, recipientAddr, types.Budget{Recipient: recipientAddr.String()}))
+			},
+			expErrMsg: "budget already exists for recipient",
+		},
+		{
+			name: "valid budget",
+			preRun: func() {
+				suite.bankKeeper.EXPECT().BlockedAddr(recipientAddr).Return(false)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.ctx = suite.ctx.WithBlockTime(blockTime)
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			msg := validMsg()
+			if tc.malleate != nil {
+				tc.malleate(msg)
+			}
+
+			_, err := suite.msgServer.CreateBudget(suite.ctx, msg)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			budget, err := suite.poolKeeper.Budgets.Get(suite.ctx, recipientAddr)
+			suite.Require().NoError(err)
+			suite.Require().Equal(types.Budget{
+				Recipient:       recipientAddr.String(),
+				AmountPerPeriod: amountPerPeriod,
+				TotalPeriods:    12,
+				StartTime:       blockTime,
+				Period:          time.Hour,
+				CliffTime:       &cliffTime,
+			}, budget)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestClawbackBudget() {
+	amountPerPeriod := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	msg := &types.MsgClawbackBudget{
+		Authority: suite.poolKeeper.GetAuthority(),
+		Recipient: recipientAddr.String(),
+	}
+
+	_, err := suite.msgServer.ClawbackBudget(suite.ctx, msg)
+	suite.Require().ErrorContains(err, "does not exist")
+
+	err = suite.poolKeeper.Budgets.Set(suite.ctx, recipientAddr, types.Budget{
+		Recipient:       recipientAddr.String(),
+		AmountPerPeriod: amountPerPeriod,
+		TotalPeriods:    10,
+		ClaimedPeriods:  4,
+		Period:          time.Hour,
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.ClawbackBudget(suite.ctx, &types.MsgClawbackBudget{Authority: "invalid_auth", Recipient: msg.Recipient})
+	suite.Require().ErrorContains(err, "invalid authority address")
+
+	resp, err := suite.msgServer.ClawbackBudget(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(recipientAddr.String(), resp.Recipient)
+	suite.Require().Equal(amountPerPeriod.MulInt(math.NewInt(6)), resp.ClawedBackAmount)
+
+	has, err := suite.poolKeeper.Budgets.Has(suite.ctx, recipientAddr)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
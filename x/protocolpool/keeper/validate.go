@@ -30,6 +30,34 @@ func validateContinuousFund(ctx sdk.Context, msg types.MsgCreateContinuousFund)
 	return nil
 }
 
+// validateBudget validates the fields of the CreateBudget message and returns the budget it creates.
+func validateBudget(ctx sdk.Context, msg types.MsgCreateBudget) (types.Budget, error) {
+	currentTime := ctx.BlockTime()
+
+	startTime := currentTime
+	if msg.StartTime != nil {
+		// Validate start time
+		if msg.StartTime.Before(currentTime) {
+			return types.Budget{}, fmt.Errorf("start time %s cannot be less than the current block time %s", msg.StartTime, currentTime)
+		}
+		startTime = *msg.StartTime
+	}
+
+	budget := types.Budget{
+		Recipient:       msg.Recipient,
+		AmountPerPeriod: msg.AmountPerPeriod,
+		TotalPeriods:    msg.TotalPeriods,
+		StartTime:       startTime,
+		Period:          msg.Period,
+		CliffTime:       msg.CliffTime,
+	}
+	if err := budget.Validate(); err != nil {
+		return types.Budget{}, fmt.Errorf("invalid budget: %w", err)
+	}
+
+	return budget, nil
+}
+
 func validateAmount(amount sdk.Coins) error {
 	if amount == nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "amount cannot be nil")
@@ -15,6 +15,8 @@ func RegisterInterfaces(ir codectypes.InterfaceRegistry) {
 		&MsgCommunityPoolSpend{},
 		&MsgCreateContinuousFund{},
 		&MsgCancelContinuousFund{},
+		&MsgCreateBudget{},
+		&MsgClawbackBudget{},
 		&MsgUpdateParams{},
 	)
 
@@ -30,8 +32,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgCommunityPoolSpend{}, "cosmos-sdk/pp/MsgCommunityPoolSpend")
 	legacy.RegisterAminoMsg(cdc, &MsgCreateContinuousFund{}, "cosmos-sdk/pp/MsgCreateContinuousFund")
 	legacy.RegisterAminoMsg(cdc, &MsgCancelContinuousFund{}, "cosmos-sdk/pp/MsgCancelContinuousFund")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateBudget{}, "cosmos-sdk/pp/MsgCreateBudget")
+	legacy.RegisterAminoMsg(cdc, &MsgClawbackBudget{}, "cosmos-sdk/pp/MsgClawbackBudget")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/pp/MsgUpdateParams")
 
 	cdc.RegisterConcrete(&ContinuousFund{}, "cosmos-sdk/pp/ContinuousFund", nil)
+	cdc.RegisterConcrete(&Budget{}, "cosmos-sdk/pp/Budget", nil)
 	cdc.RegisterConcrete(&Params{}, "cosmos-sdk/pp/Params", nil)
 }
@@ -18,6 +18,7 @@ func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		ContinuousFunds: []ContinuousFund{},
 		Params:          DefaultParams(),
+		Budgets:         []Budget{},
 	}
 }
 
@@ -46,5 +47,17 @@ func (gs *GenesisState) Validate() error {
 		seenContinuousFunds[fund.Recipient] = struct{}{}
 	}
 
+	seenBudgets := make(map[string]struct{})
+	for _, budget := range gs.Budgets {
+		if err := budget.Validate(); err != nil {
+			return fmt.Errorf("invalid budget: %w", err)
+		}
+
+		if _, ok := seenBudgets[budget.Recipient]; ok {
+			return fmt.Errorf("duplicated budget recipient address: %s", budget.Recipient)
+		}
+		seenBudgets[budget.Recipient] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
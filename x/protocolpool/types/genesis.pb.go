@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -30,6 +31,8 @@ type GenesisState struct {
 	// Params defines the parameters of this module, currently only contains the
 	// denoms that will be used for continuous fund distributions.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// Budgets defines the budgets at genesis.
+	Budgets []Budget `protobuf:"bytes,3,rep,name=budgets,proto3" json:"budgets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +82,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetBudgets() []Budget {
+	if m != nil {
+		return m.Budgets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.protocolpool.v1.GenesisState")
 }
@@ -88,23 +98,26 @@ func init() {
 }
 
 var fileDescriptor_72560a99455b4146 = []byte{
-	// 244 bytes of a gzipped FileDescriptorProto
+	// 292 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0x29, 0xc8, 0xcf, 0xcf, 0xd1,
 	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x03, 0x8b, 0x0b, 0x89, 0x41,
 	0x54, 0xe9, 0x21, 0xab, 0xd2, 0x2b, 0x33, 0x94, 0x52, 0xc2, 0xa1, 0xbb, 0xa4, 0xb2, 0x20, 0x15,
-	0xaa, 0x5a, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0x20, 0xa2, 0x4a, 0x4b,
-	0x19, 0xb9, 0x78, 0xdc, 0x21, 0x76, 0x04, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x85, 0x73, 0x09, 0x24,
-	0xe7, 0xe7, 0x95, 0x64, 0xe6, 0x95, 0xe6, 0x97, 0x16, 0xc7, 0xa7, 0x95, 0xe6, 0xa5, 0x14, 0x4b,
-	0x30, 0x2a, 0x30, 0x6b, 0x70, 0x1b, 0xa9, 0xe9, 0x61, 0xb7, 0x5d, 0xcf, 0x19, 0xae, 0xde, 0xad,
-	0x34, 0x2f, 0xc5, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xfe, 0x64, 0x14, 0xd1, 0x62, 0x21,
-	0x1b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23,
-	0x39, 0x5c, 0xc6, 0x05, 0x80, 0x55, 0x41, 0x8d, 0x81, 0xea, 0x71, 0xf2, 0x3e, 0xf1, 0x48, 0x8e,
-	0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xc3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4,
-	0xfc, 0x5c, 0x7d, 0x68, 0x30, 0x40, 0x28, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x0a, 0xd4, 0x30, 0x01,
-	0x07, 0x48, 0x12, 0x1b, 0x58, 0xcc, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x0a, 0xb2, 0x9f, 0x6f,
-	0x75, 0x01, 0x00, 0x00,
+	0xaa, 0x5a, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0xa0, 0xa2, 0x92, 0x10,
+	0x9d, 0xf1, 0x10, 0x09, 0x64, 0xe3, 0x95, 0x7e, 0x30, 0x72, 0xf1, 0xb8, 0x43, 0xac, 0x0f, 0x2e,
+	0x49, 0x2c, 0x49, 0x15, 0x0a, 0xe7, 0x12, 0x48, 0xce, 0xcf, 0x2b, 0xc9, 0xcc, 0x2b, 0xcd, 0x2f,
+	0x2d, 0x8e, 0x4f, 0x2b, 0xcd, 0x4b, 0x29, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xd3,
+	0xc3, 0xee, 0x30, 0x3d, 0x67, 0xb8, 0x7a, 0xb7, 0xd2, 0xbc, 0x14, 0x27, 0x96, 0x13, 0xf7, 0xe4,
+	0x19, 0x82, 0xf8, 0x93, 0x51, 0x44, 0x8b, 0x85, 0x6c, 0xb8, 0xd8, 0x0a, 0x12, 0x8b, 0x12, 0x73,
+	0x8b, 0x25, 0x98, 0x14, 0x18, 0x35, 0xb8, 0x8d, 0xe4, 0x70, 0x19, 0x17, 0x00, 0x56, 0x05, 0x35,
+	0x06, 0xaa, 0x47, 0x28, 0x90, 0x8b, 0x3d, 0xa9, 0x34, 0x25, 0x3d, 0xb5, 0xa4, 0x58, 0x82, 0x19,
+	0xec, 0x1a, 0x9c, 0xda, 0x9d, 0xc0, 0xca, 0x9c, 0xc4, 0x41, 0xda, 0x6f, 0x6d, 0xd1, 0xe5, 0x87,
+	0x28, 0xd3, 0x2d, 0x4e, 0xc9, 0x56, 0x30, 0xd0, 0x33, 0x35, 0x09, 0x82, 0x99, 0xe3, 0xe4, 0x7d,
+	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
+	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x86, 0xe9, 0x99, 0x25, 0x19, 0xa5,
+	0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xd0, 0xd0, 0xd2, 0x47, 0x98, 0xa2, 0x5f, 0x81, 0x1a, 0x03, 0xe0,
+	0xe0, 0x4f, 0x62, 0x03, 0x8b, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xed, 0x03, 0x77, 0xb6,
+	0xe3, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +140,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Budgets) > 0 {
+		for iNdEx := len(m.Budgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Budgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -179,6 +206,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Budgets) > 0 {
+		for _, e := range m.Budgets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -284,6 +317,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budgets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budgets = append(m.Budgets, Budget{})
+			if err := m.Budgets[len(m.Budgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,11 +2,13 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
 )
 
@@ -126,6 +128,43 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expectedErr: "duplicated continuous fund recipient address",
 		},
+		{
+			name: "invalid genesis state with duplicate budget recipients",
+			genesisState: &types.GenesisState{
+				ContinuousFunds: []types.ContinuousFund{},
+				Budgets: []types.Budget{
+					{
+						Recipient:       "cosmos1dupaddress",
+						AmountPerPeriod: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+						TotalPeriods:    10,
+						Period:          time.Hour,
+					},
+					{
+						Recipient:       "cosmos1dupaddress", // duplicate
+						AmountPerPeriod: sdk.NewCoins(sdk.NewInt64Coin("stake", 200)),
+						TotalPeriods:    5,
+						Period:          time.Hour,
+					},
+				},
+				Params: types.DefaultParams(),
+			},
+			expectedErr: "duplicated budget recipient address",
+		},
+		{
+			name: "invalid genesis state with budget (zero periods)",
+			genesisState: &types.GenesisState{
+				ContinuousFunds: []types.ContinuousFund{},
+				Budgets: []types.Budget{
+					{
+						Recipient:       "cosmos1validaddress",
+						AmountPerPeriod: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+						Period:          time.Hour,
+					},
+				},
+				Params: types.DefaultParams(),
+			},
+			expectedErr: "total periods cannot be zero",
+		},
 	}
 
 	for _, tc := range tests {
@@ -13,6 +13,7 @@ const (
 	// - all leftover funds that are not allocated to continuous funds in the BeginBlocker from ProtocolPoolEscrowAccount
 	// Distributes to:
 	// - users on MsgCommunityPoolSpend
+	// - Budgets in the store in the BeginBlocker
 	ModuleName = "protocolpool"
 
 	// ProtocolPoolEscrowAccount is an intermediary account that holds the funds to be distributed to other accounts.
@@ -32,4 +33,5 @@ const (
 var (
 	ContinuousFundsKey = collections.NewPrefix(3)
 	ParamsKey          = collections.NewPrefix(8)
+	BudgetsKey         = collections.NewPrefix(9)
 )
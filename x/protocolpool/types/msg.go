@@ -10,6 +10,8 @@ var (
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgCreateContinuousFund)(nil)
 	_ sdk.Msg = (*MsgCancelContinuousFund)(nil)
+	_ sdk.Msg = (*MsgCreateBudget)(nil)
+	_ sdk.Msg = (*MsgClawbackBudget)(nil)
 )
 
 // NewMsgFundCommunityPool returns a new MsgFundCommunityPool with a sender and
@@ -296,6 +296,199 @@ func (m *QueryContinuousFundsResponse) GetContinuousFunds() []ContinuousFund {
 	return nil
 }
 
+// QueryBudgetRequest is the request type for the Query/Budget RPC method.
+type QueryBudgetRequest struct {
+	// recipient is the recipient address to query the budget for.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *QueryBudgetRequest) Reset()         { *m = QueryBudgetRequest{} }
+func (m *QueryBudgetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBudgetRequest) ProtoMessage()    {}
+func (*QueryBudgetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{6}
+}
+func (m *QueryBudgetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBudgetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBudgetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBudgetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBudgetRequest.Merge(m, src)
+}
+func (m *QueryBudgetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBudgetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBudgetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBudgetRequest proto.InternalMessageInfo
+
+func (m *QueryBudgetRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// QueryBudgetResponse is the response type for the Query/Budget RPC method.
+type QueryBudgetResponse struct {
+	// budget is the budget of the recipient.
+	Budget Budget `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget"`
+	// claimed_amount is the amount that has been paid out.
+	ClaimedAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=claimed_amount,json=claimedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed_amount"`
+	// remaining_amount is the amount that has not been paid out yet.
+	RemainingAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=remaining_amount,json=remainingAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining_amount"`
+}
+
+func (m *QueryBudgetResponse) Reset()         { *m = QueryBudgetResponse{} }
+func (m *QueryBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBudgetResponse) ProtoMessage()    {}
+func (*QueryBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{7}
+}
+func (m *QueryBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBudgetResponse.Merge(m, src)
+}
+func (m *QueryBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBudgetResponse proto.InternalMessageInfo
+
+func (m *QueryBudgetResponse) GetBudget() Budget {
+	if m != nil {
+		return m.Budget
+	}
+	return Budget{}
+}
+
+func (m *QueryBudgetResponse) GetClaimedAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClaimedAmount
+	}
+	return nil
+}
+
+func (m *QueryBudgetResponse) GetRemainingAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RemainingAmount
+	}
+	return nil
+}
+
+// QueryBudgetsRequest is the request type for the Query/Budgets RPC method.
+type QueryBudgetsRequest struct {
+}
+
+func (m *QueryBudgetsRequest) Reset()         { *m = QueryBudgetsRequest{} }
+func (m *QueryBudgetsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBudgetsRequest) ProtoMessage()    {}
+func (*QueryBudgetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{8}
+}
+func (m *QueryBudgetsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBudgetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBudgetsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBudgetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBudgetsRequest.Merge(m, src)
+}
+func (m *QueryBudgetsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBudgetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBudgetsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBudgetsRequest proto.InternalMessageInfo
+
+// QueryBudgetsResponse is the response type for the Query/Budgets RPC method.
+type QueryBudgetsResponse struct {
+	// budgets defines all budgets in state.
+	Budgets []Budget `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets"`
+}
+
+func (m *QueryBudgetsResponse) Reset()         { *m = QueryBudgetsResponse{} }
+func (m *QueryBudgetsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBudgetsResponse) ProtoMessage()    {}
+func (*QueryBudgetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{9}
+}
+func (m *QueryBudgetsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBudgetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBudgetsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBudgetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBudgetsResponse.Merge(m, src)
+}
+func (m *QueryBudgetsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBudgetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBudgetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBudgetsResponse proto.InternalMessageInfo
+
+func (m *QueryBudgetsResponse) GetBudgets() []Budget {
+	if m != nil {
+		return m.Budgets
+	}
+	return nil
+}
+
 // QueryParamsRequest is the response type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -304,7 +497,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51500a0a77d57843, []int{6}
+	return fileDescriptor_51500a0a77d57843, []int{10}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -342,7 +535,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51500a0a77d57843, []int{7}
+	return fileDescriptor_51500a0a77d57843, []int{11}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,6 +578,10 @@ func init() {
 	proto.RegisterType((*QueryContinuousFundResponse)(nil), "cosmos.protocolpool.v1.QueryContinuousFundResponse")
 	proto.RegisterType((*QueryContinuousFundsRequest)(nil), "cosmos.protocolpool.v1.QueryContinuousFundsRequest")
 	proto.RegisterType((*QueryContinuousFundsResponse)(nil), "cosmos.protocolpool.v1.QueryContinuousFundsResponse")
+	proto.RegisterType((*QueryBudgetRequest)(nil), "cosmos.protocolpool.v1.QueryBudgetRequest")
+	proto.RegisterType((*QueryBudgetResponse)(nil), "cosmos.protocolpool.v1.QueryBudgetResponse")
+	proto.RegisterType((*QueryBudgetsRequest)(nil), "cosmos.protocolpool.v1.QueryBudgetsRequest")
+	proto.RegisterType((*QueryBudgetsResponse)(nil), "cosmos.protocolpool.v1.QueryBudgetsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.protocolpool.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.protocolpool.v1.QueryParamsResponse")
 }
@@ -394,44 +591,56 @@ func init() {
 }
 
 var fileDescriptor_51500a0a77d57843 = []byte{
-	// 588 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x63, 0x68, 0x2b, 0xf5, 0x2a, 0x5a, 0x74, 0x54, 0x28, 0x71, 0x8b, 0x1b, 0x79, 0x88,
-	0xa2, 0xa2, 0xda, 0x75, 0x5a, 0x75, 0xea, 0x42, 0x2a, 0xb1, 0xb0, 0x94, 0x14, 0x84, 0xc4, 0x12,
-	0x39, 0xce, 0x61, 0x2c, 0x92, 0x7b, 0x5d, 0xdf, 0x39, 0x10, 0xa1, 0x2e, 0x6c, 0x6c, 0x48, 0x7c,
-	0x07, 0x90, 0x18, 0x98, 0x90, 0xf8, 0x0a, 0x1d, 0x2b, 0x58, 0x98, 0x00, 0x25, 0x7c, 0x10, 0xe4,
-	0xf3, 0x9b, 0x50, 0x57, 0x76, 0x69, 0xa6, 0x44, 0xef, 0x9f, 0xe7, 0xf9, 0xdd, 0xe5, 0xc9, 0x11,
-	0xd3, 0x03, 0xd1, 0x07, 0x61, 0x87, 0x11, 0x48, 0xf0, 0xa0, 0x17, 0x02, 0xf4, 0xec, 0x81, 0x63,
-	0x1f, 0xc7, 0x2c, 0x1a, 0x5a, 0xaa, 0x4a, 0x6f, 0xa7, 0x33, 0xd6, 0xf9, 0x19, 0x6b, 0xe0, 0xe8,
-	0xab, 0x3e, 0xf8, 0xa0, 0x8a, 0x76, 0xf2, 0x2d, 0xed, 0xeb, 0xeb, 0x3e, 0x80, 0xdf, 0x63, 0xb6,
-	0x1b, 0x06, 0xb6, 0xcb, 0x39, 0x48, 0x57, 0x06, 0xc0, 0x71, 0x5b, 0x37, 0xd0, 0xaf, 0xe3, 0x0a,
-	0x66, 0x0f, 0x9c, 0x0e, 0x93, 0xae, 0x63, 0x7b, 0x10, 0x70, 0xec, 0x57, 0xd2, 0x7e, 0x3b, 0x95,
-	0x3d, 0x6f, 0xac, 0x17, 0xa1, 0xca, 0x61, 0xc8, 0x70, 0xc6, 0x5c, 0x23, 0x95, 0x87, 0x09, 0xf9,
-	0x01, 0xf4, 0xfb, 0x31, 0x0f, 0xe4, 0xf0, 0x10, 0xa0, 0xd7, 0x62, 0xc7, 0x31, 0x13, 0xd2, 0x3c,
-	0x21, 0x7a, 0x5e, 0x53, 0x84, 0xc0, 0x05, 0xa3, 0x6d, 0x32, 0x97, 0x28, 0x96, 0xb5, 0xea, 0xf5,
-	0xfa, 0x52, 0xa3, 0x62, 0xa1, 0x77, 0x02, 0x6a, 0x21, 0xa8, 0x75, 0x00, 0x01, 0x6f, 0x6e, 0x9f,
-	0xfe, 0xdc, 0x28, 0x7d, 0xfa, 0xb5, 0x51, 0xf7, 0x03, 0xf9, 0x3c, 0xee, 0x58, 0x1e, 0xf4, 0x11,
-	0x14, 0x3f, 0xb6, 0x44, 0xf7, 0x05, 0x52, 0x25, 0x0b, 0xa2, 0xa5, 0x84, 0xcd, 0x47, 0x53, 0x7b,
-	0x2e, 0x03, 0x1e, 0x43, 0x2c, 0xee, 0xc7, 0xbc, 0x8b, 0x70, 0x74, 0x8f, 0x2c, 0x46, 0xcc, 0x0b,
-	0xc2, 0x80, 0x71, 0x59, 0xd6, 0xaa, 0x5a, 0x7d, 0xb1, 0x59, 0xfe, 0xf6, 0x65, 0x6b, 0x15, 0x31,
-	0xee, 0x75, 0xbb, 0x11, 0x13, 0xe2, 0x48, 0x46, 0x01, 0xf7, 0x5b, 0xff, 0x46, 0x4d, 0x49, 0xd6,
-	0x72, 0x55, 0xf1, 0x54, 0x8f, 0xc9, 0x8a, 0x37, 0xed, 0xb4, 0x9f, 0xc5, 0xbc, 0xab, 0xc4, 0x97,
-	0x1a, 0x35, 0x2b, 0xff, 0x57, 0xb5, 0xb2, 0x42, 0xcd, 0xb9, 0xe4, 0xb4, 0xad, 0x65, 0x2f, 0x53,
-	0x35, 0xef, 0xe4, 0xba, 0x8a, 0xc9, 0x4d, 0xbf, 0x24, 0xeb, 0xf9, 0x6d, 0xa4, 0x7a, 0x42, 0x6e,
-	0x5e, 0xa0, 0x12, 0x78, 0xef, 0xb3, 0x61, 0xad, 0x64, 0xb1, 0x84, 0xb9, 0x4a, 0xa8, 0x32, 0x3e,
-	0x74, 0x23, 0xb7, 0x3f, 0xc5, 0x39, 0x22, 0xb7, 0x32, 0x55, 0xa4, 0xd8, 0x27, 0x0b, 0xa1, 0xaa,
-	0xe0, 0x95, 0x18, 0x45, 0xde, 0xe9, 0x1e, 0x7a, 0xe2, 0x4e, 0xe3, 0xe3, 0x3c, 0x99, 0x57, 0xaa,
-	0xf4, 0x83, 0x46, 0x6e, 0x64, 0x32, 0x45, 0x9d, 0x22, 0xa5, 0xc2, 0x70, 0xea, 0x8d, 0x59, 0x56,
-	0xd2, 0x03, 0x98, 0xd6, 0x9b, 0xef, 0x7f, 0xde, 0x5f, 0xab, 0xd3, 0x9a, 0x5d, 0xf0, 0xd7, 0xf0,
-	0x26, 0x6b, 0xed, 0xa4, 0x42, 0xbf, 0x6a, 0x64, 0x39, 0x7b, 0x8f, 0xf4, 0x7f, 0xb6, 0x39, 0x51,
-	0xd5, 0x77, 0x66, 0xda, 0x41, 0xd6, 0x7d, 0xc5, 0xba, 0x47, 0x77, 0x8b, 0x59, 0xb3, 0x81, 0xb0,
-	0x5f, 0x4f, 0x43, 0x7e, 0x42, 0x3f, 0x6b, 0x64, 0xe5, 0x42, 0x98, 0xe8, 0x2c, 0x18, 0x93, 0x28,
-	0xe8, 0xbb, 0xb3, 0x2d, 0x21, 0xfc, 0xb6, 0x82, 0xdf, 0xa4, 0xf5, 0xab, 0xc2, 0xd3, 0xb7, 0x1a,
-	0x59, 0x48, 0x63, 0x43, 0x37, 0x2f, 0xb5, 0xcc, 0x24, 0x55, 0xbf, 0x7b, 0xa5, 0x59, 0xa4, 0xaa,
-	0x29, 0xaa, 0x2a, 0x35, 0x8a, 0xa8, 0xd2, 0xa4, 0x36, 0x1f, 0x9c, 0x8e, 0x0c, 0xed, 0x6c, 0x64,
-	0x68, 0xbf, 0x47, 0x86, 0xf6, 0x6e, 0x6c, 0x94, 0xce, 0xc6, 0x46, 0xe9, 0xc7, 0xd8, 0x28, 0x3d,
-	0x75, 0x2e, 0x7d, 0xc2, 0x5e, 0x65, 0x05, 0xd5, 0x8b, 0xd6, 0x59, 0x50, 0xb5, 0x9d, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xdb, 0x06, 0x4d, 0xb9, 0x39, 0x06, 0x00, 0x00,
+	// 776 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x4f, 0xdb, 0x4a,
+	0x10, 0xc7, 0x63, 0xe0, 0x05, 0xb1, 0x08, 0x82, 0x96, 0xe8, 0x29, 0x18, 0x9e, 0xc9, 0xf3, 0x01,
+	0x45, 0xd0, 0xd8, 0x24, 0x50, 0x0e, 0x08, 0x55, 0x22, 0x48, 0x5c, 0x7a, 0xa1, 0xa1, 0x55, 0xa5,
+	0x5e, 0x22, 0xc7, 0x71, 0x5d, 0x8b, 0x78, 0x37, 0xd8, 0xeb, 0xb4, 0xa8, 0xe2, 0xd2, 0x5b, 0x6f,
+	0x95, 0xfa, 0x05, 0x7a, 0xaa, 0xd4, 0x1e, 0x7a, 0x42, 0xea, 0x57, 0x40, 0x3d, 0x21, 0xda, 0x43,
+	0x4f, 0x6d, 0x05, 0xfd, 0x0c, 0x3d, 0x57, 0x59, 0x8f, 0x03, 0x4b, 0x6d, 0x92, 0xa8, 0x9c, 0x12,
+	0xcd, 0xce, 0xcc, 0xff, 0x37, 0xe3, 0x99, 0x41, 0xaa, 0x49, 0x7d, 0x97, 0xfa, 0x7a, 0xcb, 0xa3,
+	0x8c, 0x9a, 0xb4, 0xd9, 0xa2, 0xb4, 0xa9, 0xb7, 0x4b, 0xfa, 0x7e, 0x60, 0x79, 0x07, 0x1a, 0xb7,
+	0xe2, 0x7f, 0x43, 0x1f, 0xed, 0xb2, 0x8f, 0xd6, 0x2e, 0xc9, 0x59, 0x9b, 0xda, 0x94, 0x1b, 0xf5,
+	0xce, 0xbf, 0xf0, 0x5d, 0x9e, 0xb3, 0x29, 0xb5, 0x9b, 0x96, 0x6e, 0xb4, 0x1c, 0xdd, 0x20, 0x84,
+	0x32, 0x83, 0x39, 0x94, 0x40, 0xb4, 0xac, 0x80, 0x5e, 0xdd, 0xf0, 0x2d, 0xbd, 0x5d, 0xaa, 0x5b,
+	0xcc, 0x28, 0xe9, 0x26, 0x75, 0x08, 0xbc, 0xcf, 0x84, 0xef, 0xb5, 0x30, 0xed, 0x65, 0x61, 0x39,
+	0x09, 0x95, 0x1d, 0xb4, 0x2c, 0xf0, 0x51, 0x67, 0xd1, 0xcc, 0xbd, 0x0e, 0xf9, 0x16, 0x75, 0xdd,
+	0x80, 0x38, 0xec, 0x60, 0x87, 0xd2, 0x66, 0xd5, 0xda, 0x0f, 0x2c, 0x9f, 0xa9, 0x87, 0x48, 0x8e,
+	0x7b, 0xf4, 0x5b, 0x94, 0xf8, 0x16, 0xae, 0xa1, 0x91, 0x4e, 0xc6, 0x9c, 0x94, 0x1f, 0x2e, 0x8c,
+	0x97, 0x67, 0x34, 0xd0, 0xee, 0x80, 0x6a, 0x00, 0xaa, 0x6d, 0x51, 0x87, 0x54, 0x96, 0x8f, 0xbf,
+	0xcd, 0xa7, 0xde, 0x7f, 0x9f, 0x2f, 0xd8, 0x0e, 0x7b, 0x12, 0xd4, 0x35, 0x93, 0xba, 0x00, 0x0a,
+	0x3f, 0x45, 0xbf, 0xb1, 0x07, 0x54, 0x9d, 0x00, 0xbf, 0xca, 0x13, 0xab, 0xf7, 0xbb, 0xf2, 0x84,
+	0x39, 0x24, 0xa0, 0x81, 0xbf, 0x1d, 0x90, 0x06, 0xc0, 0xe1, 0x35, 0x34, 0xe6, 0x59, 0xa6, 0xd3,
+	0x72, 0x2c, 0xc2, 0x72, 0x52, 0x5e, 0x2a, 0x8c, 0x55, 0x72, 0xa7, 0x47, 0xc5, 0x2c, 0x60, 0x6c,
+	0x36, 0x1a, 0x9e, 0xe5, 0xfb, 0xbb, 0xcc, 0x73, 0x88, 0x5d, 0xbd, 0x70, 0x55, 0x19, 0x9a, 0x8d,
+	0xcd, 0x0a, 0x55, 0x3d, 0x40, 0x19, 0xb3, 0xfb, 0x52, 0x7b, 0x1c, 0x90, 0x06, 0x4f, 0x3e, 0x5e,
+	0x5e, 0xd0, 0xe2, 0xbf, 0xaa, 0x26, 0x26, 0xaa, 0x8c, 0x74, 0xaa, 0xad, 0x4e, 0x9a, 0x82, 0x55,
+	0xfd, 0x2f, 0x56, 0xd5, 0x8f, 0x3a, 0xfd, 0x14, 0xcd, 0xc5, 0x3f, 0x03, 0xd5, 0x43, 0x34, 0x75,
+	0x85, 0xca, 0x87, 0xbe, 0x0f, 0x86, 0x95, 0x11, 0xb1, 0x7c, 0xd5, 0x40, 0x98, 0x0b, 0x57, 0x82,
+	0x86, 0x6d, 0xb1, 0xbf, 0xec, 0xed, 0xfa, 0xf4, 0xe9, 0x51, 0x31, 0x73, 0xf1, 0x4d, 0xf3, 0xcb,
+	0xda, 0xed, 0x55, 0xf5, 0xcb, 0x10, 0x9a, 0x16, 0x34, 0xa0, 0xa6, 0x0d, 0x94, 0xae, 0x73, 0x0b,
+	0x34, 0x58, 0x49, 0xaa, 0x24, 0x8c, 0x83, 0x0a, 0x20, 0x06, 0x7b, 0x68, 0xd2, 0x6c, 0x1a, 0x8e,
+	0x6b, 0x35, 0x6a, 0x86, 0x4b, 0x03, 0xc2, 0x72, 0x43, 0x37, 0x3f, 0x87, 0x13, 0x20, 0xb1, 0xc9,
+	0x15, 0x70, 0x1b, 0x4d, 0x79, 0x96, 0x6b, 0x38, 0xc4, 0x21, 0x76, 0xa4, 0x3a, 0x7c, 0xf3, 0xaa,
+	0x99, 0xae, 0x48, 0xa8, 0x1b, 0xdf, 0xd6, 0x45, 0xa1, 0xab, 0xd1, 0x24, 0xc5, 0xfb, 0xee, 0xa1,
+	0xac, 0xe8, 0x0b, 0x9f, 0xe0, 0x0e, 0x1a, 0x0d, 0xdb, 0x19, 0x4d, 0x53, 0x7f, 0xdf, 0x20, 0x0a,
+	0x8a, 0x17, 0xcb, 0xc2, 0x48, 0xed, 0x18, 0x9e, 0xe1, 0x76, 0x27, 0x7c, 0x17, 0x70, 0x23, 0xeb,
+	0xc5, 0x10, 0xb4, 0xb8, 0xa5, 0xd7, 0x10, 0x84, 0x71, 0xd1, 0x10, 0x84, 0x31, 0xe5, 0x5f, 0xa3,
+	0xe8, 0x1f, 0x9e, 0x15, 0xbf, 0x95, 0xd0, 0x84, 0x70, 0xa6, 0x70, 0x29, 0x29, 0x53, 0xe2, 0xbd,
+	0x93, 0xcb, 0x83, 0x84, 0x84, 0x05, 0xa8, 0xda, 0x8b, 0xcf, 0x3f, 0x5f, 0x0f, 0x15, 0xf0, 0x82,
+	0x9e, 0x70, 0x6d, 0xcd, 0x28, 0xac, 0xd6, 0xb1, 0xe0, 0x8f, 0x12, 0x9a, 0x14, 0x57, 0x13, 0xf7,
+	0x92, 0x8d, 0xb9, 0x7e, 0xf2, 0xca, 0x40, 0x31, 0xc0, 0xba, 0xc1, 0x59, 0xd7, 0xf0, 0x6a, 0x32,
+	0xab, 0x78, 0x63, 0xf4, 0xe7, 0xdd, 0xdd, 0x3e, 0xc4, 0x1f, 0x24, 0x94, 0xb9, 0x72, 0x9f, 0xf0,
+	0x20, 0x18, 0xd1, 0x28, 0xc8, 0xab, 0x83, 0x05, 0x01, 0xfc, 0x32, 0x87, 0x5f, 0xc4, 0x85, 0x7e,
+	0xe1, 0xf1, 0x3b, 0x09, 0xa5, 0xc3, 0xb9, 0xc5, 0x8b, 0xd7, 0x4a, 0x0a, 0xc7, 0x4f, 0x5e, 0xea,
+	0xcb, 0x17, 0xa8, 0xb6, 0x3f, 0xfd, 0xb9, 0x01, 0x1c, 0xb4, 0x88, 0x97, 0x92, 0x40, 0x61, 0x7b,
+	0x84, 0xe6, 0xbe, 0x91, 0xd0, 0x28, 0x6c, 0x27, 0xee, 0x07, 0xa0, 0xdb, 0xcc, 0x5b, 0xfd, 0x39,
+	0x03, 0xee, 0x7a, 0x12, 0xee, 0xff, 0x78, 0xbe, 0x07, 0x2e, 0x7e, 0x29, 0xa1, 0x74, 0xb8, 0x85,
+	0x3d, 0xda, 0x29, 0x2c, 0x7e, 0x8f, 0x76, 0x8a, 0xe7, 0x40, 0x5d, 0xe0, 0x30, 0x79, 0xac, 0x24,
+	0xc1, 0x84, 0x8b, 0x5f, 0xb9, 0x7b, 0x7c, 0xa6, 0x48, 0x27, 0x67, 0x8a, 0xf4, 0xe3, 0x4c, 0x91,
+	0x5e, 0x9d, 0x2b, 0xa9, 0x93, 0x73, 0x25, 0xf5, 0xf5, 0x5c, 0x49, 0x3d, 0x2a, 0x5d, 0x7b, 0x66,
+	0x9f, 0x89, 0x09, 0xf9, 0xd5, 0xad, 0xa7, 0xb9, 0x6d, 0xe5, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0xf6, 0x93, 0xcf, 0x8a, 0xdb, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -452,6 +661,10 @@ type QueryClient interface {
 	ContinuousFund(ctx context.Context, in *QueryContinuousFundRequest, opts ...grpc.CallOption) (*QueryContinuousFundResponse, error)
 	// ContinuousFunds queries all continuous funds in the store.
 	ContinuousFunds(ctx context.Context, in *QueryContinuousFundsRequest, opts ...grpc.CallOption) (*QueryContinuousFundsResponse, error)
+	// Budget queries a budget by the recipient it is associated with.
+	Budget(ctx context.Context, in *QueryBudgetRequest, opts ...grpc.CallOption) (*QueryBudgetResponse, error)
+	// Budgets queries all budgets in the store.
+	Budgets(ctx context.Context, in *QueryBudgetsRequest, opts ...grpc.CallOption) (*QueryBudgetsResponse, error)
 	// Params returns the total set of x/protocolpool parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -491,6 +704,24 @@ func (c *queryClient) ContinuousFunds(ctx context.Context, in *QueryContinuousFu
 	return out, nil
 }
 
+func (c *queryClient) Budget(ctx context.Context, in *QueryBudgetRequest, opts ...grpc.CallOption) (*QueryBudgetResponse, error) {
+	out := new(QueryBudgetResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Query/Budget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Budgets(ctx context.Context, in *QueryBudgetsRequest, opts ...grpc.CallOption) (*QueryBudgetsResponse, error) {
+	out := new(QueryBudgetsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Query/Budgets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Query/Params", in, out, opts...)
@@ -508,6 +739,10 @@ type QueryServer interface {
 	ContinuousFund(context.Context, *QueryContinuousFundRequest) (*QueryContinuousFundResponse, error)
 	// ContinuousFunds queries all continuous funds in the store.
 	ContinuousFunds(context.Context, *QueryContinuousFundsRequest) (*QueryContinuousFundsResponse, error)
+	// Budget queries a budget by the recipient it is associated with.
+	Budget(context.Context, *QueryBudgetRequest) (*QueryBudgetResponse, error)
+	// Budgets queries all budgets in the store.
+	Budgets(context.Context, *QueryBudgetsRequest) (*QueryBudgetsResponse, error)
 	// Params returns the total set of x/protocolpool parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -525,6 +760,12 @@ func (*UnimplementedQueryServer) ContinuousFund(ctx context.Context, req *QueryC
 func (*UnimplementedQueryServer) ContinuousFunds(ctx context.Context, req *QueryContinuousFundsRequest) (*QueryContinuousFundsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ContinuousFunds not implemented")
 }
+func (*UnimplementedQueryServer) Budget(ctx context.Context, req *QueryBudgetRequest) (*QueryBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Budget not implemented")
+}
+func (*UnimplementedQueryServer) Budgets(ctx context.Context, req *QueryBudgetsRequest) (*QueryBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Budgets not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -587,6 +828,42 @@ func _Query_ContinuousFunds_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Budget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Budget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Query/Budget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Budget(ctx, req.(*QueryBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Budgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Budgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Query/Budgets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Budgets(ctx, req.(*QueryBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -622,6 +899,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ContinuousFunds",
 			Handler:    _Query_ContinuousFunds_Handler,
 		},
+		{
+			MethodName: "Budget",
+			Handler:    _Query_Budget_Handler,
+		},
+		{
+			MethodName: "Budgets",
+			Handler:    _Query_Budgets_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -814,7 +1099,7 @@ func (m *QueryContinuousFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryBudgetRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -824,20 +1109,27 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBudgetRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBudgetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBudgetResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -847,18 +1139,46 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.RemainingAmount) > 0 {
+		for iNdEx := len(m.RemainingAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ClaimedAmount) > 0 {
+		for iNdEx := len(m.ClaimedAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Budget.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -870,45 +1190,161 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBudgetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryCommunityPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryBudgetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBudgetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCommunityPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBudgetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBudgetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBudgetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pool) > 0 {
-		for _, e := range m.Pool {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Budgets) > 0 {
+		for iNdEx := len(m.Budgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Budgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryContinuousFundRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCommunityPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCommunityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for _, e := range m.Pool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryContinuousFundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.Recipient)
@@ -953,6 +1389,66 @@ func (m *QueryContinuousFundsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBudgetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Budget.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClaimedAmount) > 0 {
+		for _, e := range m.ClaimedAmount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RemainingAmount) > 0 {
+		for _, e := range m.RemainingAmount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBudgetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBudgetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Budgets) > 0 {
+		for _, e := range m.Budgets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1412,6 +1908,373 @@ func (m *QueryContinuousFundsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBudgetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBudgetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBudgetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Budget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimedAmount = append(m.ClaimedAmount, types.Coin{})
+			if err := m.ClaimedAmount[len(m.ClaimedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingAmount = append(m.RemainingAmount, types.Coin{})
+			if err := m.RemainingAmount[len(m.RemainingAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBudgetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBudgetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBudgetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBudgetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBudgetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBudgetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budgets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budgets = append(m.Budgets, Budget{})
+			if err := m.Budgets[len(m.Budgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
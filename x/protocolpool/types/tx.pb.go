@@ -18,6 +18,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -440,6 +441,255 @@ func (m *MsgCancelContinuousFundResponse) GetRecipient() string {
 	return ""
 }
 
+// MsgCreateBudget defines a message for creating a budget.
+type MsgCreateBudget struct {
+	// Authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Recipient address of the account receiving funds.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// AmountPerPeriod is the amount paid out for each elapsed period.
+	AmountPerPeriod github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount_per_period,json=amountPerPeriod,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount_per_period"`
+	// TotalPeriods is the number of periods the budget is paid out over.
+	TotalPeriods uint64 `protobuf:"varint,4,opt,name=total_periods,json=totalPeriods,proto3" json:"total_periods,omitempty"`
+	// Optional, the time the first period starts at. Defaults to the block time.
+	StartTime *time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// Period is the duration of a single period.
+	Period time.Duration `protobuf:"bytes,6,opt,name=period,proto3,stdduration" json:"period"`
+	// Optional, if cliff_time is set, no funds are paid out before it.
+	CliffTime *time.Time `protobuf:"bytes,7,opt,name=cliff_time,json=cliffTime,proto3,stdtime" json:"cliff_time,omitempty"`
+}
+
+func (m *MsgCreateBudget) Reset()         { *m = MsgCreateBudget{} }
+func (m *MsgCreateBudget) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateBudget) ProtoMessage()    {}
+func (*MsgCreateBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{8}
+}
+func (m *MsgCreateBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateBudget.Merge(m, src)
+}
+func (m *MsgCreateBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateBudget proto.InternalMessageInfo
+
+func (m *MsgCreateBudget) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCreateBudget) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MsgCreateBudget) GetAmountPerPeriod() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AmountPerPeriod
+	}
+	return nil
+}
+
+func (m *MsgCreateBudget) GetTotalPeriods() uint64 {
+	if m != nil {
+		return m.TotalPeriods
+	}
+	return 0
+}
+
+func (m *MsgCreateBudget) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *MsgCreateBudget) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *MsgCreateBudget) GetCliffTime() *time.Time {
+	if m != nil {
+		return m.CliffTime
+	}
+	return nil
+}
+
+// MsgCreateBudgetResponse defines the response to executing a
+// MsgCreateBudget message.
+type MsgCreateBudgetResponse struct {
+}
+
+func (m *MsgCreateBudgetResponse) Reset()         { *m = MsgCreateBudgetResponse{} }
+func (m *MsgCreateBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateBudgetResponse) ProtoMessage()    {}
+func (*MsgCreateBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{9}
+}
+func (m *MsgCreateBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateBudgetResponse.Merge(m, src)
+}
+func (m *MsgCreateBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateBudgetResponse proto.InternalMessageInfo
+
+// MsgClawbackBudget defines a message to cancel the budget of a specific recipient.
+type MsgClawbackBudget struct {
+	// Authority is the account address of authority.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Recipient is the account address string of the recipient whose budget is cancelled.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *MsgClawbackBudget) Reset()         { *m = MsgClawbackBudget{} }
+func (m *MsgClawbackBudget) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackBudget) ProtoMessage()    {}
+func (*MsgClawbackBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{10}
+}
+func (m *MsgClawbackBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackBudget.Merge(m, src)
+}
+func (m *MsgClawbackBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackBudget proto.InternalMessageInfo
+
+func (m *MsgClawbackBudget) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgClawbackBudget) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// MsgClawbackBudgetResponse defines the response to executing a
+// MsgClawbackBudget message.
+type MsgClawbackBudgetResponse struct {
+	// Recipient is the account address string of the recipient whose budget is cancelled.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// ClawedBackAmount is the amount that was not paid out and remains in the community pool.
+	ClawedBackAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=clawed_back_amount,json=clawedBackAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"clawed_back_amount"`
+}
+
+func (m *MsgClawbackBudgetResponse) Reset()         { *m = MsgClawbackBudgetResponse{} }
+func (m *MsgClawbackBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackBudgetResponse) ProtoMessage()    {}
+func (*MsgClawbackBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{11}
+}
+func (m *MsgClawbackBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackBudgetResponse.Merge(m, src)
+}
+func (m *MsgClawbackBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackBudgetResponse proto.InternalMessageInfo
+
+func (m *MsgClawbackBudgetResponse) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MsgClawbackBudgetResponse) GetClawedBackAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClawedBackAmount
+	}
+	return nil
+}
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 type MsgUpdateParams struct {
 	// authority is the address that controls the module (defaults to x/gov unless overwritten).
@@ -454,7 +704,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09efe14517e7f6dc, []int{8}
+	return fileDescriptor_09efe14517e7f6dc, []int{12}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -506,7 +756,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09efe14517e7f6dc, []int{9}
+	return fileDescriptor_09efe14517e7f6dc, []int{13}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -544,6 +794,10 @@ func init() {
 	proto.RegisterType((*MsgCreateContinuousFundResponse)(nil), "cosmos.protocolpool.v1.MsgCreateContinuousFundResponse")
 	proto.RegisterType((*MsgCancelContinuousFund)(nil), "cosmos.protocolpool.v1.MsgCancelContinuousFund")
 	proto.RegisterType((*MsgCancelContinuousFundResponse)(nil), "cosmos.protocolpool.v1.MsgCancelContinuousFundResponse")
+	proto.RegisterType((*MsgCreateBudget)(nil), "cosmos.protocolpool.v1.MsgCreateBudget")
+	proto.RegisterType((*MsgCreateBudgetResponse)(nil), "cosmos.protocolpool.v1.MsgCreateBudgetResponse")
+	proto.RegisterType((*MsgClawbackBudget)(nil), "cosmos.protocolpool.v1.MsgClawbackBudget")
+	proto.RegisterType((*MsgClawbackBudgetResponse)(nil), "cosmos.protocolpool.v1.MsgClawbackBudgetResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.protocolpool.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.protocolpool.v1.MsgUpdateParamsResponse")
 }
@@ -551,57 +805,72 @@ func init() {
 func init() { proto.RegisterFile("cosmos/protocolpool/v1/tx.proto", fileDescriptor_09efe14517e7f6dc) }
 
 var fileDescriptor_09efe14517e7f6dc = []byte{
-	// 785 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0xd3, 0x48,
-	0x14, 0xce, 0x34, 0xd9, 0x68, 0x33, 0xed, 0xb6, 0x5a, 0x2b, 0xdb, 0xa6, 0xde, 0xae, 0xdd, 0xcd,
-	0xa5, 0x51, 0xb5, 0xb1, 0x37, 0x01, 0x0a, 0x42, 0x5c, 0x48, 0x2b, 0x04, 0x82, 0x48, 0xc5, 0x85,
-	0x0b, 0x97, 0xca, 0xb1, 0x87, 0x89, 0xd5, 0xd8, 0x63, 0x79, 0xc6, 0xa5, 0xe1, 0x54, 0x71, 0xe2,
-	0xd8, 0x1b, 0xe2, 0xd6, 0x13, 0x07, 0x4e, 0x3d, 0xf4, 0x8f, 0xa8, 0x84, 0x90, 0xaa, 0x9e, 0x10,
-	0x87, 0x16, 0xa5, 0x87, 0x72, 0xe5, 0x3f, 0x40, 0xb6, 0x27, 0xbf, 0x14, 0xd7, 0xb4, 0x95, 0x10,
-	0xa7, 0x58, 0xef, 0x7d, 0xdf, 0x7b, 0xdf, 0xfb, 0xec, 0x79, 0x13, 0x28, 0x1b, 0x84, 0xda, 0x84,
-	0xaa, 0xae, 0x47, 0x18, 0x31, 0x48, 0xcb, 0x25, 0xa4, 0xa5, 0x6e, 0x56, 0x54, 0xb6, 0xa5, 0x84,
-	0x21, 0x61, 0x3a, 0x02, 0x28, 0x83, 0x00, 0x65, 0xb3, 0x22, 0x16, 0xcf, 0x23, 0xb6, 0x5d, 0xc4,
-	0xd1, 0x62, 0x1e, 0x13, 0x4c, 0xc2, 0x47, 0x35, 0x78, 0xe2, 0x51, 0x89, 0x33, 0x1b, 0x3a, 0x45,
-	0xea, 0x66, 0xa5, 0x81, 0x98, 0x5e, 0x51, 0x0d, 0x62, 0x39, 0x3c, 0x3f, 0x1b, 0xe5, 0xd7, 0x23,
-	0xe2, 0x60, 0x7b, 0x71, 0x86, 0x53, 0x6d, 0x8a, 0x83, 0x5e, 0x36, 0xc5, 0x3c, 0x21, 0x63, 0x42,
-	0x70, 0x0b, 0x45, 0x6a, 0x1a, 0xfe, 0x73, 0x95, 0x59, 0x36, 0xa2, 0x4c, 0xb7, 0xdd, 0x08, 0x50,
-	0xfc, 0x00, 0x60, 0xbe, 0x4e, 0xf1, 0x3d, 0xdf, 0x31, 0x97, 0x89, 0x6d, 0xfb, 0x8e, 0xc5, 0xda,
-	0xab, 0x84, 0xb4, 0x84, 0x25, 0x98, 0x33, 0x91, 0x4b, 0xa8, 0xc5, 0x88, 0x57, 0x00, 0xf3, 0xa0,
-	0x94, 0xab, 0x15, 0x8e, 0xf6, 0xcb, 0x79, 0xde, 0xf7, 0xae, 0x69, 0x7a, 0x88, 0xd2, 0x35, 0xe6,
-	0x59, 0x0e, 0xd6, 0xfa, 0x50, 0xc1, 0x80, 0x59, 0xdd, 0x26, 0xbe, 0xc3, 0x0a, 0x63, 0xf3, 0xe9,
-	0xd2, 0x78, 0x75, 0x56, 0xe1, 0x8c, 0x60, 0x2c, 0x85, 0x8f, 0xa5, 0x2c, 0x13, 0xcb, 0xa9, 0xfd,
-	0x7f, 0x70, 0x2c, 0xa7, 0xde, 0x9f, 0xc8, 0x25, 0x6c, 0xb1, 0xa6, 0xdf, 0x50, 0x0c, 0x62, 0xf3,
-	0xb1, 0xf8, 0x4f, 0x99, 0x9a, 0x1b, 0xdc, 0xb8, 0x80, 0x40, 0x35, 0x5e, 0xfa, 0xf6, 0xf4, 0xeb,
-	0x5d, 0x39, 0xf5, 0x75, 0x57, 0x4e, 0xbd, 0x3a, 0xdb, 0x5b, 0xec, 0x37, 0x2f, 0x4a, 0x70, 0x2e,
-	0x6e, 0x18, 0x0d, 0x51, 0x97, 0x38, 0x14, 0x15, 0x3b, 0x00, 0xfe, 0x55, 0xa7, 0x78, 0x28, 0xb9,
-	0xe6, 0x22, 0xc7, 0x0c, 0xc6, 0xd5, 0x7d, 0xd6, 0x24, 0x9e, 0xc5, 0xda, 0x3f, 0x1e, 0xb7, 0x07,
-	0x15, 0xe6, 0x60, 0xce, 0x43, 0x86, 0xe5, 0x5a, 0x28, 0x9c, 0x18, 0x94, 0x72, 0x5a, 0x3f, 0x30,
-	0x60, 0x46, 0xfa, 0xe7, 0x99, 0x31, 0x19, 0x9a, 0xd0, 0x93, 0x54, 0x94, 0xe1, 0x3f, 0xb1, 0x33,
-	0xf6, 0x5c, 0x78, 0x37, 0x06, 0x67, 0x02, 0x84, 0x87, 0x74, 0x86, 0x96, 0x89, 0xc3, 0x2c, 0xc7,
-	0x27, 0x3e, 0x0d, 0x6c, 0xbb, 0xb2, 0x0f, 0x4b, 0x23, 0x3e, 0x24, 0xf1, 0xfa, 0x0e, 0x3d, 0x86,
-	0xd0, 0x45, 0x9e, 0x81, 0x1c, 0xa6, 0x63, 0x54, 0x48, 0x87, 0xc4, 0x4a, 0x60, 0xc5, 0xe7, 0x63,
-	0xf9, 0xef, 0x88, 0x4c, 0xcd, 0x0d, 0xc5, 0x22, 0xaa, 0xad, 0xb3, 0xa6, 0xf2, 0x08, 0x61, 0xdd,
-	0x68, 0xaf, 0x20, 0xe3, 0x68, 0xbf, 0x0c, 0x79, 0xed, 0x15, 0x64, 0x68, 0x03, 0x45, 0x84, 0x5b,
-	0x30, 0x8b, 0xb6, 0x5c, 0xcb, 0x6b, 0x17, 0x32, 0xf3, 0xa0, 0x34, 0x5e, 0x15, 0x95, 0xe8, 0x10,
-	0x28, 0xdd, 0x43, 0xa0, 0x3c, 0xe9, 0x1e, 0x82, 0x5a, 0x66, 0xe7, 0x44, 0x06, 0x1a, 0xc7, 0x8f,
-	0x38, 0xf9, 0x2f, 0x94, 0xcf, 0xf1, 0xa9, 0xe7, 0xe5, 0x5b, 0x10, 0x79, 0xa9, 0x3b, 0x06, 0x6a,
-	0xfd, 0x5a, 0x2f, 0x47, 0xe4, 0x7f, 0x04, 0x91, 0xfe, 0x18, 0x6d, 0x5d, 0xfd, 0xc2, 0x03, 0xf8,
-	0x87, 0x11, 0xe6, 0x91, 0xb9, 0x1e, 0xec, 0x86, 0x50, 0x67, 0xb2, 0x67, 0xbf, 0x07, 0xaf, 0x27,
-	0xf4, 0x6d, 0xa2, 0x4b, 0x0d, 0x92, 0xc2, 0x02, 0x9c, 0xea, 0x95, 0x6a, 0x22, 0x0b, 0x37, 0x23,
-	0xf1, 0x19, 0x6d, 0xb2, 0x1b, 0xbe, 0x1f, 0x46, 0x87, 0xe7, 0x4b, 0x5f, 0x78, 0xbe, 0xe2, 0x1b,
-	0x00, 0xa7, 0xea, 0x14, 0x3f, 0x75, 0x4d, 0x9d, 0xa1, 0x55, 0xdd, 0xd3, 0x6d, 0x7a, 0x65, 0x8f,
-	0xef, 0xc0, 0xac, 0x1b, 0x56, 0x08, 0x35, 0x8e, 0x57, 0x25, 0x25, 0x7e, 0x9f, 0x2b, 0x51, 0x9f,
-	0x5a, 0x26, 0x18, 0x5a, 0xe3, 0x9c, 0x11, 0xa7, 0x67, 0xc3, 0x8f, 0x60, 0x50, 0x58, 0xd7, 0xe0,
-	0xea, 0xb7, 0x0c, 0x4c, 0xd7, 0x29, 0x16, 0x5e, 0xc0, 0x3f, 0x47, 0x97, 0xec, 0x7f, 0xe7, 0x75,
-	0x8d, 0xdb, 0x62, 0xe2, 0xf5, 0xcb, 0xa0, 0x7b, 0x6f, 0xf8, 0x25, 0x14, 0x62, 0xf6, 0x5d, 0x39,
-	0xa1, 0xd6, 0x28, 0x5c, 0xbc, 0x71, 0x29, 0x78, 0xaf, 0xf7, 0x36, 0x80, 0xf9, 0xd8, 0x35, 0xa3,
-	0x26, 0xd5, 0x8b, 0x21, 0x88, 0x37, 0x2f, 0x49, 0x18, 0x96, 0x10, 0x77, 0x3a, 0x13, 0x25, 0xc4,
-	0x10, 0x92, 0x25, 0x24, 0x9d, 0xb1, 0x26, 0x9c, 0x18, 0xfa, 0x66, 0x17, 0x12, 0x0a, 0x0d, 0x02,
-	0x45, 0xf5, 0x82, 0xc0, 0x6e, 0x27, 0xf1, 0xb7, 0xed, 0xb3, 0xbd, 0x45, 0x50, 0x7b, 0x78, 0xd0,
-	0x91, 0xc0, 0x61, 0x47, 0x02, 0x5f, 0x3a, 0x12, 0xd8, 0x39, 0x95, 0x52, 0x87, 0xa7, 0x52, 0xea,
-	0xd3, 0xa9, 0x94, 0x7a, 0x56, 0x49, 0xbc, 0x5d, 0xb6, 0x86, 0xff, 0xb5, 0x84, 0x97, 0x4d, 0x23,
-	0x1b, 0xc6, 0xae, 0x7d, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x26, 0x0e, 0xff, 0x8f, 0x12, 0x09, 0x00,
-	0x00,
+	// 1032 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x34, 0xae, 0xc1, 0x93, 0x34, 0xa1, 0xdb, 0xd0, 0xda, 0x4b, 0xf1, 0xa6, 0xe6, 0x50,
+	0x53, 0xe1, 0xdd, 0x3a, 0xb4, 0x05, 0x05, 0x24, 0x54, 0x27, 0x42, 0x20, 0x88, 0x14, 0x5c, 0xb8,
+	0x70, 0xb1, 0xc6, 0xbb, 0x93, 0xf5, 0x2a, 0xde, 0x9d, 0xd5, 0xce, 0x6c, 0x12, 0x23, 0x21, 0x15,
+	0xb8, 0x70, 0xec, 0x0d, 0xb8, 0xf5, 0xd4, 0x03, 0xa7, 0x1e, 0xf2, 0x47, 0x54, 0x20, 0xa4, 0x2a,
+	0x27, 0xc4, 0xa1, 0x45, 0xc9, 0xa1, 0xfc, 0x05, 0x9c, 0xd1, 0xfc, 0xf0, 0xda, 0x8e, 0x37, 0x5b,
+	0xa7, 0x52, 0xe8, 0x21, 0xca, 0x6a, 0xe6, 0xfb, 0xde, 0x8f, 0xef, 0xbd, 0x37, 0x33, 0x86, 0x86,
+	0x4d, 0xa8, 0x4f, 0xa8, 0x15, 0x46, 0x84, 0x11, 0x9b, 0xf4, 0x42, 0x42, 0x7a, 0xd6, 0x76, 0xc3,
+	0x62, 0xbb, 0xa6, 0x58, 0xd2, 0x2e, 0x4a, 0x80, 0x39, 0x0a, 0x30, 0xb7, 0x1b, 0x7a, 0xf5, 0x38,
+	0x62, 0x3f, 0xc4, 0x0a, 0xad, 0x2f, 0xba, 0xc4, 0x25, 0xe2, 0xd3, 0xe2, 0x5f, 0x6a, 0xb5, 0xa2,
+	0x98, 0x1d, 0x44, 0xb1, 0xb5, 0xdd, 0xe8, 0x60, 0x86, 0x1a, 0x96, 0x4d, 0xbc, 0x40, 0xed, 0x97,
+	0xe5, 0x7e, 0x5b, 0x12, 0x47, 0xdd, 0xeb, 0x97, 0x14, 0xd5, 0xa7, 0x2e, 0xf7, 0xe5, 0x53, 0x57,
+	0x6d, 0x18, 0x2e, 0x21, 0x6e, 0x0f, 0xcb, 0x68, 0x3a, 0xf1, 0xa6, 0xc5, 0x3c, 0x1f, 0x53, 0x86,
+	0xfc, 0x70, 0xe0, 0xf4, 0x28, 0xc0, 0x89, 0x23, 0xc4, 0x3c, 0xa2, 0x9c, 0x56, 0x7f, 0x07, 0x70,
+	0x71, 0x9d, 0xba, 0x1f, 0xc7, 0x81, 0xb3, 0x4a, 0x7c, 0x3f, 0x0e, 0x3c, 0xd6, 0xdf, 0x20, 0xa4,
+	0xa7, 0xdd, 0x82, 0x45, 0x07, 0x87, 0x84, 0x7a, 0x8c, 0x44, 0x25, 0xb0, 0x04, 0x6a, 0xc5, 0x66,
+	0x69, 0x7f, 0xaf, 0xbe, 0xa8, 0xe2, 0xba, 0xed, 0x38, 0x11, 0xa6, 0xf4, 0x0e, 0x8b, 0xbc, 0xc0,
+	0x6d, 0x0d, 0xa1, 0x9a, 0x0d, 0x0b, 0xc8, 0x27, 0x71, 0xc0, 0x4a, 0x67, 0x96, 0x66, 0x6a, 0xb3,
+	0xcb, 0x65, 0x53, 0x31, 0x78, 0xda, 0xa6, 0x4a, 0xdb, 0x5c, 0x25, 0x5e, 0xd0, 0xbc, 0xfe, 0xe8,
+	0x89, 0x91, 0xfb, 0xf5, 0xa9, 0x51, 0x73, 0x3d, 0xd6, 0x8d, 0x3b, 0xa6, 0x4d, 0x7c, 0x95, 0xb6,
+	0xfa, 0x57, 0xa7, 0xce, 0x96, 0x12, 0x96, 0x13, 0x68, 0x4b, 0x99, 0x5e, 0xb9, 0xf8, 0xe3, 0x7d,
+	0x23, 0xf7, 0xcf, 0x7d, 0x23, 0xf7, 0xfd, 0xb3, 0x87, 0xd7, 0x86, 0xce, 0xab, 0x15, 0x78, 0x39,
+	0x2d, 0x99, 0x16, 0xa6, 0x21, 0x09, 0x28, 0xae, 0x1e, 0x00, 0xf8, 0xfa, 0x3a, 0x75, 0xc7, 0x36,
+	0xef, 0x84, 0x38, 0x70, 0x78, 0xba, 0x28, 0x66, 0x5d, 0x12, 0x79, 0xac, 0xff, 0xfc, 0x74, 0x13,
+	0xa8, 0x76, 0x19, 0x16, 0x23, 0x6c, 0x7b, 0xa1, 0x87, 0x45, 0xc6, 0xa0, 0x56, 0x6c, 0x0d, 0x17,
+	0x46, 0xc4, 0x98, 0x39, 0x3d, 0x31, 0xe6, 0x85, 0x08, 0x49, 0x48, 0x55, 0x03, 0xbe, 0x99, 0x9a,
+	0x63, 0xa2, 0xc2, 0x83, 0x33, 0xf0, 0x12, 0x47, 0x44, 0x18, 0x31, 0xbc, 0x4a, 0x02, 0xe6, 0x05,
+	0x31, 0x89, 0x29, 0x97, 0xed, 0x85, 0x75, 0xb8, 0x35, 0xa1, 0x43, 0x16, 0x6f, 0xa8, 0xd0, 0x17,
+	0x10, 0x86, 0x38, 0xb2, 0x71, 0xc0, 0x90, 0x8b, 0x4b, 0x33, 0x82, 0xd8, 0xe0, 0x52, 0xfc, 0xf5,
+	0xc4, 0x78, 0x43, 0x92, 0xa9, 0xb3, 0x65, 0x7a, 0xc4, 0xf2, 0x11, 0xeb, 0x9a, 0x9f, 0x63, 0x17,
+	0xd9, 0xfd, 0x35, 0x6c, 0xef, 0xef, 0xd5, 0xa1, 0xb2, 0xbd, 0x86, 0xed, 0xd6, 0x88, 0x11, 0xed,
+	0x7d, 0x58, 0xc0, 0xbb, 0xa1, 0x17, 0xf5, 0x4b, 0xf9, 0x25, 0x50, 0x9b, 0x5d, 0xd6, 0x4d, 0x39,
+	0x03, 0xe6, 0x60, 0x06, 0xcc, 0x2f, 0x07, 0x43, 0xd2, 0xcc, 0xdf, 0x7b, 0x6a, 0x80, 0x96, 0xc2,
+	0x4f, 0x28, 0x79, 0x05, 0x1a, 0xc7, 0xe8, 0x94, 0x68, 0xf9, 0x0b, 0x90, 0x5a, 0xa2, 0xc0, 0xc6,
+	0xbd, 0x97, 0xab, 0xe5, 0x44, 0xf8, 0x7f, 0x00, 0x19, 0x7f, 0x4a, 0x6c, 0x83, 0xf8, 0xb5, 0x4f,
+	0xe1, 0x39, 0x5b, 0xec, 0x63, 0xa7, 0xcd, 0xcf, 0x0e, 0x11, 0x67, 0xb6, 0x66, 0xaf, 0xf2, 0xf2,
+	0x08, 0xdd, 0xe6, 0x06, 0x54, 0xbe, 0xa9, 0x5d, 0x85, 0x0b, 0x89, 0xa9, 0x2e, 0xf6, 0xdc, 0xae,
+	0x0c, 0x3e, 0xdf, 0x9a, 0x1f, 0x2c, 0x7f, 0x22, 0x56, 0xc7, 0xf3, 0x9b, 0x99, 0x3a, 0xbf, 0xea,
+	0x77, 0x79, 0xb8, 0x90, 0xd4, 0xa3, 0x19, 0x3b, 0x2e, 0x66, 0xff, 0x7b, 0xbf, 0xee, 0xc0, 0xf3,
+	0x72, 0xec, 0xda, 0x21, 0x8e, 0xf8, 0x9f, 0x47, 0x9c, 0xd3, 0x18, 0xee, 0x05, 0xe9, 0x65, 0x03,
+	0x47, 0x1b, 0xc2, 0x87, 0xf6, 0x16, 0x3c, 0xc7, 0x08, 0x43, 0x3d, 0xe5, 0x93, 0x8a, 0xe6, 0xce,
+	0xb7, 0xe6, 0xc4, 0xa2, 0xc4, 0x50, 0xed, 0x23, 0x08, 0x29, 0x43, 0x11, 0x93, 0xa5, 0x3c, 0x3b,
+	0x65, 0xfb, 0x17, 0x05, 0x47, 0xd4, 0xf0, 0x03, 0x58, 0x50, 0x39, 0x15, 0x04, 0xb9, 0x3c, 0x41,
+	0x5e, 0x53, 0xf7, 0x87, 0x6c, 0x83, 0x9f, 0xc5, 0xf8, 0x48, 0x0a, 0xf7, 0x6e, 0xf7, 0xbc, 0xcd,
+	0x4d, 0xe9, 0xfd, 0x95, 0x69, 0xbd, 0x0b, 0x0e, 0x5f, 0x5d, 0xb9, 0xb2, 0xbf, 0x57, 0x5f, 0x18,
+	0x0a, 0xb2, 0x74, 0xdd, 0xbc, 0x79, 0xe3, 0x48, 0x4f, 0x9b, 0x23, 0x47, 0x97, 0x6c, 0x81, 0x41,
+	0x2b, 0xaf, 0x5c, 0x48, 0x61, 0x57, 0x1f, 0x00, 0x78, 0x9e, 0x13, 0x7a, 0x68, 0xa7, 0x83, 0xec,
+	0xad, 0x97, 0xd3, 0x35, 0xd3, 0x24, 0x76, 0x08, 0x60, 0x79, 0x22, 0xd0, 0x64, 0x4c, 0xc7, 0x1c,
+	0x83, 0xe9, 0xdb, 0xb5, 0x0f, 0x35, 0xbb, 0x87, 0x76, 0xb0, 0xd3, 0xe6, 0x46, 0xdb, 0xa7, 0x77,
+	0x33, 0xbf, 0x26, 0xdd, 0x34, 0x91, 0xbd, 0x75, 0x5b, 0x5e, 0x4b, 0xa9, 0xe5, 0xf8, 0x09, 0x88,
+	0x11, 0xfe, 0x2a, 0x74, 0x10, 0xc3, 0x1b, 0x28, 0x42, 0x3e, 0x7d, 0xe1, 0x62, 0x7c, 0x08, 0x0b,
+	0xa1, 0xb0, 0x20, 0x2a, 0x31, 0xbb, 0x5c, 0x31, 0xd3, 0x9f, 0x6c, 0xa6, 0xf4, 0xd3, 0xcc, 0xf3,
+	0xa4, 0x5a, 0x8a, 0x33, 0x71, 0x58, 0x96, 0x45, 0x63, 0x8d, 0x06, 0x36, 0x10, 0x7f, 0xf9, 0xdf,
+	0x02, 0x9c, 0x59, 0xa7, 0x2e, 0x9f, 0xfd, 0xc9, 0x77, 0xd2, 0x3b, 0xc7, 0x79, 0x4d, 0x7b, 0x88,
+	0xe8, 0x37, 0x4e, 0x82, 0x4e, 0xaa, 0xff, 0x0d, 0xd4, 0x52, 0x9e, 0x2c, 0xf5, 0x0c, 0x5b, 0x93,
+	0x70, 0xfd, 0xe6, 0x89, 0xe0, 0x89, 0xef, 0xbb, 0x00, 0x2e, 0xa6, 0xbe, 0x14, 0xac, 0x2c, 0x7b,
+	0x29, 0x04, 0xfd, 0xbd, 0x13, 0x12, 0xc6, 0x43, 0x48, 0xbb, 0x60, 0x33, 0x43, 0x48, 0x21, 0x64,
+	0x87, 0x90, 0x75, 0x4d, 0x7e, 0x0b, 0xe7, 0xc6, 0xae, 0x9d, 0xab, 0xcf, 0xcd, 0x45, 0x02, 0x75,
+	0x6b, 0x4a, 0x60, 0xf2, 0xa0, 0xb8, 0xf0, 0xdb, 0xe4, 0xd8, 0x68, 0x3f, 0x00, 0x38, 0x7f, 0xe4,
+	0x08, 0x7b, 0x3b, 0xcb, 0xf0, 0x18, 0x54, 0x6f, 0x4c, 0x0d, 0xcd, 0x8e, 0xa2, 0x0b, 0xe7, 0xc6,
+	0x06, 0x37, 0x4b, 0x84, 0x51, 0x60, 0xa6, 0x08, 0x69, 0x13, 0xa7, 0x9f, 0xbd, 0xfb, 0xec, 0xe1,
+	0x35, 0xd0, 0xfc, 0xec, 0xd1, 0x41, 0x05, 0x3c, 0x3e, 0xa8, 0x80, 0xbf, 0x0f, 0x2a, 0xe0, 0xde,
+	0x61, 0x25, 0xf7, 0xf8, 0xb0, 0x92, 0xfb, 0xf3, 0xb0, 0x92, 0xfb, 0xba, 0x91, 0x79, 0x30, 0xed,
+	0x8e, 0xff, 0x3a, 0x13, 0xe7, 0x54, 0xa7, 0x20, 0xd6, 0xde, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff,
+	0xe7, 0x3f, 0x5b, 0xea, 0xfa, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -631,6 +900,12 @@ type MsgClient interface {
 	CreateContinuousFund(ctx context.Context, in *MsgCreateContinuousFund, opts ...grpc.CallOption) (*MsgCreateContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(ctx context.Context, in *MsgCancelContinuousFund, opts ...grpc.CallOption) (*MsgCancelContinuousFundResponse, error)
+	// CreateBudget defines a method to pay a fixed amount from the community pool to an address
+	// once per period, for a fixed number of periods.
+	CreateBudget(ctx context.Context, in *MsgCreateBudget, opts ...grpc.CallOption) (*MsgCreateBudgetResponse, error)
+	// ClawbackBudget defines a method for cancelling a budget. All amounts not yet paid out
+	// remain in the community pool.
+	ClawbackBudget(ctx context.Context, in *MsgClawbackBudget, opts ...grpc.CallOption) (*MsgClawbackBudgetResponse, error)
 	// UpdateParams defines a governance operation for updating the x/protocolpool module parameters.
 	// The authority is defined in the keeper.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -680,6 +955,24 @@ func (c *msgClient) CancelContinuousFund(ctx context.Context, in *MsgCancelConti
 	return out, nil
 }
 
+func (c *msgClient) CreateBudget(ctx context.Context, in *MsgCreateBudget, opts ...grpc.CallOption) (*MsgCreateBudgetResponse, error) {
+	out := new(MsgCreateBudgetResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/CreateBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClawbackBudget(ctx context.Context, in *MsgClawbackBudget, opts ...grpc.CallOption) (*MsgClawbackBudgetResponse, error) {
+	out := new(MsgClawbackBudgetResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/ClawbackBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/UpdateParams", in, out, opts...)
@@ -706,6 +999,12 @@ type MsgServer interface {
 	CreateContinuousFund(context.Context, *MsgCreateContinuousFund) (*MsgCreateContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error)
+	// CreateBudget defines a method to pay a fixed amount from the community pool to an address
+	// once per period, for a fixed number of periods.
+	CreateBudget(context.Context, *MsgCreateBudget) (*MsgCreateBudgetResponse, error)
+	// ClawbackBudget defines a method for cancelling a budget. All amounts not yet paid out
+	// remain in the community pool.
+	ClawbackBudget(context.Context, *MsgClawbackBudget) (*MsgClawbackBudgetResponse, error)
 	// UpdateParams defines a governance operation for updating the x/protocolpool module parameters.
 	// The authority is defined in the keeper.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -727,6 +1026,12 @@ func (*UnimplementedMsgServer) CreateContinuousFund(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) CancelContinuousFund(ctx context.Context, req *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelContinuousFund not implemented")
 }
+func (*UnimplementedMsgServer) CreateBudget(ctx context.Context, req *MsgCreateBudget) (*MsgCreateBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBudget not implemented")
+}
+func (*UnimplementedMsgServer) ClawbackBudget(ctx context.Context, req *MsgClawbackBudget) (*MsgClawbackBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClawbackBudget not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -807,6 +1112,42 @@ func _Msg_CancelContinuousFund_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBudget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/CreateBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBudget(ctx, req.(*MsgCreateBudget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClawbackBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawbackBudget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClawbackBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/ClawbackBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClawbackBudget(ctx, req.(*MsgClawbackBudget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -846,6 +1187,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelContinuousFund",
 			Handler:    _Msg_CancelContinuousFund_Handler,
 		},
+		{
+			MethodName: "CreateBudget",
+			Handler:    _Msg_CreateBudget_Handler,
+		},
+		{
+			MethodName: "ClawbackBudget",
+			Handler:    _Msg_ClawbackBudget_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -1156,7 +1505,7 @@ func (m *MsgCancelContinuousFundResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateBudget) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1166,26 +1515,70 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateBudget) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.CliffTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CliffTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CliffTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err4 != nil {
+		return 0, err4
 	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x32
+	if m.StartTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TotalPeriods != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalPeriods))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AmountPerPeriod) > 0 {
+		for iNdEx := len(m.AmountPerPeriod) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AmountPerPeriod[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -1196,7 +1589,7 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateBudgetResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1206,12 +1599,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1219,23 +1612,167 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgClawbackBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgFundCommunityPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+
+func (m *MsgClawbackBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClawedBackAmount) > 0 {
+		for iNdEx := len(m.ClawedBackAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClawedBackAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgFundCommunityPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.Depositor)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -1357,6 +1894,87 @@ func (m *MsgCancelContinuousFundResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AmountPerPeriod) > 0 {
+		for _, e := range m.AmountPerPeriod {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.TotalPeriods != 0 {
+		n += 1 + sovTx(uint64(m.TotalPeriods))
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovTx(uint64(l))
+	if m.CliffTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CliffTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClawbackBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClawbackBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ClawedBackAmount) > 0 {
+		for _, e := range m.ClawedBackAmount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2233,6 +2851,558 @@ func (m *MsgCancelContinuousFundResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCreateBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountPerPeriod = append(m.AmountPerPeriod, types.Coin{})
+			if err := m.AmountPerPeriod[len(m.AmountPerPeriod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPeriods", wireType)
+			}
+			m.TotalPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CliffTime == nil {
+				m.CliffTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CliffTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawbackBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawbackBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawbackBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawbackBudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawbackBudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawbackBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBackAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawedBackAmount = append(m.ClawedBackAmount, types.Coin{})
+			if err := m.ClawedBackAmount[len(m.ClawedBackAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,8 +2,12 @@ package types
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (cf *ContinuousFund) Validate() error {
@@ -23,3 +27,59 @@ func (cf *ContinuousFund) Validate() error {
 	}
 	return nil
 }
+
+func (b *Budget) Validate() error {
+	if b.Recipient == "" {
+		return errors.New("recipient cannot be empty")
+	}
+
+	if err := b.AmountPerPeriod.Validate(); err != nil {
+		return fmt.Errorf("invalid amount per period: %w", err)
+	}
+	if b.AmountPerPeriod.IsZero() {
+		return errors.New("amount per period cannot be zero")
+	}
+
+	if b.TotalPeriods == 0 {
+		return errors.New("total periods cannot be zero")
+	}
+	if b.ClaimedPeriods > b.TotalPeriods {
+		return fmt.Errorf("claimed periods %d cannot be greater than total periods %d", b.ClaimedPeriods, b.TotalPeriods)
+	}
+
+	if b.Period <= 0 {
+		return errors.New("period must be positive")
+	}
+
+	if b.CliffTime != nil && b.CliffTime.Before(b.StartTime) {
+		return fmt.Errorf("cliff time %s cannot be before the start time %s", b.CliffTime, b.StartTime)
+	}
+
+	return nil
+}
+
+// ElapsedPeriods returns the number of periods of the budget that have
+// elapsed at the given time. No periods are considered elapsed before the
+// cliff time.
+func (b Budget) ElapsedPeriods(blockTime time.Time) uint64 {
+	if blockTime.Before(b.StartTime) {
+		return 0
+	}
+	if b.CliffTime != nil && blockTime.Before(*b.CliffTime) {
+		return 0
+	}
+
+	elapsed := uint64(blockTime.Sub(b.StartTime) / b.Period)
+	return min(elapsed, b.TotalPeriods)
+}
+
+// ClaimedAmount returns the amount of the budget that has been paid out.
+func (b Budget) ClaimedAmount() sdk.Coins {
+	return b.AmountPerPeriod.MulInt(math.NewIntFromUint64(b.ClaimedPeriods))
+}
+
+// RemainingAmount returns the amount of the budget that has not been paid
+// out yet.
+func (b Budget) RemainingAmount() sdk.Coins {
+	return b.AmountPerPeriod.MulInt(math.NewIntFromUint64(b.TotalPeriods - b.ClaimedPeriods))
+}
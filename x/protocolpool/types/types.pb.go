@@ -7,9 +7,12 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -86,6 +89,108 @@ func (m *ContinuousFund) GetExpiry() *time.Time {
 	return nil
 }
 
+// Budget defines a fixed amount of funds paid out from the community pool to a
+// recipient once per period, for a fixed number of periods.
+type Budget struct {
+	// Recipient is the address string of the account receiving funds.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// AmountPerPeriod is the amount paid out for each elapsed period.
+	AmountPerPeriod github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount_per_period,json=amountPerPeriod,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount_per_period"`
+	// TotalPeriods is the number of periods the budget is paid out over.
+	TotalPeriods uint64 `protobuf:"varint,3,opt,name=total_periods,json=totalPeriods,proto3" json:"total_periods,omitempty"`
+	// ClaimedPeriods is the number of periods that have been paid out.
+	ClaimedPeriods uint64 `protobuf:"varint,4,opt,name=claimed_periods,json=claimedPeriods,proto3" json:"claimed_periods,omitempty"`
+	// StartTime is the time the first period starts at.
+	StartTime time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// Period is the duration of a single period.
+	Period time.Duration `protobuf:"bytes,6,opt,name=period,proto3,stdduration" json:"period"`
+	// Optional, if cliff_time is set, no funds are paid out before it. Periods
+	// elapsed before the cliff are paid out at once once it is reached.
+	CliffTime *time.Time `protobuf:"bytes,7,opt,name=cliff_time,json=cliffTime,proto3,stdtime" json:"cliff_time,omitempty"`
+}
+
+func (m *Budget) Reset()         { *m = Budget{} }
+func (m *Budget) String() string { return proto.CompactTextString(m) }
+func (*Budget) ProtoMessage()    {}
+func (*Budget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{1}
+}
+func (m *Budget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Budget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Budget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Budget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Budget.Merge(m, src)
+}
+func (m *Budget) XXX_Size() int {
+	return m.Size()
+}
+func (m *Budget) XXX_DiscardUnknown() {
+	xxx_messageInfo_Budget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Budget proto.InternalMessageInfo
+
+func (m *Budget) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Budget) GetAmountPerPeriod() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AmountPerPeriod
+	}
+	return nil
+}
+
+func (m *Budget) GetTotalPeriods() uint64 {
+	if m != nil {
+		return m.TotalPeriods
+	}
+	return 0
+}
+
+func (m *Budget) GetClaimedPeriods() uint64 {
+	if m != nil {
+		return m.ClaimedPeriods
+	}
+	return 0
+}
+
+func (m *Budget) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Budget) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *Budget) GetCliffTime() *time.Time {
+	if m != nil {
+		return m.CliffTime
+	}
+	return nil
+}
+
 // Params defines the parameters for the protocolpool module.
 type Params struct {
 	// EnabledDistributionDenoms lists the denoms that are allowed to be distributed.
@@ -100,7 +205,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b7d0ea246d7f44, []int{1}
+	return fileDescriptor_c1b7d0ea246d7f44, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -145,6 +250,7 @@ func (m *Params) GetDistributionFrequency() uint64 {
 
 func init() {
 	proto.RegisterType((*ContinuousFund)(nil), "cosmos.protocolpool.v1.ContinuousFund")
+	proto.RegisterType((*Budget)(nil), "cosmos.protocolpool.v1.Budget")
 	proto.RegisterType((*Params)(nil), "cosmos.protocolpool.v1.Params")
 }
 
@@ -153,33 +259,46 @@ func init() {
 }
 
 var fileDescriptor_c1b7d0ea246d7f44 = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0x41, 0x6b, 0xdb, 0x30,
-	0x18, 0x86, 0xa3, 0xb5, 0x04, 0xa2, 0xc1, 0x0e, 0xa6, 0x2b, 0x6e, 0x0a, 0x4e, 0xc8, 0x29, 0x97,
-	0xc8, 0x64, 0x63, 0x63, 0xa7, 0xc1, 0x32, 0xd3, 0xcb, 0x76, 0xd8, 0xbc, 0x9d, 0x76, 0x09, 0xb6,
-	0xf4, 0x55, 0x15, 0xb5, 0xf5, 0x79, 0x92, 0x5c, 0xea, 0xd3, 0xfe, 0x42, 0x7f, 0x4c, 0x7f, 0x44,
-	0x8f, 0xa5, 0x30, 0x18, 0x3b, 0x74, 0x23, 0xf9, 0x23, 0x63, 0x96, 0x43, 0xdd, 0x93, 0xc4, 0xf7,
-	0x3e, 0xef, 0x2b, 0x5e, 0xf4, 0xd1, 0x19, 0x47, 0x5b, 0xa2, 0x8d, 0x2b, 0x83, 0x0e, 0x39, 0x16,
-	0x15, 0x62, 0x11, 0x5f, 0x2c, 0x63, 0xd7, 0x54, 0x60, 0x59, 0x3b, 0x0d, 0x0e, 0x3d, 0xc3, 0xfa,
-	0x0c, 0xbb, 0x58, 0x8e, 0x0f, 0x24, 0x4a, 0x6c, 0x87, 0xf1, 0xff, 0x9b, 0xd7, 0xc7, 0x47, 0x9e,
-	0x5e, 0x7b, 0xa1, 0x6f, 0x1d, 0x4f, 0x24, 0xa2, 0x2c, 0xc0, 0x3f, 0x96, 0xd7, 0xa7, 0xb1, 0x53,
-	0x25, 0x58, 0x97, 0x95, 0x95, 0x07, 0x66, 0x3f, 0x09, 0x7d, 0xf6, 0x1e, 0xb5, 0x53, 0xba, 0xc6,
-	0xda, 0x9e, 0xd4, 0x5a, 0x04, 0xaf, 0xe9, 0xc8, 0x00, 0x57, 0x95, 0x02, 0xed, 0x42, 0x32, 0x25,
-	0xf3, 0xd1, 0x2a, 0xbc, 0xbb, 0x5e, 0x1c, 0x74, 0xc1, 0xef, 0x84, 0x30, 0x60, 0xed, 0x17, 0x67,
-	0x94, 0x96, 0xe9, 0x03, 0x1a, 0x7c, 0xa6, 0xb4, 0x02, 0xc3, 0x41, 0xbb, 0x4c, 0x42, 0xf8, 0xa4,
-	0x35, 0x2e, 0x6f, 0xee, 0x27, 0x83, 0xdf, 0xf7, 0x93, 0x63, 0x6f, 0xb6, 0xe2, 0x9c, 0x29, 0x8c,
-	0xcb, 0xcc, 0x9d, 0xb1, 0x8f, 0x20, 0x33, 0xde, 0x24, 0xc0, 0xef, 0xae, 0x17, 0xb4, 0xcb, 0x4e,
-	0x80, 0xa7, 0xbd, 0x90, 0xe0, 0x0d, 0x1d, 0xc2, 0x65, 0xa5, 0x4c, 0x13, 0xee, 0x4d, 0xc9, 0xfc,
-	0xe9, 0x8b, 0x31, 0xf3, 0x7d, 0xd8, 0xae, 0x0f, 0xfb, 0xba, 0xeb, 0xb3, 0xda, 0xbf, 0xfa, 0x33,
-	0x21, 0x69, 0xc7, 0xcf, 0x7e, 0xd0, 0xe1, 0xa7, 0xcc, 0x64, 0xa5, 0x0d, 0xde, 0xd2, 0x63, 0xd0,
-	0x59, 0x5e, 0x80, 0x58, 0x0b, 0x65, 0x9d, 0x51, 0x79, 0xed, 0x14, 0xea, 0xb5, 0x00, 0x8d, 0xa5,
-	0x0d, 0xc9, 0x74, 0x6f, 0x3e, 0x4a, 0x8f, 0x3a, 0x24, 0xe9, 0x11, 0x49, 0x0b, 0x04, 0xaf, 0xe8,
-	0xe1, 0x23, 0xdf, 0xa9, 0x81, 0xef, 0x35, 0x68, 0xde, 0xb4, 0x15, 0xf7, 0xd3, 0xe7, 0x7d, 0xf5,
-	0x64, 0x27, 0xae, 0x3e, 0xdc, 0x6c, 0x22, 0x72, 0xbb, 0x89, 0xc8, 0xdf, 0x4d, 0x44, 0xae, 0xb6,
-	0xd1, 0xe0, 0x76, 0x1b, 0x0d, 0x7e, 0x6d, 0xa3, 0xc1, 0xb7, 0xa5, 0x54, 0xee, 0xac, 0xce, 0x19,
-	0xc7, 0xb2, 0xfb, 0xac, 0xee, 0x58, 0x58, 0x71, 0x1e, 0x5f, 0x3e, 0x5e, 0x8c, 0x76, 0x2b, 0xf2,
-	0x61, 0x3b, 0x7b, 0xf9, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x13, 0xcd, 0x50, 0x3c, 0x02, 0x00,
-	0x00,
+	// 610 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xc7, 0xe3, 0x26, 0x04, 0x72, 0x85, 0x56, 0x98, 0x52, 0x39, 0xa9, 0xe4, 0x44, 0x61, 0x20,
+	0x4b, 0xce, 0x75, 0xa1, 0x08, 0x81, 0x04, 0x22, 0x8d, 0xba, 0xc0, 0x50, 0x0c, 0x13, 0x4b, 0x74,
+	0xb6, 0x9f, 0xb8, 0xa7, 0xda, 0x3e, 0x73, 0x77, 0x2e, 0xcd, 0xc4, 0x57, 0xe8, 0xc8, 0x67, 0x60,
+	0xee, 0x87, 0xe8, 0x58, 0x55, 0x42, 0x42, 0x0c, 0x2d, 0x6a, 0xbe, 0x08, 0xf2, 0xf9, 0x42, 0x53,
+	0x90, 0x00, 0x31, 0x58, 0xb6, 0x9e, 0xe7, 0xf7, 0x7f, 0xde, 0x65, 0xd4, 0x0d, 0x98, 0x48, 0x98,
+	0x70, 0x32, 0xce, 0x24, 0x0b, 0x58, 0x9c, 0x31, 0x16, 0x3b, 0xfb, 0xae, 0x23, 0x27, 0x19, 0x08,
+	0xac, 0xac, 0xe6, 0x6a, 0xc9, 0xe0, 0x79, 0x06, 0xef, 0xbb, 0xad, 0x95, 0x88, 0x45, 0x4c, 0x19,
+	0x9d, 0xe2, 0xab, 0xf4, 0xb7, 0x9a, 0x25, 0x3d, 0x2a, 0x1d, 0xf3, 0xd2, 0x56, 0x3b, 0x62, 0x2c,
+	0x8a, 0xa1, 0x4c, 0xe6, 0xe7, 0x63, 0x47, 0xd2, 0x04, 0x84, 0x24, 0x49, 0xa6, 0x01, 0xfb, 0x57,
+	0x20, 0xcc, 0x39, 0x91, 0x94, 0xa5, 0x33, 0xbf, 0xae, 0xd6, 0x27, 0x02, 0x9c, 0x7d, 0xd7, 0x07,
+	0x49, 0x5c, 0x27, 0x60, 0x54, 0xfb, 0xbb, 0x5f, 0x0c, 0xb4, 0xb4, 0xc5, 0x52, 0x49, 0xd3, 0x9c,
+	0xe5, 0x62, 0x3b, 0x4f, 0x43, 0xf3, 0x11, 0x6a, 0x70, 0x08, 0x68, 0x46, 0x21, 0x95, 0x96, 0xd1,
+	0x31, 0x7a, 0x8d, 0x81, 0x75, 0x7a, 0xd4, 0x5f, 0xd1, 0x85, 0xbd, 0x08, 0x43, 0x0e, 0x42, 0xbc,
+	0x91, 0x9c, 0xa6, 0x91, 0x77, 0x89, 0x9a, 0xaf, 0x11, 0xca, 0x80, 0x07, 0x90, 0x4a, 0x12, 0x81,
+	0xb5, 0xa0, 0x84, 0xee, 0xf1, 0x59, 0xbb, 0xf2, 0xed, 0xac, 0xbd, 0x56, 0x8a, 0x45, 0xb8, 0x87,
+	0x29, 0x73, 0x12, 0x22, 0x77, 0xf1, 0x2b, 0x88, 0x48, 0x30, 0x19, 0x42, 0x70, 0x7a, 0xd4, 0x47,
+	0x3a, 0xf6, 0x10, 0x02, 0x6f, 0x2e, 0x88, 0xf9, 0x18, 0xd5, 0xe1, 0x20, 0xa3, 0x7c, 0x62, 0x55,
+	0x3b, 0x46, 0x6f, 0x71, 0xa3, 0x85, 0xcb, 0x76, 0xf1, 0xac, 0x5d, 0xfc, 0x76, 0x36, 0x8f, 0x41,
+	0xed, 0xf0, 0xbc, 0x6d, 0x78, 0x9a, 0xef, 0x9e, 0x57, 0x51, 0x7d, 0x90, 0x87, 0x11, 0xc8, 0xff,
+	0xee, 0xe7, 0x03, 0xba, 0x4d, 0x12, 0x96, 0xa7, 0x72, 0x94, 0x01, 0x2f, 0x1e, 0xca, 0x42, 0x6b,
+	0xa1, 0x53, 0xed, 0x2d, 0x6e, 0x34, 0xb1, 0x16, 0x17, 0x63, 0xc5, 0x7a, 0xac, 0x78, 0x8b, 0xd1,
+	0x74, 0xb0, 0x5e, 0x74, 0xfc, 0xf9, 0xbc, 0xdd, 0x8b, 0xa8, 0xdc, 0xcd, 0x7d, 0x1c, 0xb0, 0x44,
+	0xaf, 0x54, 0xbf, 0xfa, 0x22, 0xdc, 0xd3, 0xc7, 0x52, 0x08, 0x84, 0xb7, 0x5c, 0x66, 0xd9, 0x01,
+	0xbe, 0xa3, 0x72, 0x98, 0xf7, 0xd0, 0x2d, 0xc9, 0x24, 0x89, 0x75, 0x4e, 0xa1, 0x9a, 0xaf, 0x79,
+	0x37, 0x95, 0xb1, 0x64, 0x84, 0x79, 0x1f, 0x2d, 0x07, 0x31, 0xa1, 0x09, 0x84, 0x3f, 0xb1, 0x9a,
+	0xc2, 0x96, 0xb4, 0x79, 0x06, 0x6e, 0x21, 0x24, 0x24, 0xe1, 0x72, 0x54, 0x9c, 0x8e, 0x75, 0xed,
+	0xaf, 0x73, 0xbc, 0x51, 0x34, 0xa0, 0x66, 0xd9, 0x50, 0xba, 0xc2, 0x63, 0x3e, 0x45, 0x75, 0x3d,
+	0x80, 0xba, 0x0a, 0xd0, 0xfc, 0x2d, 0xc0, 0x50, 0xdf, 0x5d, 0xa9, 0xff, 0xa4, 0x76, 0x51, 0x4a,
+	0xcc, 0xe7, 0x08, 0x05, 0x31, 0x1d, 0x8f, 0xcb, 0x0a, 0xae, 0xff, 0xe3, 0x26, 0x1b, 0x4a, 0x53,
+	0x58, 0x9f, 0xdc, 0x39, 0x3d, 0xea, 0x2f, 0x5f, 0x4e, 0xaf, 0xb3, 0x8e, 0x37, 0x1f, 0x76, 0x3f,
+	0xa2, 0xfa, 0x0e, 0xe1, 0x24, 0x11, 0xe6, 0x33, 0xb4, 0x06, 0x29, 0xf1, 0x63, 0x08, 0x47, 0x21,
+	0x15, 0x92, 0x53, 0x3f, 0x2f, 0x2a, 0x19, 0x85, 0x90, 0xb2, 0x44, 0x58, 0x46, 0xa7, 0xda, 0x6b,
+	0x78, 0x4d, 0x8d, 0x0c, 0xe7, 0x88, 0xa1, 0x02, 0xcc, 0x4d, 0xb4, 0x7a, 0x45, 0x37, 0xe6, 0xf0,
+	0x3e, 0x87, 0x34, 0x98, 0xa8, 0x23, 0xae, 0x79, 0x77, 0xe7, 0xbd, 0xdb, 0x33, 0xe7, 0xe0, 0xe5,
+	0xf1, 0x85, 0x6d, 0x9c, 0x5c, 0xd8, 0xc6, 0xf7, 0x0b, 0xdb, 0x38, 0x9c, 0xda, 0x95, 0x93, 0xa9,
+	0x5d, 0xf9, 0x3a, 0xb5, 0x2b, 0xef, 0xdc, 0x3f, 0xee, 0xfe, 0xe0, 0xea, 0xaf, 0x43, 0x9d, 0x82,
+	0x5f, 0x57, 0xb6, 0x07, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x81, 0xd6, 0x27, 0xeb, 0x5e, 0x04,
+	0x00, 0x00,
 }
 
 func (m *ContinuousFund) Marshal() (dAtA []byte, err error) {
@@ -232,6 +351,86 @@ func (m *ContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Budget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Budget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Budget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CliffTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CliffTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CliffTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTypes(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTypes(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	if m.ClaimedPeriods != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ClaimedPeriods))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalPeriods != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalPeriods))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AmountPerPeriod) > 0 {
+		for iNdEx := len(m.AmountPerPeriod) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AmountPerPeriod[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -299,6 +498,39 @@ func (m *ContinuousFund) Size() (n int) {
 	return n
 }
 
+func (m *Budget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.AmountPerPeriod) > 0 {
+		for _, e := range m.AmountPerPeriod {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.TotalPeriods != 0 {
+		n += 1 + sovTypes(uint64(m.TotalPeriods))
+	}
+	if m.ClaimedPeriods != 0 {
+		n += 1 + sovTypes(uint64(m.ClaimedPeriods))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.CliffTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CliffTime)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -475,6 +707,262 @@ func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Budget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Budget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Budget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountPerPeriod = append(m.AmountPerPeriod, types.Coin{})
+			if err := m.AmountPerPeriod[len(m.AmountPerPeriod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPeriods", wireType)
+			}
+			m.TotalPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedPeriods", wireType)
+			}
+			m.ClaimedPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimedPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CliffTime == nil {
+				m.CliffTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CliffTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -18,6 +19,8 @@ func TestRegisterInterfaces(t *testing.T) {
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCommunityPoolSpend{}))
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCreateContinuousFund{}))
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCancelContinuousFund{}))
+	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCreateBudget{}))
+	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgClawbackBudget{}))
 }
 
 func TestNewMsgFundCommunityPool(t *testing.T) {
@@ -103,3 +106,68 @@ func TestValidateContinuousFund(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateBudget(t *testing.T) {
+	startTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	beforeStart := startTime.Add(-time.Hour)
+	validBudget := func() Budget {
+		return Budget{
+			Recipient:       "cosmos1qypq2q2l8z4wz2z2l8z4wz2z2l8z4wz2z2l8z4",
+			AmountPerPeriod: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(100))),
+			TotalPeriods:    10,
+			StartTime:       startTime,
+			Period:          time.Hour,
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func(b *Budget)
+		expErrMsg string
+	}{
+		{"valid budget", func(b *Budget) {}, ""},
+		{"empty recipient", func(b *Budget) { b.Recipient = "" }, "recipient cannot be empty"},
+		{"zero amount", func(b *Budget) { b.AmountPerPeriod = sdk.Coins{} }, "amount per period cannot be zero"},
+		{"zero periods", func(b *Budget) { b.TotalPeriods = 0 }, "total periods cannot be zero"},
+		{"claimed more than total", func(b *Budget) { b.ClaimedPeriods = 11 }, "claimed periods 11 cannot be greater than total periods 10"},
+		{"zero period", func(b *Budget) { b.Period = 0 }, "period must be positive"},
+		{"cliff before start", func(b *Budget) { b.CliffTime = &beforeStart }, "cannot be before the start time"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			budget := validBudget()
+			tc.malleate(&budget)
+			err := budget.Validate()
+			if tc.expErrMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			}
+		})
+	}
+}
+
+func TestBudgetElapsedPeriods(t *testing.T) {
+	startTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cliffTime := startTime.Add(3 * time.Hour)
+	budget := Budget{
+		AmountPerPeriod: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(100))),
+		TotalPeriods:    5,
+		ClaimedPeriods:  2,
+		StartTime:       startTime,
+		Period:          time.Hour,
+	}
+
+	require.Zero(t, budget.ElapsedPeriods(startTime.Add(-time.Minute)))
+	require.Zero(t, budget.ElapsedPeriods(startTime.Add(59*time.Minute)))
+	require.Equal(t, uint64(2), budget.ElapsedPeriods(startTime.Add(2*time.Hour)))
+	require.Equal(t, uint64(5), budget.ElapsedPeriods(startTime.Add(100*time.Hour)))
+
+	budget.CliffTime = &cliffTime
+	require.Zero(t, budget.ElapsedPeriods(startTime.Add(2*time.Hour)))
+	require.Equal(t, uint64(3), budget.ElapsedPeriods(cliffTime))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(200))), budget.ClaimedAmount())
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(300))), budget.RemainingAmount())
+}
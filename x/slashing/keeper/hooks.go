@@ -2,12 +2,14 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/cometbft/cometbft/crypto"
 
 	sdkmath "cosmossdk.io/math"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -65,6 +67,38 @@ func (h Hooks) AfterValidatorCreated(ctx context.Context, valAddr sdk.ValAddress
 	return h.k.AddPubkey(sdkCtx, consPk)
 }
 
+// AfterConsensusPubKeyUpdate moves the signing info and the missed block
+// bitmap of a validator over to its new consensus address and replaces the
+// address-pubkey relation of the old key.
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey cryptotypes.PubKey, _ sdk.Coin) error {
+	oldConsAddr := sdk.ConsAddress(oldPubKey.Address())
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+
+	signingInfo, err := h.k.GetValidatorSigningInfo(ctx, oldConsAddr)
+	if err == nil {
+		signingInfo.Address = newConsAddr.String()
+		if err := h.k.SetValidatorSigningInfo(ctx, newConsAddr, signingInfo); err != nil {
+			return err
+		}
+
+		if err := h.k.deleteValidatorSigningInfo(ctx, oldConsAddr); err != nil {
+			return err
+		}
+	} else if !errors.Is(err, types.ErrNoSigningInfoFound) {
+		return err
+	}
+
+	if err := h.k.moveMissedBlockBitmap(ctx, oldConsAddr, newConsAddr); err != nil {
+		return err
+	}
+
+	if err := h.k.deleteAddrPubkeyRelation(ctx, oldPubKey.Address()); err != nil {
+		return err
+	}
+
+	return h.k.AddPubkey(ctx, newPubKey)
+}
+
 func (h Hooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
 	return nil
 }
@@ -20,8 +20,12 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 	logger := k.Logger(ctx)
 	height := sdkCtx.BlockHeight()
 
-	// fetch the validator public key
-	consAddr := sdk.ConsAddress(addr)
+	// resolve the consensus address the vote was signed with to the current
+	// consensus address of the validator, which may have rotated its key
+	consAddr, err := k.sk.ValidatorIdentifier(ctx, sdk.ConsAddress(addr))
+	if err != nil {
+		return err
+	}
 
 	// don't update missed blocks when validator's jailed
 	isJailed, err := k.sk.IsValidatorJailed(ctx, consAddr)
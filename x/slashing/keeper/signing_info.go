@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
 	"time"
 
 	"github.com/bits-and-blooms/bitset"
@@ -50,6 +51,12 @@ func (k Keeper) SetValidatorSigningInfo(ctx context.Context, address sdk.ConsAdd
 	return store.Set(types.ValidatorSigningInfoKey(address), bz)
 }
 
+// deleteValidatorSigningInfo removes the validator signing info of a consensus address
+func (k Keeper) deleteValidatorSigningInfo(ctx context.Context, address sdk.ConsAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.ValidatorSigningInfoKey(address))
+}
+
 // IterateValidatorSigningInfos iterates over the stored ValidatorSigningInfo
 func (k Keeper) IterateValidatorSigningInfos(ctx context.Context,
 	handler func(address sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool),
@@ -215,6 +222,41 @@ func (k Keeper) DeleteMissedBlockBitmap(ctx context.Context, addr sdk.ConsAddres
 	return nil
 }
 
+// moveMissedBlockBitmap moves a validator's missed block bitmap from one
+// consensus address to another, used when a validator rotates its consensus key.
+func (k Keeper) moveMissedBlockBitmap(ctx context.Context, from, to sdk.ConsAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	prefix := types.ValidatorMissedBlockBitmapPrefixKey(from)
+	iter, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+
+	var (
+		chunkIndexes []int64
+		chunks       [][]byte
+	)
+	for ; iter.Valid(); iter.Next() {
+		chunkIndexes = append(chunkIndexes, int64(binary.LittleEndian.Uint64(iter.Key()[len(prefix):])))
+		chunks = append(chunks, iter.Value())
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	if err := k.DeleteMissedBlockBitmap(ctx, from); err != nil {
+		return err
+	}
+
+	for i, chunkIndex := range chunkIndexes {
+		if err := k.setMissedBlockBitmapChunk(ctx, to, chunkIndex, chunks[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // IterateMissedBlockBitmap iterates over a validator's signed blocks window
 // bitmap and performs a callback function on each index, i.e. block height, in
 // the range [0, SignedBlocksWindow).
@@ -61,6 +61,10 @@ type StakingKeeper interface {
 
 	// IsValidatorJailed returns if the validator is jailed.
 	IsValidatorJailed(ctx context.Context, addr sdk.ConsAddress) (bool, error)
+
+	// ValidatorIdentifier returns the current consensus address of the validator
+	// that signed with the given, possibly rotated, consensus address.
+	ValidatorIdentifier(ctx context.Context, addr sdk.ConsAddress) (sdk.ConsAddress, error)
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
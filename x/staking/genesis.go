@@ -48,9 +48,85 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateLiquidStaking(data); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateConsPubKeyRotations(data); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateConsPubKeyRotations(data *types.GenesisState) error {
+	// a consensus key may only be retired once and never by a key still in use
+	consKeys := make(map[string]bool, len(data.Validators))
+	for _, val := range data.Validators {
+		consPk, err := val.ConsPubKey()
+		if err != nil {
+			return err
+		}
+		consKeys[string(consPk.Bytes())] = true
+	}
+
+	retired := make(map[string]bool, len(data.ConsPubkeyRotations))
+	for _, rotation := range data.ConsPubkeyRotations {
+		oldPubKey, err := rotation.OldConsPubKey()
+		if err != nil {
+			return err
+		}
+
+		if _, err := rotation.NewConsPubKey(); err != nil {
+			return err
+		}
+
+		strKey := string(oldPubKey.Bytes())
+		if consKeys[strKey] || retired[strKey] {
+			return fmt.Errorf("consensus key %s rotated by validator %s is still in use", sdk.ConsAddress(oldPubKey.Address()), rotation.OperatorAddress)
+		}
+
+		if !rotation.Fee.IsValid() {
+			return fmt.Errorf("invalid key rotation fee of validator %s: %s", rotation.OperatorAddress, rotation.Fee)
+		}
+
+		retired[strKey] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateLiquidStaking(data *types.GenesisState) error {
+	ids := make(map[uint64]bool, len(data.TokenizeShareRecords))
+	for _, record := range data.TokenizeShareRecords {
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+
+		if record.Id > data.LastTokenizeShareRecordId {
+			return fmt.Errorf("tokenize share record id %d is greater than the last tokenize share record id %d", record.Id, data.LastTokenizeShareRecordId)
+		}
+
+		ids[record.Id] = true
+	}
+
+	if !data.TotalLiquidStakedTokens.IsNil() && data.TotalLiquidStakedTokens.IsNegative() {
+		return fmt.Errorf("total liquid staked tokens cannot be negative: %s", data.TotalLiquidStakedTokens)
+	}
+
+	for _, liquidVal := range data.LiquidValidators {
+		if liquidVal.LiquidShares.IsNil() || liquidVal.LiquidShares.IsNegative() {
+			return fmt.Errorf("invalid liquid shares of validator %s: %s", liquidVal.OperatorAddress, liquidVal.LiquidShares)
+		}
+
+		if liquidVal.ValidatorBondShares.IsNil() || liquidVal.ValidatorBondShares.IsNegative() {
+			return fmt.Errorf("invalid validator bond shares of validator %s: %s", liquidVal.OperatorAddress, liquidVal.ValidatorBondShares)
+		}
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
@@ -0,0 +1,346 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RotateConsPubKey rotates the consensus key of a validator. The rotation fee
+// is paid by the validator operator and handed over to the
+// AfterConsensusPubKeyUpdate hook, which is expected to move it out of the
+// staking module account. The validator record is updated immediately while
+// CometBFT is informed about the rotation at the end of the block.
+func (k Keeper) RotateConsPubKey(ctx context.Context, valAddr sdk.ValAddress, newPubKey cryptotypes.PubKey) (types.ConsPubKeyRotationHistory, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+
+	// the new key must have never been used by any validator, including keys
+	// retired by a previous rotation
+	newConsAddr := sdk.GetConsAddress(newPubKey)
+	if _, err := k.GetValidatorByConsAddr(ctx, newConsAddr); err == nil {
+		return types.ConsPubKeyRotationHistory{}, types.ErrConsensusPubKeyAlreadyUsedForValidator
+	} else if !errors.Is(err, types.ErrNoValidatorFound) {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+	if _, err := k.GetConsPubKeyRotation(ctx, valAddr, height); err == nil {
+		return types.ConsPubKeyRotationHistory{}, types.ErrConsensusPubKeyRotationInBlock
+	} else if !errors.Is(err, types.ErrNoConsPubKeyRotationFound) {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+
+	if err := k.checkMaxConsPubKeyRotations(ctx, valAddr, params); err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+
+	fee := params.KeyRotationFee
+	if fee.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), types.ModuleName, sdk.NewCoins(fee)); err != nil {
+			return types.ConsPubKeyRotationHistory{}, err
+		}
+	}
+
+	oldPubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+
+	newPkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+
+	history := types.NewConsPubKeyRotationHistory(
+		validator.GetOperator(), validator.ConsensusPubkey, newPkAny, height, sdkCtx.BlockHeader().Time, fee,
+	)
+	if err := k.SetConsPubKeyRotation(ctx, history); err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+
+	// move the consensus address index over to the new key
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetValidatorByConsAddrKey(sdk.GetConsAddress(oldPubKey))); err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+
+	validator.ConsensusPubkey = newPkAny
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+
+	if err := k.Hooks().AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey, fee); err != nil {
+		return types.ConsPubKeyRotationHistory{}, err
+	}
+
+	return history, nil
+}
+
+// checkMaxConsPubKeyRotations returns an error if the validator has already
+// used up its consensus key rotations within the current unbonding period.
+func (k Keeper) checkMaxConsPubKeyRotations(ctx context.Context, valAddr sdk.ValAddress, params types.Params) error {
+	rotations, err := k.GetValidatorConsPubKeyRotations(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	since := sdk.UnwrapSDKContext(ctx).BlockHeader().Time.Add(-params.UnbondingTime)
+	count := uint32(0)
+	for _, rotation := range rotations {
+		if rotation.Time.After(since) {
+			count++
+		}
+	}
+
+	if count >= params.MaxConsPubkeyRotations {
+		return errorsmod.Wrapf(
+			types.ErrExceedingMaxConsPubKeyRotations,
+			"%d rotations within the unbonding period, max %d", count, params.MaxConsPubkeyRotations,
+		)
+	}
+
+	return nil
+}
+
+// GetConsPubKeyRotation returns the consensus key rotation of a validator at
+// the given height.
+func (k Keeper) GetConsPubKeyRotation(ctx context.Context, valAddr sdk.ValAddress, height int64) (history types.ConsPubKeyRotationHistory, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetConsPubKeyRotationHistoryKey(valAddr, height))
+	if err != nil {
+		return history, err
+	}
+
+	if bz == nil {
+		return history, types.ErrNoConsPubKeyRotationFound
+	}
+
+	err = k.cdc.Unmarshal(bz, &history)
+	return history, err
+}
+
+// SetConsPubKeyRotation stores a consensus key rotation along with its height
+// index and the index of the retired consensus address.
+func (k Keeper) SetConsPubKeyRotation(ctx context.Context, history types.ConsPubKeyRotationHistory) error {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(history.OperatorAddress)
+	if err != nil {
+		return err
+	}
+
+	oldPubKey, err := history.OldConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&history)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetConsPubKeyRotationHistoryKey(valAddr, history.Height), bz); err != nil {
+		return err
+	}
+
+	if err := store.Set(types.GetBlockConsPubKeyRotationHistoryKey(history.Height, valAddr), []byte{}); err != nil {
+		return err
+	}
+
+	return store.Set(types.GetRotatedConsAddrKey(sdk.GetConsAddress(oldPubKey)), bz)
+}
+
+// GetValidatorConsPubKeyRotations returns the consensus key rotations of a
+// validator, ordered by height.
+func (k Keeper) GetValidatorConsPubKeyRotations(ctx context.Context, valAddr sdk.ValAddress) (rotations []types.ConsPubKeyRotationHistory, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	prefix := types.GetConsPubKeyRotationHistoryPrefix(valAddr)
+	iterator, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ConsPubKeyRotationHistory
+		if err := k.cdc.Unmarshal(iterator.Value(), &history); err != nil {
+			return nil, err
+		}
+		rotations = append(rotations, history)
+	}
+
+	return rotations, nil
+}
+
+// GetBlockConsPubKeyRotations returns the consensus key rotations performed at
+// the given height.
+func (k Keeper) GetBlockConsPubKeyRotations(ctx context.Context, height int64) (rotations []types.ConsPubKeyRotationHistory, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	prefix := types.GetBlockConsPubKeyRotationHistoryPrefix(height)
+	iterator, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		history, err := k.GetConsPubKeyRotation(ctx, types.ParseBlockConsPubKeyRotationHistoryKey(iterator.Key()), height)
+		if err != nil {
+			return nil, err
+		}
+		rotations = append(rotations, history)
+	}
+
+	return rotations, nil
+}
+
+// GetAllConsPubKeyRotations returns the consensus key rotations of all
+// validators, used during genesis dump.
+func (k Keeper) GetAllConsPubKeyRotations(ctx context.Context) (rotations []types.ConsPubKeyRotationHistory, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.ConsPubKeyRotationHistoryKey, storetypes.PrefixEndBytes(types.ConsPubKeyRotationHistoryKey))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ConsPubKeyRotationHistory
+		if err := k.cdc.Unmarshal(iterator.Value(), &history); err != nil {
+			return nil, err
+		}
+		rotations = append(rotations, history)
+	}
+
+	return rotations, nil
+}
+
+// RemoveValidatorConsPubKeyRotations deletes the consensus key rotation
+// history of a validator and its indexes.
+func (k Keeper) RemoveValidatorConsPubKeyRotations(ctx context.Context, valAddr sdk.ValAddress) error {
+	rotations, err := k.GetValidatorConsPubKeyRotations(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	for _, history := range rotations {
+		oldPubKey, err := history.OldConsPubKey()
+		if err != nil {
+			return err
+		}
+
+		if err := store.Delete(types.GetConsPubKeyRotationHistoryKey(valAddr, history.Height)); err != nil {
+			return err
+		}
+
+		if err := store.Delete(types.GetBlockConsPubKeyRotationHistoryKey(history.Height, valAddr)); err != nil {
+			return err
+		}
+
+		if err := store.Delete(types.GetRotatedConsAddrKey(sdk.GetConsAddress(oldPubKey))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetConsPubKeyRotationByOldConsAddr returns the consensus key rotation that
+// retired the given consensus address.
+func (k Keeper) GetConsPubKeyRotationByOldConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (history types.ConsPubKeyRotationHistory, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetRotatedConsAddrKey(consAddr))
+	if err != nil {
+		return history, err
+	}
+
+	if bz == nil {
+		return history, types.ErrNoConsPubKeyRotationFound
+	}
+
+	err = k.cdc.Unmarshal(bz, &history)
+	return history, err
+}
+
+// ValidatorIdentifier returns the current consensus address of the validator
+// that signed with the given consensus address. Consensus addresses that have
+// not been retired by a rotation are returned as is.
+func (k Keeper) ValidatorIdentifier(ctx context.Context, consAddr sdk.ConsAddress) (sdk.ConsAddress, error) {
+	history, err := k.GetConsPubKeyRotationByOldConsAddr(ctx, consAddr)
+	if errors.Is(err, types.ErrNoConsPubKeyRotationFound) {
+		return consAddr, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(history.OperatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return validator.GetConsAddr()
+}
+
+// blockConsPubKeyRotations returns the consensus key rotations of the current
+// block, keyed by the operator address of the rotated validators.
+func (k Keeper) blockConsPubKeyRotations(ctx context.Context) (map[string]types.ConsPubKeyRotationHistory, error) {
+	rotations, err := k.GetBlockConsPubKeyRotations(ctx, sdk.UnwrapSDKContext(ctx).BlockHeight())
+	if err != nil {
+		return nil, err
+	}
+
+	rotated := make(map[string]types.ConsPubKeyRotationHistory, len(rotations))
+	for _, history := range rotations {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(history.OperatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		rotated[string(valAddr)] = history
+	}
+
+	return rotated, nil
+}
+
+// oldConsPubKeyUpdateZero returns a zero power validator update for the
+// consensus key retired by a rotation.
+func oldConsPubKeyUpdateZero(history types.ConsPubKeyRotationHistory) (abci.ValidatorUpdate, error) {
+	oldPubKey, err := history.OldConsPubKey()
+	if err != nil {
+		return abci.ValidatorUpdate{}, err
+	}
+
+	cmtPk, err := cryptocodec.ToCmtProtoPublicKey(oldPubKey)
+	if err != nil {
+		return abci.ValidatorUpdate{}, err
+	}
+
+	return abci.ValidatorUpdate{PubKey: cmtPk, Power: 0}, nil
+}
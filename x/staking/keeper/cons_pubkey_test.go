@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"go.uber.org/mock/gomock"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestMsgRotateConsPubKey() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	s.createLiquidStakingValidator()
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(ValAddr), stakingtypes.ModuleName, sdk.NewCoins(params.KeyRotationFee)).AnyTimes()
+
+	validator, err := keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	oldConsAddr, err := validator.GetConsAddr()
+	require.NoError(err)
+
+	// rotating to a key that is already in use fails
+	oldPubKey, err := validator.ConsPubKey()
+	require.NoError(err)
+	msg, err := stakingtypes.NewMsgRotateConsPubKey(ValAddr.String(), oldPubKey)
+	require.NoError(err)
+	_, err = msgServer.RotateConsPubKey(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrConsensusPubKeyAlreadyUsedForValidator)
+
+	newPubKey := ed25519.GenPrivKey().PubKey()
+	msg, err = stakingtypes.NewMsgRotateConsPubKey(ValAddr.String(), newPubKey)
+	require.NoError(err)
+	_, err = msgServer.RotateConsPubKey(ctx, msg)
+	require.NoError(err)
+
+	validator, err = keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(err)
+	require.Equal(newConsAddr.Bytes(), consAddr)
+
+	// both the retired and the new consensus address resolve to the validator
+	byNew, err := keeper.GetValidatorByConsAddr(ctx, newConsAddr)
+	require.NoError(err)
+	require.Equal(validator.GetOperator(), byNew.GetOperator())
+	byOld, err := keeper.GetValidatorByConsAddr(ctx, oldConsAddr)
+	require.NoError(err)
+	require.Equal(validator.GetOperator(), byOld.GetOperator())
+
+	identifier, err := keeper.ValidatorIdentifier(ctx, oldConsAddr)
+	require.NoError(err)
+	require.Equal(newConsAddr.Bytes(), identifier.Bytes())
+
+	rotations, err := keeper.GetValidatorConsPubKeyRotations(ctx, ValAddr)
+	require.NoError(err)
+	require.Len(rotations, 1)
+	require.Equal(params.KeyRotationFee, rotations[0].Fee)
+
+	// a second rotation within the same block is rejected
+	msg, err = stakingtypes.NewMsgRotateConsPubKey(ValAddr.String(), ed25519.GenPrivKey().PubKey())
+	require.NoError(err)
+	_, err = msgServer.RotateConsPubKey(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrConsensusPubKeyRotationInBlock)
+
+	// the retired key can not be reused
+	msg, err = stakingtypes.NewMsgRotateConsPubKey(ValAddr.String(), oldPubKey)
+	require.NoError(err)
+	_, err = msgServer.RotateConsPubKey(ctx.WithBlockHeight(ctx.BlockHeight()+1), msg)
+	require.ErrorIs(err, stakingtypes.ErrConsensusPubKeyAlreadyUsedForValidator)
+
+	// the rotation limit applies within the unbonding period
+	nextCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	msg, err = stakingtypes.NewMsgRotateConsPubKey(ValAddr.String(), ed25519.GenPrivKey().PubKey())
+	require.NoError(err)
+	_, err = msgServer.RotateConsPubKey(nextCtx, msg)
+	require.ErrorIs(err, stakingtypes.ErrExceedingMaxConsPubKeyRotations)
+
+	laterCtx := nextCtx.WithBlockTime(ctx.BlockTime().Add(params.UnbondingTime + time.Second))
+	_, err = msgServer.RotateConsPubKey(laterCtx, msg)
+	require.NoError(err)
+}
+
+func (s *KeeperTestSuite) TestConsPubKeyRotationValidatorUpdates() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	s.createLiquidStakingValidator()
+	s.expectLiquidStakingCalls()
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any()).AnyTimes()
+
+	updates, err := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(err)
+	require.Len(updates, 1)
+	oldCmtPk := updates[0].PubKey
+
+	newPubKey := ed25519.GenPrivKey().PubKey()
+	msg, err := stakingtypes.NewMsgRotateConsPubKey(ValAddr.String(), newPubKey)
+	require.NoError(err)
+	_, err = msgServer.RotateConsPubKey(ctx, msg)
+	require.NoError(err)
+
+	// the old key is removed from the set while the new one takes its power
+	updates, err = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(err)
+	require.Len(updates, 2)
+
+	newCmtPk, err := cryptocodec.ToCmtProtoPublicKey(newPubKey)
+	require.NoError(err)
+	require.ElementsMatch([]abci.ValidatorUpdate{
+		{PubKey: oldCmtPk, Power: 0},
+		{PubKey: newCmtPk, Power: 100},
+	}, updates)
+}
@@ -967,6 +967,18 @@ func (k Keeper) Delegate(
 		return newShares, err
 	}
 
+	// Account the new shares of a validator bond delegation
+	isValidatorBond, err := k.IsValidatorBond(ctx, delAddr, valbz)
+	if err != nil {
+		return newShares, err
+	}
+
+	if isValidatorBond {
+		if err = k.increaseValidatorBondShares(ctx, valbz, newShares); err != nil {
+			return newShares, err
+		}
+	}
+
 	// Call the after-modification hook
 	if err := k.Hooks().AfterDelegationModified(ctx, delAddr, valbz); err != nil {
 		return newShares, err
@@ -1029,6 +1041,25 @@ func (k Keeper) Unbond(
 		validator = k.mustGetValidator(ctx, valbz)
 	}
 
+	// Remove the unbonded shares from the validator bond shares, the caller is
+	// responsible for checking the validator bond factor
+	isValidatorBond, err := k.IsValidatorBond(ctx, delegatorAddress, valbz)
+	if err != nil {
+		return amount, err
+	}
+
+	if isValidatorBond {
+		if err = k.decreaseValidatorBondShares(ctx, valbz, shares); err != nil {
+			return amount, err
+		}
+
+		if delegation.Shares.IsZero() {
+			if err = k.RemoveValidatorBond(ctx, delegatorAddress, valbz); err != nil {
+				return amount, err
+			}
+		}
+	}
+
 	if delegation.Shares.IsZero() {
 		err = k.RemoveDelegation(ctx, delegation)
 	} else {
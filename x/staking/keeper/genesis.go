@@ -137,6 +137,48 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) (res
 		}
 	}
 
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId); err != nil {
+		panic(err)
+	}
+
+	if err := k.SetTotalLiquidStakedTokens(ctx, data.TotalLiquidStakedTokens); err != nil {
+		panic(err)
+	}
+
+	for _, liquidVal := range data.LiquidValidators {
+		if err := k.SetLiquidValidator(ctx, liquidVal); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, bond := range data.ValidatorBonds {
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(bond.DelegatorAddress)
+		if err != nil {
+			panic(fmt.Errorf("invalid delegator address: %w", err))
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(bond.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.SetValidatorBond(ctx, delegatorAddress, valAddr); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, rotation := range data.ConsPubkeyRotations {
+		if err := k.SetConsPubKeyRotation(ctx, rotation); err != nil {
+			panic(err)
+		}
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -266,14 +308,50 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	tokenizeShareRecords, err := k.GetAllTokenizeShareRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	lastTokenizeShareRecordID, err := k.GetLastTokenizeShareRecordID(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	totalLiquidStakedTokens, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	liquidValidators, err := k.GetAllLiquidValidators(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	validatorBonds, err := k.GetAllValidatorBonds(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	consPubKeyRotations, err := k.GetAllConsPubKeyRotations(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params:               params,
-		LastTotalPower:       totalPower,
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           allValidators,
-		Delegations:          allDelegations,
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    params,
+		LastTotalPower:            totalPower,
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                allValidators,
+		Delegations:               allDelegations,
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		TokenizeShareRecords:      tokenizeShareRecords,
+		LastTokenizeShareRecordId: lastTokenizeShareRecordID,
+		TotalLiquidStakedTokens:   totalLiquidStakedTokens,
+		LiquidValidators:          liquidValidators,
+		ValidatorBonds:            validatorBonds,
+		ConsPubkeyRotations:       consPubKeyRotations,
 	}
 }
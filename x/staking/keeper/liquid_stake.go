@@ -0,0 +1,793 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the last created tokenize share record.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx context.Context) (uint64, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.LastTokenizeShareRecordIDKey)
+	if err != nil {
+		return 0, err
+	}
+
+	if bz == nil {
+		return 0, nil
+	}
+
+	return sdk.BigEndianToUint64(bz), nil
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last created tokenize share record.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx context.Context, id uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns a tokenize share record by id.
+func (k Keeper) GetTokenizeShareRecord(ctx context.Context, id uint64) (record types.TokenizeShareRecord, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetTokenizeShareRecordByIndexKey(id))
+	if err != nil {
+		return record, err
+	}
+
+	if bz == nil {
+		return record, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotExists, "id %d", id)
+	}
+
+	err = k.cdc.Unmarshal(bz, &record)
+	return record, err
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record whose share
+// tokens have the given denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx context.Context, denom string) (types.TokenizeShareRecord, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+
+	if bz == nil {
+		return types.TokenizeShareRecord{}, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotExists, "denom %s", denom)
+	}
+
+	return k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetTokenizeShareRecordsByOwner returns all the tokenize share records owned by an address.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx context.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	prefix := types.GetTokenizeShareRecordIDsByOwnerPrefix(owner)
+	iterator, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[len(prefix):])
+		record, err := k.GetTokenizeShareRecord(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// IterateTokenizeShareRecords iterates through all the tokenize share records.
+func (k Keeper) IterateTokenizeShareRecords(ctx context.Context, cb func(record types.TokenizeShareRecord) (stop bool)) error {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.TokenizeShareRecordPrefix, storetypes.PrefixEndBytes(types.TokenizeShareRecordPrefix))
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		if err := k.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			return err
+		}
+		if cb(record) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records, used during genesis dump.
+func (k Keeper) GetAllTokenizeShareRecords(ctx context.Context) (records []types.TokenizeShareRecord, err error) {
+	err = k.IterateTokenizeShareRecords(ctx, func(record types.TokenizeShareRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records, err
+}
+
+// AddTokenizeShareRecord stores a new tokenize share record along with its
+// owner and denom indexes.
+func (k Keeper) AddTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	store := k.storeService.OpenKVStore(ctx)
+	has, err := store.Has(types.GetTokenizeShareRecordByIndexKey(record.Id))
+	if err != nil {
+		return err
+	}
+
+	if has {
+		return errorsmod.Wrapf(types.ErrTokenizeShareRecordAlreadyExists, "id %d", record.Id)
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	return k.setTokenizeShareRecordWithOwner(ctx, owner, record)
+}
+
+// DeleteTokenizeShareRecord removes a tokenize share record along with its
+// owner and denom indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx context.Context, id uint64) error {
+	record, err := k.GetTokenizeShareRecord(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err = store.Delete(types.GetTokenizeShareRecordByIndexKey(id)); err != nil {
+		return err
+	}
+
+	if err = store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, id)); err != nil {
+		return err
+	}
+
+	return store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+}
+
+// TransferTokenizeShareRecord changes the owner of a tokenize share record.
+func (k Keeper) TransferTokenizeShareRecord(ctx context.Context, id uint64, from, to sdk.AccAddress) error {
+	record, err := k.GetTokenizeShareRecord(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	newOwner, err := k.authKeeper.AddressCodec().BytesToString(to)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err = store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(from, id)); err != nil {
+		return err
+	}
+
+	record.Owner = newOwner
+	return k.setTokenizeShareRecordWithOwner(ctx, to, record)
+}
+
+func (k Keeper) setTokenizeShareRecordWithOwner(ctx context.Context, owner sdk.AccAddress, record types.TokenizeShareRecord) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&record)
+	if err != nil {
+		return err
+	}
+
+	if err = store.Set(types.GetTokenizeShareRecordByIndexKey(record.Id), bz); err != nil {
+		return err
+	}
+
+	if err = store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, record.Id), []byte{}); err != nil {
+		return err
+	}
+
+	return store.Set(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()), sdk.Uint64ToBigEndian(record.Id))
+}
+
+// GetTotalLiquidStakedTokens returns the total amount of tokens delegated
+// through tokenize share records.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx context.Context) (math.Int, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.TotalLiquidStakedTokensKey)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	if bz == nil {
+		return math.ZeroInt(), nil
+	}
+
+	ip := sdk.IntProto{}
+	if err = k.cdc.Unmarshal(bz, &ip); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	return ip.Int, nil
+}
+
+// SetTotalLiquidStakedTokens sets the total amount of tokens delegated
+// through tokenize share records.
+func (k Keeper) SetTotalLiquidStakedTokens(ctx context.Context, tokens math.Int) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&sdk.IntProto{Int: tokens})
+	if err != nil {
+		return err
+	}
+	return store.Set(types.TotalLiquidStakedTokensKey, bz)
+}
+
+// DecreaseTotalLiquidStakedTokens decreases the total amount of liquid staked
+// tokens, erroring if it would become negative.
+func (k Keeper) DecreaseTotalLiquidStakedTokens(ctx context.Context, amount math.Int) error {
+	total, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	if amount.GT(total) {
+		return types.ErrTotalLiquidStakedUnderflow
+	}
+
+	return k.SetTotalLiquidStakedTokens(ctx, total.Sub(amount))
+}
+
+// GetLiquidValidator returns the liquid staking accounting of a validator.
+// A validator without any liquid or validator bond shares has a zero record.
+func (k Keeper) GetLiquidValidator(ctx context.Context, valAddr sdk.ValAddress) (types.LiquidValidator, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetLiquidValidatorKey(valAddr))
+	if err != nil {
+		return types.LiquidValidator{}, err
+	}
+
+	if bz == nil {
+		operator, err := k.validatorAddressCodec.BytesToString(valAddr)
+		if err != nil {
+			return types.LiquidValidator{}, err
+		}
+		return types.NewLiquidValidator(operator), nil
+	}
+
+	var liquidVal types.LiquidValidator
+	err = k.cdc.Unmarshal(bz, &liquidVal)
+	return liquidVal, err
+}
+
+// SetLiquidValidator sets the liquid staking accounting of a validator. Zero
+// records are removed from the store.
+func (k Keeper) SetLiquidValidator(ctx context.Context, liquidVal types.LiquidValidator) error {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(liquidVal.OperatorAddress)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if liquidVal.LiquidShares.IsZero() && liquidVal.ValidatorBondShares.IsZero() {
+		return store.Delete(types.GetLiquidValidatorKey(valAddr))
+	}
+
+	bz, err := k.cdc.Marshal(&liquidVal)
+	if err != nil {
+		return err
+	}
+	return store.Set(types.GetLiquidValidatorKey(valAddr), bz)
+}
+
+// RemoveLiquidValidator removes the liquid staking accounting of a validator.
+func (k Keeper) RemoveLiquidValidator(ctx context.Context, valAddr sdk.ValAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GetLiquidValidatorKey(valAddr))
+}
+
+// GetAllLiquidValidators returns the liquid staking accounting of all
+// validators, used during genesis dump.
+func (k Keeper) GetAllLiquidValidators(ctx context.Context) (liquidVals []types.LiquidValidator, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.LiquidValidatorPrefix, storetypes.PrefixEndBytes(types.LiquidValidatorPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var liquidVal types.LiquidValidator
+		if err := k.cdc.Unmarshal(iterator.Value(), &liquidVal); err != nil {
+			return nil, err
+		}
+		liquidVals = append(liquidVals, liquidVal)
+	}
+
+	return liquidVals, nil
+}
+
+// IsValidatorBond returns true if the delegation is flagged as a validator bond.
+func (k Keeper) IsValidatorBond(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Has(types.GetValidatorBondKey(delAddr, valAddr))
+}
+
+// SetValidatorBond flags the delegation as a validator bond.
+func (k Keeper) SetValidatorBond(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetValidatorBondKey(delAddr, valAddr), []byte{})
+}
+
+// RemoveValidatorBond removes the validator bond flag of the delegation.
+func (k Keeper) RemoveValidatorBond(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GetValidatorBondKey(delAddr, valAddr))
+}
+
+// GetAllValidatorBonds returns all the delegations flagged as validator bond,
+// used during genesis dump.
+func (k Keeper) GetAllValidatorBonds(ctx context.Context) (bonds []types.DVPair, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.ValidatorBondKey, storetypes.PrefixEndBytes(types.ValidatorBondKey))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		delAddr, valAddr := types.ParseValidatorBondKey(iterator.Key())
+		delAddrStr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+		if err != nil {
+			return nil, err
+		}
+		valAddrStr, err := k.validatorAddressCodec.BytesToString(valAddr)
+		if err != nil {
+			return nil, err
+		}
+		bonds = append(bonds, types.DVPair{DelegatorAddress: delAddrStr, ValidatorAddress: valAddrStr})
+	}
+
+	return bonds, nil
+}
+
+// increaseValidatorBondShares increases the validator bond shares of a validator.
+func (k Keeper) increaseValidatorBondShares(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	liquidVal, err := k.GetLiquidValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	liquidVal.ValidatorBondShares = liquidVal.ValidatorBondShares.Add(shares)
+	return k.SetLiquidValidator(ctx, liquidVal)
+}
+
+// decreaseValidatorBondShares decreases the validator bond shares of a
+// validator without checking the validator bond factor. It is used when the
+// bond shares are removed outside of the control of the delegator, e.g. when
+// slashing redelegations.
+func (k Keeper) decreaseValidatorBondShares(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	liquidVal, err := k.GetLiquidValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	liquidVal.ValidatorBondShares = math.LegacyMaxDec(liquidVal.ValidatorBondShares.Sub(shares), math.LegacyZeroDec())
+	return k.SetLiquidValidator(ctx, liquidVal)
+}
+
+// CheckValidatorBondDecrease returns an error if removing the given shares from
+// a validator bond delegation would leave the validator with more liquid shares
+// than allowed by the validator bond factor.
+func (k Keeper) CheckValidatorBondDecrease(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	isBond, err := k.IsValidatorBond(ctx, delAddr, valAddr)
+	if err != nil || !isBond {
+		return err
+	}
+
+	factor, err := k.ValidatorBondFactor(ctx)
+	if err != nil {
+		return err
+	}
+
+	if factor.IsNil() || factor.IsNegative() {
+		return nil
+	}
+
+	liquidVal, err := k.GetLiquidValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	remaining := liquidVal.ValidatorBondShares.Sub(shares)
+	if liquidVal.LiquidShares.GT(remaining.Mul(factor)) {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientValidatorBondShares,
+			"validator has %s liquid shares, remaining validator bond shares %s", liquidVal.LiquidShares, remaining,
+		)
+	}
+
+	return nil
+}
+
+// checkLiquidStakingCaps returns an error if tokenizing the given tokens and
+// shares of a validator would exceed the global or validator liquid staking
+// caps, or the validator bond factor.
+func (k Keeper) checkLiquidStakingCaps(ctx context.Context, validator types.Validator, liquidVal types.LiquidValidator, tokens math.Int, shares math.LegacyDec) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.GlobalLiquidStakingCap.LT(math.LegacyOneDec()) {
+		totalLiquid, err := k.GetTotalLiquidStakedTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		totalBonded, err := k.TotalValidatorPower(ctx)
+		if err != nil {
+			return err
+		}
+
+		// tokens delegated to a validator outside of the active set are not
+		// part of the bonded pool yet, count them as if they were
+		if !validator.IsBonded() {
+			totalBonded = totalBonded.Add(tokens)
+		}
+
+		newTotalLiquid := math.LegacyNewDecFromInt(totalLiquid.Add(tokens))
+		if newTotalLiquid.GT(params.GlobalLiquidStakingCap.MulInt(totalBonded)) {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+	}
+
+	newLiquidShares := liquidVal.LiquidShares.Add(shares)
+	if params.ValidatorLiquidStakingCap.LT(math.LegacyOneDec()) &&
+		newLiquidShares.GT(params.ValidatorLiquidStakingCap.Mul(validator.DelegatorShares)) {
+		return types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	if !params.ValidatorBondFactor.IsNegative() &&
+		newLiquidShares.GT(liquidVal.ValidatorBondShares.Mul(params.ValidatorBondFactor)) {
+		return types.ErrInsufficientValidatorBondShares
+	}
+
+	return nil
+}
+
+// checkVestedDelegation returns an error if the account is a vesting account
+// and the amount exceeds the part of its delegations that is not vesting.
+func (k Keeper) checkVestedDelegation(ctx context.Context, delAddr sdk.AccAddress, amount sdk.Coin) error {
+	acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestingexported.VestingAccount)
+	if !ok {
+		return nil
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	// delegated vesting coins that have vested since they were delegated are free
+	vested := acc.GetDelegatedVesting().AmountOf(amount.Denom).Sub(acc.GetVestingCoins(blockTime).AmountOf(amount.Denom))
+	free := acc.GetDelegatedFree().AmountOf(amount.Denom).Add(math.MaxInt(vested, math.ZeroInt()))
+	if amount.Amount.GT(free) {
+		return errorsmod.Wrapf(types.ErrExceedingFreeVestingDelegations, "free delegation %s, requested %s", free, amount.Amount)
+	}
+
+	return nil
+}
+
+// TokenizeShares converts part of a delegation into share tokens. The tokens are
+// undelegated from the delegator and delegated again from the module account
+// of a new tokenize share record owned by the given owner. Share tokens
+// representing the delegation shares of the record are minted to the
+// delegator.
+func (k Keeper) TokenizeShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin, owner sdk.AccAddress,
+) (types.TokenizeShareRecord, sdk.Coin, error) {
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if amount.Denom != bondDenom {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrOnlyBondDenomAllowedForTokenize
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	isBond, err := k.IsValidatorBond(ctx, delAddr, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if isBond {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrValidatorBondNotAllowedForTokenizeShare
+	}
+
+	// tokenizing would allow slashable redelegated shares to escape slashing
+	hasRedelegation, err := k.HasReceivingRedelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if hasRedelegation {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+
+	if err = k.checkVestedDelegation(ctx, delAddr, amount); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount.Amount)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	liquidVal, err := k.GetLiquidValidator(ctx, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err = k.checkLiquidStakingCaps(ctx, validator, liquidVal, validator.TokensFromShares(shares).TruncateInt(), shares); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	lastID, err := k.GetLastTokenizeShareRecordID(ctx)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	ownerStr, err := k.authKeeper.AddressCodec().BytesToString(owner)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	record := types.NewTokenizeShareRecord(lastID+1, ownerStr, validator.GetOperator())
+	if err = k.AddTokenizeShareRecord(ctx, record); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err = k.SetLastTokenizeShareRecordID(ctx, record.Id); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	// unbond the shares and move the tokens to the record module account
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		if err = k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return types.TokenizeShareRecord{}, sdk.Coin{}, err
+		}
+	}
+
+	returnCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err = k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, returnCoins); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	if err = k.bankKeeper.SendCoins(ctx, delAddr, recordAddr, returnCoins); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	// reload the validator as unbonding changed its tokens and shares
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	newShares, err := k.Delegate(ctx, recordAddr, returnAmount, types.Unbonded, validator, true)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	liquidVal, err = k.GetLiquidValidator(ctx, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	liquidVal.LiquidShares = liquidVal.LiquidShares.Add(newShares)
+	if err = k.SetLiquidValidator(ctx, liquidVal); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	totalLiquid, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err = k.SetTotalLiquidStakedTokens(ctx, totalLiquid.Add(returnAmount)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	return record, shareToken, nil
+}
+
+// RedeemTokensForShares burns share tokens and converts the delegation shares
+// they represent back into a delegation of the redeemer. When the last share
+// token of a record is redeemed, the remaining balance of the record module
+// account, i.e. the accrued rewards, is sent to the record owner and the record
+// is deleted.
+func (k Keeper) RedeemTokensForShares(ctx context.Context, delAddr sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	balance := k.bankKeeper.GetBalance(ctx, delAddr, amount.Denom)
+	if balance.Amount.LT(amount.Amount) {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", balance, amount)
+	}
+
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, amount.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	delegation, err := k.GetDelegation(ctx, recordAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the last redemption takes the remaining shares of the record, so that
+	// no dust is left behind because of the truncation of the share tokens
+	supply := k.bankKeeper.GetSupply(ctx, amount.Denom)
+	isLastRedemption := supply.Amount.Equal(amount.Amount)
+	shares := math.LegacyNewDecFromInt(amount.Amount)
+	if isLastRedemption || shares.GT(delegation.Shares) {
+		shares = delegation.Shares
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, recordAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	liquidVal, err := k.GetLiquidValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if shares.GT(liquidVal.LiquidShares) {
+		return sdk.Coin{}, types.ErrValidatorLiquidSharesUnderflow
+	}
+
+	liquidVal.LiquidShares = liquidVal.LiquidShares.Sub(shares)
+	if err = k.SetLiquidValidator(ctx, liquidVal); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err = k.DecreaseTotalLiquidStakedTokens(ctx, returnAmount); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		if err = k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnCoin := sdk.NewCoin(bondDenom, returnAmount)
+	if err = k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, recordAddr, sdk.NewCoins(returnCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err = k.bankKeeper.SendCoins(ctx, recordAddr, delAddr, sdk.NewCoins(returnCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the validator may have been removed if the record held all its shares
+	validator, err = k.GetValidator(ctx, valAddr)
+	if errors.Is(err, types.ErrNoValidatorFound) {
+		return returnCoin, k.finishTokenizeShareRecord(ctx, record, isLastRedemption)
+	} else if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if _, err = k.Delegate(ctx, delAddr, returnAmount, types.Unbonded, validator, true); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return returnCoin, k.finishTokenizeShareRecord(ctx, record, isLastRedemption)
+}
+
+// finishTokenizeShareRecord sends the rewards accrued by the module account of
+// a record to its owner and deletes the record once all its share tokens have
+// been redeemed.
+func (k Keeper) finishTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord, isLastRedemption bool) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	if rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr); !rewards.IsZero() {
+		if err = k.bankKeeper.SendCoins(ctx, recordAddr, owner, rewards); err != nil {
+			return err
+		}
+	}
+
+	if !isLastRedemption {
+		return nil
+	}
+
+	return k.DeleteTokenizeShareRecord(ctx, record.Id)
+}
+
+// decreaseLiquidTokensOnSlash decreases the total liquid staked tokens by the
+// part of the slashed tokens of a validator backing tokenized shares.
+func (k Keeper) decreaseLiquidTokensOnSlash(ctx context.Context, validator types.Validator, tokensToBurn math.Int) error {
+	if !validator.DelegatorShares.IsPositive() {
+		return nil
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	liquidVal, err := k.GetLiquidValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if !liquidVal.LiquidShares.IsPositive() {
+		return nil
+	}
+
+	slashed := liquidVal.LiquidShares.MulInt(tokensToBurn).Quo(validator.DelegatorShares).TruncateInt()
+	total, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	// slashing must not fail on rounding, floor the total at zero instead
+	return k.SetTotalLiquidStakedTokens(ctx, math.MaxInt(total.Sub(slashed), math.ZeroInt()))
+}
@@ -0,0 +1,268 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// createLiquidStakingValidator creates a validator self-delegated by Addr with
+// 100 consensus power worth of tokens.
+func (s *KeeperTestSuite) createLiquidStakingValidator() math.Int {
+	require := s.Require()
+	s.execExpectCalls()
+
+	comm := stakingtypes.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := s.stakingKeeper.TokensFromConsensusPower(s.ctx, int64(100))
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		ValAddr.String(), ed25519.GenPrivKey().PubKey(), sdk.NewCoin(sdk.DefaultBondDenom, amt),
+		stakingtypes.Description{Moniker: "NewVal"}, comm, math.OneInt(),
+	)
+	require.NoError(err)
+	_, err = s.msgServer.CreateValidator(s.ctx, msg)
+	require.NoError(err)
+
+	return amt
+}
+
+func (s *KeeperTestSuite) expectLiquidStakingCalls() {
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), stakingtypes.BondedPoolName).Return(bondedAcc).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedAcc.GetAddress(), sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)).AnyTimes()
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), gomock.Any(), stakingtypes.NotBondedPoolName, gomock.Any()).AnyTimes()
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, gomock.Any(), gomock.Any()).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), stakingtypes.ModuleName, gomock.Any()).AnyTimes()
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.ModuleName, gomock.Any()).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), stakingtypes.ModuleName, gomock.Any(), gomock.Any()).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), stakingtypes.ModuleName, gomock.Any()).AnyTimes()
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), gomock.Any()).Return(sdk.NewCoins()).AnyTimes()
+}
+
+func (s *KeeperTestSuite) TestTokenizeShareRecords() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(2)
+	record1 := stakingtypes.NewTokenizeShareRecord(1, addrs[0].String(), valAddrs[0].String())
+	record2 := stakingtypes.NewTokenizeShareRecord(2, addrs[0].String(), valAddrs[1].String())
+
+	_, err := keeper.GetTokenizeShareRecord(ctx, 1)
+	require.ErrorIs(err, stakingtypes.ErrTokenizeShareRecordNotExists)
+
+	require.NoError(keeper.AddTokenizeShareRecord(ctx, record1))
+	require.NoError(keeper.AddTokenizeShareRecord(ctx, record2))
+	require.ErrorIs(keeper.AddTokenizeShareRecord(ctx, record1), stakingtypes.ErrTokenizeShareRecordAlreadyExists)
+
+	resRecord, err := keeper.GetTokenizeShareRecordByDenom(ctx, record2.GetShareTokenDenom())
+	require.NoError(err)
+	require.Equal(record2, resRecord)
+
+	owned, err := keeper.GetTokenizeShareRecordsByOwner(ctx, addrs[0])
+	require.NoError(err)
+	require.Equal([]stakingtypes.TokenizeShareRecord{record1, record2}, owned)
+
+	// transfer the first record to a new owner
+	require.NoError(keeper.TransferTokenizeShareRecord(ctx, 1, addrs[0], addrs[1]))
+	owned, err = keeper.GetTokenizeShareRecordsByOwner(ctx, addrs[0])
+	require.NoError(err)
+	require.Equal([]stakingtypes.TokenizeShareRecord{record2}, owned)
+	owned, err = keeper.GetTokenizeShareRecordsByOwner(ctx, addrs[1])
+	require.NoError(err)
+	require.Len(owned, 1)
+	require.Equal(addrs[1].String(), owned[0].Owner)
+
+	// delete the second record along with its indexes
+	require.NoError(keeper.DeleteTokenizeShareRecord(ctx, 2))
+	_, err = keeper.GetTokenizeShareRecordByDenom(ctx, record2.GetShareTokenDenom())
+	require.ErrorIs(err, stakingtypes.ErrTokenizeShareRecordNotExists)
+	all, err := keeper.GetAllTokenizeShareRecords(ctx)
+	require.NoError(err)
+	require.Len(all, 1)
+}
+
+func (s *KeeperTestSuite) TestMsgTokenizeSharesAndRedeem() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	selfBond := s.createLiquidStakingValidator()
+	s.expectLiquidStakingCalls()
+
+	owner := sdk.AccAddress([]byte("owner_______________"))
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, selfBond.QuoRaw(4))
+
+	_, err := msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(Addr.String(), ValAddr.String(), sdk.NewInt64Coin("foo", 10), owner.String()))
+	require.ErrorIs(err, stakingtypes.ErrOnlyBondDenomAllowedForTokenize)
+
+	res, err := msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(Addr.String(), ValAddr.String(), amount, owner.String()))
+	require.NoError(err)
+
+	record, err := keeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(err)
+	require.Equal(owner.String(), record.Owner)
+	require.Equal(record.GetShareTokenDenom(), res.Amount.Denom)
+	require.Equal(amount.Amount, res.Amount.Amount)
+
+	recordDel, err := keeper.GetDelegation(ctx, record.GetModuleAddress(), ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(amount.Amount), recordDel.Shares)
+
+	selfDel, err := keeper.GetDelegation(ctx, Addr, ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(selfBond.Sub(amount.Amount)), selfDel.Shares)
+
+	liquidVal, err := keeper.GetLiquidValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(recordDel.Shares, liquidVal.LiquidShares)
+
+	totalLiquid, err := keeper.GetTotalLiquidStakedTokens(ctx)
+	require.NoError(err)
+	require.Equal(amount.Amount, totalLiquid)
+
+	// redeem all the share tokens back
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), Addr, res.Amount.Denom).Return(res.Amount).AnyTimes()
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), res.Amount.Denom).Return(res.Amount).AnyTimes()
+
+	redeemRes, err := msgServer.RedeemTokensForShares(ctx, stakingtypes.NewMsgRedeemTokensForShares(Addr.String(), res.Amount))
+	require.NoError(err)
+	require.Equal(amount, redeemRes.Amount)
+
+	selfDel, err = keeper.GetDelegation(ctx, Addr, ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(selfBond), selfDel.Shares)
+
+	_, err = keeper.GetDelegation(ctx, record.GetModuleAddress(), ValAddr)
+	require.ErrorIs(err, stakingtypes.ErrNoDelegation)
+
+	_, err = keeper.GetTokenizeShareRecord(ctx, 1)
+	require.ErrorIs(err, stakingtypes.ErrTokenizeShareRecordNotExists)
+
+	liquidVal, err = keeper.GetLiquidValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.True(liquidVal.LiquidShares.IsZero())
+
+	totalLiquid, err = keeper.GetTotalLiquidStakedTokens(ctx)
+	require.NoError(err)
+	require.True(totalLiquid.IsZero())
+}
+
+func (s *KeeperTestSuite) TestMsgTransferTokenizeShareRecord() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	addrs, valAddrs := createValAddrs(2)
+	require.NoError(keeper.AddTokenizeShareRecord(ctx, stakingtypes.NewTokenizeShareRecord(1, addrs[0].String(), valAddrs[0].String())))
+
+	_, err := msgServer.TransferTokenizeShareRecord(ctx, stakingtypes.NewMsgTransferTokenizeShareRecord(1, addrs[1].String(), addrs[1].String()))
+	require.ErrorIs(err, stakingtypes.ErrNotTokenizeShareRecordOwner)
+
+	_, err = msgServer.TransferTokenizeShareRecord(ctx, stakingtypes.NewMsgTransferTokenizeShareRecord(2, addrs[0].String(), addrs[1].String()))
+	require.ErrorIs(err, stakingtypes.ErrTokenizeShareRecordNotExists)
+
+	_, err = msgServer.TransferTokenizeShareRecord(ctx, stakingtypes.NewMsgTransferTokenizeShareRecord(1, addrs[0].String(), addrs[1].String()))
+	require.NoError(err)
+
+	record, err := keeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(err)
+	require.Equal(addrs[1].String(), record.Owner)
+}
+
+func (s *KeeperTestSuite) TestLiquidStakingCaps() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	selfBond := s.createLiquidStakingValidator()
+	s.expectLiquidStakingCalls()
+
+	owner := sdk.AccAddress([]byte("owner_______________"))
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, selfBond.QuoRaw(4))
+	msg := stakingtypes.NewMsgTokenizeShares(Addr.String(), ValAddr.String(), amount, owner.String())
+
+	testCases := []struct {
+		name     string
+		malleate func(params *stakingtypes.Params)
+		expErr   error
+	}{
+		{
+			name: "global cap exceeded",
+			malleate: func(params *stakingtypes.Params) {
+				params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(1, 1)
+			},
+			expErr: stakingtypes.ErrGlobalLiquidStakingCapExceeded,
+		},
+		{
+			name: "validator cap exceeded",
+			malleate: func(params *stakingtypes.Params) {
+				params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(1, 1)
+			},
+			expErr: stakingtypes.ErrValidatorLiquidStakingCapExceeded,
+		},
+		{
+			name: "insufficient validator bond",
+			malleate: func(params *stakingtypes.Params) {
+				params.ValidatorBondFactor = math.LegacyNewDec(10)
+			},
+			expErr: stakingtypes.ErrInsufficientValidatorBondShares,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			params := stakingtypes.DefaultParams()
+			tc.malleate(&params)
+			require.NoError(keeper.SetParams(ctx, params))
+
+			_, err := msgServer.TokenizeShares(ctx, msg)
+			require.ErrorIs(err, tc.expErr)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgValidatorBond() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	selfBond := s.createLiquidStakingValidator()
+	s.expectLiquidStakingCalls()
+
+	_, err := msgServer.ValidatorBond(ctx, stakingtypes.NewMsgValidatorBond(sdk.AccAddress([]byte("nodelegation________")).String(), ValAddr.String()))
+	require.ErrorIs(err, stakingtypes.ErrNoDelegation)
+
+	_, err = msgServer.ValidatorBond(ctx, stakingtypes.NewMsgValidatorBond(Addr.String(), ValAddr.String()))
+	require.NoError(err)
+
+	_, err = msgServer.ValidatorBond(ctx, stakingtypes.NewMsgValidatorBond(Addr.String(), ValAddr.String()))
+	require.ErrorIs(err, stakingtypes.ErrValidatorBondAlreadyExists)
+
+	liquidVal, err := keeper.GetLiquidValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(selfBond), liquidVal.ValidatorBondShares)
+
+	// validator bond delegations cannot be tokenized
+	owner := sdk.AccAddress([]byte("owner_______________"))
+	_, err = msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(Addr.String(), ValAddr.String(), sdk.NewCoin(sdk.DefaultBondDenom, selfBond.QuoRaw(4)), owner.String()))
+	require.ErrorIs(err, stakingtypes.ErrValidatorBondNotAllowedForTokenizeShare)
+
+	// with a validator bond factor of 1, the liquid shares cannot exceed the validator bond shares
+	params := stakingtypes.DefaultParams()
+	params.ValidatorBondFactor = math.LegacyOneDec()
+	require.NoError(keeper.SetParams(ctx, params))
+
+	liquidVal.LiquidShares = math.LegacyNewDecFromInt(selfBond.QuoRaw(2))
+	require.NoError(keeper.SetLiquidValidator(ctx, liquidVal))
+
+	_, err = msgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(Addr.String(), ValAddr.String(), sdk.NewCoin(sdk.DefaultBondDenom, selfBond.QuoRaw(4).MulRaw(3))))
+	require.ErrorIs(err, stakingtypes.ErrInsufficientValidatorBondShares)
+
+	_, err = msgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(Addr.String(), ValAddr.String(), sdk.NewCoin(sdk.DefaultBondDenom, selfBond.QuoRaw(4))))
+	require.NoError(err)
+
+	liquidVal, err = keeper.GetLiquidValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(selfBond.Sub(selfBond.QuoRaw(4))), liquidVal.ValidatorBondShares)
+}
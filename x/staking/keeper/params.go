@@ -56,6 +56,24 @@ func (k Keeper) MinCommissionRate(ctx context.Context) (math.LegacyDec, error) {
 	return params.MinCommissionRate, err
 }
 
+// ValidatorBondFactor - Maximum ratio of liquid shares to validator bond shares of a validator
+func (k Keeper) ValidatorBondFactor(ctx context.Context) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	return params.ValidatorBondFactor, err
+}
+
+// GlobalLiquidStakingCap - Maximum fraction of the total bonded tokens that can be liquid staked
+func (k Keeper) GlobalLiquidStakingCap(ctx context.Context) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	return params.GlobalLiquidStakingCap, err
+}
+
+// ValidatorLiquidStakingCap - Maximum fraction of the shares of a validator that can be liquid staked
+func (k Keeper) ValidatorLiquidStakingCap(ctx context.Context) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	return params.ValidatorLiquidStakingCap, err
+}
+
 // SetParams sets the x/staking module parameters.
 // CONTRACT: This method performs no validation of the parameters.
 func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
@@ -146,6 +146,14 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 		return nil, fmt.Errorf("failed to get last validator set: %w", err)
 	}
 
+	// Retrieve the consensus key rotations of this block. CometBFT still knows
+	// the rotated validators by their old key, which has to be removed from the
+	// validator set in favor of the new one.
+	rotated, err := k.blockConsPubKeyRotations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get consensus key rotations: %w", err)
+	}
+
 	// Iterate over validators, highest power to lowest.
 	iterator, err := k.ValidatorsPowerStoreIterator(ctx)
 	if err != nil {
@@ -197,8 +205,9 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 		oldPower, found := last[valAddrStr]
 		newPower := validator.ConsensusPower(powerReduction)
 
-		// update the validator set if power has changed
-		if !found || oldPower != newPower {
+		// update the validator set if power or consensus key has changed
+		history, isRotated := rotated[valAddrStr]
+		if !found || oldPower != newPower || isRotated {
 			updates = append(updates, validator.ABCIValidatorUpdate(powerReduction))
 
 			if err = k.SetLastValidatorPower(ctx, valAddr, newPower); err != nil {
@@ -206,6 +215,14 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 			}
 		}
 
+		if found && isRotated {
+			update, err := oldConsPubKeyUpdateZero(history)
+			if err != nil {
+				return nil, err
+			}
+			updates = append(updates, update)
+		}
+
 		delete(last, valAddrStr)
 		count++
 
@@ -235,7 +252,17 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 			return nil, err
 		}
 
-		updates = append(updates, validator.ABCIValidatorUpdateZero())
+		// a validator rotating its consensus key in this block is still known
+		// to CometBFT by its old key
+		if history, isRotated := rotated[string(valAddrBytes)]; isRotated {
+			update, err := oldConsPubKeyUpdateZero(history)
+			if err != nil {
+				return nil, err
+			}
+			updates = append(updates, update)
+		} else {
+			updates = append(updates, validator.ABCIValidatorUpdateZero())
+		}
 	}
 
 	// Update the pools based on the recent updates in the validator set:
@@ -43,7 +43,8 @@ func (k Keeper) mustGetValidator(ctx context.Context, addr sdk.ValAddress) types
 	return validator
 }
 
-// GetValidatorByConsAddr gets a single validator by consensus address
+// GetValidatorByConsAddr gets a single validator by consensus address. Consensus
+// addresses retired by a key rotation still resolve to their validator.
 func (k Keeper) GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (validator types.Validator, err error) {
 	store := k.storeService.OpenKVStore(ctx)
 	opAddr, err := store.Get(types.GetValidatorByConsAddrKey(consAddr))
@@ -52,7 +53,16 @@ func (k Keeper) GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAdd
 	}
 
 	if opAddr == nil {
-		return validator, types.ErrNoValidatorFound
+		history, err := k.GetConsPubKeyRotationByOldConsAddr(ctx, consAddr)
+		if errors.Is(err, types.ErrNoConsPubKeyRotationFound) {
+			return validator, types.ErrNoValidatorFound
+		} else if err != nil {
+			return validator, err
+		}
+
+		if opAddr, err = k.validatorAddressCodec.StringToBytes(history.OperatorAddress); err != nil {
+			return validator, err
+		}
 	}
 
 	return k.GetValidator(ctx, opAddr)
@@ -246,6 +256,14 @@ func (k Keeper) RemoveValidator(ctx context.Context, address sdk.ValAddress) err
 		return err
 	}
 
+	if err = k.RemoveLiquidValidator(ctx, address); err != nil {
+		return err
+	}
+
+	if err = k.RemoveValidatorConsPubKeyRotations(ctx, address); err != nil {
+		return err
+	}
+
 	str, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
 	if err != nil {
 		return err
@@ -0,0 +1,46 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	v6 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v6"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	// params stored before v6 have no liquid staking or key rotation parameters
+	oldParams := types.DefaultParams()
+	oldParams.ValidatorBondFactor = sdkmath.LegacyDec{}
+	oldParams.GlobalLiquidStakingCap = sdkmath.LegacyDec{}
+	oldParams.ValidatorLiquidStakingCap = sdkmath.LegacyDec{}
+	oldParams.KeyRotationFee = sdk.Coin{}
+	oldParams.MaxConsPubkeyRotations = 0
+	oldParams.MaxValidators = 42
+	oldParams.BondDenom = "uatom"
+	store.Set(types.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v6.MigrateStore(ctx, store, cdc))
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.Equal(t, uint32(42), params.MaxValidators)
+	require.Equal(t, types.DefaultValidatorBondFactor, params.ValidatorBondFactor)
+	require.Equal(t, types.DefaultGlobalLiquidStakingCap, params.GlobalLiquidStakingCap)
+	require.Equal(t, types.DefaultValidatorLiquidStakingCap, params.ValidatorLiquidStakingCap)
+	require.Equal(t, sdk.NewCoin("uatom", types.DefaultKeyRotationFee.Amount), params.KeyRotationFee)
+	require.Equal(t, types.DefaultMaxConsPubKeyRotations, params.MaxConsPubkeyRotations)
+}
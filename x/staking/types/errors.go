@@ -48,4 +48,23 @@ var (
 	ErrInvalidSigner                   = errors.Register(ModuleName, 43, "expected authority account as only signer for proposal message")
 	ErrBadRedelegationSrc              = errors.Register(ModuleName, 44, "redelegation source validator not found")
 	ErrNoUnbondingType                 = errors.Register(ModuleName, 45, "unbonding type not found")
+
+	ErrTokenizeShareRecordNotExists            = errors.Register(ModuleName, 46, "tokenize share record not exists")
+	ErrTokenizeShareRecordAlreadyExists        = errors.Register(ModuleName, 47, "tokenize share record already exists")
+	ErrNotTokenizeShareRecordOwner             = errors.Register(ModuleName, 48, "not tokenize share record owner")
+	ErrOnlyBondDenomAllowedForTokenize         = errors.Register(ModuleName, 49, "only bond denom is allowed for tokenize")
+	ErrRedelegationInProgress                  = errors.Register(ModuleName, 50, "delegator is not allowed to tokenize shares from validator with a redelegation in progress")
+	ErrExceedingFreeVestingDelegations         = errors.Register(ModuleName, 51, "trying to exceed vested free delegation for vesting account")
+	ErrValidatorBondNotAllowedForTokenizeShare = errors.Register(ModuleName, 52, "validator bond delegation is not allowed to tokenize share")
+	ErrInsufficientValidatorBondShares         = errors.Register(ModuleName, 53, "insufficient validator bond shares")
+	ErrGlobalLiquidStakingCapExceeded          = errors.Register(ModuleName, 54, "delegation or tokenization exceeds the global cap")
+	ErrValidatorLiquidStakingCapExceeded       = errors.Register(ModuleName, 55, "delegation or tokenization exceeds the validator cap")
+	ErrValidatorBondAlreadyExists              = errors.Register(ModuleName, 56, "delegation is already a validator bond")
+	ErrTotalLiquidStakedUnderflow              = errors.Register(ModuleName, 57, "liquid staked tokens cannot be negative")
+	ErrValidatorLiquidSharesUnderflow          = errors.Register(ModuleName, 58, "validator liquid shares cannot be negative")
+
+	ErrConsensusPubKeyAlreadyUsedForValidator = errors.Register(ModuleName, 59, "consensus pubkey is already used for a validator")
+	ErrExceedingMaxConsPubKeyRotations        = errors.Register(ModuleName, 60, "exceeding maximum consensus pubkey rotations within unbonding period")
+	ErrConsensusPubKeyRotationInBlock         = errors.Register(ModuleName, 61, "validator already rotated its consensus pubkey in this block")
+	ErrNoConsPubKeyRotationFound              = errors.Register(ModuleName, 62, "consensus pubkey rotation not found")
 )
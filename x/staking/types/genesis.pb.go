@@ -46,6 +46,18 @@ type GenesisState struct {
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	// exported defines a bool to identify whether the chain dealing with exported or initialized genesis.
 	Exported bool `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// tokenize_share_records defines the tokenize share records active at genesis.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the most recently created tokenize share record.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// total_liquid_staked_tokens is the total amount of tokens that are liquid staked.
+	TotalLiquidStakedTokens cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=total_liquid_staked_tokens,json=totalLiquidStakedTokens,proto3,customtype=cosmossdk.io/math.Int" json:"total_liquid_staked_tokens"`
+	// liquid_validators defines the liquid staking accounting of each validator.
+	LiquidValidators []LiquidValidator `protobuf:"bytes,12,rep,name=liquid_validators,json=liquidValidators,proto3" json:"liquid_validators"`
+	// validator_bonds defines the delegations flagged as validator bond.
+	ValidatorBonds []DVPair `protobuf:"bytes,13,rep,name=validator_bonds,json=validatorBonds,proto3" json:"validator_bonds"`
+	// cons_pubkey_rotations defines the consensus key rotation history of all validators.
+	ConsPubkeyRotations []ConsPubKeyRotationHistory `protobuf:"bytes,14,rep,name=cons_pubkey_rotations,json=consPubkeyRotations,proto3" json:"cons_pubkey_rotations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -130,6 +142,41 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLiquidValidators() []LiquidValidator {
+	if m != nil {
+		return m.LiquidValidators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorBonds() []DVPair {
+	if m != nil {
+		return m.ValidatorBonds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsPubkeyRotations() []ConsPubKeyRotationHistory {
+	if m != nil {
+		return m.ConsPubkeyRotations
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -181,40 +228,54 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 513 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0x86, 0x6d, 0x4a, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x09, 0x32, 0x19, 0x9c, 0x10, 0x75, 0x88,
-	0x0a, 0xb1, 0x69, 0xd8, 0xd8, 0x1a, 0x21, 0x50, 0xa5, 0x4a, 0x54, 0x2e, 0x65, 0xe8, 0x12, 0x5d,
-	0xea, 0x93, 0x73, 0x8a, 0x7d, 0x67, 0xf9, 0xbe, 0x94, 0xf2, 0x0f, 0x18, 0xd9, 0x58, 0x3b, 0x32,
-	0x32, 0xf4, 0x47, 0x74, 0xac, 0x3a, 0x21, 0x86, 0x0a, 0x25, 0x03, 0xfc, 0x0c, 0xe4, 0x3b, 0xc7,
-	0x18, 0xa5, 0x5e, 0x92, 0x38, 0xdf, 0xf3, 0x3e, 0xef, 0x27, 0xf9, 0x0e, 0x6d, 0x9d, 0x08, 0x19,
-	0x09, 0xe9, 0x4a, 0x20, 0x53, 0xc6, 0x03, 0xf7, 0x74, 0x67, 0x4c, 0x81, 0xec, 0xb8, 0x01, 0xe5,
-	0x54, 0x32, 0xe9, 0xc4, 0x89, 0x00, 0x81, 0x1f, 0x6b, 0xca, 0xc9, 0x28, 0x27, 0xa3, 0x5a, 0x8d,
-	0x40, 0x04, 0x42, 0x21, 0x6e, 0xfa, 0x4b, 0xd3, 0xad, 0x32, 0xe7, 0x32, 0xad, 0xa9, 0x27, 0x9a,
-	0x1a, 0xe9, 0x78, 0x56, 0xa0, 0x47, 0x0f, 0x49, 0xc4, 0xb8, 0x70, 0xd5, 0xa7, 0xfe, 0xab, 0xfb,
-	0xb5, 0x82, 0x36, 0xdf, 0xea, 0x9d, 0x0e, 0x81, 0x00, 0xc5, 0xbb, 0xa8, 0x1a, 0x93, 0x84, 0x44,
-	0xd2, 0x32, 0x3b, 0x66, 0xaf, 0x3e, 0xb0, 0x9d, 0xdb, 0x77, 0x74, 0x0e, 0x14, 0x35, 0xdc, 0xb8,
-	0xbc, 0x69, 0x1b, 0xdf, 0x7e, 0x7f, 0xdf, 0x36, 0xbd, 0x2c, 0x88, 0x8f, 0xd1, 0x83, 0x90, 0x48,
-	0x18, 0x81, 0x00, 0x12, 0x8e, 0x62, 0xf1, 0x91, 0x26, 0xd6, 0x9d, 0x8e, 0xd9, 0xdb, 0x1c, 0xbe,
-	0x48, 0xe1, 0x9f, 0x37, 0xed, 0xa6, 0x76, 0x4a, 0x7f, 0xea, 0x30, 0xe1, 0x46, 0x04, 0x26, 0xce,
-	0x1e, 0x87, 0xeb, 0x8b, 0x3e, 0xca, 0xca, 0xf6, 0x38, 0x68, 0xe7, 0xfd, 0xd4, 0xf4, 0x3e, 0x15,
-	0x1d, 0xa4, 0x1e, 0xcc, 0x50, 0x53, 0xb9, 0x4f, 0x49, 0xc8, 0x7c, 0x02, 0x22, 0xd1, 0x7e, 0x69,
-	0xad, 0x75, 0xd6, 0x7a, 0xf5, 0xc1, 0x76, 0xd9, 0xb6, 0xfb, 0x44, 0xc2, 0x87, 0x65, 0x46, 0xa9,
-	0x8a, 0x9b, 0x3f, 0x0a, 0x57, 0xc6, 0x12, 0xef, 0x23, 0x94, 0xb7, 0x48, 0xeb, 0xae, 0xf2, 0x3f,
-	0x2d, 0xf3, 0xe7, 0xe1, 0xa2, 0xb6, 0x90, 0xc7, 0xef, 0x50, 0xdd, 0xa7, 0x21, 0x0d, 0x08, 0x30,
-	0xc1, 0xa5, 0x55, 0x51, 0xba, 0x6e, 0x99, 0xee, 0x75, 0x8e, 0x16, 0x7d, 0x45, 0x03, 0x9e, 0xa2,
-	0xe6, 0x8c, 0x8f, 0x05, 0xf7, 0x19, 0x0f, 0x46, 0x45, 0x75, 0x55, 0xa9, 0x9f, 0x95, 0xa9, 0x8f,
-	0x96, 0xa1, 0xdb, 0x3b, 0x1a, 0xb3, 0xd5, 0xb9, 0xc4, 0x47, 0xe8, 0x5e, 0x42, 0x8b, 0x25, 0xeb,
-	0xaa, 0x64, 0xab, 0xac, 0xc4, 0x2b, 0xc0, 0x45, 0xfb, 0xff, 0x16, 0xdc, 0x42, 0x35, 0x7a, 0x16,
-	0x8b, 0x04, 0xa8, 0x6f, 0xd5, 0x3a, 0x66, 0xaf, 0xe6, 0xe5, 0xcf, 0xdd, 0x09, 0xc2, 0xab, 0x2f,
-	0x0d, 0x0f, 0xd0, 0x3a, 0xf1, 0xfd, 0x84, 0x4a, 0x7d, 0x3e, 0x37, 0x86, 0xd6, 0xf5, 0x45, 0xbf,
-	0x91, 0x6d, 0xb1, 0xab, 0x27, 0x87, 0x90, 0x30, 0x1e, 0x78, 0x4b, 0x10, 0x37, 0x50, 0xe5, 0xdf,
-	0x21, 0x5c, 0xf3, 0xf4, 0xc3, 0xab, 0xda, 0xe7, 0xf3, 0xb6, 0xf1, 0xe7, 0xbc, 0x6d, 0x0c, 0xdf,
-	0x5c, 0xce, 0x6d, 0xf3, 0x6a, 0x6e, 0x9b, 0xbf, 0xe6, 0xb6, 0xf9, 0x65, 0x61, 0x1b, 0x57, 0x0b,
-	0xdb, 0xf8, 0xb1, 0xb0, 0x8d, 0xe3, 0xe7, 0x01, 0x83, 0xc9, 0x6c, 0xec, 0x9c, 0x88, 0x28, 0xbb,
-	0x49, 0xd9, 0x57, 0x5f, 0xfa, 0x53, 0xf7, 0x2c, 0xbf, 0x89, 0xf0, 0x29, 0xa6, 0x72, 0x5c, 0x55,
-	0x57, 0xea, 0xe5, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x57, 0x29, 0x7a, 0x5e, 0xfc, 0x03, 0x00,
-	0x00,
+	// 740 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xc7, 0xbb, 0x3f, 0xfe, 0x95, 0x29, 0x7f, 0x87, 0x96, 0xdf, 0xd2, 0x68, 0x5b, 0x09, 0x89,
+	0x0d, 0xda, 0x5d, 0x40, 0xbd, 0x78, 0xa3, 0x10, 0x95, 0x48, 0x62, 0xb3, 0x05, 0x0e, 0x5c, 0x36,
+	0xd3, 0xee, 0x64, 0x3b, 0x69, 0xbb, 0x53, 0x67, 0xa6, 0x48, 0xbd, 0x68, 0xe2, 0xc5, 0xa3, 0x2f,
+	0x81, 0xa3, 0x47, 0x0f, 0xbc, 0x08, 0x8e, 0x04, 0x2f, 0xc6, 0x03, 0x31, 0x70, 0xd0, 0x97, 0x61,
+	0x76, 0x66, 0xdb, 0x2e, 0x69, 0x17, 0xe2, 0xa5, 0xe9, 0xee, 0x7c, 0xbf, 0x9f, 0xef, 0x33, 0x9b,
+	0xe7, 0x99, 0x01, 0x2b, 0x55, 0xca, 0x9b, 0x94, 0x9b, 0x5c, 0xa0, 0x3a, 0xf1, 0x5c, 0xf3, 0x68,
+	0xbd, 0x82, 0x05, 0x5a, 0x37, 0x5d, 0xec, 0x61, 0x4e, 0xb8, 0xd1, 0x62, 0x54, 0x50, 0xb8, 0xa8,
+	0x54, 0x46, 0xa0, 0x32, 0x02, 0x55, 0x3a, 0xe9, 0x52, 0x97, 0x4a, 0x89, 0xe9, 0xff, 0x53, 0xea,
+	0x74, 0x14, 0xb3, 0xeb, 0x56, 0xaa, 0x25, 0xa5, 0xb2, 0x95, 0x3d, 0x08, 0x50, 0x4b, 0xf3, 0xa8,
+	0x49, 0x3c, 0x6a, 0xca, 0x5f, 0xf5, 0x6a, 0xf9, 0x3b, 0x00, 0x53, 0x2f, 0x55, 0x4d, 0x65, 0x81,
+	0x04, 0x86, 0x9b, 0x60, 0xbc, 0x85, 0x18, 0x6a, 0x72, 0x5d, 0xcb, 0x69, 0xf9, 0xc4, 0x46, 0xc6,
+	0x18, 0x5e, 0xa3, 0x51, 0x92, 0xaa, 0xe2, 0xe4, 0xd9, 0x65, 0x36, 0xf6, 0xf5, 0xf7, 0xb7, 0x55,
+	0xcd, 0x0a, 0x8c, 0xf0, 0x10, 0xcc, 0x35, 0x10, 0x17, 0xb6, 0xa0, 0x02, 0x35, 0xec, 0x16, 0x7d,
+	0x87, 0x99, 0xfe, 0x5f, 0x4e, 0xcb, 0x4f, 0x15, 0xd7, 0x7c, 0xf1, 0xcf, 0xcb, 0x6c, 0x4a, 0x31,
+	0xb9, 0x53, 0x37, 0x08, 0x35, 0x9b, 0x48, 0xd4, 0x8c, 0x1d, 0x4f, 0x5c, 0x9c, 0x16, 0x40, 0x10,
+	0xb6, 0xe3, 0x09, 0xc5, 0x9c, 0xf1, 0x49, 0x7b, 0x3e, 0xa8, 0xe4, 0x73, 0x20, 0x01, 0x29, 0xc9,
+	0x3e, 0x42, 0x0d, 0xe2, 0x20, 0x41, 0x99, 0xe2, 0x73, 0x7d, 0x24, 0x37, 0x92, 0x4f, 0x6c, 0xac,
+	0x46, 0x55, 0xbb, 0x8b, 0xb8, 0x38, 0xe8, 0x7a, 0x24, 0x2a, 0x5c, 0xf9, 0x42, 0x63, 0x60, 0x99,
+	0xc3, 0x5d, 0x00, 0x7a, 0x29, 0x5c, 0x1f, 0x95, 0xfc, 0x07, 0x51, 0xfc, 0x9e, 0x39, 0x8c, 0x0d,
+	0xf9, 0xe1, 0x1b, 0x90, 0x70, 0x70, 0x03, 0xbb, 0x48, 0x10, 0xea, 0x71, 0x7d, 0x4c, 0xe2, 0x96,
+	0xa3, 0x70, 0xdb, 0x3d, 0x69, 0x98, 0x17, 0x26, 0xc0, 0x3a, 0x48, 0xb5, 0xbd, 0x0a, 0xf5, 0x1c,
+	0xe2, 0xb9, 0x76, 0x18, 0x3d, 0x2e, 0xd1, 0x8f, 0xa2, 0xd0, 0xfb, 0x5d, 0xd3, 0xf0, 0x8c, 0x64,
+	0x7b, 0x70, 0x9d, 0xc3, 0x7d, 0x30, 0xcd, 0x70, 0x38, 0x64, 0x42, 0x86, 0xac, 0x44, 0x85, 0x58,
+	0x21, 0x71, 0x98, 0x7e, 0x93, 0x02, 0xd3, 0x20, 0x8e, 0x8f, 0x5b, 0x94, 0x09, 0xec, 0xe8, 0xf1,
+	0x9c, 0x96, 0x8f, 0x5b, 0xbd, 0x67, 0xf8, 0x01, 0x2c, 0x0a, 0x5a, 0xc7, 0x1e, 0x79, 0x8f, 0x6d,
+	0x5e, 0x43, 0x0c, 0xdb, 0x0c, 0x57, 0x29, 0x73, 0xb8, 0x3e, 0x79, 0xfb, 0x06, 0xf7, 0x02, 0x57,
+	0xd9, 0x37, 0x59, 0xd2, 0x53, 0xbc, 0x27, 0x1b, 0xef, 0xb4, 0x30, 0xab, 0x3c, 0x05, 0xee, 0xd4,
+	0x73, 0x6b, 0xc6, 0xb3, 0xa7, 0xc1, 0x9e, 0xc5, 0xa0, 0xc5, 0xdf, 0xf3, 0xfd, 0xa0, 0x8d, 0x87,
+	0x54, 0x61, 0x13, 0x47, 0x07, 0x39, 0x2d, 0x3f, 0x5a, 0x5c, 0x18, 0x82, 0xb5, 0x96, 0x54, 0xdb,
+	0x0e, 0x50, 0x77, 0x1c, 0xf8, 0x51, 0x03, 0x69, 0x35, 0x19, 0x0d, 0xf2, 0xb6, 0x4d, 0x1c, 0xdb,
+	0xaf, 0x1f, 0x3b, 0x2a, 0x86, 0xeb, 0x09, 0x39, 0x28, 0x5b, 0xff, 0x30, 0x28, 0x91, 0xdb, 0xfa,
+	0x5f, 0xc6, 0xec, 0xca, 0x94, 0xb2, 0x0c, 0x91, 0x15, 0x71, 0xc8, 0xc0, 0x7c, 0x90, 0x1d, 0x6a,
+	0xf0, 0x29, 0xf9, 0x55, 0x1f, 0x46, 0x0e, 0x90, 0x34, 0xf4, 0xdb, 0xfc, 0xf6, 0x2f, 0x3a, 0xd7,
+	0xb8, 0x29, 0xe7, 0x10, 0x83, 0xd9, 0xfe, 0xcc, 0xfa, 0x1d, 0xc6, 0xf5, 0x69, 0x99, 0x18, 0x79,
+	0xc0, 0x6c, 0x1f, 0x94, 0x10, 0xb9, 0x2b, 0x68, 0xa6, 0x07, 0x2d, 0xfa, 0x4c, 0xf8, 0x49, 0x03,
+	0xa9, 0x2a, 0xf5, 0xb8, 0xdd, 0x6a, 0x57, 0xea, 0xb8, 0x63, 0x33, 0x2a, 0x82, 0x8e, 0x9d, 0x91,
+	0x69, 0xeb, 0x51, 0x69, 0x5b, 0xd4, 0xe3, 0xa5, 0x76, 0xe5, 0x35, 0xee, 0x58, 0x81, 0xe5, 0x15,
+	0xe1, 0x82, 0xb2, 0xce, 0x1d, 0x05, 0x2c, 0x54, 0x95, 0xb1, 0xde, 0x37, 0xf2, 0xe5, 0x1a, 0x80,
+	0x83, 0x07, 0x0e, 0xdc, 0x00, 0x13, 0xc8, 0x71, 0x18, 0xe6, 0xea, 0x6c, 0x9d, 0x2c, 0xea, 0x17,
+	0xa7, 0x85, 0x64, 0x50, 0xcf, 0xa6, 0x5a, 0x29, 0x0b, 0x46, 0x3c, 0xd7, 0xea, 0x0a, 0x61, 0x12,
+	0x8c, 0xf5, 0x0f, 0xd0, 0x11, 0x4b, 0x3d, 0x3c, 0x8f, 0x7f, 0x3e, 0xc9, 0xc6, 0xfe, 0x9c, 0x64,
+	0x63, 0xc5, 0x17, 0x67, 0x57, 0x19, 0xed, 0xfc, 0x2a, 0xa3, 0xfd, 0xba, 0xca, 0x68, 0x5f, 0xae,
+	0x33, 0xb1, 0xf3, 0xeb, 0x4c, 0xec, 0xc7, 0x75, 0x26, 0x76, 0xf8, 0xd8, 0x25, 0xa2, 0xd6, 0xae,
+	0x18, 0x55, 0xda, 0x0c, 0x6e, 0x01, 0xb3, 0xbf, 0x01, 0xf3, 0xb8, 0x77, 0x8b, 0x88, 0x4e, 0x0b,
+	0xf3, 0xca, 0xb8, 0xbc, 0x0e, 0x9e, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x3d, 0xbb, 0xea, 0x94,
+	0xb8, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -237,6 +298,77 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConsPubkeyRotations) > 0 {
+		for iNdEx := len(m.ConsPubkeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsPubkeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ValidatorBonds) > 0 {
+		for iNdEx := len(m.ValidatorBonds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorBonds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.LiquidValidators) > 0 {
+		for iNdEx := len(m.LiquidValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size := m.TotalLiquidStakedTokens.Size()
+		i -= size
+		if _, err := m.TotalLiquidStakedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -429,6 +561,35 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	l = m.TotalLiquidStakedTokens.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LiquidValidators) > 0 {
+		for _, e := range m.LiquidValidators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorBonds) > 0 {
+		for _, e := range m.ValidatorBonds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsPubkeyRotations) > 0 {
+		for _, e := range m.ConsPubkeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -739,6 +900,194 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidStakedTokens", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLiquidStakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidValidators = append(m.LiquidValidators, LiquidValidator{})
+			if err := m.LiquidValidators[len(m.LiquidValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBonds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorBonds = append(m.ValidatorBonds, DVPair{})
+			if err := m.ValidatorBonds[len(m.ValidatorBonds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubkeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsPubkeyRotations = append(m.ConsPubkeyRotations, ConsPubKeyRotationHistory{})
+			if err := m.ConsPubkeyRotations[len(m.ConsPubkeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
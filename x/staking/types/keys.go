@@ -60,8 +60,24 @@ var (
 
 	// NOTE: keys in range 0x81–0x87 were previously used in liquid staking forks of the staking module.
 	// Module developers MUST NOT use these keys and MUST consider them "reserved".
+
+	TokenizeShareRecordPrefix          = []byte{0x91} // key for tokenize share records, by id
+	TokenizeShareRecordIDByOwnerPrefix = []byte{0x92} // prefix for each key for a tokenize share record id, by owner
+	TokenizeShareRecordIDByDenomPrefix = []byte{0x93} // prefix for each key for a tokenize share record id, by denom
+	LastTokenizeShareRecordIDKey       = []byte{0x94} // key for the last tokenize share record id
+	TotalLiquidStakedTokensKey         = []byte{0x95} // key for the total amount of liquid staked tokens
+	LiquidValidatorPrefix              = []byte{0x96} // prefix for each key to a liquid validator
+	ValidatorBondKey                   = []byte{0x97} // prefix for each key for a delegation flagged as validator bond
+
+	ConsPubKeyRotationHistoryKey      = []byte{0xA1} // prefix for the consensus key rotation history, by validator and height
+	BlockConsPubKeyRotationHistoryKey = []byte{0xA2} // prefix for each key to a consensus key rotation, by height and validator
+	RotatedConsAddrKey                = []byte{0xA3} // prefix for the consensus key rotation that retired a consensus address
 )
 
+// TokenizeShareModuleAccountPrefix is the prefix of the module account name
+// holding the delegation of a tokenize share record.
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
 // UnbondingType defines the type of unbonding operation
 type UnbondingType int
 
@@ -426,3 +442,95 @@ func GetHistoricalInfoKey(height int64) []byte {
 	binary.BigEndian.PutUint64(heightBytes, uint64(height))
 	return append(HistoricalInfoKey, heightBytes...)
 }
+
+// GetTokenizeShareRecordByIndexKey returns the key of a tokenize share record.
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordByIndexKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerPrefix returns the key prefix of the
+// tokenize share record ids owned by an address.
+func GetTokenizeShareRecordIDsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey returns the owner index key of a
+// tokenize share record.
+// VALUE: none (key rearrangement used)
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey returns the denom index key of a
+// tokenize share record.
+// VALUE: tokenize share record id (uint64)
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
+}
+
+// GetLiquidValidatorKey returns the key of a liquid validator.
+// VALUE: staking/LiquidValidator
+func GetLiquidValidatorKey(operatorAddr sdk.ValAddress) []byte {
+	return append(LiquidValidatorPrefix, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorBondKey returns the key flagging a delegation as validator bond.
+// VALUE: none (presence only)
+func GetValidatorBondKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(append(ValidatorBondKey, address.MustLengthPrefix(delAddr)...), address.MustLengthPrefix(valAddr)...)
+}
+
+// ParseValidatorBondKey returns the delegator and validator addresses of a
+// validator bond key.
+func ParseValidatorBondKey(key []byte) (sdk.AccAddress, sdk.ValAddress) {
+	kv.AssertKeyAtLeastLength(key, 2)
+	addrs := key[1:] // remove prefix byte
+
+	delAddrLen := addrs[0]
+	kv.AssertKeyAtLeastLength(addrs, 2+int(delAddrLen))
+	delAddr := addrs[1 : 1+delAddrLen]
+	valAddr := addrs[2+delAddrLen:]
+
+	return delAddr, valAddr
+}
+
+// GetConsPubKeyRotationHistoryPrefix returns the key prefix of the consensus
+// key rotation history of a validator.
+func GetConsPubKeyRotationHistoryPrefix(valAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationHistoryKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetConsPubKeyRotationHistoryKey returns the key of the consensus key rotation
+// of a validator at the given height.
+// VALUE: staking/ConsPubKeyRotationHistory
+func GetConsPubKeyRotationHistoryKey(valAddr sdk.ValAddress, height int64) []byte {
+	return append(GetConsPubKeyRotationHistoryPrefix(valAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetBlockConsPubKeyRotationHistoryPrefix returns the key prefix of the
+// consensus key rotations at the given height.
+func GetBlockConsPubKeyRotationHistoryPrefix(height int64) []byte {
+	return append(BlockConsPubKeyRotationHistoryKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetBlockConsPubKeyRotationHistoryKey returns the height index key of the
+// consensus key rotation of a validator.
+// VALUE: none (key rearrangement used)
+func GetBlockConsPubKeyRotationHistoryKey(height int64, valAddr sdk.ValAddress) []byte {
+	return append(GetBlockConsPubKeyRotationHistoryPrefix(height), address.MustLengthPrefix(valAddr)...)
+}
+
+// ParseBlockConsPubKeyRotationHistoryKey returns the validator address of a
+// consensus key rotation height index key.
+func ParseBlockConsPubKeyRotationHistoryKey(key []byte) sdk.ValAddress {
+	kv.AssertKeyAtLeastLength(key, 1+8+2)
+	return sdk.ValAddress(key[1+8+1:]) // remove prefix byte, height and address length
+}
+
+// GetRotatedConsAddrKey returns the key of the consensus key rotation that
+// retired the given consensus address.
+// VALUE: staking/ConsPubKeyRotationHistory
+func GetRotatedConsAddrKey(consAddr sdk.ConsAddress) []byte {
+	return append(RotatedConsAddrKey, address.MustLengthPrefix(consAddr)...)
+}
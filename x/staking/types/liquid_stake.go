@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// NewTokenizeShareRecord creates a new tokenize share record. The module
+// account holding the delegation is derived from the record id.
+func NewTokenizeShareRecord(id uint64, owner, validator string) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner,
+		ModuleAccount: fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, id),
+		Validator:     validator,
+	}
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// delegation of the record.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return address.Module(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denom of the tokens representing the shares
+// of the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(r.Validator), r.Id)
+}
+
+// NewLiquidValidator creates a new liquid validator with no liquid or
+// validator bond shares.
+func NewLiquidValidator(operatorAddress string) LiquidValidator {
+	return LiquidValidator{
+		OperatorAddress:     operatorAddress,
+		LiquidShares:        math.LegacyZeroDec(),
+		ValidatorBondShares: math.LegacyZeroDec(),
+	}
+}
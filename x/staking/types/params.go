@@ -29,20 +29,45 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultMaxConsPubKeyRotations allows a single consensus key rotation per
+	// unbonding period.
+	DefaultMaxConsPubKeyRotations uint32 = 1
 )
 
-// DefaultMinCommissionRate is set to 0%
-var DefaultMinCommissionRate = math.LegacyZeroDec()
+var (
+	// DefaultMinCommissionRate is set to 0%
+	DefaultMinCommissionRate = math.LegacyZeroDec()
+
+	// DefaultValidatorBondFactor is set to -1, which disables the validator bond requirement
+	DefaultValidatorBondFactor = math.LegacyNewDec(-1)
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, which disables the global liquid staking cap
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
 
-// NewParams creates a new Params instance
+	// DefaultValidatorLiquidStakingCap is set to 100%, which disables the per-validator liquid staking cap
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultKeyRotationFee is set to 1000000 of the default bond denom
+	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+)
+
+// NewParams creates a new Params instance. The liquid staking and consensus key
+// rotation parameters are set to their defaults and can be changed through
+// MsgUpdateParams. The key rotation fee is denominated in the bond denom.
 func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate math.LegacyDec) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
+		UnbondingTime:             unbondingTime,
+		MaxValidators:             maxValidators,
+		MaxEntries:                maxEntries,
+		HistoricalEntries:         historicalEntries,
+		BondDenom:                 bondDenom,
+		MinCommissionRate:         minCommissionRate,
+		ValidatorBondFactor:       DefaultValidatorBondFactor,
+		GlobalLiquidStakingCap:    DefaultGlobalLiquidStakingCap,
+		ValidatorLiquidStakingCap: DefaultValidatorLiquidStakingCap,
+		KeyRotationFee:            sdk.NewCoin(bondDenom, DefaultKeyRotationFee.Amount),
+		MaxConsPubkeyRotations:    DefaultMaxConsPubKeyRotations,
 	}
 }
 
@@ -104,6 +129,26 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateValidatorBondFactor(p.ValidatorBondFactor); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap("global", p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap("validator", p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateKeyRotationFee(p.KeyRotationFee); err != nil {
+		return err
+	}
+
+	if err := validateMaxConsPubKeyRotations(p.MaxConsPubkeyRotations); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -203,3 +248,64 @@ func validateMinCommissionRate(i any) error {
 
 	return nil
 }
+
+func validateValidatorBondFactor(i any) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("validator bond factor cannot be nil: %s", v)
+	}
+	if v.IsNegative() && !v.Equal(math.LegacyNewDec(-1)) {
+		return fmt.Errorf("invalid validator bond factor: %s, must be non-negative or -1 to disable", v)
+	}
+
+	return nil
+}
+
+func validateLiquidStakingCap(name string, i any) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("%s liquid staking cap cannot be nil: %s", name, v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("%s liquid staking cap cannot be negative: %s", name, v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s liquid staking cap cannot be greater than 100%%: %s", name, v)
+	}
+
+	return nil
+}
+
+func validateKeyRotationFee(i any) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid key rotation fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateMaxConsPubKeyRotations(i any) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max consensus pubkey rotations must be positive: %d", v)
+	}
+
+	return nil
+}
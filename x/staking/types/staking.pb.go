@@ -930,6 +930,19 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// validator_bond_factor is the number of liquid shares a validator may hold for each validator bond share.
+	// A negative value disables the validator bond requirement.
+	ValidatorBondFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=validator_bond_factor,json=validatorBondFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_bond_factor"`
+	// global_liquid_staking_cap is the maximum fraction of the total bonded stake that may be liquid staked.
+	GlobalLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of a single validator's delegator shares that may be
+	// liquid staked.
+	ValidatorLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_liquid_staking_cap"`
+	// key_rotation_fee is the fee charged to a validator operator for rotating its consensus key.
+	KeyRotationFee types1.Coin `protobuf:"bytes,10,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// max_cons_pubkey_rotations is the maximum number of consensus key rotations a validator may perform within an
+	// unbonding period.
+	MaxConsPubkeyRotations uint32 `protobuf:"varint,11,opt,name=max_cons_pubkey_rotations,json=maxConsPubkeyRotations,proto3" json:"max_cons_pubkey_rotations,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1000,6 +1013,20 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetKeyRotationFee() types1.Coin {
+	if m != nil {
+		return m.KeyRotationFee
+	}
+	return types1.Coin{}
+}
+
+func (m *Params) GetMaxConsPubkeyRotations() uint32 {
+	if m != nil {
+		return m.MaxConsPubkeyRotations
+	}
+	return 0
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1243,6 +1270,223 @@ func (m *ValidatorUpdates) GetUpdates() []types2.ValidatorUpdate {
 	return nil
 }
 
+// TokenizeShareRecord represents a tokenized delegation. The delegation is held
+// by a dedicated module account and the record owner may redeem its tokens
+// for a regular delegation.
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address that controls the record and is entitled to its rewards.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the operator address of the validator the shares are delegated to.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+// LiquidValidator tracks the liquid staking accounting of a validator.
+type LiquidValidator struct {
+	// operator_address defines the address of the validator's operator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// liquid_shares is the number of the validator's delegator shares that have been tokenized.
+	LiquidShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=liquid_shares,json=liquidShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquid_shares"`
+	// validator_bond_shares is the number of delegator shares flagged as validator bond.
+	ValidatorBondShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=validator_bond_shares,json=validatorBondShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_bond_shares"`
+}
+
+func (m *LiquidValidator) Reset()         { *m = LiquidValidator{} }
+func (m *LiquidValidator) String() string { return proto.CompactTextString(m) }
+func (*LiquidValidator) ProtoMessage()    {}
+func (*LiquidValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
+}
+func (m *LiquidValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidValidator.Merge(m, src)
+}
+func (m *LiquidValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidValidator proto.InternalMessageInfo
+
+func (m *LiquidValidator) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+// ConsPubKeyRotationHistory records a rotation of a validator's consensus key.
+type ConsPubKeyRotationHistory struct {
+	// operator_address defines the address of the validator's operator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// old_cons_pubkey is the consensus key of the validator before the rotation.
+	OldConsPubkey *any.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty"`
+	// new_cons_pubkey is the consensus key of the validator after the rotation.
+	NewConsPubkey *any.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty"`
+	// height is the block height at which the rotation was requested. The new key
+	// is used for signing from height + ValidatorUpdateDelay + 1 onwards.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the rotation was requested.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// fee is the key rotation fee paid by the validator operator.
+	Fee types1.Coin `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *ConsPubKeyRotationHistory) Reset()         { *m = ConsPubKeyRotationHistory{} }
+func (m *ConsPubKeyRotationHistory) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotationHistory) ProtoMessage()    {}
+func (*ConsPubKeyRotationHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsPubKeyRotationHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotationHistory.Merge(m, src)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsPubKeyRotationHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotationHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsPubKeyRotationHistory proto.InternalMessageInfo
+
+func (m *ConsPubKeyRotationHistory) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *ConsPubKeyRotationHistory) GetOldConsPubkey() *any.Any {
+	if m != nil {
+		return m.OldConsPubkey
+	}
+	return nil
+}
+
+func (m *ConsPubKeyRotationHistory) GetNewConsPubkey() *any.Any {
+	if m != nil {
+		return m.NewConsPubkey
+	}
+	return nil
+}
+
+func (m *ConsPubKeyRotationHistory) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ConsPubKeyRotationHistory) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *ConsPubKeyRotationHistory) GetFee() types1.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types1.Coin{}
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
@@ -1267,6 +1511,9 @@ func init() {
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
 	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
+	proto.RegisterType((*LiquidValidator)(nil), "cosmos.staking.v1beta1.LiquidValidator")
+	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
 }
 
 func init() {
@@ -1274,127 +1521,151 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1920 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4f, 0x6c, 0x63, 0x47,
-	0x19, 0xcf, 0x8b, 0x53, 0x27, 0xf9, 0x9c, 0xc4, 0xce, 0x6c, 0x76, 0xd7, 0xeb, 0xa5, 0xb1, 0xd7,
-	0x5d, 0x68, 0xba, 0x34, 0x76, 0x37, 0xa0, 0x3d, 0x04, 0x54, 0x14, 0xc7, 0xde, 0xae, 0x4b, 0x9b,
-	0x04, 0x3b, 0x09, 0x14, 0x01, 0x4f, 0xe3, 0xf7, 0x26, 0xce, 0x23, 0x7e, 0xf3, 0xcc, 0x9b, 0xf1,
-	0x12, 0xdf, 0x39, 0x54, 0x41, 0x48, 0x95, 0x90, 0x10, 0x12, 0x44, 0xac, 0xc4, 0xa5, 0xdc, 0x7a,
-	0x58, 0x71, 0xe7, 0x56, 0x90, 0x90, 0x56, 0x7b, 0x42, 0x95, 0x08, 0x68, 0xf7, 0xd0, 0x0a, 0x2e,
-	0x88, 0x13, 0x47, 0x34, 0x7f, 0xde, 0x1f, 0xc7, 0xc9, 0x66, 0xb3, 0xad, 0xd0, 0x8a, 0x5e, 0x9e,
-	0xde, 0xcc, 0x7c, 0xdf, 0x6f, 0xe6, 0xfb, 0x7d, 0x7f, 0xe6, 0x0f, 0x5c, 0xb7, 0x3c, 0xe6, 0x7a,
-	0xac, 0xcc, 0x38, 0xde, 0x73, 0x68, 0xbb, 0x7c, 0xf7, 0x66, 0x8b, 0x70, 0x7c, 0x33, 0x68, 0x97,
-	0xba, 0xbe, 0xc7, 0x3d, 0x74, 0x49, 0x49, 0x95, 0x82, 0x5e, 0x2d, 0x95, 0x9b, 0x6b, 0x7b, 0x6d,
-	0x4f, 0x8a, 0x94, 0xc5, 0x9f, 0x92, 0xce, 0x5d, 0x69, 0x7b, 0x5e, 0xbb, 0x43, 0xca, 0xb2, 0xd5,
-	0xea, 0xed, 0x94, 0x31, 0xed, 0xeb, 0xa1, 0xf9, 0xe3, 0x43, 0x76, 0xcf, 0xc7, 0xdc, 0xf1, 0xa8,
-	0x1e, 0xcf, 0x1f, 0x1f, 0xe7, 0x8e, 0x4b, 0x18, 0xc7, 0x6e, 0x37, 0xc0, 0x56, 0x2b, 0x31, 0xd5,
-	0xa4, 0x7a, 0x59, 0x1a, 0x5b, 0x9b, 0xd2, 0xc2, 0x8c, 0x84, 0x76, 0x58, 0x9e, 0x13, 0x60, 0xcf,
-	0x62, 0xd7, 0xa1, 0x5e, 0x59, 0x7e, 0x75, 0xd7, 0x17, 0x38, 0xa1, 0x36, 0xf1, 0x5d, 0x87, 0xf2,
-	0x32, 0xef, 0x77, 0x09, 0x53, 0x5f, 0x3d, 0x7a, 0x35, 0x36, 0x8a, 0x5b, 0x96, 0x13, 0x1f, 0x2c,
-	0xfe, 0xdc, 0x80, 0x99, 0x3b, 0x0e, 0xe3, 0x9e, 0xef, 0x58, 0xb8, 0x53, 0xa7, 0x3b, 0x1e, 0xfa,
-	0x1a, 0x24, 0x77, 0x09, 0xb6, 0x89, 0x9f, 0x35, 0x0a, 0xc6, 0x42, 0x6a, 0x29, 0x5b, 0x8a, 0x00,
-	0x4a, 0x4a, 0xf7, 0x8e, 0x1c, 0xaf, 0x4c, 0x7e, 0x78, 0x94, 0x1f, 0x79, 0xff, 0xe3, 0x0f, 0x6e,
-	0x18, 0x0d, 0xad, 0x82, 0xaa, 0x90, 0xbc, 0x8b, 0x3b, 0x8c, 0xf0, 0xec, 0x68, 0x21, 0xb1, 0x90,
-	0x5a, 0xba, 0x56, 0x3a, 0x99, 0xf3, 0xd2, 0x36, 0xee, 0x38, 0x36, 0xe6, 0xde, 0x20, 0x8a, 0xd2,
-	0x2d, 0xfe, 0x62, 0x14, 0xd2, 0xab, 0x9e, 0xeb, 0x3a, 0x8c, 0x39, 0x1e, 0x6d, 0x60, 0x4e, 0x18,
-	0x7a, 0x13, 0xc6, 0x7c, 0xcc, 0x89, 0x5c, 0xd4, 0x64, 0xe5, 0x96, 0x50, 0xfa, 0xe8, 0x28, 0x7f,
-	0x55, 0xc1, 0x33, 0x7b, 0xaf, 0xe4, 0x78, 0x65, 0x17, 0xf3, 0xdd, 0xd2, 0x5b, 0xa4, 0x8d, 0xad,
-	0x7e, 0x95, 0x58, 0x0f, 0xef, 0x2f, 0x82, 0x9e, 0xbd, 0x4a, 0x2c, 0x35, 0x83, 0xc4, 0x40, 0xdf,
-	0x82, 0x09, 0x17, 0xef, 0x9b, 0x12, 0x6f, 0xf4, 0x53, 0xe1, 0x8d, 0xbb, 0x78, 0x5f, 0xac, 0x0f,
-	0xfd, 0x00, 0xd2, 0x02, 0xd2, 0xda, 0xc5, 0xb4, 0x4d, 0x14, 0x72, 0xe2, 0x53, 0x21, 0x4f, 0xbb,
-	0x78, 0x7f, 0x55, 0xa2, 0x09, 0xfc, 0xe5, 0xb1, 0x4f, 0xee, 0xe5, 0x8d, 0xe2, 0x1f, 0x0c, 0x80,
-	0x88, 0x18, 0x84, 0x21, 0x63, 0x85, 0x2d, 0x39, 0x29, 0xd3, 0x4e, 0x7b, 0xf9, 0x34, 0xde, 0x8f,
-	0xd1, 0x5a, 0x99, 0x16, 0xcb, 0x7b, 0x70, 0x94, 0x37, 0xd4, 0xac, 0x69, 0x6b, 0x88, 0xf6, 0x54,
-	0xaf, 0x6b, 0x63, 0x4e, 0x4c, 0x11, 0xc3, 0x92, 0xad, 0xd4, 0x52, 0xae, 0xa4, 0x02, 0xbc, 0x14,
-	0x04, 0x78, 0x69, 0x33, 0x08, 0x70, 0x05, 0xf8, 0xde, 0xdf, 0x02, 0x40, 0x50, 0xda, 0x62, 0x5c,
-	0xdb, 0xf0, 0xbe, 0x01, 0xa9, 0x2a, 0x61, 0x96, 0xef, 0x74, 0x45, 0xca, 0xa0, 0x2c, 0x8c, 0xbb,
-	0x1e, 0x75, 0xf6, 0x74, 0xc0, 0x4d, 0x36, 0x82, 0x26, 0xca, 0xc1, 0x84, 0x63, 0x13, 0xca, 0x1d,
-	0xde, 0x57, 0x6e, 0x6a, 0x84, 0x6d, 0xa1, 0xf5, 0x63, 0xd2, 0x62, 0x4e, 0xc0, 0x73, 0x23, 0x68,
-	0xa2, 0x57, 0x20, 0xc3, 0x88, 0xd5, 0xf3, 0x1d, 0xde, 0x37, 0x2d, 0x8f, 0x72, 0x6c, 0xf1, 0xec,
-	0x98, 0x14, 0x49, 0x07, 0xfd, 0xab, 0xaa, 0x5b, 0x80, 0xd8, 0x84, 0x63, 0xa7, 0xc3, 0xb2, 0x2f,
-	0x28, 0x10, 0xdd, 0xd4, 0x4b, 0x3d, 0x1c, 0x87, 0xc9, 0x30, 0x50, 0xd1, 0x2a, 0x64, 0xbc, 0x2e,
-	0xf1, 0xc5, 0xbf, 0x89, 0x6d, 0xdb, 0x27, 0x8c, 0xe9, 0x68, 0xcc, 0x3e, 0xbc, 0xbf, 0x38, 0xa7,
-	0x09, 0x5f, 0x51, 0x23, 0x4d, 0xee, 0x3b, 0xb4, 0xdd, 0x48, 0x07, 0x1a, 0xba, 0x1b, 0xbd, 0x23,
-	0x5c, 0x46, 0x19, 0xa1, 0xac, 0xc7, 0xcc, 0x6e, 0xaf, 0xb5, 0x47, 0xfa, 0x9a, 0xd4, 0xb9, 0x21,
-	0x52, 0x57, 0x68, 0xbf, 0x92, 0xfd, 0x53, 0x04, 0x6d, 0xf9, 0xfd, 0x2e, 0xf7, 0x4a, 0x1b, 0xbd,
-	0xd6, 0x37, 0x49, 0x5f, 0xb8, 0x4a, 0xe3, 0x6c, 0x48, 0x18, 0x74, 0x09, 0x92, 0x3f, 0xc4, 0x4e,
-	0x87, 0xd8, 0x92, 0x91, 0x89, 0x86, 0x6e, 0xa1, 0x65, 0x48, 0x32, 0x8e, 0x79, 0x8f, 0x49, 0x1a,
-	0x66, 0x96, 0x8a, 0xa7, 0xc5, 0x46, 0xc5, 0xa3, 0x76, 0x53, 0x4a, 0x36, 0xb4, 0x06, 0x5a, 0x85,
-	0x24, 0xf7, 0xf6, 0x08, 0xd5, 0x04, 0x55, 0xbe, 0xac, 0xa3, 0xf9, 0xe2, 0x70, 0x34, 0xd7, 0x29,
-	0x8f, 0xc5, 0x71, 0x9d, 0xf2, 0x86, 0x56, 0x45, 0xdf, 0x83, 0x8c, 0x4d, 0x3a, 0xa4, 0x2d, 0x99,
-	0x63, 0xbb, 0xd8, 0x27, 0x2c, 0x9b, 0x94, 0x70, 0x37, 0xcf, 0x9d, 0x1c, 0x8d, 0x74, 0x08, 0xd5,
-	0x94, 0x48, 0x68, 0x03, 0x52, 0x76, 0x14, 0x4e, 0xd9, 0x71, 0x49, 0xe6, 0x4b, 0xa7, 0xd9, 0x18,
-	0x8b, 0xbc, 0x78, 0xe5, 0x89, 0x43, 0x88, 0x08, 0xea, 0xd1, 0x96, 0x47, 0x6d, 0x87, 0xb6, 0xcd,
-	0x5d, 0xe2, 0xb4, 0x77, 0x79, 0x76, 0xa2, 0x60, 0x2c, 0x24, 0x1a, 0xe9, 0xb0, 0xff, 0x8e, 0xec,
-	0x46, 0x1b, 0x30, 0x13, 0x89, 0xca, 0x0c, 0x99, 0x3c, 0x6f, 0x86, 0x4c, 0x87, 0x00, 0x42, 0x04,
-	0xbd, 0x0d, 0x10, 0xe5, 0x60, 0x16, 0x24, 0x5a, 0xf1, 0xec, 0x6c, 0x8e, 0x1b, 0x13, 0x03, 0x40,
-	0x14, 0x2e, 0xb8, 0x0e, 0x35, 0x19, 0xe9, 0xec, 0x98, 0x9a, 0x39, 0x81, 0x9b, 0x92, 0xf4, 0xbf,
-	0x7e, 0x0e, 0x6f, 0x7e, 0x74, 0x7f, 0x31, 0xad, 0x5a, 0x8b, 0xcc, 0xde, 0x2b, 0xbc, 0x56, 0xfa,
-	0xea, 0xad, 0xc6, 0xac, 0xeb, 0xd0, 0x26, 0xe9, 0xec, 0x54, 0x43, 0x60, 0xf4, 0x75, 0xb8, 0x1a,
-	0x11, 0xe2, 0x51, 0x73, 0xd7, 0xeb, 0xd8, 0xa6, 0x4f, 0x76, 0x4c, 0xcb, 0xeb, 0x51, 0x9e, 0x9d,
-	0x92, 0x34, 0x5e, 0x0e, 0x45, 0xd6, 0xe9, 0x1d, 0xaf, 0x63, 0x37, 0xc8, 0xce, 0xaa, 0x18, 0x46,
-	0x2f, 0x41, 0xc4, 0x86, 0xe9, 0xd8, 0x2c, 0x3b, 0x5d, 0x48, 0x2c, 0x8c, 0x35, 0xa6, 0xc2, 0xce,
-	0xba, 0xcd, 0x96, 0x27, 0xde, 0xbd, 0x97, 0x1f, 0xf9, 0xe4, 0x5e, 0x7e, 0xa4, 0x78, 0x1b, 0xa6,
-	0xb6, 0x71, 0x47, 0xa7, 0x16, 0x61, 0xe8, 0x16, 0x4c, 0xe2, 0xa0, 0x91, 0x35, 0x0a, 0x89, 0x27,
-	0xa6, 0x66, 0x24, 0x5a, 0xfc, 0x9d, 0x01, 0xc9, 0xea, 0xf6, 0x06, 0x76, 0x7c, 0x54, 0x83, 0xd9,
-	0x28, 0x56, 0x9f, 0x36, 0xcb, 0xa3, 0xf0, 0x0e, 0xd2, 0x7c, 0x0d, 0x66, 0xef, 0x06, 0x85, 0x23,
-	0x84, 0x51, 0x5b, 0xcd, 0xb5, 0x87, 0xf7, 0x17, 0x5f, 0xd4, 0x30, 0x61, 0x71, 0x39, 0x86, 0x77,
-	0xf7, 0x58, 0x7f, 0xcc, 0xe6, 0x37, 0x61, 0x5c, 0x2d, 0x95, 0xa1, 0x6f, 0xc0, 0x0b, 0x5d, 0xf1,
-	0x23, 0x4d, 0x4d, 0x2d, 0xcd, 0x9f, 0x1a, 0xf3, 0x52, 0x3e, 0x1e, 0x21, 0x4a, 0xaf, 0xf8, 0xd3,
-	0x51, 0x80, 0xea, 0xf6, 0xf6, 0xa6, 0xef, 0x74, 0x3b, 0x84, 0x7f, 0x56, 0xb6, 0x6f, 0xc1, 0xc5,
-	0xc8, 0x76, 0xe6, 0x5b, 0xe7, 0xb7, 0xff, 0x42, 0xa8, 0xdf, 0xf4, 0xad, 0x13, 0x61, 0x6d, 0xc6,
-	0x43, 0xd8, 0xc4, 0xf9, 0x61, 0xab, 0x8c, 0x0f, 0x33, 0xfb, 0x1d, 0x48, 0x45, 0x64, 0x30, 0x54,
-	0x87, 0x09, 0xae, 0xff, 0x35, 0xc1, 0xc5, 0xd3, 0x09, 0x0e, 0xd4, 0xe2, 0x24, 0x87, 0xea, 0xc5,
-	0xff, 0x18, 0x00, 0xb1, 0x1c, 0x79, 0x3e, 0x63, 0x0c, 0xd5, 0x21, 0xa9, 0x8b, 0x73, 0xe2, 0x59,
-	0x8b, 0xb3, 0x06, 0x88, 0x91, 0xfa, 0xb3, 0x51, 0xb8, 0xb0, 0x15, 0x64, 0xef, 0xf3, 0xcf, 0xc1,
-	0x16, 0x8c, 0x13, 0xca, 0x7d, 0x47, 0x92, 0x20, 0x7c, 0xfe, 0xda, 0x69, 0x3e, 0x3f, 0xc1, 0xa8,
-	0x1a, 0xe5, 0x7e, 0x3f, 0x1e, 0x01, 0x01, 0x56, 0x8c, 0x8f, 0x5f, 0x25, 0x20, 0x7b, 0x9a, 0x2a,
-	0x7a, 0x19, 0xd2, 0x96, 0x4f, 0x64, 0x47, 0xb0, 0xef, 0x18, 0xb2, 0x60, 0xce, 0x04, 0xdd, 0x7a,
-	0xdb, 0x69, 0x80, 0x38, 0xa8, 0x89, 0xe0, 0x12, 0xa2, 0xcf, 0x76, 0x32, 0x9b, 0x89, 0x10, 0xe4,
-	0xc6, 0xb3, 0x09, 0x69, 0x87, 0x3a, 0xdc, 0xc1, 0x1d, 0xb3, 0x85, 0x3b, 0x98, 0x5a, 0xc1, 0x09,
-	0xf6, 0x5c, 0x7b, 0xfe, 0x8c, 0xc6, 0xa8, 0x28, 0x08, 0x54, 0x83, 0xf1, 0x00, 0x6d, 0xec, 0xfc,
-	0x68, 0x81, 0x2e, 0xba, 0x06, 0x53, 0xf1, 0x8d, 0x41, 0x9e, 0x46, 0xc6, 0x1a, 0xa9, 0xd8, 0xbe,
-	0x70, 0xd6, 0xce, 0x93, 0x7c, 0xe2, 0xce, 0xa3, 0x0f, 0x7c, 0xbf, 0x49, 0xc0, 0x6c, 0x83, 0xd8,
-	0xff, 0xff, 0x6e, 0xd9, 0x00, 0x50, 0xa9, 0x2a, 0x2a, 0xa9, 0xf6, 0xcc, 0x33, 0xe4, 0xfb, 0xa4,
-	0x02, 0xa9, 0x32, 0xfe, 0xbf, 0xf2, 0xd0, 0x5f, 0x47, 0x61, 0x2a, 0xee, 0xa1, 0xcf, 0xe5, 0xa6,
-	0x85, 0xd6, 0xa2, 0x32, 0x35, 0x26, 0xcb, 0xd4, 0x2b, 0xa7, 0x95, 0xa9, 0xa1, 0x68, 0x3e, 0xa3,
-	0x3e, 0xfd, 0x3a, 0x01, 0xc9, 0x0d, 0xec, 0x63, 0x97, 0xa1, 0xf5, 0xa1, 0xb3, 0xad, 0xba, 0x5b,
-	0x5e, 0x19, 0x0a, 0xe6, 0xaa, 0x7e, 0xfe, 0x50, 0xb1, 0xfc, 0xcb, 0xd3, 0x8e, 0xb6, 0x5f, 0x84,
-	0x19, 0x71, 0x47, 0x0e, 0x0d, 0x52, 0xe4, 0x4e, 0xcb, 0xab, 0x6e, 0x68, 0x3d, 0x43, 0x79, 0x48,
-	0x09, 0xb1, 0xa8, 0x0e, 0x0b, 0x19, 0x70, 0xf1, 0x7e, 0x4d, 0xf5, 0xa0, 0x45, 0x40, 0xbb, 0xe1,
-	0x9b, 0x85, 0x19, 0x11, 0x21, 0xe4, 0x66, 0xa3, 0x91, 0x40, 0xfc, 0x45, 0x00, 0xb1, 0x0a, 0xd3,
-	0x26, 0xd4, 0x73, 0xf5, 0x45, 0x6f, 0x52, 0xf4, 0x54, 0x45, 0x07, 0xfa, 0x89, 0xa1, 0x8e, 0xc8,
-	0xc7, 0x6e, 0xd2, 0xfa, 0x86, 0xb2, 0xf9, 0x14, 0x49, 0xf1, 0xef, 0xa3, 0x7c, 0xae, 0x8f, 0xdd,
-	0xce, 0x72, 0xf1, 0x04, 0x9c, 0xe2, 0x49, 0x97, 0x7b, 0x71, 0x70, 0x1e, 0xbc, 0x89, 0x2f, 0x5f,
-	0x17, 0xe1, 0x7d, 0xf0, 0xf1, 0x07, 0x37, 0xae, 0x46, 0xa7, 0xec, 0xf2, 0x7e, 0xf8, 0xa2, 0xa5,
-	0x7c, 0x22, 0x4e, 0xaa, 0x28, 0xda, 0x35, 0x1a, 0x84, 0x75, 0xc5, 0x25, 0x50, 0x5c, 0x1a, 0x62,
-	0x87, 0x7b, 0xe3, 0xc9, 0x97, 0x86, 0x48, 0x7f, 0xe0, 0xd2, 0x10, 0xcb, 0xa9, 0xd7, 0xa3, 0xa2,
-	0x3d, 0xaa, 0x5d, 0xae, 0xb1, 0x5a, 0x98, 0x91, 0xd8, 0xed, 0xc3, 0x19, 0x80, 0x08, 0x94, 0x64,
-	0xaa, 0x8e, 0x14, 0xff, 0x6c, 0xc0, 0x95, 0xa1, 0xf0, 0x0b, 0x97, 0x6c, 0x01, 0xf2, 0x63, 0x83,
-	0xd2, 0x8d, 0x7d, 0xbd, 0xf4, 0x67, 0x8b, 0xe6, 0x59, 0x7f, 0xa8, 0x72, 0x7f, 0x36, 0xbb, 0x8f,
-	0x2e, 0x3d, 0x7f, 0x34, 0x60, 0x2e, 0xbe, 0x80, 0xd0, 0x94, 0x26, 0x4c, 0xc5, 0xa7, 0xd6, 0x46,
-	0x5c, 0x7f, 0x1a, 0x23, 0xe2, 0xeb, 0x1f, 0x00, 0x41, 0xdb, 0x51, 0x8a, 0xab, 0xa7, 0xb4, 0x9b,
-	0x4f, 0x4d, 0x4a, 0xb0, 0xb0, 0x13, 0x53, 0x5d, 0xf9, 0xe6, 0x9f, 0x06, 0x8c, 0x6d, 0x78, 0x5e,
-	0x07, 0xfd, 0x08, 0x66, 0xa9, 0xc7, 0x4d, 0x91, 0x0e, 0xc4, 0x36, 0xf5, 0x5d, 0x5f, 0x95, 0xcf,
-	0xda, 0x13, 0xb9, 0xfa, 0xc7, 0x51, 0x7e, 0x58, 0x73, 0x90, 0x40, 0xfd, 0xa4, 0x44, 0x3d, 0x5e,
-	0x91, 0x42, 0x9b, 0xea, 0x39, 0x60, 0x07, 0xa6, 0x07, 0xa7, 0x53, 0x25, 0x76, 0xe5, 0xac, 0xe9,
-	0xa6, 0xcf, 0x9c, 0x6a, 0xaa, 0x15, 0x9b, 0x67, 0x79, 0x42, 0x78, 0xed, 0x5f, 0xc2, 0x73, 0xef,
-	0x40, 0x26, 0xac, 0x2f, 0x5b, 0xf2, 0x3d, 0x8a, 0x89, 0xd0, 0x50, 0x4f, 0x53, 0xc1, 0xe9, 0xbe,
-	0x10, 0x7f, 0xe7, 0xc4, 0x2d, 0xcb, 0x29, 0x1d, 0xd3, 0x19, 0xa0, 0x53, 0xeb, 0xde, 0xf8, 0xbd,
-	0x01, 0x10, 0xbd, 0x9b, 0xa0, 0x57, 0xe1, 0x72, 0x65, 0x7d, 0xad, 0x6a, 0x36, 0x37, 0x57, 0x36,
-	0xb7, 0x9a, 0xe6, 0xd6, 0x5a, 0x73, 0xa3, 0xb6, 0x5a, 0xbf, 0x5d, 0xaf, 0x55, 0x33, 0x23, 0xb9,
-	0xf4, 0xc1, 0x61, 0x21, 0xb5, 0x45, 0x59, 0x97, 0x58, 0xce, 0x8e, 0x43, 0x6c, 0xf4, 0x25, 0x98,
-	0x1b, 0x94, 0x16, 0xad, 0x5a, 0x35, 0x63, 0xe4, 0xa6, 0x0e, 0x0e, 0x0b, 0x13, 0xea, 0x9c, 0x48,
-	0x6c, 0xb4, 0x00, 0x17, 0x87, 0xe5, 0xea, 0x6b, 0x6f, 0x64, 0x46, 0x73, 0xd3, 0x07, 0x87, 0x85,
-	0xc9, 0xf0, 0x40, 0x89, 0x8a, 0x80, 0xe2, 0x92, 0x1a, 0x2f, 0x91, 0x83, 0x83, 0xc3, 0x42, 0x52,
-	0x79, 0x21, 0x37, 0xf6, 0xee, 0x6f, 0xe7, 0x47, 0x6e, 0x7c, 0x1f, 0xa0, 0x4e, 0x77, 0x7c, 0x6c,
-	0xc9, 0x68, 0xcb, 0xc1, 0xa5, 0xfa, 0xda, 0xed, 0xc6, 0xca, 0xea, 0x66, 0x7d, 0x7d, 0x6d, 0x70,
-	0xd9, 0xc7, 0xc6, 0xaa, 0xeb, 0x5b, 0x95, 0xb7, 0x6a, 0x66, 0xb3, 0xfe, 0xc6, 0x5a, 0xc6, 0x40,
-	0x97, 0xe1, 0xc2, 0xc0, 0xd8, 0xb7, 0xd7, 0x36, 0xeb, 0x6f, 0xd7, 0x32, 0xa3, 0x95, 0xdb, 0x1f,
-	0x3e, 0x9a, 0x37, 0x1e, 0x3c, 0x9a, 0x37, 0xfe, 0xfe, 0x68, 0xde, 0x78, 0xef, 0xf1, 0xfc, 0xc8,
-	0x83, 0xc7, 0xf3, 0x23, 0x7f, 0x79, 0x3c, 0x3f, 0xf2, 0xdd, 0x57, 0xdb, 0x0e, 0xdf, 0xed, 0xb5,
-	0x4a, 0x96, 0xe7, 0xea, 0x97, 0xef, 0xf2, 0x89, 0x15, 0x4f, 0x3e, 0x35, 0xb7, 0x92, 0x72, 0x93,
-	0xf9, 0xca, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x81, 0x47, 0x51, 0xf7, 0xe2, 0x17, 0x00, 0x00,
+	// 2293 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xd6, 0x92, 0x34, 0x25, 0x3e, 0x8a, 0xa2, 0x34, 0x72, 0x64, 0x4a, 0x4e, 0x24, 0x85, 0x71,
+	0x1b, 0xc7, 0x8d, 0xa8, 0xd8, 0x4d, 0x7d, 0x50, 0xdb, 0x04, 0xa2, 0x28, 0xc5, 0x4c, 0x1c, 0x59,
+	0x5d, 0xfd, 0xb4, 0xe9, 0xdf, 0x62, 0xb8, 0x3b, 0xa2, 0x36, 0x22, 0x67, 0x99, 0x9d, 0xa1, 0x2d,
+	0x16, 0xe8, 0xad, 0x87, 0x40, 0x45, 0x81, 0x00, 0x05, 0x8a, 0x02, 0x85, 0x51, 0x03, 0xbd, 0xa4,
+	0xb7, 0x1c, 0x8c, 0xde, 0x73, 0x4b, 0x0b, 0x14, 0x30, 0x7c, 0x28, 0x8a, 0x00, 0x75, 0x0b, 0xfb,
+	0x90, 0xa0, 0xbd, 0x14, 0x3d, 0xf5, 0x58, 0xcc, 0xcf, 0xfe, 0x50, 0x14, 0xad, 0x1f, 0x1b, 0x45,
+	0xd0, 0x5e, 0x04, 0xee, 0xcc, 0x7b, 0xdf, 0x9b, 0xf9, 0xe6, 0xcd, 0x7b, 0xf3, 0x9e, 0xe0, 0x82,
+	0xed, 0xb1, 0xa6, 0xc7, 0xe6, 0x19, 0xc7, 0xbb, 0x2e, 0xad, 0xcf, 0xdf, 0xbc, 0x5c, 0x23, 0x1c,
+	0x5f, 0x0e, 0xbe, 0x4b, 0x2d, 0xdf, 0xe3, 0x1e, 0x9a, 0x50, 0x52, 0xa5, 0x60, 0x54, 0x4b, 0x4d,
+	0x9d, 0xad, 0x7b, 0x75, 0x4f, 0x8a, 0xcc, 0x8b, 0x5f, 0x4a, 0x7a, 0x6a, 0xb2, 0xee, 0x79, 0xf5,
+	0x06, 0x99, 0x97, 0x5f, 0xb5, 0xf6, 0xf6, 0x3c, 0xa6, 0x1d, 0x3d, 0x35, 0x7d, 0x70, 0xca, 0x69,
+	0xfb, 0x98, 0xbb, 0x1e, 0xd5, 0xf3, 0x33, 0x07, 0xe7, 0xb9, 0xdb, 0x24, 0x8c, 0xe3, 0x66, 0x2b,
+	0xc0, 0x56, 0x2b, 0xb1, 0x94, 0x51, 0xbd, 0x2c, 0x8d, 0xad, 0xb7, 0x52, 0xc3, 0x8c, 0x84, 0xfb,
+	0xb0, 0x3d, 0x37, 0xc0, 0x1e, 0xc3, 0x4d, 0x97, 0x7a, 0xf3, 0xf2, 0xaf, 0x1e, 0x7a, 0x96, 0x13,
+	0xea, 0x10, 0xbf, 0xe9, 0x52, 0x3e, 0xcf, 0x3b, 0x2d, 0xc2, 0xd4, 0x5f, 0x3d, 0x7b, 0x3e, 0x36,
+	0x8b, 0x6b, 0xb6, 0x1b, 0x9f, 0x2c, 0xfe, 0xdc, 0x80, 0x91, 0x6b, 0x2e, 0xe3, 0x9e, 0xef, 0xda,
+	0xb8, 0x51, 0xa5, 0xdb, 0x1e, 0xfa, 0x3a, 0xa4, 0x77, 0x08, 0x76, 0x88, 0x5f, 0x30, 0x66, 0x8d,
+	0x8b, 0xd9, 0x2b, 0x85, 0x52, 0x04, 0x50, 0x52, 0xba, 0xd7, 0xe4, 0x7c, 0x39, 0xf3, 0xc9, 0x83,
+	0x99, 0x81, 0x0f, 0x3f, 0xfb, 0xe8, 0x92, 0x61, 0x6a, 0x15, 0x54, 0x81, 0xf4, 0x4d, 0xdc, 0x60,
+	0x84, 0x17, 0x12, 0xb3, 0xc9, 0x8b, 0xd9, 0x2b, 0xcf, 0x97, 0x0e, 0xe7, 0xbc, 0xb4, 0x85, 0x1b,
+	0xae, 0x83, 0xb9, 0xd7, 0x8d, 0xa2, 0x74, 0x8b, 0xbf, 0x48, 0x40, 0x7e, 0xc9, 0x6b, 0x36, 0x5d,
+	0xc6, 0x5c, 0x8f, 0x9a, 0x98, 0x13, 0x86, 0xde, 0x84, 0x94, 0x8f, 0x39, 0x91, 0x8b, 0xca, 0x94,
+	0xaf, 0x0a, 0xa5, 0x4f, 0x1f, 0xcc, 0x9c, 0x57, 0xf0, 0xcc, 0xd9, 0x2d, 0xb9, 0xde, 0x7c, 0x13,
+	0xf3, 0x9d, 0xd2, 0x75, 0x52, 0xc7, 0x76, 0xa7, 0x42, 0xec, 0xfb, 0x77, 0xe7, 0x40, 0x5b, 0xaf,
+	0x10, 0x5b, 0x59, 0x90, 0x18, 0xe8, 0x5b, 0x30, 0xd4, 0xc4, 0x7b, 0x96, 0xc4, 0x4b, 0x3c, 0x11,
+	0xde, 0x60, 0x13, 0xef, 0x89, 0xf5, 0xa1, 0x1f, 0x42, 0x5e, 0x40, 0xda, 0x3b, 0x98, 0xd6, 0x89,
+	0x42, 0x4e, 0x3e, 0x11, 0x72, 0xae, 0x89, 0xf7, 0x96, 0x24, 0x9a, 0xc0, 0x5f, 0x48, 0x7d, 0x7e,
+	0x67, 0xc6, 0x28, 0x7e, 0x6c, 0x00, 0x44, 0xc4, 0x20, 0x0c, 0xa3, 0x76, 0xf8, 0x25, 0x8d, 0x32,
+	0x7d, 0x68, 0x2f, 0xf6, 0xe3, 0xfd, 0x00, 0xad, 0xe5, 0x9c, 0x58, 0xde, 0xbd, 0x07, 0x33, 0x86,
+	0xb2, 0x9a, 0xb7, 0x7b, 0x68, 0xcf, 0xb6, 0x5b, 0x0e, 0xe6, 0xc4, 0x12, 0x3e, 0x2c, 0xd9, 0xca,
+	0x5e, 0x99, 0x2a, 0x29, 0x07, 0x2f, 0x05, 0x0e, 0x5e, 0xda, 0x08, 0x1c, 0x5c, 0x01, 0x7e, 0xf0,
+	0xd7, 0x00, 0x10, 0x94, 0xb6, 0x98, 0xd7, 0x7b, 0xf8, 0xd0, 0x80, 0x6c, 0x85, 0x30, 0xdb, 0x77,
+	0x5b, 0xe2, 0xca, 0xa0, 0x02, 0x0c, 0x36, 0x3d, 0xea, 0xee, 0x6a, 0x87, 0xcb, 0x98, 0xc1, 0x27,
+	0x9a, 0x82, 0x21, 0xd7, 0x21, 0x94, 0xbb, 0xbc, 0xa3, 0x8e, 0xc9, 0x0c, 0xbf, 0x85, 0xd6, 0x2d,
+	0x52, 0x63, 0x6e, 0xc0, 0xb3, 0x19, 0x7c, 0xa2, 0x97, 0x60, 0x94, 0x11, 0xbb, 0xed, 0xbb, 0xbc,
+	0x63, 0xd9, 0x1e, 0xe5, 0xd8, 0xe6, 0x85, 0x94, 0x14, 0xc9, 0x07, 0xe3, 0x4b, 0x6a, 0x58, 0x80,
+	0x38, 0x84, 0x63, 0xb7, 0xc1, 0x0a, 0x67, 0x14, 0x88, 0xfe, 0xd4, 0x4b, 0xbd, 0x3d, 0x08, 0x99,
+	0xd0, 0x51, 0xd1, 0x12, 0x8c, 0x7a, 0x2d, 0xe2, 0x8b, 0xdf, 0x16, 0x76, 0x1c, 0x9f, 0x30, 0xa6,
+	0xbd, 0xb1, 0x70, 0xff, 0xee, 0xdc, 0x59, 0x4d, 0xf8, 0xa2, 0x9a, 0x59, 0xe7, 0xbe, 0x4b, 0xeb,
+	0x66, 0x3e, 0xd0, 0xd0, 0xc3, 0xe8, 0x1d, 0x71, 0x64, 0x94, 0x11, 0xca, 0xda, 0xcc, 0x6a, 0xb5,
+	0x6b, 0xbb, 0xa4, 0xa3, 0x49, 0x3d, 0xdb, 0x43, 0xea, 0x22, 0xed, 0x94, 0x0b, 0x7f, 0x88, 0xa0,
+	0x6d, 0xbf, 0xd3, 0xe2, 0x5e, 0x69, 0xad, 0x5d, 0x7b, 0x8b, 0x74, 0xc4, 0x51, 0x69, 0x9c, 0x35,
+	0x09, 0x83, 0x26, 0x20, 0xfd, 0x2e, 0x76, 0x1b, 0xc4, 0x91, 0x8c, 0x0c, 0x99, 0xfa, 0x0b, 0x2d,
+	0x40, 0x9a, 0x71, 0xcc, 0xdb, 0x4c, 0xd2, 0x30, 0x72, 0xa5, 0xd8, 0xcf, 0x37, 0xca, 0x1e, 0x75,
+	0xd6, 0xa5, 0xa4, 0xa9, 0x35, 0xd0, 0x12, 0xa4, 0xb9, 0xb7, 0x4b, 0xa8, 0x26, 0xa8, 0xfc, 0x15,
+	0xed, 0xcd, 0xcf, 0xf4, 0x7a, 0x73, 0x95, 0xf2, 0x98, 0x1f, 0x57, 0x29, 0x37, 0xb5, 0x2a, 0xfa,
+	0x3e, 0x8c, 0x3a, 0xa4, 0x41, 0xea, 0x92, 0x39, 0xb6, 0x83, 0x7d, 0xc2, 0x0a, 0x69, 0x09, 0x77,
+	0xf9, 0xc4, 0x97, 0xc3, 0xcc, 0x87, 0x50, 0xeb, 0x12, 0x09, 0xad, 0x41, 0xd6, 0x89, 0xdc, 0xa9,
+	0x30, 0x28, 0xc9, 0x7c, 0xa1, 0xdf, 0x1e, 0x63, 0x9e, 0x17, 0x8f, 0x3c, 0x71, 0x08, 0xe1, 0x41,
+	0x6d, 0x5a, 0xf3, 0xa8, 0xe3, 0xd2, 0xba, 0xb5, 0x43, 0xdc, 0xfa, 0x0e, 0x2f, 0x0c, 0xcd, 0x1a,
+	0x17, 0x93, 0x66, 0x3e, 0x1c, 0xbf, 0x26, 0x87, 0xd1, 0x1a, 0x8c, 0x44, 0xa2, 0xf2, 0x86, 0x64,
+	0x4e, 0x7a, 0x43, 0x72, 0x21, 0x80, 0x10, 0x41, 0x6f, 0x03, 0x44, 0x77, 0xb0, 0x00, 0x12, 0xad,
+	0x78, 0xf4, 0x6d, 0x8e, 0x6f, 0x26, 0x06, 0x80, 0x28, 0x8c, 0x37, 0x5d, 0x6a, 0x31, 0xd2, 0xd8,
+	0xb6, 0x34, 0x73, 0x02, 0x37, 0x2b, 0xe9, 0x7f, 0xed, 0x04, 0xa7, 0xf9, 0xe9, 0xdd, 0xb9, 0xbc,
+	0xfa, 0x9a, 0x63, 0xce, 0xee, 0xec, 0x2b, 0xa5, 0x57, 0xaf, 0x9a, 0x63, 0x4d, 0x97, 0xae, 0x93,
+	0xc6, 0x76, 0x25, 0x04, 0x46, 0xdf, 0x80, 0xf3, 0x11, 0x21, 0x1e, 0xb5, 0x76, 0xbc, 0x86, 0x63,
+	0xf9, 0x64, 0xdb, 0xb2, 0xbd, 0x36, 0xe5, 0x85, 0x61, 0x49, 0xe3, 0xb9, 0x50, 0xe4, 0x06, 0xbd,
+	0xe6, 0x35, 0x1c, 0x93, 0x6c, 0x2f, 0x89, 0x69, 0xf4, 0x02, 0x44, 0x6c, 0x58, 0xae, 0xc3, 0x0a,
+	0xb9, 0xd9, 0xe4, 0xc5, 0x94, 0x39, 0x1c, 0x0e, 0x56, 0x1d, 0xb6, 0x30, 0xf4, 0xfe, 0x9d, 0x99,
+	0x81, 0xcf, 0xef, 0xcc, 0x0c, 0x14, 0x57, 0x60, 0x78, 0x0b, 0x37, 0xf4, 0xd5, 0x22, 0x0c, 0x5d,
+	0x85, 0x0c, 0x0e, 0x3e, 0x0a, 0xc6, 0x6c, 0xf2, 0xb1, 0x57, 0x33, 0x12, 0x2d, 0xfe, 0xd6, 0x80,
+	0x74, 0x65, 0x6b, 0x0d, 0xbb, 0x3e, 0x5a, 0x86, 0xb1, 0xc8, 0x57, 0x8f, 0x7b, 0xcb, 0x23, 0xf7,
+	0x0e, 0xae, 0xf9, 0x2a, 0x8c, 0xdd, 0x0c, 0x02, 0x47, 0x08, 0xa3, 0x52, 0xcd, 0xf3, 0xf7, 0xef,
+	0xce, 0x3d, 0xa7, 0x61, 0xc2, 0xe0, 0x72, 0x00, 0xef, 0xe6, 0x81, 0xf1, 0xd8, 0x9e, 0xdf, 0x84,
+	0x41, 0xb5, 0x54, 0x86, 0x5e, 0x87, 0x33, 0x2d, 0xf1, 0x43, 0x6e, 0x35, 0x7b, 0x65, 0xba, 0xaf,
+	0xcf, 0x4b, 0xf9, 0xb8, 0x87, 0x28, 0xbd, 0xe2, 0x4f, 0x13, 0x00, 0x95, 0xad, 0xad, 0x0d, 0xdf,
+	0x6d, 0x35, 0x08, 0x7f, 0x5a, 0x7b, 0xdf, 0x84, 0x67, 0xa2, 0xbd, 0x33, 0xdf, 0x3e, 0xf9, 0xfe,
+	0xc7, 0x43, 0xfd, 0x75, 0xdf, 0x3e, 0x14, 0xd6, 0x61, 0x3c, 0x84, 0x4d, 0x9e, 0x1c, 0xb6, 0xc2,
+	0x78, 0x2f, 0xb3, 0xdf, 0x81, 0x6c, 0x44, 0x06, 0x43, 0x55, 0x18, 0xe2, 0xfa, 0xb7, 0x26, 0xb8,
+	0xd8, 0x9f, 0xe0, 0x40, 0x2d, 0x4e, 0x72, 0xa8, 0x5e, 0xfc, 0xb7, 0x01, 0x10, 0xbb, 0x23, 0x5f,
+	0x4c, 0x1f, 0x43, 0x55, 0x48, 0xeb, 0xe0, 0x9c, 0x3c, 0x6d, 0x70, 0xd6, 0x00, 0x31, 0x52, 0x7f,
+	0x96, 0x80, 0xf1, 0xcd, 0xe0, 0xf6, 0x7e, 0xf1, 0x39, 0xd8, 0x84, 0x41, 0x42, 0xb9, 0xef, 0x4a,
+	0x12, 0xc4, 0x99, 0xbf, 0xd2, 0xef, 0xcc, 0x0f, 0xd9, 0xd4, 0x32, 0xe5, 0x7e, 0x27, 0xee, 0x01,
+	0x01, 0x56, 0x8c, 0x8f, 0x5f, 0x25, 0xa1, 0xd0, 0x4f, 0x15, 0xbd, 0x08, 0x79, 0xdb, 0x27, 0x72,
+	0x20, 0xc8, 0x3b, 0x86, 0x0c, 0x98, 0x23, 0xc1, 0xb0, 0x4e, 0x3b, 0x26, 0x88, 0x87, 0x9a, 0x70,
+	0x2e, 0x21, 0x7a, 0xba, 0x97, 0xd9, 0x48, 0x84, 0x20, 0x13, 0xcf, 0x06, 0xe4, 0x5d, 0xea, 0x72,
+	0x17, 0x37, 0xac, 0x1a, 0x6e, 0x60, 0x6a, 0x07, 0x2f, 0xd8, 0x13, 0xe5, 0xfc, 0x11, 0x8d, 0x51,
+	0x56, 0x10, 0x68, 0x19, 0x06, 0x03, 0xb4, 0xd4, 0xc9, 0xd1, 0x02, 0x5d, 0xf4, 0x3c, 0x0c, 0xc7,
+	0x13, 0x83, 0x7c, 0x8d, 0xa4, 0xcc, 0x6c, 0x2c, 0x2f, 0x1c, 0x95, 0x79, 0xd2, 0x8f, 0xcd, 0x3c,
+	0xfa, 0xc1, 0xf7, 0xeb, 0x24, 0x8c, 0x99, 0xc4, 0xf9, 0xdf, 0x3f, 0x96, 0x35, 0x00, 0x75, 0x55,
+	0x45, 0x24, 0xd5, 0x27, 0x73, 0x8a, 0xfb, 0x9e, 0x51, 0x20, 0x15, 0xc6, 0xff, 0x5b, 0x27, 0xf4,
+	0x97, 0x04, 0x0c, 0xc7, 0x4f, 0xe8, 0xff, 0x32, 0x69, 0xa1, 0xd5, 0x28, 0x4c, 0xa5, 0x64, 0x98,
+	0x7a, 0xa9, 0x5f, 0x98, 0xea, 0xf1, 0xe6, 0x23, 0xe2, 0xd3, 0xc3, 0x41, 0x48, 0xaf, 0x61, 0x1f,
+	0x37, 0x19, 0xba, 0xd1, 0xf3, 0xb6, 0x55, 0xb5, 0xe5, 0x64, 0x8f, 0x33, 0x57, 0x74, 0xfb, 0x43,
+	0xf9, 0xf2, 0x2f, 0xfb, 0x3d, 0x6d, 0xbf, 0x04, 0x23, 0xa2, 0x46, 0x0e, 0x37, 0xa4, 0xc8, 0xcd,
+	0xc9, 0x52, 0x37, 0xdc, 0x3d, 0x43, 0x33, 0x90, 0x15, 0x62, 0x51, 0x1c, 0x16, 0x32, 0xd0, 0xc4,
+	0x7b, 0xcb, 0x6a, 0x04, 0xcd, 0x01, 0xda, 0x09, 0x7b, 0x16, 0x56, 0x44, 0x84, 0x90, 0x1b, 0x8b,
+	0x66, 0x02, 0xf1, 0xe7, 0x00, 0xc4, 0x2a, 0x2c, 0x87, 0x50, 0xaf, 0xa9, 0x0b, 0xbd, 0x8c, 0x18,
+	0xa9, 0x88, 0x01, 0xf4, 0x13, 0x43, 0x3d, 0x91, 0x0f, 0x54, 0xd2, 0xba, 0x42, 0xd9, 0x38, 0xc6,
+	0xa5, 0xf8, 0xd7, 0x83, 0x99, 0xa9, 0x0e, 0x6e, 0x36, 0x16, 0x8a, 0x87, 0xe0, 0x14, 0x0f, 0x2b,
+	0xee, 0xc5, 0xc3, 0xb9, 0xbb, 0x12, 0x47, 0x3f, 0x8e, 0x7b, 0x8a, 0x5c, 0xef, 0x36, 0xb6, 0xb9,
+	0xe7, 0xcb, 0x82, 0x26, 0x53, 0xae, 0x9e, 0xf8, 0x72, 0xf6, 0x3c, 0xd8, 0xbf, 0xf6, 0xaa, 0x32,
+	0x1e, 0x79, 0x94, 0x28, 0xf8, 0x56, 0xa4, 0x15, 0xc1, 0xc2, 0x64, 0xbd, 0xe1, 0xd5, 0x70, 0xc3,
+	0x6a, 0xb8, 0xef, 0xb5, 0x5d, 0xc7, 0xd2, 0x9e, 0x64, 0xd9, 0xb8, 0x25, 0xab, 0x9f, 0xa7, 0xba,
+	0x86, 0x09, 0x65, 0xeb, 0xba, 0x34, 0xb5, 0xae, 0x2c, 0x2d, 0xe1, 0x16, 0xda, 0x37, 0xe0, 0xd9,
+	0x88, 0x86, 0x43, 0x56, 0x92, 0x79, 0xda, 0x2b, 0x99, 0x0c, 0xcd, 0xf5, 0x2c, 0x06, 0xc3, 0xe8,
+	0x2e, 0xe9, 0x58, 0xbe, 0xc7, 0x55, 0xec, 0xdf, 0x26, 0x44, 0x17, 0x64, 0x93, 0xc1, 0x75, 0xab,
+	0x61, 0x46, 0x62, 0xd5, 0x98, 0x4b, 0xcb, 0xcf, 0xca, 0xa5, 0xf5, 0xb3, 0x36, 0xb2, 0x4b, 0x3a,
+	0xa6, 0xc6, 0x5b, 0x21, 0x04, 0xad, 0xc2, 0xa4, 0x6c, 0x1b, 0x79, 0x34, 0xe8, 0x06, 0x84, 0xe6,
+	0x98, 0x2c, 0xd2, 0x72, 0xe5, 0xf1, 0x43, 0xc0, 0xcc, 0x89, 0x26, 0xde, 0x5b, 0xf2, 0xa8, 0x2e,
+	0xfd, 0x03, 0x44, 0xb6, 0x70, 0x41, 0x04, 0xc9, 0xfd, 0xcf, 0x3e, 0xba, 0x74, 0x3e, 0xd2, 0x98,
+	0xdf, 0x0b, 0xfb, 0xa2, 0xea, 0x66, 0x8b, 0x7a, 0x07, 0x45, 0x6f, 0x0f, 0x93, 0xb0, 0x96, 0x47,
+	0x99, 0x2c, 0x3d, 0x63, 0x25, 0xa2, 0xf1, 0xf8, 0xd2, 0x33, 0xd2, 0xef, 0x2a, 0x3d, 0x63, 0x91,
+	0xf9, 0xb5, 0x28, 0xf5, 0x27, 0x8e, 0x62, 0x2d, 0x1e, 0x94, 0xb4, 0x92, 0x0c, 0xf8, 0x03, 0xc5,
+	0x3f, 0x1a, 0x30, 0xd9, 0x13, 0xc4, 0xc2, 0x25, 0xdb, 0x80, 0xfc, 0xd8, 0xa4, 0x0c, 0x06, 0x1d,
+	0xbd, 0xf4, 0xd3, 0xc5, 0xc4, 0x31, 0xbf, 0x27, 0xff, 0x3f, 0x9d, 0x37, 0x8c, 0x4e, 0x60, 0xbf,
+	0x37, 0xe0, 0x6c, 0x7c, 0x01, 0xe1, 0x56, 0xd6, 0x61, 0x38, 0x6e, 0x5a, 0x6f, 0xe2, 0xc2, 0x71,
+	0x36, 0x11, 0x5f, 0x7f, 0x17, 0x08, 0xda, 0x8a, 0x12, 0x85, 0x6a, 0xc8, 0x5e, 0x3e, 0x36, 0x29,
+	0xc1, 0xc2, 0x0e, 0x4d, 0x18, 0xea, 0x6c, 0xfe, 0x61, 0x40, 0x6a, 0xcd, 0xf3, 0x1a, 0xe8, 0x3d,
+	0x18, 0xa3, 0x1e, 0x97, 0x61, 0x8b, 0x38, 0x96, 0xee, 0x18, 0xa9, 0x24, 0xbc, 0xfc, 0x58, 0xae,
+	0xfe, 0xfe, 0x60, 0xa6, 0x57, 0xb3, 0x9b, 0x40, 0xdd, 0x98, 0xa4, 0x1e, 0x2f, 0x4b, 0xa1, 0x0d,
+	0xd5, 0x54, 0xda, 0x86, 0x5c, 0xb7, 0x39, 0x95, 0xa8, 0x17, 0x8f, 0x32, 0x97, 0x3b, 0xd2, 0xd4,
+	0x70, 0x2d, 0x66, 0x67, 0x61, 0x48, 0x9c, 0xda, 0x3f, 0xc5, 0xc9, 0xbd, 0x03, 0xa3, 0x61, 0x96,
+	0xda, 0x94, 0x5d, 0x4d, 0x26, 0x5c, 0x43, 0x35, 0x38, 0x83, 0x1a, 0x71, 0x36, 0xde, 0x2d, 0xc7,
+	0x35, 0xdb, 0x2d, 0x1d, 0xd0, 0xe9, 0xa2, 0x53, 0xeb, 0x16, 0xff, 0x64, 0xc0, 0xb8, 0xb4, 0xe7,
+	0xfe, 0x88, 0xc8, 0xb6, 0x96, 0x49, 0x6c, 0xcf, 0x77, 0xd0, 0x08, 0x24, 0x5c, 0x47, 0x12, 0x99,
+	0x32, 0x13, 0xae, 0x83, 0x4a, 0x70, 0xc6, 0xbb, 0x45, 0x89, 0xaf, 0x37, 0xdb, 0xff, 0x81, 0xa3,
+	0xc4, 0x64, 0xc6, 0xf5, 0x9c, 0x76, 0x83, 0x58, 0xd8, 0x56, 0x8f, 0x2c, 0xd5, 0x2c, 0xcd, 0xa9,
+	0xd1, 0x45, 0x35, 0x88, 0x5e, 0x87, 0x4c, 0x18, 0x05, 0xb5, 0x8b, 0x1f, 0xe3, 0x65, 0x12, 0xe9,
+	0x2c, 0x9c, 0x13, 0x24, 0xdd, 0xef, 0x8d, 0x53, 0xc5, 0x8f, 0x13, 0x90, 0x57, 0x71, 0x35, 0xea,
+	0xa3, 0x5e, 0xef, 0xdb, 0x47, 0x3d, 0x86, 0xd1, 0x9e, 0x86, 0xea, 0xf7, 0x20, 0x17, 0xa4, 0x09,
+	0x55, 0xbc, 0x3e, 0x59, 0x43, 0x7f, 0x58, 0x81, 0xe9, 0xde, 0xe2, 0xbb, 0x3d, 0x49, 0xb9, 0xab,
+	0x42, 0x3e, 0xad, 0x91, 0xee, 0x0c, 0xac, 0x6c, 0x3d, 0x86, 0xc3, 0x24, 0x4c, 0xea, 0x58, 0xff,
+	0x56, 0x14, 0xeb, 0xd5, 0x7f, 0x6d, 0x3a, 0x4f, 0x99, 0xcd, 0x2d, 0xc8, 0x8b, 0x47, 0x79, 0x2c,
+	0x1f, 0x9d, 0xb2, 0x3b, 0x9d, 0xf3, 0x1a, 0x4e, 0x94, 0xa0, 0x04, 0x2e, 0x25, 0xb7, 0xba, 0x70,
+	0x93, 0xa7, 0xc3, 0xa5, 0xe4, 0x56, 0x0c, 0x77, 0x02, 0xd2, 0xba, 0x22, 0x4b, 0xc9, 0xea, 0x41,
+	0x7f, 0xa1, 0x6f, 0x42, 0x4a, 0xbe, 0x58, 0xcf, 0x9c, 0xb4, 0xfc, 0x92, 0x6a, 0xe8, 0x2a, 0x24,
+	0x45, 0xb2, 0x4f, 0x9f, 0x20, 0x6d, 0x09, 0x05, 0x79, 0x86, 0x03, 0x87, 0x9c, 0xe1, 0xa5, 0xdf,
+	0x19, 0x00, 0x51, 0x7b, 0x1d, 0xbd, 0x0c, 0xe7, 0xca, 0x37, 0x56, 0x2b, 0xd6, 0xfa, 0xc6, 0xe2,
+	0xc6, 0xe6, 0xba, 0xb5, 0xb9, 0xba, 0xbe, 0xb6, 0xbc, 0x54, 0x5d, 0xa9, 0x2e, 0x57, 0x46, 0x07,
+	0xa6, 0xf2, 0xfb, 0xb7, 0x67, 0xb3, 0x9b, 0x94, 0xb5, 0x88, 0xed, 0x6e, 0xbb, 0xc4, 0x41, 0x5f,
+	0x86, 0xb3, 0xdd, 0xd2, 0xe2, 0x6b, 0xb9, 0x32, 0x6a, 0x4c, 0x0d, 0xef, 0xdf, 0x9e, 0x1d, 0x52,
+	0xed, 0x04, 0xe2, 0xa0, 0x8b, 0xf0, 0x4c, 0xaf, 0x5c, 0x75, 0xf5, 0x8d, 0xd1, 0xc4, 0x54, 0x6e,
+	0xff, 0xf6, 0x6c, 0x26, 0xec, 0x3b, 0xa0, 0x22, 0xa0, 0xb8, 0xa4, 0xc6, 0x4b, 0x4e, 0xc1, 0xfe,
+	0xed, 0xd9, 0xb4, 0x0a, 0xb3, 0x53, 0xa9, 0xf7, 0x7f, 0x33, 0x3d, 0x70, 0xe9, 0x07, 0x00, 0x55,
+	0xba, 0xed, 0x63, 0x5b, 0xa6, 0x93, 0x29, 0x98, 0xa8, 0xae, 0xae, 0x98, 0x8b, 0x4b, 0x1b, 0xd5,
+	0x1b, 0xab, 0xdd, 0xcb, 0x3e, 0x30, 0x57, 0xb9, 0xb1, 0x59, 0xbe, 0xbe, 0x6c, 0xad, 0x57, 0xdf,
+	0x58, 0x1d, 0x35, 0xd0, 0x39, 0x18, 0xef, 0x9a, 0xfb, 0xf6, 0xea, 0x46, 0xf5, 0xed, 0xe5, 0xd1,
+	0x44, 0x79, 0xe5, 0x93, 0x87, 0xd3, 0xc6, 0xbd, 0x87, 0xd3, 0xc6, 0xdf, 0x1e, 0x4e, 0x1b, 0x1f,
+	0x3c, 0x9a, 0x1e, 0xb8, 0xf7, 0x68, 0x7a, 0xe0, 0xcf, 0x8f, 0xa6, 0x07, 0xbe, 0xfb, 0x72, 0xdd,
+	0xe5, 0x3b, 0xed, 0x5a, 0xc9, 0xf6, 0x9a, 0xfa, 0x1f, 0xa4, 0xf3, 0x87, 0x3e, 0x69, 0xe4, 0x7f,
+	0x24, 0x6b, 0x69, 0x79, 0xb2, 0x5f, 0xfd, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x05, 0xaa, 0x21,
+	0xdb, 0x09, 0x1e, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1403,817 +1674,830 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 12959 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x94, 0x5b, 0xd7,
-		0x75, 0x18, 0x3c, 0x17, 0x6f, 0x6c, 0x60, 0x80, 0x3b, 0x67, 0x86, 0x24, 0x08, 0x4a, 0x9c, 0x21,
-		0x64, 0x49, 0x14, 0x25, 0x0e, 0xc5, 0x91, 0x48, 0x89, 0x43, 0xdb, 0xfa, 0xf0, 0x22, 0x09, 0x6a,
-		0x06, 0x18, 0x5f, 0x60, 0x28, 0x51, 0x49, 0x7c, 0x73, 0x07, 0x38, 0x33, 0x73, 0x45, 0xe0, 0x5e,
-		0x18, 0xf7, 0x82, 0x9a, 0xd1, 0xb7, 0x56, 0x96, 0x1d, 0x3b, 0xf9, 0x1c, 0x39, 0xce, 0xe7, 0x7c,
-		0xc9, 0x4a, 0x94, 0xd8, 0x74, 0x6c, 0xe7, 0x8b, 0xed, 0xbc, 0x5a, 0xc7, 0x76, 0xf3, 0x68, 0x57,
-		0xf3, 0x68, 0x9b, 0x36, 0x71, 0xd3, 0x2e, 0x27, 0x6b, 0xb5, 0x4d, 0xb3, 0x56, 0xdc, 0xc6, 0xc9,
-		0x8a, 0xe4, 0x47, 0x9a, 0x97, 0xd2, 0xa6, 0xcb, 0xcd, 0x72, 0xd7, 0x79, 0xdd, 0x07, 0x1e, 0x03,
-		0x0c, 0x2d, 0xa7, 0x6a, 0xd2, 0x3f, 0xe4, 0xdc, 0x73, 0xf6, 0xde, 0x67, 0x9f, 0x7d, 0xf6, 0xd9,
-		0x67, 0xef, 0x7d, 0x1e, 0x80, 0xbf, 0xd1, 0x61, 0x69, 0xc7, 0x34, 0x77, 0xda, 0xf8, 0x5c, 0xb7,
-		0x67, 0xda, 0xe6, 0x56, 0x7f, 0xfb, 0x5c, 0x0b, 0x5b, 0xcd, 0x9e, 0xde, 0xb5, 0xcd, 0xde, 0x32,
-		0x2d, 0x43, 0x69, 0x06, 0xb1, 0x2c, 0x20, 0x72, 0x9f, 0x93, 0x60, 0xee, 0x8a, 0xde, 0xc6, 0x25,
-		0x07, 0xb2, 0x8e, 0x6d, 0xf4, 0x24, 0x84, 0xb6, 0xf5, 0x36, 0xce, 0x48, 0x4b, 0xc1, 0xd3, 0x89,
-		0x95, 0x37, 0x2d, 0x0f, 0x60, 0x2d, 0xfb, 0x31, 0x36, 0x48, 0xb1, 0x42, 0x31, 0xce, 0xfc, 0xdf,
-		0xb1, 0x77, 0x7e, 0xf5, 0x73, 0x5f, 0x97, 0xe4, 0x77, 0x91, 0x7f, 0xb3, 0x1d, 0x74, 0x8b, 0x7d,
-		0xa3, 0xc7, 0x97, 0x09, 0x9e, 0x87, 0x9f, 0xdb, 0xe7, 0x49, 0x89, 0x4a, 0x90, 0x54, 0xb7, 0x58,
-		0xb5, 0xb0, 0xad, 0xe2, 0x3d, 0x1b, 0x1b, 0x96, 0x6e, 0x1a, 0xd9, 0xb3, 0x23, 0xb0, 0x86, 0xb8,
-		0x2d, 0x0b, 0xf0, 0xdc, 0xaf, 0x87, 0x61, 0x7e, 0x04, 0x6b, 0x08, 0x41, 0xc8, 0xd0, 0x3a, 0xa4,
-		0x3b, 0xd2, 0xe9, 0xb8, 0x42, 0xff, 0x46, 0x19, 0x88, 0x76, 0xb5, 0xe6, 0x2d, 0x6d, 0x07, 0x67,
-		0x02, 0xb4, 0x58, 0x7c, 0xa2, 0x93, 0x00, 0x2d, 0xdc, 0xc5, 0x46, 0x0b, 0x1b, 0xcd, 0xfd, 0x4c,
-		0x70, 0x29, 0x78, 0x3a, 0xae, 0x78, 0x4a, 0xd0, 0xc3, 0x30, 0xd7, 0xed, 0x6f, 0xb5, 0xf5, 0xa6,
-		0xea, 0x01, 0x83, 0xa5, 0xe0, 0xe9, 0xb0, 0x22, 0xb3, 0x8a, 0x92, 0x0b, 0xfc, 0x20, 0xa4, 0x5f,
-		0xc0, 0xda, 0x2d, 0x2f, 0x68, 0x82, 0x82, 0xa6, 0x48, 0x71, 0xc9, 0x47, 0xd5, 0xec, 0xda, 0xba,
-		0x69, 0x78, 0x41, 0xd3, 0xb4, 0x71, 0x99, 0x55, 0x78, 0x80, 0x8b, 0x90, 0xec, 0x60, 0xcb, 0xd2,
-		0x76, 0xb0, 0x6a, 0xef, 0x77, 0x71, 0x26, 0x44, 0xc7, 0x69, 0x69, 0x68, 0x9c, 0x06, 0xc7, 0x28,
-		0xc1, 0xb1, 0x1a, 0xfb, 0x5d, 0x8c, 0xf2, 0x10, 0xc7, 0x46, 0xbf, 0xc3, 0x28, 0x84, 0xc7, 0x8c,
-		0x74, 0xd9, 0xe8, 0x77, 0x06, 0xa9, 0xc4, 0x08, 0x1a, 0x27, 0x11, 0xb5, 0x70, 0xef, 0xb6, 0xde,
-		0xc4, 0x99, 0x08, 0x25, 0xf0, 0xe0, 0x10, 0x81, 0x3a, 0xab, 0x1f, 0xa4, 0x21, 0xf0, 0x50, 0x11,
-		0xe2, 0xce, 0x78, 0x67, 0xa2, 0x94, 0xc8, 0xfd, 0x23, 0xf4, 0x0d, 0xb7, 0x5b, 0x83, 0x24, 0x5c,
-		0x3c, 0x74, 0x11, 0xa2, 0x4c, 0x46, 0x56, 0x26, 0xb6, 0x24, 0x9d, 0x4e, 0xac, 0xdc, 0x33, 0x52,
-		0x65, 0x6b, 0x0c, 0x46, 0x11, 0xc0, 0xa8, 0x02, 0xb2, 0x65, 0xf6, 0x7b, 0x4d, 0xac, 0x36, 0xcd,
-		0x16, 0x56, 0x75, 0x63, 0xdb, 0xcc, 0xc4, 0x29, 0x81, 0xc5, 0xe1, 0x8e, 0x50, 0xc0, 0xa2, 0xd9,
-		0xc2, 0x15, 0x63, 0xdb, 0x54, 0x52, 0x96, 0xef, 0x1b, 0x1d, 0x85, 0x88, 0xb5, 0x6f, 0xd8, 0xda,
-		0x5e, 0x26, 0x49, 0xd5, 0x89, 0x7f, 0xa1, 0x15, 0x88, 0xe2, 0x96, 0x4e, 0x9a, 0xcb, 0xa4, 0x96,
-		0xa4, 0xd3, 0xa9, 0x95, 0xcc, 0xb0, 0x8c, 0x59, 0xbd, 0x22, 0x00, 0x73, 0xff, 0x23, 0x02, 0xe9,
-		0x69, 0x74, 0xf8, 0x32, 0x84, 0xb7, 0x89, 0x64, 0x32, 0x81, 0xc3, 0xc8, 0x8d, 0xe1, 0xf8, 0x05,
-		0x1f, 0xb9, 0x4b, 0xc1, 0xe7, 0x21, 0x61, 0x60, 0xcb, 0xc6, 0x2d, 0xa6, 0x45, 0xc1, 0x29, 0xf5,
-		0x10, 0x18, 0xd2, 0xb0, 0x1a, 0x86, 0xee, 0x4a, 0x0d, 0x9f, 0x85, 0xb4, 0xc3, 0x92, 0xda, 0xd3,
-		0x8c, 0x1d, 0xa1, 0xcf, 0xe7, 0x26, 0x71, 0xb2, 0xec, 0x18, 0x0f, 0x85, 0xa0, 0x29, 0x29, 0xec,
-		0xfb, 0x46, 0x25, 0x00, 0xd3, 0xc0, 0xe6, 0xb6, 0xda, 0xc2, 0xcd, 0x76, 0x26, 0x36, 0x46, 0x4a,
-		0x35, 0x02, 0x32, 0x24, 0x25, 0x93, 0x95, 0x36, 0xdb, 0xe8, 0x92, 0xab, 0x9e, 0xd1, 0x31, 0xda,
-		0xb5, 0xce, 0x26, 0xe6, 0x90, 0x86, 0x6e, 0x42, 0xaa, 0x87, 0xc9, 0x5c, 0xc1, 0x2d, 0xde, 0xb3,
-		0x38, 0x65, 0x62, 0x79, 0x62, 0xcf, 0x14, 0x8e, 0xc6, 0x3a, 0x36, 0xdb, 0xf3, 0x7e, 0xa2, 0xfb,
-		0xc0, 0x29, 0x50, 0xa9, 0x5a, 0x01, 0xb5, 0x34, 0x49, 0x51, 0x58, 0x25, 0xea, 0x95, 0x07, 0xb8,
-		0xad, 0x5b, 0xfa, 0x96, 0xde, 0xd6, 0x6d, 0x62, 0xb6, 0x88, 0xf6, 0x9e, 0x1a, 0x9e, 0x17, 0xfb,
-		0x9d, 0x2d, 0xb3, 0x7d, 0xc3, 0x01, 0x54, 0x3c, 0x48, 0xd9, 0x17, 0x21, 0xe5, 0x97, 0x30, 0x5a,
-		0x80, 0xb0, 0x65, 0x6b, 0x3d, 0x9b, 0x2a, 0x72, 0x58, 0x61, 0x1f, 0x48, 0x86, 0x20, 0x36, 0x5a,
-		0xd4, 0x12, 0x87, 0x15, 0xf2, 0x27, 0xfa, 0xbf, 0x5c, 0x99, 0x05, 0xa9, 0xcc, 0x1e, 0x18, 0x56,
-		0x0a, 0x1f, 0xe5, 0x41, 0xd1, 0x65, 0x9f, 0x80, 0x59, 0x9f, 0x0c, 0xa6, 0x6d, 0x3a, 0xf7, 0x5b,
-		0x21, 0x38, 0x32, 0x92, 0x36, 0x7a, 0x16, 0x16, 0xfa, 0x86, 0x6e, 0xd8, 0xb8, 0xd7, 0xed, 0x61,
-		0xa2, 0xf5, 0xac, 0xad, 0xcc, 0x2b, 0xd1, 0x31, 0x7a, 0xbb, 0xe9, 0x85, 0x66, 0x54, 0x94, 0xf9,
-		0xfe, 0x70, 0x21, 0xba, 0x09, 0x09, 0xa2, 0x62, 0x5a, 0x4f, 0xa3, 0x04, 0xd9, 0x84, 0x5e, 0x99,
-		0xae, 0xcb, 0xcb, 0x25, 0x17, 0xb3, 0x10, 0x7c, 0xaf, 0x14, 0x50, 0xbc, 0xb4, 0xd0, 0x13, 0x10,
-		0xdb, 0xc6, 0x9a, 0xdd, 0xef, 0x61, 0x2b, 0xb3, 0x42, 0x45, 0x79, 0x62, 0x78, 0x9e, 0x33, 0x80,
-		0x3a, 0xb6, 0x15, 0x07, 0x18, 0x75, 0x20, 0x79, 0x1b, 0xf7, 0xf4, 0x6d, 0xbd, 0xc9, 0x98, 0x0a,
-		0x52, 0x0d, 0x78, 0x72, 0x4a, 0xa6, 0x6e, 0x78, 0x50, 0xeb, 0xb6, 0x66, 0xe3, 0x55, 0xd8, 0xac,
-		0xde, 0x28, 0x2b, 0x95, 0x2b, 0x95, 0x72, 0x89, 0xb1, 0xe9, 0x23, 0x9f, 0xfd, 0x41, 0x09, 0x12,
-		0x9e, 0x9e, 0x10, 0x8b, 0x6a, 0xf4, 0x3b, 0x5b, 0xb8, 0xc7, 0xc7, 0x8b, 0x7f, 0xa1, 0x13, 0x10,
-		0xdf, 0xee, 0xb7, 0xdb, 0x4c, 0x6f, 0xd9, 0xda, 0x1d, 0x23, 0x05, 0x54, 0x67, 0x11, 0x84, 0xb8,
-		0x25, 0xa2, 0x66, 0x92, 0xfc, 0x8d, 0xb2, 0x10, 0x13, 0x7a, 0x9d, 0x09, 0x2f, 0x49, 0xa7, 0x63,
-		0x8a, 0xf3, 0xcd, 0xea, 0xba, 0x58, 0xb3, 0x71, 0x2b, 0x13, 0x11, 0x75, 0xec, 0xfb, 0x7a, 0x28,
-		0x16, 0x92, 0xc3, 0xb9, 0xc7, 0x61, 0x6e, 0xa8, 0x2b, 0x28, 0x0d, 0x89, 0x52, 0xb9, 0xb8, 0x96,
-		0x57, 0xf2, 0x8d, 0x4a, 0xad, 0x2a, 0xcf, 0xa0, 0x14, 0x78, 0x7a, 0x27, 0x4b, 0x67, 0xe2, 0xb1,
-		0x57, 0xa3, 0xf2, 0x3b, 0xdf, 0xf9, 0xce, 0x77, 0x06, 0x72, 0xbf, 0x16, 0x81, 0x85, 0x51, 0x76,
-		0x74, 0xa4, 0x49, 0x77, 0x3b, 0x1d, 0xf4, 0x75, 0x3a, 0x0f, 0xe1, 0xb6, 0xb6, 0x85, 0xdb, 0x99,
-		0x10, 0x1d, 0x84, 0x87, 0xa7, 0xb2, 0xd4, 0xcb, 0x6b, 0x04, 0x45, 0x61, 0x98, 0xe8, 0xad, 0x5c,
-		0x34, 0x61, 0x4a, 0xe1, 0xcc, 0x74, 0x14, 0x88, 0x7d, 0xe5, 0x62, 0x3c, 0x01, 0x71, 0xf2, 0x3f,
-		0x93, 0x7b, 0x84, 0xc9, 0x9d, 0x14, 0x50, 0xb9, 0x67, 0x21, 0x46, 0x4d, 0x67, 0x0b, 0x3b, 0x63,
-		0x22, 0xbe, 0x89, 0xb1, 0x69, 0xe1, 0x6d, 0xad, 0xdf, 0xb6, 0xd5, 0xdb, 0x5a, 0xbb, 0x8f, 0xa9,
-		0x11, 0x8c, 0x2b, 0x49, 0x5e, 0x78, 0x83, 0x94, 0xa1, 0x45, 0x48, 0x30, 0x4b, 0xab, 0x1b, 0x2d,
-		0xbc, 0x47, 0x57, 0xe1, 0xb0, 0xc2, 0x8c, 0x6f, 0x85, 0x94, 0x90, 0xe6, 0x9f, 0xb7, 0x4c, 0x43,
-		0x98, 0x2b, 0xda, 0x04, 0x29, 0xa0, 0xcd, 0x3f, 0x31, 0xe8, 0x00, 0xdc, 0x3b, 0xba, 0x7b, 0x43,
-		0xf6, 0xf5, 0x41, 0x48, 0x53, 0x88, 0xc7, 0xf8, 0x54, 0xd6, 0xda, 0x99, 0x39, 0xaa, 0x06, 0x29,
-		0x56, 0x5c, 0xe3, 0xa5, 0xb9, 0x9f, 0x0f, 0x40, 0x88, 0x2e, 0x36, 0x69, 0x48, 0x34, 0x6e, 0x6e,
-		0x94, 0xd5, 0x52, 0x6d, 0xb3, 0xb0, 0x56, 0x96, 0x25, 0x32, 0xf4, 0xb4, 0xe0, 0xca, 0x5a, 0x2d,
-		0xdf, 0x90, 0x03, 0xce, 0x77, 0xa5, 0xda, 0xb8, 0xf8, 0xb8, 0x1c, 0x74, 0x10, 0x36, 0x59, 0x41,
-		0xc8, 0x0b, 0xf0, 0xd8, 0x8a, 0x1c, 0x46, 0x32, 0x24, 0x19, 0x81, 0xca, 0xb3, 0xe5, 0xd2, 0xc5,
-		0xc7, 0xe5, 0x88, 0xbf, 0xe4, 0xb1, 0x15, 0x39, 0x8a, 0x66, 0x21, 0x4e, 0x4b, 0x0a, 0xb5, 0xda,
-		0x9a, 0x1c, 0x73, 0x68, 0xd6, 0x1b, 0x4a, 0xa5, 0x7a, 0x55, 0x8e, 0x3b, 0x34, 0xaf, 0x2a, 0xb5,
-		0xcd, 0x0d, 0x19, 0x1c, 0x0a, 0xeb, 0xe5, 0x7a, 0x3d, 0x7f, 0xb5, 0x2c, 0x27, 0x1c, 0x88, 0xc2,
-		0xcd, 0x46, 0xb9, 0x2e, 0x27, 0x7d, 0x6c, 0x3d, 0xb6, 0x22, 0xcf, 0x3a, 0x4d, 0x94, 0xab, 0x9b,
-		0xeb, 0x72, 0x0a, 0xcd, 0xc1, 0x2c, 0x6b, 0x42, 0x30, 0x91, 0x1e, 0x28, 0xba, 0xf8, 0xb8, 0x2c,
-		0xbb, 0x8c, 0x30, 0x2a, 0x73, 0xbe, 0x82, 0x8b, 0x8f, 0xcb, 0x28, 0x57, 0x84, 0x30, 0x55, 0x43,
-		0x84, 0x20, 0xb5, 0x96, 0x2f, 0x94, 0xd7, 0xd4, 0xda, 0x06, 0x99, 0x34, 0xf9, 0x35, 0x59, 0x72,
-		0xcb, 0x94, 0xf2, 0x46, 0x39, 0xdf, 0x28, 0x97, 0xe4, 0xa0, 0xb7, 0xec, 0x6d, 0x9b, 0x15, 0xa5,
-		0x5c, 0x92, 0x03, 0xb9, 0x26, 0x2c, 0x8c, 0x5a, 0x64, 0x47, 0x4e, 0x21, 0x8f, 0x2e, 0x04, 0xc6,
-		0xe8, 0x02, 0xa5, 0x35, 0xa8, 0x0b, 0xb9, 0x8f, 0x07, 0x61, 0x7e, 0x84, 0xa3, 0x31, 0xb2, 0x91,
-		0xa7, 0x20, 0xcc, 0x74, 0x99, 0x59, 0xea, 0x87, 0x46, 0x7a, 0x2c, 0x54, 0xb3, 0x87, 0xdc, 0x2f,
-		0x8a, 0xe7, 0x75, 0x59, 0x83, 0x63, 0x5c, 0x56, 0x42, 0x62, 0x48, 0x61, 0xbf, 0x6d, 0xc8, 0x21,
-		0x60, 0x3e, 0xd3, 0xc5, 0x69, 0x7c, 0x26, 0x5a, 0x76, 0x38, 0xc7, 0x20, 0x3c, 0xd1, 0x31, 0x88,
-		0xdc, 0x8d, 0x63, 0x70, 0x19, 0xe6, 0x86, 0x78, 0x99, 0x7a, 0x81, 0x7e, 0xb7, 0x04, 0x99, 0x71,
-		0xf2, 0x9d, 0x60, 0x55, 0x03, 0x3e, 0xab, 0x7a, 0x79, 0x70, 0x10, 0x4e, 0x8d, 0x1f, 0xc7, 0x21,
-		0x75, 0xf9, 0x84, 0x04, 0x47, 0x47, 0x47, 0x37, 0x23, 0x79, 0x78, 0x2b, 0x44, 0x3a, 0xd8, 0xde,
-		0x35, 0x85, 0xb7, 0xfe, 0xc0, 0x08, 0x1f, 0x90, 0x54, 0x0f, 0xea, 0x0b, 0xc7, 0xf2, 0x3a, 0x91,
-		0xc1, 0x71, 0x21, 0x0a, 0xe3, 0x66, 0x88, 0xd3, 0xef, 0x09, 0xc0, 0x91, 0x91, 0xc4, 0x47, 0x32,
-		0x7a, 0x2f, 0x80, 0x6e, 0x74, 0xfb, 0x36, 0xf3, 0xc8, 0x99, 0x31, 0x8f, 0xd3, 0x12, 0x6a, 0xff,
-		0x88, 0xa1, 0xee, 0xdb, 0x4e, 0x3d, 0x5b, 0x68, 0x81, 0x15, 0x51, 0x80, 0x27, 0x5d, 0x46, 0x43,
-		0x94, 0xd1, 0x93, 0x63, 0x7a, 0x3a, 0xa4, 0xdb, 0x8f, 0x82, 0xdc, 0x6c, 0xeb, 0xd8, 0xb0, 0x55,
-		0xcb, 0xee, 0x61, 0xad, 0xa3, 0x1b, 0x3b, 0x6c, 0xc1, 0x5e, 0x0d, 0x6f, 0x6b, 0x6d, 0x0b, 0x2b,
-		0x69, 0x56, 0x5d, 0x17, 0xb5, 0x04, 0x83, 0x2a, 0x50, 0xcf, 0x83, 0x11, 0xf1, 0x61, 0xb0, 0x6a,
-		0x07, 0x23, 0xf7, 0xb3, 0x71, 0x48, 0x78, 0x62, 0x41, 0x74, 0x0a, 0x92, 0xcf, 0x6b, 0xb7, 0x35,
-		0x55, 0x24, 0x03, 0x98, 0x24, 0x12, 0xa4, 0x6c, 0x83, 0x27, 0x04, 0x1e, 0x85, 0x05, 0x0a, 0x62,
-		0xf6, 0x6d, 0xdc, 0x53, 0x9b, 0x6d, 0xcd, 0xb2, 0xa8, 0xd0, 0x62, 0x14, 0x14, 0x91, 0xba, 0x1a,
-		0xa9, 0x2a, 0x8a, 0x1a, 0x74, 0x01, 0xe6, 0x29, 0x46, 0xa7, 0xdf, 0xb6, 0xf5, 0x6e, 0x1b, 0xd3,
-		0x34, 0x87, 0x45, 0x57, 0x2d, 0x87, 0xb3, 0x39, 0x02, 0xb1, 0xce, 0x01, 0x08, 0x47, 0x16, 0x2a,
-		0xc1, 0xbd, 0x14, 0x6d, 0x07, 0x1b, 0xb8, 0xa7, 0xd9, 0x58, 0xc5, 0xef, 0xe8, 0x6b, 0x6d, 0x4b,
-		0xd5, 0x8c, 0x96, 0xba, 0xab, 0x59, 0xbb, 0x99, 0x05, 0x42, 0xa0, 0x10, 0xc8, 0x48, 0xca, 0x71,
-		0x02, 0x78, 0x95, 0xc3, 0x95, 0x29, 0x58, 0xde, 0x68, 0x5d, 0xd3, 0xac, 0x5d, 0xb4, 0x0a, 0x47,
-		0x29, 0x15, 0xcb, 0xee, 0xe9, 0xc6, 0x8e, 0xda, 0xdc, 0xc5, 0xcd, 0x5b, 0x6a, 0xdf, 0xde, 0x7e,
-		0x32, 0x73, 0xc2, 0xdb, 0x3e, 0xe5, 0xb0, 0x4e, 0x61, 0x8a, 0x04, 0x64, 0xd3, 0xde, 0x7e, 0x12,
-		0xd5, 0x21, 0x49, 0x06, 0xa3, 0xa3, 0xbf, 0x88, 0xd5, 0x6d, 0xb3, 0x47, 0x97, 0xe1, 0xd4, 0x08,
-		0xeb, 0xe6, 0x91, 0xe0, 0x72, 0x8d, 0x23, 0xac, 0x9b, 0x2d, 0xbc, 0x1a, 0xae, 0x6f, 0x94, 0xcb,
-		0x25, 0x25, 0x21, 0xa8, 0x5c, 0x31, 0x7b, 0x44, 0xa1, 0x76, 0x4c, 0x47, 0xc0, 0x09, 0xa6, 0x50,
-		0x3b, 0xa6, 0x10, 0xef, 0x05, 0x98, 0x6f, 0x36, 0x59, 0x9f, 0xf5, 0xa6, 0xca, 0xf3, 0x02, 0x56,
-		0x46, 0xf6, 0x09, 0xab, 0xd9, 0xbc, 0xca, 0x00, 0xb8, 0x8e, 0x5b, 0xe8, 0x12, 0x1c, 0x71, 0x85,
-		0xe5, 0x45, 0x9c, 0x1b, 0xea, 0xe5, 0x20, 0xea, 0x05, 0x98, 0xef, 0xee, 0x0f, 0x23, 0x22, 0x5f,
-		0x8b, 0xdd, 0xfd, 0x41, 0xb4, 0xfb, 0x69, 0x62, 0xa8, 0x87, 0x9b, 0xd4, 0x5b, 0x3c, 0xe6, 0x85,
-		0xf6, 0x54, 0xa0, 0x65, 0x90, 0x9b, 0x4d, 0x15, 0x1b, 0xda, 0x56, 0x1b, 0xab, 0x5a, 0x0f, 0x1b,
-		0x9a, 0x95, 0x59, 0xa4, 0xc0, 0x21, 0xbb, 0xd7, 0xc7, 0x4a, 0xaa, 0xd9, 0x2c, 0xd3, 0xca, 0x3c,
-		0xad, 0x43, 0x67, 0x60, 0xce, 0xdc, 0x7a, 0xbe, 0xc9, 0x14, 0x4b, 0xed, 0xf6, 0xf0, 0xb6, 0xbe,
-		0x97, 0x79, 0x13, 0x95, 0x52, 0x9a, 0x54, 0x50, 0xb5, 0xda, 0xa0, 0xc5, 0xe8, 0x21, 0x90, 0x9b,
-		0xd6, 0xae, 0xd6, 0xeb, 0x52, 0xe3, 0x6c, 0x75, 0xb5, 0x26, 0xce, 0xdc, 0xcf, 0x40, 0x59, 0x79,
-		0x55, 0x14, 0x13, 0xc5, 0xb6, 0x5e, 0xd0, 0xb7, 0x6d, 0x41, 0xf1, 0x41, 0xa6, 0xd8, 0xb4, 0x8c,
-		0x53, 0x3b, 0x0d, 0x72, 0x77, 0xb7, 0xeb, 0x6f, 0xf8, 0x34, 0x05, 0x4b, 0x75, 0x77, 0xbb, 0xde,
-		0x76, 0xef, 0x83, 0x59, 0x02, 0xe9, 0x36, 0xfa, 0x10, 0x73, 0xe1, 0xba, 0xbb, 0x9e, 0x16, 0x1f,
-		0x87, 0xa3, 0x04, 0xa8, 0x83, 0x6d, 0xad, 0xa5, 0xd9, 0x9a, 0x07, 0xfa, 0x11, 0x0a, 0xbd, 0xd0,
-		0xdd, 0xed, 0xae, 0xf3, 0x4a, 0x1f, 0x9f, 0xbd, 0xfe, 0xd6, 0xbe, 0xa3, 0x1f, 0x67, 0x19, 0x9f,
-		0xa4, 0x4c, 0x68, 0xc8, 0x5d, 0x47, 0x30, 0xdf, 0xb4, 0x78, 0x2d, 0xb7, 0x0a, 0x49, 0xaf, 0xde,
-		0xa3, 0x38, 0x30, 0xcd, 0x97, 0x25, 0xe2, 0x47, 0x15, 0x6b, 0x25, 0xe2, 0x01, 0x3d, 0x57, 0x96,
-		0x03, 0xc4, 0x13, 0x5b, 0xab, 0x34, 0xca, 0xaa, 0xb2, 0x59, 0x6d, 0x54, 0xd6, 0xcb, 0x72, 0xd0,
-		0x13, 0x1b, 0x5c, 0x0f, 0xc5, 0xce, 0xc8, 0x0f, 0x5f, 0x0f, 0xc5, 0x1e, 0x90, 0x1f, 0xa4, 0xe2,
-		0x19, 0x52, 0xca, 0xdc, 0x6b, 0x41, 0x48, 0xf9, 0x93, 0x03, 0xe8, 0xcd, 0x70, 0x4c, 0x64, 0xff,
-		0x2c, 0x6c, 0xab, 0x2f, 0xe8, 0x3d, 0x3a, 0x59, 0x3b, 0x1a, 0x5b, 0x38, 0x1d, 0xa5, 0x5c, 0xe0,
-		0x50, 0x75, 0x6c, 0x3f, 0xa3, 0xf7, 0xc8, 0x54, 0xec, 0x68, 0x36, 0x5a, 0x83, 0x45, 0xc3, 0x54,
-		0x2d, 0x5b, 0x33, 0x5a, 0x5a, 0xaf, 0xe5, 0xcd, 0xbd, 0x6a, 0xcd, 0x26, 0xb6, 0x2c, 0x93, 0x2d,
-		0x92, 0x0e, 0x95, 0x7b, 0x0c, 0xb3, 0xce, 0x81, 0xdd, 0xd5, 0x23, 0xcf, 0x41, 0x07, 0xe6, 0x44,
-		0x70, 0xdc, 0x9c, 0x38, 0x01, 0xf1, 0x8e, 0xd6, 0x55, 0xb1, 0x61, 0xf7, 0xf6, 0xa9, 0xfb, 0x1f,
-		0x53, 0x62, 0x1d, 0xad, 0x5b, 0x26, 0xdf, 0xe8, 0x06, 0x3c, 0xe0, 0x82, 0xaa, 0x6d, 0xbc, 0xa3,
-		0x35, 0xf7, 0x55, 0xea, 0xeb, 0xd3, 0x4c, 0x95, 0xda, 0x34, 0x8d, 0xed, 0xb6, 0xde, 0xb4, 0x2d,
-		0x6a, 0x3b, 0x98, 0xfd, 0xcb, 0xb9, 0x18, 0x6b, 0x14, 0xe1, 0xba, 0x65, 0x1a, 0xd4, 0xc5, 0x2f,
-		0x0a, 0x68, 0x9f, 0xda, 0x24, 0xdf, 0x10, 0x6a, 0xe3, 0x1f, 0xfa, 0x90, 0x1c, 0xbe, 0x1e, 0x8a,
-		0x85, 0xe5, 0xc8, 0xf5, 0x50, 0x2c, 0x22, 0x47, 0xaf, 0x87, 0x62, 0x31, 0x39, 0x7e, 0x3d, 0x14,
-		0x8b, 0xcb, 0x90, 0xbb, 0x33, 0x0b, 0x49, 0x6f, 0xc4, 0x42, 0x02, 0xc0, 0x26, 0x5d, 0x70, 0x25,
-		0x6a, 0x92, 0xef, 0x3b, 0x30, 0xbe, 0x59, 0x2e, 0x92, 0x95, 0x78, 0x35, 0xc2, 0xc2, 0x03, 0x85,
-		0x61, 0x12, 0x2f, 0x88, 0x4c, 0x32, 0xcc, 0x7c, 0xa9, 0x98, 0xc2, 0xbf, 0xd0, 0x55, 0x88, 0x3c,
-		0x6f, 0x51, 0xda, 0xcc, 0x95, 0x7b, 0xd3, 0xc1, 0xb4, 0xaf, 0xd7, 0x29, 0xf1, 0xf8, 0xf5, 0xba,
-		0x5a, 0xad, 0x29, 0xeb, 0xf9, 0x35, 0x85, 0xa3, 0xa3, 0xe3, 0x10, 0x6a, 0x6b, 0x2f, 0xee, 0xfb,
-		0xd7, 0x6c, 0x5a, 0x84, 0x96, 0x21, 0xdd, 0x37, 0x58, 0xb8, 0x4f, 0xc6, 0x98, 0x40, 0xa5, 0xbd,
-		0x50, 0x29, 0xb7, 0x76, 0x8d, 0xc0, 0x4f, 0xa9, 0x57, 0xc7, 0x21, 0xf4, 0x02, 0xd6, 0x6e, 0xf9,
-		0x57, 0x56, 0x5a, 0x84, 0x4e, 0x43, 0xb2, 0x85, 0xb7, 0xfa, 0x3b, 0x6a, 0x0f, 0xb7, 0xb4, 0xa6,
-		0xed, 0x5f, 0x4f, 0x12, 0xb4, 0x4a, 0xa1, 0x35, 0xe8, 0x69, 0x88, 0x93, 0x31, 0x32, 0xe8, 0x18,
-		0xcf, 0x51, 0x11, 0x9c, 0x3d, 0x58, 0x04, 0x7c, 0x88, 0x05, 0x92, 0xe2, 0xe2, 0xa3, 0x6b, 0x10,
-		0xb5, 0xb5, 0xde, 0x0e, 0xb6, 0xad, 0xcc, 0xfc, 0x52, 0xf0, 0x74, 0x6a, 0x44, 0xa6, 0x6e, 0x04,
-		0xa9, 0x06, 0x45, 0xa1, 0xc1, 0xb6, 0x40, 0x47, 0xcf, 0x80, 0xcc, 0x13, 0xc2, 0x2a, 0x8f, 0x94,
-		0xad, 0xcc, 0x02, 0x55, 0xc0, 0x47, 0x0e, 0x26, 0xc9, 0xf3, 0xc9, 0x25, 0x86, 0xa4, 0xa4, 0xb1,
-		0xef, 0xdb, 0x3f, 0x2f, 0x8e, 0x1c, 0x66, 0x5e, 0x6c, 0x42, 0x9a, 0xff, 0xad, 0x5a, 0xfd, 0x6e,
-		0xd7, 0xec, 0xd9, 0x99, 0xa3, 0x14, 0x7f, 0x02, 0x43, 0x82, 0x18, 0xc3, 0x51, 0x52, 0xdb, 0xbe,
-		0xef, 0x6f, 0xde, 0x74, 0xcb, 0x3e, 0x07, 0x29, 0xbf, 0x30, 0xbc, 0xe9, 0xf8, 0xe0, 0x94, 0xe9,
-		0x78, 0x12, 0x96, 0x88, 0x58, 0x8f, 0x2c, 0x4d, 0xec, 0x23, 0xfb, 0x43, 0x01, 0x48, 0xf9, 0x3b,
-		0x86, 0xae, 0x02, 0x12, 0x23, 0xa6, 0x1b, 0x76, 0xcf, 0x6c, 0xf5, 0x9b, 0xb8, 0xc5, 0x27, 0xec,
-		0xf8, 0x76, 0xe6, 0x38, 0x4e, 0xc5, 0x41, 0xf1, 0x12, 0xf2, 0xcc, 0x82, 0xc0, 0x94, 0x84, 0x4a,
-		0xee, 0xfc, 0x38, 0x07, 0xf3, 0x82, 0x00, 0x21, 0xf6, 0x82, 0xd6, 0x33, 0x88, 0x8b, 0xcc, 0x9c,
-		0x76, 0xe4, 0xa9, 0x7a, 0x86, 0xd5, 0xa0, 0x3c, 0x08, 0x75, 0x51, 0x7b, 0xb8, 0x63, 0xde, 0xc6,
-		0x2d, 0x9e, 0x71, 0x1a, 0xdf, 0x6c, 0x8a, 0x23, 0x28, 0x0c, 0x3e, 0x77, 0x0e, 0xc2, 0xd4, 0xfc,
-		0x20, 0x00, 0x6e, 0x80, 0xe4, 0x19, 0x14, 0x83, 0x50, 0xb1, 0xa6, 0x90, 0xe5, 0x51, 0x86, 0x24,
-		0x2b, 0x55, 0x37, 0x2a, 0xe5, 0x62, 0x59, 0x0e, 0xe4, 0x2e, 0x40, 0x84, 0xd9, 0x14, 0xb2, 0x74,
-		0x3a, 0x56, 0x45, 0x9e, 0xe1, 0x9f, 0x9c, 0x86, 0x24, 0x6a, 0x37, 0xd7, 0x0b, 0x65, 0x45, 0x0e,
-		0xe4, 0x36, 0x21, 0x3d, 0x30, 0x0f, 0xd1, 0x11, 0x98, 0x53, 0xca, 0x8d, 0x72, 0xb5, 0x51, 0xa9,
-		0x55, 0xd5, 0xcd, 0xea, 0xd3, 0xd5, 0xda, 0x33, 0x55, 0x79, 0xc6, 0x5f, 0x2c, 0xd6, 0x61, 0x09,
-		0x2d, 0x80, 0xec, 0x16, 0xd7, 0x6b, 0x9b, 0x0a, 0xe5, 0xe6, 0x7b, 0x03, 0x20, 0x0f, 0x4e, 0x4a,
-		0x74, 0x0c, 0xe6, 0x1b, 0x79, 0xe5, 0x6a, 0xb9, 0xa1, 0xb2, 0x9c, 0x89, 0x43, 0x7a, 0x01, 0x64,
-		0x6f, 0xc5, 0x95, 0x0a, 0x4d, 0x09, 0x2d, 0xc2, 0x09, 0x6f, 0x69, 0xf9, 0xd9, 0x46, 0xb9, 0x5a,
-		0xa7, 0x8d, 0xe7, 0xab, 0x57, 0x89, 0x53, 0x30, 0x40, 0x4f, 0x64, 0x69, 0x82, 0x84, 0x55, 0x3f,
-		0xbd, 0xf2, 0x5a, 0x49, 0x0e, 0x0d, 0x16, 0xd7, 0xaa, 0xe5, 0xda, 0x15, 0x39, 0x3c, 0xd8, 0x3a,
-		0xcd, 0xdc, 0x44, 0x50, 0x16, 0x8e, 0x0e, 0x96, 0xaa, 0xe5, 0x6a, 0x43, 0xb9, 0x29, 0x47, 0x07,
-		0x1b, 0xae, 0x97, 0x95, 0x1b, 0x95, 0x62, 0x59, 0x8e, 0xa1, 0xa3, 0x80, 0xfc, 0x1c, 0x35, 0xae,
-		0xd5, 0x4a, 0x72, 0x7c, 0xd4, 0x8a, 0x85, 0xe4, 0xf9, 0xdc, 0xcf, 0x48, 0x90, 0xf4, 0x66, 0x51,
-		0x7c, 0x46, 0x45, 0x7a, 0xa3, 0x2d, 0xb6, 0xb9, 0xdf, 0x0e, 0x40, 0xc2, 0x93, 0x4e, 0x21, 0x41,
-		0xac, 0xd6, 0x6e, 0x9b, 0x2f, 0xa8, 0x5a, 0x5b, 0xd7, 0x2c, 0xbe, 0x1e, 0x02, 0x2d, 0xca, 0x93,
-		0x92, 0x69, 0xd7, 0x9f, 0xe9, 0x5d, 0x97, 0xc8, 0x5d, 0xbb, 0x2e, 0xd1, 0x37, 0xa0, 0xeb, 0x12,
-		0x96, 0x23, 0xb9, 0xdf, 0x0d, 0x80, 0x3c, 0x98, 0x1d, 0x19, 0x90, 0x9b, 0x34, 0x4e, 0x6e, 0xde,
-		0xfe, 0x05, 0x0e, 0xd3, 0xbf, 0xc1, 0x55, 0x3d, 0x38, 0x76, 0x55, 0x1f, 0xb1, 0x58, 0x85, 0xde,
-		0xc8, 0x8b, 0x95, 0x57, 0x5d, 0xff, 0x9d, 0x04, 0x29, 0x7f, 0x32, 0xc7, 0x27, 0xb1, 0xdc, 0x61,
-		0x24, 0xe6, 0x1f, 0x91, 0x53, 0xe3, 0x46, 0xe4, 0x6f, 0xa5, 0x5f, 0x3f, 0x12, 0x84, 0x59, 0x5f,
-		0xee, 0x67, 0x5a, 0xee, 0xde, 0x01, 0x73, 0x7a, 0x0b, 0x77, 0xba, 0xa6, 0x8d, 0x8d, 0xe6, 0xbe,
-		0xda, 0xc6, 0xb7, 0x71, 0x9b, 0x8a, 0x21, 0x35, 0x62, 0x8f, 0xd7, 0xd7, 0xc2, 0x72, 0xc5, 0xc5,
-		0x5b, 0x23, 0x68, 0xab, 0xf3, 0x95, 0x52, 0x79, 0x7d, 0xa3, 0xd6, 0x28, 0x57, 0x8b, 0x37, 0x85,
-		0x25, 0x57, 0x64, 0x7d, 0x00, 0xcc, 0x27, 0xf0, 0xfb, 0xde, 0x18, 0x41, 0xe7, 0x06, 0xc8, 0x83,
-		0xbd, 0x21, 0x06, 0x7d, 0x44, 0x7f, 0xe4, 0x19, 0x34, 0x0f, 0xe9, 0x6a, 0x4d, 0xad, 0x57, 0x4a,
-		0x65, 0xb5, 0x7c, 0xe5, 0x4a, 0xb9, 0xd8, 0xa8, 0xb3, 0xbd, 0x0a, 0x07, 0xba, 0x21, 0x07, 0xbc,
-		0x63, 0xf3, 0xa3, 0x41, 0x98, 0x1f, 0xc1, 0x09, 0xca, 0xf3, 0x14, 0x21, 0xcb, 0x5a, 0x9e, 0x9d,
-		0x86, 0xfb, 0x65, 0x12, 0xdd, 0x6f, 0x68, 0x3d, 0x9b, 0x67, 0x14, 0x1f, 0x02, 0x22, 0x5e, 0xc3,
-		0x26, 0xee, 0x7d, 0x8f, 0xef, 0x01, 0x31, 0x17, 0x24, 0xed, 0x96, 0xb3, 0x6d, 0xa0, 0x47, 0x00,
-		0x75, 0x4d, 0x4b, 0xb7, 0xf5, 0xdb, 0x98, 0xf8, 0x50, 0x1c, 0x98, 0x4c, 0xdc, 0x90, 0x22, 0x8b,
-		0x9a, 0x8a, 0x61, 0x3b, 0xd0, 0x06, 0xde, 0xd1, 0x06, 0xa0, 0x49, 0xf8, 0x11, 0x54, 0x64, 0x51,
-		0xe3, 0x40, 0x9f, 0x82, 0x64, 0xcb, 0xec, 0x6f, 0xb5, 0x31, 0x87, 0x23, 0x26, 0x59, 0x52, 0x12,
-		0xac, 0xcc, 0x01, 0xe1, 0x69, 0x33, 0x77, 0xa7, 0x2a, 0xa9, 0x24, 0x58, 0x19, 0x03, 0x79, 0x10,
-		0xd2, 0xda, 0xce, 0x4e, 0x8f, 0x10, 0x17, 0x84, 0x58, 0x22, 0x30, 0xe5, 0x14, 0x53, 0xc0, 0xec,
-		0x75, 0x88, 0x09, 0x39, 0x90, 0xf8, 0x97, 0x48, 0x42, 0xed, 0xb2, 0xec, 0x76, 0xe0, 0x74, 0x5c,
-		0x89, 0x19, 0xa2, 0xf2, 0x14, 0x24, 0x75, 0xcb, 0x3d, 0xf5, 0x94, 0x09, 0x2c, 0x05, 0x4e, 0xc7,
-		0x94, 0x84, 0x6e, 0xb9, 0x27, 0x9b, 0x7e, 0x49, 0x06, 0x70, 0x95, 0x0d, 0x7d, 0xbf, 0x04, 0x29,
-		0xb6, 0xc0, 0x74, 0x7b, 0xd8, 0xc2, 0x46, 0x53, 0x84, 0x85, 0x0f, 0x1d, 0xa0, 0xa2, 0xcc, 0xcc,
-		0x6d, 0x70, 0x84, 0xc2, 0x53, 0xef, 0x95, 0xa4, 0x97, 0xa5, 0xd0, 0xcb, 0x92, 0xf4, 0x31, 0x69,
-		0x16, 0xc5, 0xca, 0xcf, 0x6e, 0xac, 0x55, 0x8a, 0x95, 0x46, 0xe6, 0x3d, 0x51, 0xfa, 0x5d, 0x59,
-		0xe7, 0xdf, 0xaf, 0x44, 0xfd, 0xf5, 0xaf, 0x46, 0x3f, 0x2d, 0x05, 0x63, 0xaf, 0x46, 0x95, 0xd9,
-		0x6d, 0x2f, 0x3d, 0xd4, 0xf6, 0x9e, 0xe3, 0x08, 0x8c, 0x0b, 0x24, 0x5d, 0x6e, 0xca, 0xfc, 0xf4,
-		0x46, 0xe1, 0x21, 0xca, 0x48, 0x84, 0x32, 0x92, 0x40, 0x91, 0xe2, 0x5a, 0xad, 0x5e, 0x2e, 0x51,
-		0x36, 0xe2, 0x28, 0x54, 0xdb, 0x28, 0x57, 0x33, 0xaf, 0x88, 0x26, 0xdd, 0x23, 0x1f, 0x2f, 0x4b,
-		0x70, 0x4c, 0x6c, 0xd4, 0xf2, 0xb5, 0x16, 0x1b, 0x4d, 0xb3, 0x25, 0xbc, 0xdb, 0xd4, 0xca, 0xf9,
-		0x83, 0x1a, 0x57, 0x38, 0x2a, 0x15, 0x49, 0x99, 0x23, 0x16, 0xce, 0x0e, 0x89, 0x24, 0x5f, 0x2d,
-		0x71, 0x5e, 0x12, 0x28, 0xb2, 0x91, 0x2f, 0x3e, 0x5d, 0x2e, 0xb9, 0xdc, 0x1c, 0xe9, 0x8d, 0xa2,
-		0x82, 0xbe, 0x03, 0xd2, 0x7d, 0x7b, 0xfb, 0x49, 0xa2, 0x1b, 0x7a, 0x8b, 0xed, 0x9c, 0x87, 0xc6,
-		0x6d, 0xb9, 0xba, 0x1c, 0x6d, 0xda, 0xdb, 0x4f, 0xde, 0x70, 0x30, 0xb8, 0x50, 0x18, 0x2b, 0x71,
-		0x14, 0xaa, 0xd6, 0xaa, 0x65, 0xc1, 0x06, 0xdd, 0x65, 0xbe, 0xe9, 0xb2, 0x91, 0xea, 0xfb, 0x50,
-		0xd1, 0x77, 0x80, 0x2c, 0xd2, 0x43, 0x8e, 0x48, 0xc2, 0xe3, 0x76, 0x8d, 0x5d, 0x06, 0x78, 0x92,
-		0xc9, 0x11, 0xc6, 0x03, 0x1e, 0x0e, 0x16, 0x50, 0x7a, 0xad, 0x5c, 0xbd, 0xda, 0xb8, 0xa6, 0x6e,
-		0x28, 0x65, 0xba, 0xf9, 0x97, 0x79, 0x8f, 0x68, 0x3e, 0xdd, 0xf1, 0x23, 0xa2, 0xef, 0x94, 0x20,
-		0xc1, 0x5c, 0x20, 0x96, 0x93, 0x62, 0x49, 0x85, 0x07, 0x0e, 0x6a, 0x9b, 0x7a, 0x40, 0x14, 0xba,
-		0x70, 0x89, 0x36, 0x1b, 0x14, 0x0a, 0x71, 0x0c, 0xa1, 0xb5, 0xf2, 0xd5, 0x7c, 0xf1, 0xa6, 0x5a,
-		0x28, 0xd7, 0x1b, 0xc4, 0x92, 0xd5, 0x14, 0xa6, 0xa3, 0x80, 0xc2, 0xf9, 0xb5, 0xb5, 0xda, 0x33,
-		0xae, 0x20, 0xe0, 0x79, 0x87, 0x0c, 0xfa, 0x69, 0x09, 0x16, 0xb0, 0xb1, 0x6d, 0xf6, 0x9a, 0x74,
-		0xc3, 0x9a, 0xcc, 0x68, 0xcb, 0xde, 0x6f, 0xb3, 0x19, 0x3d, 0x32, 0x28, 0xf7, 0x6a, 0x26, 0xc5,
-		0xab, 0x52, 0xb4, 0x3a, 0xc1, 0x2a, 0x54, 0xde, 0x2b, 0x05, 0x5e, 0x26, 0x8c, 0x05, 0x28, 0x6f,
-		0xa1, 0x97, 0xa5, 0x30, 0xe5, 0x30, 0xfa, 0xb2, 0x14, 0x7b, 0x59, 0x8a, 0x7f, 0x4c, 0x9a, 0x43,
-		0xc9, 0x7a, 0xe3, 0xe6, 0x5a, 0x59, 0x65, 0xdc, 0x52, 0x0e, 0x53, 0x28, 0x4e, 0xcb, 0x56, 0x1e,
-		0x5d, 0x79, 0x3c, 0xf3, 0x25, 0xca, 0xe5, 0x97, 0xa2, 0x0a, 0xc2, 0x43, 0xe4, 0xd1, 0x2f, 0x49,
-		0x70, 0x5c, 0x6c, 0x91, 0x5b, 0x74, 0xdb, 0x4c, 0xf5, 0x6c, 0xb0, 0xc5, 0x28, 0xcb, 0xe5, 0x83,
-		0x58, 0x76, 0x77, 0xd9, 0x78, 0xe1, 0x32, 0x0f, 0x78, 0x07, 0x37, 0xe1, 0x0a, 0x17, 0x59, 0x4f,
-		0x3e, 0x26, 0xa5, 0x11, 0x94, 0x9f, 0xdd, 0xa8, 0x29, 0x0d, 0x35, 0xbf, 0xb6, 0x46, 0xf9, 0x3d,
-		0x82, 0x64, 0x5e, 0xd2, 0xa8, 0x6d, 0xa8, 0x6b, 0xe5, 0x1b, 0xe5, 0x35, 0x97, 0xed, 0x63, 0xad,
-		0xd1, 0x04, 0xb3, 0x1f, 0x95, 0x60, 0x6e, 0xa8, 0xf9, 0xdc, 0xbb, 0x24, 0x38, 0x36, 0x86, 0x05,
-		0x74, 0x3f, 0x9c, 0x2a, 0x95, 0xaf, 0xe4, 0x37, 0xd7, 0x1a, 0x6a, 0xfd, 0xe6, 0x7a, 0xa1, 0xb6,
-		0xa6, 0xde, 0xa8, 0xd4, 0x2b, 0x85, 0xca, 0x5a, 0xa5, 0xe1, 0x5d, 0xc0, 0x52, 0xe0, 0x61, 0x90,
-		0x85, 0x6b, 0x83, 0xec, 0xc9, 0x01, 0x12, 0x14, 0xae, 0xd5, 0x8a, 0xf9, 0x35, 0x0a, 0x14, 0x14,
-		0x31, 0x67, 0xb1, 0x21, 0x87, 0xae, 0xc7, 0x62, 0x12, 0x5f, 0xdb, 0xbe, 0x15, 0x66, 0x7d, 0xc6,
-		0x8f, 0x84, 0x48, 0x34, 0xb4, 0x22, 0xfa, 0x5c, 0x2f, 0x57, 0x8b, 0xde, 0x90, 0x2e, 0x09, 0x8e,
-		0xb1, 0x93, 0x25, 0xf2, 0x25, 0x4c, 0xa1, 0x1c, 0x20, 0x8b, 0x2a, 0x57, 0x47, 0x67, 0x73, 0x3a,
-		0x98, 0x7b, 0x02, 0x62, 0xc2, 0x98, 0x91, 0x40, 0x8d, 0xc6, 0x5b, 0x03, 0x61, 0x62, 0x0c, 0xa8,
-		0x25, 0x93, 0x25, 0xc2, 0x20, 0xb3, 0x70, 0x72, 0x20, 0x77, 0x03, 0x8e, 0x8c, 0x34, 0x44, 0xe8,
-		0x3e, 0x58, 0x14, 0x1b, 0xe2, 0x2c, 0x04, 0x54, 0xcb, 0xd5, 0x62, 0xad, 0x44, 0x82, 0x66, 0x97,
-		0x26, 0x00, 0xb7, 0x48, 0x8c, 0x4b, 0x61, 0xad, 0xe4, 0x40, 0xae, 0x02, 0x29, 0xbf, 0x39, 0x41,
-		0x27, 0xe0, 0xd8, 0x66, 0xe3, 0xca, 0x93, 0xea, 0x8d, 0xfc, 0x5a, 0xa5, 0x94, 0x1f, 0x08, 0x8f,
-		0x01, 0xb8, 0x4d, 0x91, 0x03, 0x84, 0x51, 0x62, 0x6b, 0xe4, 0x60, 0x2e, 0x14, 0x93, 0x64, 0x29,
-		0x57, 0x87, 0xf4, 0x80, 0x61, 0x40, 0xf7, 0x40, 0x86, 0xc7, 0xab, 0xa3, 0xb8, 0xa2, 0x12, 0xf2,
-		0x99, 0x0a, 0x16, 0xb9, 0x97, 0xca, 0x6b, 0x95, 0xf5, 0x4a, 0x83, 0xf2, 0x77, 0x0d, 0xc0, 0x9d,
-		0xf1, 0xc4, 0x83, 0xb9, 0x5e, 0xaf, 0x55, 0xd5, 0x2b, 0x24, 0xec, 0x6f, 0x78, 0x48, 0xc5, 0x81,
-		0xcd, 0x70, 0x59, 0x22, 0xd1, 0xe9, 0xb0, 0x19, 0x90, 0x03, 0xb9, 0x67, 0x00, 0x0d, 0xcf, 0x56,
-		0xb4, 0x04, 0xf7, 0x94, 0xab, 0x57, 0x6a, 0x4a, 0xb1, 0xac, 0x56, 0xf3, 0xeb, 0x84, 0x3f, 0x36,
-		0x37, 0x5d, 0xd2, 0xb3, 0xe0, 0x4e, 0x4d, 0x91, 0x93, 0x70, 0x67, 0xaf, 0x1c, 0x38, 0xf3, 0x29,
-		0x89, 0x78, 0x46, 0xef, 0xab, 0x66, 0x3f, 0x21, 0xa1, 0x7b, 0x63, 0xaf, 0x46, 0x51, 0x74, 0xb9,
-		0xbb, 0xb5, 0xdc, 0xec, 0x76, 0xb3, 0x69, 0xf2, 0x47, 0xb1, 0xdb, 0xbd, 0x22, 0xfc, 0xbd, 0xc5,
-		0xd8, 0x97, 0xa2, 0x28, 0x46, 0x4a, 0x9f, 0xd7, 0x6e, 0x6b, 0x59, 0x99, 0xfc, 0x75, 0x5d, 0xbb,
-		0xad, 0x39, 0x00, 0x27, 0x62, 0x5f, 0x8e, 0xa2, 0x08, 0x29, 0xde, 0x31, 0xb3, 0x29, 0xf2, 0xff,
-		0x55, 0xd3, 0xa9, 0xbc, 0x2f, 0xf6, 0x95, 0x28, 0x02, 0x52, 0xd8, 0xdd, 0xb7, 0x77, 0x4d, 0x23,
-		0x8b, 0xc8, 0xdf, 0x1b, 0xf4, 0x6f, 0x0f, 0xd0, 0x77, 0x57, 0x39, 0x10, 0x99, 0xfc, 0xe7, 0x39,
-		0x10, 0xfd, 0x5b, 0x00, 0x9d, 0x89, 0xc4, 0xde, 0x57, 0x95, 0x3f, 0x50, 0x3d, 0x13, 0x89, 0x7d,
-		0xa0, 0x2a, 0x7f, 0x7f, 0xf5, 0x7a, 0x24, 0xf6, 0x4a, 0x54, 0x7e, 0x35, 0x9a, 0xfb, 0xd3, 0x20,
-		0x20, 0xd7, 0x54, 0x38, 0x59, 0xc0, 0x67, 0x21, 0xe6, 0xa4, 0x15, 0xd9, 0x39, 0xef, 0x37, 0x1f,
-		0x60, 0x61, 0x04, 0x9a, 0xa7, 0x68, 0x20, 0xcd, 0xe8, 0x50, 0x43, 0x79, 0x48, 0x77, 0x74, 0x43,
-		0xef, 0xf4, 0x3b, 0xaa, 0xc8, 0xb5, 0x4d, 0xcc, 0x21, 0x71, 0x04, 0xfe, 0x4d, 0x49, 0x68, 0x7b,
-		0x3e, 0x12, 0xe1, 0x89, 0x24, 0x18, 0x02, 0xff, 0xce, 0xfe, 0xb5, 0x04, 0x99, 0x71, 0xcc, 0xde,
-		0x55, 0x1a, 0xb0, 0x0a, 0x0b, 0xe6, 0x6d, 0xdc, 0xeb, 0xe9, 0x2d, 0xba, 0xb3, 0xe7, 0x04, 0x07,
-		0xa1, 0xc9, 0xc1, 0xc1, 0xbc, 0x07, 0xd1, 0x19, 0xd4, 0x02, 0xf1, 0xe1, 0xf6, 0x88, 0xfb, 0x22,
-		0x28, 0x85, 0x27, 0x53, 0x9a, 0xa5, 0x28, 0x82, 0xc6, 0x75, 0x32, 0x49, 0x49, 0x3c, 0x1e, 0x90,
-		0x83, 0x6e, 0x04, 0x92, 0xfb, 0x70, 0x10, 0x52, 0xfe, 0xe3, 0xca, 0xa8, 0x04, 0xb1, 0xb6, 0xc9,
-		0xcf, 0xf1, 0xb1, 0xd1, 0x3e, 0x3d, 0xe1, 0x84, 0xf3, 0xf2, 0x1a, 0x87, 0x57, 0x1c, 0xcc, 0xec,
-		0xbf, 0x95, 0x20, 0x26, 0x8a, 0xd1, 0x51, 0x08, 0x75, 0x35, 0x7b, 0x97, 0x92, 0x0b, 0x17, 0x02,
-		0xb2, 0xa4, 0xd0, 0x6f, 0x52, 0x6e, 0x75, 0x35, 0x76, 0x86, 0x91, 0x97, 0x93, 0x6f, 0x12, 0x05,
-		0xb4, 0xb1, 0xd6, 0xa2, 0x7b, 0xd2, 0x66, 0xa7, 0x83, 0x0d, 0xdb, 0x12, 0x51, 0x00, 0x2f, 0x2f,
-		0xf2, 0x62, 0xf4, 0x30, 0xcc, 0xd9, 0x3d, 0x4d, 0x6f, 0xfb, 0x60, 0x43, 0x14, 0x56, 0x16, 0x15,
-		0x0e, 0xf0, 0x2a, 0x1c, 0x17, 0x74, 0x5b, 0xd8, 0xd6, 0x9a, 0xbb, 0xb8, 0xe5, 0x22, 0x45, 0xe8,
-		0xf1, 0x95, 0x63, 0x1c, 0xa0, 0xc4, 0xeb, 0x05, 0xee, 0x99, 0xbe, 0xef, 0xba, 0xc2, 0x0e, 0xc2,
-		0xfc, 0xba, 0xc2, 0xf9, 0x31, 0xd7, 0x15, 0x06, 0x4f, 0x8b, 0x7b, 0xee, 0x2a, 0x9c, 0x19, 0x81,
-		0xe2, 0x97, 0xa8, 0xeb, 0xce, 0x7f, 0x3e, 0x00, 0x73, 0x62, 0xf3, 0xbe, 0xe5, 0x8c, 0xd1, 0x3a,
-		0x80, 0x66, 0x18, 0xa6, 0xed, 0x1d, 0xa5, 0xe1, 0x78, 0x6b, 0x08, 0x6f, 0x39, 0xef, 0x20, 0x29,
-		0x1e, 0x02, 0xd9, 0xaf, 0x48, 0x00, 0x6e, 0xd5, 0xd8, 0xe1, 0x5a, 0x84, 0x04, 0xef, 0x15, 0xbd,
-		0xf2, 0xc1, 0x72, 0xdc, 0xc0, 0x8a, 0xae, 0xe8, 0x6d, 0x7a, 0x2a, 0x67, 0x0b, 0xef, 0xe8, 0x06,
-		0x3f, 0x91, 0xc8, 0x3e, 0xc4, 0xa9, 0x9c, 0x90, 0x7b, 0x62, 0x57, 0x81, 0x98, 0x85, 0x3b, 0x9a,
-		0x61, 0xeb, 0x4d, 0x3e, 0x59, 0x2f, 0x1e, 0x8a, 0xf9, 0xe5, 0x3a, 0xc7, 0x56, 0x1c, 0x3a, 0xb9,
-		0xd3, 0x10, 0x13, 0xa5, 0xce, 0xd2, 0x34, 0x83, 0xa2, 0x10, 0xac, 0x97, 0xc9, 0xe2, 0x4c, 0x57,
-		0x88, 0x4a, 0xbe, 0x2e, 0x07, 0xce, 0x7c, 0x22, 0x00, 0x51, 0x61, 0x3d, 0xe6, 0x21, 0x5d, 0x2e,
-		0x55, 0x06, 0x56, 0xb9, 0x79, 0x48, 0x89, 0x42, 0x6e, 0xe5, 0xdf, 0x13, 0xf5, 0x16, 0x6e, 0x28,
-		0xb5, 0x46, 0x6d, 0x45, 0xfe, 0xe3, 0xe1, 0xc2, 0xc7, 0xe4, 0x57, 0xa2, 0x68, 0x0e, 0x92, 0xa2,
-		0x70, 0xe5, 0xd1, 0x95, 0xc7, 0xe4, 0x57, 0x07, 0x8b, 0x1e, 0x97, 0xbf, 0x44, 0xd3, 0xab, 0xa2,
-		0xe8, 0xbc, 0xda, 0x20, 0x4b, 0x55, 0xad, 0xba, 0x76, 0x53, 0x96, 0xbc, 0x15, 0x2b, 0x9e, 0x8a,
-		0x00, 0xba, 0x17, 0x8e, 0x89, 0x8a, 0x4b, 0x97, 0x2e, 0x5d, 0x7a, 0xc2, 0x53, 0x79, 0xe7, 0xfd,
-		0x91, 0xc1, 0xea, 0x27, 0x3d, 0xd5, 0x1f, 0x1e, 0xae, 0xbe, 0xe4, 0xa9, 0xfe, 0xb1, 0xf7, 0x47,
-		0xd0, 0x3c, 0x24, 0x44, 0xf5, 0x7a, 0xfe, 0x59, 0xf9, 0xeb, 0x5f, 0xff, 0xfa, 0xd7, 0xa3, 0x67,
-		0x36, 0x41, 0x1e, 0x72, 0xc9, 0x16, 0x40, 0xf6, 0xf9, 0x60, 0x44, 0xbc, 0x33, 0x03, 0xa5, 0xd4,
-		0xcd, 0x92, 0x25, 0xe2, 0xe2, 0x78, 0x4a, 0x99, 0x4b, 0x26, 0x07, 0x0a, 0xdf, 0x01, 0xf3, 0x4d,
-		0xb3, 0x33, 0x38, 0xe2, 0x05, 0x79, 0xe0, 0xc8, 0x91, 0x75, 0x4d, 0x7a, 0xee, 0x2c, 0x07, 0xda,
-		0x31, 0xdb, 0x9a, 0xb1, 0xb3, 0x6c, 0xf6, 0x76, 0xdc, 0x2b, 0x4b, 0x24, 0x7c, 0xb4, 0x3c, 0x17,
-		0x97, 0xba, 0x5b, 0x7f, 0x2d, 0x49, 0x1f, 0x0b, 0x04, 0xaf, 0x6e, 0x14, 0x7e, 0x2a, 0x90, 0xbd,
-		0xca, 0x10, 0x37, 0x84, 0x3e, 0x29, 0x78, 0xbb, 0x8d, 0x9b, 0x64, 0xd0, 0xe1, 0x4f, 0x1e, 0x86,
-		0x85, 0x1d, 0x73, 0xc7, 0xa4, 0x94, 0xce, 0x91, 0xbf, 0xf8, 0x9d, 0xa7, 0xb8, 0x53, 0x9a, 0x9d,
-		0x78, 0x41, 0x6a, 0xb5, 0x0a, 0xf3, 0x1c, 0x58, 0xa5, 0xd1, 0x2c, 0x3b, 0x15, 0x81, 0x0e, 0x3c,
-		0x9c, 0x97, 0xf9, 0xb9, 0x3f, 0xa2, 0x69, 0x48, 0x65, 0x8e, 0xa3, 0x92, 0x3a, 0x76, 0x70, 0x62,
-		0x55, 0x81, 0x23, 0x3e, 0x7a, 0x2c, 0x93, 0x80, 0x7b, 0x13, 0x28, 0xfe, 0x3a, 0xa7, 0x38, 0xef,
-		0xa1, 0x58, 0xe7, 0xa8, 0xab, 0x45, 0x98, 0x3d, 0x0c, 0xad, 0x7f, 0xc9, 0x69, 0x25, 0xb1, 0x97,
-		0xc8, 0x55, 0x48, 0x53, 0x22, 0xcd, 0xbe, 0x65, 0x9b, 0x1d, 0x9a, 0xa6, 0x39, 0x98, 0xcc, 0xbf,
-		0xfa, 0x23, 0x66, 0xac, 0x53, 0x04, 0xad, 0xe8, 0x60, 0xad, 0xae, 0x02, 0x8d, 0xca, 0x5b, 0xb8,
-		0xd9, 0x9e, 0x40, 0xe1, 0x37, 0x38, 0x23, 0x0e, 0xfc, 0xea, 0x0d, 0x12, 0xa4, 0xf5, 0x3b, 0x34,
-		0x8b, 0xe2, 0xe5, 0x64, 0xf2, 0x31, 0xbc, 0xcc, 0x6f, 0xbf, 0x9b, 0xad, 0x07, 0xf3, 0x0e, 0x01,
-		0x0f, 0x4f, 0x9e, 0x51, 0xdc, 0xc1, 0xb6, 0x8d, 0x7b, 0x96, 0xaa, 0xb5, 0x47, 0xb1, 0xe7, 0x39,
-		0xc7, 0x94, 0xf9, 0x91, 0xaf, 0xfa, 0x47, 0xf1, 0x2a, 0xc3, 0xcc, 0xb7, 0xdb, 0xab, 0x9b, 0x70,
-		0x6c, 0x84, 0x56, 0x4c, 0x41, 0xf3, 0x47, 0x39, 0xcd, 0x85, 0x21, 0xcd, 0x20, 0x64, 0x37, 0x40,
-		0x94, 0x3b, 0x63, 0x39, 0x05, 0xcd, 0x0f, 0x72, 0x9a, 0x88, 0xe3, 0x8a, 0x21, 0x25, 0x14, 0xaf,
-		0xc3, 0xdc, 0x6d, 0xdc, 0xdb, 0x32, 0x2d, 0x7e, 0x76, 0x6c, 0x0a, 0x72, 0x1f, 0xe2, 0xe4, 0xd2,
-		0x1c, 0x91, 0x1e, 0x26, 0x23, 0xb4, 0x2e, 0x41, 0x6c, 0x5b, 0x6b, 0xe2, 0x29, 0x48, 0xdc, 0xe1,
-		0x24, 0xa2, 0x04, 0x9e, 0xa0, 0xe6, 0x21, 0xb9, 0x63, 0xf2, 0x44, 0xda, 0x64, 0xf4, 0x0f, 0x73,
-		0xf4, 0x84, 0xc0, 0xe1, 0x24, 0xba, 0x66, 0xb7, 0xdf, 0xd6, 0xec, 0x69, 0x38, 0xf8, 0x31, 0x41,
-		0x42, 0xe0, 0x70, 0x12, 0x87, 0x10, 0xeb, 0x47, 0x04, 0x09, 0xcb, 0x23, 0xcf, 0xa7, 0x20, 0x61,
-		0x1a, 0xed, 0x7d, 0xd3, 0x98, 0x86, 0x89, 0x8f, 0x72, 0x0a, 0xc0, 0x51, 0x08, 0x81, 0xcb, 0x10,
-		0x9f, 0x76, 0x20, 0x7e, 0xe2, 0xab, 0x62, 0x7a, 0x88, 0x11, 0xb8, 0x0a, 0x69, 0x61, 0xa0, 0x74,
-		0xd3, 0x98, 0x82, 0xc4, 0xc7, 0x39, 0x89, 0x94, 0x07, 0x8d, 0x77, 0xc3, 0xc6, 0x96, 0xbd, 0x83,
-		0xa7, 0x21, 0xf2, 0x09, 0xd1, 0x0d, 0x8e, 0xc2, 0x45, 0xb9, 0x85, 0x8d, 0xe6, 0xee, 0x74, 0x14,
-		0x3e, 0x29, 0x44, 0x29, 0x70, 0x08, 0x89, 0x22, 0xcc, 0x76, 0xb4, 0x9e, 0xb5, 0xab, 0xb5, 0xa7,
-		0x1a, 0x8e, 0x9f, 0xe4, 0x34, 0x92, 0x0e, 0x12, 0x97, 0x48, 0xdf, 0x38, 0x0c, 0x99, 0x9f, 0x12,
-		0x12, 0xf1, 0xa0, 0xf1, 0xa9, 0x67, 0xd9, 0xd4, 0x8f, 0x3f, 0x0c, 0xb5, 0x9f, 0x16, 0x53, 0x8f,
-		0xe1, 0xae, 0x7b, 0x29, 0x5e, 0x86, 0xb8, 0xa5, 0xbf, 0x38, 0x15, 0x99, 0x9f, 0x11, 0x23, 0x4d,
-		0x11, 0x08, 0xf2, 0x4d, 0x38, 0x3e, 0x72, 0x99, 0x98, 0x82, 0xd8, 0xcf, 0x72, 0x62, 0x47, 0x47,
-		0x2c, 0x15, 0xdc, 0x24, 0x1c, 0x96, 0xe4, 0x3f, 0x10, 0x26, 0x01, 0x0f, 0xd0, 0xda, 0x80, 0x85,
-		0xbe, 0x61, 0x69, 0xdb, 0x87, 0x93, 0xda, 0x3f, 0x14, 0x52, 0x63, 0xb8, 0x3e, 0xa9, 0x35, 0xe0,
-		0x28, 0xa7, 0x78, 0xb8, 0x71, 0xfd, 0x94, 0x30, 0xac, 0x0c, 0x7b, 0xd3, 0x3f, 0xba, 0xdf, 0x02,
-		0x59, 0x47, 0x9c, 0xc2, 0xe9, 0xb6, 0xd4, 0x8e, 0xd6, 0x9d, 0x82, 0xf2, 0xcf, 0x71, 0xca, 0xc2,
-		0xe2, 0x3b, 0x5e, 0xbb, 0xb5, 0xae, 0x75, 0x09, 0xf1, 0x67, 0x21, 0x23, 0x88, 0xf7, 0x8d, 0x1e,
-		0x6e, 0x9a, 0x3b, 0x86, 0xfe, 0x22, 0x6e, 0x4d, 0x41, 0xfa, 0xd3, 0x03, 0x43, 0xb5, 0xe9, 0x41,
-		0x27, 0x94, 0x2b, 0x20, 0x3b, 0xbe, 0x8a, 0xaa, 0x77, 0xe8, 0x7e, 0xe3, 0xc1, 0x14, 0x3f, 0x23,
-		0x46, 0xca, 0xc1, 0xab, 0x50, 0xb4, 0xd5, 0x32, 0xb0, 0x0b, 0x2c, 0xd3, 0xaa, 0xe4, 0x67, 0x39,
-		0xa1, 0x59, 0x17, 0x8b, 0x1b, 0x8e, 0xa6, 0xd9, 0xe9, 0x6a, 0xbd, 0x69, 0xec, 0xdf, 0x3f, 0x12,
-		0x86, 0x83, 0xa3, 0x70, 0xc3, 0x41, 0x3c, 0x3a, 0xb2, 0xda, 0x4f, 0x41, 0xe1, 0xe7, 0x85, 0xe1,
-		0x10, 0x38, 0x9c, 0x84, 0x70, 0x18, 0xa6, 0x20, 0xf1, 0x0b, 0x82, 0x84, 0xc0, 0x21, 0x24, 0xde,
-		0xe6, 0x2e, 0xb4, 0x3d, 0xbc, 0xa3, 0x5b, 0x36, 0xbf, 0x62, 0x76, 0x30, 0xa9, 0x5f, 0xfc, 0xaa,
-		0xdf, 0x09, 0x53, 0x3c, 0xa8, 0xc4, 0x12, 0xf1, 0xc4, 0x37, 0xdd, 0xd8, 0x99, 0xcc, 0xd8, 0x2f,
-		0x09, 0x4b, 0xe4, 0x41, 0x23, 0xbc, 0x79, 0x3c, 0x44, 0x22, 0xf6, 0x26, 0x09, 0x50, 0xa7, 0x20,
-		0xf7, 0x8f, 0x07, 0x98, 0xab, 0x0b, 0x5c, 0x42, 0xd3, 0xe3, 0xff, 0xf4, 0x8d, 0x5b, 0x78, 0x7f,
-		0x2a, 0xed, 0xfc, 0x27, 0x03, 0xfe, 0xcf, 0x26, 0xc3, 0x64, 0x36, 0x24, 0x3d, 0xe0, 0x4f, 0xa1,
-		0x49, 0x57, 0x58, 0x33, 0xef, 0x7a, 0x8d, 0xf7, 0xd7, 0xef, 0x4e, 0xad, 0xae, 0x11, 0x25, 0xf7,
-		0x3b, 0x3d, 0x93, 0x89, 0xbd, 0xfb, 0x35, 0x47, 0xcf, 0x7d, 0x3e, 0xcf, 0xea, 0x15, 0x98, 0xf5,
-		0x39, 0x3c, 0x93, 0x49, 0xbd, 0x87, 0x93, 0x4a, 0x7a, 0xfd, 0x9d, 0xd5, 0x0b, 0x10, 0x22, 0xce,
-		0xcb, 0x64, 0xf4, 0xef, 0xe2, 0xe8, 0x14, 0x7c, 0xf5, 0x2d, 0x10, 0x13, 0x4e, 0xcb, 0x64, 0xd4,
-		0xef, 0xe6, 0xa8, 0x0e, 0x0a, 0x41, 0x17, 0x0e, 0xcb, 0x64, 0xf4, 0xff, 0x47, 0xa0, 0x0b, 0x14,
-		0x82, 0x3e, 0xbd, 0x08, 0x7f, 0xf5, 0x7d, 0x21, 0xbe, 0xe8, 0x08, 0xd9, 0x5d, 0x86, 0x28, 0xf7,
-		0x54, 0x26, 0x63, 0x7f, 0x0f, 0x6f, 0x5c, 0x60, 0xac, 0x3e, 0x01, 0xe1, 0x29, 0x05, 0xfe, 0x7e,
-		0x8e, 0xca, 0xe0, 0x57, 0x8b, 0x90, 0xf0, 0x78, 0x27, 0x93, 0xd1, 0xbf, 0x8f, 0xa3, 0x7b, 0xb1,
-		0x08, 0xeb, 0xdc, 0x3b, 0x99, 0x4c, 0xe0, 0xff, 0x15, 0xac, 0x73, 0x0c, 0x22, 0x36, 0xe1, 0x98,
-		0x4c, 0xc6, 0xfe, 0x80, 0x90, 0xba, 0x40, 0x59, 0x7d, 0x0a, 0xe2, 0xce, 0x62, 0x33, 0x19, 0xff,
-		0xfb, 0x39, 0xbe, 0x8b, 0x43, 0x24, 0xe0, 0x59, 0xec, 0x26, 0x93, 0xf8, 0xff, 0x84, 0x04, 0x3c,
-		0x58, 0x64, 0x1a, 0x0d, 0x3a, 0x30, 0x93, 0x29, 0xfd, 0x80, 0x98, 0x46, 0x03, 0xfe, 0x0b, 0x19,
-		0x4d, 0x6a, 0xf3, 0x27, 0x93, 0xf8, 0x41, 0x31, 0x9a, 0x14, 0x9e, 0xb0, 0x31, 0xe8, 0x11, 0x4c,
-		0xa6, 0xf1, 0xc3, 0x82, 0x8d, 0x01, 0x87, 0x60, 0x75, 0x03, 0xd0, 0xb0, 0x37, 0x30, 0x99, 0xde,
-		0xcb, 0x9c, 0xde, 0xdc, 0x90, 0x33, 0xb0, 0xfa, 0x0c, 0x1c, 0x1d, 0xed, 0x09, 0x4c, 0xa6, 0xfa,
-		0x23, 0xaf, 0x0d, 0xc4, 0x6e, 0x5e, 0x47, 0x60, 0xb5, 0xe1, 0x2e, 0x29, 0x5e, 0x2f, 0x60, 0x32,
-		0xd9, 0x1f, 0x7d, 0xcd, 0x6f, 0xb8, 0xbd, 0x4e, 0xc0, 0x6a, 0x1e, 0xc0, 0x5d, 0x80, 0x27, 0xd3,
-		0xfa, 0x10, 0xa7, 0xe5, 0x41, 0x22, 0x53, 0x83, 0xaf, 0xbf, 0x93, 0xf1, 0xef, 0x88, 0xa9, 0xc1,
-		0x31, 0xc8, 0xd4, 0x10, 0x4b, 0xef, 0x64, 0xec, 0x0f, 0x8b, 0xa9, 0x21, 0x50, 0x88, 0x66, 0x7b,
-		0x56, 0xb7, 0xc9, 0x14, 0x3e, 0x2a, 0x34, 0xdb, 0x83, 0xb5, 0x5a, 0x85, 0xb9, 0xa1, 0x05, 0x71,
-		0x32, 0xa9, 0x8f, 0x71, 0x52, 0xf2, 0xe0, 0x7a, 0xe8, 0x5d, 0xbc, 0xf8, 0x62, 0x38, 0x99, 0xda,
-		0x8f, 0x0f, 0x2c, 0x5e, 0x7c, 0x2d, 0x5c, 0xbd, 0x0c, 0x31, 0xa3, 0xdf, 0x6e, 0x93, 0xc9, 0x83,
-		0x0e, 0xbe, 0x62, 0x9c, 0xf9, 0xd2, 0xd7, 0xb8, 0x74, 0x04, 0xc2, 0xea, 0x05, 0x08, 0xe3, 0xce,
-		0x16, 0x6e, 0x4d, 0xc2, 0xfc, 0xf2, 0xd7, 0x84, 0xc1, 0x24, 0xd0, 0xab, 0x4f, 0x01, 0xb0, 0xd4,
-		0x08, 0x3d, 0x63, 0x3f, 0x01, 0xf7, 0x2b, 0x5f, 0xe3, 0x17, 0xf2, 0x5c, 0x14, 0x97, 0x00, 0xbb,
-		0xde, 0x77, 0x30, 0x81, 0xaf, 0xfa, 0x09, 0xd0, 0x11, 0xb9, 0x04, 0xd1, 0xe7, 0x2d, 0xd3, 0xb0,
-		0xb5, 0x9d, 0x49, 0xd8, 0x7f, 0xc2, 0xb1, 0x05, 0x3c, 0x11, 0x58, 0xc7, 0xec, 0x61, 0x5b, 0xdb,
-		0xb1, 0x26, 0xe1, 0xfe, 0x17, 0x8e, 0xeb, 0x20, 0x10, 0xe4, 0xa6, 0x66, 0xd9, 0xd3, 0xf4, 0xfb,
-		0x4f, 0x05, 0xb2, 0x40, 0x20, 0x4c, 0x93, 0xbf, 0x6f, 0xe1, 0xfd, 0x49, 0xb8, 0x7f, 0x26, 0x98,
-		0xe6, 0xf0, 0xab, 0x6f, 0x81, 0x38, 0xf9, 0x93, 0x5d, 0xd4, 0x9d, 0x80, 0xfc, 0xe7, 0x1c, 0xd9,
-		0xc5, 0x20, 0x2d, 0x5b, 0x76, 0xcb, 0xd6, 0x27, 0x0b, 0xfb, 0x2f, 0xf8, 0x48, 0x0b, 0xf8, 0xd5,
-		0x3c, 0x24, 0x2c, 0xbb, 0xd5, 0xea, 0x73, 0xff, 0x74, 0x02, 0xfa, 0x5f, 0x7e, 0xcd, 0x49, 0x59,
-		0x38, 0x38, 0x64, 0xb4, 0x5f, 0xb8, 0x65, 0x77, 0x4d, 0x7a, 0x2a, 0x6b, 0x12, 0x85, 0xd7, 0x38,
-		0x05, 0x0f, 0xca, 0x6a, 0x11, 0x92, 0xa4, 0x2f, 0xe2, 0x70, 0xcb, 0x24, 0x12, 0x7f, 0xc5, 0x05,
-		0xe0, 0x43, 0x2a, 0x7c, 0xfb, 0x6f, 0x7c, 0xf1, 0xa4, 0xf4, 0xf9, 0x2f, 0x9e, 0x94, 0xfe, 0xf3,
-		0x17, 0x4f, 0x4a, 0x1f, 0xf8, 0xc3, 0x93, 0x33, 0x9f, 0xff, 0xc3, 0x93, 0x33, 0xbf, 0xfb, 0x87,
-		0x27, 0x67, 0x46, 0x67, 0x89, 0xe1, 0xaa, 0x79, 0xd5, 0x64, 0xf9, 0xe1, 0xe7, 0xee, 0xdf, 0xd1,
-		0xed, 0xdd, 0xfe, 0xd6, 0x72, 0xd3, 0xec, 0x9c, 0x6b, 0x9a, 0x56, 0xc7, 0xb4, 0xce, 0xf9, 0xf3,
-		0xba, 0xf4, 0x2f, 0xf8, 0x1b, 0x89, 0xc4, 0xcc, 0xfe, 0x74, 0xae, 0x66, 0xec, 0x8f, 0x7b, 0xe8,
-		0xea, 0x22, 0x04, 0xf3, 0xc6, 0x3e, 0x3a, 0xce, 0x0c, 0x9c, 0xda, 0xef, 0xb5, 0xf9, 0x55, 0xcf,
-		0x28, 0xf9, 0xde, 0xec, 0xb5, 0xfd, 0xc7, 0xfc, 0x93, 0xfc, 0x98, 0x7f, 0xe1, 0xfb, 0xa4, 0xc3,
-		0xf5, 0x24, 0x96, 0x37, 0xf6, 0x69, 0x47, 0x36, 0xa4, 0xe7, 0x1e, 0x99, 0x98, 0xe7, 0xbe, 0x65,
-		0x98, 0x2f, 0x18, 0x84, 0xed, 0xee, 0x96, 0xc8, 0x71, 0x9f, 0x1c, 0xcc, 0x71, 0x3f, 0x83, 0xdb,
-		0xed, 0xa7, 0x09, 0x5c, 0x83, 0xa0, 0x6c, 0x45, 0xd8, 0xc3, 0x04, 0xf0, 0x03, 0x01, 0x38, 0x39,
-		0x94, 0xce, 0xe6, 0x4a, 0x30, 0x4e, 0x08, 0xab, 0x10, 0x2b, 0x09, 0xdd, 0xca, 0x40, 0xd4, 0xc2,
-		0x4d, 0xd3, 0x68, 0xb1, 0xf3, 0xda, 0x41, 0x45, 0x7c, 0x12, 0x41, 0x18, 0x9a, 0x61, 0x5a, 0xfc,
-		0xb2, 0x34, 0xfb, 0x28, 0x7c, 0xf0, 0x90, 0x82, 0x98, 0x15, 0x2d, 0x09, 0x69, 0x9c, 0x9f, 0x52,
-		0x1a, 0xa2, 0x13, 0xbe, 0xcc, 0xff, 0xb4, 0x52, 0xf9, 0xe1, 0x00, 0x2c, 0x0e, 0x4a, 0x85, 0xcc,
-		0x2c, 0xcb, 0xd6, 0x3a, 0xdd, 0x71, 0x62, 0xb9, 0x0c, 0xf1, 0x86, 0x80, 0x39, 0xb4, 0x5c, 0xee,
-		0x1c, 0x52, 0x2e, 0x29, 0xa7, 0x29, 0x21, 0x98, 0x95, 0x29, 0x05, 0xe3, 0xf4, 0xe3, 0xae, 0x24,
-		0xf3, 0xc1, 0x38, 0x1c, 0x67, 0xd3, 0x49, 0x65, 0x53, 0x89, 0x7d, 0x70, 0x99, 0x24, 0xbd, 0x55,
-		0x93, 0xf7, 0x49, 0x72, 0x4f, 0xc3, 0x7c, 0x85, 0x58, 0x0b, 0x12, 0x05, 0xb9, 0x3b, 0x3c, 0x23,
-		0xef, 0x93, 0x2f, 0xf9, 0x1c, 0x7e, 0xbe, 0xc1, 0xe8, 0x2d, 0xca, 0xbd, 0x4b, 0x02, 0xb9, 0xde,
-		0xd4, 0xda, 0x5a, 0xef, 0x1b, 0x25, 0x85, 0x9e, 0x00, 0x60, 0xa7, 0x01, 0x9d, 0xf7, 0xa8, 0x52,
-		0x2b, 0x99, 0x65, 0x6f, 0xe7, 0x96, 0x59, 0x4b, 0xf4, 0xae, 0x55, 0x9c, 0xc2, 0x92, 0x3f, 0xcf,
-		0x3c, 0x0b, 0xe0, 0x56, 0xa0, 0x13, 0x70, 0xac, 0x5e, 0xcc, 0xaf, 0xe5, 0x15, 0x71, 0x90, 0xa7,
-		0xbe, 0x51, 0x2e, 0xb2, 0xb7, 0x5d, 0x66, 0xd0, 0x51, 0x40, 0xde, 0x4a, 0xe7, 0x7e, 0xca, 0x11,
-		0x98, 0xf3, 0x96, 0xb3, 0x87, 0x36, 0x02, 0xab, 0xd7, 0x20, 0xcd, 0xae, 0xf0, 0xab, 0x5a, 0xab,
-		0x85, 0x5b, 0xaa, 0x6e, 0xa0, 0x09, 0x37, 0xe2, 0x33, 0xbf, 0xf9, 0xef, 0xc3, 0xb4, 0x6b, 0xb3,
-		0x0c, 0x31, 0x4f, 0xf0, 0x2a, 0x06, 0xf1, 0x39, 0xf5, 0x4e, 0xb7, 0x8d, 0xe9, 0xde, 0xb5, 0xaa,
-		0x0b, 0xf9, 0x4f, 0x76, 0x67, 0x08, 0xbd, 0xe0, 0xe9, 0xb8, 0x32, 0xef, 0xa2, 0x3b, 0xa3, 0xb7,
-		0xfa, 0xb4, 0x7b, 0x5e, 0xd0, 0x61, 0x70, 0x22, 0xc5, 0xcf, 0x71, 0x0e, 0x45, 0x36, 0x43, 0xb0,
-		0xb8, 0x06, 0x73, 0x5a, 0xb3, 0x89, 0xbb, 0x3e, 0xfe, 0x26, 0xac, 0x20, 0xa2, 0xb7, 0x32, 0xc7,
-		0x74, 0x59, 0x7b, 0x02, 0x22, 0x16, 0x1d, 0x94, 0x49, 0x24, 0x04, 0x3b, 0x1c, 0x7c, 0xb5, 0x2c,
-		0xce, 0xc7, 0x3a, 0x3d, 0x9a, 0x40, 0xe0, 0x5f, 0x73, 0x02, 0x49, 0x8a, 0x26, 0x7a, 0x63, 0xc0,
-		0x1c, 0x7b, 0x49, 0x09, 0x7b, 0x7a, 0x73, 0x70, 0x16, 0xe5, 0x9f, 0x7e, 0xe6, 0x51, 0xba, 0x71,
-		0x7f, 0xca, 0xaf, 0x74, 0x23, 0x26, 0x8b, 0x22, 0x73, 0xda, 0x6e, 0x7f, 0x31, 0xa4, 0x44, 0x7b,
-		0xbc, 0xdf, 0x07, 0x37, 0xf6, 0xcb, 0xbc, 0xb1, 0x93, 0xa3, 0x34, 0xdc, 0xd3, 0xd2, 0x2c, 0xa7,
-		0xca, 0x2a, 0x56, 0x0b, 0x30, 0x4b, 0xdf, 0x5d, 0x74, 0x84, 0x73, 0x70, 0x2b, 0xbf, 0xf2, 0x99,
-		0x47, 0xd9, 0x44, 0x23, 0x48, 0x5c, 0x34, 0x85, 0xf2, 0x38, 0xab, 0xf7, 0xdc, 0xc3, 0xc3, 0xeb,
-		0x37, 0xfb, 0xef, 0x2c, 0xa5, 0x7e, 0xd9, 0xcb, 0xaa, 0x6b, 0x9d, 0x42, 0x30, 0xa7, 0x75, 0x74,
-		0xc3, 0x3c, 0x47, 0xff, 0xe5, 0x56, 0x29, 0x4c, 0x3f, 0xa6, 0xd8, 0xb6, 0xbd, 0xc8, 0x8c, 0xc5,
-		0x64, 0xbd, 0xfd, 0xf3, 0xef, 0xfd, 0x78, 0xd8, 0x35, 0x28, 0xab, 0xeb, 0xc3, 0x67, 0x65, 0x27,
-		0xd3, 0xf8, 0x0b, 0x41, 0x63, 0xf0, 0xe8, 0xeb, 0xea, 0x9b, 0x21, 0xe6, 0x90, 0x99, 0xe4, 0xbb,
-		0x09, 0x22, 0x0e, 0x06, 0xf1, 0xdc, 0x98, 0xd2, 0x4e, 0xe3, 0xa7, 0xbf, 0x26, 0xf0, 0x99, 0x0d,
-		0xab, 0x92, 0xde, 0x5c, 0x85, 0x54, 0xcb, 0x34, 0x6c, 0xd5, 0xec, 0xe8, 0x36, 0xee, 0x74, 0xed,
-		0x89, 0x9e, 0xef, 0x5f, 0x31, 0x22, 0x31, 0x65, 0x96, 0xe0, 0xd5, 0x04, 0x1a, 0xe1, 0x84, 0x3d,
-		0xc6, 0x34, 0x0d, 0x27, 0xff, 0xd5, 0xe1, 0x84, 0xe2, 0x10, 0x4e, 0xee, 0x4a, 0x3b, 0xac, 0xd6,
-		0x2d, 0xbe, 0xdc, 0xd9, 0x7b, 0x4c, 0x0b, 0x1c, 0xed, 0xf8, 0xe7, 0x21, 0x38, 0xc9, 0x81, 0xb7,
-		0x34, 0x0b, 0x9f, 0xbb, 0x7d, 0x7e, 0x0b, 0xdb, 0xda, 0xf9, 0x73, 0x4d, 0x53, 0x17, 0xbe, 0xce,
-		0x3c, 0x5f, 0xce, 0x48, 0xfd, 0x32, 0xaf, 0xcf, 0x8e, 0x3c, 0x10, 0x90, 0x1d, 0xbf, 0x0c, 0x66,
-		0x87, 0x75, 0x30, 0xd7, 0x86, 0x50, 0xd1, 0xd4, 0xe9, 0x2d, 0xd0, 0x16, 0x36, 0xcc, 0x0e, 0x5f,
-		0x90, 0xd8, 0x07, 0xba, 0x06, 0x11, 0xad, 0x63, 0xf6, 0x0d, 0x9b, 0x2d, 0x46, 0x85, 0x47, 0x7f,
-		0xe3, 0x0b, 0x8b, 0x33, 0xbf, 0xf7, 0x85, 0xc5, 0x23, 0x8c, 0xac, 0xd5, 0xba, 0xb5, 0xac, 0x9b,
-		0xe7, 0x3a, 0x9a, 0xbd, 0x4b, 0x4c, 0xc0, 0xef, 0x7c, 0xf6, 0x2c, 0xf0, 0xf6, 0x2a, 0x86, 0xfd,
-		0xc9, 0x57, 0x3e, 0x75, 0x46, 0x52, 0x38, 0xfe, 0x6a, 0xe8, 0xd5, 0x8f, 0x2c, 0x4a, 0xb9, 0x2e,
-		0x44, 0x4b, 0xb8, 0x79, 0x40, 0x83, 0x95, 0x81, 0x06, 0xcf, 0xf3, 0x06, 0x4f, 0x0c, 0x37, 0xc8,
-		0x6e, 0x97, 0x95, 0x70, 0xd3, 0xd3, 0x6c, 0x09, 0x37, 0x07, 0x5a, 0xac, 0x40, 0xac, 0x62, 0xd8,
-		0xec, 0x39, 0x98, 0xb7, 0x40, 0x50, 0x37, 0xd8, 0x2b, 0x02, 0xf1, 0xc2, 0xc3, 0x87, 0xe8, 0x8a,
-		0x42, 0xf0, 0x72, 0x35, 0x88, 0x95, 0x70, 0x93, 0x91, 0x2a, 0x42, 0xb0, 0x85, 0x9b, 0x9c, 0xd4,
-		0x5d, 0x30, 0x49, 0xb0, 0x0b, 0xa5, 0xdf, 0xfd, 0x83, 0x93, 0x33, 0xef, 0xfc, 0xe2, 0xc9, 0x99,
-		0xb1, 0xea, 0x94, 0x9b, 0xac, 0x4e, 0x8e, 0x16, 0x7d, 0x3c, 0x04, 0xf7, 0xd2, 0x27, 0xc9, 0x7a,
-		0x1d, 0xdd, 0xb0, 0xcf, 0x35, 0x7b, 0xfb, 0x5d, 0xdb, 0x24, 0x46, 0xc5, 0xdc, 0xe6, 0x4a, 0x34,
-		0xe7, 0x56, 0x2f, 0xb3, 0xea, 0xd1, 0x2a, 0x94, 0xdb, 0x86, 0xf0, 0x06, 0xc1, 0x23, 0x83, 0x64,
-		0x9b, 0xb6, 0xd6, 0xe6, 0xbe, 0x22, 0xfb, 0x20, 0xa5, 0xec, 0x19, 0xb3, 0x00, 0x2b, 0xd5, 0xc5,
-		0x0b, 0x66, 0x6d, 0xac, 0x6d, 0xb3, 0xa7, 0x5c, 0x82, 0x34, 0xc8, 0x88, 0x91, 0x02, 0xfa, 0x6a,
-		0xcb, 0x02, 0x84, 0xb5, 0x3e, 0x3b, 0xe6, 0x16, 0x24, 0xd1, 0x07, 0xfd, 0xc8, 0x3d, 0x0d, 0x51,
-		0x7e, 0xea, 0x01, 0xc9, 0x10, 0xbc, 0x85, 0xf7, 0x69, 0x3b, 0x49, 0x85, 0xfc, 0x89, 0x96, 0x21,
-		0x4c, 0x99, 0xe7, 0x37, 0xe8, 0x32, 0xcb, 0x43, 0xdc, 0x2f, 0x53, 0x26, 0x15, 0x06, 0x96, 0xbb,
-		0x0e, 0xb1, 0x92, 0x49, 0x34, 0xdb, 0x4f, 0x2d, 0xce, 0xa8, 0x51, 0x9e, 0xbb, 0x7d, 0x5b, 0xdc,
-		0x72, 0xa6, 0x1f, 0xe8, 0x28, 0x44, 0xd8, 0xd3, 0x3e, 0xfc, 0xa8, 0x1e, 0xff, 0xca, 0x15, 0x21,
-		0x4a, 0x69, 0xd7, 0xba, 0xce, 0x93, 0x7b, 0x92, 0xe7, 0xc9, 0x3d, 0x4e, 0x3e, 0xe0, 0x32, 0x8b,
-		0x20, 0xd4, 0xd2, 0x6c, 0x8d, 0xf7, 0x9b, 0xfe, 0x9d, 0x7b, 0x2b, 0xc4, 0x38, 0x11, 0x0b, 0xad,
-		0x40, 0xd0, 0xec, 0x8a, 0x93, 0xa8, 0xd9, 0x71, 0x5d, 0xa9, 0x75, 0x0b, 0x21, 0xa2, 0x4b, 0x0a,
-		0x01, 0x2e, 0x54, 0xc7, 0xaa, 0xc5, 0xe3, 0x3e, 0xb5, 0xe8, 0x60, 0x7b, 0x6b, 0xdb, 0x76, 0xff,
-		0x60, 0xc3, 0x39, 0xa4, 0x0a, 0x8e, 0xa2, 0xdc, 0x09, 0xc0, 0x49, 0x4f, 0xed, 0x6d, 0xdc, 0xb3,
-		0x74, 0xd3, 0x60, 0xda, 0xc4, 0x35, 0x05, 0x79, 0x18, 0xe4, 0xf5, 0x63, 0x54, 0xe5, 0x2d, 0x10,
-		0xcc, 0x77, 0xbb, 0x28, 0x0b, 0x31, 0xfa, 0xdd, 0x34, 0x99, 0xae, 0x84, 0x14, 0xe7, 0x9b, 0xd4,
-		0x59, 0xe6, 0xb6, 0xfd, 0x82, 0xd6, 0x73, 0x1e, 0xcf, 0x13, 0xdf, 0xb9, 0x4b, 0x10, 0x2f, 0x9a,
-		0x86, 0x85, 0x0d, 0xab, 0x4f, 0x23, 0x90, 0xad, 0xb6, 0xd9, 0xbc, 0xc5, 0x29, 0xb0, 0x0f, 0x22,
-		0x6c, 0xad, 0xdb, 0xa5, 0x98, 0x21, 0x85, 0xfc, 0xc9, 0x66, 0x76, 0xa1, 0x36, 0x56, 0x3c, 0x17,
-		0x0e, 0x27, 0x1e, 0xde, 0x41, 0x47, 0x3e, 0xff, 0x5d, 0x82, 0x7b, 0x86, 0x27, 0xd2, 0x2d, 0xbc,
-		0x6f, 0x1d, 0x76, 0x1e, 0x3d, 0x0b, 0xf1, 0x0d, 0xfa, 0x64, 0xf2, 0xd3, 0x78, 0x1f, 0x65, 0x21,
-		0x8a, 0x5b, 0x2b, 0x17, 0x2e, 0x9c, 0xbf, 0xc4, 0xb4, 0xfc, 0xda, 0x8c, 0x22, 0x0a, 0xd0, 0x49,
-		0x88, 0x5b, 0xb8, 0xd9, 0x5d, 0xb9, 0x70, 0xf1, 0xd6, 0x79, 0xa6, 0x56, 0xd7, 0x66, 0x14, 0xb7,
-		0x68, 0x35, 0x46, 0x7a, 0xfc, 0xea, 0x47, 0x17, 0xa5, 0x42, 0x18, 0x82, 0x56, 0xbf, 0xf3, 0x4d,
-		0xd3, 0x8d, 0x3f, 0x8b, 0xc0, 0x92, 0xa7, 0x96, 0x2d, 0x58, 0xfc, 0xaa, 0x8f, 0xf3, 0xcc, 0xb6,
-		0xec, 0xe9, 0x3f, 0x85, 0x18, 0xb3, 0x12, 0x1d, 0x28, 0xc5, 0xdc, 0xa7, 0x25, 0x48, 0xde, 0x10,
-		0x94, 0xeb, 0xd8, 0x46, 0x97, 0x01, 0x9c, 0x96, 0xc4, 0x54, 0x39, 0xb1, 0x3c, 0xd8, 0xd6, 0xb2,
-		0x83, 0xa3, 0x78, 0xc0, 0xd1, 0x13, 0x54, 0x01, 0xbb, 0xa6, 0xc5, 0x5f, 0x41, 0x9b, 0x80, 0xea,
-		0x00, 0xa3, 0x47, 0x00, 0x51, 0xab, 0xa6, 0xde, 0x36, 0x6d, 0xdd, 0xd8, 0x51, 0xbb, 0xe6, 0x0b,
-		0xfc, 0x79, 0xca, 0xa0, 0x22, 0xd3, 0x9a, 0x1b, 0xb4, 0x62, 0x83, 0x94, 0x13, 0xa6, 0xe3, 0x0e,
-		0x15, 0x12, 0x4c, 0x6b, 0xad, 0x56, 0x0f, 0x5b, 0x16, 0x37, 0x5c, 0xe2, 0x13, 0x5d, 0x86, 0x68,
-		0xb7, 0xbf, 0xa5, 0x0a, 0x2b, 0x91, 0x58, 0xb9, 0x67, 0xd4, 0x9c, 0x17, 0xba, 0xc1, 0x67, 0x7d,
-		0xa4, 0xdb, 0xdf, 0x22, 0x9a, 0x72, 0x0a, 0x92, 0x23, 0x98, 0x49, 0xdc, 0x76, 0xf9, 0xa0, 0xaf,
-		0x74, 0xf3, 0x1e, 0xa8, 0xdd, 0x9e, 0x6e, 0xf6, 0x74, 0x7b, 0x9f, 0x9e, 0x56, 0x0d, 0x2a, 0xb2,
-		0xa8, 0xd8, 0xe0, 0xe5, 0xb9, 0x5b, 0x90, 0xae, 0xd3, 0xd0, 0xc8, 0xe5, 0xfc, 0x82, 0xcb, 0x9f,
-		0x34, 0x99, 0xbf, 0xb1, 0x9c, 0x05, 0x86, 0x38, 0x3b, 0xf3, 0x1f, 0x24, 0x48, 0x14, 0xc8, 0xc4,
-		0xad, 0x94, 0xae, 0xb4, 0xb5, 0x1d, 0x74, 0x1e, 0x8e, 0x14, 0xd6, 0x6a, 0xc5, 0xa7, 0xd5, 0x4a,
-		0x49, 0xbd, 0xb2, 0x96, 0xf7, 0x5c, 0xa6, 0xc8, 0x1e, 0x7d, 0xe9, 0xce, 0x12, 0xf2, 0xc0, 0x6e,
-		0x1a, 0x34, 0x13, 0x80, 0xce, 0xc1, 0x82, 0x1f, 0x25, 0x5f, 0xa8, 0x97, 0xab, 0x0d, 0x59, 0xca,
-		0x1e, 0x79, 0xe9, 0xce, 0xd2, 0x9c, 0x07, 0x23, 0xbf, 0x65, 0x61, 0xc3, 0x1e, 0x46, 0x28, 0xd6,
-		0xd6, 0xd7, 0x2b, 0x0d, 0x39, 0x30, 0x84, 0x50, 0x34, 0x3b, 0x1d, 0xdd, 0x46, 0x0f, 0xc1, 0x9c,
-		0x1f, 0xa1, 0x5a, 0x59, 0x93, 0x83, 0x59, 0xf4, 0xd2, 0x9d, 0xa5, 0x94, 0x07, 0xba, 0xaa, 0xb7,
-		0xb3, 0xb1, 0xf7, 0xfe, 0xf8, 0xc9, 0x99, 0x4f, 0xfe, 0xff, 0x27, 0xa5, 0xc2, 0xfa, 0xd8, 0x39,
-		0xf7, 0xd8, 0xe1, 0xe6, 0x9c, 0x7f, 0xdd, 0xfe, 0xda, 0x3d, 0x3e, 0x73, 0xc3, 0x7d, 0x44, 0x8f,
-		0x31, 0x9e, 0x76, 0xba, 0x4d, 0xca, 0x0c, 0x65, 0x0f, 0x76, 0x0f, 0xb2, 0x13, 0x16, 0x85, 0xec,
-		0x44, 0xc3, 0x90, 0xbb, 0x04, 0xb3, 0x1b, 0x5a, 0xcf, 0xae, 0x63, 0xfb, 0x1a, 0xd6, 0x5a, 0xb8,
-		0xe7, 0xf7, 0x1f, 0x66, 0x85, 0xff, 0x80, 0x20, 0x44, 0x9d, 0x04, 0xb6, 0x7e, 0xd2, 0xbf, 0x73,
-		0xbb, 0x10, 0xa2, 0xb7, 0x45, 0x1d, 0xdf, 0x82, 0x63, 0x30, 0xdf, 0x82, 0xac, 0x0c, 0xfb, 0x36,
-		0xbf, 0x4d, 0x9f, 0x54, 0xd8, 0x07, 0x7a, 0x5c, 0x78, 0x08, 0xc1, 0x83, 0x3d, 0x04, 0x3e, 0xbd,
-		0xb8, 0x9f, 0xd0, 0x86, 0x28, 0x1f, 0x62, 0x87, 0x11, 0xc9, 0x65, 0x04, 0xad, 0x43, 0xba, 0xab,
-		0xf5, 0x6c, 0xfa, 0xf6, 0xd4, 0x2e, 0xed, 0x05, 0x9f, 0xc1, 0x8b, 0xc3, 0xf6, 0xc4, 0xd7, 0x59,
-		0xde, 0xca, 0x6c, 0xd7, 0x5b, 0x98, 0xfb, 0xe3, 0x10, 0x44, 0xb8, 0x30, 0xde, 0x02, 0x51, 0x2e,
-		0x56, 0x3e, 0xe7, 0xee, 0x5d, 0x1e, 0x5e, 0x66, 0x97, 0x9d, 0xe5, 0x90, 0xd3, 0x13, 0x38, 0xe8,
-		0x01, 0x88, 0x35, 0x77, 0x35, 0xdd, 0x50, 0xf5, 0x16, 0x77, 0x8e, 0x13, 0x5f, 0xfc, 0xc2, 0x62,
-		0xb4, 0x48, 0xca, 0x2a, 0x25, 0x25, 0x4a, 0x2b, 0x2b, 0x2d, 0xe2, 0xd3, 0xec, 0x62, 0x7d, 0x67,
-		0xd7, 0xe6, 0x76, 0x83, 0x7f, 0xa1, 0x27, 0x21, 0x44, 0x14, 0x82, 0x5f, 0xe8, 0xc8, 0x0e, 0x45,
-		0x3d, 0x4e, 0xe2, 0xae, 0x10, 0x23, 0x0d, 0x7f, 0xe0, 0x3f, 0x2d, 0x4a, 0x0a, 0xc5, 0x40, 0x45,
-		0x98, 0x6d, 0x6b, 0x96, 0xad, 0xd2, 0xf5, 0x98, 0x34, 0xcf, 0x6e, 0x72, 0x1c, 0x1f, 0x16, 0x08,
-		0x17, 0x2c, 0x67, 0x3d, 0x41, 0xb0, 0x58, 0x51, 0x0b, 0x9d, 0x06, 0x99, 0x12, 0x69, 0xd2, 0x19,
-		0xc8, 0xbc, 0xc4, 0x08, 0x95, 0x7b, 0x8a, 0x94, 0xb3, 0x89, 0x49, 0x7d, 0xc5, 0x13, 0x10, 0xa7,
-		0x0f, 0xac, 0x51, 0x10, 0x76, 0x45, 0x39, 0x46, 0x0a, 0x68, 0xe5, 0x83, 0x90, 0x76, 0xad, 0x3e,
-		0x03, 0x89, 0x31, 0x2a, 0x6e, 0x31, 0x05, 0x7c, 0x14, 0x16, 0x0c, 0xbc, 0x67, 0xab, 0x83, 0xd0,
-		0x71, 0x0a, 0x8d, 0x48, 0xdd, 0x0d, 0x3f, 0xc6, 0xfd, 0x90, 0x6a, 0x0a, 0xe1, 0x33, 0x58, 0xa0,
-		0xb0, 0xb3, 0x4e, 0x29, 0x05, 0x3b, 0x0e, 0x31, 0xad, 0xdb, 0x65, 0x00, 0x09, 0x6e, 0xf5, 0xbb,
-		0x5d, 0x5a, 0x75, 0x06, 0xe6, 0x68, 0x1f, 0x7b, 0xd8, 0xea, 0xb7, 0x6d, 0x4e, 0x24, 0x49, 0x61,
-		0xd2, 0xa4, 0x42, 0x61, 0xe5, 0x14, 0xf6, 0x3e, 0x98, 0xc5, 0xb7, 0xf5, 0x16, 0x36, 0x9a, 0x98,
-		0xc1, 0xcd, 0x52, 0xb8, 0xa4, 0x28, 0xa4, 0x40, 0x0f, 0x81, 0x63, 0xcd, 0x55, 0xb1, 0xd2, 0xa4,
-		0x18, 0x3d, 0x51, 0x9e, 0x67, 0xc5, 0xb9, 0x0c, 0x84, 0x4a, 0x9a, 0xad, 0x11, 0x77, 0xc9, 0xde,
-		0x63, 0xcb, 0x67, 0x52, 0x21, 0x7f, 0xe6, 0x7e, 0x21, 0x08, 0xa1, 0x1b, 0xa6, 0x8d, 0xd1, 0x63,
-		0x1e, 0x57, 0x36, 0x35, 0x4a, 0x9f, 0xeb, 0xfa, 0x8e, 0x81, 0x5b, 0xeb, 0xd6, 0x8e, 0xe7, 0x5d,
-		0x64, 0x57, 0x9d, 0x02, 0x3e, 0x75, 0x5a, 0x80, 0x70, 0xcf, 0xec, 0x1b, 0x2d, 0x71, 0x6f, 0x82,
-		0x7e, 0xa0, 0x32, 0xc4, 0x1c, 0x2d, 0x09, 0x4d, 0xd2, 0x92, 0x34, 0xd1, 0x12, 0xa2, 0xc3, 0xbc,
-		0x40, 0x89, 0x6e, 0x71, 0x65, 0x29, 0x40, 0xdc, 0x31, 0x5e, 0x5c, 0xdb, 0xa6, 0x53, 0x58, 0x17,
-		0x8d, 0x2c, 0x91, 0xce, 0xd8, 0x3b, 0xc2, 0x63, 0x1a, 0x27, 0x3b, 0x15, 0x5c, 0x7a, 0x3e, 0xb5,
-		0xe2, 0x6f, 0x34, 0x47, 0x69, 0xbf, 0x5c, 0xb5, 0x62, 0xef, 0x34, 0xdf, 0x03, 0x71, 0x4b, 0xdf,
-		0x31, 0xe8, 0x55, 0x24, 0xae, 0x79, 0x6e, 0x01, 0xa9, 0x75, 0x2f, 0xba, 0x33, 0x4d, 0xf3, 0xfc,
-		0x9c, 0xc0, 0x39, 0x98, 0x77, 0x1f, 0xf2, 0x77, 0xa9, 0x30, 0x2d, 0x43, 0x4e, 0x55, 0x5d, 0xd4,
-		0xe4, 0x7e, 0x55, 0x82, 0x08, 0x5f, 0xb1, 0xdc, 0x61, 0x90, 0x46, 0x0f, 0x43, 0x60, 0xdc, 0x30,
-		0x04, 0xef, 0x7e, 0x18, 0xf2, 0x00, 0x0e, 0x9b, 0x16, 0x7f, 0x89, 0x77, 0x84, 0x5b, 0xc5, 0x58,
-		0xac, 0xeb, 0x3b, 0x7c, 0xde, 0x7b, 0x90, 0x72, 0xbf, 0x2f, 0x11, 0x0f, 0x9f, 0xd7, 0xa3, 0x3c,
-		0xcc, 0x0a, 0xbe, 0xd4, 0xed, 0xb6, 0xb6, 0xc3, 0x55, 0xf1, 0xde, 0xb1, 0xcc, 0x91, 0x55, 0x58,
-		0x49, 0x70, 0x7e, 0xa8, 0x3f, 0x31, 0x72, 0x58, 0x03, 0x63, 0x86, 0xd5, 0xa7, 0x47, 0xc1, 0xbb,
-		0xd3, 0x23, 0xdf, 0x88, 0x87, 0x06, 0x46, 0x3c, 0xf7, 0x07, 0x12, 0xff, 0x0d, 0x80, 0x16, 0xbb,
-		0x77, 0xf5, 0xbf, 0x6a, 0xa8, 0x9e, 0xe3, 0xba, 0xd5, 0xc2, 0x2d, 0x75, 0x68, 0xcc, 0xee, 0x1b,
-		0xa6, 0xe8, 0xe7, 0xd9, 0x1d, 0x3b, 0x24, 0xa8, 0xd4, 0xdd, 0x31, 0xfc, 0x6c, 0x00, 0xe6, 0x86,
-		0xe0, 0xff, 0xee, 0x8d, 0xa5, 0x7f, 0xf6, 0x86, 0xa7, 0x9c, 0xbd, 0x91, 0xb1, 0xb3, 0xf7, 0x33,
-		0x01, 0x9a, 0x00, 0xe8, 0x9a, 0x96, 0xd6, 0xfe, 0xdb, 0xb0, 0xbd, 0x27, 0x20, 0xde, 0x35, 0xdb,
-		0x2a, 0xab, 0x61, 0x37, 0xd7, 0x62, 0x5d, 0xb3, 0xad, 0x0c, 0xa9, 0x59, 0xf8, 0x75, 0x32, 0xcc,
-		0x91, 0xd7, 0x61, 0x10, 0xa2, 0x83, 0x13, 0xaa, 0x07, 0x49, 0x26, 0x0a, 0xee, 0x35, 0x3d, 0x4a,
-		0x64, 0x40, 0xdd, 0x30, 0x69, 0xd8, 0xcb, 0x63, 0x6c, 0x33, 0x48, 0x85, 0xc3, 0x11, 0x0c, 0xe6,
-		0x64, 0x8c, 0xca, 0x1c, 0x79, 0x2d, 0x96, 0xc2, 0xe1, 0x72, 0x3f, 0x24, 0x01, 0xac, 0x11, 0xc9,
-		0xd2, 0xfe, 0x12, 0x7f, 0xc7, 0xa2, 0x2c, 0xa8, 0xbe, 0x96, 0x4f, 0x8e, 0x1b, 0x34, 0xde, 0x7e,
-		0xd2, 0xf2, 0xf2, 0x5d, 0x84, 0x59, 0x57, 0xb7, 0x2d, 0x2c, 0x98, 0x39, 0x79, 0x40, 0x54, 0x5a,
-		0xc7, 0xb6, 0x92, 0xbc, 0xed, 0xf9, 0xca, 0xfd, 0x33, 0x09, 0xe2, 0x94, 0xa7, 0x75, 0x6c, 0x6b,
-		0xbe, 0x31, 0x94, 0xee, 0x7e, 0x0c, 0xef, 0x05, 0x60, 0x64, 0x2c, 0xfd, 0x45, 0xcc, 0x35, 0x2b,
-		0x4e, 0x4b, 0xea, 0xfa, 0x8b, 0x18, 0x5d, 0x74, 0x04, 0x1e, 0x3c, 0x58, 0xe0, 0x22, 0x6a, 0xe5,
-		0x62, 0x3f, 0x06, 0x51, 0xfa, 0x40, 0xca, 0x9e, 0xc5, 0x03, 0xd1, 0x88, 0xd1, 0xef, 0x34, 0xf6,
-		0xac, 0xdc, 0xf3, 0x10, 0x6d, 0xec, 0xb1, 0x7c, 0xe2, 0x09, 0x88, 0xf7, 0x4c, 0x93, 0x7b, 0x7f,
-		0xcc, 0xeb, 0x8e, 0x91, 0x02, 0xea, 0xec, 0x88, 0x1c, 0x5a, 0xc0, 0xcd, 0xa1, 0xb9, 0x49, 0xc0,
-		0xe0, 0x54, 0x49, 0x40, 0x12, 0x7d, 0xce, 0xfa, 0x66, 0x12, 0x7a, 0x04, 0x8e, 0xd5, 0x2b, 0x57,
-		0xab, 0xe5, 0x92, 0xba, 0x5e, 0xbf, 0x3a, 0xf0, 0x70, 0x41, 0x36, 0xfd, 0xd2, 0x9d, 0xa5, 0x04,
-		0x0f, 0x3b, 0xc7, 0x41, 0x6f, 0x28, 0xe5, 0x1b, 0xb5, 0x46, 0x59, 0x96, 0x18, 0xf4, 0x46, 0x0f,
-		0xdf, 0x36, 0x6d, 0xf6, 0x1b, 0x53, 0x8f, 0xc2, 0xf1, 0x11, 0xd0, 0x4e, 0xf0, 0x39, 0xf7, 0xd2,
-		0x9d, 0xa5, 0xd9, 0x8d, 0x1e, 0x66, 0x5a, 0x46, 0x31, 0x96, 0x21, 0x33, 0x8c, 0x51, 0xdb, 0xa8,
-		0xd5, 0xf3, 0x6b, 0xf2, 0x52, 0x56, 0x7e, 0xe9, 0xce, 0x52, 0x52, 0x98, 0x0c, 0x02, 0xff, 0xcd,
-		0x8f, 0x3e, 0xbf, 0x27, 0xe6, 0xcb, 0x1a, 0xb3, 0xb8, 0xae, 0xab, 0xf5, 0xb4, 0xce, 0x61, 0xc3,
-		0xcf, 0x09, 0xc7, 0x35, 0x72, 0x9f, 0x0e, 0x40, 0xda, 0x09, 0x6e, 0x36, 0x68, 0x0b, 0xe8, 0x31,
-		0x6f, 0xc6, 0x2f, 0x31, 0x76, 0xed, 0x60, 0xd0, 0x22, 0x21, 0xf8, 0x66, 0x88, 0x09, 0x27, 0x99,
-		0x4f, 0xaa, 0xa5, 0x11, 0xeb, 0x1b, 0x87, 0xe0, 0xa8, 0x0e, 0x06, 0x7a, 0x0a, 0xe2, 0xce, 0x14,
-		0x73, 0x9e, 0xc5, 0x1f, 0x3f, 0x27, 0x39, 0xbe, 0x8b, 0x83, 0x2e, 0xb9, 0x61, 0x5c, 0x68, 0x5c,
-		0x60, 0x78, 0x83, 0x01, 0x70, 0x64, 0x27, 0x84, 0x7b, 0x14, 0x42, 0xda, 0x56, 0x53, 0xe7, 0x06,
-		0xf8, 0x9e, 0x61, 0xbc, 0x7c, 0xa1, 0x58, 0xe1, 0x48, 0x14, 0x32, 0x57, 0xe1, 0xc9, 0x14, 0x2e,
-		0x2f, 0xfa, 0xd0, 0xf0, 0x9e, 0xca, 0x62, 0x61, 0xe6, 0x5d, 0xc4, 0x3a, 0xda, 0x5e, 0x81, 0x86,
-		0xc3, 0xc7, 0x20, 0x4a, 0x2a, 0x77, 0xf8, 0x8b, 0x7f, 0x41, 0x25, 0xd2, 0xd1, 0xf6, 0xae, 0x6a,
-		0xd6, 0xf5, 0x50, 0x2c, 0x28, 0x87, 0x72, 0x3f, 0x49, 0x3c, 0x15, 0x9f, 0x54, 0xd0, 0xc3, 0x80,
-		0x08, 0x86, 0xb6, 0x83, 0x55, 0x32, 0x75, 0xa9, 0x78, 0x05, 0xdd, 0x74, 0x47, 0xdb, 0xcb, 0xef,
-		0xe0, 0x6a, 0xbf, 0x43, 0x19, 0xb0, 0xd0, 0x3a, 0xc8, 0x02, 0x58, 0x8c, 0x2c, 0x17, 0xff, 0xf1,
-		0xe1, 0x5f, 0x6b, 0xe2, 0x00, 0x6c, 0x01, 0x78, 0x99, 0x2c, 0x00, 0x29, 0x46, 0xcf, 0x39, 0xa0,
-		0xe3, 0xeb, 0x4a, 0xd0, 0xdf, 0x95, 0xdc, 0x53, 0x90, 0x1e, 0x18, 0x01, 0x94, 0x83, 0x59, 0x9e,
-		0xb1, 0xa2, 0x87, 0x1f, 0x58, 0x84, 0x13, 0x57, 0x12, 0x2c, 0x33, 0x45, 0x0f, 0x83, 0xac, 0xc6,
-		0x7e, 0xf1, 0x23, 0x8b, 0x12, 0xdd, 0xf6, 0x79, 0x18, 0x66, 0x7d, 0x63, 0x20, 0xb2, 0xc8, 0x92,
-		0x9b, 0x45, 0x76, 0x81, 0x9f, 0x83, 0x24, 0x31, 0x40, 0xb8, 0xc5, 0x61, 0x1f, 0x80, 0x34, 0x33,
-		0x90, 0x83, 0xb2, 0x66, 0x0e, 0xcf, 0xba, 0x10, 0x78, 0x4e, 0x78, 0x40, 0x7e, 0xb1, 0x27, 0x04,
-		0xd4, 0x55, 0xcd, 0xca, 0xd5, 0x00, 0xdc, 0x41, 0x45, 0x79, 0xb8, 0x97, 0x18, 0x0f, 0xef, 0x61,
-		0x5d, 0xfe, 0x92, 0xba, 0xcf, 0x63, 0xcc, 0x12, 0x20, 0xf7, 0x40, 0x2e, 0x7b, 0x4f, 0xfd, 0x1a,
-		0x85, 0x28, 0xbc, 0xed, 0x93, 0x5f, 0x3c, 0x29, 0x7d, 0x73, 0x6c, 0xc1, 0x7f, 0xbc, 0x09, 0x27,
-		0x3c, 0x95, 0x44, 0x01, 0x7d, 0x89, 0xa8, 0xb4, 0x47, 0x5f, 0x49, 0xe5, 0xa4, 0x84, 0xd2, 0x81,
-		0xf9, 0xdf, 0xec, 0xc1, 0x66, 0x67, 0x72, 0xb6, 0x69, 0x72, 0xbe, 0x6b, 0x74, 0x52, 0xfe, 0x57,
-		0xe2, 0x10, 0x55, 0xf0, 0x3b, 0xfa, 0xd8, 0xb2, 0xd1, 0x0a, 0x84, 0x70, 0x73, 0xd7, 0x1c, 0x95,
-		0x03, 0x25, 0x1d, 0x5c, 0xe6, 0x70, 0xe5, 0xe6, 0xae, 0x79, 0x6d, 0x46, 0xa1, 0xb0, 0xe8, 0x02,
-		0x84, 0xb7, 0xdb, 0x7d, 0x9e, 0xbe, 0x1a, 0xb0, 0x59, 0x5e, 0xa4, 0x2b, 0x04, 0xe8, 0xda, 0x8c,
-		0xc2, 0xa0, 0x49, 0x53, 0xf4, 0x07, 0xf8, 0x82, 0x07, 0x37, 0x55, 0x31, 0xb6, 0x69, 0x53, 0x04,
-		0x16, 0x15, 0x00, 0x74, 0x43, 0xb7, 0x55, 0x9a, 0xda, 0xe1, 0x56, 0xe3, 0xd4, 0x78, 0x4c, 0xdd,
-		0xa6, 0xc9, 0xa0, 0x6b, 0x33, 0x4a, 0x5c, 0x17, 0x1f, 0x84, 0xdd, 0x77, 0xf4, 0x71, 0x6f, 0x9f,
-		0x7b, 0x6b, 0x63, 0xd9, 0x7d, 0x1b, 0x01, 0x22, 0xec, 0x52, 0x68, 0x62, 0x64, 0xd9, 0x4f, 0x2b,
-		0xd8, 0x7b, 0xfc, 0x37, 0x87, 0x16, 0xc7, 0x61, 0xd2, 0xdf, 0x57, 0x68, 0xec, 0x5d, 0x9b, 0x51,
-		0xa2, 0x4d, 0xf6, 0x27, 0x7a, 0xd2, 0x71, 0xc1, 0x12, 0xc3, 0x5e, 0x8f, 0x0f, 0x97, 0xa5, 0x7d,
-		0x66, 0x84, 0x2b, 0x86, 0xaa, 0x90, 0x6a, 0xeb, 0x96, 0xad, 0x5a, 0x86, 0xd6, 0xb5, 0x76, 0x4d,
-		0x5b, 0xbc, 0x6d, 0x7e, 0xff, 0x38, 0x0a, 0x6b, 0xba, 0x65, 0xd7, 0x05, 0xf0, 0xb5, 0x19, 0x65,
-		0xb6, 0xed, 0x2d, 0x20, 0xf4, 0xcc, 0xed, 0x6d, 0xdc, 0x73, 0x08, 0xd2, 0x3c, 0xcb, 0x01, 0xf4,
-		0x6a, 0x04, 0x5a, 0xe0, 0x13, 0x7a, 0xa6, 0xb7, 0x00, 0x7d, 0x0b, 0xcc, 0xb7, 0x4d, 0xad, 0xe5,
-		0x90, 0x53, 0x9b, 0xbb, 0x7d, 0xe3, 0x16, 0x4d, 0xca, 0x24, 0x56, 0x1e, 0x1a, 0xcb, 0xa4, 0xa9,
-		0xb5, 0x04, 0x89, 0x22, 0x41, 0xb8, 0x36, 0xa3, 0xcc, 0xb5, 0x07, 0x0b, 0xd1, 0xdb, 0x61, 0x41,
-		0xeb, 0x76, 0xdb, 0xfb, 0x83, 0xd4, 0xd3, 0x94, 0xfa, 0x99, 0x71, 0xd4, 0xf3, 0x04, 0x67, 0x90,
-		0x3c, 0xd2, 0x86, 0x4a, 0x51, 0x03, 0xe4, 0x6e, 0x0f, 0xd3, 0xfb, 0x6e, 0x5d, 0xee, 0x65, 0xd0,
-		0x87, 0xc3, 0x13, 0x2b, 0x0f, 0x8e, 0xa3, 0xbd, 0xc1, 0xe0, 0x85, 0x53, 0x72, 0x6d, 0x46, 0x49,
-		0x77, 0xfd, 0x45, 0x8c, 0xaa, 0xd9, 0xc4, 0xf4, 0x57, 0x16, 0x38, 0xd5, 0xb9, 0x49, 0x54, 0x29,
-		0xbc, 0x9f, 0xaa, 0xaf, 0x08, 0x95, 0x21, 0xc1, 0x42, 0x51, 0x95, 0x18, 0x43, 0xfa, 0xeb, 0x15,
-		0x89, 0x95, 0xdc, 0xd8, 0x19, 0x4a, 0x41, 0x6f, 0x98, 0x36, 0xbe, 0x36, 0xa3, 0x00, 0x76, 0xbe,
-		0x90, 0x06, 0x47, 0xe8, 0xcb, 0xeb, 0xfb, 0xaa, 0xdf, 0xf0, 0x66, 0xe6, 0x29, 0xc1, 0x87, 0xc7,
-		0x11, 0xa4, 0x3f, 0x8a, 0xb6, 0x7f, 0xc3, 0x6b, 0x87, 0xaf, 0xcd, 0x28, 0xf3, 0xb7, 0x87, 0x8b,
-		0x89, 0x8a, 0x6d, 0xeb, 0x86, 0xd6, 0xd6, 0x5f, 0xc4, 0x6c, 0x09, 0xa5, 0x3f, 0x64, 0x72, 0x80,
-		0x8a, 0x5d, 0xe1, 0xd0, 0x74, 0x61, 0x25, 0x2a, 0xb6, 0xed, 0x2d, 0x28, 0x44, 0xf9, 0x49, 0x5d,
-		0xe7, 0x45, 0xe2, 0xa8, 0x1c, 0x63, 0xef, 0xe6, 0x5f, 0x0f, 0xc5, 0x40, 0x4e, 0xe4, 0x1e, 0x84,
-		0x84, 0xc7, 0x30, 0xa1, 0x0c, 0x44, 0xf9, 0x49, 0x1d, 0x71, 0xe6, 0x97, 0x7f, 0xe6, 0x52, 0x90,
-		0xf4, 0x1a, 0xa3, 0xdc, 0x07, 0x24, 0x07, 0x93, 0x3e, 0xc9, 0x92, 0xf1, 0x67, 0xa4, 0xe3, 0xae,
-		0xa7, 0x72, 0x9f, 0x58, 0xda, 0x44, 0x3d, 0xdb, 0x7e, 0x4d, 0xd2, 0x42, 0xbe, 0xb2, 0xa2, 0x45,
-		0x48, 0x74, 0x57, 0xba, 0x0e, 0x48, 0x90, 0x82, 0x40, 0x77, 0xa5, 0x2b, 0x00, 0x4e, 0x41, 0x92,
-		0xf4, 0x5b, 0xf5, 0xfa, 0x4b, 0x71, 0x25, 0x41, 0xca, 0x38, 0x48, 0xee, 0xb7, 0x02, 0x20, 0x0f,
-		0x1a, 0x30, 0x27, 0x55, 0x2d, 0x1d, 0x3a, 0x55, 0x7d, 0x7c, 0x30, 0x49, 0xee, 0xe6, 0xc5, 0xd7,
-		0x40, 0x76, 0xd3, 0xbb, 0x6c, 0xa9, 0x19, 0xef, 0xff, 0x0d, 0x38, 0xaa, 0x4a, 0xba, 0x39, 0xe0,
-		0xb9, 0x5e, 0xf1, 0x6d, 0x56, 0x8a, 0x5f, 0xa8, 0x1d, 0x1c, 0x70, 0xc7, 0x89, 0xd9, 0xec, 0xb6,
-		0x34, 0x1b, 0x8b, 0xfc, 0x98, 0x67, 0xdf, 0xf2, 0x01, 0x48, 0x6b, 0xdd, 0xae, 0x6a, 0xd9, 0x9a,
-		0x8d, 0xb9, 0xaf, 0xc1, 0x32, 0x0f, 0xb3, 0x5a, 0xb7, 0x4b, 0x7f, 0x8d, 0x8f, 0xf9, 0x1a, 0xf7,
-		0x43, 0x8a, 0xd8, 0x74, 0x5d, 0x6b, 0x0b, 0x57, 0x21, 0xc2, 0x5c, 0x12, 0x5e, 0xca, 0xbc, 0x83,
-		0x5c, 0xcb, 0x19, 0x71, 0x6a, 0xcf, 0x9d, 0x98, 0x4a, 0xf2, 0xc4, 0x54, 0x88, 0x3f, 0x95, 0xc3,
-		0xe4, 0x23, 0x5e, 0x35, 0x1a, 0xbd, 0x69, 0xb0, 0x40, 0xe3, 0xaf, 0xdb, 0x2c, 0x59, 0x12, 0x53,
-		0xd8, 0x47, 0x4e, 0x81, 0x94, 0xdf, 0xf6, 0xa3, 0x14, 0x04, 0xec, 0x3d, 0xde, 0x4a, 0xc0, 0xde,
-		0x23, 0x9e, 0xae, 0xe7, 0xa5, 0xcc, 0xe1, 0xd5, 0x8e, 0xe3, 0xb9, 0xb9, 0x8e, 0x5c, 0x1a, 0x66,
-		0x7d, 0x6b, 0x42, 0xee, 0x28, 0x2c, 0x8c, 0x32, 0xf1, 0xb9, 0x5d, 0xa7, 0xdc, 0x67, 0xaa, 0xd1,
-		0x05, 0x88, 0x39, 0x36, 0x7e, 0x44, 0x74, 0x4c, 0x9b, 0x15, 0xc0, 0x8a, 0x03, 0xea, 0x4b, 0xe7,
-		0x07, 0x7c, 0xe9, 0xfc, 0xdc, 0xb7, 0x43, 0x66, 0x9c, 0xfd, 0x1e, 0x48, 0xf2, 0x85, 0x1c, 0x81,
-		0x1d, 0x85, 0x08, 0x7f, 0x18, 0x32, 0x40, 0x37, 0xb0, 0xf8, 0x17, 0x11, 0x24, 0xb3, 0xe5, 0x41,
-		0xb6, 0xaf, 0x45, 0x3f, 0x72, 0x2a, 0x1c, 0x1f, 0x6b, 0xc3, 0xc7, 0x6f, 0x85, 0x31, 0x42, 0x7c,
-		0x2b, 0xac, 0x29, 0xd8, 0xb1, 0x68, 0x5f, 0xc5, 0x41, 0x16, 0xf6, 0x95, 0x7b, 0x39, 0x08, 0x47,
-		0x47, 0x5b, 0x72, 0xb4, 0x04, 0x49, 0xe2, 0xb7, 0xda, 0x7e, 0x17, 0x17, 0x3a, 0xda, 0x5e, 0x83,
-		0xfb, 0xb7, 0x7c, 0x2b, 0x21, 0xe0, 0x6c, 0x25, 0xa0, 0x4d, 0x98, 0x6b, 0x9b, 0x4d, 0xad, 0xad,
-		0x7a, 0xb6, 0x72, 0xf8, 0x24, 0xba, 0x6f, 0x48, 0xd8, 0xfe, 0x94, 0x21, 0x31, 0x38, 0x5c, 0xff,
-		0xd3, 0x94, 0xc6, 0x9a, 0xb3, 0xeb, 0x83, 0x4a, 0x90, 0xe8, 0xe8, 0xd6, 0x16, 0xde, 0xd5, 0x6e,
-		0xeb, 0x66, 0x8f, 0xcf, 0xa6, 0x61, 0xa5, 0x59, 0x77, 0x61, 0xc4, 0x0e, 0x93, 0x07, 0xcd, 0x33,
-		0x24, 0xe1, 0x91, 0x1b, 0x5f, 0x91, 0x43, 0x5b, 0x93, 0x71, 0x7b, 0x48, 0xd1, 0xb1, 0x7b, 0x48,
-		0xa3, 0x36, 0x6c, 0x62, 0xa3, 0x37, 0x6c, 0xde, 0xeb, 0x1d, 0x1a, 0xff, 0xda, 0x37, 0xb4, 0x87,
-		0x83, 0xea, 0xb0, 0xc0, 0xf1, 0x5b, 0x3e, 0xd9, 0x8f, 0x38, 0xea, 0xc0, 0xe6, 0xd7, 0xa0, 0xcc,
-		0x91, 0x40, 0x1f, 0x2f, 0xf6, 0xe0, 0xdd, 0x89, 0x5d, 0x6c, 0xa2, 0x86, 0x3c, 0x9b, 0xa8, 0xff,
-		0x9b, 0x0d, 0xc5, 0xbb, 0x83, 0x30, 0x37, 0xe4, 0x48, 0x8c, 0xdc, 0x1d, 0x1e, 0x97, 0x91, 0x15,
-		0x1d, 0x0b, 0x1e, 0xba, 0x63, 0x7c, 0xac, 0x43, 0x93, 0xc7, 0x3a, 0xfc, 0x3a, 0x8e, 0x75, 0xe4,
-		0xee, 0xc6, 0xfa, 0x9b, 0x3a, 0x0a, 0x1f, 0x92, 0x20, 0x3b, 0xde, 0xfb, 0x1a, 0x39, 0x1c, 0x87,
-		0xda, 0x40, 0x18, 0xb7, 0xc6, 0xdd, 0x0f, 0xa9, 0x01, 0xdf, 0x90, 0xa9, 0xf2, 0xac, 0x2f, 0x0a,
-		0xcf, 0xbd, 0x27, 0xe8, 0x2c, 0x3c, 0x3e, 0x07, 0x6e, 0xc4, 0x6c, 0x7d, 0x1b, 0xcc, 0xb7, 0x70,
-		0x53, 0x6f, 0xdd, 0xed, 0x64, 0x9d, 0xe3, 0xd8, 0xff, 0x67, 0xae, 0x0e, 0x6b, 0xc9, 0x0f, 0x02,
-		0xc4, 0x14, 0x6c, 0x75, 0x89, 0x3f, 0x86, 0x0a, 0x10, 0xc7, 0x7b, 0x4d, 0xdc, 0xb5, 0xdd, 0x43,
-		0x15, 0xa3, 0x42, 0x04, 0x06, 0x5d, 0x16, 0x90, 0x24, 0x40, 0x76, 0xd0, 0xd0, 0x63, 0x3c, 0x07,
-		0x30, 0x3e, 0x9c, 0xe7, 0xe8, 0xde, 0x24, 0xc0, 0x45, 0x91, 0x04, 0x08, 0x8e, 0x8d, 0x6f, 0x19,
-		0xd6, 0x40, 0x16, 0xe0, 0x31, 0x9e, 0x05, 0x08, 0x4d, 0x68, 0xcc, 0x97, 0x06, 0x28, 0xfa, 0xd2,
-		0x00, 0x91, 0x09, 0xdd, 0x1c, 0x93, 0x07, 0xb8, 0x28, 0xf2, 0x00, 0xd1, 0x09, 0x1c, 0x0f, 0x24,
-		0x02, 0xde, 0xe2, 0x49, 0x04, 0xc4, 0x87, 0xb3, 0xad, 0x3e, 0xd4, 0x11, 0x99, 0x80, 0x4b, 0x4e,
-		0x26, 0x20, 0x39, 0x36, 0x8b, 0xc0, 0x91, 0x07, 0x53, 0x01, 0xb5, 0xa1, 0x54, 0xc0, 0x2c, 0xff,
-		0xa9, 0xf4, 0x71, 0x24, 0x26, 0xe4, 0x02, 0x6a, 0x43, 0xb9, 0x80, 0xd4, 0x04, 0x82, 0x13, 0x92,
-		0x01, 0xdf, 0x3a, 0x3a, 0x19, 0x30, 0x3e, 0x5c, 0xe7, 0x6c, 0x4e, 0x97, 0x0d, 0x50, 0xc7, 0x64,
-		0x03, 0xe4, 0xb1, 0x91, 0x2b, 0x23, 0x3f, 0x75, 0x3a, 0x60, 0x73, 0x44, 0x3a, 0x80, 0x05, 0xee,
-		0xa7, 0xc7, 0x12, 0x9f, 0x22, 0x1f, 0xb0, 0x39, 0x22, 0x1f, 0x80, 0x26, 0x92, 0x9d, 0x98, 0x10,
-		0xb8, 0xe2, 0x4f, 0x08, 0xcc, 0x8f, 0xf1, 0x3a, 0xdd, 0xd9, 0x3e, 0x26, 0x23, 0xb0, 0x35, 0x2e,
-		0x23, 0xb0, 0xc0, 0x7f, 0x3f, 0x65, 0x1c, 0xc5, 0x43, 0xa4, 0x04, 0x6a, 0x43, 0x29, 0x81, 0x23,
-		0x13, 0x34, 0x6d, 0xfa, 0x9c, 0x00, 0xfb, 0x45, 0x3d, 0xf6, 0x5b, 0x7a, 0x20, 0x27, 0xae, 0x87,
-		0x62, 0x09, 0x39, 0x99, 0x7b, 0x88, 0x78, 0x30, 0x03, 0x76, 0x8e, 0xc4, 0x0a, 0xb8, 0xd7, 0x33,
-		0x7b, 0xe2, 0x8e, 0x05, 0xfd, 0xc8, 0x9d, 0x26, 0x31, 0xa2, 0x6b, 0xd3, 0x0e, 0xc8, 0x1f, 0xd0,
-		0x98, 0xcc, 0x63, 0xc7, 0x72, 0xbf, 0x28, 0xb9, 0xb8, 0x34, 0x83, 0xe0, 0x8d, 0x2f, 0xe3, 0x3c,
-		0xbe, 0xf4, 0x64, 0x15, 0x02, 0xfe, 0xac, 0xc2, 0x22, 0x24, 0x48, 0xac, 0x35, 0x90, 0x30, 0xd0,
-		0xba, 0x4e, 0xc2, 0x40, 0x1c, 0xa0, 0x62, 0xb9, 0x07, 0xbe, 0x2c, 0xb1, 0xdd, 0xc4, 0xb4, 0x73,
-		0x98, 0x8c, 0x85, 0xba, 0xe8, 0x2c, 0xcc, 0x7b, 0x60, 0x9d, 0x18, 0x8e, 0x45, 0xcf, 0xb2, 0x03,
-		0x9d, 0xe7, 0xc1, 0xdc, 0xbf, 0x90, 0x5c, 0x09, 0xb9, 0x99, 0x86, 0x51, 0x49, 0x01, 0xe9, 0x75,
-		0x4a, 0x0a, 0x04, 0xee, 0x3a, 0x29, 0xe0, 0x8d, 0x49, 0x83, 0xfe, 0x98, 0xf4, 0xbf, 0x49, 0xee,
-		0x98, 0x38, 0x21, 0x7e, 0xd3, 0x6c, 0x61, 0x1e, 0x25, 0xd2, 0xbf, 0x89, 0x4b, 0xd2, 0x36, 0xc5,
-		0x0f, 0xa1, 0x91, 0x3f, 0x09, 0x94, 0xb3, 0xf0, 0xc4, 0xf9, 0xba, 0xe2, 0x04, 0x98, 0x61, 0xef,
-		0x3d, 0x0e, 0x7e, 0xb9, 0x21, 0xe2, 0x5e, 0x6e, 0x70, 0xae, 0x8e, 0x47, 0x3d, 0x57, 0xc7, 0xd1,
-		0x93, 0x10, 0xa7, 0xc9, 0x7e, 0xd5, 0xec, 0x8a, 0x9f, 0xa5, 0x3f, 0x31, 0xfe, 0x62, 0x83, 0x45,
-		0x8f, 0x5c, 0xb3, 0xcb, 0x10, 0xae, 0xc7, 0x11, 0xf7, 0x79, 0x1c, 0xf7, 0x40, 0x9c, 0x70, 0xcf,
-		0x7e, 0x54, 0x15, 0xf8, 0xbb, 0x03, 0xa2, 0x20, 0xf7, 0x53, 0x01, 0x48, 0x0f, 0x2c, 0x34, 0x23,
-		0xfb, 0x3e, 0x6a, 0x1b, 0x79, 0x3a, 0x79, 0x9c, 0x04, 0xd8, 0xd1, 0x2c, 0xf5, 0x05, 0xcd, 0xb0,
-		0x71, 0x8b, 0x0b, 0xc5, 0x53, 0x82, 0xb2, 0x10, 0x23, 0x5f, 0x7d, 0x0b, 0xb7, 0x78, 0xf6, 0xc5,
-		0xf9, 0x46, 0xd7, 0x20, 0x82, 0x6f, 0xd3, 0x37, 0x99, 0xd9, 0xef, 0xe0, 0x1c, 0x1d, 0x0e, 0x87,
-		0x49, 0x75, 0x21, 0x43, 0x06, 0xfb, 0xcb, 0x5f, 0x58, 0x94, 0x19, 0xf4, 0x23, 0xce, 0x65, 0x36,
-		0x85, 0xe3, 0xfb, 0xa5, 0x10, 0x1b, 0x90, 0x02, 0xcd, 0x03, 0x26, 0x45, 0x78, 0x4f, 0x64, 0xca,
-		0xce, 0x78, 0x2b, 0xb3, 0x1d, 0xdc, 0xe9, 0x9a, 0x66, 0x5b, 0x65, 0x73, 0x3c, 0x0f, 0x29, 0xff,
-		0xba, 0xca, 0x7e, 0xfa, 0xdc, 0xd6, 0x74, 0x43, 0xf5, 0x39, 0xc1, 0x49, 0x56, 0xc8, 0xe6, 0x94,
-		0xf7, 0xc1, 0xed, 0xdc, 0x06, 0x1c, 0x19, 0xb9, 0xae, 0xa2, 0x27, 0x20, 0xee, 0x2e, 0xc9, 0xec,
-		0x98, 0xfe, 0x01, 0x99, 0x16, 0x17, 0x36, 0xf7, 0xcb, 0x92, 0x4b, 0xd2, 0x9f, 0xbb, 0x29, 0x43,
-		0x84, 0x9d, 0x99, 0xe4, 0xe7, 0x63, 0xce, 0x4e, 0xb7, 0x22, 0x2f, 0xb3, 0x03, 0x95, 0x0a, 0x47,
-		0xce, 0xbd, 0x1d, 0x22, 0xac, 0x04, 0x25, 0x20, 0xea, 0xfb, 0x05, 0x80, 0x7c, 0xb1, 0x58, 0xde,
-		0x10, 0x6f, 0x2a, 0x17, 0xe8, 0x93, 0xbe, 0xa4, 0x58, 0x29, 0x5f, 0x2f, 0x17, 0x1b, 0x72, 0x10,
-		0xcd, 0xc1, 0x2c, 0xfb, 0x9b, 0xbf, 0xd3, 0x2f, 0x87, 0x3c, 0x45, 0xf5, 0x72, 0xb5, 0x54, 0x56,
-		0xe4, 0x70, 0xee, 0x3c, 0x1c, 0x1f, 0xbb, 0x86, 0xbb, 0x89, 0x19, 0xc9, 0x93, 0x98, 0xc9, 0xbd,
-		0x1c, 0x20, 0x41, 0xcd, 0xb8, 0x85, 0x19, 0x5d, 0x1f, 0xe8, 0xf8, 0xca, 0x21, 0x56, 0xf5, 0x81,
-		0xde, 0x93, 0x38, 0xa6, 0x87, 0xb7, 0xb1, 0xdd, 0xdc, 0x65, 0x8e, 0x02, 0xb3, 0x40, 0xb3, 0xca,
-		0x2c, 0x2f, 0xa5, 0x48, 0x16, 0x03, 0x7b, 0x1e, 0x37, 0x6d, 0x95, 0x29, 0x91, 0x45, 0x83, 0x89,
-		0x38, 0x01, 0x23, 0xa5, 0x75, 0x56, 0x98, 0xfb, 0xf6, 0x43, 0xc9, 0x32, 0x0e, 0x61, 0xa5, 0xdc,
-		0x50, 0x6e, 0xca, 0x41, 0x84, 0x20, 0x45, 0xff, 0x54, 0xeb, 0xd5, 0xfc, 0x46, 0xfd, 0x5a, 0x8d,
-		0xc8, 0x72, 0x1e, 0xd2, 0x42, 0x96, 0xa2, 0x30, 0x9c, 0x7b, 0x18, 0x8e, 0x8d, 0xf1, 0x2a, 0x46,
-		0x1c, 0x62, 0xfd, 0xa8, 0xe4, 0x85, 0xf6, 0x7b, 0x06, 0x35, 0x88, 0x58, 0xb6, 0x66, 0xf7, 0x2d,
-		0x2e, 0xc4, 0x27, 0xa6, 0x75, 0x33, 0x96, 0xc5, 0x1f, 0x75, 0x8a, 0xae, 0x70, 0x32, 0xb9, 0x0b,
-		0x90, 0xf2, 0xd7, 0x8c, 0x97, 0x81, 0xab, 0x44, 0x81, 0xdc, 0x65, 0x40, 0xc3, 0xde, 0xc7, 0x88,
-		0xf0, 0x52, 0x1a, 0x15, 0x5e, 0xfe, 0x84, 0x04, 0x27, 0x0e, 0xf0, 0x34, 0xd0, 0xdb, 0x06, 0x3a,
-		0x79, 0xe9, 0x30, 0x7e, 0xca, 0x32, 0x2b, 0x1b, 0xe8, 0xe6, 0x63, 0x90, 0xf4, 0x96, 0x4f, 0xd7,
-		0xc9, 0x2f, 0x07, 0xdc, 0x49, 0xec, 0x8f, 0x83, 0x5d, 0x13, 0x28, 0x7d, 0x83, 0x26, 0xf0, 0xcd,
-		0x00, 0xf6, 0x9e, 0x38, 0x45, 0xcd, 0xd7, 0xd1, 0x7b, 0x47, 0xe4, 0x17, 0x71, 0xb3, 0xb1, 0xc7,
-		0x27, 0x41, 0xdc, 0xe6, 0x7f, 0x59, 0xa8, 0xee, 0x4d, 0x0a, 0xf4, 0xe9, 0x1a, 0x6b, 0xf1, 0x80,
-		0x79, 0xda, 0xc5, 0xd8, 0x4d, 0x1e, 0xb0, 0x62, 0x0b, 0xdd, 0x84, 0x63, 0x03, 0x8e, 0x82, 0x43,
-		0x3a, 0x34, 0xad, 0xbf, 0x70, 0xc4, 0xef, 0x2f, 0x08, 0xd2, 0xde, 0xd5, 0x3e, 0xec, 0x5f, 0xed,
-		0x6f, 0x02, 0xb8, 0xc9, 0x01, 0xf7, 0xd8, 0x9f, 0xe4, 0x3d, 0xf6, 0x77, 0x01, 0xc2, 0x44, 0x93,
-		0x84, 0x9c, 0x86, 0x4d, 0x31, 0xd1, 0x04, 0x4f, 0x72, 0x81, 0x41, 0xe7, 0x74, 0x40, 0xc3, 0x09,
-		0xda, 0x31, 0x4d, 0xbc, 0xc5, 0xdf, 0xc4, 0xa9, 0xb1, 0xa9, 0xde, 0xd1, 0x4d, 0xbd, 0x08, 0x61,
-		0x3a, 0xf2, 0x23, 0xef, 0x52, 0x7e, 0x1b, 0x80, 0x66, 0xdb, 0x3d, 0x7d, 0xab, 0xef, 0x36, 0xb0,
-		0x38, 0x5a, 0x73, 0xf2, 0x02, 0xae, 0x70, 0x0f, 0x57, 0xa1, 0x05, 0x17, 0xd5, 0xa3, 0x46, 0x1e,
-		0x82, 0xb9, 0x2a, 0xa4, 0xfc, 0xb8, 0xa3, 0xef, 0x86, 0x0e, 0xff, 0x02, 0xae, 0xeb, 0x1d, 0xf1,
-		0xe7, 0xaa, 0xe8, 0x47, 0xee, 0x9d, 0x01, 0x48, 0x7a, 0x15, 0xef, 0xef, 0x9f, 0x0b, 0x92, 0xfb,
-		0x2e, 0x09, 0x62, 0x4e, 0xf7, 0x0f, 0x38, 0xec, 0xec, 0xde, 0x11, 0x76, 0x36, 0x2f, 0xd8, 0x36,
-		0x51, 0xd0, 0xd9, 0x26, 0xba, 0xec, 0x2c, 0x7f, 0xe3, 0x12, 0x22, 0x5e, 0x59, 0x8b, 0x03, 0x87,
-		0x7c, 0xb5, 0xbf, 0x3c, 0xdd, 0x55, 0xbc, 0x05, 0x08, 0x7b, 0xaf, 0xd1, 0xb1, 0x8f, 0x5c, 0xcb,
-		0x73, 0xc2, 0x88, 0x4d, 0x44, 0xef, 0x9d, 0x3d, 0xe9, 0xd0, 0x77, 0xf6, 0x9c, 0x56, 0x02, 0xde,
-		0x56, 0x7e, 0x40, 0x82, 0x98, 0x98, 0x13, 0xe8, 0xad, 0xde, 0x93, 0x67, 0x62, 0x6b, 0x73, 0xac,
-		0x3d, 0xe2, 0xf4, 0x3d, 0x07, 0xcf, 0x86, 0x0e, 0x5c, 0x07, 0x0f, 0x7b, 0xe0, 0x9a, 0x7b, 0x76,
-		0x7f, 0x29, 0x81, 0x3c, 0x38, 0x63, 0xbf, 0x61, 0xee, 0x86, 0x97, 0xb9, 0xe0, 0x88, 0x65, 0x6e,
-		0xdc, 0x29, 0xea, 0xd0, 0xb8, 0x53, 0xd4, 0xc3, 0xbd, 0x0e, 0xdf, 0x65, 0xaf, 0xdf, 0x1d, 0x80,
-		0x84, 0x27, 0x3d, 0x8a, 0x1e, 0xf7, 0x9d, 0xc8, 0x5e, 0x3a, 0x28, 0x95, 0xea, 0x39, 0x92, 0xed,
-		0x13, 0x53, 0xe0, 0xf0, 0x62, 0x7a, 0xfd, 0x6f, 0x67, 0x8d, 0xbe, 0xc0, 0x1a, 0x1e, 0x73, 0x81,
-		0xf5, 0x3b, 0x25, 0x88, 0x39, 0x6e, 0xf7, 0x61, 0x37, 0x31, 0x8f, 0x42, 0x84, 0x7b, 0x96, 0x6c,
-		0x17, 0x93, 0x7f, 0x8d, 0x4c, 0x2b, 0x67, 0x21, 0xd6, 0xc1, 0xb6, 0x46, 0xed, 0x20, 0x5b, 0xd5,
-		0x9c, 0xef, 0x33, 0x97, 0x20, 0xe1, 0xd9, 0x00, 0x26, 0xa6, 0xb1, 0x5a, 0x7e, 0x46, 0x9e, 0xc9,
-		0x46, 0x5f, 0xba, 0xb3, 0x14, 0xac, 0xe2, 0x17, 0xc8, 0x6c, 0x56, 0xca, 0xc5, 0x6b, 0xe5, 0xe2,
-		0xd3, 0xb2, 0x94, 0x4d, 0xbc, 0x74, 0x67, 0x29, 0xaa, 0x60, 0x9a, 0x51, 0x3c, 0xf3, 0x34, 0xa4,
-		0x07, 0x06, 0xc6, 0xef, 0xb6, 0x20, 0x48, 0x95, 0x36, 0x37, 0xd6, 0x2a, 0xc5, 0x7c, 0xa3, 0xac,
-		0xb2, 0x73, 0xbb, 0xe8, 0x18, 0xcc, 0xaf, 0x55, 0xae, 0x5e, 0x6b, 0xa8, 0xc5, 0xb5, 0x4a, 0xb9,
-		0xda, 0x50, 0xf3, 0x8d, 0x46, 0xbe, 0xf8, 0xb4, 0x1c, 0x58, 0xb9, 0x93, 0x80, 0x50, 0xbe, 0x50,
-		0xac, 0xa0, 0x22, 0x84, 0x68, 0x2a, 0xe4, 0xc0, 0x13, 0x60, 0xd9, 0x83, 0x73, 0xc3, 0xe8, 0x0a,
-		0x84, 0x69, 0x96, 0x04, 0x1d, 0x7c, 0x24, 0x2c, 0x3b, 0x21, 0x59, 0x4c, 0x98, 0xa1, 0x33, 0xf2,
-		0xc0, 0x33, 0x62, 0xd9, 0x83, 0x73, 0xc7, 0x68, 0x0d, 0xa2, 0x22, 0x48, 0x9e, 0x74, 0x70, 0x2b,
-		0x3b, 0x31, 0xa1, 0x4b, 0xba, 0xc6, 0x92, 0x0d, 0x07, 0x1f, 0x1f, 0xcb, 0x4e, 0xc8, 0x2a, 0xa3,
-		0x8a, 0x73, 0x9f, 0x69, 0xc2, 0x89, 0xb0, 0xec, 0xa4, 0x3c, 0x31, 0x52, 0x20, 0xee, 0xa6, 0x71,
-		0x26, 0x1f, 0x8a, 0xcb, 0x4e, 0x91, 0x30, 0x47, 0x6f, 0x87, 0x59, 0x7f, 0xa8, 0x3b, 0xdd, 0xa9,
-		0xb3, 0xec, 0x94, 0x19, 0x69, 0x42, 0xdf, 0x1f, 0xf7, 0x4e, 0x77, 0x0a, 0x2d, 0x3b, 0x65, 0x82,
-		0x1a, 0x3d, 0x0f, 0x73, 0xc3, 0x71, 0xe9, 0xf4, 0x87, 0xd2, 0xb2, 0x87, 0x48, 0x59, 0xa3, 0x0e,
-		0xa0, 0x11, 0xf1, 0xec, 0x21, 0xce, 0xa8, 0x65, 0x0f, 0x93, 0xc1, 0x46, 0x2d, 0x48, 0x0f, 0x06,
-		0x89, 0xd3, 0x9e, 0x59, 0xcb, 0x4e, 0x9d, 0xcd, 0x66, 0xad, 0xf8, 0x83, 0xcb, 0x69, 0xcf, 0xb0,
-		0x65, 0xa7, 0x4e, 0x6e, 0xa3, 0x4d, 0x00, 0x4f, 0x7c, 0x38, 0xc5, 0x99, 0xb6, 0xec, 0x34, 0x69,
-		0x6e, 0xd4, 0x85, 0xf9, 0x51, 0x81, 0xe3, 0x61, 0x8e, 0xb8, 0x65, 0x0f, 0x95, 0xfd, 0x26, 0xfa,
-		0xec, 0x0f, 0x01, 0xa7, 0x3b, 0xf2, 0x96, 0x9d, 0x32, 0x0d, 0x5e, 0xc8, 0x8f, 0x3d, 0xe7, 0xfc,
-		0xe0, 0x81, 0xe7, 0x9c, 0xdd, 0x93, 0xcb, 0xce, 0xd9, 0xe6, 0x2f, 0x9e, 0x83, 0x37, 0xf1, 0x17,
-		0x74, 0x2c, 0x5b, 0xbb, 0xa5, 0x1b, 0x3b, 0xce, 0x33, 0x4b, 0xfc, 0x9b, 0x1f, 0x72, 0x3e, 0xca,
-		0x5f, 0xe9, 0x11, 0xa5, 0x13, 0x1e, 0x5b, 0x1a, 0xfb, 0x46, 0xe7, 0xa4, 0xfb, 0x10, 0x93, 0x8f,
-		0x2f, 0x1f, 0xf0, 0x90, 0xd3, 0x84, 0xe7, 0xa2, 0x46, 0x3c, 0xf4, 0x94, 0x3d, 0xf0, 0x8d, 0x81,
-		0xec, 0x41, 0xe7, 0xbe, 0x89, 0x17, 0x9b, 0xba, 0xa6, 0x5b, 0xb6, 0xd9, 0xd3, 0x9b, 0x5a, 0x9b,
-		0x2e, 0x2f, 0x97, 0xa7, 0xbd, 0x95, 0x55, 0x88, 0x13, 0x77, 0x85, 0xbf, 0x05, 0xc5, 0x6f, 0x0a,
-		0x95, 0x20, 0x72, 0x5b, 0x6b, 0xb3, 0x3b, 0x51, 0xde, 0xc7, 0xe4, 0x06, 0x65, 0xee, 0xf1, 0xa3,
-		0xbc, 0x54, 0x18, 0x6e, 0xee, 0x87, 0xe8, 0x7d, 0x92, 0x4e, 0x47, 0xb7, 0x88, 0x2e, 0x2a, 0x34,
-		0x94, 0xbe, 0x0e, 0xa1, 0x9e, 0x66, 0xf3, 0x00, 0xb3, 0x70, 0xf1, 0xd0, 0xef, 0x32, 0xb1, 0x16,
-		0x28, 0x0d, 0xf4, 0x36, 0x88, 0x75, 0xb4, 0x3d, 0x95, 0xd2, 0x0b, 0x7c, 0x43, 0xf4, 0xa2, 0x1d,
-		0x6d, 0x8f, 0xf0, 0x87, 0xde, 0x4e, 0x7f, 0xf7, 0x51, 0x6d, 0xee, 0x6a, 0xc6, 0x0e, 0x66, 0x94,
-		0x83, 0xdf, 0x10, 0xe5, 0xd9, 0x8e, 0xb6, 0x57, 0xa4, 0xd4, 0x08, 0x7d, 0xfe, 0xe4, 0xd5, 0xaf,
-		0x49, 0x3c, 0x6b, 0x40, 0x05, 0x83, 0x34, 0x90, 0x9b, 0xce, 0x17, 0x6d, 0x54, 0x6c, 0x71, 0x3c,
-		0x38, 0x4e, 0xee, 0x03, 0x62, 0x2d, 0xcc, 0x12, 0xf6, 0x3e, 0xff, 0x85, 0x45, 0x89, 0xb5, 0x9a,
-		0x6e, 0x0e, 0x89, 0x3d, 0xc1, 0x92, 0x21, 0x2a, 0xf5, 0x61, 0x03, 0x13, 0x7d, 0xd8, 0x59, 0xe1,
-		0xc3, 0x32, 0x82, 0xc0, 0xb0, 0x49, 0x3d, 0xef, 0xc3, 0x27, 0x25, 0x48, 0x94, 0x3c, 0x0f, 0x5f,
-		0x66, 0x20, 0xda, 0x31, 0x0d, 0xfd, 0x16, 0xee, 0x39, 0x7b, 0x54, 0xec, 0x93, 0xf8, 0x99, 0xec,
-		0x57, 0xd6, 0xed, 0x7d, 0xf1, 0xba, 0x90, 0xf8, 0x26, 0x58, 0x2f, 0xe0, 0x2d, 0x4b, 0x17, 0x72,
-		0x56, 0xc4, 0x27, 0x7a, 0x08, 0x64, 0x0b, 0x37, 0xfb, 0x3d, 0xdd, 0xde, 0x57, 0x9b, 0xa6, 0x61,
-		0x6b, 0x4d, 0x9b, 0xc7, 0xe1, 0x69, 0x51, 0x5e, 0x64, 0xc5, 0x84, 0x48, 0x0b, 0xdb, 0x9a, 0xde,
-		0x66, 0x27, 0x33, 0xe3, 0x8a, 0xf8, 0xe4, 0xac, 0xde, 0x89, 0x7a, 0xe3, 0xd0, 0x22, 0xc8, 0x66,
-		0x17, 0xf7, 0x7c, 0x07, 0x52, 0x98, 0x36, 0x66, 0x7e, 0xe7, 0xb3, 0x67, 0x17, 0xb8, 0xc0, 0xf9,
-		0x61, 0x06, 0xf6, 0x3b, 0x0f, 0x4a, 0x5a, 0x60, 0x88, 0x93, 0x2a, 0x37, 0x7d, 0xbb, 0x52, 0xfd,
-		0x2d, 0xf7, 0x19, 0x99, 0x85, 0x21, 0xa1, 0xe6, 0x8d, 0xfd, 0x42, 0xe6, 0x73, 0x2e, 0x69, 0x37,
-		0x4e, 0x7d, 0x1a, 0xef, 0x7b, 0xb7, 0xa8, 0x28, 0x19, 0xe2, 0xc2, 0x3f, 0xaf, 0xe9, 0x6d, 0xdc,
-		0xe2, 0x69, 0x0d, 0xfe, 0x85, 0x56, 0x9d, 0xf4, 0x22, 0xfb, 0x31, 0xd3, 0xdc, 0x38, 0xdd, 0x28,
-		0x98, 0x46, 0xcb, 0x9f, 0x47, 0x44, 0x45, 0x88, 0xd8, 0xe6, 0x2d, 0x6c, 0x70, 0x01, 0x1d, 0xee,
-		0x69, 0x35, 0x8e, 0x8a, 0xbe, 0x15, 0xe4, 0x16, 0x6e, 0xe3, 0x1d, 0x76, 0x5f, 0x72, 0x57, 0xeb,
-		0x61, 0x76, 0x5b, 0xff, 0xae, 0x9e, 0x57, 0x4b, 0x3b, 0xa4, 0xea, 0x94, 0x12, 0xda, 0xf0, 0x3f,
-		0xad, 0x1a, 0xe5, 0x9b, 0xc7, 0x63, 0xfa, 0xe8, 0xd1, 0x3c, 0xaf, 0xe5, 0xf1, 0x3d, 0xc5, 0xfa,
-		0x10, 0xc8, 0x7d, 0x63, 0xcb, 0x34, 0xe8, 0x2f, 0x73, 0xf2, 0x28, 0x2a, 0xc6, 0x76, 0x2a, 0x9d,
-		0x72, 0xbe, 0x53, 0xb9, 0x01, 0x29, 0x17, 0x94, 0xce, 0x90, 0xf8, 0x61, 0x67, 0xc8, 0xac, 0x43,
-		0x80, 0x80, 0xa0, 0x75, 0x00, 0x77, 0x0e, 0xd2, 0xad, 0xb2, 0xc4, 0xf8, 0x11, 0x73, 0x67, 0xb3,
-		0xb7, 0x33, 0x1e, 0x02, 0xc8, 0x80, 0xf9, 0x8e, 0x6e, 0xa8, 0x16, 0x6e, 0x6f, 0xab, 0x5c, 0x72,
-		0x84, 0x6e, 0x82, 0x8a, 0xff, 0xad, 0x87, 0x18, 0xcd, 0xdf, 0xfb, 0xec, 0xd9, 0xb4, 0xfb, 0x40,
-		0xdd, 0xd2, 0xa3, 0xcb, 0x8f, 0x5f, 0x54, 0xe6, 0x3a, 0xba, 0x51, 0xc7, 0xed, 0xed, 0x92, 0x43,
-		0x18, 0xbd, 0x19, 0x4e, 0xb8, 0x02, 0x31, 0x0d, 0x75, 0xd7, 0x6c, 0xb7, 0xd4, 0x1e, 0xde, 0x56,
-		0x9b, 0xf4, 0xe9, 0xbf, 0x24, 0x15, 0xe3, 0x31, 0x07, 0xa4, 0x66, 0x5c, 0x33, 0xdb, 0x2d, 0x05,
-		0x6f, 0x17, 0x49, 0x35, 0xba, 0x0f, 0x5c, 0x69, 0xa8, 0x7a, 0xcb, 0xca, 0xcc, 0x2e, 0x05, 0x4f,
-		0x87, 0x94, 0xa4, 0x53, 0x58, 0x69, 0x59, 0xab, 0xb1, 0xf7, 0x7e, 0x64, 0x71, 0xe6, 0xd5, 0x8f,
-		0x2c, 0xce, 0xe4, 0xae, 0xd0, 0x67, 0xa6, 0xf8, 0xd4, 0xc2, 0x16, 0xba, 0x08, 0x71, 0x4d, 0x7c,
-		0xb0, 0x4b, 0x64, 0x07, 0x4c, 0x4d, 0x17, 0x34, 0xf7, 0x93, 0x12, 0x44, 0x4a, 0x37, 0x36, 0x34,
-		0xbd, 0x87, 0xca, 0x30, 0xe7, 0xea, 0xea, 0xb4, 0xb3, 0xdc, 0x55, 0x6f, 0x31, 0xcd, 0xab, 0xe3,
-		0x4e, 0xaf, 0xc5, 0x0b, 0xa7, 0x7e, 0xe7, 0xb3, 0x67, 0xef, 0xe5, 0x64, 0x6e, 0x0c, 0x1c, 0x64,
-		0x13, 0xf4, 0x06, 0x0f, 0xb8, 0x79, 0xfa, 0x7c, 0x1d, 0xa2, 0x8c, 0x55, 0x0b, 0x3d, 0x05, 0xe1,
-		0x2e, 0xf9, 0x83, 0x27, 0xe5, 0x4f, 0x8e, 0xd5, 0x79, 0x0a, 0xef, 0xd5, 0x10, 0x86, 0x97, 0x7b,
-		0x5f, 0x00, 0xa0, 0x74, 0xe3, 0x46, 0xa3, 0xa7, 0x77, 0xdb, 0xd8, 0x7e, 0xbd, 0xfa, 0xbe, 0x09,
-		0x47, 0x3c, 0xd7, 0xa3, 0x7b, 0xcd, 0xc3, 0xf7, 0x7f, 0xde, 0xbd, 0x29, 0xdd, 0x6b, 0x8e, 0x24,
-		0xdb, 0xb2, 0x6c, 0x87, 0x6c, 0xf0, 0xf0, 0x64, 0x4b, 0x96, 0x3d, 0x2c, 0xd9, 0x67, 0x21, 0xe1,
-		0x0a, 0xc3, 0x42, 0x15, 0x88, 0xd9, 0xfc, 0x6f, 0x2e, 0xe0, 0xdc, 0x78, 0x01, 0x0b, 0x34, 0xaf,
-		0x90, 0x1d, 0xf4, 0xdc, 0x5f, 0x4b, 0x00, 0x9e, 0x39, 0xf2, 0xc6, 0xd4, 0x31, 0x12, 0xc1, 0x73,
-		0xe3, 0x1c, 0xbc, 0xeb, 0x07, 0x3a, 0x19, 0x01, 0x8f, 0x50, 0xdf, 0x1f, 0x80, 0xf9, 0x4d, 0x31,
-		0x7b, 0xdf, 0xf8, 0x32, 0xd8, 0x84, 0x28, 0x36, 0xec, 0x9e, 0xee, 0x6c, 0x2b, 0x3d, 0x3a, 0x6e,
-		0xcc, 0x47, 0x74, 0xaa, 0x6c, 0xd8, 0xbd, 0x7d, 0xaf, 0x06, 0x08, 0x5a, 0x1e, 0x79, 0x7c, 0x30,
-		0x08, 0x99, 0x71, 0xa8, 0xe8, 0x41, 0x48, 0x37, 0x7b, 0x98, 0x16, 0xf8, 0x6f, 0x8d, 0xa6, 0x44,
-		0x31, 0x5f, 0x76, 0x14, 0x20, 0x8e, 0x1a, 0x51, 0x2e, 0x02, 0x7a, 0x77, 0x9e, 0x59, 0xca, 0xa5,
-		0x40, 0x17, 0x9e, 0x06, 0xa4, 0xc5, 0x35, 0x94, 0x2d, 0xad, 0xad, 0x19, 0x4d, 0xe1, 0xc1, 0x1e,
-		0x6a, 0xcd, 0x17, 0x57, 0x59, 0x0a, 0x8c, 0x04, 0x2a, 0x43, 0x54, 0x50, 0x0b, 0x1d, 0x9e, 0x9a,
-		0xc0, 0x45, 0xa7, 0x20, 0xe9, 0x5d, 0x18, 0xa8, 0x37, 0x12, 0x52, 0x12, 0x9e, 0x75, 0x61, 0xd2,
-		0xca, 0x13, 0x39, 0x70, 0xe5, 0xe1, 0x0e, 0xdf, 0x8f, 0xd1, 0xd3, 0xe2, 0xad, 0xbf, 0xfb, 0xc3,
-		0xb2, 0x01, 0xc0, 0xa6, 0x2a, 0xb1, 0xa4, 0x7c, 0x64, 0xee, 0x62, 0xbe, 0xc7, 0x19, 0x91, 0x92,
-		0x65, 0xff, 0x6d, 0x8d, 0xd0, 0xef, 0x07, 0x20, 0xe9, 0x1d, 0xa1, 0xbf, 0x97, 0x8b, 0x16, 0xaa,
-		0xba, 0x66, 0x8a, 0xdd, 0xa8, 0x79, 0x68, 0x9c, 0x99, 0x1a, 0xd2, 0xe6, 0x09, 0xf6, 0xe9, 0x43,
-		0x41, 0x88, 0xf0, 0x23, 0x6f, 0xb5, 0x21, 0xdf, 0x56, 0x9a, 0xf4, 0x26, 0xc0, 0xac, 0x78, 0x13,
-		0x60, 0xa4, 0x6b, 0x7b, 0x3f, 0xa4, 0x48, 0x8c, 0xec, 0x3b, 0x47, 0x27, 0x9d, 0x9e, 0xa5, 0xa1,
-		0xae, 0x7b, 0x6a, 0x1c, 0x2d, 0x42, 0x82, 0x80, 0xb9, 0x76, 0x98, 0xc0, 0x40, 0x47, 0xdb, 0x2b,
-		0xb3, 0x12, 0x74, 0x16, 0xd0, 0xae, 0x93, 0xb3, 0x50, 0x5d, 0x41, 0x10, 0xb8, 0x39, 0xb7, 0x46,
-		0x80, 0xdf, 0x0b, 0x40, 0xb8, 0x50, 0xd9, 0x9b, 0xd4, 0xfc, 0xc5, 0x6f, 0x52, 0x52, 0xa2, 0xef,
-		0x52, 0xbf, 0x47, 0x62, 0x2e, 0xf2, 0x40, 0x24, 0xcd, 0x23, 0x94, 0xc6, 0x14, 0x93, 0xe2, 0x2f,
-		0xbe, 0xb0, 0x98, 0xdd, 0xd7, 0x3a, 0xed, 0xd5, 0xdc, 0x08, 0x3a, 0xb9, 0x51, 0xc1, 0x3d, 0x71,
-		0x9c, 0xfd, 0x91, 0xf8, 0xea, 0x9b, 0x88, 0x7a, 0xbf, 0xf4, 0xca, 0xa7, 0xce, 0x9c, 0xf0, 0x3c,
-		0x03, 0xbd, 0xe7, 0x64, 0xb4, 0xd8, 0x98, 0x10, 0x4f, 0x15, 0xb9, 0xab, 0x86, 0x73, 0x58, 0x7e,
-		0x1d, 0xc0, 0xe3, 0xdc, 0x4b, 0x07, 0x07, 0x0d, 0x2e, 0xbe, 0x2f, 0x68, 0xf0, 0xcc, 0xa9, 0xb7,
-		0xba, 0x46, 0x5b, 0x3c, 0x03, 0x31, 0xe2, 0x91, 0xf2, 0xe5, 0xa2, 0xa9, 0xfb, 0x48, 0x08, 0x24,
-		0x3a, 0x55, 0x67, 0x72, 0xff, 0x46, 0x82, 0xe3, 0x43, 0xea, 0xe7, 0xb0, 0xdc, 0x04, 0xd4, 0xf3,
-		0x54, 0xd2, 0x61, 0x14, 0xbb, 0xb3, 0x77, 0xa7, 0xcd, 0x73, 0xbd, 0x21, 0xcb, 0xfd, 0xfa, 0xac,
-		0x3e, 0xdc, 0xf4, 0xfc, 0xa6, 0x04, 0x0b, 0x5e, 0x06, 0x9c, 0xae, 0xd4, 0x21, 0xe9, 0x6d, 0x9a,
-		0x77, 0xe2, 0x4d, 0xd3, 0x74, 0xc2, 0xcb, 0xbf, 0x8f, 0x08, 0xba, 0xe1, 0x4e, 0x71, 0x96, 0x4a,
-		0x3b, 0x3f, 0xb5, 0x50, 0x04, 0x63, 0x23, 0xa7, 0x3a, 0x1b, 0x9b, 0xaf, 0x48, 0x10, 0xda, 0x30,
-		0xcd, 0x36, 0x7a, 0x07, 0xcc, 0x19, 0xa6, 0xad, 0x92, 0xe9, 0x80, 0x5b, 0x2a, 0x8f, 0xf5, 0x99,
-		0xf9, 0x2c, 0x1f, 0x28, 0xab, 0x2f, 0x7f, 0x61, 0x71, 0x18, 0x73, 0xd4, 0x33, 0xf1, 0x69, 0xc3,
-		0xb4, 0x0b, 0x14, 0xa8, 0xc1, 0xd2, 0x01, 0xdb, 0x30, 0xeb, 0x6f, 0x8e, 0x99, 0xd8, 0xfc, 0xa4,
-		0xe6, 0x66, 0x27, 0x36, 0x95, 0xdc, 0xf2, 0xb4, 0xc3, 0x5e, 0x56, 0xfe, 0x33, 0x32, 0x72, 0x37,
-		0x41, 0xbe, 0x31, 0x78, 0xea, 0xa7, 0x0c, 0x51, 0x71, 0xca, 0x47, 0x9a, 0xf2, 0x00, 0x91, 0x57,
-		0x9c, 0x1c, 0xf7, 0xcc, 0xcf, 0x4b, 0x00, 0x6e, 0xde, 0x04, 0x3d, 0x02, 0xc7, 0x0a, 0xb5, 0x6a,
-		0x49, 0xad, 0x37, 0xf2, 0x8d, 0xcd, 0xba, 0xff, 0xe7, 0x57, 0xc4, 0x93, 0x44, 0x56, 0x17, 0x37,
-		0xf5, 0x6d, 0x1d, 0xb7, 0xd0, 0x03, 0xb0, 0xe0, 0x87, 0x26, 0x5f, 0xe5, 0x92, 0x2c, 0x65, 0x93,
-		0x2f, 0xdd, 0x59, 0x8a, 0x31, 0x3f, 0x11, 0xb7, 0xd0, 0x69, 0x38, 0x32, 0x0c, 0x57, 0xa9, 0x5e,
-		0x95, 0x03, 0xd9, 0xd9, 0x97, 0xee, 0x2c, 0xc5, 0x1d, 0x87, 0x12, 0xe5, 0x00, 0x79, 0x21, 0x39,
-		0xbd, 0x60, 0x16, 0x5e, 0xba, 0xb3, 0x14, 0x61, 0xa3, 0x90, 0x0d, 0xbd, 0xf7, 0xc7, 0x4f, 0xce,
-		0x9c, 0xf9, 0x36, 0x80, 0x8a, 0xb1, 0xdd, 0xd3, 0x9a, 0x54, 0xdb, 0xb2, 0x70, 0xb4, 0x52, 0xbd,
-		0xa2, 0xe4, 0x8b, 0x8d, 0x4a, 0xad, 0x3a, 0xf0, 0xab, 0x31, 0xfe, 0xba, 0x52, 0x6d, 0xb3, 0xb0,
-		0x56, 0x56, 0xeb, 0x95, 0xab, 0x55, 0xb6, 0xff, 0xea, 0xab, 0x7b, 0xa6, 0xda, 0xa8, 0xac, 0x97,
-		0xe5, 0x40, 0xe1, 0xca, 0xd8, 0xfc, 0xfe, 0x23, 0x07, 0x3e, 0x7c, 0xef, 0x5a, 0x3c, 0x5f, 0x92,
-		0xff, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x75, 0xa9, 0xc4, 0x25, 0xb6, 0x00, 0x00,
+		// 13160 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x90, 0x1c, 0xd7,
+		0x75, 0xd8, 0xf6, 0xbc, 0xe7, 0xcc, 0xab, 0xf7, 0xee, 0x02, 0x18, 0x0c, 0x48, 0x60, 0x39, 0x14,
+		0x49, 0x10, 0x24, 0x17, 0xe4, 0x92, 0x00, 0x89, 0x85, 0x28, 0x66, 0x5e, 0x00, 0x06, 0xd8, 0x9d,
+		0x59, 0xf5, 0xcc, 0x82, 0x04, 0x65, 0xab, 0xdd, 0x3b, 0x73, 0x77, 0xb7, 0x89, 0x99, 0xee, 0xd1,
+		0x74, 0x0f, 0xb0, 0xcb, 0xaa, 0xb8, 0x24, 0x4b, 0x4e, 0x64, 0xca, 0x72, 0xe4, 0xd8, 0x65, 0xd3,
+		0x96, 0x61, 0x4b, 0x72, 0x2c, 0xcb, 0xaf, 0xc4, 0x96, 0xe4, 0x57, 0x52, 0xf1, 0x23, 0x89, 0x13,
+		0xdb, 0x71, 0x52, 0xb2, 0xaa, 0xe2, 0x38, 0xae, 0xb2, 0x12, 0xcb, 0x2e, 0x8b, 0x92, 0xe5, 0xf8,
+		0x25, 0x27, 0x4e, 0x29, 0x2e, 0xa5, 0xee, 0xab, 0x1f, 0xf3, 0xd8, 0x99, 0x85, 0x28, 0x87, 0xb1,
+		0xf3, 0x03, 0x6c, 0xdf, 0x7b, 0xce, 0xb9, 0xe7, 0x9e, 0x7b, 0xee, 0xb9, 0xe7, 0x9c, 0xfb, 0x18,
+		0xf8, 0xeb, 0x6d, 0x58, 0xda, 0x31, 0xcd, 0x9d, 0x0e, 0x3e, 0xdb, 0xeb, 0x9b, 0xb6, 0xb9, 0x35,
+		0xd8, 0x3e, 0xdb, 0xc6, 0x56, 0xab, 0xaf, 0xf7, 0x6c, 0xb3, 0xbf, 0x4c, 0xcb, 0x50, 0x86, 0x41,
+		0x2c, 0x0b, 0x88, 0xfc, 0xdb, 0x60, 0xfe, 0x92, 0xde, 0xc1, 0x65, 0x07, 0xb0, 0x81, 0x6d, 0xf4,
+		0x0c, 0x84, 0xb6, 0xf5, 0x0e, 0xce, 0x4a, 0x4b, 0xc1, 0xd3, 0x89, 0x95, 0x37, 0x2d, 0x0f, 0x21,
+		0x2d, 0xfb, 0x31, 0x36, 0x48, 0xb1, 0x42, 0x31, 0xce, 0x24, 0x63, 0xef, 0xfc, 0xe2, 0xaf, 0x7f,
+		0x45, 0x92, 0xdf, 0x45, 0xfe, 0xcd, 0xff, 0x4a, 0x18, 0x16, 0xc6, 0xc0, 0x22, 0x04, 0x21, 0x43,
+		0xeb, 0x12, 0xfa, 0xd2, 0xe9, 0xb8, 0x42, 0xff, 0x46, 0x59, 0x88, 0xf6, 0xb4, 0xd6, 0x4d, 0x6d,
+		0x07, 0x67, 0x03, 0xb4, 0x58, 0x7c, 0xa2, 0x93, 0x00, 0x6d, 0xdc, 0xc3, 0x46, 0x1b, 0x1b, 0xad,
+		0xfd, 0x6c, 0x70, 0x29, 0x78, 0x3a, 0xae, 0x78, 0x4a, 0xd0, 0x23, 0x30, 0xdf, 0x1b, 0x6c, 0x75,
+		0xf4, 0x96, 0xea, 0x01, 0x83, 0xa5, 0xe0, 0xe9, 0xb0, 0x22, 0xb3, 0x8a, 0xb2, 0x0b, 0xfc, 0x10,
+		0x64, 0x6e, 0x63, 0xed, 0xa6, 0x17, 0x34, 0x41, 0x41, 0xd3, 0xa4, 0xb8, 0xec, 0xa3, 0x6a, 0xf6,
+		0x6c, 0xdd, 0x34, 0xbc, 0xa0, 0x19, 0xda, 0xb8, 0xcc, 0x2a, 0x3c, 0xc0, 0x25, 0x48, 0x76, 0xb1,
+		0x65, 0x69, 0x3b, 0x58, 0xb5, 0xf7, 0x7b, 0x38, 0x1b, 0xa2, 0x82, 0x5b, 0x1a, 0x11, 0xdc, 0xb0,
+		0xd0, 0x12, 0x1c, 0xab, 0xb9, 0xdf, 0xc3, 0xa8, 0x00, 0x71, 0x6c, 0x0c, 0xba, 0x8c, 0x42, 0x78,
+		0x82, 0xe8, 0x2b, 0xc6, 0xa0, 0x3b, 0x4c, 0x25, 0x46, 0xd0, 0x38, 0x89, 0xa8, 0x85, 0xfb, 0xb7,
+		0xf4, 0x16, 0xce, 0x46, 0x28, 0x81, 0x87, 0x46, 0x08, 0x34, 0x58, 0xfd, 0x30, 0x0d, 0x81, 0x87,
+		0x4a, 0x10, 0xc7, 0x7b, 0x36, 0x36, 0x2c, 0xdd, 0x34, 0xb2, 0x51, 0x4a, 0xe4, 0x81, 0x31, 0x0a,
+		0x80, 0x3b, 0xed, 0x61, 0x12, 0x2e, 0x1e, 0x3a, 0x0f, 0x51, 0x26, 0x23, 0x2b, 0x1b, 0x5b, 0x92,
+		0x4e, 0x27, 0x56, 0xee, 0x19, 0xab, 0x43, 0x75, 0x06, 0xa3, 0x08, 0x60, 0x54, 0x05, 0xd9, 0x32,
+		0x07, 0xfd, 0x16, 0x56, 0x5b, 0x66, 0x1b, 0xab, 0xba, 0xb1, 0x6d, 0x66, 0xe3, 0x94, 0xc0, 0xa9,
+		0xd1, 0x8e, 0x50, 0xc0, 0x92, 0xd9, 0xc6, 0x55, 0x63, 0xdb, 0x54, 0xd2, 0x96, 0xef, 0x1b, 0x1d,
+		0x85, 0x88, 0xb5, 0x6f, 0xd8, 0xda, 0x5e, 0x36, 0x49, 0xd5, 0x89, 0x7f, 0xa1, 0x15, 0x88, 0xe2,
+		0xb6, 0x4e, 0x9a, 0xcb, 0xa6, 0x97, 0xa4, 0xd3, 0xe9, 0x95, 0xec, 0xa8, 0x8c, 0x59, 0xbd, 0x22,
+		0x00, 0xf3, 0xff, 0x3b, 0x02, 0x99, 0x59, 0x74, 0xf8, 0x22, 0x84, 0xb7, 0x89, 0x64, 0xb2, 0x81,
+		0xc3, 0xc8, 0x8d, 0xe1, 0xf8, 0x05, 0x1f, 0xb9, 0x4b, 0xc1, 0x17, 0x20, 0x61, 0x60, 0xcb, 0xc6,
+		0x6d, 0xa6, 0x45, 0xc1, 0x19, 0xf5, 0x10, 0x18, 0xd2, 0xa8, 0x1a, 0x86, 0xee, 0x4a, 0x0d, 0x5f,
+		0x80, 0x8c, 0xc3, 0x92, 0xda, 0xd7, 0x8c, 0x1d, 0xa1, 0xcf, 0x67, 0xa7, 0x71, 0xb2, 0x5c, 0x11,
+		0x78, 0x0a, 0x41, 0x53, 0xd2, 0xd8, 0xf7, 0x8d, 0xca, 0x00, 0xa6, 0x81, 0xcd, 0x6d, 0xb5, 0x8d,
+		0x5b, 0x9d, 0x6c, 0x6c, 0x82, 0x94, 0xea, 0x04, 0x64, 0x44, 0x4a, 0x26, 0x2b, 0x6d, 0x75, 0xd0,
+		0x05, 0x57, 0x3d, 0xa3, 0x13, 0xb4, 0x6b, 0x9d, 0x4d, 0xcc, 0x11, 0x0d, 0xdd, 0x84, 0x74, 0x1f,
+		0x93, 0xb9, 0x82, 0xdb, 0xbc, 0x67, 0x71, 0xca, 0xc4, 0xf2, 0xd4, 0x9e, 0x29, 0x1c, 0x8d, 0x75,
+		0x2c, 0xd5, 0xf7, 0x7e, 0xa2, 0xfb, 0xc1, 0x29, 0x50, 0xa9, 0x5a, 0x01, 0xb5, 0x34, 0x49, 0x51,
+		0x58, 0x23, 0xea, 0x55, 0x00, 0xb8, 0xa5, 0x5b, 0xfa, 0x96, 0xde, 0xd1, 0x6d, 0x62, 0xb6, 0x88,
+		0xf6, 0xde, 0x37, 0x3a, 0x2f, 0xf6, 0xbb, 0x5b, 0x66, 0xe7, 0xba, 0x03, 0xa8, 0x78, 0x90, 0x72,
+		0x2f, 0x43, 0xda, 0x2f, 0x61, 0xb4, 0x08, 0x61, 0xcb, 0xd6, 0xfa, 0x36, 0x55, 0xe4, 0xb0, 0xc2,
+		0x3e, 0x90, 0x0c, 0x41, 0x6c, 0xb4, 0xa9, 0x25, 0x0e, 0x2b, 0xe4, 0x4f, 0xf4, 0xf7, 0x5c, 0x99,
+		0x05, 0xa9, 0xcc, 0x1e, 0x1c, 0x55, 0x0a, 0x1f, 0xe5, 0x61, 0xd1, 0xe5, 0x9e, 0x86, 0x94, 0x4f,
+		0x06, 0xb3, 0x36, 0x9d, 0xff, 0x8d, 0x10, 0x1c, 0x19, 0x4b, 0x1b, 0xbd, 0x00, 0x8b, 0x03, 0x43,
+		0x37, 0x6c, 0xdc, 0xef, 0xf5, 0x31, 0xd1, 0x7a, 0xd6, 0x56, 0xf6, 0x73, 0xd1, 0x09, 0x7a, 0xbb,
+		0xe9, 0x85, 0x66, 0x54, 0x94, 0x85, 0xc1, 0x68, 0x21, 0xba, 0x01, 0x09, 0xa2, 0x62, 0x5a, 0x5f,
+		0xa3, 0x04, 0xd9, 0x84, 0x5e, 0x99, 0xad, 0xcb, 0xcb, 0x65, 0x17, 0xb3, 0x18, 0x7c, 0xaf, 0x14,
+		0x50, 0xbc, 0xb4, 0xd0, 0xd3, 0x10, 0xdb, 0xc6, 0x9a, 0x3d, 0xe8, 0x63, 0x2b, 0xbb, 0x42, 0x45,
+		0x79, 0x62, 0x74, 0x9e, 0x33, 0x80, 0x06, 0xb6, 0x15, 0x07, 0x18, 0x75, 0x21, 0x79, 0x0b, 0xf7,
+		0xf5, 0x6d, 0xbd, 0xc5, 0x98, 0x0a, 0x52, 0x0d, 0x78, 0x66, 0x46, 0xa6, 0xae, 0x7b, 0x50, 0x1b,
+		0xb6, 0x66, 0xe3, 0x55, 0xd8, 0xac, 0x5d, 0xaf, 0x28, 0xd5, 0x4b, 0xd5, 0x4a, 0x99, 0xb1, 0xe9,
+		0x23, 0x9f, 0xfb, 0x4e, 0x09, 0x12, 0x9e, 0x9e, 0x10, 0x8b, 0x6a, 0x0c, 0xba, 0x5b, 0xb8, 0xcf,
+		0xc7, 0x8b, 0x7f, 0xa1, 0x13, 0x10, 0xdf, 0x1e, 0x74, 0x3a, 0x4c, 0x6f, 0xd9, 0xda, 0x1d, 0x23,
+		0x05, 0x54, 0x67, 0x11, 0x84, 0xb8, 0x25, 0xa2, 0x66, 0x92, 0xfc, 0x8d, 0x72, 0x10, 0x13, 0x7a,
+		0x9d, 0x0d, 0x2f, 0x49, 0xa7, 0x63, 0x8a, 0xf3, 0xcd, 0xea, 0x7a, 0x58, 0xb3, 0x71, 0x3b, 0x1b,
+		0x11, 0x75, 0xec, 0xfb, 0x6a, 0x28, 0x16, 0x92, 0xc3, 0xf9, 0xa7, 0x60, 0x7e, 0xa4, 0x2b, 0x28,
+		0x03, 0x89, 0x72, 0xa5, 0xb4, 0x56, 0x50, 0x0a, 0xcd, 0x6a, 0xbd, 0x26, 0xcf, 0xa1, 0x34, 0x78,
+		0x7a, 0x27, 0x4b, 0x67, 0xe2, 0xb1, 0xd7, 0xa2, 0xf2, 0x3b, 0xdf, 0xf9, 0xce, 0x77, 0x06, 0xf2,
+		0xbf, 0x1c, 0x81, 0xc5, 0x71, 0x76, 0x74, 0xac, 0x49, 0x77, 0x3b, 0x1d, 0xf4, 0x75, 0xba, 0x00,
+		0xe1, 0x8e, 0xb6, 0x85, 0x3b, 0xd9, 0x10, 0x1d, 0x84, 0x47, 0x66, 0xb2, 0xd4, 0xcb, 0x6b, 0x04,
+		0x45, 0x61, 0x98, 0xe8, 0x2d, 0x5c, 0x34, 0x61, 0x4a, 0xe1, 0xcc, 0x6c, 0x14, 0x88, 0x7d, 0xe5,
+		0x62, 0x3c, 0x01, 0x71, 0xf2, 0x3f, 0x93, 0x7b, 0x84, 0xc9, 0x9d, 0x14, 0x50, 0xb9, 0xe7, 0x20,
+		0x46, 0x4d, 0x67, 0x1b, 0x3b, 0x63, 0x22, 0xbe, 0x89, 0xb1, 0x69, 0xe3, 0x6d, 0x6d, 0xd0, 0xb1,
+		0xd5, 0x5b, 0x5a, 0x67, 0x80, 0xa9, 0x11, 0x8c, 0x2b, 0x49, 0x5e, 0x78, 0x9d, 0x94, 0xa1, 0x53,
+		0x90, 0x60, 0x96, 0x56, 0x37, 0xda, 0x78, 0x8f, 0xae, 0xc2, 0x61, 0x85, 0x19, 0xdf, 0x2a, 0x29,
+		0x21, 0xcd, 0xbf, 0x64, 0x99, 0x86, 0x30, 0x57, 0xb4, 0x09, 0x52, 0x40, 0x9b, 0x7f, 0x7a, 0xd8,
+		0x01, 0xb8, 0x77, 0x7c, 0xf7, 0x46, 0xec, 0xeb, 0x43, 0x90, 0xa1, 0x10, 0x4f, 0xf2, 0xa9, 0xac,
+		0x75, 0xb2, 0xf3, 0x54, 0x0d, 0xd2, 0xac, 0xb8, 0xce, 0x4b, 0xf3, 0x3f, 0x13, 0x80, 0x10, 0x5d,
+		0x6c, 0x32, 0x90, 0x68, 0xde, 0xd8, 0xa8, 0xa8, 0xe5, 0xfa, 0x66, 0x71, 0xad, 0x22, 0x4b, 0x64,
+		0xe8, 0x69, 0xc1, 0xa5, 0xb5, 0x7a, 0xa1, 0x29, 0x07, 0x9c, 0xef, 0x6a, 0xad, 0x79, 0xfe, 0x29,
+		0x39, 0xe8, 0x20, 0x6c, 0xb2, 0x82, 0x90, 0x17, 0xe0, 0xc9, 0x15, 0x39, 0x8c, 0x64, 0x48, 0x32,
+		0x02, 0xd5, 0x17, 0x2a, 0xe5, 0xf3, 0x4f, 0xc9, 0x11, 0x7f, 0xc9, 0x93, 0x2b, 0x72, 0x14, 0xa5,
+		0x20, 0x4e, 0x4b, 0x8a, 0xf5, 0xfa, 0x9a, 0x1c, 0x73, 0x68, 0x36, 0x9a, 0x4a, 0xb5, 0x76, 0x59,
+		0x8e, 0x3b, 0x34, 0x2f, 0x2b, 0xf5, 0xcd, 0x0d, 0x19, 0x1c, 0x0a, 0xeb, 0x95, 0x46, 0xa3, 0x70,
+		0xb9, 0x22, 0x27, 0x1c, 0x88, 0xe2, 0x8d, 0x66, 0xa5, 0x21, 0x27, 0x7d, 0x6c, 0x3d, 0xb9, 0x22,
+		0xa7, 0x9c, 0x26, 0x2a, 0xb5, 0xcd, 0x75, 0x39, 0x8d, 0xe6, 0x21, 0xc5, 0x9a, 0x10, 0x4c, 0x64,
+		0x86, 0x8a, 0xce, 0x3f, 0x25, 0xcb, 0x2e, 0x23, 0x8c, 0xca, 0xbc, 0xaf, 0xe0, 0xfc, 0x53, 0x32,
+		0xca, 0x97, 0x20, 0x4c, 0xd5, 0x10, 0x21, 0x48, 0xaf, 0x15, 0x8a, 0x95, 0x35, 0xb5, 0xbe, 0x41,
+		0x26, 0x4d, 0x61, 0x4d, 0x96, 0xdc, 0x32, 0xa5, 0xb2, 0x51, 0x29, 0x34, 0x2b, 0x65, 0x39, 0xe8,
+		0x2d, 0x7b, 0xeb, 0x66, 0x55, 0xa9, 0x94, 0xe5, 0x40, 0xbe, 0x05, 0x8b, 0xe3, 0x16, 0xd9, 0xb1,
+		0x53, 0xc8, 0xa3, 0x0b, 0x81, 0x09, 0xba, 0x40, 0x69, 0x0d, 0xeb, 0x42, 0xfe, 0xa3, 0x41, 0x58,
+		0x18, 0xe3, 0x68, 0x8c, 0x6d, 0xe4, 0x39, 0x08, 0x33, 0x5d, 0x66, 0x96, 0xfa, 0xe1, 0xb1, 0x1e,
+		0x0b, 0xd5, 0xec, 0x11, 0xf7, 0x8b, 0xe2, 0x79, 0x5d, 0xd6, 0xe0, 0x04, 0x97, 0x95, 0x90, 0x18,
+		0x51, 0xd8, 0xaf, 0x1f, 0x71, 0x08, 0x98, 0xcf, 0x74, 0x7e, 0x16, 0x9f, 0x89, 0x96, 0x1d, 0xce,
+		0x31, 0x08, 0x4f, 0x75, 0x0c, 0x22, 0x77, 0xe3, 0x18, 0x5c, 0x84, 0xf9, 0x11, 0x5e, 0x66, 0x5e,
+		0xa0, 0xdf, 0x2d, 0x41, 0x76, 0x92, 0x7c, 0xa7, 0x58, 0xd5, 0x80, 0xcf, 0xaa, 0x5e, 0x1c, 0x1e,
+		0x84, 0xfb, 0x26, 0x8f, 0xe3, 0x88, 0xba, 0xfc, 0xb0, 0x04, 0x47, 0xc7, 0x47, 0x37, 0x63, 0x79,
+		0x78, 0x0b, 0x44, 0xba, 0xd8, 0xde, 0x35, 0x85, 0xb7, 0xfe, 0xe0, 0x18, 0x1f, 0x90, 0x54, 0x0f,
+		0xeb, 0x0b, 0xc7, 0xf2, 0x3a, 0x91, 0xc1, 0x49, 0x21, 0x0a, 0xe3, 0x66, 0x84, 0xd3, 0x6f, 0x09,
+		0xc0, 0x91, 0xb1, 0xc4, 0xc7, 0x32, 0x7a, 0x2f, 0x80, 0x6e, 0xf4, 0x06, 0x36, 0xf3, 0xc8, 0x99,
+		0x31, 0x8f, 0xd3, 0x12, 0x6a, 0xff, 0x88, 0xa1, 0x1e, 0xd8, 0x4e, 0x3d, 0x5b, 0x68, 0x81, 0x15,
+		0x51, 0x80, 0x67, 0x5c, 0x46, 0x43, 0x94, 0xd1, 0x93, 0x13, 0x7a, 0x3a, 0xa2, 0xdb, 0x8f, 0x83,
+		0xdc, 0xea, 0xe8, 0xd8, 0xb0, 0x55, 0xcb, 0xee, 0x63, 0xad, 0xab, 0x1b, 0x3b, 0x6c, 0xc1, 0x5e,
+		0x0d, 0x6f, 0x6b, 0x1d, 0x0b, 0x2b, 0x19, 0x56, 0xdd, 0x10, 0xb5, 0x04, 0x83, 0x2a, 0x50, 0xdf,
+		0x83, 0x11, 0xf1, 0x61, 0xb0, 0x6a, 0x07, 0x23, 0xff, 0x13, 0x71, 0x48, 0x78, 0x62, 0x41, 0x74,
+		0x1f, 0x24, 0x5f, 0xd2, 0x6e, 0x69, 0xaa, 0x48, 0x06, 0x30, 0x49, 0x24, 0x48, 0xd9, 0x06, 0x4f,
+		0x08, 0x3c, 0x0e, 0x8b, 0x14, 0xc4, 0x1c, 0xd8, 0xb8, 0xaf, 0xb6, 0x3a, 0x9a, 0x65, 0x51, 0xa1,
+		0xc5, 0x28, 0x28, 0x22, 0x75, 0x75, 0x52, 0x55, 0x12, 0x35, 0xe8, 0x1c, 0x2c, 0x50, 0x8c, 0xee,
+		0xa0, 0x63, 0xeb, 0xbd, 0x0e, 0x56, 0xb7, 0xf5, 0x0e, 0xb6, 0xe8, 0xaa, 0xe5, 0x70, 0x36, 0x4f,
+		0x20, 0xd6, 0x39, 0x00, 0xe1, 0xc8, 0x42, 0x65, 0xb8, 0x97, 0xa2, 0xed, 0x60, 0x03, 0xf7, 0x35,
+		0x1b, 0xab, 0xf8, 0x1d, 0x03, 0xad, 0x63, 0xa9, 0x9a, 0xd1, 0x56, 0x77, 0x35, 0x6b, 0x37, 0xbb,
+		0x48, 0x08, 0x14, 0x03, 0x59, 0x49, 0x39, 0x4e, 0x00, 0x2f, 0x73, 0xb8, 0x0a, 0x05, 0x2b, 0x18,
+		0xed, 0x2b, 0x9a, 0xb5, 0x8b, 0x56, 0xe1, 0x28, 0xa5, 0x62, 0xd9, 0x7d, 0xdd, 0xd8, 0x51, 0x5b,
+		0xbb, 0xb8, 0x75, 0x53, 0x1d, 0xd8, 0xdb, 0xcf, 0x64, 0x4f, 0x78, 0xdb, 0xa7, 0x1c, 0x36, 0x28,
+		0x4c, 0x89, 0x80, 0x6c, 0xda, 0xdb, 0xcf, 0xa0, 0x06, 0x24, 0xc9, 0x60, 0x74, 0xf5, 0x97, 0xb1,
+		0xba, 0x6d, 0xf6, 0xe9, 0x32, 0x9c, 0x1e, 0x63, 0xdd, 0x3c, 0x12, 0x5c, 0xae, 0x73, 0x84, 0x75,
+		0xb3, 0x8d, 0x57, 0xc3, 0x8d, 0x8d, 0x4a, 0xa5, 0xac, 0x24, 0x04, 0x95, 0x4b, 0x66, 0x9f, 0x28,
+		0xd4, 0x8e, 0xe9, 0x08, 0x38, 0xc1, 0x14, 0x6a, 0xc7, 0x14, 0xe2, 0x3d, 0x07, 0x0b, 0xad, 0x16,
+		0xeb, 0xb3, 0xde, 0x52, 0x79, 0x5e, 0xc0, 0xca, 0xca, 0x3e, 0x61, 0xb5, 0x5a, 0x97, 0x19, 0x00,
+		0xd7, 0x71, 0x0b, 0x5d, 0x80, 0x23, 0xae, 0xb0, 0xbc, 0x88, 0xf3, 0x23, 0xbd, 0x1c, 0x46, 0x3d,
+		0x07, 0x0b, 0xbd, 0xfd, 0x51, 0x44, 0xe4, 0x6b, 0xb1, 0xb7, 0x3f, 0x8c, 0xf6, 0x00, 0x4d, 0x0c,
+		0xf5, 0x71, 0x8b, 0x7a, 0x8b, 0xc7, 0xbc, 0xd0, 0x9e, 0x0a, 0xb4, 0x0c, 0x72, 0xab, 0xa5, 0x62,
+		0x43, 0xdb, 0xea, 0x60, 0x55, 0xeb, 0x63, 0x43, 0xb3, 0xb2, 0xa7, 0x28, 0x70, 0xc8, 0xee, 0x0f,
+		0xb0, 0x92, 0x6e, 0xb5, 0x2a, 0xb4, 0xb2, 0x40, 0xeb, 0xd0, 0x19, 0x98, 0x37, 0xb7, 0x5e, 0x6a,
+		0x31, 0xc5, 0x52, 0x7b, 0x7d, 0xbc, 0xad, 0xef, 0x65, 0xdf, 0x44, 0xa5, 0x94, 0x21, 0x15, 0x54,
+		0xad, 0x36, 0x68, 0x31, 0x7a, 0x18, 0xe4, 0x96, 0xb5, 0xab, 0xf5, 0x7b, 0xd4, 0x38, 0x5b, 0x3d,
+		0xad, 0x85, 0xb3, 0x0f, 0x30, 0x50, 0x56, 0x5e, 0x13, 0xc5, 0x44, 0xb1, 0xad, 0xdb, 0xfa, 0xb6,
+		0x2d, 0x28, 0x3e, 0xc4, 0x14, 0x9b, 0x96, 0x71, 0x6a, 0xa7, 0x41, 0xee, 0xed, 0xf6, 0xfc, 0x0d,
+		0x9f, 0xa6, 0x60, 0xe9, 0xde, 0x6e, 0xcf, 0xdb, 0xee, 0xfd, 0x90, 0x22, 0x90, 0x6e, 0xa3, 0x0f,
+		0x33, 0x17, 0xae, 0xb7, 0xeb, 0x69, 0xf1, 0x29, 0x38, 0x4a, 0x80, 0xba, 0xd8, 0xd6, 0xda, 0x9a,
+		0xad, 0x79, 0xa0, 0x1f, 0xa5, 0xd0, 0x8b, 0xbd, 0xdd, 0xde, 0x3a, 0xaf, 0xf4, 0xf1, 0xd9, 0x1f,
+		0x6c, 0xed, 0x3b, 0xfa, 0xf1, 0x18, 0xe3, 0x93, 0x94, 0x09, 0x0d, 0xb9, 0xeb, 0x08, 0xe6, 0x6b,
+		0x16, 0xaf, 0xe5, 0x57, 0x21, 0xe9, 0xd5, 0x7b, 0x14, 0x07, 0xa6, 0xf9, 0xb2, 0x44, 0xfc, 0xa8,
+		0x52, 0xbd, 0x4c, 0x3c, 0xa0, 0x17, 0x2b, 0x72, 0x80, 0x78, 0x62, 0x6b, 0xd5, 0x66, 0x45, 0x55,
+		0x36, 0x6b, 0xcd, 0xea, 0x7a, 0x45, 0x0e, 0x7a, 0x62, 0x83, 0xab, 0xa1, 0xd8, 0x19, 0xf9, 0x91,
+		0xab, 0xa1, 0xd8, 0x83, 0xf2, 0x43, 0x54, 0x3c, 0x23, 0x4a, 0x99, 0xff, 0x52, 0x10, 0xd2, 0xfe,
+		0xe4, 0x00, 0x7a, 0x33, 0x1c, 0x13, 0xd9, 0x3f, 0x0b, 0xdb, 0xea, 0x6d, 0xbd, 0x4f, 0x27, 0x6b,
+		0x57, 0x63, 0x0b, 0xa7, 0xa3, 0x94, 0x8b, 0x1c, 0xaa, 0x81, 0xed, 0xe7, 0xf5, 0x3e, 0x99, 0x8a,
+		0x5d, 0xcd, 0x46, 0x6b, 0x70, 0xca, 0x30, 0x55, 0xcb, 0xd6, 0x8c, 0xb6, 0xd6, 0x6f, 0xab, 0x6e,
+		0xca, 0x56, 0xd5, 0x5a, 0x2d, 0x6c, 0x59, 0x26, 0x5b, 0x24, 0x1d, 0x2a, 0xf7, 0x18, 0x66, 0x83,
+		0x03, 0xbb, 0xab, 0x47, 0x81, 0x83, 0x0e, 0xcd, 0x89, 0xe0, 0xa4, 0x39, 0x71, 0x02, 0xe2, 0x5d,
+		0xad, 0xa7, 0x62, 0xc3, 0xee, 0xef, 0x53, 0xf7, 0x3f, 0xa6, 0xc4, 0xba, 0x5a, 0xaf, 0x42, 0xbe,
+		0xd1, 0x75, 0x78, 0xd0, 0x05, 0x55, 0x3b, 0x78, 0x47, 0x6b, 0xed, 0xab, 0xd4, 0xd7, 0xa7, 0x99,
+		0x2a, 0xb5, 0x65, 0x1a, 0xdb, 0x1d, 0xbd, 0x65, 0x5b, 0xd4, 0x76, 0x30, 0xfb, 0x97, 0x77, 0x31,
+		0xd6, 0x28, 0xc2, 0x55, 0xcb, 0x34, 0xa8, 0x8b, 0x5f, 0x12, 0xd0, 0x3e, 0xb5, 0x49, 0xbe, 0x21,
+		0xd4, 0xc6, 0x3f, 0xf4, 0x21, 0x39, 0x7c, 0x35, 0x14, 0x0b, 0xcb, 0x91, 0xab, 0xa1, 0x58, 0x44,
+		0x8e, 0x5e, 0x0d, 0xc5, 0x62, 0x72, 0xfc, 0x6a, 0x28, 0x16, 0x97, 0x21, 0xff, 0xe1, 0x14, 0x24,
+		0xbd, 0x11, 0x0b, 0x09, 0x00, 0x5b, 0x74, 0xc1, 0x95, 0xa8, 0x49, 0xbe, 0xff, 0xc0, 0xf8, 0x66,
+		0xb9, 0x44, 0x56, 0xe2, 0xd5, 0x08, 0x0b, 0x0f, 0x14, 0x86, 0x49, 0xbc, 0x20, 0x32, 0xc9, 0x30,
+		0xf3, 0xa5, 0x62, 0x0a, 0xff, 0x42, 0x97, 0x21, 0xf2, 0x92, 0x45, 0x69, 0x33, 0x57, 0xee, 0x4d,
+		0x07, 0xd3, 0xbe, 0xda, 0xa0, 0xc4, 0xe3, 0x57, 0x1b, 0x6a, 0xad, 0xae, 0xac, 0x17, 0xd6, 0x14,
+		0x8e, 0x8e, 0x8e, 0x43, 0xa8, 0xa3, 0xbd, 0xbc, 0xef, 0x5f, 0xb3, 0x69, 0x11, 0x5a, 0x86, 0xcc,
+		0xc0, 0x60, 0xe1, 0x3e, 0x19, 0x63, 0x02, 0x95, 0xf1, 0x42, 0xa5, 0xdd, 0xda, 0x35, 0x02, 0x3f,
+		0xa3, 0x5e, 0xdd, 0x0b, 0xa1, 0xdb, 0x58, 0xbb, 0xe9, 0x5b, 0x59, 0xa9, 0x7e, 0xd0, 0x62, 0x74,
+		0x1a, 0x92, 0x6d, 0xbc, 0x35, 0xd8, 0x51, 0xfb, 0xb8, 0xad, 0xb5, 0x6c, 0xff, 0x9a, 0x92, 0xa0,
+		0x55, 0x0a, 0xad, 0x41, 0xd7, 0x20, 0x4e, 0xc6, 0xc9, 0xa0, 0xe3, 0x3c, 0x4f, 0xc5, 0xf0, 0xd8,
+		0xc1, 0x62, 0xe0, 0xc3, 0x2c, 0x90, 0x14, 0x17, 0x1f, 0x5d, 0x81, 0xa8, 0xad, 0xf5, 0x77, 0xb0,
+		0x6d, 0x65, 0x17, 0x96, 0x82, 0xa7, 0xd3, 0x63, 0xb2, 0x75, 0x63, 0x48, 0x35, 0x29, 0x0a, 0x0d,
+		0xb8, 0x05, 0x3a, 0x7a, 0x1e, 0x64, 0x9e, 0x14, 0x56, 0x79, 0xb4, 0x6c, 0x65, 0x17, 0xa9, 0x12,
+		0x3e, 0x7a, 0x30, 0x49, 0x9e, 0x53, 0x2e, 0x33, 0x24, 0x25, 0x83, 0x7d, 0xdf, 0xfe, 0xb9, 0x71,
+		0xe4, 0x30, 0x73, 0x63, 0x13, 0x32, 0xfc, 0x6f, 0xd5, 0x1a, 0xf4, 0x7a, 0x66, 0xdf, 0xce, 0x1e,
+		0xa5, 0xf8, 0x53, 0x18, 0x12, 0xc4, 0x18, 0x8e, 0x92, 0xde, 0xf6, 0x7d, 0x7f, 0xed, 0xa6, 0x5c,
+		0xee, 0x45, 0x48, 0xfb, 0x85, 0xe1, 0x4d, 0xc9, 0x07, 0x67, 0x4c, 0xc9, 0x93, 0xd0, 0x44, 0xc4,
+		0x7b, 0x64, 0x79, 0x62, 0x1f, 0xb9, 0xef, 0x0a, 0x40, 0xda, 0xdf, 0x31, 0x74, 0x19, 0x90, 0x18,
+		0x31, 0xdd, 0xb0, 0xfb, 0x66, 0x7b, 0xd0, 0xc2, 0x6d, 0x3e, 0x69, 0x27, 0xb7, 0x33, 0xcf, 0x71,
+		0xaa, 0x0e, 0x8a, 0x97, 0x90, 0x67, 0x26, 0x04, 0x66, 0x24, 0x54, 0x76, 0xe7, 0xc8, 0x59, 0x58,
+		0x10, 0x04, 0x08, 0xb1, 0xdb, 0x5a, 0xdf, 0x20, 0x6e, 0x32, 0x73, 0xdc, 0x91, 0xa7, 0xea, 0x79,
+		0x56, 0x83, 0x0a, 0x20, 0xd4, 0x45, 0xed, 0xe3, 0xae, 0x79, 0x0b, 0xb7, 0x79, 0xd6, 0x69, 0x72,
+		0xb3, 0x69, 0x8e, 0xa0, 0x30, 0xf8, 0xfc, 0x59, 0x08, 0x53, 0x13, 0x84, 0x00, 0xb8, 0x11, 0x92,
+		0xe7, 0x50, 0x0c, 0x42, 0xa5, 0xba, 0x42, 0x96, 0x48, 0x19, 0x92, 0xac, 0x54, 0xdd, 0xa8, 0x56,
+		0x4a, 0x15, 0x39, 0x90, 0x3f, 0x07, 0x11, 0x66, 0x57, 0xc8, 0xf2, 0xe9, 0x58, 0x16, 0x79, 0x8e,
+		0x7f, 0x72, 0x1a, 0x92, 0xa8, 0xdd, 0x5c, 0x2f, 0x56, 0x14, 0x39, 0x90, 0xdf, 0x84, 0xcc, 0xd0,
+		0x3c, 0x44, 0x47, 0x60, 0x5e, 0xa9, 0x34, 0x2b, 0xb5, 0x66, 0xb5, 0x5e, 0x53, 0x37, 0x6b, 0xd7,
+		0x6a, 0xf5, 0xe7, 0x6b, 0xf2, 0x9c, 0xbf, 0x58, 0xac, 0xc5, 0x12, 0x5a, 0x04, 0xd9, 0x2d, 0x6e,
+		0xd4, 0x37, 0x15, 0xca, 0xcd, 0xb7, 0x06, 0x40, 0x1e, 0x9e, 0x94, 0xe8, 0x18, 0x2c, 0x34, 0x0b,
+		0xca, 0xe5, 0x4a, 0x53, 0x65, 0x79, 0x13, 0x87, 0xf4, 0x22, 0xc8, 0xde, 0x8a, 0x4b, 0x55, 0x9a,
+		0x16, 0x3a, 0x05, 0x27, 0xbc, 0xa5, 0x95, 0x17, 0x9a, 0x95, 0x5a, 0x83, 0x36, 0x5e, 0xa8, 0x5d,
+		0x26, 0x8e, 0xc1, 0x10, 0x3d, 0x91, 0xa9, 0x09, 0x12, 0x56, 0xfd, 0xf4, 0x2a, 0x6b, 0x65, 0x39,
+		0x34, 0x5c, 0x5c, 0xaf, 0x55, 0xea, 0x97, 0xe4, 0xf0, 0x70, 0xeb, 0x34, 0x7b, 0x13, 0x41, 0x39,
+		0x38, 0x3a, 0x5c, 0xaa, 0x56, 0x6a, 0x4d, 0xe5, 0x86, 0x1c, 0x1d, 0x6e, 0xb8, 0x51, 0x51, 0xae,
+		0x57, 0x4b, 0x15, 0x39, 0x86, 0x8e, 0x02, 0xf2, 0x73, 0xd4, 0xbc, 0x52, 0x2f, 0xcb, 0xf1, 0x71,
+		0xab, 0x16, 0x92, 0x17, 0xf2, 0x3f, 0x2e, 0x41, 0xd2, 0x9b, 0x49, 0xf1, 0x19, 0x15, 0xe9, 0x8d,
+		0xb6, 0xe0, 0xe6, 0x7f, 0x33, 0x00, 0x09, 0x4f, 0x4a, 0x85, 0x04, 0xb2, 0x5a, 0xa7, 0x63, 0xde,
+		0x56, 0xb5, 0x8e, 0xae, 0x59, 0x7c, 0x4d, 0x04, 0x5a, 0x54, 0x20, 0x25, 0xb3, 0xae, 0x41, 0xb3,
+		0xbb, 0x2f, 0x91, 0xbb, 0x76, 0x5f, 0xa2, 0x6f, 0x40, 0xf7, 0x25, 0x2c, 0x47, 0xf2, 0xbf, 0x1d,
+		0x00, 0x79, 0x38, 0x43, 0x32, 0x24, 0x37, 0x69, 0x92, 0xdc, 0xbc, 0xfd, 0x0b, 0x1c, 0xa6, 0x7f,
+		0xc3, 0xab, 0x7a, 0x70, 0xe2, 0xaa, 0x3e, 0x66, 0xb1, 0x0a, 0xbd, 0x91, 0x17, 0x2b, 0xaf, 0xba,
+		0xfe, 0x27, 0x09, 0xd2, 0xfe, 0x84, 0x8e, 0x4f, 0x62, 0xf9, 0xc3, 0x48, 0xcc, 0x3f, 0x22, 0xf7,
+		0x4d, 0x1a, 0x91, 0xbf, 0x91, 0x7e, 0x7d, 0x4f, 0x10, 0x52, 0xbe, 0xfc, 0xcf, 0xac, 0xdc, 0xbd,
+		0x03, 0xe6, 0xf5, 0x36, 0xee, 0xf6, 0x4c, 0x1b, 0x1b, 0xad, 0x7d, 0xb5, 0x83, 0x6f, 0xe1, 0x0e,
+		0x15, 0x43, 0x7a, 0xcc, 0x3e, 0xaf, 0xaf, 0x85, 0xe5, 0xaa, 0x8b, 0xb7, 0x46, 0xd0, 0x56, 0x17,
+		0xaa, 0xe5, 0xca, 0xfa, 0x46, 0xbd, 0x59, 0xa9, 0x95, 0x6e, 0x08, 0x4b, 0xae, 0xc8, 0xfa, 0x10,
+		0x98, 0x4f, 0xe0, 0xf7, 0xbf, 0x31, 0x02, 0xcf, 0x0d, 0x90, 0x87, 0x7b, 0x43, 0x0c, 0xfa, 0x98,
+		0xfe, 0xc8, 0x73, 0x68, 0x01, 0x32, 0xb5, 0xba, 0xda, 0xa8, 0x96, 0x2b, 0x6a, 0xe5, 0xd2, 0xa5,
+		0x4a, 0xa9, 0xd9, 0x60, 0xfb, 0x15, 0x0e, 0x74, 0x53, 0x0e, 0x78, 0xc7, 0xe6, 0x7b, 0x83, 0xb0,
+		0x30, 0x86, 0x13, 0x54, 0xe0, 0x69, 0x42, 0x96, 0xb9, 0x7c, 0x6c, 0x16, 0xee, 0x97, 0x49, 0x84,
+		0xbf, 0xa1, 0xf5, 0x6d, 0x9e, 0x55, 0x7c, 0x18, 0x88, 0x78, 0x0d, 0x9b, 0xb8, 0xf8, 0x7d, 0xbe,
+		0x0f, 0xc4, 0x5c, 0x90, 0x8c, 0x5b, 0xce, 0xb6, 0x82, 0x1e, 0x05, 0xd4, 0x33, 0x2d, 0xdd, 0xd6,
+		0x6f, 0x61, 0xe2, 0x43, 0x71, 0x60, 0x32, 0x71, 0x43, 0x8a, 0x2c, 0x6a, 0xaa, 0x86, 0xed, 0x40,
+		0x1b, 0x78, 0x47, 0x1b, 0x82, 0x26, 0x21, 0x48, 0x50, 0x91, 0x45, 0x8d, 0x03, 0x7d, 0x1f, 0x24,
+		0xdb, 0xe6, 0x60, 0xab, 0x83, 0x39, 0x1c, 0x31, 0xc9, 0x92, 0x92, 0x60, 0x65, 0x0e, 0x08, 0x4f,
+		0x9d, 0xb9, 0xbb, 0x55, 0x49, 0x25, 0xc1, 0xca, 0x18, 0xc8, 0x43, 0x90, 0xd1, 0x76, 0x76, 0xfa,
+		0x84, 0xb8, 0x20, 0xc4, 0x92, 0x81, 0x69, 0xa7, 0x98, 0x02, 0xe6, 0xae, 0x42, 0x4c, 0xc8, 0x81,
+		0xc4, 0xc0, 0x44, 0x12, 0x6a, 0x8f, 0x65, 0xb8, 0x03, 0xa7, 0xe3, 0x4a, 0xcc, 0x10, 0x95, 0xf7,
+		0x41, 0x52, 0xb7, 0x54, 0xf7, 0x40, 0x46, 0x60, 0x29, 0x70, 0x3a, 0xa6, 0x24, 0x74, 0xcb, 0xd9,
+		0x5c, 0xcd, 0x7f, 0x5b, 0x06, 0xc0, 0x55, 0x36, 0xf4, 0xed, 0x12, 0xa4, 0xd9, 0x02, 0xd3, 0xeb,
+		0x63, 0x0b, 0x1b, 0x2d, 0x11, 0x1a, 0x3e, 0x7c, 0x80, 0x8a, 0x32, 0x33, 0xb7, 0xc1, 0x11, 0x8a,
+		0xcf, 0xbd, 0x57, 0x92, 0x5e, 0x95, 0x42, 0xaf, 0x4a, 0xd2, 0x47, 0xa4, 0x14, 0x8a, 0x55, 0x5e,
+		0xd8, 0x58, 0xab, 0x96, 0xaa, 0xcd, 0xec, 0x7b, 0xa2, 0xf4, 0xbb, 0xba, 0xce, 0xbf, 0x3f, 0x17,
+		0xf5, 0xd7, 0xbf, 0x16, 0xfd, 0xb8, 0x14, 0x8c, 0xbd, 0x16, 0x55, 0x52, 0xdb, 0x5e, 0x7a, 0xa8,
+		0xe3, 0x3d, 0xcb, 0x11, 0x98, 0x14, 0x4c, 0xba, 0xdc, 0x54, 0xf8, 0x09, 0x8e, 0xe2, 0xc3, 0x94,
+		0x91, 0x08, 0x65, 0x24, 0x81, 0x22, 0xa5, 0xb5, 0x7a, 0xa3, 0x52, 0xa6, 0x6c, 0xc4, 0x51, 0xa8,
+		0xbe, 0x51, 0xa9, 0x65, 0x3f, 0x27, 0x9a, 0x74, 0x8f, 0x7d, 0xbc, 0x2a, 0xc1, 0x31, 0xb1, 0x59,
+		0xcb, 0xd7, 0x5a, 0x6c, 0xb4, 0xcc, 0xb6, 0xf0, 0x6e, 0xd3, 0x2b, 0x4f, 0x1c, 0xd4, 0xb8, 0xc2,
+		0x51, 0xa9, 0x48, 0x2a, 0x1c, 0xb1, 0xf8, 0xd8, 0x88, 0x48, 0x0a, 0xb5, 0x32, 0xe7, 0x25, 0x81,
+		0x22, 0x1b, 0x85, 0xd2, 0xb5, 0x4a, 0xd9, 0xe5, 0xe6, 0x48, 0x7f, 0x1c, 0x15, 0xf4, 0x8d, 0x90,
+		0x19, 0xd8, 0xdb, 0xcf, 0x10, 0xdd, 0xd0, 0xdb, 0x6c, 0xf7, 0x3c, 0x34, 0x69, 0xdb, 0xd5, 0xe5,
+		0x68, 0xd3, 0xde, 0x7e, 0xe6, 0xba, 0x83, 0xc1, 0x85, 0xc2, 0x58, 0x89, 0xa3, 0x50, 0xad, 0x5e,
+		0xab, 0x08, 0x36, 0xe8, 0x4e, 0xf3, 0x0d, 0x97, 0x8d, 0xf4, 0xc0, 0x87, 0x8a, 0xbe, 0x11, 0x64,
+		0x91, 0x22, 0x72, 0x44, 0x12, 0x9e, 0xb4, 0x73, 0xec, 0x32, 0xc0, 0x13, 0x4d, 0x8e, 0x30, 0x1e,
+		0xf4, 0x70, 0xb0, 0x88, 0x32, 0x6b, 0x95, 0xda, 0xe5, 0xe6, 0x15, 0x75, 0x43, 0xa9, 0xd0, 0x0d,
+		0xc0, 0xec, 0x7b, 0x44, 0xf3, 0x99, 0xae, 0x1f, 0x11, 0x7d, 0x93, 0x04, 0x09, 0xe6, 0x02, 0xb1,
+		0xbc, 0x14, 0x4b, 0x2c, 0x3c, 0x78, 0x50, 0xdb, 0xd4, 0x03, 0xa2, 0xd0, 0xc5, 0x0b, 0xb4, 0xd9,
+		0xa0, 0x50, 0x88, 0x63, 0x08, 0xad, 0x55, 0x2e, 0x17, 0x4a, 0x37, 0xd4, 0x62, 0xa5, 0xd1, 0x24,
+		0x96, 0xac, 0xae, 0x30, 0x1d, 0x05, 0x14, 0x2e, 0xac, 0xad, 0xd5, 0x9f, 0x77, 0x05, 0x01, 0x2f,
+		0x39, 0x64, 0xd0, 0x8f, 0x49, 0xb0, 0x88, 0x8d, 0x6d, 0xb3, 0xdf, 0xa2, 0x9b, 0xd6, 0x64, 0x46,
+		0x5b, 0xf6, 0x7e, 0x87, 0xcd, 0xe8, 0xb1, 0x41, 0xb9, 0x57, 0x33, 0x29, 0x5e, 0x8d, 0xa2, 0x35,
+		0x08, 0x56, 0xb1, 0xfa, 0x5e, 0x29, 0xf0, 0x2a, 0x61, 0x2c, 0x40, 0x79, 0x0b, 0xbd, 0x2a, 0x85,
+		0x29, 0x87, 0xd1, 0x57, 0xa5, 0xd8, 0xab, 0x52, 0xfc, 0x23, 0xd2, 0x3c, 0x4a, 0x36, 0x9a, 0x37,
+		0xd6, 0x2a, 0x2a, 0xe3, 0x96, 0x72, 0x98, 0x46, 0x71, 0x5a, 0xb6, 0xf2, 0xf8, 0xca, 0x53, 0xd9,
+		0xcf, 0x53, 0x2e, 0x3f, 0x1f, 0x55, 0x10, 0x1e, 0x21, 0x8f, 0x7e, 0x5e, 0x82, 0xe3, 0x62, 0x9b,
+		0xdc, 0xa2, 0x5b, 0x67, 0xaa, 0x67, 0x93, 0x2d, 0x46, 0x59, 0xae, 0x1c, 0xc4, 0xb2, 0xbb, 0xd3,
+		0xc6, 0x0b, 0x97, 0x79, 0xc0, 0x3b, 0xbc, 0x11, 0x57, 0x3c, 0xcf, 0x7a, 0xf2, 0x11, 0x29, 0x83,
+		0xa0, 0xf2, 0xc2, 0x46, 0x5d, 0x69, 0xaa, 0x85, 0xb5, 0x35, 0xca, 0xef, 0x11, 0x24, 0xf3, 0x92,
+		0x66, 0x7d, 0x43, 0x5d, 0xab, 0x5c, 0xaf, 0xac, 0xb9, 0x6c, 0x1f, 0x6b, 0x8f, 0x27, 0x98, 0xfb,
+		0xb0, 0x04, 0xf3, 0x23, 0xcd, 0xe7, 0xdf, 0x25, 0xc1, 0xb1, 0x09, 0x2c, 0xa0, 0x07, 0xe0, 0xbe,
+		0x72, 0xe5, 0x52, 0x61, 0x73, 0xad, 0xa9, 0x36, 0x6e, 0xac, 0x17, 0xeb, 0x6b, 0xea, 0xf5, 0x6a,
+		0xa3, 0x5a, 0xac, 0xae, 0x55, 0x9b, 0xde, 0x05, 0x2c, 0x0d, 0x1e, 0x06, 0x59, 0xb8, 0x36, 0xcc,
+		0x9e, 0x1c, 0x20, 0x41, 0xe1, 0x5a, 0xbd, 0x54, 0x58, 0xa3, 0x40, 0x41, 0x11, 0x73, 0x96, 0x9a,
+		0x72, 0xe8, 0x6a, 0x2c, 0x26, 0xf1, 0xb5, 0xed, 0xeb, 0x20, 0xe5, 0x33, 0x7e, 0x24, 0x44, 0xa2,
+		0xa1, 0x15, 0xd1, 0xe7, 0x46, 0xa5, 0x56, 0xf2, 0x86, 0x74, 0x49, 0x70, 0x8c, 0x9d, 0x2c, 0x91,
+		0x2f, 0x61, 0x0a, 0xe5, 0x00, 0x59, 0x54, 0xb9, 0x3a, 0x3a, 0x1b, 0xd4, 0xc1, 0xfc, 0xd3, 0x10,
+		0x13, 0xc6, 0x8c, 0x04, 0x6a, 0x34, 0xde, 0x1a, 0x0a, 0x13, 0x63, 0x40, 0x2d, 0x99, 0x2c, 0x11,
+		0x06, 0x99, 0x85, 0x93, 0x03, 0xf9, 0xeb, 0x70, 0x64, 0xac, 0x21, 0x42, 0xf7, 0xc3, 0x29, 0xb1,
+		0x29, 0xce, 0x42, 0x40, 0xb5, 0x52, 0x2b, 0xd5, 0xcb, 0x24, 0x68, 0x76, 0x69, 0x02, 0x70, 0x8b,
+		0xc4, 0xb8, 0x14, 0xd6, 0x4a, 0x0e, 0xe4, 0xab, 0x90, 0xf6, 0x9b, 0x13, 0x74, 0x02, 0x8e, 0x6d,
+		0x36, 0x2f, 0x3d, 0xa3, 0x5e, 0x2f, 0xac, 0x55, 0xcb, 0x85, 0xa1, 0xf0, 0x18, 0x80, 0xdb, 0x14,
+		0x39, 0x40, 0x18, 0x25, 0xb6, 0x46, 0x0e, 0xe6, 0x43, 0x31, 0x49, 0x96, 0xf2, 0x0d, 0xc8, 0x0c,
+		0x19, 0x06, 0x74, 0x0f, 0x64, 0x79, 0xbc, 0x3a, 0x8e, 0x2b, 0x2a, 0x21, 0x9f, 0xa9, 0x60, 0x91,
+		0x7b, 0xb9, 0xb2, 0x56, 0x5d, 0xaf, 0x36, 0x29, 0x7f, 0x57, 0x00, 0xdc, 0x19, 0x4f, 0x3c, 0x98,
+		0xab, 0x8d, 0x7a, 0x4d, 0xbd, 0x44, 0xc2, 0xfe, 0xa6, 0x87, 0x54, 0x1c, 0xd8, 0x0c, 0x97, 0x25,
+		0x12, 0x9d, 0x8e, 0x9a, 0x01, 0x39, 0x90, 0x7f, 0x1e, 0xd0, 0xe8, 0x6c, 0x45, 0x4b, 0x70, 0x4f,
+		0xa5, 0x76, 0xa9, 0xae, 0x94, 0x2a, 0x6a, 0xad, 0xb0, 0x4e, 0xf8, 0x63, 0x73, 0xd3, 0x25, 0x9d,
+		0x02, 0x77, 0x6a, 0x8a, 0x9c, 0x84, 0x3b, 0x7b, 0xe5, 0xc0, 0x99, 0x08, 0x71, 0x8c, 0xde, 0x57,
+		0x3b, 0x13, 0x89, 0xbd, 0xaf, 0x26, 0x7f, 0x80, 0xfc, 0xff, 0x81, 0x9a, 0xfc, 0xed, 0xb5, 0xab,
+		0x91, 0xd8, 0xe7, 0xa2, 0xf2, 0x6b, 0xd1, 0xfc, 0x9f, 0x04, 0x01, 0xb9, 0xf3, 0xcf, 0x49, 0xad,
+		0xbd, 0x00, 0x31, 0x27, 0x57, 0xc7, 0x4e, 0x34, 0xbf, 0xf9, 0x80, 0x69, 0x2b, 0xd0, 0x3c, 0x45,
+		0x43, 0xb9, 0x3b, 0x87, 0x1a, 0x2a, 0x40, 0xa6, 0xab, 0x1b, 0x7a, 0x77, 0xd0, 0x55, 0x45, 0x02,
+		0x6b, 0x6a, 0x62, 0x86, 0x23, 0xf0, 0x6f, 0x4a, 0x42, 0xdb, 0xf3, 0x91, 0x08, 0x4f, 0x25, 0xc1,
+		0x10, 0xf8, 0x77, 0xee, 0xaf, 0x24, 0xc8, 0x4e, 0x62, 0xf6, 0xae, 0x72, 0x6b, 0x35, 0x58, 0x34,
+		0x6f, 0xe1, 0x7e, 0x5f, 0x6f, 0xd3, 0x2d, 0x33, 0xc7, 0xe3, 0x0e, 0x4d, 0xf7, 0xb8, 0x17, 0x3c,
+		0x88, 0x97, 0x84, 0xf3, 0x5d, 0x24, 0x8e, 0xd1, 0x1e, 0xf1, 0x09, 0x04, 0xa5, 0xf0, 0x74, 0x4a,
+		0x29, 0x8a, 0x22, 0x68, 0x5c, 0x25, 0x9a, 0x4f, 0x82, 0xdc, 0x80, 0x1c, 0x74, 0xdd, 0xfa, 0xfc,
+		0x4f, 0x07, 0x20, 0xed, 0x3f, 0x07, 0x8c, 0xca, 0x10, 0xeb, 0x98, 0xfc, 0x80, 0x1c, 0x1b, 0xed,
+		0xd3, 0x53, 0x8e, 0x0e, 0x2f, 0xaf, 0x71, 0x78, 0xc5, 0xc1, 0xcc, 0xfd, 0x47, 0x09, 0x62, 0xa2,
+		0x18, 0x1d, 0x85, 0x50, 0x4f, 0xb3, 0x77, 0x29, 0xb9, 0x70, 0x31, 0x20, 0x4b, 0x0a, 0xfd, 0x26,
+		0xe5, 0x56, 0x4f, 0x63, 0x87, 0x03, 0x79, 0x39, 0xf9, 0x26, 0xae, 0x75, 0x07, 0x6b, 0x6d, 0xba,
+		0xd9, 0x6b, 0x76, 0xbb, 0xd8, 0xb0, 0x2d, 0xe1, 0x5a, 0xf3, 0xf2, 0x12, 0x2f, 0x46, 0x8f, 0xc0,
+		0xbc, 0xdd, 0xd7, 0xf4, 0x8e, 0x0f, 0x36, 0x44, 0x61, 0x65, 0x51, 0xe1, 0x00, 0xaf, 0xc2, 0x71,
+		0x41, 0xb7, 0x8d, 0x6d, 0xad, 0xb5, 0x8b, 0xdb, 0x2e, 0x52, 0x84, 0x9e, 0x0b, 0x39, 0xc6, 0x01,
+		0xca, 0xbc, 0x5e, 0xe0, 0x0e, 0x1d, 0xcc, 0xff, 0x54, 0x00, 0xe6, 0xc5, 0x66, 0x75, 0xdb, 0x11,
+		0xdd, 0x3a, 0x80, 0x66, 0x18, 0xa6, 0xed, 0x15, 0xde, 0x68, 0x6c, 0x31, 0x82, 0xb7, 0x5c, 0x70,
+		0x90, 0x14, 0x0f, 0x81, 0xdc, 0x1f, 0x49, 0x00, 0x6e, 0xd5, 0x44, 0x29, 0x9e, 0x82, 0x04, 0x3f,
+		0xf3, 0x4d, 0xef, 0x1c, 0xb0, 0x7c, 0x2e, 0xb0, 0xa2, 0x4b, 0x7a, 0x87, 0x9e, 0x42, 0xd9, 0xc2,
+		0x3b, 0xba, 0xc1, 0x4f, 0xe0, 0xb1, 0x0f, 0x71, 0x0a, 0x25, 0xe4, 0x9e, 0x50, 0x55, 0x20, 0x66,
+		0xe1, 0xae, 0x66, 0xd8, 0x7a, 0x8b, 0xcf, 0xa1, 0xf3, 0x87, 0x62, 0x7e, 0xb9, 0xc1, 0xb1, 0x15,
+		0x87, 0x4e, 0xfe, 0x34, 0xc4, 0x44, 0xa9, 0x63, 0x86, 0xe7, 0x50, 0x14, 0x82, 0x8d, 0x0a, 0x59,
+		0x88, 0xa8, 0x35, 0xac, 0x16, 0x1a, 0x72, 0xe0, 0xcc, 0x0f, 0x07, 0x20, 0x2a, 0x26, 0xf5, 0x02,
+		0x64, 0x2a, 0xe5, 0xea, 0x90, 0x45, 0x5f, 0x80, 0xb4, 0x28, 0xe4, 0x16, 0xed, 0x3d, 0x51, 0x6f,
+		0xe1, 0x86, 0x52, 0x6f, 0xd6, 0x57, 0xe4, 0x3f, 0x1c, 0x2d, 0x7c, 0x52, 0xfe, 0x5c, 0x14, 0xcd,
+		0x43, 0x52, 0x14, 0xae, 0x3c, 0xbe, 0xf2, 0xa4, 0xfc, 0xda, 0x70, 0xd1, 0x53, 0xf2, 0xe7, 0x69,
+		0x2a, 0x51, 0x14, 0x3d, 0xa1, 0x36, 0x89, 0x59, 0xae, 0xd7, 0xd6, 0x6e, 0xc8, 0x92, 0xb7, 0x62,
+		0xc5, 0x53, 0x11, 0x40, 0xf7, 0xc2, 0x31, 0x51, 0x71, 0xe1, 0xc2, 0x85, 0x0b, 0x4f, 0x7b, 0x2a,
+		0xef, 0xbc, 0x3f, 0x32, 0x5c, 0xfd, 0x8c, 0xa7, 0xfa, 0xfb, 0x47, 0xab, 0x2f, 0x78, 0xaa, 0x7f,
+		0xe0, 0xfd, 0x11, 0xb4, 0x00, 0x09, 0x51, 0xbd, 0x5e, 0x78, 0x41, 0xfe, 0xca, 0x57, 0xbe, 0xf2,
+		0x95, 0xe8, 0x99, 0x4d, 0x90, 0x47, 0xdc, 0x8f, 0x45, 0x90, 0x7d, 0xfe, 0x06, 0x11, 0xef, 0xdc,
+		0x50, 0x29, 0x75, 0x29, 0x64, 0x89, 0x2c, 0xe7, 0x9e, 0x52, 0xe6, 0x7e, 0xc8, 0x81, 0xe2, 0x37,
+		0xc2, 0x42, 0xcb, 0xec, 0x0e, 0x8f, 0x78, 0x51, 0x1e, 0x3a, 0x62, 0x63, 0x5d, 0x91, 0x5e, 0x7c,
+		0x8c, 0x03, 0xed, 0x98, 0x1d, 0xcd, 0xd8, 0x59, 0x36, 0xfb, 0x3b, 0xee, 0x95, 0x19, 0x12, 0x2a,
+		0x59, 0x9e, 0x8b, 0x33, 0xbd, 0xad, 0xbf, 0x92, 0xa4, 0x8f, 0x04, 0x82, 0x97, 0x37, 0x8a, 0x3f,
+		0x1a, 0xc8, 0x5d, 0x66, 0x88, 0x1b, 0x42, 0x9f, 0x14, 0xbc, 0xdd, 0xc1, 0x2d, 0x32, 0xe8, 0xf0,
+		0xc7, 0x8f, 0xc0, 0xe2, 0x8e, 0xb9, 0x63, 0x52, 0x4a, 0x67, 0xc9, 0x5f, 0xfc, 0xce, 0x4d, 0xdc,
+		0x29, 0xcd, 0x4d, 0xbd, 0xa0, 0xb3, 0x5a, 0x83, 0x05, 0x0e, 0xac, 0xd2, 0xc8, 0x8d, 0x9d, 0x02,
+		0x40, 0x07, 0x1e, 0x46, 0xcb, 0xfe, 0xd4, 0x1f, 0xd0, 0x94, 0x9b, 0x32, 0xcf, 0x51, 0x49, 0x1d,
+		0x3b, 0x28, 0xb0, 0xaa, 0xc0, 0x11, 0x1f, 0x3d, 0x16, 0x35, 0xe3, 0xfe, 0x14, 0x8a, 0xbf, 0xc2,
+		0x29, 0x2e, 0x78, 0x28, 0x36, 0x38, 0xea, 0x6a, 0x09, 0x52, 0x87, 0xa1, 0xf5, 0x6f, 0x39, 0xad,
+		0x24, 0xf6, 0x12, 0xb9, 0x0c, 0x19, 0x4a, 0xa4, 0x35, 0xb0, 0x6c, 0xb3, 0x4b, 0x53, 0x12, 0x07,
+		0x93, 0xf9, 0x77, 0x7f, 0xc0, 0x6c, 0x68, 0x9a, 0xa0, 0x95, 0x1c, 0xac, 0xd5, 0x55, 0xa0, 0x11,
+		0x68, 0x1b, 0xb7, 0x3a, 0x53, 0x28, 0xfc, 0x2a, 0x67, 0xc4, 0x81, 0x5f, 0xbd, 0x4e, 0x02, 0x92,
+		0x41, 0x97, 0x66, 0x0c, 0xbc, 0x9c, 0x4c, 0x3f, 0x76, 0x96, 0xfd, 0xcd, 0x77, 0x33, 0x33, 0xbd,
+		0xe0, 0x10, 0xf0, 0xf0, 0xe4, 0x19, 0xc5, 0x1d, 0x6c, 0xdb, 0xb8, 0x6f, 0xa9, 0x5a, 0x67, 0x1c,
+		0x7b, 0x9e, 0x73, 0x3b, 0xd9, 0xef, 0xf9, 0xa2, 0x7f, 0x14, 0x2f, 0x33, 0xcc, 0x42, 0xa7, 0xb3,
+		0xba, 0x09, 0xc7, 0xc6, 0x68, 0xc5, 0x0c, 0x34, 0xbf, 0x97, 0xd3, 0x5c, 0x1c, 0xd1, 0x0c, 0x42,
+		0x76, 0x03, 0x44, 0xb9, 0x33, 0x96, 0x33, 0xd0, 0xfc, 0x20, 0xa7, 0x89, 0x38, 0xae, 0x18, 0x52,
+		0x42, 0xf1, 0x2a, 0xcc, 0xdf, 0xc2, 0xfd, 0x2d, 0xd3, 0xe2, 0x67, 0xa5, 0x66, 0x20, 0xf7, 0x7d,
+		0x9c, 0x5c, 0x86, 0x23, 0xd2, 0xc3, 0x53, 0x84, 0xd6, 0x05, 0x88, 0x6d, 0x6b, 0x2d, 0x3c, 0x03,
+		0x89, 0x3b, 0x9c, 0x44, 0x94, 0xc0, 0x13, 0xd4, 0x02, 0x24, 0x77, 0x4c, 0x9e, 0x34, 0x9a, 0x8e,
+		0xfe, 0xfd, 0x1c, 0x3d, 0x21, 0x70, 0x38, 0x89, 0x9e, 0xd9, 0x1b, 0x74, 0x34, 0x7b, 0x16, 0x0e,
+		0x7e, 0x40, 0x90, 0x10, 0x38, 0x9c, 0xc4, 0x21, 0xc4, 0xfa, 0x21, 0x41, 0xc2, 0xf2, 0xc8, 0xf3,
+		0x39, 0x48, 0x98, 0x46, 0x67, 0xdf, 0x34, 0x66, 0x61, 0xe2, 0xc3, 0x9c, 0x02, 0x70, 0x14, 0x42,
+		0xe0, 0x22, 0xc4, 0x67, 0x1d, 0x88, 0x1f, 0xfa, 0xa2, 0x98, 0x1e, 0x62, 0x04, 0x2e, 0x43, 0x46,
+		0x18, 0x28, 0xdd, 0x34, 0x66, 0x20, 0xf1, 0x51, 0x4e, 0x22, 0xed, 0x41, 0xe3, 0xdd, 0xb0, 0xb1,
+		0x65, 0xef, 0xe0, 0x59, 0x88, 0xfc, 0xb0, 0xe8, 0x06, 0x47, 0xe1, 0xa2, 0xdc, 0xc2, 0x46, 0x6b,
+		0x77, 0x36, 0x0a, 0x1f, 0x13, 0xa2, 0x14, 0x38, 0x84, 0x44, 0x09, 0x52, 0x5d, 0xad, 0x6f, 0xed,
+		0x6a, 0x9d, 0x99, 0x86, 0xe3, 0x47, 0x38, 0x8d, 0xa4, 0x83, 0xc4, 0x25, 0x32, 0x30, 0x0e, 0x43,
+		0xe6, 0x47, 0x85, 0x44, 0x3c, 0x68, 0x7c, 0xea, 0x59, 0x36, 0x75, 0xaf, 0x0f, 0x43, 0xed, 0xc7,
+		0xc4, 0xd4, 0x63, 0xb8, 0xeb, 0x5e, 0x8a, 0x17, 0x21, 0x6e, 0xe9, 0x2f, 0xcf, 0x44, 0xe6, 0xc7,
+		0xc5, 0x48, 0x53, 0x04, 0x82, 0x7c, 0x03, 0x8e, 0x8f, 0x5d, 0x26, 0x66, 0x20, 0xf6, 0x13, 0x9c,
+		0xd8, 0xd1, 0x31, 0x4b, 0x05, 0x37, 0x09, 0x87, 0x25, 0xf9, 0x4f, 0x85, 0x49, 0xc0, 0x43, 0xb4,
+		0x36, 0x60, 0x71, 0x60, 0x58, 0xda, 0xf6, 0xe1, 0xa4, 0xf6, 0xcf, 0x84, 0xd4, 0x18, 0xae, 0x4f,
+		0x6a, 0x4d, 0x38, 0xca, 0x29, 0x1e, 0x6e, 0x5c, 0x7f, 0x52, 0x18, 0x56, 0x86, 0xbd, 0xe9, 0x1f,
+		0xdd, 0xb7, 0x41, 0xce, 0x11, 0xa7, 0xc8, 0x17, 0x5b, 0x6a, 0x57, 0xeb, 0xcd, 0x40, 0xf9, 0xa7,
+		0x38, 0x65, 0x61, 0xf1, 0x9d, 0x84, 0xb3, 0xb5, 0xae, 0xf5, 0x08, 0xf1, 0x17, 0x20, 0x2b, 0x88,
+		0x0f, 0x8c, 0x3e, 0x6e, 0x99, 0x3b, 0x86, 0xfe, 0x32, 0x6e, 0xcf, 0x40, 0xfa, 0xe3, 0x43, 0x43,
+		0xb5, 0xe9, 0x41, 0x27, 0x94, 0xab, 0x20, 0x3b, 0xbe, 0x8a, 0xaa, 0x77, 0xe9, 0xde, 0xda, 0xc1,
+		0x14, 0x3f, 0x21, 0x46, 0xca, 0xc1, 0xab, 0x52, 0xb4, 0xd5, 0x0a, 0xb0, 0x0b, 0x1b, 0xb3, 0xaa,
+		0xe4, 0x27, 0x39, 0xa1, 0x94, 0x8b, 0xc5, 0x0d, 0x47, 0xcb, 0xec, 0xf6, 0xb4, 0xfe, 0x2c, 0xf6,
+		0xef, 0xa7, 0x85, 0xe1, 0xe0, 0x28, 0xdc, 0x70, 0x10, 0x8f, 0x8e, 0xac, 0xf6, 0x33, 0x50, 0xf8,
+		0x19, 0x61, 0x38, 0x04, 0x0e, 0x27, 0x21, 0x1c, 0x86, 0x19, 0x48, 0xfc, 0xac, 0x20, 0x21, 0x70,
+		0x08, 0x89, 0xb7, 0xba, 0x0b, 0x6d, 0x1f, 0xef, 0xe8, 0x96, 0xcd, 0xaf, 0x54, 0x1d, 0x4c, 0xea,
+		0xe7, 0xbe, 0xe8, 0x77, 0xc2, 0x14, 0x0f, 0x2a, 0xb1, 0x44, 0x3c, 0xc9, 0x4b, 0x37, 0x31, 0xa6,
+		0x33, 0xf6, 0xf3, 0xc2, 0x12, 0x79, 0xd0, 0x08, 0x6f, 0x1e, 0x0f, 0x91, 0x88, 0xbd, 0x45, 0xe2,
+		0xc6, 0x19, 0xc8, 0xfd, 0xf3, 0x21, 0xe6, 0x1a, 0x02, 0x97, 0xd0, 0xf4, 0xf8, 0x3f, 0x03, 0xe3,
+		0x26, 0xde, 0x9f, 0x49, 0x3b, 0xff, 0xc5, 0x90, 0xff, 0xb3, 0xc9, 0x30, 0x99, 0x0d, 0xc9, 0x0c,
+		0xf9, 0x53, 0x68, 0xda, 0x95, 0xcd, 0xec, 0xbb, 0xbe, 0xc4, 0xfb, 0xeb, 0x77, 0xa7, 0x56, 0xd7,
+		0x88, 0x92, 0xfb, 0x9d, 0x9e, 0xe9, 0xc4, 0xde, 0xfd, 0x25, 0x47, 0xcf, 0x7d, 0x3e, 0xcf, 0xea,
+		0x25, 0x48, 0xf9, 0x1c, 0x9e, 0xe9, 0xa4, 0xde, 0xc3, 0x49, 0x25, 0xbd, 0xfe, 0xce, 0xea, 0x39,
+		0x08, 0x11, 0xe7, 0x65, 0x3a, 0xfa, 0x37, 0x73, 0x74, 0x0a, 0xbe, 0xfa, 0x2c, 0xc4, 0x84, 0xd3,
+		0x32, 0x1d, 0xf5, 0x1f, 0x70, 0x54, 0x07, 0x85, 0xa0, 0x0b, 0x87, 0x65, 0x3a, 0xfa, 0x3f, 0x14,
+		0xe8, 0x02, 0x85, 0xa0, 0xcf, 0x2e, 0xc2, 0x5f, 0x7a, 0x5f, 0x88, 0x2f, 0x3a, 0x42, 0x76, 0x17,
+		0x21, 0xca, 0x3d, 0x95, 0xe9, 0xd8, 0xdf, 0xc2, 0x1b, 0x17, 0x18, 0xab, 0x4f, 0x43, 0x78, 0x46,
+		0x81, 0xbf, 0x9f, 0xa3, 0x32, 0xf8, 0xd5, 0x12, 0x24, 0x3c, 0xde, 0xc9, 0x74, 0xf4, 0x6f, 0xe3,
+		0xe8, 0x5e, 0x2c, 0xc2, 0x3a, 0xf7, 0x4e, 0xa6, 0x13, 0xf8, 0x47, 0x82, 0x75, 0x8e, 0x41, 0xc4,
+		0x26, 0x1c, 0x93, 0xe9, 0xd8, 0x1f, 0x10, 0x52, 0x17, 0x28, 0xab, 0xcf, 0x41, 0xdc, 0x59, 0x6c,
+		0xa6, 0xe3, 0x7f, 0x3b, 0xc7, 0x77, 0x71, 0x88, 0x04, 0x3c, 0x8b, 0xdd, 0x74, 0x12, 0xff, 0x58,
+		0x48, 0xc0, 0x83, 0x45, 0xa6, 0xd1, 0xb0, 0x03, 0x33, 0x9d, 0xd2, 0x77, 0x88, 0x69, 0x34, 0xe4,
+		0xbf, 0x90, 0xd1, 0xa4, 0x36, 0x7f, 0x3a, 0x89, 0xef, 0x14, 0xa3, 0x49, 0xe1, 0x09, 0x1b, 0xc3,
+		0x1e, 0xc1, 0x74, 0x1a, 0xdf, 0x2d, 0xd8, 0x18, 0x72, 0x08, 0x56, 0x37, 0x00, 0x8d, 0x7a, 0x03,
+		0xd3, 0xe9, 0xbd, 0xca, 0xe9, 0xcd, 0x8f, 0x38, 0x03, 0xab, 0xcf, 0xc3, 0xd1, 0xf1, 0x9e, 0xc0,
+		0x74, 0xaa, 0xdf, 0xf3, 0xa5, 0xa1, 0xd8, 0xcd, 0xeb, 0x08, 0xac, 0x36, 0xdd, 0x25, 0xc5, 0xeb,
+		0x05, 0x4c, 0x27, 0xfb, 0xbd, 0x5f, 0xf2, 0x1b, 0x6e, 0xaf, 0x13, 0xb0, 0x5a, 0x00, 0x70, 0x17,
+		0xe0, 0xe9, 0xb4, 0xbe, 0x8f, 0xd3, 0xf2, 0x20, 0x91, 0xa9, 0xc1, 0xd7, 0xdf, 0xe9, 0xf8, 0x77,
+		0xc4, 0xd4, 0xe0, 0x18, 0x64, 0x6a, 0x88, 0xa5, 0x77, 0x3a, 0xf6, 0xf7, 0x8b, 0xa9, 0x21, 0x50,
+		0x88, 0x66, 0x7b, 0x56, 0xb7, 0xe9, 0x14, 0x3e, 0x2c, 0x34, 0xdb, 0x83, 0xb5, 0x5a, 0x83, 0xf9,
+		0x91, 0x05, 0x71, 0x3a, 0xa9, 0x8f, 0x70, 0x52, 0xf2, 0xf0, 0x7a, 0xe8, 0x5d, 0xbc, 0xf8, 0x62,
+		0x38, 0x9d, 0xda, 0x0f, 0x0e, 0x2d, 0x5e, 0x7c, 0x2d, 0x5c, 0xbd, 0x08, 0x31, 0x63, 0xd0, 0xe9,
+		0x90, 0xc9, 0x83, 0x0e, 0xbe, 0x52, 0x9b, 0xfd, 0xfc, 0x97, 0xb9, 0x74, 0x04, 0xc2, 0xea, 0x39,
+		0x08, 0xe3, 0xee, 0x16, 0x6e, 0x4f, 0xc3, 0xfc, 0xc2, 0x97, 0x85, 0xc1, 0x24, 0xd0, 0xab, 0xcf,
+		0x01, 0xb0, 0xd4, 0x08, 0x3d, 0x53, 0x3e, 0x05, 0xf7, 0x8f, 0xbe, 0xcc, 0x2f, 0xa0, 0xb9, 0x28,
+		0x2e, 0x01, 0x76, 0x9d, 0xed, 0x60, 0x02, 0x5f, 0xf4, 0x13, 0xa0, 0x23, 0x72, 0x01, 0xa2, 0x2f,
+		0x59, 0xa6, 0x61, 0x6b, 0x3b, 0xd3, 0xb0, 0xff, 0x98, 0x63, 0x0b, 0x78, 0x22, 0xb0, 0xae, 0xd9,
+		0xc7, 0xb6, 0xb6, 0x63, 0x4d, 0xc3, 0xfd, 0xef, 0x1c, 0xd7, 0x41, 0x20, 0xc8, 0x2d, 0xcd, 0xb2,
+		0x67, 0xe9, 0xf7, 0x9f, 0x08, 0x64, 0x81, 0x40, 0x98, 0x26, 0x7f, 0xdf, 0xc4, 0xfb, 0xd3, 0x70,
+		0xff, 0x54, 0x30, 0xcd, 0xe1, 0x57, 0x9f, 0x85, 0x38, 0xf9, 0x93, 0x5d, 0x4c, 0x9d, 0x82, 0xfc,
+		0x67, 0x1c, 0xd9, 0xc5, 0x20, 0x2d, 0x5b, 0x76, 0xdb, 0xd6, 0xa7, 0x0b, 0xfb, 0xcf, 0xf9, 0x48,
+		0x0b, 0xf8, 0xd5, 0x02, 0x24, 0x2c, 0xbb, 0xdd, 0x1e, 0x70, 0xff, 0x74, 0x0a, 0xfa, 0x5f, 0x7c,
+		0xd9, 0x49, 0x59, 0x38, 0x38, 0x64, 0xb4, 0x6f, 0xdf, 0xb4, 0x7b, 0x26, 0x3d, 0x81, 0x34, 0x8d,
+		0xc2, 0x97, 0x38, 0x05, 0x0f, 0xca, 0x6a, 0x09, 0x92, 0xa4, 0x2f, 0xe2, 0x20, 0xc7, 0x34, 0x12,
+		0x7f, 0xc9, 0x05, 0xe0, 0x43, 0x2a, 0x7e, 0xc3, 0xaf, 0x7e, 0xf6, 0xa4, 0xf4, 0xa9, 0xcf, 0x9e,
+		0x94, 0xfe, 0xdb, 0x67, 0x4f, 0x4a, 0x1f, 0xf8, 0xfd, 0x93, 0x73, 0x9f, 0xfa, 0xfd, 0x93, 0x73,
+		0xbf, 0xfd, 0xfb, 0x27, 0xe7, 0xc6, 0x67, 0x89, 0xe1, 0xb2, 0x79, 0xd9, 0x64, 0xf9, 0xe1, 0x17,
+		0x1f, 0xd8, 0xd1, 0xed, 0xdd, 0xc1, 0xd6, 0x72, 0xcb, 0xec, 0x9e, 0x6d, 0x99, 0x56, 0xd7, 0xb4,
+		0xce, 0xfa, 0xf3, 0xba, 0xf4, 0x2f, 0xf8, 0x6b, 0x89, 0xc4, 0xcc, 0xfe, 0x74, 0xae, 0x66, 0xec,
+		0x4f, 0x7a, 0x68, 0xe9, 0x3c, 0x04, 0x0b, 0xc6, 0x3e, 0x3a, 0xce, 0x0c, 0x9c, 0x3a, 0xe8, 0x77,
+		0xf8, 0xd5, 0xc6, 0x28, 0xf9, 0xde, 0xec, 0x77, 0xfc, 0x47, 0xda, 0x93, 0xfc, 0x48, 0x7b, 0xf1,
+		0xdb, 0xa4, 0xc3, 0xf5, 0x24, 0x56, 0x30, 0xf6, 0x69, 0x47, 0x36, 0xa4, 0x17, 0x1f, 0x9d, 0x9a,
+		0xe7, 0xbe, 0x69, 0x98, 0xb7, 0x0d, 0xc2, 0x76, 0x6f, 0x4b, 0xe4, 0xb8, 0x4f, 0x0e, 0xe7, 0xb8,
+		0x9f, 0xc7, 0x9d, 0xce, 0x35, 0x02, 0xd7, 0x24, 0x28, 0x5b, 0x11, 0x76, 0x11, 0x1f, 0xbe, 0x23,
+		0x00, 0x27, 0x47, 0xd2, 0xd9, 0x5c, 0x09, 0x26, 0x09, 0x61, 0x15, 0x62, 0x65, 0xa1, 0x5b, 0x59,
+		0x88, 0x5a, 0xb8, 0x65, 0x1a, 0x6d, 0x76, 0x36, 0x39, 0xa8, 0x88, 0x4f, 0x22, 0x08, 0x43, 0x33,
+		0x4c, 0x8b, 0x5f, 0x0e, 0x66, 0x1f, 0xc5, 0x0f, 0x1e, 0x52, 0x10, 0x29, 0xd1, 0x92, 0x90, 0xc6,
+		0x13, 0x33, 0x4a, 0x43, 0x74, 0xc2, 0x97, 0xf9, 0x9f, 0x55, 0x2a, 0xdf, 0x1d, 0x80, 0x53, 0xc3,
+		0x52, 0x21, 0x33, 0xcb, 0xb2, 0xb5, 0x6e, 0x6f, 0x92, 0x58, 0x2e, 0x42, 0xbc, 0x29, 0x60, 0x0e,
+		0x2d, 0x97, 0x3b, 0x87, 0x94, 0x4b, 0xda, 0x69, 0x4a, 0x08, 0x66, 0x65, 0x46, 0xc1, 0x38, 0xfd,
+		0xb8, 0x2b, 0xc9, 0x7c, 0x30, 0x0e, 0xc7, 0xd9, 0x74, 0x52, 0xd9, 0x54, 0x62, 0x1f, 0x5c, 0x26,
+		0x49, 0x6f, 0xd5, 0xf4, 0x7d, 0x92, 0xfc, 0x35, 0x58, 0xa8, 0x12, 0x6b, 0x41, 0xa2, 0x20, 0x77,
+		0x87, 0x67, 0xec, 0xfd, 0xe9, 0x25, 0x9f, 0xc3, 0xcf, 0x37, 0x18, 0xbd, 0x45, 0xf9, 0x77, 0x49,
+		0x20, 0x37, 0x5a, 0x5a, 0x47, 0xeb, 0x7f, 0xb5, 0xa4, 0xd0, 0xd3, 0x00, 0xec, 0xe4, 0x9b, 0xf3,
+		0xfe, 0x52, 0x7a, 0x25, 0xbb, 0xec, 0xed, 0xdc, 0x32, 0x6b, 0x89, 0xde, 0x2b, 0x8a, 0x53, 0x58,
+		0xf2, 0xe7, 0x99, 0x17, 0x00, 0xdc, 0x0a, 0x74, 0x02, 0x8e, 0x35, 0x4a, 0x85, 0xb5, 0x82, 0x22,
+		0x0e, 0xad, 0x34, 0x36, 0x2a, 0x25, 0xf6, 0x96, 0xc9, 0x1c, 0x3a, 0x0a, 0xc8, 0x5b, 0xe9, 0xdc,
+		0xc5, 0x38, 0x02, 0xf3, 0xde, 0x72, 0xf6, 0xb0, 0x44, 0x60, 0xf5, 0x0a, 0x64, 0xd8, 0x95, 0x75,
+		0x55, 0x6b, 0xb7, 0x71, 0x5b, 0xd5, 0x0d, 0x34, 0xe5, 0x06, 0x78, 0xf6, 0xd7, 0x7e, 0x2b, 0x4c,
+		0xbb, 0x96, 0x62, 0x88, 0x05, 0x82, 0x57, 0x35, 0x88, 0xcf, 0xa9, 0x77, 0x7b, 0x1d, 0x4c, 0xb7,
+		0x94, 0x55, 0x5d, 0xc8, 0x7f, 0xba, 0x3b, 0x43, 0xe8, 0x05, 0x4f, 0xc7, 0x95, 0x05, 0x17, 0xdd,
+		0x19, 0xbd, 0xd5, 0x6b, 0xee, 0xd9, 0x38, 0x87, 0xc1, 0xa9, 0x14, 0x7f, 0x9d, 0x73, 0x28, 0xb2,
+		0x19, 0x82, 0xc5, 0x35, 0x98, 0xd7, 0x5a, 0x2d, 0xdc, 0xf3, 0xf1, 0x37, 0x65, 0x05, 0x11, 0xbd,
+		0x95, 0x39, 0xa6, 0xcb, 0xda, 0xd3, 0x10, 0xb1, 0xe8, 0xa0, 0x4c, 0x23, 0x21, 0xd8, 0xe1, 0xe0,
+		0xab, 0x15, 0x71, 0x16, 0xd4, 0xe9, 0xd1, 0x14, 0x02, 0xff, 0x9e, 0x13, 0x48, 0x52, 0x34, 0xd1,
+		0x1b, 0x03, 0xe6, 0xd9, 0xcb, 0x41, 0xd8, 0xd3, 0x9b, 0x83, 0xb3, 0x28, 0xff, 0xf2, 0x13, 0x8f,
+		0xd3, 0x8d, 0xfb, 0xfb, 0xfc, 0x4a, 0x37, 0x66, 0xb2, 0x28, 0x32, 0xa7, 0xed, 0xf6, 0x17, 0x43,
+		0x5a, 0xb4, 0xc7, 0xfb, 0x7d, 0x70, 0x63, 0xbf, 0xc0, 0x1b, 0x3b, 0x39, 0x4e, 0xc3, 0x3d, 0x2d,
+		0xa5, 0x38, 0x55, 0x56, 0xb1, 0x5a, 0x84, 0xd4, 0xb6, 0xde, 0xf1, 0x0c, 0xf7, 0xc1, 0xad, 0xfc,
+		0xe2, 0x27, 0x1e, 0x67, 0x13, 0x8d, 0x20, 0x71, 0xd1, 0x14, 0x2b, 0x93, 0xac, 0xde, 0x8b, 0x8f,
+		0x8c, 0xae, 0xdf, 0xec, 0xbf, 0xc7, 0x28, 0xf5, 0x8b, 0x5e, 0x56, 0x5d, 0xeb, 0x14, 0x82, 0x79,
+		0xad, 0xab, 0x1b, 0xe6, 0x59, 0xfa, 0x2f, 0xb7, 0x4a, 0x61, 0xfa, 0x31, 0xc3, 0xb6, 0xed, 0x79,
+		0x66, 0x2c, 0xa6, 0xeb, 0xed, 0x9f, 0x7d, 0xeb, 0x47, 0xc3, 0xae, 0x41, 0x59, 0x5d, 0x1f, 0x3d,
+		0x17, 0x3a, 0x9d, 0xc6, 0x9f, 0x0b, 0x1a, 0xc3, 0xc7, 0x3c, 0x57, 0xdf, 0x0c, 0x31, 0x87, 0xcc,
+		0x34, 0xdf, 0x4d, 0x10, 0x71, 0x30, 0x88, 0xe7, 0xc6, 0x94, 0x76, 0x16, 0x3f, 0xfd, 0x4b, 0x02,
+		0x9f, 0xd9, 0xb0, 0x1a, 0xe9, 0xcd, 0x65, 0x48, 0xb7, 0x4d, 0xc3, 0x56, 0xcd, 0xae, 0x6e, 0xe3,
+		0x6e, 0xcf, 0x9e, 0xea, 0xf9, 0xfe, 0x25, 0x23, 0x12, 0x53, 0x52, 0x04, 0xaf, 0x2e, 0xd0, 0x08,
+		0x27, 0xec, 0xf1, 0xa1, 0x59, 0x38, 0xf9, 0x1f, 0x0e, 0x27, 0x14, 0x87, 0x70, 0x72, 0x57, 0xda,
+		0x61, 0xb5, 0x6f, 0xf2, 0xe5, 0xce, 0xde, 0x63, 0x5a, 0xe0, 0x68, 0xc7, 0xbf, 0x0e, 0xc1, 0x49,
+		0x0e, 0xbc, 0xa5, 0x59, 0xf8, 0xec, 0xad, 0x27, 0xb6, 0xb0, 0xad, 0x3d, 0x71, 0xb6, 0x65, 0xea,
+		0xc2, 0xd7, 0x59, 0xe0, 0xcb, 0x19, 0xa9, 0x5f, 0xe6, 0xf5, 0xb9, 0xb1, 0x07, 0x02, 0x72, 0x93,
+		0x97, 0xc1, 0xdc, 0xa8, 0x0e, 0xe6, 0x3b, 0x10, 0x2a, 0x99, 0x3a, 0xbd, 0xf1, 0xd8, 0xc6, 0x86,
+		0xd9, 0xe5, 0x0b, 0x12, 0xfb, 0x40, 0x57, 0x20, 0xa2, 0x75, 0xcd, 0x81, 0x61, 0xb3, 0xc5, 0xa8,
+		0xf8, 0xf8, 0xaf, 0x7e, 0xe6, 0xd4, 0xdc, 0xef, 0x7c, 0xe6, 0xd4, 0x11, 0x46, 0xd6, 0x6a, 0xdf,
+		0x5c, 0xd6, 0xcd, 0xb3, 0x5d, 0xcd, 0xde, 0x25, 0x26, 0xe0, 0xd3, 0x9f, 0x7c, 0x0c, 0x78, 0x7b,
+		0x55, 0xc3, 0xfe, 0xd8, 0xe7, 0x7e, 0xf2, 0x8c, 0xa4, 0x70, 0xfc, 0xd5, 0xd0, 0x6b, 0x1f, 0x3a,
+		0x25, 0xe5, 0x7b, 0x10, 0x2d, 0xe3, 0xd6, 0x01, 0x0d, 0x56, 0x87, 0x1a, 0x7c, 0x82, 0x37, 0x78,
+		0x62, 0xb4, 0x41, 0x76, 0x93, 0xaa, 0x8c, 0x5b, 0x9e, 0x66, 0xcb, 0xb8, 0x35, 0xd4, 0x62, 0x15,
+		0x62, 0x55, 0xc3, 0x66, 0xcf, 0x9f, 0x3c, 0x0b, 0x41, 0xdd, 0x60, 0xb7, 0xe6, 0xe3, 0xc5, 0x47,
+		0x0e, 0xd1, 0x15, 0x85, 0xe0, 0xe5, 0xeb, 0x10, 0x2b, 0xe3, 0x16, 0x23, 0x55, 0x82, 0x60, 0x1b,
+		0xb7, 0x38, 0xa9, 0xbb, 0x60, 0x92, 0x60, 0x17, 0xcb, 0xbf, 0xfd, 0x7b, 0x27, 0xe7, 0xde, 0xf9,
+		0xd9, 0x93, 0x73, 0x13, 0xd5, 0x29, 0x3f, 0x5d, 0x9d, 0x1c, 0x2d, 0xfa, 0x68, 0x08, 0xee, 0xa5,
+		0x4f, 0x70, 0xf5, 0xbb, 0xba, 0x61, 0x9f, 0x6d, 0xf5, 0xf7, 0x7b, 0xb6, 0x49, 0x8c, 0x8a, 0xb9,
+		0xcd, 0x95, 0x68, 0xde, 0xad, 0x5e, 0x66, 0xd5, 0xe3, 0x55, 0x28, 0xbf, 0x0d, 0xe1, 0x0d, 0x82,
+		0x47, 0x06, 0xc9, 0x36, 0x6d, 0xad, 0xc3, 0x7d, 0x45, 0xf6, 0x41, 0x4a, 0xd9, 0xb3, 0x5d, 0x01,
+		0x56, 0xaa, 0x8b, 0x17, 0xbb, 0x3a, 0x58, 0xdb, 0x66, 0x4f, 0x97, 0x04, 0x69, 0x90, 0x11, 0x23,
+		0x05, 0xf4, 0x95, 0x92, 0x45, 0x08, 0x6b, 0x03, 0x76, 0xfa, 0x2c, 0x48, 0xa2, 0x0f, 0xfa, 0x91,
+		0xbf, 0x06, 0x51, 0x7e, 0xea, 0x01, 0xc9, 0x10, 0xbc, 0x89, 0xf7, 0x69, 0x3b, 0x49, 0x85, 0xfc,
+		0x89, 0x96, 0x21, 0x4c, 0x99, 0xe7, 0xb7, 0xc5, 0xb2, 0xcb, 0x23, 0xdc, 0x2f, 0x53, 0x26, 0x15,
+		0x06, 0x96, 0xbf, 0x0a, 0xb1, 0xb2, 0x49, 0x34, 0xdb, 0x4f, 0x2d, 0xce, 0xa8, 0x51, 0x9e, 0x7b,
+		0x03, 0x5b, 0xdc, 0xe8, 0xa5, 0x1f, 0xe8, 0x28, 0x44, 0xd8, 0x53, 0x36, 0xfc, 0x04, 0x1d, 0xff,
+		0xca, 0x97, 0x20, 0x4a, 0x69, 0xd7, 0x7b, 0xce, 0x13, 0x73, 0x92, 0xe7, 0x89, 0x39, 0x4e, 0x3e,
+		0xe0, 0x32, 0x8b, 0x20, 0xd4, 0xd6, 0x6c, 0x8d, 0xf7, 0x9b, 0xfe, 0x9d, 0x7f, 0x0b, 0xc4, 0x38,
+		0x11, 0x0b, 0xad, 0x40, 0xd0, 0xec, 0x89, 0x03, 0xa2, 0xb9, 0x49, 0x5d, 0xa9, 0xf7, 0x8a, 0x21,
+		0xa2, 0x4b, 0x0a, 0x01, 0x2e, 0xd6, 0x26, 0xaa, 0xc5, 0x53, 0x3e, 0xb5, 0xe8, 0x62, 0x7b, 0x6b,
+		0xdb, 0x76, 0xff, 0x60, 0xc3, 0x39, 0xa2, 0x0a, 0x8e, 0xa2, 0xdc, 0x09, 0xc0, 0x49, 0x4f, 0xed,
+		0x2d, 0xdc, 0xb7, 0x74, 0xd3, 0x60, 0xda, 0xc4, 0x35, 0x05, 0x79, 0x18, 0xe4, 0xf5, 0x13, 0x54,
+		0xe5, 0x59, 0x08, 0x16, 0x7a, 0x3d, 0x94, 0x83, 0x18, 0xfd, 0x6e, 0x99, 0x4c, 0x57, 0x42, 0x8a,
+		0xf3, 0x4d, 0xea, 0x2c, 0x73, 0xdb, 0xbe, 0xad, 0xf5, 0x9d, 0xc7, 0xe2, 0xc4, 0x77, 0xfe, 0x02,
+		0xc4, 0x4b, 0xa6, 0x61, 0x61, 0xc3, 0x1a, 0xd0, 0x08, 0x64, 0xab, 0x63, 0xb6, 0x6e, 0x72, 0x0a,
+		0xec, 0x83, 0x08, 0x5b, 0xeb, 0xf5, 0x28, 0x66, 0x48, 0x21, 0x7f, 0xb2, 0x99, 0x5d, 0xac, 0x4f,
+		0x14, 0xcf, 0xb9, 0xc3, 0x89, 0x87, 0x77, 0xd0, 0x91, 0xcf, 0xff, 0x92, 0xe0, 0x9e, 0xd1, 0x89,
+		0x74, 0x13, 0xef, 0x5b, 0x87, 0x9d, 0x47, 0x2f, 0x40, 0x7c, 0x83, 0x3e, 0x11, 0x7c, 0x0d, 0xef,
+		0xa3, 0x1c, 0x44, 0x71, 0x7b, 0xe5, 0xdc, 0xb9, 0x27, 0x2e, 0x30, 0x2d, 0xbf, 0x32, 0xa7, 0x88,
+		0x02, 0x74, 0x12, 0xe2, 0x16, 0x6e, 0xf5, 0x56, 0xce, 0x9d, 0xbf, 0xf9, 0x04, 0x53, 0xab, 0x2b,
+		0x73, 0x8a, 0x5b, 0xb4, 0x1a, 0x23, 0x3d, 0x7e, 0xed, 0xc3, 0xa7, 0xa4, 0x62, 0x18, 0x82, 0xd6,
+		0xa0, 0xfb, 0x35, 0xd3, 0x8d, 0x3f, 0x8d, 0xc0, 0x92, 0xa7, 0x96, 0x2d, 0x58, 0xfc, 0x5a, 0x8b,
+		0xf3, 0xcc, 0xb3, 0xec, 0xe9, 0x3f, 0x85, 0x98, 0xb0, 0x12, 0x1d, 0x28, 0xc5, 0xfc, 0xc7, 0x25,
+		0x48, 0x5e, 0x17, 0x94, 0x1b, 0xd8, 0x46, 0x17, 0x01, 0x9c, 0x96, 0xc4, 0x54, 0x39, 0xb1, 0x3c,
+		0xdc, 0xd6, 0xb2, 0x83, 0xa3, 0x78, 0xc0, 0xd1, 0xd3, 0x54, 0x01, 0x7b, 0xa6, 0xc5, 0x5f, 0xfd,
+		0x9a, 0x82, 0xea, 0x00, 0xa3, 0x47, 0x01, 0x51, 0xab, 0xa6, 0xde, 0x32, 0x6d, 0xdd, 0xd8, 0x51,
+		0x7b, 0xe6, 0x6d, 0xfe, 0x1c, 0x63, 0x50, 0x91, 0x69, 0xcd, 0x75, 0x5a, 0xb1, 0x41, 0xca, 0x09,
+		0xd3, 0x71, 0x87, 0x0a, 0x09, 0xa6, 0xb5, 0x76, 0xbb, 0x8f, 0x2d, 0x8b, 0x1b, 0x2e, 0xf1, 0x89,
+		0x2e, 0x42, 0xb4, 0x37, 0xd8, 0x52, 0x85, 0x95, 0x48, 0xac, 0xdc, 0x33, 0x6e, 0xce, 0x0b, 0xdd,
+		0xe0, 0xb3, 0x3e, 0xd2, 0x1b, 0x6c, 0x11, 0x4d, 0xb9, 0x0f, 0x92, 0x63, 0x98, 0x49, 0xdc, 0x72,
+		0xf9, 0xa0, 0xaf, 0x52, 0xf3, 0x1e, 0xa8, 0xbd, 0xbe, 0x6e, 0xf6, 0x75, 0x7b, 0x9f, 0x9e, 0x56,
+		0x0d, 0x2a, 0xb2, 0xa8, 0xd8, 0xe0, 0xe5, 0xf9, 0x9b, 0x90, 0x69, 0xd0, 0xd0, 0xc8, 0xe5, 0xfc,
+		0x9c, 0xcb, 0x9f, 0x34, 0x9d, 0xbf, 0x89, 0x9c, 0x05, 0x46, 0x38, 0x3b, 0xf3, 0x9f, 0x25, 0x48,
+		0x14, 0xc9, 0xc4, 0xad, 0x96, 0x2f, 0x75, 0xb4, 0x1d, 0xf4, 0x04, 0x1c, 0x29, 0xae, 0xd5, 0x4b,
+		0xd7, 0xd4, 0x6a, 0x59, 0xbd, 0xb4, 0x56, 0xf0, 0x5c, 0x1c, 0xc8, 0x1d, 0x7d, 0xe5, 0xce, 0x12,
+		0xf2, 0xc0, 0x6e, 0x1a, 0x34, 0x13, 0x80, 0xce, 0xc2, 0xa2, 0x1f, 0xa5, 0x50, 0x6c, 0x54, 0x6a,
+		0x4d, 0x59, 0xca, 0x1d, 0x79, 0xe5, 0xce, 0xd2, 0xbc, 0x07, 0xa3, 0xb0, 0x65, 0x61, 0xc3, 0x1e,
+		0x45, 0x28, 0xd5, 0xd7, 0xd7, 0xab, 0x4d, 0x39, 0x30, 0x82, 0x50, 0x32, 0xbb, 0x5d, 0xdd, 0x46,
+		0x0f, 0xc3, 0xbc, 0x1f, 0xa1, 0x56, 0x5d, 0x93, 0x83, 0x39, 0xf4, 0xca, 0x9d, 0xa5, 0xb4, 0x07,
+		0xba, 0xa6, 0x77, 0x72, 0xb1, 0xf7, 0xfe, 0xe0, 0xc9, 0xb9, 0x8f, 0xfd, 0x93, 0x93, 0x52, 0x71,
+		0x7d, 0xe2, 0x9c, 0x7b, 0xf2, 0x70, 0x73, 0xce, 0xbf, 0x6e, 0x7f, 0xf9, 0x1e, 0x9f, 0xb9, 0xe1,
+		0x3e, 0xa2, 0xc7, 0x18, 0xcf, 0x3a, 0xdd, 0xa6, 0x65, 0x86, 0x72, 0x07, 0xbb, 0x07, 0xb9, 0x29,
+		0x8b, 0x42, 0x6e, 0xaa, 0x61, 0xc8, 0x5f, 0x80, 0xd4, 0x86, 0xd6, 0xb7, 0x1b, 0xd8, 0xbe, 0x82,
+		0xb5, 0x36, 0xee, 0xfb, 0xfd, 0x87, 0x94, 0xf0, 0x1f, 0x10, 0x84, 0xa8, 0x93, 0xc0, 0xd6, 0x4f,
+		0xfa, 0x77, 0x7e, 0x17, 0x42, 0xf4, 0x66, 0xa4, 0xe3, 0x5b, 0x70, 0x0c, 0xe6, 0x5b, 0x90, 0x95,
+		0x61, 0xdf, 0xe6, 0x37, 0xc7, 0x93, 0x0a, 0xfb, 0x40, 0x4f, 0x09, 0x0f, 0x21, 0x78, 0xb0, 0x87,
+		0xc0, 0xa7, 0x17, 0xf7, 0x13, 0x3a, 0x10, 0xe5, 0x43, 0xec, 0x30, 0x22, 0xb9, 0x8c, 0xa0, 0x75,
+		0xc8, 0xf4, 0xb4, 0xbe, 0x4d, 0xdf, 0x5a, 0xda, 0xa5, 0xbd, 0xe0, 0x33, 0xf8, 0xd4, 0xa8, 0x3d,
+		0xf1, 0x75, 0x96, 0xb7, 0x92, 0xea, 0x79, 0x0b, 0xf3, 0x7f, 0x18, 0x82, 0x08, 0x17, 0xc6, 0xb3,
+		0x10, 0xe5, 0x62, 0xe5, 0x73, 0xee, 0xde, 0xe5, 0xd1, 0x65, 0x76, 0xd9, 0x59, 0x0e, 0x39, 0x3d,
+		0x81, 0x83, 0x1e, 0x84, 0x58, 0x6b, 0x57, 0xd3, 0x0d, 0x55, 0x6f, 0x73, 0xe7, 0x38, 0xf1, 0xd9,
+		0xcf, 0x9c, 0x8a, 0x96, 0x48, 0x59, 0xb5, 0xac, 0x44, 0x69, 0x65, 0xb5, 0x4d, 0x7c, 0x9a, 0x5d,
+		0xac, 0xef, 0xec, 0xda, 0xdc, 0x6e, 0xf0, 0x2f, 0xf4, 0x0c, 0x84, 0x88, 0x42, 0xf0, 0x7b, 0x16,
+		0xb9, 0x91, 0xa8, 0xc7, 0x49, 0xdc, 0x15, 0x63, 0xa4, 0xe1, 0x0f, 0xfc, 0xd7, 0x53, 0x92, 0x42,
+		0x31, 0x50, 0x09, 0x52, 0x1d, 0xcd, 0xb2, 0x55, 0xba, 0x1e, 0x93, 0xe6, 0xd9, 0x05, 0x8b, 0xe3,
+		0xa3, 0x02, 0xe1, 0x82, 0xe5, 0xac, 0x27, 0x08, 0x16, 0x2b, 0x6a, 0xa3, 0xd3, 0x20, 0x53, 0x22,
+		0x2d, 0x3a, 0x03, 0x99, 0x97, 0x18, 0xa1, 0x72, 0x4f, 0x93, 0x72, 0x36, 0x31, 0xa9, 0xaf, 0x78,
+		0x02, 0xe2, 0xf4, 0x41, 0x31, 0x0a, 0xc2, 0xae, 0xe3, 0xc6, 0x48, 0x01, 0xad, 0x7c, 0x08, 0x32,
+		0xae, 0xd5, 0x67, 0x20, 0x31, 0x46, 0xc5, 0x2d, 0xa6, 0x80, 0x8f, 0xc3, 0xa2, 0x81, 0xf7, 0x6c,
+		0x75, 0x18, 0x3a, 0x4e, 0xa1, 0x11, 0xa9, 0xbb, 0xee, 0xc7, 0x78, 0x00, 0xd2, 0x2d, 0x21, 0x7c,
+		0x06, 0x0b, 0x14, 0x36, 0xe5, 0x94, 0x52, 0xb0, 0xe3, 0x10, 0xd3, 0x7a, 0x3d, 0x06, 0x90, 0xe0,
+		0x56, 0xbf, 0xd7, 0xa3, 0x55, 0x67, 0x60, 0x9e, 0xf6, 0xb1, 0x8f, 0xad, 0x41, 0xc7, 0xe6, 0x44,
+		0x92, 0x14, 0x26, 0x43, 0x2a, 0x14, 0x56, 0x4e, 0x61, 0xef, 0x87, 0x14, 0xbe, 0xa5, 0xb7, 0xb1,
+		0xd1, 0xc2, 0x0c, 0x2e, 0x45, 0xe1, 0x92, 0xa2, 0x90, 0x02, 0x3d, 0x0c, 0x8e, 0x35, 0x57, 0xc5,
+		0x4a, 0x93, 0x66, 0xf4, 0x44, 0x79, 0x81, 0x15, 0xe7, 0xb3, 0x10, 0x2a, 0x6b, 0xb6, 0x46, 0xdc,
+		0x25, 0x7b, 0x8f, 0x2d, 0x9f, 0x49, 0x85, 0xfc, 0x99, 0xff, 0xd9, 0x20, 0x84, 0xae, 0x9b, 0x36,
+		0x46, 0x4f, 0x7a, 0x5c, 0xd9, 0xf4, 0x38, 0x7d, 0x6e, 0xe8, 0x3b, 0x06, 0x6e, 0xaf, 0x5b, 0x3b,
+		0x9e, 0x77, 0x80, 0x5d, 0x75, 0x0a, 0xf8, 0xd4, 0x69, 0x11, 0xc2, 0x7d, 0x73, 0x60, 0xb4, 0xc5,
+		0xbd, 0x09, 0xfa, 0x81, 0x2a, 0x10, 0x73, 0xb4, 0x24, 0x34, 0x4d, 0x4b, 0x32, 0x44, 0x4b, 0x88,
+		0x0e, 0xf3, 0x02, 0x25, 0xba, 0xc5, 0x95, 0xa5, 0x08, 0x71, 0xc7, 0x78, 0x71, 0x6d, 0x9b, 0x4d,
+		0x61, 0x5d, 0x34, 0xb2, 0x44, 0x3a, 0x63, 0xef, 0x08, 0x8f, 0x69, 0x9c, 0xec, 0x54, 0x70, 0xe9,
+		0xf9, 0xd4, 0x8a, 0xbf, 0x49, 0x1c, 0xa5, 0xfd, 0x72, 0xd5, 0x8a, 0xbd, 0x4b, 0x7c, 0x0f, 0xc4,
+		0x2d, 0x7d, 0xc7, 0xa0, 0x37, 0x84, 0xb8, 0xe6, 0xb9, 0x05, 0xa4, 0xd6, 0xbd, 0xd4, 0xcd, 0x34,
+		0xcd, 0xf3, 0x7c, 0xfe, 0x59, 0x58, 0x70, 0x1f, 0xae, 0x77, 0xa9, 0x30, 0x2d, 0x43, 0x4e, 0x55,
+		0x43, 0xd4, 0xe4, 0x7f, 0x49, 0x82, 0x08, 0x5f, 0xb1, 0xdc, 0x61, 0x90, 0xc6, 0x0f, 0x43, 0x60,
+		0xd2, 0x30, 0x04, 0xef, 0x7e, 0x18, 0x0a, 0x00, 0x0e, 0x9b, 0x16, 0x7f, 0x79, 0x76, 0x8c, 0x5b,
+		0xc5, 0x58, 0x6c, 0xe8, 0x3b, 0x7c, 0xde, 0x7b, 0x90, 0xf2, 0xbf, 0x2b, 0x11, 0x0f, 0x9f, 0xd7,
+		0xa3, 0x02, 0xa4, 0x04, 0x5f, 0xea, 0x76, 0x47, 0xdb, 0xe1, 0xaa, 0x78, 0xef, 0x44, 0xe6, 0xc8,
+		0x2a, 0xac, 0x24, 0x38, 0x3f, 0xd4, 0x9f, 0x18, 0x3b, 0xac, 0x81, 0x09, 0xc3, 0xea, 0xd3, 0xa3,
+		0xe0, 0xdd, 0xe9, 0x91, 0x6f, 0xc4, 0x43, 0x43, 0x23, 0x9e, 0xff, 0x3d, 0x89, 0xbf, 0x79, 0xdf,
+		0x66, 0xd7, 0xa1, 0xfe, 0x6f, 0x0d, 0xd5, 0x8b, 0x5c, 0xb7, 0xda, 0xb8, 0xad, 0x8e, 0x8c, 0xd9,
+		0xfd, 0xa3, 0x14, 0xfd, 0x3c, 0xbb, 0x63, 0x87, 0x04, 0x95, 0x86, 0x3b, 0x86, 0x9f, 0x0c, 0xc0,
+		0xfc, 0x08, 0xfc, 0xdf, 0xbe, 0xb1, 0xf4, 0xcf, 0xde, 0xf0, 0x8c, 0xb3, 0x37, 0x32, 0x71, 0xf6,
+		0x7e, 0x22, 0x40, 0x13, 0x00, 0x3d, 0xd3, 0xd2, 0x3a, 0x7f, 0x13, 0xb6, 0xf7, 0x04, 0xc4, 0x7b,
+		0x66, 0x47, 0x65, 0x35, 0xec, 0xe6, 0x5a, 0xac, 0x67, 0x76, 0x94, 0x11, 0x35, 0x0b, 0xbf, 0x4e,
+		0x86, 0x39, 0xf2, 0x3a, 0x0c, 0x42, 0x74, 0x78, 0x42, 0xf5, 0x21, 0xc9, 0x44, 0xc1, 0xbd, 0xa6,
+		0xc7, 0x89, 0x0c, 0xa8, 0x1b, 0x26, 0x8d, 0x7a, 0x79, 0x8c, 0x6d, 0x06, 0xa9, 0x70, 0x38, 0x82,
+		0xc1, 0x9c, 0x8c, 0x71, 0x99, 0x23, 0xaf, 0xc5, 0x52, 0x38, 0x5c, 0xfe, 0xbb, 0x24, 0x80, 0x35,
+		0x22, 0x59, 0xda, 0x5f, 0xe2, 0xef, 0x58, 0x94, 0x05, 0xd5, 0xd7, 0xf2, 0xc9, 0x49, 0x83, 0xc6,
+		0xdb, 0x4f, 0x5a, 0x5e, 0xbe, 0x4b, 0x90, 0x72, 0x75, 0xdb, 0xc2, 0x82, 0x99, 0x93, 0x07, 0x44,
+		0xa5, 0x0d, 0x6c, 0x2b, 0xc9, 0x5b, 0x9e, 0xaf, 0xfc, 0xbf, 0x92, 0x20, 0x4e, 0x79, 0x5a, 0xc7,
+		0xb6, 0xe6, 0x1b, 0x43, 0xe9, 0xee, 0xc7, 0xf0, 0x5e, 0x00, 0x46, 0xc6, 0xd2, 0x5f, 0xc6, 0x5c,
+		0xb3, 0xe2, 0xb4, 0xa4, 0xa1, 0xbf, 0x8c, 0xd1, 0x79, 0x47, 0xe0, 0xc1, 0x83, 0x05, 0x2e, 0xa2,
+		0x56, 0x2e, 0xf6, 0x63, 0x10, 0xa5, 0x8f, 0x81, 0xec, 0x59, 0x3c, 0x10, 0x8d, 0x18, 0x83, 0x6e,
+		0x73, 0xcf, 0xca, 0xbf, 0x04, 0xd1, 0xe6, 0x1e, 0xcb, 0x27, 0x9e, 0x80, 0x78, 0xdf, 0x34, 0xb9,
+		0xf7, 0xc7, 0xbc, 0xee, 0x18, 0x29, 0xa0, 0xce, 0x8e, 0xc8, 0xa1, 0x05, 0xdc, 0x1c, 0x9a, 0x9b,
+		0x04, 0x0c, 0xce, 0x94, 0x04, 0x24, 0xd1, 0x67, 0xca, 0x37, 0x93, 0xd0, 0xa3, 0x70, 0xac, 0x51,
+		0xbd, 0x5c, 0xab, 0x94, 0xd5, 0xf5, 0xc6, 0xe5, 0xa1, 0x4b, 0xfa, 0xb9, 0xcc, 0x2b, 0x77, 0x96,
+		0x12, 0x3c, 0xec, 0x9c, 0x04, 0xbd, 0xa1, 0x54, 0xae, 0xd7, 0x9b, 0x15, 0x59, 0x62, 0xd0, 0x1b,
+		0x7d, 0x7c, 0xcb, 0xb4, 0xd9, 0x6f, 0x2a, 0x3d, 0x0e, 0xc7, 0xc7, 0x40, 0x3b, 0xc1, 0xe7, 0xfc,
+		0x2b, 0x77, 0x96, 0x52, 0x1b, 0x7d, 0xcc, 0xb4, 0x8c, 0x62, 0x2c, 0x43, 0x76, 0x14, 0xa3, 0xbe,
+		0x51, 0x6f, 0x14, 0xd6, 0xe4, 0xa5, 0x9c, 0xfc, 0xca, 0x9d, 0xa5, 0xa4, 0x30, 0x19, 0x04, 0xfe,
+		0x6b, 0x1f, 0x7d, 0x7e, 0x4b, 0xcc, 0x97, 0x35, 0x66, 0x71, 0x5d, 0x4f, 0xeb, 0x6b, 0xdd, 0xc3,
+		0x86, 0x9f, 0x53, 0x8e, 0x6b, 0xe4, 0x3f, 0x1e, 0x80, 0x8c, 0x13, 0xdc, 0x6c, 0xd0, 0x16, 0xd0,
+		0x93, 0xde, 0x8c, 0x5f, 0x62, 0xe2, 0xda, 0xc1, 0xa0, 0x45, 0x42, 0xf0, 0xcd, 0x10, 0x13, 0x4e,
+		0x32, 0x9f, 0x54, 0x4b, 0x63, 0xd6, 0x37, 0x0e, 0xc1, 0x51, 0x1d, 0x0c, 0xf4, 0x1c, 0xc4, 0x9d,
+		0x29, 0xe6, 0x3c, 0x03, 0x3f, 0x79, 0x4e, 0x72, 0x7c, 0x17, 0x07, 0x5d, 0x70, 0xc3, 0xb8, 0xd0,
+		0xa4, 0xc0, 0xf0, 0x3a, 0x03, 0xe0, 0xc8, 0x4e, 0x08, 0xf7, 0x38, 0x84, 0xb4, 0xad, 0x96, 0xce,
+		0x0d, 0xf0, 0x3d, 0xa3, 0x78, 0x85, 0x62, 0xa9, 0xca, 0x91, 0x28, 0x64, 0xbe, 0xca, 0x93, 0x29,
+		0x5c, 0x5e, 0xf4, 0x61, 0xdd, 0x3d, 0x95, 0xc5, 0xc2, 0xcc, 0xbb, 0x88, 0x75, 0xb5, 0xbd, 0x22,
+		0x0d, 0x87, 0x8f, 0x41, 0x94, 0x54, 0xee, 0xf0, 0xd7, 0xed, 0x82, 0x4a, 0xa4, 0xab, 0xed, 0x5d,
+		0xd6, 0xac, 0xab, 0xa1, 0x58, 0x50, 0x0e, 0xe5, 0x7f, 0x84, 0x78, 0x2a, 0x3e, 0xa9, 0xa0, 0x47,
+		0x00, 0x11, 0x0c, 0x6d, 0x07, 0xab, 0x64, 0xea, 0x52, 0xf1, 0x0a, 0xba, 0x99, 0xae, 0xb6, 0x57,
+		0xd8, 0xc1, 0xb5, 0x41, 0x97, 0x32, 0x60, 0xa1, 0x75, 0x90, 0x05, 0xb0, 0x18, 0x59, 0x2e, 0xfe,
+		0xe3, 0xa3, 0xbf, 0x4e, 0xc4, 0x01, 0xd8, 0x02, 0xf0, 0x2a, 0x59, 0x00, 0xd2, 0x8c, 0x9e, 0x73,
+		0x40, 0xc7, 0xd7, 0x95, 0xa0, 0xbf, 0x2b, 0xf9, 0xe7, 0x20, 0x33, 0x34, 0x02, 0x28, 0x0f, 0x29,
+		0x9e, 0xb1, 0xa2, 0x87, 0x1f, 0x58, 0x84, 0x13, 0x57, 0x12, 0x2c, 0x33, 0x45, 0x0f, 0x83, 0xac,
+		0xc6, 0x7e, 0xee, 0x43, 0xa7, 0x24, 0xba, 0xed, 0xf3, 0x08, 0xa4, 0x7c, 0x63, 0x20, 0xb2, 0xc8,
+		0x92, 0x9b, 0x45, 0x76, 0x81, 0x5f, 0x84, 0x24, 0x31, 0x40, 0xb8, 0xcd, 0x61, 0x1f, 0x84, 0x0c,
+		0x33, 0x90, 0xc3, 0xb2, 0x66, 0x0e, 0xcf, 0xba, 0x10, 0x78, 0x5e, 0x78, 0x40, 0x7e, 0xb1, 0x27,
+		0x04, 0xd4, 0x65, 0xcd, 0xca, 0xd7, 0x01, 0xdc, 0x41, 0x45, 0x05, 0xb8, 0x97, 0x18, 0x0f, 0xef,
+		0x61, 0x5d, 0xfe, 0x72, 0xb8, 0xcf, 0x63, 0xcc, 0x11, 0x20, 0xf7, 0x40, 0x2e, 0x7b, 0x3f, 0xfc,
+		0x0a, 0x85, 0x28, 0xbe, 0xf5, 0x63, 0x9f, 0x3d, 0x29, 0x7d, 0x6d, 0x6c, 0xc1, 0x7f, 0xb9, 0x01,
+		0x27, 0x3c, 0x95, 0x44, 0x01, 0x7d, 0x89, 0xa8, 0x8c, 0x47, 0x5f, 0x49, 0xe5, 0xb4, 0x84, 0xd2,
+		0x81, 0xf9, 0xdf, 0xdc, 0xc1, 0x66, 0x67, 0x7a, 0xb6, 0x69, 0x7a, 0xbe, 0x6b, 0x7c, 0x52, 0xfe,
+		0x17, 0xe3, 0x10, 0x55, 0xf0, 0x3b, 0x06, 0xd8, 0xb2, 0xd1, 0x0a, 0x84, 0x70, 0x6b, 0xd7, 0x1c,
+		0x97, 0x03, 0x25, 0x1d, 0x5c, 0xe6, 0x70, 0x95, 0xd6, 0xae, 0x79, 0x65, 0x4e, 0xa1, 0xb0, 0xe8,
+		0x1c, 0x84, 0xb7, 0x3b, 0x03, 0x9e, 0xbe, 0x1a, 0xb2, 0x59, 0x5e, 0xa4, 0x4b, 0x04, 0xe8, 0xca,
+		0x9c, 0xc2, 0xa0, 0x49, 0x53, 0xf4, 0x07, 0xe7, 0x82, 0x07, 0x37, 0x55, 0x35, 0xb6, 0x69, 0x53,
+		0x04, 0x16, 0x15, 0x01, 0x74, 0x43, 0xb7, 0x55, 0x9a, 0xda, 0xe1, 0x56, 0xe3, 0xbe, 0xc9, 0x98,
+		0xba, 0x4d, 0x93, 0x41, 0x57, 0xe6, 0x94, 0xb8, 0x2e, 0x3e, 0x08, 0xbb, 0xef, 0x18, 0xe0, 0xfe,
+		0x3e, 0xf7, 0xd6, 0x26, 0xb2, 0xfb, 0x56, 0x02, 0x44, 0xd8, 0xa5, 0xd0, 0xc4, 0xc8, 0xb2, 0x9f,
+		0x12, 0xb0, 0xf7, 0xf8, 0x6f, 0xec, 0x9c, 0x9a, 0x84, 0x49, 0x7f, 0x4f, 0xa0, 0xb9, 0x77, 0x65,
+		0x4e, 0x89, 0xb6, 0xd8, 0x9f, 0xe8, 0x19, 0xc7, 0x05, 0x4b, 0x8c, 0x7a, 0x3d, 0x3e, 0x5c, 0x96,
+		0xf6, 0x99, 0x13, 0xae, 0x18, 0xaa, 0x41, 0xba, 0xa3, 0x5b, 0xb6, 0x6a, 0x19, 0x5a, 0xcf, 0xda,
+		0x35, 0x6d, 0xf1, 0x96, 0xf7, 0x03, 0x93, 0x28, 0xac, 0xe9, 0x96, 0xdd, 0x10, 0xc0, 0x57, 0xe6,
+		0x94, 0x54, 0xc7, 0x5b, 0x40, 0xe8, 0x99, 0xdb, 0xdb, 0xb8, 0xef, 0x10, 0xa4, 0x79, 0x96, 0x03,
+		0xe8, 0xd5, 0x09, 0xb4, 0xc0, 0x27, 0xf4, 0x4c, 0x6f, 0x01, 0x7a, 0x1b, 0x2c, 0x74, 0x4c, 0xad,
+		0xed, 0x90, 0x53, 0x5b, 0xbb, 0x03, 0xe3, 0x26, 0x4d, 0xca, 0x24, 0x56, 0x1e, 0x9e, 0xc8, 0xa4,
+		0xa9, 0xb5, 0x05, 0x89, 0x12, 0x41, 0xb8, 0x32, 0xa7, 0xcc, 0x77, 0x86, 0x0b, 0xd1, 0xdb, 0x61,
+		0x51, 0xeb, 0xf5, 0x3a, 0xfb, 0xc3, 0xd4, 0x33, 0x94, 0xfa, 0x99, 0x49, 0xd4, 0x0b, 0x04, 0x67,
+		0x98, 0x3c, 0xd2, 0x46, 0x4a, 0x51, 0x13, 0xe4, 0x5e, 0x1f, 0xd3, 0xfb, 0x6e, 0x3d, 0xee, 0x65,
+		0xd0, 0x47, 0xb2, 0x13, 0x2b, 0x0f, 0x4d, 0xa2, 0xbd, 0xc1, 0xe0, 0x85, 0x53, 0x72, 0x65, 0x4e,
+		0xc9, 0xf4, 0xfc, 0x45, 0x8c, 0xaa, 0xd9, 0xc2, 0xf4, 0x57, 0x05, 0x38, 0xd5, 0xf9, 0x69, 0x54,
+		0x29, 0xbc, 0x9f, 0xaa, 0xaf, 0x08, 0x55, 0x20, 0xc1, 0x42, 0x51, 0x95, 0x18, 0x43, 0xfa, 0x6b,
+		0x0d, 0x89, 0x95, 0xfc, 0xc4, 0x19, 0x4a, 0x41, 0xaf, 0x9b, 0x36, 0xbe, 0x32, 0xa7, 0x00, 0x76,
+		0xbe, 0x90, 0x06, 0x47, 0xe8, 0x4b, 0xe3, 0xfb, 0xaa, 0xdf, 0xf0, 0x66, 0x17, 0x28, 0xc1, 0x47,
+		0x26, 0x11, 0xa4, 0x3f, 0x02, 0xb6, 0x7f, 0xdd, 0x6b, 0x87, 0xaf, 0xcc, 0x29, 0x0b, 0xb7, 0x46,
+		0x8b, 0x89, 0x8a, 0x6d, 0xeb, 0x86, 0xd6, 0xd1, 0x5f, 0xc6, 0x6c, 0x09, 0xa5, 0x3f, 0xdc, 0x71,
+		0x80, 0x8a, 0x5d, 0xe2, 0xd0, 0x74, 0x61, 0x25, 0x2a, 0xb6, 0xed, 0x2d, 0x28, 0x46, 0xf9, 0x49,
+		0x5d, 0xe7, 0xf5, 0xdd, 0xa8, 0x1c, 0x63, 0xef, 0xc4, 0x5f, 0x0d, 0xc5, 0x40, 0x4e, 0xe4, 0x1f,
+		0x82, 0x84, 0xc7, 0x30, 0xa1, 0x2c, 0x44, 0xf9, 0x49, 0x1d, 0x71, 0xe6, 0x97, 0x7f, 0xe6, 0xd3,
+		0x90, 0xf4, 0x1a, 0xa3, 0xfc, 0x07, 0x24, 0x07, 0x93, 0x3e, 0xc9, 0x92, 0xf5, 0x67, 0xa4, 0xe3,
+		0xae, 0xa7, 0x72, 0xbf, 0x58, 0xda, 0x44, 0x3d, 0xdb, 0x7e, 0x4d, 0xd2, 0x42, 0xbe, 0xb2, 0xa2,
+		0x53, 0x90, 0xe8, 0xad, 0xf4, 0x1c, 0x90, 0x20, 0x05, 0x81, 0xde, 0x4a, 0x4f, 0x00, 0xdc, 0x07,
+		0x49, 0xd2, 0x6f, 0xd5, 0xeb, 0x2f, 0xc5, 0x95, 0x04, 0x29, 0xe3, 0x20, 0xf9, 0xdf, 0x08, 0x80,
+		0x3c, 0x6c, 0xc0, 0x9c, 0x54, 0xb5, 0x74, 0xe8, 0x54, 0xf5, 0xf1, 0xe1, 0x24, 0xb9, 0x9b, 0x17,
+		0x5f, 0x03, 0xd9, 0x4d, 0xef, 0xb2, 0xa5, 0x66, 0xb2, 0xff, 0x37, 0xe4, 0xa8, 0x2a, 0x99, 0xd6,
+		0x90, 0xe7, 0x7a, 0xc9, 0xb7, 0x59, 0x29, 0x7e, 0x91, 0x75, 0x78, 0xc0, 0x1d, 0x27, 0x66, 0xb3,
+		0xd7, 0xd6, 0x6c, 0x2c, 0xf2, 0x63, 0x9e, 0x7d, 0xcb, 0x07, 0x21, 0xa3, 0xf5, 0x7a, 0xaa, 0x65,
+		0x6b, 0x36, 0xe6, 0xbe, 0x06, 0xcb, 0x3c, 0xa4, 0xb4, 0x5e, 0x8f, 0xfe, 0xfa, 0x1c, 0xf3, 0x35,
+		0x1e, 0x80, 0x34, 0xb1, 0xe9, 0xba, 0xd6, 0x11, 0xae, 0x42, 0x84, 0xb9, 0x24, 0xbc, 0x94, 0x79,
+		0x07, 0xf9, 0xb6, 0x33, 0xe2, 0xd4, 0x9e, 0x3b, 0x31, 0x95, 0xe4, 0x89, 0xa9, 0x10, 0x7f, 0x2a,
+		0x87, 0xc9, 0x47, 0x3c, 0x36, 0x34, 0x7e, 0xd3, 0x60, 0x91, 0xc6, 0x5f, 0xb7, 0x58, 0xb2, 0x24,
+		0xa6, 0xb0, 0x8f, 0xbc, 0x02, 0x69, 0xbf, 0xed, 0x47, 0x69, 0x08, 0xd8, 0x7b, 0xbc, 0x95, 0x80,
+		0xbd, 0x47, 0x3c, 0x5d, 0xcf, 0xab, 0x90, 0xa3, 0xab, 0x1d, 0xc7, 0x73, 0x73, 0x1d, 0xf9, 0x0c,
+		0xa4, 0x7c, 0x6b, 0x42, 0xfe, 0x28, 0x2c, 0x8e, 0x33, 0xf1, 0xf9, 0x5d, 0xa7, 0xdc, 0x67, 0xaa,
+		0xd1, 0x39, 0x88, 0x39, 0x36, 0x7e, 0x4c, 0x74, 0x4c, 0x9b, 0x15, 0xc0, 0x8a, 0x03, 0xea, 0x4b,
+		0xe7, 0x07, 0x7c, 0xe9, 0xfc, 0xfc, 0x37, 0x40, 0x76, 0x92, 0xfd, 0x1e, 0x4a, 0xf2, 0x85, 0x1c,
+		0x81, 0x1d, 0x85, 0x08, 0x7f, 0x04, 0x31, 0x40, 0x37, 0xb0, 0xf8, 0x17, 0x11, 0x24, 0xb3, 0xe5,
+		0x41, 0xb6, 0xaf, 0x45, 0x3f, 0xf2, 0x2a, 0x1c, 0x9f, 0x68, 0xc3, 0x27, 0x6f, 0x85, 0x31, 0x42,
+		0x7c, 0x2b, 0xac, 0x25, 0xd8, 0xb1, 0x68, 0x5f, 0xc5, 0x41, 0x16, 0xf6, 0x95, 0x7f, 0x35, 0x08,
+		0x47, 0xc7, 0x5b, 0x72, 0xb4, 0x04, 0x49, 0xe2, 0xb7, 0xda, 0x7e, 0x17, 0x17, 0xba, 0xda, 0x5e,
+		0x93, 0xfb, 0xb7, 0x7c, 0x2b, 0x21, 0xe0, 0x6c, 0x25, 0xa0, 0x4d, 0x98, 0xef, 0x98, 0x2d, 0xad,
+		0xa3, 0x7a, 0xb6, 0x72, 0xf8, 0x24, 0xba, 0x7f, 0x44, 0xd8, 0xfe, 0x94, 0x21, 0x31, 0x38, 0x5c,
+		0xff, 0x33, 0x94, 0xc6, 0x9a, 0xb3, 0xeb, 0x83, 0xca, 0x90, 0xe8, 0xea, 0xd6, 0x16, 0xde, 0xd5,
+		0x6e, 0xe9, 0x66, 0x9f, 0xcf, 0xa6, 0x51, 0xa5, 0x59, 0x77, 0x61, 0xc4, 0x0e, 0x93, 0x07, 0xcd,
+		0x33, 0x24, 0xe1, 0xb1, 0x1b, 0x5f, 0x91, 0x43, 0x5b, 0x93, 0x49, 0x7b, 0x48, 0xd1, 0x89, 0x7b,
+		0x48, 0xe3, 0x36, 0x6c, 0x62, 0xe3, 0x37, 0x6c, 0xde, 0xeb, 0x1d, 0x1a, 0xff, 0xda, 0x37, 0xb2,
+		0x87, 0x83, 0x1a, 0xb0, 0xc8, 0xf1, 0xdb, 0x3e, 0xd9, 0x8f, 0x39, 0xea, 0xc0, 0xe6, 0xd7, 0xb0,
+		0xcc, 0x91, 0x40, 0x9f, 0x2c, 0xf6, 0xe0, 0xdd, 0x89, 0x5d, 0x6c, 0xa2, 0x86, 0x3c, 0x9b, 0xa8,
+		0xff, 0x8f, 0x0d, 0xc5, 0xbb, 0x83, 0x30, 0x3f, 0xe2, 0x48, 0x8c, 0xdd, 0x1d, 0x9e, 0x94, 0x91,
+		0x15, 0x1d, 0x0b, 0x1e, 0xba, 0x63, 0x7c, 0xac, 0x43, 0xd3, 0xc7, 0x3a, 0xfc, 0x3a, 0x8e, 0x75,
+		0xe4, 0xee, 0xc6, 0xfa, 0x6b, 0x3a, 0x0a, 0xdf, 0x27, 0x41, 0x6e, 0xb2, 0xf7, 0x35, 0x76, 0x38,
+		0x0e, 0xb5, 0x81, 0x30, 0x69, 0x8d, 0x7b, 0x00, 0xd2, 0x43, 0xbe, 0x21, 0x53, 0xe5, 0x94, 0x2f,
+		0x0a, 0xcf, 0xbf, 0x27, 0xe8, 0x2c, 0x3c, 0x3e, 0x07, 0x6e, 0xcc, 0x6c, 0x7d, 0x2b, 0x2c, 0xb4,
+		0x71, 0x4b, 0x6f, 0xdf, 0xed, 0x64, 0x9d, 0xe7, 0xd8, 0xff, 0x7f, 0xae, 0x8e, 0x6a, 0xc9, 0x77,
+		0x02, 0xc4, 0x14, 0x6c, 0xf5, 0x88, 0x3f, 0x86, 0x8a, 0x10, 0xc7, 0x7b, 0x2d, 0xdc, 0xb3, 0xdd,
+		0x43, 0x15, 0xe3, 0x42, 0x04, 0x06, 0x5d, 0x11, 0x90, 0x24, 0x40, 0x76, 0xd0, 0xd0, 0x93, 0x3c,
+		0x07, 0x30, 0x39, 0x9c, 0xe7, 0xe8, 0xde, 0x24, 0xc0, 0x79, 0x91, 0x04, 0x08, 0x4e, 0x8c, 0x6f,
+		0x19, 0xd6, 0x50, 0x16, 0xe0, 0x49, 0x9e, 0x05, 0x08, 0x4d, 0x69, 0xcc, 0x97, 0x06, 0x28, 0xf9,
+		0xd2, 0x00, 0x91, 0x29, 0xdd, 0x9c, 0x90, 0x07, 0x38, 0x2f, 0xf2, 0x00, 0xd1, 0x29, 0x1c, 0x0f,
+		0x25, 0x02, 0x9e, 0xf5, 0x24, 0x02, 0xe2, 0xa3, 0xd9, 0x56, 0x1f, 0xea, 0x98, 0x4c, 0xc0, 0x05,
+		0x27, 0x13, 0x90, 0x9c, 0x98, 0x45, 0xe0, 0xc8, 0xc3, 0xa9, 0x80, 0xfa, 0x48, 0x2a, 0x20, 0xc5,
+		0x7f, 0x1a, 0x7c, 0x12, 0x89, 0x29, 0xb9, 0x80, 0xfa, 0x48, 0x2e, 0x20, 0x3d, 0x85, 0xe0, 0x94,
+		0x64, 0xc0, 0xd7, 0x8d, 0x4f, 0x06, 0x4c, 0x0e, 0xd7, 0x39, 0x9b, 0xb3, 0x65, 0x03, 0xd4, 0x09,
+		0xd9, 0x00, 0x79, 0x62, 0xe4, 0xca, 0xc8, 0xcf, 0x9c, 0x0e, 0xd8, 0x1c, 0x93, 0x0e, 0x60, 0x81,
+		0xfb, 0xe9, 0x89, 0xc4, 0x67, 0xc8, 0x07, 0x6c, 0x8e, 0xc9, 0x07, 0xa0, 0xa9, 0x64, 0xa7, 0x26,
+		0x04, 0x2e, 0xf9, 0x13, 0x02, 0x0b, 0x13, 0xbc, 0x4e, 0x77, 0xb6, 0x4f, 0xc8, 0x08, 0x6c, 0x4d,
+		0xca, 0x08, 0x2c, 0xf2, 0xdf, 0x0a, 0x99, 0x44, 0xf1, 0x10, 0x29, 0x81, 0xfa, 0x48, 0x4a, 0xe0,
+		0xc8, 0x14, 0x4d, 0x9b, 0x3d, 0x27, 0xc0, 0x7e, 0x41, 0x8e, 0xfd, 0x76, 0x1c, 0xc8, 0x89, 0xab,
+		0xa1, 0x58, 0x42, 0x4e, 0xe6, 0x1f, 0x26, 0x1e, 0xcc, 0x90, 0x9d, 0x23, 0xb1, 0x02, 0xee, 0xf7,
+		0xcd, 0xbe, 0xb8, 0x63, 0x41, 0x3f, 0xf2, 0xa7, 0x49, 0x8c, 0xe8, 0xda, 0xb4, 0x03, 0xf2, 0x07,
+		0x34, 0x26, 0xf3, 0xd8, 0xb1, 0xfc, 0xcf, 0x49, 0x2e, 0x2e, 0xcd, 0x20, 0x78, 0xe3, 0xcb, 0x38,
+		0x8f, 0x2f, 0x3d, 0x59, 0x85, 0x80, 0x3f, 0xab, 0x70, 0x0a, 0x12, 0x24, 0xd6, 0x1a, 0x4a, 0x18,
+		0x68, 0x3d, 0x27, 0x61, 0x20, 0x0e, 0x50, 0xb1, 0xdc, 0x03, 0x5f, 0x96, 0xd8, 0x6e, 0x62, 0xc6,
+		0x39, 0x4c, 0xc6, 0x42, 0x5d, 0xf4, 0x18, 0x2c, 0x78, 0x60, 0x9d, 0x18, 0x8e, 0x45, 0xcf, 0xb2,
+		0x03, 0x5d, 0xe0, 0xc1, 0xdc, 0xbf, 0x91, 0x5c, 0x09, 0xb9, 0x99, 0x86, 0x71, 0x49, 0x01, 0xe9,
+		0x75, 0x4a, 0x0a, 0x04, 0xee, 0x3a, 0x29, 0xe0, 0x8d, 0x49, 0x83, 0xfe, 0x98, 0xf4, 0x7f, 0x4a,
+		0xee, 0x98, 0x38, 0x21, 0x7e, 0xcb, 0x6c, 0x63, 0x1e, 0x25, 0xd2, 0xbf, 0x89, 0x4b, 0xd2, 0x31,
+		0xc5, 0x8f, 0x7e, 0x91, 0x3f, 0x09, 0x94, 0xb3, 0xf0, 0xc4, 0xf9, 0xba, 0xe2, 0x04, 0x98, 0x61,
+		0xef, 0x3d, 0x0e, 0x7e, 0xb9, 0x21, 0xe2, 0x5e, 0x6e, 0x70, 0xae, 0x8e, 0x47, 0x3d, 0x57, 0xc7,
+		0xd1, 0x33, 0x10, 0xa7, 0xc9, 0x7e, 0xd5, 0xec, 0x89, 0x9f, 0x61, 0x3f, 0x31, 0xf9, 0x62, 0x83,
+		0x45, 0x8f, 0x5c, 0xb3, 0xcb, 0x10, 0xae, 0xc7, 0x11, 0xf7, 0x79, 0x1c, 0xf7, 0x40, 0x9c, 0x70,
+		0xcf, 0x7e, 0x44, 0x14, 0xf8, 0xbb, 0x03, 0xa2, 0x20, 0xff, 0xa3, 0x01, 0xc8, 0x0c, 0x2d, 0x34,
+		0x63, 0xfb, 0x3e, 0x6e, 0x1b, 0x79, 0x36, 0x79, 0x9c, 0x04, 0xd8, 0xd1, 0x2c, 0xf5, 0xb6, 0x66,
+		0xd8, 0xb8, 0xcd, 0x85, 0xe2, 0x29, 0x41, 0x39, 0x88, 0x91, 0xaf, 0x81, 0x85, 0xdb, 0x3c, 0xfb,
+		0xe2, 0x7c, 0xa3, 0x2b, 0x10, 0xc1, 0xb7, 0xe8, 0x53, 0xc9, 0xec, 0x37, 0x5f, 0x8e, 0x8e, 0x86,
+		0xc3, 0xa4, 0xba, 0x98, 0x25, 0x83, 0xfd, 0x85, 0xcf, 0x9c, 0x92, 0x19, 0xf4, 0xa3, 0xce, 0x65,
+		0x36, 0x85, 0xe3, 0xfb, 0xa5, 0x10, 0x1b, 0x92, 0x02, 0xcd, 0x03, 0x26, 0x45, 0x78, 0x4f, 0x64,
+		0xca, 0xce, 0x78, 0x2b, 0xa9, 0x2e, 0xee, 0xf6, 0x4c, 0xb3, 0xa3, 0xb2, 0x39, 0x5e, 0x80, 0xb4,
+		0x7f, 0x5d, 0x65, 0x3f, 0xf5, 0x6d, 0x6b, 0xba, 0xa1, 0xfa, 0x9c, 0xe0, 0x24, 0x2b, 0x64, 0x73,
+		0xca, 0xfb, 0x0e, 0x76, 0x7e, 0x03, 0x8e, 0x8c, 0x5d, 0x57, 0xd1, 0xd3, 0x10, 0x77, 0x97, 0x64,
+		0x76, 0x4c, 0xff, 0x80, 0x4c, 0x8b, 0x0b, 0x9b, 0xff, 0x05, 0xc9, 0x25, 0xe9, 0xcf, 0xdd, 0x54,
+		0x20, 0xc2, 0xce, 0x4c, 0xf2, 0xf3, 0x31, 0x8f, 0xcd, 0xb6, 0x22, 0x2f, 0xb3, 0x03, 0x95, 0x0a,
+		0x47, 0xce, 0xbf, 0x1d, 0x22, 0xac, 0x04, 0x25, 0x20, 0xea, 0x7b, 0xed, 0xbe, 0x50, 0x2a, 0x55,
+		0x36, 0xc4, 0x9b, 0xca, 0x45, 0xfa, 0xa4, 0x2f, 0x29, 0x56, 0x2a, 0x57, 0x2b, 0xa5, 0xa6, 0x1c,
+		0x44, 0xf3, 0x90, 0x62, 0x7f, 0xf3, 0x37, 0xe9, 0xe5, 0x90, 0xa7, 0xa8, 0x51, 0xa9, 0x95, 0x2b,
+		0x8a, 0x1c, 0xce, 0x3f, 0x01, 0xc7, 0x27, 0xae, 0xe1, 0x6e, 0x62, 0x46, 0xf2, 0x24, 0x66, 0xf2,
+		0xaf, 0x06, 0x48, 0x50, 0x33, 0x69, 0x61, 0x46, 0x57, 0x87, 0x3a, 0xbe, 0x72, 0x88, 0x55, 0x7d,
+		0xa8, 0xf7, 0x24, 0x8e, 0xe9, 0xe3, 0x6d, 0x6c, 0xb7, 0x76, 0x99, 0xa3, 0xc0, 0x2c, 0x50, 0x4a,
+		0x49, 0xf1, 0x52, 0x8a, 0x64, 0x31, 0xb0, 0x97, 0x70, 0xcb, 0x56, 0x99, 0x12, 0x59, 0x34, 0x98,
+		0x88, 0x13, 0x30, 0x52, 0xda, 0x60, 0x85, 0xf9, 0x6f, 0x38, 0x94, 0x2c, 0xe3, 0x10, 0x56, 0x2a,
+		0x4d, 0xe5, 0x06, 0xfb, 0x41, 0x7f, 0xfa, 0xa7, 0xda, 0xa8, 0x15, 0x36, 0x1a, 0x57, 0xea, 0x44,
+		0x96, 0x0b, 0x90, 0x11, 0xb2, 0x14, 0x85, 0xe1, 0xfc, 0x23, 0x70, 0x6c, 0x82, 0x57, 0x31, 0xe6,
+		0x10, 0xeb, 0x87, 0x25, 0x2f, 0xb4, 0xdf, 0x33, 0xa8, 0x43, 0xc4, 0xb2, 0x35, 0x7b, 0x60, 0x71,
+		0x21, 0x3e, 0x3d, 0xab, 0x9b, 0xb1, 0x2c, 0xfe, 0x68, 0x50, 0x74, 0x85, 0x93, 0xc9, 0x9f, 0x83,
+		0xb4, 0xbf, 0x66, 0xb2, 0x0c, 0x5c, 0x25, 0x0a, 0xe4, 0x2f, 0x02, 0x1a, 0xf5, 0x3e, 0xc6, 0x84,
+		0x97, 0xd2, 0xb8, 0xf0, 0xf2, 0x87, 0x24, 0x38, 0x71, 0x80, 0xa7, 0x81, 0xde, 0x3a, 0xd4, 0xc9,
+		0x0b, 0x87, 0xf1, 0x53, 0x96, 0x59, 0xd9, 0x50, 0x37, 0x9f, 0x84, 0xa4, 0xb7, 0x7c, 0xb6, 0x4e,
+		0x7e, 0x21, 0xe0, 0x4e, 0x62, 0x7f, 0x1c, 0xec, 0x9a, 0x40, 0xe9, 0xab, 0x34, 0x81, 0x6f, 0x06,
+		0xb0, 0xf7, 0xc4, 0x29, 0x6a, 0xbe, 0x8e, 0xde, 0x3b, 0x26, 0xbf, 0x88, 0x5b, 0xcd, 0x3d, 0x3e,
+		0x09, 0xe2, 0x36, 0xff, 0xcb, 0x42, 0x0d, 0x6f, 0x52, 0x60, 0x40, 0xd7, 0x58, 0x8b, 0x07, 0xcc,
+		0xb3, 0x2e, 0xc6, 0x6e, 0xf2, 0x80, 0x15, 0x5b, 0xe8, 0x06, 0x1c, 0x1b, 0x72, 0x14, 0x1c, 0xd2,
+		0xa1, 0x59, 0xfd, 0x85, 0x23, 0x7e, 0x7f, 0x41, 0x90, 0xf6, 0xae, 0xf6, 0x61, 0xff, 0x6a, 0x7f,
+		0x03, 0xc0, 0x4d, 0x0e, 0xb8, 0xc7, 0xfe, 0x24, 0xef, 0xb1, 0xbf, 0x73, 0x10, 0x26, 0x9a, 0x24,
+		0xe4, 0x34, 0x6a, 0x8a, 0x89, 0x26, 0x78, 0x92, 0x0b, 0x0c, 0x3a, 0xaf, 0x03, 0x1a, 0x4d, 0xd0,
+		0x4e, 0x68, 0xe2, 0x59, 0x7f, 0x13, 0xf7, 0x4d, 0x4c, 0xf5, 0x8e, 0x6f, 0xea, 0x65, 0x08, 0xd3,
+		0x91, 0x1f, 0x7b, 0x97, 0xf2, 0xeb, 0x01, 0x34, 0xdb, 0xee, 0xeb, 0x5b, 0x03, 0xb7, 0x81, 0x53,
+		0xe3, 0x35, 0xa7, 0x20, 0xe0, 0x8a, 0xf7, 0x70, 0x15, 0x5a, 0x74, 0x51, 0x3d, 0x6a, 0xe4, 0x21,
+		0x98, 0xaf, 0x41, 0xda, 0x8f, 0x3b, 0xfe, 0x6e, 0xe8, 0xe8, 0xaf, 0xbd, 0xba, 0xde, 0x11, 0x7f,
+		0xae, 0x8a, 0x7e, 0xe4, 0xdf, 0x19, 0x80, 0xa4, 0x57, 0xf1, 0xfe, 0xee, 0xb9, 0x20, 0xf9, 0x6f,
+		0x96, 0x20, 0xe6, 0x74, 0xff, 0x80, 0xc3, 0xce, 0xee, 0x1d, 0x61, 0x67, 0xf3, 0x82, 0x6d, 0x13,
+		0x05, 0x9d, 0x6d, 0xa2, 0x8b, 0xce, 0xf2, 0x37, 0x29, 0x21, 0xe2, 0x95, 0xb5, 0x38, 0x70, 0xc8,
+		0x57, 0xfb, 0x8b, 0xb3, 0x5d, 0xc5, 0x5b, 0x84, 0xb0, 0xf7, 0x1a, 0x1d, 0xfb, 0xc8, 0xb7, 0x3d,
+		0x27, 0x8c, 0xd8, 0x44, 0xf4, 0xde, 0xd9, 0x93, 0x0e, 0x7d, 0x67, 0xcf, 0x69, 0x25, 0xe0, 0x6d,
+		0xe5, 0x3b, 0x24, 0x88, 0x89, 0x39, 0x81, 0xde, 0xe2, 0x3d, 0x79, 0x26, 0xb6, 0x36, 0x27, 0xda,
+		0x23, 0x4e, 0xdf, 0x73, 0xf0, 0x6c, 0xe4, 0xc0, 0x75, 0xf0, 0xb0, 0x07, 0xae, 0xb9, 0x67, 0xf7,
+		0x17, 0x12, 0xc8, 0xc3, 0x33, 0xf6, 0xab, 0xe6, 0x6e, 0x74, 0x99, 0x0b, 0x8e, 0x59, 0xe6, 0x26,
+		0x9d, 0xa2, 0x0e, 0x4d, 0x3a, 0x45, 0x3d, 0xda, 0xeb, 0xf0, 0x5d, 0xf6, 0xfa, 0xdd, 0x01, 0x48,
+		0x78, 0xd2, 0xa3, 0xe8, 0x29, 0xdf, 0x89, 0xec, 0xa5, 0x83, 0x52, 0xa9, 0x9e, 0x23, 0xd9, 0x3e,
+		0x31, 0x05, 0x0e, 0x2f, 0xa6, 0xd7, 0xff, 0x76, 0xd6, 0xf8, 0x0b, 0xac, 0xe1, 0x09, 0x17, 0x58,
+		0xbf, 0x49, 0x82, 0x98, 0xe3, 0x76, 0x1f, 0x76, 0x13, 0xf3, 0x28, 0x44, 0xb8, 0x67, 0xc9, 0x76,
+		0x31, 0xf9, 0xd7, 0xd8, 0xb4, 0x72, 0x0e, 0x62, 0x5d, 0x6c, 0x6b, 0xd4, 0x0e, 0xb2, 0x55, 0xcd,
+		0xf9, 0x3e, 0x73, 0x01, 0x12, 0x9e, 0x0d, 0x60, 0x62, 0x1a, 0x6b, 0x95, 0xe7, 0xe5, 0xb9, 0x5c,
+		0xf4, 0x95, 0x3b, 0x4b, 0xc1, 0x1a, 0xbe, 0x4d, 0x66, 0xb3, 0x52, 0x29, 0x5d, 0xa9, 0x94, 0xae,
+		0xc9, 0x52, 0x2e, 0xf1, 0xca, 0x9d, 0xa5, 0xa8, 0x82, 0x69, 0x46, 0xf1, 0xcc, 0x35, 0xc8, 0x0c,
+		0x0d, 0x8c, 0xdf, 0x6d, 0x41, 0x90, 0x2e, 0x6f, 0x6e, 0xac, 0x55, 0x4b, 0x85, 0x66, 0x45, 0x65,
+		0xe7, 0x76, 0xd1, 0x31, 0x58, 0x58, 0xab, 0x5e, 0xbe, 0xd2, 0x54, 0x4b, 0x6b, 0xd5, 0x4a, 0xad,
+		0xa9, 0x16, 0x9a, 0xcd, 0x42, 0xe9, 0x9a, 0x1c, 0x58, 0xb9, 0x93, 0x80, 0x50, 0xa1, 0x58, 0xaa,
+		0xa2, 0x12, 0x84, 0x68, 0x2a, 0xe4, 0xc0, 0x13, 0x60, 0xb9, 0x83, 0x73, 0xc3, 0xe8, 0x12, 0x84,
+		0x69, 0x96, 0x04, 0x1d, 0x7c, 0x24, 0x2c, 0x37, 0x25, 0x59, 0x4c, 0x98, 0xa1, 0x33, 0xf2, 0xc0,
+		0x33, 0x62, 0xb9, 0x83, 0x73, 0xc7, 0x68, 0x0d, 0xa2, 0x22, 0x48, 0x9e, 0x76, 0x70, 0x2b, 0x37,
+		0x35, 0xa1, 0x4b, 0xba, 0xc6, 0x92, 0x0d, 0x07, 0x1f, 0x1f, 0xcb, 0x4d, 0xc9, 0x2a, 0xa3, 0xaa,
+		0x73, 0x9f, 0x69, 0xca, 0x89, 0xb0, 0xdc, 0xb4, 0x3c, 0x31, 0x52, 0x20, 0xee, 0xa6, 0x71, 0xa6,
+		0x1f, 0x8a, 0xcb, 0xcd, 0x90, 0x30, 0x47, 0x6f, 0x87, 0x94, 0x3f, 0xd4, 0x9d, 0xed, 0xd4, 0x59,
+		0x6e, 0xc6, 0x8c, 0x34, 0xa1, 0xef, 0x8f, 0x7b, 0x67, 0x3b, 0x85, 0x96, 0x9b, 0x31, 0x41, 0x8d,
+		0x5e, 0x82, 0xf9, 0xd1, 0xb8, 0x74, 0xf6, 0x43, 0x69, 0xb9, 0x43, 0xa4, 0xac, 0x51, 0x17, 0xd0,
+		0x98, 0x78, 0xf6, 0x10, 0x67, 0xd4, 0x72, 0x87, 0xc9, 0x60, 0xa3, 0x36, 0x64, 0x86, 0x83, 0xc4,
+		0x59, 0xcf, 0xac, 0xe5, 0x66, 0xce, 0x66, 0xb3, 0x56, 0xfc, 0xc1, 0xe5, 0xac, 0x67, 0xd8, 0x72,
+		0x33, 0x27, 0xb7, 0xd1, 0x26, 0x80, 0x27, 0x3e, 0x9c, 0xe1, 0x4c, 0x5b, 0x6e, 0x96, 0x34, 0x37,
+		0xea, 0xc1, 0xc2, 0xb8, 0xc0, 0xf1, 0x30, 0x47, 0xdc, 0x72, 0x87, 0xca, 0x7e, 0x13, 0x7d, 0xf6,
+		0x87, 0x80, 0xb3, 0x1d, 0x79, 0xcb, 0xcd, 0x98, 0x06, 0x2f, 0x16, 0x26, 0x9e, 0x73, 0x7e, 0xe8,
+		0xc0, 0x73, 0xce, 0xee, 0xc9, 0x65, 0xf7, 0x9e, 0xc3, 0x9b, 0xe1, 0x4d, 0xfc, 0x05, 0x1d, 0xcb,
+		0xd6, 0x6e, 0xea, 0xc6, 0x8e, 0xf3, 0xcc, 0x12, 0xff, 0xe6, 0x87, 0x9c, 0x8f, 0xf2, 0x57, 0x7a,
+		0x44, 0xe9, 0x94, 0xc7, 0x96, 0x26, 0xbe, 0xd1, 0x39, 0xed, 0x3e, 0xc4, 0xf4, 0xe3, 0xcb, 0x07,
+		0x3c, 0xe4, 0x34, 0xe5, 0xb9, 0xa8, 0x31, 0x0f, 0x3d, 0xe5, 0x0e, 0x7c, 0x63, 0x20, 0x77, 0xd0,
+		0xb9, 0x6f, 0xe2, 0xc5, 0xa6, 0xaf, 0xe8, 0x96, 0x6d, 0xf6, 0xf5, 0x96, 0xd6, 0xa1, 0xcb, 0xcb,
+		0xc5, 0x59, 0x6f, 0x65, 0x15, 0xe3, 0xc4, 0x5d, 0xe1, 0x6f, 0x41, 0xf1, 0x9b, 0x42, 0x65, 0x88,
+		0xdc, 0xd2, 0x3a, 0xec, 0x4e, 0x94, 0xf7, 0x31, 0xb9, 0x61, 0x99, 0x7b, 0xfc, 0x28, 0x2f, 0x15,
+		0x86, 0x9b, 0xff, 0x2e, 0x7a, 0x9f, 0xa4, 0xdb, 0xd5, 0x2d, 0xa2, 0x8b, 0x0a, 0x0d, 0xa5, 0xaf,
+		0x42, 0xa8, 0xaf, 0xd9, 0x3c, 0xc0, 0x2c, 0x9e, 0x3f, 0xf4, 0xbb, 0x4c, 0xac, 0x05, 0x4a, 0x03,
+		0xbd, 0x15, 0x62, 0x5d, 0x6d, 0x4f, 0xa5, 0xf4, 0x02, 0x5f, 0x15, 0xbd, 0x68, 0x57, 0xdb, 0x23,
+		0xfc, 0xa1, 0xb7, 0xd3, 0x9f, 0x63, 0x54, 0x5b, 0xbb, 0x9a, 0xb1, 0x83, 0x19, 0xe5, 0xe0, 0x57,
+		0x45, 0x39, 0xd5, 0xd5, 0xf6, 0x4a, 0x94, 0x1a, 0xa1, 0xcf, 0x9f, 0xbc, 0xfa, 0x65, 0x89, 0x67,
+		0x0d, 0xa8, 0x60, 0x90, 0x06, 0x72, 0xcb, 0xf9, 0xa2, 0x8d, 0x8a, 0x2d, 0x8e, 0x87, 0x26, 0xc9,
+		0x7d, 0x48, 0xac, 0xc5, 0x14, 0x61, 0xef, 0x53, 0x9f, 0x39, 0x25, 0xb1, 0x56, 0x33, 0xad, 0x11,
+		0xb1, 0x27, 0x58, 0x32, 0x44, 0xa5, 0x3e, 0x6c, 0x60, 0xaa, 0x0f, 0x9b, 0x12, 0x3e, 0x2c, 0x23,
+		0x08, 0x0c, 0x9b, 0xd4, 0xf3, 0x3e, 0x7c, 0x4c, 0x82, 0x44, 0xd9, 0xf3, 0xf0, 0x65, 0x16, 0xa2,
+		0x5d, 0xd3, 0xd0, 0x6f, 0xe2, 0xbe, 0xb3, 0x47, 0xc5, 0x3e, 0x89, 0x9f, 0xc9, 0x7e, 0x51, 0xdc,
+		0xde, 0x17, 0xaf, 0x0b, 0x89, 0x6f, 0x82, 0x75, 0x1b, 0x6f, 0x59, 0xba, 0x90, 0xb3, 0x22, 0x3e,
+		0xd1, 0xc3, 0x20, 0x5b, 0xb8, 0x35, 0xe8, 0xeb, 0xf6, 0xbe, 0xda, 0x32, 0x0d, 0x5b, 0x6b, 0xd9,
+		0x3c, 0x0e, 0xcf, 0x88, 0xf2, 0x12, 0x2b, 0x26, 0x44, 0xda, 0xd8, 0xd6, 0xf4, 0x0e, 0x3b, 0x99,
+		0x19, 0x57, 0xc4, 0x27, 0x67, 0xf5, 0x4e, 0xd4, 0x1b, 0x87, 0x96, 0x40, 0x36, 0x7b, 0xb8, 0xef,
+		0x3b, 0x90, 0xc2, 0xb4, 0x31, 0xfb, 0xe9, 0x4f, 0x3e, 0xb6, 0xc8, 0x05, 0xce, 0x0f, 0x33, 0xb0,
+		0xdf, 0x79, 0x50, 0x32, 0x02, 0x43, 0x9c, 0x54, 0xb9, 0xe1, 0xdb, 0x95, 0x1a, 0x6c, 0xb9, 0xcf,
+		0xc8, 0x2c, 0x8e, 0x08, 0xb5, 0x60, 0xec, 0x17, 0xb3, 0xbf, 0xee, 0x92, 0x76, 0xe3, 0xd4, 0x6b,
+		0x78, 0xdf, 0xbb, 0x45, 0x45, 0xc9, 0x10, 0x17, 0xfe, 0x25, 0x4d, 0xef, 0xe0, 0x36, 0x4f, 0x6b,
+		0xf0, 0x2f, 0xb4, 0xea, 0xa4, 0x17, 0xd9, 0x6f, 0x8c, 0xe6, 0x27, 0xe9, 0x46, 0xd1, 0x34, 0xda,
+		0xfe, 0x3c, 0x22, 0x2a, 0x41, 0xc4, 0x36, 0x6f, 0x62, 0x83, 0x0b, 0xe8, 0x70, 0x4f, 0xab, 0x71,
+		0x54, 0xf4, 0x75, 0x20, 0xb7, 0x71, 0x07, 0xef, 0xb0, 0xfb, 0x92, 0xbb, 0x5a, 0x1f, 0xb3, 0xdb,
+		0xfa, 0x77, 0xf5, 0xbc, 0x5a, 0xc6, 0x21, 0xd5, 0xa0, 0x94, 0xd0, 0x86, 0xff, 0x69, 0xd5, 0x28,
+		0xdf, 0x3c, 0x9e, 0xd0, 0x47, 0x8f, 0xe6, 0x79, 0x2d, 0x8f, 0xef, 0x29, 0xd6, 0x87, 0x41, 0x1e,
+		0x18, 0x5b, 0xa6, 0x41, 0x7f, 0x30, 0x93, 0x47, 0x51, 0x31, 0xb6, 0x53, 0xe9, 0x94, 0xf3, 0x9d,
+		0xca, 0x0d, 0x48, 0xbb, 0xa0, 0x74, 0x86, 0xc4, 0x0f, 0x3b, 0x43, 0x52, 0x0e, 0x01, 0x02, 0x82,
+		0xd6, 0x01, 0xdc, 0x39, 0x48, 0xb7, 0xca, 0x12, 0x93, 0x47, 0xcc, 0x9d, 0xcd, 0xde, 0xce, 0x78,
+		0x08, 0x20, 0x03, 0x16, 0xba, 0xba, 0xa1, 0x5a, 0xb8, 0xb3, 0xad, 0x72, 0xc9, 0x11, 0xba, 0x09,
+		0x2a, 0xfe, 0xb7, 0x1c, 0x62, 0x34, 0x7f, 0xe7, 0x93, 0x8f, 0x65, 0xdc, 0x07, 0xea, 0x96, 0x1e,
+		0x5f, 0x7e, 0xea, 0xbc, 0x32, 0xdf, 0xd5, 0x8d, 0x06, 0xee, 0x6c, 0x97, 0x1d, 0xc2, 0xe8, 0xcd,
+		0x70, 0xc2, 0x15, 0x88, 0x69, 0xa8, 0xbb, 0x66, 0xa7, 0xad, 0xf6, 0xf1, 0xb6, 0xda, 0xa2, 0x4f,
+		0xff, 0x25, 0xa9, 0x18, 0x8f, 0x39, 0x20, 0x75, 0xe3, 0x8a, 0xd9, 0x69, 0x2b, 0x78, 0xbb, 0x44,
+		0xaa, 0xd1, 0xfd, 0xe0, 0x4a, 0x43, 0xd5, 0xdb, 0x56, 0x36, 0xb5, 0x14, 0x3c, 0x1d, 0x52, 0x92,
+		0x4e, 0x61, 0xb5, 0x6d, 0xad, 0xc6, 0xde, 0xfb, 0xa1, 0x53, 0x73, 0xaf, 0x7d, 0xe8, 0xd4, 0x5c,
+		0xfe, 0x12, 0x7d, 0x66, 0x8a, 0x4f, 0x2d, 0x6c, 0xa1, 0xf3, 0x10, 0xd7, 0xc4, 0x07, 0xbb, 0x44,
+		0x76, 0xc0, 0xd4, 0x74, 0x41, 0xf3, 0x3f, 0x22, 0x41, 0xa4, 0x7c, 0x7d, 0x43, 0xd3, 0xfb, 0xa8,
+		0x02, 0xf3, 0xae, 0xae, 0xce, 0x3a, 0xcb, 0x5d, 0xf5, 0x16, 0xd3, 0xbc, 0x36, 0xe9, 0xf4, 0x5a,
+		0xbc, 0x78, 0xdf, 0xa7, 0x3f, 0xf9, 0xd8, 0xbd, 0x9c, 0xcc, 0xf5, 0xa1, 0x83, 0x6c, 0x82, 0xde,
+		0xf0, 0x01, 0x37, 0x4f, 0x9f, 0xaf, 0x42, 0x94, 0xb1, 0x6a, 0xa1, 0xe7, 0x20, 0xdc, 0x23, 0x7f,
+		0xf0, 0xa4, 0xfc, 0xc9, 0x89, 0x3a, 0x4f, 0xe1, 0xbd, 0x1a, 0xc2, 0xf0, 0xf2, 0xef, 0x0b, 0x00,
+		0x94, 0xaf, 0x5f, 0x6f, 0xf6, 0xf5, 0x5e, 0x07, 0xdb, 0xaf, 0x57, 0xdf, 0x37, 0xe1, 0x88, 0xe7,
+		0x7a, 0x74, 0xbf, 0x75, 0xf8, 0xfe, 0x2f, 0xb8, 0x37, 0xa5, 0xfb, 0xad, 0xb1, 0x64, 0xdb, 0x96,
+		0xed, 0x90, 0x0d, 0x1e, 0x9e, 0x6c, 0xd9, 0xb2, 0x47, 0x25, 0xfb, 0x02, 0x24, 0x5c, 0x61, 0x58,
+		0xa8, 0x0a, 0x31, 0x9b, 0xff, 0xcd, 0x05, 0x9c, 0x9f, 0x2c, 0x60, 0x81, 0xe6, 0x15, 0xb2, 0x83,
+		0x9e, 0xff, 0x2b, 0x09, 0xc0, 0x33, 0x47, 0xde, 0x98, 0x3a, 0x46, 0x22, 0x78, 0x6e, 0x9c, 0x83,
+		0x77, 0xfd, 0x40, 0x27, 0x23, 0xe0, 0x11, 0xea, 0xfb, 0x03, 0xb0, 0xb0, 0x29, 0x66, 0xef, 0x1b,
+		0x5f, 0x06, 0x9b, 0x10, 0xc5, 0x86, 0xdd, 0xd7, 0x9d, 0x6d, 0xa5, 0xc7, 0x27, 0x8d, 0xf9, 0x98,
+		0x4e, 0x55, 0x0c, 0xbb, 0xbf, 0xef, 0xd5, 0x00, 0x41, 0xcb, 0x23, 0x8f, 0x0f, 0x06, 0x21, 0x3b,
+		0x09, 0x15, 0x3d, 0x04, 0x99, 0x56, 0x1f, 0xd3, 0x02, 0xff, 0xad, 0xd1, 0xb4, 0x28, 0xe6, 0xcb,
+		0x8e, 0x02, 0xc4, 0x51, 0x23, 0xca, 0x45, 0x40, 0xef, 0xce, 0x33, 0x4b, 0xbb, 0x14, 0xe8, 0xc2,
+		0xd3, 0x84, 0x8c, 0xb8, 0x86, 0xb2, 0xa5, 0x75, 0x34, 0xa3, 0x25, 0x3c, 0xd8, 0x43, 0xad, 0xf9,
+		0xe2, 0x2a, 0x4b, 0x91, 0x91, 0x40, 0x15, 0x88, 0x0a, 0x6a, 0xa1, 0xc3, 0x53, 0x13, 0xb8, 0xe8,
+		0x3e, 0x48, 0x7a, 0x17, 0x06, 0xea, 0x8d, 0x84, 0x94, 0x84, 0x67, 0x5d, 0x98, 0xb6, 0xf2, 0x44,
+		0x0e, 0x5c, 0x79, 0xb8, 0xc3, 0xf7, 0x03, 0xf4, 0xb4, 0x78, 0xfb, 0x6f, 0xff, 0xb0, 0x6c, 0x00,
+		0xb0, 0xa9, 0x4a, 0x2c, 0x29, 0x1f, 0x99, 0xbb, 0x98, 0xef, 0x71, 0x46, 0xa4, 0x6c, 0xd9, 0x7f,
+		0x53, 0x23, 0xf4, 0xbb, 0x01, 0x48, 0x7a, 0x47, 0xe8, 0xef, 0xe4, 0xa2, 0x85, 0x6a, 0xae, 0x99,
+		0x62, 0x37, 0x6a, 0x1e, 0x9e, 0x64, 0xa6, 0x46, 0xb4, 0x79, 0x8a, 0x7d, 0xfa, 0x6c, 0x14, 0x22,
+		0xfc, 0xc8, 0x5b, 0x7d, 0xc4, 0xb7, 0x95, 0xa6, 0xbd, 0x09, 0x90, 0x12, 0x6f, 0x02, 0x8c, 0x75,
+		0x6d, 0x1f, 0x80, 0x34, 0x89, 0x91, 0x7d, 0xe7, 0xe8, 0xa4, 0xd3, 0x29, 0x1a, 0xea, 0xba, 0xa7,
+		0xc6, 0xd1, 0x29, 0x48, 0x10, 0x30, 0xd7, 0x0e, 0x13, 0x18, 0xe8, 0x6a, 0x7b, 0x15, 0x56, 0x82,
+		0x1e, 0x03, 0xb4, 0xeb, 0xe4, 0x2c, 0x54, 0x57, 0x10, 0x04, 0x6e, 0xde, 0xad, 0x11, 0xe0, 0xf7,
+		0x02, 0x10, 0x2e, 0x54, 0xf6, 0x26, 0x35, 0x7f, 0xf1, 0x9b, 0x94, 0x94, 0xe9, 0xbb, 0xd4, 0xef,
+		0x91, 0x98, 0x8b, 0x3c, 0x14, 0x49, 0xf3, 0x08, 0xa5, 0x39, 0xc3, 0xa4, 0xf8, 0xf3, 0xcf, 0x9c,
+		0xca, 0xed, 0x6b, 0xdd, 0xce, 0x6a, 0x7e, 0x0c, 0x9d, 0xfc, 0xb8, 0xe0, 0x9e, 0x38, 0xce, 0xfe,
+		0x48, 0x1c, 0xfd, 0x7d, 0xaf, 0xa6, 0x50, 0x7e, 0xb7, 0xb5, 0x96, 0x6d, 0xf6, 0x69, 0x40, 0x13,
+		0x2f, 0x56, 0x0f, 0x3d, 0x39, 0x47, 0x1c, 0xf6, 0x73, 0x4f, 0xb1, 0xc6, 0x5d, 0x8d, 0x22, 0x01,
+		0xdf, 0x25, 0xda, 0x0a, 0x91, 0xc2, 0xf1, 0x9d, 0x8e, 0xb9, 0xa5, 0x75, 0xd4, 0x8e, 0xfe, 0x8e,
+		0x81, 0xde, 0x56, 0xb9, 0x26, 0xa9, 0x2d, 0xad, 0xc7, 0x36, 0x8a, 0x5f, 0x4f, 0x1e, 0x8e, 0xb2,
+		0xb6, 0xd6, 0x68, 0x53, 0x0d, 0xd6, 0x52, 0x49, 0xeb, 0xa1, 0x57, 0x24, 0xb8, 0xc7, 0x15, 0xc3,
+		0x18, 0x4e, 0xe2, 0xaf, 0x37, 0x27, 0xc7, 0x9d, 0xe6, 0x46, 0x98, 0xd1, 0x40, 0xbe, 0x89, 0xf7,
+		0xd5, 0x3e, 0xff, 0x99, 0x7f, 0x75, 0x1b, 0x63, 0x1e, 0x90, 0x1d, 0x5f, 0x1e, 0xf3, 0x68, 0xfb,
+		0x72, 0xc9, 0xd4, 0x0d, 0x76, 0x7e, 0x61, 0x62, 0x6b, 0xe9, 0x9b, 0x78, 0x5f, 0xe1, 0xf4, 0x2e,
+		0x61, 0x8c, 0x6a, 0x70, 0x9c, 0xa6, 0x8d, 0x4c, 0x43, 0x64, 0x03, 0x9c, 0xe6, 0x2c, 0x1a, 0xa4,
+		0xa5, 0x8a, 0x0b, 0x63, 0x88, 0x29, 0x47, 0xbb, 0xda, 0x5e, 0xc9, 0x34, 0x78, 0xe8, 0x2f, 0x28,
+		0x5a, 0xab, 0x6f, 0x22, 0x46, 0xf2, 0x95, 0xcf, 0xfd, 0xe4, 0x99, 0x13, 0x9e, 0xc7, 0xc4, 0xf7,
+		0x9c, 0xbc, 0x28, 0x9b, 0xd9, 0x24, 0xde, 0x41, 0xae, 0xef, 0xe1, 0x5c, 0xb9, 0x58, 0x07, 0xf0,
+		0x84, 0x88, 0xd2, 0xc1, 0xa1, 0xa7, 0x8b, 0xef, 0x0b, 0x3d, 0x3d, 0x96, 0xf9, 0x2d, 0xee, 0xd2,
+		0x1f, 0x98, 0x26, 0x35, 0xaf, 0x51, 0xe2, 0x48, 0xd4, 0xe0, 0xcf, 0xe5, 0xff, 0x83, 0x04, 0xc7,
+		0x47, 0x8c, 0x98, 0xc3, 0x72, 0x0b, 0x50, 0xdf, 0x53, 0x49, 0x8d, 0x81, 0xd8, 0xe3, 0xbf, 0x3b,
+		0x9b, 0x38, 0xdf, 0x1f, 0x59, 0xff, 0x5f, 0x1f, 0x1f, 0x86, 0x2f, 0x60, 0xbf, 0x26, 0xc1, 0xa2,
+		0x97, 0x01, 0xa7, 0x2b, 0x0d, 0x48, 0x7a, 0x9b, 0xe6, 0x9d, 0x78, 0xd3, 0x2c, 0x9d, 0xf0, 0xf2,
+		0xef, 0x23, 0x82, 0xae, 0xbb, 0x0b, 0x05, 0x4b, 0xc8, 0x3e, 0x31, 0xb3, 0x50, 0x04, 0x63, 0x63,
+		0x17, 0x0c, 0x36, 0x36, 0x7f, 0x24, 0x41, 0x68, 0xc3, 0x34, 0x3b, 0xe8, 0x1d, 0x30, 0x6f, 0x98,
+		0x36, 0x35, 0x5b, 0xb8, 0xad, 0xf2, 0x8c, 0x11, 0x5b, 0x84, 0x2b, 0x07, 0xca, 0xea, 0x0b, 0x9f,
+		0x39, 0x35, 0x8a, 0x39, 0xee, 0xc7, 0x06, 0x32, 0x86, 0x69, 0x17, 0x29, 0x50, 0x93, 0x25, 0x95,
+		0xb6, 0x21, 0xe5, 0x6f, 0x8e, 0x2d, 0xd4, 0x85, 0x69, 0xcd, 0xa5, 0xa6, 0x36, 0x95, 0xdc, 0xf2,
+		0xb4, 0xc3, 0xde, 0xe7, 0xfe, 0x53, 0x32, 0x72, 0x37, 0x40, 0xbe, 0x3e, 0x7c, 0x76, 0xac, 0x02,
+		0x51, 0x71, 0x56, 0x4c, 0x9a, 0xf1, 0x18, 0x9a, 0x57, 0x9c, 0x1c, 0x37, 0xff, 0x5b, 0x12, 0x2c,
+		0xd0, 0xf6, 0xf4, 0x97, 0x31, 0x4d, 0x6b, 0x29, 0xb8, 0x65, 0xf6, 0xdb, 0x28, 0x0d, 0x01, 0xfe,
+		0x20, 0x58, 0x48, 0x09, 0xe8, 0x6d, 0xb4, 0x0c, 0x61, 0xf3, 0xb6, 0xc1, 0x8f, 0xa2, 0x1c, 0xe4,
+		0xe0, 0x30, 0x30, 0xba, 0xe2, 0x9a, 0xed, 0x41, 0x07, 0xab, 0x5a, 0x8b, 0x39, 0x59, 0x41, 0xfe,
+		0xf3, 0x3c, 0xb4, 0xb4, 0xc0, 0x0a, 0xfd, 0x0f, 0x27, 0x85, 0x66, 0xf5, 0x4c, 0x5c, 0x9c, 0xd5,
+		0x63, 0x44, 0x48, 0x9f, 0x1e, 0xb5, 0x53, 0xf9, 0x5f, 0x0e, 0x40, 0x86, 0xd9, 0x55, 0x37, 0x8f,
+		0xba, 0x36, 0x31, 0x8f, 0x3a, 0x43, 0xa3, 0x23, 0x09, 0xd5, 0xb7, 0x41, 0x4a, 0x2c, 0x13, 0x2c,
+		0x78, 0xfd, 0xea, 0x12, 0xfa, 0x49, 0x46, 0x8c, 0xe7, 0x16, 0x5f, 0x1a, 0x59, 0x94, 0x7d, 0x11,
+		0xf2, 0xdd, 0x36, 0xe2, 0x5f, 0x81, 0x59, 0x5b, 0x07, 0xc8, 0x30, 0x08, 0xc7, 0xb9, 0xad, 0xbf,
+		0xe6, 0xda, 0x7a, 0xb6, 0x6b, 0xb3, 0xff, 0x3a, 0x4b, 0xf3, 0x3a, 0x64, 0x88, 0x53, 0xee, 0x59,
+		0x8f, 0xee, 0x32, 0x3b, 0x9d, 0x32, 0x3b, 0x6d, 0x77, 0x81, 0x22, 0x74, 0x0d, 0x7c, 0xdb, 0x47,
+		0x37, 0x78, 0x77, 0x74, 0x0d, 0x7c, 0xdb, 0x43, 0xd7, 0x3d, 0xe6, 0x12, 0xf2, 0x9d, 0xb9, 0x79,
+		0x96, 0x9f, 0xb9, 0x09, 0x1f, 0x36, 0xfc, 0x62, 0x07, 0x6f, 0xce, 0x43, 0x90, 0x2c, 0xf6, 0x91,
+		0x43, 0x2c, 0x5b, 0x04, 0x81, 0x8e, 0xe1, 0xdc, 0x98, 0x31, 0x3c, 0xf3, 0x33, 0x12, 0x80, 0x9b,
+		0x5e, 0x47, 0x8f, 0xc2, 0xb1, 0x62, 0xbd, 0x56, 0x56, 0x1b, 0xcd, 0x42, 0x73, 0xb3, 0xe1, 0xff,
+		0x95, 0x2e, 0xf1, 0x72, 0x9d, 0xd5, 0xc3, 0x2d, 0x7d, 0x5b, 0xc7, 0x6d, 0xf4, 0x20, 0x2c, 0xfa,
+		0xa1, 0xc9, 0x57, 0xa5, 0x2c, 0x4b, 0xb9, 0xe4, 0x2b, 0x77, 0x96, 0x62, 0x2c, 0x9d, 0x80, 0xdb,
+		0xe8, 0x34, 0x1c, 0x19, 0x85, 0xab, 0xd6, 0x2e, 0xcb, 0x81, 0x5c, 0xea, 0x95, 0x3b, 0x4b, 0x71,
+		0x27, 0xef, 0x80, 0xf2, 0x80, 0xbc, 0x90, 0x9c, 0x5e, 0x30, 0x07, 0xaf, 0xdc, 0x59, 0x8a, 0x30,
+		0x33, 0x9b, 0x0b, 0xbd, 0xf7, 0x07, 0x4f, 0xce, 0x9d, 0xf9, 0x7a, 0x80, 0xaa, 0xb1, 0xdd, 0xd7,
+		0x5a, 0x74, 0x39, 0xc9, 0xc1, 0xd1, 0x6a, 0xed, 0x92, 0x52, 0x28, 0x35, 0xab, 0xf5, 0xda, 0xd0,
+		0x8f, 0x8b, 0xf9, 0xeb, 0xca, 0xf5, 0xcd, 0xe2, 0x5a, 0x45, 0x6d, 0x54, 0x2f, 0xd7, 0xd8, 0x31,
+		0x1d, 0x5f, 0xdd, 0xf3, 0xb5, 0x66, 0x75, 0xbd, 0x22, 0x07, 0x8a, 0x97, 0x26, 0x6e, 0x03, 0x3f,
+		0x7a, 0xe0, 0xef, 0xa3, 0xb8, 0x2e, 0x8d, 0x6f, 0x2f, 0xf8, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
+		0x33, 0x83, 0x39, 0xf2, 0xcc, 0xba, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2437,6 +2721,21 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if !this.ValidatorBondFactor.Equal(that1.ValidatorBondFactor) {
+		return false
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
+		return false
+	}
+	if this.MaxConsPubkeyRotations != that1.MaxConsPubkeyRotations {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2493,6 +2792,69 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	return true
+}
+func (this *LiquidValidator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LiquidValidator)
+	if !ok {
+		that2, ok := that.(LiquidValidator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OperatorAddress != that1.OperatorAddress {
+		return false
+	}
+	if !this.LiquidShares.Equal(that1.LiquidShares) {
+		return false
+	}
+	if !this.ValidatorBondShares.Equal(that1.ValidatorBondShares) {
+		return false
+	}
+	return true
+}
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3330,6 +3692,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxConsPubkeyRotations != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxConsPubkeyRotations))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ValidatorBondFactor.Size()
+		i -= size
+		if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -3362,12 +3769,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err12 != nil {
-		return 0, err12
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n12
-	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i -= n13
+	i = encodeVarintStaking(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3586,30 +3993,206 @@ func (m *ValidatorUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *HistoricalInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Header.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Valset) > 0 {
-		for _, e := range m.Valset {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ValidatorBondShares.Size()
+		i -= size
+		if _, err := m.ValidatorBondShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LiquidShares.Size()
+		i -= size
+		if _, err := m.LiquidShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsPubKeyRotationHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsPubKeyRotationHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsPubKeyRotationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintStaking(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewConsPubkey != nil {
+		{
+			size, err := m.NewConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldConsPubkey != nil {
+		{
+			size, err := m.OldConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HistoricalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Valset) > 0 {
+		for _, e := range m.Valset {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
 	}
 	return n
 }
@@ -3942,6 +4525,17 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.KeyRotationFee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxConsPubkeyRotations != 0 {
+		n += 1 + sovStaking(uint64(m.MaxConsPubkeyRotations))
+	}
 	return n
 }
 
@@ -4016,6 +4610,75 @@ func (m *ValidatorUpdates) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.LiquidShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *ConsPubKeyRotationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.OldConsPubkey != nil {
+		l = m.OldConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.NewConsPubkey != nil {
+		l = m.NewConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6676,61 +7339,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6740,12 +7353,216 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeyRotationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsPubkeyRotations", wireType)
+			}
+			m.MaxConsPubkeyRotations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsPubkeyRotations |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
 			postIndex := iNdEx + msglen
@@ -7249,6 +8066,560 @@ func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldConsPubkey == nil {
+				m.OldConsPubkey = &any.Any{}
+			}
+			if err := m.OldConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewConsPubkey == nil {
+				m.NewConsPubkey = &any.Any{}
+			}
+			if err := m.NewConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -17,7 +17,8 @@ import (
 // PreBlocker will check if there is a scheduled plan and if it is ready to be executed.
 // If the current height is in the provided set of heights to skip, it will skip and clear the upgrade plan.
 // If it is ready, it will execute it if the handler is installed, and panic/abort otherwise.
-// If the plan is not ready, it will ensure the handler is not registered too early (and abort otherwise).
+// If the plan is not ready, it will ensure the handler is not registered too early (and abort otherwise),
+// and pre-download the binary of the plan when enabled with keeper.SetPreDownload.
 //
 // The purpose is to ensure the binary is switched EXACTLY at the desired block, and to allow
 // a migration to be executed if needed upon this switch (migration defined in the new binary)
@@ -85,6 +86,17 @@ func PreBlocker(ctx context.Context, k *keeper.Keeper) (appmodule.ResponsePreBlo
 			}, nil
 		}
 
+		// Delay the upgrade if not enough validators signalled they are ready for it
+		delayed, err := k.DelayUpgradeIfNotReady(ctx, plan)
+		if err != nil {
+			return nil, err
+		}
+		if delayed {
+			return &sdk.ResponsePreBlock{
+				ConsensusParamsChanged: false,
+			}, nil
+		}
+
 		// Prepare shutdown if we don't have an upgrade handler for this upgrade name (meaning this software is out of date)
 		if !k.HasHandler(plan.Name) {
 			// Write the upgrade info to disk. The UpgradeStoreLoader uses this info to perform or skip
@@ -96,6 +108,9 @@ func PreBlocker(ctx context.Context, k *keeper.Keeper) (appmodule.ResponsePreBlo
 
 			upgradeMsg := BuildUpgradeNeededMsg(plan)
 			logger.Error(upgradeMsg)
+			if status, ok := k.GetDownloadStatus(); ok && status.IsDoneFor(plan) {
+				logger.Info("upgrade binary was pre-downloaded", "path", status.BinaryPath, "sha256", status.Checksum)
+			}
 
 			// Returning an error will end up in a panic
 			return nil, errors.New(upgradeMsg)
@@ -123,6 +138,10 @@ func PreBlocker(ctx context.Context, k *keeper.Keeper) (appmodule.ResponsePreBlo
 		// Returning an error will end up in a panic
 		return nil, errors.New(downgradeMsg)
 	}
+
+	// get the binary of the upcoming upgrade ready, if enabled
+	k.PreDownloadUpgrade(ctx, plan)
+
 	return &sdk.ResponsePreBlock{
 		ConsensusParamsChanged: false,
 	}, nil
@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/plan"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// defaultPreDownloadRetryDelay is the delay after which a failed pre-download
+// is retried.
+const defaultPreDownloadRetryDelay = time.Minute
+
+// preDownloader downloads the binaries of scheduled upgrade plans in the
+// background. Its status is node-local: it is logged, counted by the
+// upgrade_download telemetry counter and returned by GetDownloadStatus. It is
+// never written to state nor emitted as events, which are part of the block
+// results and must be the same on all the nodes, whereas a download completes
+// after the block that started it and its outcome differs between nodes.
+type preDownloader struct {
+	daemonName string
+	retryDelay time.Duration
+
+	mu       sync.Mutex
+	status   types.DownloadStatus
+	failedAt time.Time
+}
+
+// SetPreDownload enables the pre-download of the binaries of scheduled upgrade
+// plans. Once a plan with binaries info is scheduled, the binary for the OS and
+// architecture of the node is downloaded into the data directory of the node
+// home and verified against the checksum of its URL before the upgrade height.
+// The daemonName is the name of the executable expected in the downloaded
+// archives.
+func (k *Keeper) SetPreDownload(daemonName string) {
+	k.preDownloader = &preDownloader{daemonName: daemonName, retryDelay: defaultPreDownloadRetryDelay}
+}
+
+// GetDownloadStatus returns the status of the pre-download of the binary of the
+// last upgrade plan. It returns false if no download was started.
+func (k Keeper) GetDownloadStatus() (types.DownloadStatus, bool) {
+	if k.preDownloader == nil {
+		return types.DownloadStatus{}, false
+	}
+
+	k.preDownloader.mu.Lock()
+	defer k.preDownloader.mu.Unlock()
+	return k.preDownloader.status, k.preDownloader.status.PlanName != ""
+}
+
+// GetDownloadDir returns the directory in which the binary of the given plan is
+// downloaded.
+func (k Keeper) GetDownloadDir(planName string) string {
+	return filepath.Join(k.homePath, "data", types.UpgradesDirName, planName)
+}
+
+// PreDownloadUpgrade starts the pre-download of the binary of the given plan if
+// it is enabled and not started yet for the name and info of the plan. A failed
+// download is retried once the retry delay has elapsed, while a plan whose info
+// lists no valid binary for the node is skipped until it is replaced. The
+// outcome is logged and returned by GetDownloadStatus.
+func (k Keeper) PreDownloadUpgrade(ctx context.Context, p types.Plan) {
+	d := k.preDownloader
+	if d == nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// a single download runs at a time, the plan is checked again once it is over
+	if d.status.State == types.DownloadInProgress {
+		return
+	}
+
+	infoHash := types.PlanInfoHash(p)
+	changed := d.status.PlanName != p.Name || d.status.InfoHash != infoHash
+	retry := d.status.State == types.DownloadFailed && time.Since(d.failedAt) >= d.retryDelay
+	if !changed && !retry {
+		return
+	}
+
+	logger := k.Logger(ctx)
+
+	// the info of the plan does not change until it is replaced, so a plan
+	// without a valid binary for the node is not retried
+	url, err := planBinaryURL(p)
+	if err != nil {
+		logger.Info("skipping pre-download of upgrade binary", "plan", p.Name, "info_hash", infoHash, "reason", err)
+		d.setStatus(types.DownloadStatus{PlanName: p.Name, InfoHash: infoHash, State: types.DownloadSkipped, Err: err})
+		return
+	}
+
+	dstRoot := k.GetDownloadDir(p.Name)
+	logger.Info("pre-downloading upgrade binary", "plan", p.Name, "info_hash", infoHash, "dir", dstRoot)
+	d.setStatus(types.DownloadStatus{PlanName: p.Name, InfoHash: infoHash, State: types.DownloadInProgress})
+	go d.download(logger, p.Name, url, dstRoot)
+}
+
+// download downloads and verifies the binary of the given plan from url into
+// dstRoot, and records the outcome.
+func (d *preDownloader) download(logger log.Logger, planName, url, dstRoot string) {
+	binaryPath, checksum, err := downloadBinary(url, dstRoot, d.daemonName)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	status := d.status
+	if err != nil {
+		logger.Error("failed to pre-download upgrade binary", "plan", planName, "err", err, "retry_in", d.retryDelay)
+		status.State = types.DownloadFailed
+		status.Err = err
+		d.failedAt = time.Now()
+	} else {
+		logger.Info("pre-downloaded upgrade binary", "plan", planName, "path", binaryPath, "sha256", checksum)
+		status.State = types.DownloadDone
+		status.BinaryPath = binaryPath
+		status.Checksum = checksum
+	}
+	d.setStatus(status)
+}
+
+// setStatus records the status of the download and counts its state in the
+// upgrade_download telemetry counter. The caller must hold the lock.
+func (d *preDownloader) setStatus(status types.DownloadStatus) {
+	d.status = status
+	telemetry.IncrCounterWithLabels( //nolint:staticcheck // TODO: switch to OpenTelemetry
+		[]string{types.ModuleName, "download"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("plan", status.PlanName), telemetry.NewLabel("state", status.State.String())},
+	)
+}
+
+// planBinaryURL returns the URL of the binary of the plan for the OS and
+// architecture of the node, or else for any of them. The URL must carry a
+// checksum.
+func planBinaryURL(p types.Plan) (string, error) {
+	info, err := plan.ParseInfo(p.Info, plan.ParseOptionEnforceChecksum(true))
+	if err != nil {
+		return "", err
+	}
+
+	if err := info.Binaries.ValidateBasic(true); err != nil {
+		return "", err
+	}
+
+	osArch := runtime.GOOS + "/" + runtime.GOARCH
+	url, ok := info.Binaries[osArch]
+	if !ok {
+		if url, ok = info.Binaries["any"]; !ok {
+			return "", fmt.Errorf("no binary for %s in plan info", osArch)
+		}
+	}
+
+	return url, nil
+}
+
+// downloadBinary downloads the binary at url into dstRoot, verifying it against
+// the checksum of the URL while downloading. It returns the path of the binary
+// and its sha256 checksum.
+func downloadBinary(url, dstRoot, daemonName string) (string, string, error) {
+	// start from a clean directory so that a previous partial download is not used
+	if err := os.RemoveAll(dstRoot); err != nil {
+		return "", "", err
+	}
+
+	if err := plan.DownloadUpgrade(dstRoot, url, daemonName); err != nil {
+		return "", "", err
+	}
+
+	binaryPath := filepath.Join(dstRoot, "bin", daemonName)
+	checksum, err := fileChecksum(binaryPath)
+	if err != nil {
+		return "", "", err
+	}
+
+	return binaryPath, checksum, nil
+}
+
+// fileChecksum returns the hex encoded sha256 checksum of the given file.
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("could not read %s: %w", path, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
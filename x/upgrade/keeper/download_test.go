@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func (s *KeeperTestSuite) TestPreDownloadUpgrade() {
+	binary := []byte("#!/usr/bin/env bash\necho upgraded\n")
+	srcPath := filepath.Join(s.T().TempDir(), "simd")
+	s.Require().NoError(os.WriteFile(srcPath, binary, 0o600))
+	checksum := fmt.Sprintf("%x", sha256.Sum256(binary))
+
+	planWithURL := func(name, url string) types.Plan {
+		return types.Plan{
+			Name:   name,
+			Height: 100,
+			Info:   fmt.Sprintf(`{"binaries":{"any":%q}}`, url),
+		}
+	}
+
+	// pre-download is disabled by default
+	s.upgradeKeeper.PreDownloadUpgrade(s.ctx, planWithURL("disabled", "file://"+srcPath+"?checksum=sha256:"+checksum))
+	_, ok := s.upgradeKeeper.GetDownloadStatus()
+	s.Require().False(ok)
+
+	s.upgradeKeeper.SetPreDownload("simd")
+
+	waitFor := func(p types.Plan, state types.DownloadState) types.DownloadStatus {
+		var status types.DownloadStatus
+		s.Require().Eventually(func() bool {
+			var ok bool
+			status, ok = s.upgradeKeeper.GetDownloadStatus()
+			return ok && status.PlanName == p.Name && status.InfoHash == types.PlanInfoHash(p) && status.State == state
+		}, 5*time.Second, 10*time.Millisecond)
+
+		// the node-local status is never emitted as events
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		s.upgradeKeeper.PreDownloadUpgrade(ctx, p)
+		s.Require().Empty(ctx.EventManager().Events())
+
+		return status
+	}
+
+	s.Run("missing checksum", func() {
+		p := planWithURL("no-checksum", "file://"+srcPath)
+		s.upgradeKeeper.PreDownloadUpgrade(s.ctx, p)
+		status := waitFor(p, types.DownloadSkipped)
+		s.Require().ErrorContains(status.Err, "missing checksum")
+	})
+
+	s.Run("checksum mismatch", func() {
+		p := planWithURL("bad-checksum", "file://"+srcPath+"?checksum=sha256:"+fmt.Sprintf("%x", sha256.Sum256([]byte("other"))))
+		s.upgradeKeeper.PreDownloadUpgrade(s.ctx, p)
+		status := waitFor(p, types.DownloadFailed)
+		s.Require().Error(status.Err)
+	})
+
+	s.Run("no binary for the node", func() {
+		p := types.Plan{Name: "other-arch", Height: 100, Info: `{"binaries":{"plan9/mips":"file:///simd?checksum=sha256:00"}}`}
+		s.upgradeKeeper.PreDownloadUpgrade(s.ctx, p)
+		status := waitFor(p, types.DownloadSkipped)
+		s.Require().ErrorContains(status.Err, "no binary for")
+	})
+
+	s.Run("downloaded and verified", func() {
+		p := planWithURL("v2", "file://"+srcPath+"?checksum=sha256:"+checksum)
+		s.upgradeKeeper.PreDownloadUpgrade(s.ctx, p)
+		status := waitFor(p, types.DownloadDone)
+		s.Require().NoError(status.Err)
+		s.Require().Equal(checksum, status.Checksum)
+		s.Require().Equal(filepath.Join(s.upgradeKeeper.GetDownloadDir("v2"), "bin", "simd"), status.BinaryPath)
+
+		bz, err := os.ReadFile(status.BinaryPath)
+		s.Require().NoError(err)
+		s.Require().Equal(binary, bz)
+	})
+
+	s.Run("binaries of the plan replaced", func() {
+		p := planWithURL("v2", "file://"+srcPath+"?checksum=sha256:"+fmt.Sprintf("%x", sha256.Sum256([]byte("other"))))
+		s.upgradeKeeper.PreDownloadUpgrade(s.ctx, p)
+		status := waitFor(p, types.DownloadFailed)
+		s.Require().False(status.IsDoneFor(planWithURL("v2", "file://"+srcPath+"?checksum=sha256:"+checksum)))
+	})
+
+	s.Run("failed download retried", func() {
+		missingPath := filepath.Join(s.T().TempDir(), "simd")
+		p := planWithURL("v3", "file://"+missingPath+"?checksum=sha256:"+checksum)
+		s.upgradeKeeper.PreDownloadUpgrade(s.ctx, p)
+		waitFor(p, types.DownloadFailed)
+
+		// the download is not retried before the retry delay
+		s.Require().NoError(os.WriteFile(missingPath, binary, 0o600))
+		s.upgradeKeeper.PreDownloadUpgrade(s.ctx, p)
+		status, _ := s.upgradeKeeper.GetDownloadStatus()
+		s.Require().Equal(types.DownloadFailed, status.State)
+
+		s.upgradeKeeper.SetPreDownloadRetryDelay(0)
+		s.upgradeKeeper.PreDownloadUpgrade(s.ctx, p)
+		status = waitFor(p, types.DownloadDone)
+		s.Require().True(status.IsDoneFor(p))
+	})
+
+	s.Run("no binaries info", func() {
+		// the plan is skipped once, even without retry delay
+		var logs bytes.Buffer
+		ctx := s.ctx.WithLogger(log.NewLogger(&logs, log.ColorOption(false)))
+		p := types.Plan{Name: "no-binaries", Height: 100}
+		for range 3 {
+			s.upgradeKeeper.PreDownloadUpgrade(ctx, p)
+		}
+
+		status := waitFor(p, types.DownloadSkipped)
+		s.Require().Error(status.Err)
+		s.Require().False(status.IsDoneFor(p))
+		s.Require().Equal(1, strings.Count(logs.String(), "skipping pre-download of upgrade binary"))
+		s.Require().NotContains(logs.String(), "failed to pre-download")
+	})
+}
@@ -0,0 +1,9 @@
+package keeper
+
+import "time"
+
+// SetPreDownloadRetryDelay sets the delay after which a failed pre-download is
+// retried.
+func (k Keeper) SetPreDownloadRetryDelay(delay time.Duration) {
+	k.preDownloader.retryDelay = delay
+}
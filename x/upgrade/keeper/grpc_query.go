@@ -87,3 +87,16 @@ func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersions
 func (k Keeper) Authority(c context.Context, req *types.QueryAuthorityRequest) (*types.QueryAuthorityResponse, error) {
 	return &types.QueryAuthorityResponse{Address: k.authority}, nil
 }
+
+// UpgradeReadiness implements the Query/UpgradeReadiness gRPC method
+func (k Keeper) UpgradeReadiness(c context.Context, _ *types.QueryUpgradeReadinessRequest) (*types.QueryUpgradeReadinessResponse, error) {
+	readiness, err := k.GetUpgradeReadiness(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUpgradeReadinessResponse{
+		Readiness:  readiness,
+		ReadyRatio: readinessRatio(readiness, readiness.PlanName),
+	}, nil
+}
@@ -46,6 +46,8 @@ type Keeper struct {
 	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
 	authority          string                          // the address capable of executing and canceling an upgrade. Usually the gov module account
 	initVersionMap     module.VersionMap               // the module version map at init genesis
+	preDownloader      *preDownloader                  // pre-downloads the binaries of upgrade plans, nil when disabled
+	readinessRule      *readinessRule                  // delays upgrades lacking validator readiness, nil when disabled
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
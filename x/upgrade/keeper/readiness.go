@@ -0,0 +1,374 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// maxReadinessFieldLength is the maximum length of the strings of a readiness
+// vote extension.
+const maxReadinessFieldLength = 256
+
+// Environment variables set for the binaries run by cosmovisor.
+const (
+	cosmovisorEnvHome = "DAEMON_HOME"
+	cosmovisorEnvName = "DAEMON_NAME"
+)
+
+// readinessTxPrefix prefixes the extended commit info injected by the proposer
+// as the first transaction of a block.
+var readinessTxPrefix = []byte("upgrade-readiness:")
+
+// readinessRule delays an upgrade plan by delay blocks when the voting power
+// ready for it is below threshold at its height.
+type readinessRule struct {
+	threshold math.LegacyDec
+	delay     int64
+}
+
+// SetReadinessRule delays the scheduled upgrade plan by delay blocks, as many
+// times as needed, when less than threshold of the voting power signalled it is
+// ready for it at its height. The rule only applies when the readiness was
+// aggregated from vote extensions. It is consensus critical and must be the
+// same on all the nodes.
+func (k *Keeper) SetReadinessRule(threshold math.LegacyDec, delay int64) {
+	if threshold.IsNil() || threshold.IsNegative() || threshold.GT(math.LegacyOneDec()) {
+		panic(fmt.Sprintf("invalid upgrade readiness threshold: %s", threshold))
+	}
+	if delay <= 0 {
+		panic(fmt.Sprintf("upgrade readiness delay must be positive: %d", delay))
+	}
+
+	k.readinessRule = &readinessRule{threshold: threshold, delay: delay}
+}
+
+// GetReadyFilePath returns the path of the file an operator creates to mark
+// the node ready for the given plan.
+func (k Keeper) GetReadyFilePath(planName string) string {
+	return filepath.Join(k.homePath, "data", types.UpgradesDirName, url.PathEscape(planName)+".ready")
+}
+
+// isReadyFor returns true if this node is ready to switch to the binary of the
+// given plan, i.e. the operator marked it ready with the ready file, the binary
+// has been pre-downloaded, or the binary is installed in the upgrade directory
+// of cosmovisor.
+func (k Keeper) isReadyFor(plan types.Plan) bool {
+	if fileExists(k.GetReadyFilePath(plan.Name)) {
+		return true
+	}
+
+	if status, ok := k.GetDownloadStatus(); ok && status.IsDoneFor(plan) {
+		return true
+	}
+
+	return hasCosmovisorUpgradeBin(plan.Name)
+}
+
+// hasCosmovisorUpgradeBin returns true if the node is run by cosmovisor and the
+// binary of the given plan is installed in its upgrade directory. Cosmovisor
+// lowercases the plan names unless configured otherwise, so both cases are
+// looked up.
+func hasCosmovisorUpgradeBin(planName string) bool {
+	home, name := os.Getenv(cosmovisorEnvHome), os.Getenv(cosmovisorEnvName)
+	if home == "" || name == "" {
+		return false
+	}
+
+	for _, dir := range []string{planName, strings.ToLower(planName)} {
+		if fileExists(filepath.Join(home, "cosmovisor", "upgrades", url.PathEscape(dir), "bin", name)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fileExists returns true if path exists and is not a directory.
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+// ExtendVoteHandler returns an ExtendVoteHandler putting the version of the
+// binary of the validator and its readiness for the scheduled upgrade plan into
+// its vote extension.
+func (k Keeper) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, _ *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		ve := types.ReadinessVoteExtension{AppVersion: version.Version}
+
+		plan, err := k.GetUpgradePlan(ctx)
+		switch {
+		case err == nil:
+			ve.PlanName = plan.Name
+			ve.Ready = k.isReadyFor(plan)
+		case !errors.Is(err, types.ErrNoUpgradePlanFound):
+			return nil, err
+		}
+
+		bz, err := k.cdc.Marshal(&ve)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode readiness vote extension: %w", err)
+		}
+
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns a VerifyVoteExtensionHandler accepting
+// empty or well-formed readiness vote extensions.
+func (k Keeper) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(_ sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if len(req.VoteExtension) == 0 {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+		}
+
+		var ve types.ReadinessVoteExtension
+		if err := k.cdc.Unmarshal(req.VoteExtension, &ve); err != nil ||
+			len(ve.AppVersion) > maxReadinessFieldLength || len(ve.PlanName) > maxReadinessFieldLength {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+// PrepareProposalHandler wraps the given handler to inject the extended commit
+// info of the last block, which carries the readiness vote extensions, as the
+// first transaction of the proposal.
+func (k Keeper) PrepareProposalHandler(next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return next(ctx, req)
+		}
+
+		bz, err := req.LocalLastCommit.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode extended commit info: %w", err)
+		}
+		readinessTx := append(append([]byte{}, readinessTxPrefix...), bz...)
+
+		// leave room for the injected transaction
+		nextReq := *req
+		nextReq.MaxTxBytes -= int64(len(readinessTx))
+		resp, err := next(ctx, &nextReq)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Txs = append([][]byte{readinessTx}, resp.Txs...)
+		return resp, nil
+	}
+}
+
+// ProcessProposalHandler wraps the given handler to reject proposals without a
+// valid extended commit info injected as their first transaction, and to strip
+// it before calling the handler.
+func (k Keeper) ProcessProposalHandler(valStore baseapp.ValidatorStore, next sdk.ProcessProposalHandler) sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return next(ctx, req)
+		}
+
+		if len(req.Txs) == 0 {
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		extCommit, ok, err := parseReadinessTx(req.Txs[0])
+		if !ok || err != nil {
+			k.Logger(ctx).Error("proposal without upgrade readiness", "height", req.Height, "err", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, valStore, req.Height, ctx.ChainID(), extCommit); err != nil {
+			k.Logger(ctx).Error("proposal with invalid upgrade readiness", "height", req.Height, "err", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		nextReq := *req
+		nextReq.Txs = req.Txs[1:]
+		return next(ctx, &nextReq)
+	}
+}
+
+// PreBlocker wraps the given PreBlocker to aggregate the readiness vote
+// extensions injected in the block into state before calling it.
+func (k Keeper) PreBlocker(next sdk.PreBlocker) sdk.PreBlocker {
+	return func(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		if voteExtensionsEnabled(ctx, req.Height) && len(req.Txs) > 0 {
+			// the injected transaction was validated in ProcessProposal
+			if extCommit, ok, err := parseReadinessTx(req.Txs[0]); ok && err == nil {
+				if err := k.AggregateReadiness(ctx, extCommit); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		return next(ctx, req)
+	}
+}
+
+// AggregateReadiness stores the upgrade readiness of the validators of the
+// given extended commit info for the scheduled upgrade plan.
+func (k Keeper) AggregateReadiness(ctx context.Context, extCommit abci.ExtendedCommitInfo) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	readiness := types.UpgradeReadiness{
+		Height:     sdkCtx.HeaderInfo().Height,
+		Validators: make([]types.ValidatorReadiness, 0, len(extCommit.Votes)),
+	}
+
+	plan, err := k.GetUpgradePlan(ctx)
+	switch {
+	case err == nil:
+		readiness.PlanName = plan.Name
+	case !errors.Is(err, types.ErrNoUpgradePlanFound):
+		return err
+	}
+
+	for _, vote := range extCommit.Votes {
+		validator := types.ValidatorReadiness{
+			ConsensusAddress: sdk.ConsAddress(vote.Validator.Address).String(),
+			Power:            vote.Validator.Power,
+		}
+		readiness.TotalPower += vote.Validator.Power
+
+		// absent validators and invalid extensions do not count as ready
+		var ve types.ReadinessVoteExtension
+		if vote.BlockIdFlag == cmtproto.BlockIDFlagCommit && len(vote.VoteExtension) > 0 &&
+			k.cdc.Unmarshal(vote.VoteExtension, &ve) == nil {
+			validator.AppVersion = ve.AppVersion
+			validator.PlanName = ve.PlanName
+			validator.Ready = ve.Ready
+		}
+
+		if validator.Ready && readiness.PlanName != "" && validator.PlanName == readiness.PlanName {
+			readiness.ReadyPower += validator.Power
+		}
+
+		readiness.Validators = append(readiness.Validators, validator)
+	}
+
+	bz, err := k.cdc.Marshal(&readiness)
+	if err != nil {
+		return err
+	}
+
+	return k.storeService.OpenKVStore(ctx).Set([]byte{types.ReadinessByte}, bz)
+}
+
+// GetUpgradeReadiness returns the last aggregated upgrade readiness, or an
+// empty one if none was aggregated.
+func (k Keeper) GetUpgradeReadiness(ctx context.Context) (types.UpgradeReadiness, error) {
+	var readiness types.UpgradeReadiness
+
+	bz, err := k.storeService.OpenKVStore(ctx).Get([]byte{types.ReadinessByte})
+	if err != nil || bz == nil {
+		return readiness, err
+	}
+
+	err = k.cdc.Unmarshal(bz, &readiness)
+	return readiness, err
+}
+
+// DelayUpgradeIfNotReady delays the given plan, due at the current height, by
+// the delay of the readiness rule if the voting power ready for it is below the
+// threshold of the rule. It returns true if the plan was delayed.
+func (k Keeper) DelayUpgradeIfNotReady(ctx context.Context, plan types.Plan) (bool, error) {
+	if k.readinessRule == nil {
+		return false, nil
+	}
+
+	readiness, err := k.GetUpgradeReadiness(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// the rule cannot be evaluated without vote extensions
+	if readiness.Height == 0 {
+		return false, nil
+	}
+
+	ratio := readinessRatio(readiness, plan.Name)
+	if ratio.GTE(k.readinessRule.threshold) {
+		return false, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	oldHeight := plan.Height
+	plan.Height = sdkCtx.HeaderInfo().Height + k.readinessRule.delay
+
+	// keep the upgraded client, if any, along with the plan
+	upgradedClient, err := k.GetUpgradedClient(ctx, oldHeight)
+	if err != nil && !errors.Is(err, types.ErrNoUpgradedClientFound) {
+		return false, err
+	}
+
+	if err := k.ScheduleUpgrade(ctx, plan); err != nil {
+		return false, err
+	}
+
+	if len(upgradedClient) > 0 {
+		if err := k.SetUpgradedClient(ctx, plan.Height, upgradedClient); err != nil {
+			return false, err
+		}
+	}
+
+	k.Logger(ctx).Info("upgrade delayed for lack of readiness", "plan", plan.Name, "height", plan.Height, "ready_ratio", ratio)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpgradeDelayed,
+			sdk.NewAttribute(types.AttributeKeyPlanName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(plan.Height, 10)),
+			sdk.NewAttribute(types.AttributeKeyReadyRatio, ratio.String()),
+		),
+	)
+
+	return true, nil
+}
+
+// readinessRatio returns the ratio of the voting power ready for the given plan.
+func readinessRatio(readiness types.UpgradeReadiness, planName string) math.LegacyDec {
+	if readiness.PlanName != planName || readiness.TotalPower <= 0 {
+		return math.LegacyZeroDec()
+	}
+
+	return math.LegacyNewDec(readiness.ReadyPower).QuoInt64(readiness.TotalPower)
+}
+
+// voteExtensionsEnabled returns true if the proposal at the given height carries
+// the vote extensions of the previous one.
+func voteExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight != 0 && height > cp.Abci.VoteExtensionsEnableHeight
+}
+
+// parseReadinessTx decodes the extended commit info injected in the given
+// transaction. It returns false if the transaction is not an injected one.
+func parseReadinessTx(tx []byte) (abci.ExtendedCommitInfo, bool, error) {
+	var extCommit abci.ExtendedCommitInfo
+	if !bytes.HasPrefix(tx, readinessTxPrefix) {
+		return extCommit, false, nil
+	}
+
+	if err := extCommit.Unmarshal(tx[len(readinessTxPrefix):]); err != nil {
+		return extCommit, true, fmt.Errorf("failed to decode extended commit info: %w", err)
+	}
+
+	return extCommit, true, nil
+}
@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"os"
+	"path/filepath"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func (s *KeeperTestSuite) TestUpgradeReadiness() {
+	ctx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 2},
+	})
+	plan := types.Plan{Name: "v2", Height: 20}
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(ctx, plan))
+
+	extend := func(ready bool, planName string) []byte {
+		bz, err := s.encCfg.Codec.Marshal(&types.ReadinessVoteExtension{AppVersion: "v1.0.0", PlanName: planName, Ready: ready})
+		s.Require().NoError(err)
+		return bz
+	}
+
+	// the vote extension of the node signals it is not ready without the binary
+	resp, err := s.upgradeKeeper.ExtendVoteHandler()(ctx, &abci.RequestExtendVote{Height: 10})
+	s.Require().NoError(err)
+	var ve types.ReadinessVoteExtension
+	s.Require().NoError(s.encCfg.Codec.Unmarshal(resp.VoteExtension, &ve))
+	s.Require().Equal("v2", ve.PlanName)
+	s.Require().False(ve.Ready)
+
+	isReady := func() bool {
+		resp, err := s.upgradeKeeper.ExtendVoteHandler()(ctx, &abci.RequestExtendVote{Height: 10})
+		s.Require().NoError(err)
+		var ve types.ReadinessVoteExtension
+		s.Require().NoError(s.encCfg.Codec.Unmarshal(resp.VoteExtension, &ve))
+		return ve.Ready
+	}
+
+	// the operator can mark the node ready explicitly
+	readyFile := s.upgradeKeeper.GetReadyFilePath("v2")
+	s.Require().NoError(os.MkdirAll(filepath.Dir(readyFile), 0o755))
+	s.Require().NoError(os.WriteFile(readyFile, nil, 0o600))
+	s.Require().True(isReady())
+	s.Require().NoError(os.Remove(readyFile))
+	s.Require().False(isReady())
+
+	// the binary installed for cosmovisor makes the node ready
+	daemonHome := s.T().TempDir()
+	s.T().Setenv("DAEMON_HOME", daemonHome)
+	s.T().Setenv("DAEMON_NAME", "simd")
+	s.Require().False(isReady())
+	binDir := filepath.Join(daemonHome, "cosmovisor", "upgrades", "v2", "bin")
+	s.Require().NoError(os.MkdirAll(binDir, 0o755))
+	s.Require().NoError(os.WriteFile(filepath.Join(binDir, "simd"), nil, 0o700))
+	s.Require().True(isReady())
+
+	verify := s.upgradeKeeper.VerifyVoteExtensionHandler()
+	for _, bz := range [][]byte{nil, resp.VoteExtension} {
+		res, err := verify(ctx, &abci.RequestVerifyVoteExtension{VoteExtension: bz})
+		s.Require().NoError(err)
+		s.Require().Equal(abci.ResponseVerifyVoteExtension_ACCEPT, res.Status)
+	}
+	res, err := verify(ctx, &abci.RequestVerifyVoteExtension{VoteExtension: []byte("not a vote extension")})
+	s.Require().NoError(err)
+	s.Require().Equal(abci.ResponseVerifyVoteExtension_REJECT, res.Status)
+
+	// the proposer injects the vote extensions in the proposal
+	extCommit := abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{
+		{Validator: abci.Validator{Address: []byte("val1________________"), Power: 50}, BlockIdFlag: cmtproto.BlockIDFlagCommit, VoteExtension: extend(true, "v2")},
+		{Validator: abci.Validator{Address: []byte("val2________________"), Power: 30}, BlockIdFlag: cmtproto.BlockIDFlagCommit, VoteExtension: extend(true, "v1")},
+		{Validator: abci.Validator{Address: []byte("val3________________"), Power: 10}, BlockIdFlag: cmtproto.BlockIDFlagCommit, VoteExtension: extend(false, "v2")},
+		{Validator: abci.Validator{Address: []byte("val4________________"), Power: 10}, BlockIdFlag: cmtproto.BlockIDFlagAbsent},
+	}}
+	prepare := s.upgradeKeeper.PrepareProposalHandler(func(_ sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
+	})
+	prepared, err := prepare(ctx, &abci.RequestPrepareProposal{Height: 10, MaxTxBytes: 1 << 20, Txs: [][]byte{[]byte("tx")}, LocalLastCommit: extCommit})
+	s.Require().NoError(err)
+	s.Require().Len(prepared.Txs, 2)
+	s.Require().Equal([]byte("tx"), prepared.Txs[1])
+
+	// proposals without vote extensions are rejected
+	process := s.upgradeKeeper.ProcessProposalHandler(nil, func(sdk.Context, *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	})
+	processed, err := process(ctx, &abci.RequestProcessProposal{Height: 10, Txs: [][]byte{[]byte("tx")}})
+	s.Require().NoError(err)
+	s.Require().Equal(abci.ResponseProcessProposal_REJECT, processed.Status)
+
+	// the readiness is aggregated before the other pre-blockers
+	var nextCalled bool
+	preBlocker := s.upgradeKeeper.PreBlocker(func(sdk.Context, *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		nextCalled = true
+		return &sdk.ResponsePreBlock{}, nil
+	})
+	_, err = preBlocker(ctx, &abci.RequestFinalizeBlock{Height: 10, Txs: prepared.Txs})
+	s.Require().NoError(err)
+	s.Require().True(nextCalled)
+
+	queried, err := s.upgradeKeeper.UpgradeReadiness(ctx, &types.QueryUpgradeReadinessRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(10), queried.Readiness.Height)
+	s.Require().Equal("v2", queried.Readiness.PlanName)
+	s.Require().Equal(int64(50), queried.Readiness.ReadyPower)
+	s.Require().Equal(int64(100), queried.Readiness.TotalPower)
+	s.Require().Len(queried.Readiness.Validators, 4)
+	s.Require().Equal("v1.0.0", queried.Readiness.Validators[0].AppVersion)
+	s.Require().Empty(queried.Readiness.Validators[3].AppVersion)
+	s.Require().Equal(math.LegacyNewDecWithPrec(5, 1), queried.ReadyRatio)
+
+	// without a readiness rule the upgrade is due at its height
+	dueCtx := ctx.WithHeaderInfo(header.Info{Height: 20})
+	delayed, err := s.upgradeKeeper.DelayUpgradeIfNotReady(dueCtx, plan)
+	s.Require().NoError(err)
+	s.Require().False(delayed)
+
+	// below the threshold, the plan is delayed instead of halting the chain
+	s.upgradeKeeper.SetReadinessRule(math.LegacyNewDecWithPrec(67, 2), 5)
+	_, err = upgrade.PreBlocker(dueCtx, s.upgradeKeeper)
+	s.Require().NoError(err)
+	delayedPlan, err := s.upgradeKeeper.GetUpgradePlan(dueCtx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(25), delayedPlan.Height)
+
+	events := dueCtx.EventManager().Events()
+	s.Require().Equal(types.EventTypeUpgradeDelayed, events[len(events)-1].Type)
+
+	// above the threshold, the upgrade is not delayed
+	s.upgradeKeeper.SetReadinessRule(math.LegacyNewDecWithPrec(5, 1), 5)
+	delayed, err = s.upgradeKeeper.DelayUpgradeIfNotReady(ctx.WithHeaderInfo(header.Info{Height: 25}), delayedPlan)
+	s.Require().NoError(err)
+	s.Require().False(delayed)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cast"
@@ -196,6 +198,7 @@ type ModuleOutputs struct {
 func ProvideModule(in ModuleInputs) ModuleOutputs {
 	var (
 		homePath           string
+		preDownload        bool
 		skipUpgradeHeights = make(map[int64]bool)
 	)
 
@@ -205,12 +208,14 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		}
 
 		homePath = in.Viper.GetString(flags.FlagHome)
+		preDownload = in.Viper.GetBool(server.FlagUpgradePreDownload)
 	} else if in.AppOpts != nil {
 		for _, h := range cast.ToIntSlice(in.AppOpts.Get(server.FlagUnsafeSkipUpgrades)) {
 			skipUpgradeHeights[int64(h)] = true
 		}
 
 		homePath = cast.ToString(in.AppOpts.Get(flags.FlagHome))
+		preDownload = cast.ToBool(in.AppOpts.Get(server.FlagUpgradePreDownload))
 	}
 
 	// default to governance authority if not provided
@@ -221,6 +226,10 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	// set the governance module account as the authority for conducting upgrades
 	k := keeper.NewKeeper(skipUpgradeHeights, in.StoreService, in.Cdc, homePath, nil, authority.String())
+	if preDownload {
+		// the new binary is expected to have the same name as the running one
+		k.SetPreDownload(filepath.Base(os.Args[0]))
+	}
 	baseappOpt := func(app *baseapp.BaseApp) {
 		k.SetVersionSetter(app)
 	}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// UpgradesDirName is the name of the directory, under the data directory of the
+// node home, in which the binaries of upgrade plans are pre-downloaded.
+const UpgradesDirName = "upgrades"
+
+// DownloadState is the state of the pre-download of an upgrade binary.
+type DownloadState int
+
+const (
+	// DownloadInProgress means the binary is being downloaded.
+	DownloadInProgress DownloadState = iota + 1
+	// DownloadDone means the binary has been downloaded and verified.
+	DownloadDone
+	// DownloadFailed means the binary could not be downloaded or verified.
+	DownloadFailed
+	// DownloadSkipped means the info of the plan lists no valid binary for the
+	// node, so there is nothing to download until the plan is replaced.
+	DownloadSkipped
+)
+
+// String implements fmt.Stringer.
+func (s DownloadState) String() string {
+	switch s {
+	case DownloadInProgress:
+		return "in_progress"
+	case DownloadDone:
+		return "done"
+	case DownloadFailed:
+		return "failed"
+	case DownloadSkipped:
+		return "skipped"
+	default:
+		return "unknown"
+	}
+}
+
+// DownloadStatus is the node-local status of the pre-download of the binary of
+// an upgrade plan.
+type DownloadStatus struct {
+	// PlanName is the name of the upgrade plan.
+	PlanName string
+	// InfoHash is the hex encoded sha256 hash of the info of the upgrade plan.
+	InfoHash string
+	// State is the state of the download.
+	State DownloadState
+	// BinaryPath is the path of the downloaded binary, once done.
+	BinaryPath string
+	// Checksum is the hex encoded sha256 checksum of the downloaded binary, once done.
+	Checksum string
+	// Err is the reason of the failure or of the skip, if any.
+	Err error
+}
+
+// IsDoneFor returns true if the binary of the given plan, with the same name
+// and info, has been downloaded and verified.
+func (s DownloadStatus) IsDoneFor(p Plan) bool {
+	return s.State == DownloadDone && s.PlanName == p.Name && s.InfoHash == PlanInfoHash(p)
+}
+
+// PlanInfoHash returns the hex encoded sha256 hash of the info of the plan, so
+// that a plan replaced by one of the same name but other binaries is told
+// apart.
+func PlanInfoHash(p Plan) string {
+	h := sha256.Sum256([]byte(p.Info))
+	return hex.EncodeToString(h[:])
+}
@@ -0,0 +1,10 @@
+package types
+
+// upgrade module event types
+const (
+	EventTypeUpgradeDelayed = "upgrade_delayed"
+
+	AttributeKeyPlanName   = "plan_name"
+	AttributeKeyHeight     = "height"
+	AttributeKeyReadyRatio = "ready_ratio"
+)
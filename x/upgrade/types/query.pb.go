@@ -5,8 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -484,6 +486,93 @@ func (m *QueryAuthorityResponse) GetAddress() string {
 	return ""
 }
 
+// QueryUpgradeReadinessRequest is the request type for the Query/UpgradeReadiness
+// RPC method.
+type QueryUpgradeReadinessRequest struct {
+}
+
+func (m *QueryUpgradeReadinessRequest) Reset()         { *m = QueryUpgradeReadinessRequest{} }
+func (m *QueryUpgradeReadinessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeReadinessRequest) ProtoMessage()    {}
+func (*QueryUpgradeReadinessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeReadinessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeReadinessRequest.Merge(m, src)
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeReadinessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeReadinessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeReadinessRequest proto.InternalMessageInfo
+
+// QueryUpgradeReadinessResponse is the response type for the Query/UpgradeReadiness
+// RPC method.
+type QueryUpgradeReadinessResponse struct {
+	// readiness is the last aggregated upgrade readiness.
+	Readiness UpgradeReadiness `protobuf:"bytes,1,opt,name=readiness,proto3" json:"readiness"`
+	// ready_ratio is the ratio of the voting power ready for the scheduled plan.
+	ReadyRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=ready_ratio,json=readyRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ready_ratio"`
+}
+
+func (m *QueryUpgradeReadinessResponse) Reset()         { *m = QueryUpgradeReadinessResponse{} }
+func (m *QueryUpgradeReadinessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeReadinessResponse) ProtoMessage()    {}
+func (*QueryUpgradeReadinessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeReadinessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeReadinessResponse.Merge(m, src)
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeReadinessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeReadinessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeReadinessResponse proto.InternalMessageInfo
+
+func (m *QueryUpgradeReadinessResponse) GetReadiness() UpgradeReadiness {
+	if m != nil {
+		return m.Readiness
+	}
+	return UpgradeReadiness{}
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -495,6 +584,8 @@ func init() {
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
 	proto.RegisterType((*QueryAuthorityRequest)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityRequest")
 	proto.RegisterType((*QueryAuthorityResponse)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityResponse")
+	proto.RegisterType((*QueryUpgradeReadinessRequest)(nil), "cosmos.upgrade.v1beta1.QueryUpgradeReadinessRequest")
+	proto.RegisterType((*QueryUpgradeReadinessResponse)(nil), "cosmos.upgrade.v1beta1.QueryUpgradeReadinessResponse")
 }
 
 func init() {
@@ -502,51 +593,60 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 689 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x4f, 0x13, 0x41,
-	0x1c, 0x65, 0x0a, 0x82, 0xfc, 0x6a, 0xc0, 0x0c, 0xb1, 0x2e, 0x2b, 0xa9, 0x75, 0x40, 0xc5, 0x48,
-	0x77, 0xa1, 0x35, 0x1c, 0xd0, 0x18, 0x85, 0x44, 0xc5, 0x08, 0xd1, 0x1a, 0x3d, 0x78, 0x69, 0x86,
-	0xee, 0xa4, 0x6d, 0x6c, 0x77, 0x96, 0x9d, 0x59, 0x22, 0x21, 0x78, 0xe0, 0xe4, 0xd1, 0xc4, 0x9b,
-	0x07, 0x6f, 0x26, 0x7e, 0x00, 0xaf, 0xde, 0x8d, 0x27, 0xa2, 0x17, 0x63, 0x3c, 0x18, 0xf0, 0x83,
-	0x98, 0x9d, 0x9d, 0x62, 0x4b, 0x77, 0x0b, 0x7a, 0xeb, 0xec, 0xbc, 0xdf, 0xfb, 0xb3, 0x33, 0x6f,
-	0x0b, 0xa4, 0xc2, 0x45, 0x93, 0x0b, 0x3b, 0xf0, 0xaa, 0x3e, 0x75, 0x98, 0xbd, 0x31, 0xb7, 0xc6,
-	0x24, 0x9d, 0xb3, 0xd7, 0x03, 0xe6, 0x6f, 0x5a, 0x9e, 0xcf, 0x25, 0xc7, 0x99, 0x08, 0x63, 0x69,
-	0x8c, 0xa5, 0x31, 0xe6, 0x44, 0x95, 0xf3, 0x6a, 0x83, 0xd9, 0xd4, 0xab, 0xdb, 0xd4, 0x75, 0xb9,
-	0xa4, 0xb2, 0xce, 0x5d, 0x11, 0x4d, 0x99, 0x53, 0x09, 0xcc, 0x2d, 0x96, 0x08, 0x35, 0x1e, 0xa1,
-	0xca, 0x6a, 0x65, 0x6b, 0x21, 0xb5, 0x20, 0xe3, 0x70, 0xf6, 0x51, 0xe8, 0x62, 0x29, 0xf0, 0x7d,
-	0xe6, 0xca, 0x87, 0x0d, 0xea, 0x96, 0xd8, 0x7a, 0xc0, 0x84, 0x24, 0x0f, 0xc0, 0xe8, 0xde, 0x12,
-	0x1e, 0x77, 0x05, 0xc3, 0xb3, 0x30, 0xe0, 0x35, 0xa8, 0x6b, 0xa0, 0x1c, 0x9a, 0x4e, 0x17, 0x26,
-	0xac, 0x78, 0xf3, 0x96, 0x9a, 0x51, 0x48, 0x92, 0xd7, 0x42, 0xb7, 0x3d, 0xaf, 0x51, 0x67, 0x4e,
-	0x9b, 0x10, 0xc6, 0x30, 0xe0, 0xd2, 0x26, 0x53, 0x64, 0xc3, 0x25, 0xf5, 0x9b, 0x14, 0xb4, 0x78,
-	0x07, 0x5c, 0x8b, 0x67, 0x60, 0xb0, 0xc6, 0xea, 0xd5, 0x9a, 0x54, 0x13, 0xfd, 0x25, 0xbd, 0x22,
-	0xcb, 0x40, 0xd4, 0xcc, 0x93, 0xc8, 0x85, 0xb3, 0x14, 0xa2, 0x5d, 0x11, 0x88, 0xc7, 0x92, 0x4a,
-	0xd6, 0x52, 0x3b, 0x0f, 0xe9, 0x06, 0x15, 0xb2, 0xdc, 0x41, 0x01, 0xe1, 0xa3, 0x7b, 0xea, 0xc9,
-	0x42, 0xca, 0x40, 0xe4, 0x25, 0x4c, 0xf6, 0xa4, 0xd2, 0x4e, 0x56, 0xc0, 0xd0, 0x91, 0x9d, 0x72,
-	0xa5, 0x05, 0x29, 0x8b, 0x10, 0x63, 0xa4, 0x72, 0x68, 0xfa, 0xd4, 0xe2, 0xd8, 0x8f, 0x8f, 0xf9,
-	0xd1, 0xe8, 0xed, 0xe4, 0x85, 0xf3, 0x3c, 0x37, 0x6b, 0x5d, 0x2b, 0x96, 0x32, 0x41, 0x2c, 0x6d,
-	0xa8, 0x7c, 0x7f, 0xe0, 0x24, 0x3a, 0x9d, 0x22, 0x25, 0x30, 0x95, 0xfe, 0x0a, 0x77, 0x82, 0x06,
-	0x7b, 0xca, 0x7c, 0x11, 0x1e, 0x7a, 0x5b, 0x84, 0xa6, 0xda, 0x28, 0xb7, 0xbd, 0x37, 0x88, 0x1e,
-	0xad, 0xd2, 0x26, 0x5b, 0x18, 0xfb, 0xda, 0xad, 0x4a, 0x76, 0x10, 0x9c, 0x8b, 0x25, 0xd5, 0x61,
-	0x56, 0x61, 0x54, 0xb3, 0x6e, 0xe8, 0x2d, 0x03, 0xe5, 0xfa, 0xa7, 0xd3, 0x85, 0x8b, 0x49, 0xc7,
-	0xdb, 0x41, 0x54, 0x1a, 0x69, 0x76, 0xf0, 0xc6, 0x9b, 0x98, 0x81, 0x33, 0xd1, 0xb9, 0x06, 0xb2,
-	0xc6, 0xfd, 0xba, 0xdc, 0xd4, 0x99, 0xe2, 0xd0, 0xf3, 0xe4, 0x2e, 0x64, 0x0e, 0xa3, 0xb5, 0x59,
-	0x03, 0x86, 0xa8, 0xe3, 0xf8, 0x4c, 0x08, 0x1d, 0xbf, 0xb5, 0x8c, 0x25, 0x2a, 0xbc, 0x1d, 0x82,
-	0x13, 0x8a, 0x09, 0xbf, 0x43, 0x90, 0x6e, 0xbb, 0xd1, 0xd8, 0x4e, 0x0a, 0x97, 0x50, 0x0b, 0x73,
-	0xf6, 0xf8, 0x03, 0x91, 0x57, 0x32, 0xb3, 0xf3, 0xed, 0xf7, 0x9b, 0xd4, 0x25, 0x3c, 0x65, 0x27,
-	0xb4, 0xb5, 0x12, 0x0d, 0x95, 0xc3, 0xa2, 0xe0, 0xf7, 0x08, 0xd2, 0x6d, 0xb7, 0xfe, 0x08, 0x83,
-	0xdd, 0x75, 0x3a, 0xc2, 0x60, 0x4c, 0xa1, 0x48, 0x51, 0x19, 0xcc, 0xe3, 0xab, 0x49, 0x06, 0x69,
-	0x34, 0xa4, 0x0c, 0xda, 0x5b, 0xe1, 0xa5, 0xdb, 0xc6, 0x3f, 0x11, 0x64, 0xe2, 0xeb, 0x81, 0x17,
-	0x7a, 0x3a, 0xe8, 0x59, 0x4f, 0xf3, 0xfa, 0x7f, 0xcd, 0xea, 0x20, 0xcb, 0x2a, 0xc8, 0x2d, 0x7c,
-	0xd3, 0xee, 0xfd, 0x5d, 0xec, 0x6a, 0xab, 0xbd, 0xd5, 0xf6, 0x4d, 0xd8, 0x7e, 0x95, 0x42, 0xf8,
-	0x13, 0x82, 0x91, 0xce, 0xa2, 0xe0, 0x42, 0x4f, 0x6b, 0xb1, 0x55, 0x35, 0x8b, 0xff, 0x34, 0xa3,
-	0x63, 0x2c, 0x7e, 0xe9, 0x6e, 0x8e, 0x4a, 0x76, 0x05, 0x5f, 0x4e, 0x4a, 0x76, 0xa8, 0xba, 0xf8,
-	0x03, 0x82, 0xe1, 0x83, 0xda, 0xe0, 0x7c, 0xef, 0x3b, 0x71, 0xa8, 0x8c, 0xa6, 0x75, 0x5c, 0xb8,
-	0x36, 0x7c, 0xa3, 0xdb, 0xf0, 0xbc, 0x32, 0x3c, 0x89, 0x2f, 0x24, 0xde, 0xa9, 0x16, 0xcb, 0xe2,
-	0x9d, 0xcf, 0x7b, 0x59, 0xb4, 0xbb, 0x97, 0x45, 0xbf, 0xf6, 0xb2, 0xe8, 0xf5, 0x7e, 0xb6, 0x6f,
-	0x77, 0x3f, 0xdb, 0xf7, 0x7d, 0x3f, 0xdb, 0xf7, 0x6c, 0xa6, 0x5a, 0x97, 0xb5, 0x60, 0xcd, 0xaa,
-	0xf0, 0x66, 0x8b, 0xe6, 0xaf, 0x8a, 0xfd, 0xe2, 0x80, 0x53, 0x6e, 0x7a, 0x4c, 0xac, 0x0d, 0xaa,
-	0xbf, 0xb4, 0xe2, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x53, 0xf4, 0x4d, 0x6f, 0x07, 0x00,
-	0x00,
+	// 844 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x4f, 0xe3, 0x46,
+	0x1c, 0xcd, 0x84, 0x94, 0x92, 0x49, 0x05, 0x68, 0x68, 0xd3, 0x60, 0x68, 0x48, 0x0d, 0x6d, 0x83,
+	0x20, 0x36, 0x24, 0xc0, 0x81, 0x56, 0x55, 0x1b, 0x50, 0x5b, 0x2a, 0x40, 0xad, 0xab, 0xf6, 0xd0,
+	0x8b, 0x35, 0xc4, 0x23, 0xc7, 0x22, 0xb1, 0x8d, 0xc7, 0x46, 0x8d, 0x10, 0x3d, 0x70, 0xda, 0xe3,
+	0x4a, 0x7b, 0xdf, 0xdb, 0x4a, 0xfb, 0x01, 0xb8, 0xee, 0x71, 0x25, 0xc4, 0x09, 0xb1, 0xd2, 0x6a,
+	0x85, 0x56, 0x68, 0x15, 0xf6, 0x83, 0xac, 0x3c, 0x9e, 0x84, 0xfc, 0xb1, 0x03, 0xec, 0xcd, 0x33,
+	0xf3, 0x7e, 0xef, 0xf7, 0xde, 0xfc, 0x79, 0x09, 0x14, 0x2b, 0x16, 0xad, 0x5b, 0x54, 0xf6, 0x6c,
+	0xdd, 0xc1, 0x1a, 0x91, 0x0f, 0x97, 0xf7, 0x88, 0x8b, 0x97, 0xe5, 0x03, 0x8f, 0x38, 0x0d, 0xc9,
+	0x76, 0x2c, 0xd7, 0x42, 0xe9, 0x00, 0x23, 0x71, 0x8c, 0xc4, 0x31, 0xc2, 0xb4, 0x6e, 0x59, 0x7a,
+	0x8d, 0xc8, 0xd8, 0x36, 0x64, 0x6c, 0x9a, 0x96, 0x8b, 0x5d, 0xc3, 0x32, 0x69, 0x50, 0x25, 0xcc,
+	0x45, 0x30, 0xb7, 0x58, 0x02, 0xd4, 0x64, 0x80, 0x52, 0xd9, 0x48, 0xe6, 0x8d, 0x82, 0xa5, 0xcf,
+	0x75, 0x4b, 0xb7, 0x82, 0x79, 0xff, 0x2b, 0x98, 0x15, 0x27, 0xe1, 0x97, 0x7f, 0xfa, 0xda, 0x36,
+	0x3c, 0xc7, 0x21, 0xa6, 0xfb, 0x47, 0x0d, 0x9b, 0x0a, 0x39, 0xf0, 0x08, 0x75, 0xc5, 0x6d, 0x98,
+	0xe9, 0x5f, 0xa2, 0xb6, 0x65, 0x52, 0x82, 0x96, 0x60, 0xc2, 0xae, 0x61, 0x33, 0x03, 0x72, 0x20,
+	0x9f, 0x2a, 0x4e, 0x4b, 0xe1, 0x96, 0x24, 0x56, 0xc3, 0x90, 0x62, 0x81, 0x37, 0xfa, 0xd9, 0xb6,
+	0x6b, 0x06, 0xd1, 0x3a, 0x1a, 0x21, 0x04, 0x13, 0x26, 0xae, 0x13, 0x46, 0x96, 0x54, 0xd8, 0xb7,
+	0x58, 0xe4, 0xcd, 0xbb, 0xe0, 0xbc, 0x79, 0x1a, 0x0e, 0x57, 0x89, 0xa1, 0x57, 0x5d, 0x56, 0x31,
+	0xa4, 0xf0, 0x91, 0xb8, 0x05, 0x45, 0x56, 0xf3, 0x77, 0xa0, 0x42, 0xdb, 0xf0, 0xd1, 0x26, 0xf5,
+	0xe8, 0x5f, 0x2e, 0x76, 0x49, 0xab, 0xdb, 0x0c, 0x4c, 0xd5, 0x30, 0x75, 0xd5, 0x2e, 0x0a, 0xe8,
+	0x4f, 0xfd, 0xc6, 0x66, 0xd6, 0xe3, 0x19, 0x20, 0xfe, 0x0f, 0x67, 0x07, 0x52, 0x71, 0x25, 0x3b,
+	0x30, 0xc3, 0x2d, 0x6b, 0x6a, 0xa5, 0x05, 0x51, 0xa9, 0x8f, 0xc9, 0xc4, 0x73, 0x20, 0xff, 0x59,
+	0x79, 0xe2, 0xea, 0xb4, 0x30, 0x16, 0xec, 0x4e, 0x81, 0x6a, 0xfb, 0xb9, 0x25, 0x69, 0xa5, 0xa4,
+	0xa4, 0xbd, 0x50, 0x5a, 0xbf, 0xf3, 0xef, 0x89, 0x11, 0x30, 0x1e, 0x17, 0x15, 0x28, 0xb0, 0xfe,
+	0x3b, 0x96, 0xe6, 0xd5, 0xc8, 0x3f, 0xc4, 0xa1, 0xfe, 0x55, 0xe8, 0xb0, 0x50, 0x67, 0x0b, 0x6a,
+	0xc7, 0xbe, 0xc1, 0x60, 0x6a, 0x17, 0xd7, 0xc9, 0xfa, 0xc4, 0x65, 0x7f, 0x57, 0xf1, 0x04, 0xc0,
+	0xa9, 0x50, 0x52, 0x6e, 0x66, 0x17, 0x8e, 0x71, 0xd6, 0x43, 0xbe, 0x94, 0x01, 0xb9, 0xa1, 0x7c,
+	0xaa, 0xf8, 0x4d, 0xd4, 0xf1, 0x76, 0x11, 0x29, 0xa3, 0xf5, 0x2e, 0xde, 0x70, 0x11, 0x8b, 0xf0,
+	0x8b, 0xe0, 0x5c, 0x3d, 0xb7, 0x6a, 0x39, 0x86, 0xdb, 0xe0, 0x9e, 0xc2, 0xd0, 0x6b, 0xe2, 0xaf,
+	0x30, 0xdd, 0x8b, 0xe6, 0x62, 0x33, 0xf0, 0x53, 0xac, 0x69, 0x0e, 0xa1, 0x94, 0xdb, 0x6f, 0x0d,
+	0xc3, 0x89, 0x4a, 0x70, 0xba, 0xf3, 0x3c, 0x15, 0x82, 0x35, 0xc3, 0x24, 0x94, 0x46, 0x77, 0x5f,
+	0x5d, 0x11, 0x5f, 0x03, 0xf8, 0x55, 0x44, 0x15, 0x57, 0xb1, 0x0d, 0x93, 0x4e, 0x6b, 0x92, 0xbf,
+	0x85, 0x7c, 0xd4, 0x66, 0xf5, 0x92, 0x94, 0x13, 0x67, 0xd7, 0x33, 0x31, 0xe5, 0x96, 0x00, 0x29,
+	0x30, 0xe5, 0x0f, 0x1a, 0xaa, 0xe3, 0xbf, 0x7c, 0x76, 0x81, 0x92, 0xe5, 0x65, 0x1f, 0x75, 0x75,
+	0x3d, 0x33, 0x15, 0xd0, 0x52, 0x6d, 0x5f, 0x32, 0x2c, 0xb9, 0x8e, 0xdd, 0xaa, 0xb4, 0x4d, 0x74,
+	0x5c, 0x69, 0x6c, 0x92, 0xca, 0xe5, 0x69, 0x01, 0xf2, 0xae, 0x9b, 0xa4, 0xa2, 0x40, 0xc6, 0xa2,
+	0xf8, 0x24, 0xa1, 0xc6, 0x8a, 0xcd, 0x11, 0xf8, 0x09, 0x33, 0x86, 0x9e, 0x02, 0x98, 0xea, 0x78,
+	0xdf, 0x48, 0x8e, 0x52, 0x1f, 0x11, 0x12, 0xc2, 0xd2, 0xfd, 0x0b, 0x82, 0x3d, 0x13, 0x17, 0x4f,
+	0x5e, 0xbd, 0x7f, 0x12, 0xff, 0x16, 0xcd, 0xc9, 0x11, 0x89, 0x56, 0x09, 0x8a, 0x54, 0x3f, 0x36,
+	0xd0, 0x33, 0x00, 0x53, 0x1d, 0x19, 0x70, 0x87, 0xc0, 0xfe, 0x70, 0xb9, 0x43, 0x60, 0x48, 0xbc,
+	0x88, 0x25, 0x26, 0xb0, 0x80, 0x16, 0xa2, 0x04, 0xe2, 0xa0, 0x88, 0x09, 0x94, 0x8f, 0xfc, 0x27,
+	0x78, 0x8c, 0xde, 0x02, 0x98, 0x0e, 0x0f, 0x0b, 0xb4, 0x3e, 0x50, 0xc1, 0xc0, 0xb0, 0x12, 0xbe,
+	0xff, 0xa8, 0x5a, 0x6e, 0x64, 0x8b, 0x19, 0xf9, 0x09, 0xfd, 0x28, 0x0f, 0xfe, 0xed, 0xe8, 0xcb,
+	0x2e, 0xf9, 0xa8, 0x23, 0x21, 0x8f, 0x1f, 0xc5, 0x01, 0x7a, 0x01, 0xe0, 0x68, 0x77, 0x6c, 0xa0,
+	0xe2, 0x40, 0x69, 0xa1, 0xc1, 0x25, 0x94, 0x1e, 0x54, 0xc3, 0x6d, 0x94, 0xcf, 0xfb, 0x73, 0x84,
+	0x39, 0x9b, 0x47, 0xdf, 0x45, 0x39, 0xeb, 0x09, 0x32, 0xf4, 0x1c, 0xc0, 0x64, 0x3b, 0x44, 0x50,
+	0x61, 0xf0, 0x9d, 0xe8, 0x89, 0x26, 0x41, 0xba, 0x2f, 0x9c, 0x0b, 0xfe, 0xa1, 0x5f, 0xf0, 0x1a,
+	0x13, 0x3c, 0x8b, 0xbe, 0x8e, 0xbc, 0x53, 0x6d, 0x71, 0x2f, 0x01, 0x1c, 0xef, 0xcd, 0x0a, 0xb4,
+	0x72, 0x9f, 0x7b, 0xd0, 0x9b, 0x6a, 0xc2, 0xea, 0x03, 0xab, 0xb8, 0xfe, 0xcd, 0xf3, 0xfe, 0xcc,
+	0x60, 0xfa, 0x17, 0xd0, 0xfc, 0x1d, 0x57, 0x49, 0x6d, 0xa7, 0x59, 0xf9, 0x97, 0xb3, 0x66, 0x16,
+	0x5c, 0x34, 0xb3, 0xe0, 0x5d, 0x33, 0x0b, 0x1e, 0xdf, 0x64, 0x63, 0x17, 0x37, 0xd9, 0xd8, 0x9b,
+	0x9b, 0x6c, 0xec, 0xdf, 0x45, 0xdd, 0x70, 0xab, 0xde, 0x9e, 0x54, 0xb1, 0xea, 0x2d, 0xba, 0xdb,
+	0x6e, 0xf2, 0x7f, 0x6d, 0x6e, 0xb7, 0x61, 0x13, 0xba, 0x37, 0xcc, 0xfe, 0xa8, 0x94, 0x3e, 0x04,
+	0x00, 0x00, 0xff, 0xff, 0xec, 0xfd, 0x93, 0x6c, 0x5b, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -576,6 +676,9 @@ type QueryClient interface {
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// UpgradeReadiness queries the upgrade readiness of the validators, by voting
+	// power, aggregated from their vote extensions.
+	UpgradeReadiness(ctx context.Context, in *QueryUpgradeReadinessRequest, opts ...grpc.CallOption) (*QueryUpgradeReadinessResponse, error)
 }
 
 type queryClient struct {
@@ -632,6 +735,15 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) UpgradeReadiness(ctx context.Context, in *QueryUpgradeReadinessRequest, opts ...grpc.CallOption) (*QueryUpgradeReadinessResponse, error) {
+	out := new(QueryUpgradeReadinessResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/UpgradeReadiness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -649,6 +761,9 @@ type QueryServer interface {
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// UpgradeReadiness queries the upgrade readiness of the validators, by voting
+	// power, aggregated from their vote extensions.
+	UpgradeReadiness(context.Context, *QueryUpgradeReadinessRequest) (*QueryUpgradeReadinessResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -670,6 +785,9 @@ func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryM
 func (*UnimplementedQueryServer) Authority(ctx context.Context, req *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (*UnimplementedQueryServer) UpgradeReadiness(ctx context.Context, req *QueryUpgradeReadinessRequest) (*QueryUpgradeReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeReadiness not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -765,6 +883,24 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UpgradeReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeReadinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/UpgradeReadiness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeReadiness(ctx, req.(*QueryUpgradeReadinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
@@ -790,6 +926,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "UpgradeReadiness",
+			Handler:    _Query_UpgradeReadiness_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -1089,6 +1229,72 @@ func (m *QueryAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryUpgradeReadinessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeReadinessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeReadinessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeReadinessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeReadinessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeReadinessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ReadyRatio.Size()
+		i -= size
+		if _, err := m.ReadyRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Readiness.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1222,6 +1428,28 @@ func (m *QueryAuthorityResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryUpgradeReadinessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUpgradeReadinessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Readiness.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ReadyRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1966,6 +2194,173 @@ func (m *QueryAuthorityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryUpgradeReadinessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeReadinessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Readiness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Readiness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReadyRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
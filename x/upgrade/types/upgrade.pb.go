@@ -223,11 +223,154 @@ func (m *ModuleVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
+// ReadinessVoteExtension is the vote extension by which a validator signals the
+// version of its binary and whether it is ready for the scheduled upgrade plan.
+type ReadinessVoteExtension struct {
+	// app_version is the version of the binary run by the validator.
+	AppVersion string `protobuf:"bytes,1,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// plan_name is the name of the scheduled upgrade plan, if any.
+	PlanName string `protobuf:"bytes,2,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	// ready is true if the validator is ready to upgrade to the plan.
+	Ready bool `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+}
+
+func (m *ReadinessVoteExtension) Reset()         { *m = ReadinessVoteExtension{} }
+func (m *ReadinessVoteExtension) String() string { return proto.CompactTextString(m) }
+func (*ReadinessVoteExtension) ProtoMessage()    {}
+func (*ReadinessVoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
+}
+func (m *ReadinessVoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadinessVoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadinessVoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadinessVoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadinessVoteExtension.Merge(m, src)
+}
+func (m *ReadinessVoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadinessVoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadinessVoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadinessVoteExtension proto.InternalMessageInfo
+
+// ValidatorReadiness is the upgrade readiness signalled by a validator.
+type ValidatorReadiness struct {
+	// consensus_address is the consensus address of the validator.
+	ConsensusAddress string `protobuf:"bytes,1,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// power is the voting power of the validator.
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	// app_version is the version of the binary run by the validator, empty if it
+	// did not signal it.
+	AppVersion string `protobuf:"bytes,3,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// plan_name is the name of the upgrade plan the validator signalled for.
+	PlanName string `protobuf:"bytes,4,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	// ready is true if the validator is ready to upgrade to the plan.
+	Ready bool `protobuf:"varint,5,opt,name=ready,proto3" json:"ready,omitempty"`
+}
+
+func (m *ValidatorReadiness) Reset()         { *m = ValidatorReadiness{} }
+func (m *ValidatorReadiness) String() string { return proto.CompactTextString(m) }
+func (*ValidatorReadiness) ProtoMessage()    {}
+func (*ValidatorReadiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{5}
+}
+func (m *ValidatorReadiness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorReadiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorReadiness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorReadiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorReadiness.Merge(m, src)
+}
+func (m *ValidatorReadiness) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorReadiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorReadiness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorReadiness proto.InternalMessageInfo
+
+// UpgradeReadiness is the upgrade readiness of the validators aggregated from
+// the vote extensions of a block.
+type UpgradeReadiness struct {
+	// height is the height of the block in which the readiness was aggregated.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// plan_name is the name of the upgrade plan scheduled at that height, if any.
+	PlanName string `protobuf:"bytes,2,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	// ready_power is the voting power of the validators ready for the plan.
+	ReadyPower int64 `protobuf:"varint,3,opt,name=ready_power,json=readyPower,proto3" json:"ready_power,omitempty"`
+	// total_power is the voting power of all the validators of the last commit.
+	TotalPower int64 `protobuf:"varint,4,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// validators is the readiness signalled by each validator of the last commit.
+	Validators []ValidatorReadiness `protobuf:"bytes,5,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *UpgradeReadiness) Reset()         { *m = UpgradeReadiness{} }
+func (m *UpgradeReadiness) String() string { return proto.CompactTextString(m) }
+func (*UpgradeReadiness) ProtoMessage()    {}
+func (*UpgradeReadiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{6}
+}
+func (m *UpgradeReadiness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeReadiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeReadiness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeReadiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeReadiness.Merge(m, src)
+}
+func (m *UpgradeReadiness) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeReadiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeReadiness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeReadiness proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
+	proto.RegisterType((*ReadinessVoteExtension)(nil), "cosmos.upgrade.v1beta1.ReadinessVoteExtension")
+	proto.RegisterType((*ValidatorReadiness)(nil), "cosmos.upgrade.v1beta1.ValidatorReadiness")
+	proto.RegisterType((*UpgradeReadiness)(nil), "cosmos.upgrade.v1beta1.UpgradeReadiness")
 }
 
 func init() {
@@ -235,41 +378,55 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 540 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xf7, 0xb5, 0x6e, 0x51, 0x2f, 0x42, 0x15, 0x26, 0xb4, 0xd7, 0x08, 0x1c, 0xcb, 0x62, 0x88,
-	0x2a, 0x62, 0xd3, 0x96, 0x29, 0x0c, 0x88, 0x64, 0xe3, 0x8f, 0x54, 0x5c, 0xe8, 0xc0, 0x12, 0x5d,
-	0xec, 0x8b, 0x63, 0x61, 0xdf, 0x59, 0xf6, 0x25, 0x90, 0xaf, 0xc0, 0xd4, 0x8f, 0xc0, 0x88, 0x98,
-	0x3a, 0xf4, 0x43, 0x44, 0x4c, 0x15, 0x13, 0x12, 0x12, 0x7f, 0x92, 0xa1, 0x6c, 0x7c, 0x05, 0x74,
-	0x77, 0x76, 0x65, 0x41, 0x41, 0x0c, 0x2c, 0xd6, 0x7b, 0xef, 0xde, 0xcf, 0xbf, 0xdf, 0xfb, 0xdd,
-	0x3d, 0x78, 0xd3, 0x67, 0x79, 0xc2, 0x72, 0x77, 0x9c, 0x86, 0x19, 0x0e, 0x88, 0x3b, 0xd9, 0x19,
-	0x10, 0x8e, 0x77, 0xca, 0xdc, 0x49, 0x33, 0xc6, 0x99, 0xb1, 0xa1, 0xba, 0x9c, 0xb2, 0x5a, 0x74,
-	0x35, 0xb6, 0x42, 0xc6, 0xc2, 0x98, 0xb8, 0xb2, 0x6b, 0x30, 0x1e, 0xba, 0x98, 0x4e, 0x15, 0xa4,
-	0x51, 0x0f, 0x59, 0xc8, 0x64, 0xe8, 0x8a, 0xa8, 0xa8, 0x36, 0x7f, 0x05, 0xf0, 0x28, 0x21, 0x39,
-	0xc7, 0x49, 0x5a, 0x34, 0x6c, 0x29, 0xa6, 0xbe, 0x42, 0x16, 0xb4, 0xea, 0xe8, 0x0a, 0x4e, 0x22,
-	0xca, 0x5c, 0xf9, 0x55, 0x25, 0xfb, 0x07, 0x80, 0xfa, 0x7e, 0x8c, 0xa9, 0x61, 0x40, 0x9d, 0xe2,
-	0x84, 0x20, 0x60, 0x81, 0xd6, 0x9a, 0x27, 0x63, 0xe3, 0x1e, 0xd4, 0xc5, 0xdf, 0xd1, 0x92, 0x05,
-	0x5a, 0xb5, 0xdd, 0x86, 0xa3, 0xa8, 0x9d, 0x92, 0xda, 0x79, 0x5a, 0x52, 0x77, 0xd7, 0x67, 0x9f,
-	0x9b, 0xda, 0xd1, 0x97, 0x26, 0x78, 0x7b, 0x76, 0xbc, 0x0d, 0x10, 0xf0, 0x24, 0xd0, 0xd8, 0x80,
-	0xab, 0x23, 0x12, 0x85, 0x23, 0x8e, 0x96, 0x2d, 0xd0, 0x5a, 0xf6, 0x8a, 0x4c, 0x90, 0x45, 0x74,
-	0xc8, 0x90, 0xae, 0xc8, 0x44, 0x6c, 0x3c, 0x82, 0xd7, 0x0a, 0x73, 0x82, 0xbe, 0x1f, 0x47, 0x84,
-	0xf2, 0x7e, 0xce, 0x31, 0x27, 0x68, 0x45, 0xb2, 0xd7, 0x7f, 0x63, 0xbf, 0x4f, 0xa7, 0xdd, 0x25,
-	0x04, 0xbc, 0xab, 0x25, 0xac, 0x27, 0x51, 0x07, 0x02, 0xd4, 0x41, 0xdf, 0xdf, 0x34, 0xc1, 0xeb,
-	0xb3, 0xe3, 0xed, 0x75, 0xe5, 0x40, 0x3b, 0x0f, 0x5e, 0xb8, 0x62, 0x50, 0xfb, 0x13, 0x80, 0x9b,
-	0x07, 0x6c, 0xc8, 0x5f, 0xe2, 0x8c, 0x3c, 0x53, 0xc8, 0xfd, 0x8c, 0xa5, 0x2c, 0xc7, 0xb1, 0x51,
-	0x87, 0x2b, 0x3c, 0xe2, 0x71, 0xe9, 0x82, 0x4a, 0x0c, 0x0b, 0xd6, 0x02, 0x92, 0xfb, 0x59, 0x94,
-	0xf2, 0x88, 0x51, 0xe9, 0xc6, 0x9a, 0x57, 0x2d, 0x19, 0x77, 0xa1, 0x9e, 0xc6, 0x98, 0xca, 0x29,
-	0x6b, 0xbb, 0xd7, 0x9d, 0x8b, 0x2f, 0xdb, 0x11, 0xfc, 0xdd, 0x35, 0x61, 0x95, 0xb4, 0xc9, 0x93,
-	0xa0, 0xce, 0x43, 0x21, 0xf5, 0xfd, 0x49, 0xbb, 0x51, 0xa0, 0x42, 0x36, 0x39, 0x47, 0xf4, 0x18,
-	0xe5, 0x84, 0x72, 0x31, 0x88, 0x5d, 0x19, 0xe4, 0x0f, 0xfa, 0x11, 0xb0, 0xdf, 0x01, 0x78, 0xa3,
-	0x87, 0xa9, 0x4f, 0xe2, 0xff, 0x3c, 0x63, 0xe7, 0xc9, 0xbf, 0xc9, 0x6c, 0x55, 0x64, 0xfe, 0x55,
-	0x08, 0x02, 0xf6, 0x21, 0xbc, 0xfc, 0x98, 0x05, 0xe3, 0x98, 0x1c, 0x92, 0x2c, 0x17, 0x3e, 0x5e,
-	0xf4, 0x08, 0x11, 0xbc, 0x34, 0x51, 0xc7, 0x52, 0x95, 0xee, 0x95, 0x69, 0x67, 0x53, 0x28, 0xfa,
-	0x70, 0xd2, 0xae, 0x5c, 0xb1, 0x75, 0xdb, 0xb9, 0xb3, 0xd7, 0x7d, 0x30, 0xfb, 0x66, 0x6a, 0xb3,
-	0xb9, 0x09, 0x4e, 0xe7, 0x26, 0xf8, 0x3a, 0x37, 0xc1, 0xd1, 0xc2, 0xd4, 0x4e, 0x17, 0xa6, 0xf6,
-	0x71, 0x61, 0x6a, 0xcf, 0x6f, 0x85, 0x11, 0x1f, 0x8d, 0x07, 0x8e, 0xcf, 0x92, 0x62, 0x3d, 0xdc,
-	0x8a, 0xe6, 0x57, 0xe7, 0x8b, 0xcc, 0xa7, 0x29, 0xc9, 0x07, 0xab, 0xf2, 0xbd, 0xed, 0xfd, 0x0c,
-	0x00, 0x00, 0xff, 0xff, 0x0f, 0x7b, 0xab, 0xbf, 0xe7, 0x03, 0x00, 0x00,
+	// 755 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x3b, 0x4f, 0x1b, 0x4b,
+	0x14, 0xf6, 0xe0, 0x35, 0x17, 0x8f, 0x75, 0x05, 0x2c, 0xbe, 0xb0, 0xf8, 0x06, 0xdb, 0xb1, 0x52,
+	0x58, 0x28, 0xde, 0x0d, 0x8f, 0x34, 0x4e, 0x11, 0x61, 0x2b, 0x4d, 0x1e, 0xc8, 0x59, 0x12, 0x17,
+	0x69, 0xac, 0xb1, 0x77, 0x58, 0x56, 0x59, 0xcf, 0xac, 0x76, 0xc6, 0x06, 0x97, 0x69, 0x53, 0xf1,
+	0x13, 0x52, 0x46, 0xa9, 0x28, 0xf8, 0x11, 0x28, 0x15, 0xa2, 0x8a, 0x14, 0x29, 0x0f, 0x5c, 0x90,
+	0x2e, 0xf9, 0x09, 0xd1, 0xcc, 0xec, 0x82, 0xc3, 0x2b, 0x29, 0xd2, 0xa0, 0x39, 0x67, 0xcf, 0xb7,
+	0xdf, 0xe3, 0x30, 0x6b, 0x78, 0xab, 0x43, 0x59, 0x97, 0x32, 0xab, 0x17, 0xb8, 0x21, 0x72, 0xb0,
+	0xd5, 0x5f, 0x6a, 0x63, 0x8e, 0x96, 0xe2, 0xda, 0x0c, 0x42, 0xca, 0xa9, 0x3e, 0xab, 0xa6, 0xcc,
+	0xb8, 0x1b, 0x4d, 0xe5, 0xe6, 0x5d, 0x4a, 0x5d, 0x1f, 0x5b, 0x72, 0xaa, 0xdd, 0xdb, 0xb4, 0x10,
+	0x19, 0x28, 0x48, 0x2e, 0xeb, 0x52, 0x97, 0xca, 0xa3, 0x25, 0x4e, 0x51, 0xb7, 0x70, 0x1e, 0xc0,
+	0xbd, 0x2e, 0x66, 0x1c, 0x75, 0x83, 0x68, 0x60, 0x5e, 0x31, 0xb5, 0x14, 0x32, 0xa2, 0x55, 0x8f,
+	0xa6, 0x51, 0xd7, 0x23, 0xd4, 0x92, 0x7f, 0x55, 0xab, 0xf4, 0x1d, 0x40, 0xad, 0xe1, 0x23, 0xa2,
+	0xeb, 0x50, 0x23, 0xa8, 0x8b, 0x0d, 0x50, 0x04, 0xe5, 0xb4, 0x2d, 0xcf, 0xfa, 0x7d, 0xa8, 0x89,
+	0xb7, 0x1b, 0x63, 0x45, 0x50, 0xce, 0x2c, 0xe7, 0x4c, 0x45, 0x6d, 0xc6, 0xd4, 0xe6, 0xb3, 0x98,
+	0xba, 0x36, 0x79, 0xf0, 0xa9, 0x90, 0xd8, 0xfd, 0x5c, 0x00, 0x6f, 0x4f, 0xf6, 0x16, 0x81, 0x01,
+	0x6c, 0x09, 0xd4, 0x67, 0xe1, 0xf8, 0x16, 0xf6, 0xdc, 0x2d, 0x6e, 0x24, 0x8b, 0xa0, 0x9c, 0xb4,
+	0xa3, 0x4a, 0x90, 0x79, 0x64, 0x93, 0x1a, 0x9a, 0x22, 0x13, 0x67, 0xfd, 0x31, 0xfc, 0x2f, 0x0a,
+	0xc7, 0x69, 0x75, 0x7c, 0x0f, 0x13, 0xde, 0x62, 0x1c, 0x71, 0x6c, 0xa4, 0x24, 0x7b, 0xf6, 0x02,
+	0xfb, 0x1a, 0x19, 0xd4, 0xc6, 0x0c, 0x60, 0xcf, 0xc4, 0xb0, 0xba, 0x44, 0x6d, 0x08, 0x50, 0xd5,
+	0xf8, 0xf6, 0xa6, 0x00, 0x5e, 0x9f, 0xec, 0x2d, 0x4e, 0xaa, 0x04, 0x2a, 0xcc, 0x79, 0x69, 0x09,
+	0xa3, 0xa5, 0x8f, 0x00, 0xce, 0x6d, 0xd0, 0x4d, 0xbe, 0x8d, 0x42, 0xfc, 0x5c, 0x21, 0x1b, 0x21,
+	0x0d, 0x28, 0x43, 0xbe, 0x9e, 0x85, 0x29, 0xee, 0x71, 0x3f, 0x4e, 0x41, 0x15, 0x7a, 0x11, 0x66,
+	0x1c, 0xcc, 0x3a, 0xa1, 0x17, 0x70, 0x8f, 0x12, 0x99, 0x46, 0xda, 0x1e, 0x6d, 0xe9, 0xf7, 0xa0,
+	0x16, 0xf8, 0x88, 0x48, 0x97, 0x99, 0xe5, 0x1b, 0xe6, 0xe5, 0xcb, 0x36, 0x05, 0x7f, 0x2d, 0x2d,
+	0xa2, 0x92, 0x31, 0xd9, 0x12, 0x54, 0x7d, 0x24, 0xa4, 0xbe, 0xdf, 0xaf, 0xe4, 0x22, 0x94, 0x4b,
+	0xfb, 0xa7, 0x88, 0x3a, 0x25, 0x1c, 0x13, 0x2e, 0x8c, 0x94, 0x46, 0x8c, 0x5c, 0xa1, 0xdf, 0x00,
+	0xa5, 0x77, 0x00, 0x2e, 0xd4, 0x11, 0xe9, 0x60, 0xff, 0x2f, 0x7b, 0xac, 0x3e, 0xfd, 0x33, 0x99,
+	0xe5, 0x11, 0x99, 0xd7, 0x0a, 0x31, 0x40, 0xa9, 0x09, 0xff, 0x7d, 0x42, 0x9d, 0x9e, 0x8f, 0x9b,
+	0x38, 0x64, 0x22, 0xc7, 0xcb, 0xfe, 0x09, 0x0d, 0xf8, 0x4f, 0x5f, 0x3d, 0x96, 0xaa, 0x34, 0x3b,
+	0x2e, 0xab, 0x73, 0x42, 0xd1, 0xd1, 0x7e, 0x65, 0x64, 0xc5, 0xc5, 0x3b, 0xe6, 0xea, 0x4a, 0xe9,
+	0x15, 0x80, 0xb3, 0x36, 0x46, 0x8e, 0x47, 0x30, 0x63, 0x4d, 0xca, 0xf1, 0x83, 0x1d, 0x8e, 0x89,
+	0x64, 0x28, 0xc0, 0x0c, 0x0a, 0x82, 0x56, 0xfc, 0x46, 0x45, 0x04, 0x51, 0x10, 0xc4, 0x12, 0xfe,
+	0x87, 0x69, 0xb1, 0x95, 0x96, 0xd4, 0xa1, 0x62, 0x98, 0x10, 0x8d, 0x75, 0xa1, 0x25, 0x0b, 0x53,
+	0x21, 0x46, 0xce, 0x40, 0x2e, 0x7a, 0xc2, 0x56, 0x45, 0x75, 0xe6, 0x82, 0x86, 0xbb, 0xab, 0xa5,
+	0x21, 0x80, 0x7a, 0x13, 0xf9, 0x9e, 0x83, 0x38, 0x0d, 0x4f, 0xc5, 0xe8, 0xeb, 0x70, 0xba, 0x43,
+	0x09, 0xc3, 0x84, 0xf5, 0x58, 0x0b, 0x39, 0x4e, 0x88, 0x19, 0x53, 0x2a, 0x6a, 0x37, 0x8f, 0xf6,
+	0x2b, 0x0b, 0x51, 0xb8, 0xf5, 0x78, 0x66, 0x4d, 0x8d, 0x6c, 0xf0, 0xd0, 0x23, 0xae, 0x3d, 0xd5,
+	0x39, 0xd7, 0x17, 0x8a, 0x02, 0xba, 0x8d, 0x43, 0x29, 0x35, 0x69, 0xab, 0xe2, 0xbc, 0xcb, 0xe4,
+	0xf5, 0x2e, 0xb5, 0xab, 0x5c, 0xa6, 0x7e, 0xeb, 0xf2, 0x07, 0x80, 0x53, 0xd1, 0x5e, 0xcf, 0x3c,
+	0x9e, 0xdd, 0x7a, 0xf0, 0xcb, 0xad, 0xbf, 0x36, 0xda, 0x02, 0xcc, 0x48, 0x9e, 0x96, 0xb2, 0xa3,
+	0xbe, 0x17, 0x50, 0xb6, 0x1a, 0xb1, 0x27, 0x4e, 0x39, 0xf2, 0xa3, 0x01, 0x4d, 0x0d, 0xc8, 0x96,
+	0x1a, 0x68, 0x40, 0xd8, 0x8f, 0x03, 0x67, 0x46, 0xaa, 0x98, 0x2c, 0x67, 0x96, 0x17, 0xaf, 0xba,
+	0x8a, 0x17, 0x57, 0x53, 0xd3, 0xc4, 0xc5, 0xb4, 0x47, 0xde, 0x71, 0xa9, 0xe5, 0xda, 0xc3, 0x83,
+	0xaf, 0xf9, 0xc4, 0xc1, 0x71, 0x1e, 0x1c, 0x1e, 0xe7, 0xc1, 0x97, 0xe3, 0x3c, 0xd8, 0x1d, 0xe6,
+	0x13, 0x87, 0xc3, 0x7c, 0xe2, 0xc3, 0x30, 0x9f, 0x78, 0x71, 0xdb, 0xf5, 0xf8, 0x56, 0xaf, 0x6d,
+	0x76, 0x68, 0x37, 0xfa, 0xf6, 0x5a, 0x23, 0x17, 0x62, 0xe7, 0xf4, 0x57, 0x82, 0x0f, 0x02, 0xcc,
+	0xda, 0xe3, 0xf2, 0x63, 0xb6, 0xf2, 0x33, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x49, 0x3d, 0xf5, 0x44,
+	0x06, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -573,6 +730,171 @@ func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReadinessVoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadinessVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadinessVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ready {
+		i--
+		if m.Ready {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PlanName) > 0 {
+		i -= len(m.PlanName)
+		copy(dAtA[i:], m.PlanName)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.PlanName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AppVersion) > 0 {
+		i -= len(m.AppVersion)
+		copy(dAtA[i:], m.AppVersion)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.AppVersion)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorReadiness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorReadiness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorReadiness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ready {
+		i--
+		if m.Ready {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PlanName) > 0 {
+		i -= len(m.PlanName)
+		copy(dAtA[i:], m.PlanName)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.PlanName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AppVersion) > 0 {
+		i -= len(m.AppVersion)
+		copy(dAtA[i:], m.AppVersion)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.AppVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Power != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeReadiness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeReadiness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeReadiness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUpgrade(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadyPower != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.ReadyPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PlanName) > 0 {
+		i -= len(m.PlanName)
+		copy(dAtA[i:], m.PlanName)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.PlanName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -662,6 +984,81 @@ func (m *ModuleVersion) Size() (n int) {
 	return n
 }
 
+func (m *ReadinessVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppVersion)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.PlanName)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.Ready {
+		n += 2
+	}
+	return n
+}
+
+func (m *ValidatorReadiness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovUpgrade(uint64(m.Power))
+	}
+	l = len(m.AppVersion)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.PlanName)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.Ready {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpgradeReadiness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovUpgrade(uint64(m.Height))
+	}
+	l = len(m.PlanName)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.ReadyPower != 0 {
+		n += 1 + sovUpgrade(uint64(m.ReadyPower))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovUpgrade(uint64(m.TotalPower))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovUpgrade(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1232,6 +1629,498 @@ func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReadinessVoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadinessVoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadinessVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ready = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorReadiness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorReadiness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorReadiness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ready = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeReadiness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeReadiness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeReadiness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyPower", wireType)
+			}
+			m.ReadyPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ValidatorReadiness{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0